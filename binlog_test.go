@@ -0,0 +1,257 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/binlog"
+	"github.com/dolthub/go-mysql-server/sql/replica"
+)
+
+func TestBinlogSinks(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t", memory.NewTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t"},
+	}))
+
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), &Config{CommitSequence: sql.NewCommitSequence()})
+	defer e.Close()
+
+	var written []sql.BinlogTransaction
+	e.Binlog.AddSink(sql.BinlogSinkFunc(func(ctx *sql.Context, tx sql.BinlogTransaction) error {
+		require.False(tx.Timestamp.IsZero())
+		tx.Timestamp = time.Time{}
+		for i, e := range tx.Events {
+			if e.Type != sql.BinlogQuery {
+				require.Equal(e.Table, e.Schema[0].Source)
+				tx.Events[i].Schema = nil
+			}
+		}
+		written = append(written, tx)
+		return nil
+	}))
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	query := func(q string) error {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return err
+		}
+		_, err = sql.RowIterToRows(ctx, iter)
+		return err
+	}
+	last := func() sql.BinlogTransaction {
+		require.NotEmpty(written)
+		return written[len(written)-1]
+	}
+
+	require.NoError(query("CREATE TABLE t2 (a bigint primary key, b bigint)"))
+	require.Len(written, 1)
+	require.Equal(uint64(1), last().Sequence)
+	require.Len(last().Events, 1)
+	require.Equal(sql.BinlogQuery, last().Events[0].Type)
+	require.Equal("mydb", last().Events[0].Database)
+
+	require.NoError(query("INSERT INTO t2 VALUES (1, 10), (2, 20)"))
+	require.Equal(sql.BinlogTransaction{Sequence: 2, Events: []sql.BinlogEvent{
+		{Type: sql.BinlogInsert, Database: "mydb", Table: "t2", After: sql.Row{int64(1), int64(10)}},
+		{Type: sql.BinlogInsert, Database: "mydb", Table: "t2", After: sql.Row{int64(2), int64(20)}},
+	}}, last())
+
+	require.NoError(query("UPDATE t2 SET b = b + 1 WHERE a = 1"))
+	require.Equal(sql.BinlogTransaction{Sequence: 3, Events: []sql.BinlogEvent{
+		{Type: sql.BinlogUpdate, Database: "mydb", Table: "t2", Before: sql.Row{int64(1), int64(10)}, After: sql.Row{int64(1), int64(11)}},
+	}}, last())
+
+	// Statements that don't change any row aren't written
+	require.NoError(query("UPDATE t2 SET b = 20 WHERE a = 2"))
+	require.Len(written, 3)
+
+	require.NoError(query("DELETE FROM t2 WHERE a = 2"))
+	require.Equal(sql.BinlogTransaction{Sequence: 5, Events: []sql.BinlogEvent{
+		{Type: sql.BinlogDelete, Database: "mydb", Table: "t2", Before: sql.Row{int64(2), int64(20)}},
+	}}, last())
+
+	require.NoError(query("REPLACE INTO t2 VALUES (1, 12)"))
+	require.Equal(sql.BinlogTransaction{Sequence: 6, Events: []sql.BinlogEvent{
+		{Type: sql.BinlogUpdate, Database: "mydb", Table: "t2", Before: sql.Row{int64(1), int64(11)}, After: sql.Row{int64(1), int64(12)}},
+	}}, last())
+	require.Len(written, 5)
+
+	// Changes that don't pass the binlog filters and failed statements aren't written
+	e.BinlogFilters.SetFilters(sql.ReplicationFilters{IgnoreTables: []string{"mydb.t"}})
+	require.NoError(query("INSERT INTO t VALUES (1)"))
+	require.Error(query("INSERT INTO t2 VALUES (3, 30), (1, 0)"))
+	require.Len(written, 5)
+}
+
+func TestBinaryLog(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), nil)
+	defer e.Close()
+
+	log, err := binlog.NewLog(binlog.Config{ServerUUID: "3e11fa47-71ca-11e1-9e33-c80aa9429562"})
+	require.NoError(err)
+	e.Binlog.SetBinaryLog(log)
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	query("CREATE TABLE t (a bigint primary key, b varchar(10))")
+	query("INSERT INTO t VALUES (1, 'one'), (2, 'two')")
+	query("DELETE FROM t WHERE a = 2")
+
+	file, pos := log.Position()
+	require.Equal([]sql.Row{{file, pos, "", "", "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3"}}, query("SHOW MASTER STATUS"))
+	require.Equal([]sql.Row{{"binlog.000001", pos, "No"}}, query("SHOW BINARY LOGS"))
+
+	var types []interface{}
+	for _, row := range query("SHOW BINLOG EVENTS IN 'binlog.000001'") {
+		types = append(types, row[2])
+	}
+	require.Equal([]interface{}{
+		"Format_desc", "Previous_gtids",
+		"Gtid", "Query",
+		"Gtid", "Query", "Table_map", "Write_rows", "Xid",
+		"Gtid", "Query", "Table_map", "Delete_rows", "Xid",
+	}, types)
+
+	rows := query("SHOW BINLOG EVENTS FROM 4 LIMIT 2, 2")
+	require.Len(rows, 2)
+	require.Equal("Gtid", rows[0][2])
+	require.Equal("use `mydb`; CREATE TABLE t (a bigint primary key, b varchar(10))", rows[1][5])
+
+	_, _, err = e.Query(ctx, "SHOW BINLOG EVENTS IN 'binlog.000002'")
+	require.True(sql.ErrBinaryLogNotFound.Is(err))
+}
+
+func TestChangeListeners(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), nil)
+	defer e.Close()
+
+	var changes [][]sql.RowChange
+	e.AddChangeListener(sql.ChangeListenerFunc(func(ctx *sql.Context, c []sql.RowChange) {
+		for i := range c {
+			require.Len(c[i].Schema, 2)
+			c[i].Schema = nil
+		}
+		changes = append(changes, c)
+	}))
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	query := func(q string) {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	}
+
+	// Changes of schemas aren't received
+	query("CREATE TABLE t (a bigint primary key, b bigint)")
+	require.Empty(changes)
+
+	query("INSERT INTO t VALUES (1, 10), (2, 20)")
+	query("UPDATE t SET b = 11 WHERE a = 1")
+	query("DELETE FROM t WHERE a = 2")
+	require.Equal([][]sql.RowChange{
+		{
+			{Database: "mydb", Table: "t", Operation: sql.BinlogInsert, New: sql.Row{int64(1), int64(10)}},
+			{Database: "mydb", Table: "t", Operation: sql.BinlogInsert, New: sql.Row{int64(2), int64(20)}},
+		},
+		{{Database: "mydb", Table: "t", Operation: sql.BinlogUpdate, Old: sql.Row{int64(1), int64(10)}, New: sql.Row{int64(1), int64(11)}}},
+		{{Database: "mydb", Table: "t", Operation: sql.BinlogDelete, Old: sql.Row{int64(2), int64(20)}}},
+	}, changes)
+}
+
+func TestBinlogCreateTableSelect(t *testing.T) {
+	require := require.New(t)
+
+	const sourceID = "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+
+	newEngine := func() (*Engine, *sql.Context) {
+		db := memory.NewDatabase("mydb")
+		e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), &Config{CommitSequence: sql.NewCommitSequence()})
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+		return e, ctx
+	}
+	query := func(e *Engine, ctx *sql.Context, q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	source, ctx := newEngine()
+	defer source.Close()
+	var captured []replica.Transaction
+	source.Binlog.AddSink(sql.BinlogSinkFunc(func(ctx *sql.Context, tx sql.BinlogTransaction) error {
+		captured = append(captured, replica.FromBinlog(sourceID, tx))
+		return nil
+	}))
+
+	query(source, ctx, "CREATE TABLE t (a bigint primary key, b varchar(10))")
+	query(source, ctx, "INSERT INTO t VALUES (1, 'one'), (2, 'two')")
+	query(source, ctx, "CREATE TABLE c AS SELECT * FROM t")
+	query(source, ctx, "CREATE TABLE d SELECT b, a * 10 AS a10 FROM t WHERE a > 1")
+	require.Len(captured, 4)
+
+	// The table created is written as a CREATE TABLE statement without the SELECT, before the rows inserted into it
+	ctas := captured[2]
+	require.Len(ctas.Events, 3)
+	require.Equal(replica.Query, ctas.Events[0].Type)
+	require.Equal("mydb", ctas.Events[0].Database)
+	require.Equal("CREATE TABLE `c` (\n  `a` bigint NOT NULL,\n  `b` varchar(10),\n  PRIMARY KEY (`a`)\n)", ctas.Events[0].Query)
+	// The rows are read from the partitions of the memory table, which aren't in any particular order
+	require.ElementsMatch([]replica.RowEvent{
+		{Type: replica.Insert, Database: "mydb", Table: "c", After: sql.Row{int64(1), "one"}},
+		{Type: replica.Insert, Database: "mydb", Table: "c", After: sql.Row{int64(2), "two"}},
+	}, ctas.Events[1:])
+	require.NotContains(captured[3].Events[0].Query, "SELECT")
+
+	replicated, replicaCtx := newEngine()
+	defer replicated.Close()
+	applier := replicated.NewApplier(replica.ApplyStrict, nil)
+	for _, txn := range captured {
+		applied, err := applier.Apply(replicaCtx, txn)
+		require.NoError(err)
+		require.True(applied)
+	}
+
+	require.Equal(query(source, ctx, "SELECT * FROM c ORDER BY a"), query(replicated, replicaCtx, "SELECT * FROM c ORDER BY a"))
+	require.Equal([]sql.Row{{"two", int64(20)}}, query(replicated, replicaCtx, "SELECT * FROM d"))
+	require.Equal(query(source, ctx, "SHOW CREATE TABLE d"), query(replicated, replicaCtx, "SHOW CREATE TABLE d"))
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestDatabaseFunctions(t *testing.T) {
+	require := require.New(t)
+
+	constant := func(name string, v interface{}) sql.Function {
+		return sql.NewFunction0(name, func() sql.Expression {
+			return expression.NewLiteral(v, sql.LongText)
+		})
+	}
+
+	tenant1 := memory.NewDatabase("tenant1")
+	tenant1.RegisterFunction(constant("tenant_name", "one"), constant("upper", "shadowed"))
+	tenant2 := memory.NewDatabase("tenant2")
+	tenant2.RegisterFunction(constant("tenant_name", "two"))
+	other := memory.NewDatabase("other")
+
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(tenant1, tenant2, other)), nil)
+	defer e.Close()
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("tenant1")
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	for _, tt := range []struct {
+		db       string
+		query    string
+		expected interface{}
+	}{
+		// Unqualified functions are the functions of the current database
+		{"tenant1", "SELECT tenant_name()", "one"},
+		{"tenant2", "SELECT tenant_name()", "two"},
+		// Qualified ones are the functions of their database
+		{"tenant1", "SELECT tenant2.tenant_name()", "two"},
+		{"other", "SELECT TENANT1.Tenant_Name()", "one"},
+		// Global functions take precedence over the functions of the current database
+		{"tenant1", "SELECT upper('a')", "A"},
+		{"tenant1", "SELECT tenant1.upper()", "shadowed"},
+	} {
+		ctx.SetCurrentDatabase(tt.db)
+		rows, err := query(tt.query)
+		require.NoError(err, tt.query)
+		require.Equal([]sql.Row{{tt.expected}}, rows, tt.query)
+	}
+
+	// The functions of a database aren't visible from others
+	ctx.SetCurrentDatabase("other")
+	_, err := query("SELECT tenant_name()")
+	require.True(sql.ErrFunctionNotFound.Is(err))
+	_, err = query("SELECT other.tenant_name()")
+	require.True(sql.ErrFunctionNotFound.Is(err))
+	_, err = query("SELECT missing.tenant_name()")
+	require.True(sql.ErrDatabaseNotFound.Is(err))
+}
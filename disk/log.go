@@ -0,0 +1,97 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"hash/crc32"
+	"io"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/replica"
+)
+
+func init() {
+	// Row values are written to the log as interfaces, so gob needs to know about every concrete type other than the
+	// basic ones it already handles.
+	gob.Register(time.Time{})
+	gob.Register(decimal.Decimal{})
+	gob.Register(sql.JSONDocument{})
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+}
+
+// recordHeaderSize is the size of the header of a record: the size of its payload and the CRC-32 of its payload.
+const recordHeaderSize = 8
+
+// record is an entry of the write ahead log or of a snapshot. Records of the log are the committed transactions,
+// numbered by their LSN. The first record of a snapshot has the LSN of the last transaction it includes and the
+// statements that made the schemas of its databases, and the others the rows of its tables.
+type record struct {
+	LSN       uint64
+	Timestamp time.Time
+	// Database and Table are the table of the rows of a snapshot record. The last record of each table has no rows,
+	// and the next AUTO_INCREMENT value of the table, if it has one.
+	Database      string
+	Table         string
+	AutoIncrement interface{}
+	Events        []replica.RowEvent
+}
+
+// encodeRecord returns the record given as it's written to a file: its header followed by its gob encoding. Each
+// record is encoded on its own, so that it can be read without the ones before it.
+func encodeRecord(rec record) ([]byte, error) {
+	var payload bytes.Buffer
+	if err := gob.NewEncoder(&payload).Encode(rec); err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, recordHeaderSize, recordHeaderSize+payload.Len())
+	binary.LittleEndian.PutUint32(b[0:4], uint32(payload.Len()))
+	binary.LittleEndian.PutUint32(b[4:8], crc32.ChecksumIEEE(payload.Bytes()))
+	return append(b, payload.Bytes()...), nil
+}
+
+// readRecord reads the next record of a file. It returns io.EOF at the end of the file, and io.ErrUnexpectedEOF if
+// the file ends with a record that was only partly written or whose checksum doesn't match.
+func readRecord(r io.Reader) (record, error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err == io.EOF {
+		return record{}, io.EOF
+	} else if err != nil {
+		return record{}, io.ErrUnexpectedEOF
+	}
+
+	// The payload is copied rather than read into a buffer of its size, which a torn header could make huge
+	size := int64(binary.LittleEndian.Uint32(header[0:4]))
+	var payload bytes.Buffer
+	if n, err := io.CopyN(&payload, r, size); n < size || (err != nil && err != io.EOF) {
+		return record{}, io.ErrUnexpectedEOF
+	}
+	if crc32.ChecksumIEEE(payload.Bytes()) != binary.LittleEndian.Uint32(header[4:8]) {
+		return record{}, io.ErrUnexpectedEOF
+	}
+
+	var rec record
+	if err := gob.NewDecoder(&payload).Decode(&rec); err != nil {
+		return record{}, err
+	}
+	return rec, nil
+}
@@ -0,0 +1,580 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package disk persists the databases of the memory package to a directory, so that a server storing its tables in
+// memory survives restarts. The tables stay memory.Table, with the same interfaces and the same behavior; what the
+// package adds is durability.
+//
+// Every transaction committed through the engine is appended to a write ahead log and synced before the client is
+// told it committed, as a binlog sink of the engine. Checkpoints write a snapshot of every table and of the statements
+// that made the schemas of the databases, after which the log starts over. Opening the storage loads the snapshot and
+// replays the log, so the databases are as they were when the last transaction committed.
+//
+// The log is written once the transaction has committed in memory, so when it can't be written the client gets an
+// error for a transaction that the other sessions already see, and that's lost if the server restarts before the next
+// checkpoint, which writes the tables as they are.
+//
+//	engine := sqle.NewDefault(memory.NewMemoryDBProvider(memory.NewDatabase("mydb")))
+//	storage, err := disk.Open(sql.NewEmptyContext(), engine, "/var/lib/mydb")
+//	...
+//	defer storage.Close()
+package disk
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/src-d/go-errors.v1"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/replica"
+	"github.com/dolthub/go-mysql-server/sql/schemadiff"
+)
+
+// ErrCorruptSnapshot is returned when opening a storage whose snapshot can't be read.
+var ErrCorruptSnapshot = errors.NewKind("corrupt snapshot %s: %s")
+
+// ErrStorageClosed is returned when a transaction commits after its storage was closed.
+var ErrStorageClosed = errors.NewKind("the storage at %s is closed")
+
+const (
+	snapshotFile = "snapshot"
+	walFile      = "wal"
+	// snapshotBatchSize is the number of rows of each record of the rows of a table in a snapshot.
+	snapshotBatchSize = 1024
+)
+
+// Storage keeps the databases of an engine on disk. It's a binlog sink of the engine, so only the changes that pass
+// the binlog filters of the engine are persisted. The changes of temporary tables aren't persisted either.
+type Storage struct {
+	dir    string
+	engine *sqle.Engine
+
+	mu  sync.Mutex
+	wal *os.File
+	// walSize is the size of the log up to the last transaction written, which a failed write is truncated to
+	walSize int64
+	lsn     uint64
+	// schema has the statements that changed the schemas of the databases, which snapshots start with
+	schema []replica.RowEvent
+	// temporary has the temporary tables of each session, by database and table name
+	temporary map[uint32]map[string]bool
+}
+
+var _ sql.BinlogSink = (*Storage)(nil)
+
+// Open loads the databases stored in the directory given into the engine given, and persists the transactions the
+// engine commits from then on. The directory is created if it doesn't exist. The databases the engine starts with
+// must be the same every time, and tables they already have rows in are replaced with the stored ones. The
+// statements and rows replayed go through the engine, so Open should be called before the engine is given any other
+// binlog sinks or change listeners.
+func Open(ctx *sql.Context, e *sqle.Engine, dir string) (*Storage, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	s := &Storage{dir: dir, engine: e, temporary: make(map[uint32]map[string]bool)}
+	applier := e.NewApplier(replica.ApplyIdempotent, nil)
+	if err := s.loadSnapshot(ctx, applier); err != nil {
+		return nil, err
+	}
+	skipped, err := s.replayLog(ctx, applier)
+	if err != nil {
+		return nil, err
+	}
+
+	// The checkpoint below empties the log, so a log with transactions that couldn't be replayed is kept aside, named
+	// after its last transaction, for them to be recovered by hand
+	if skipped > 0 {
+		kept := filepath.Join(dir, fmt.Sprintf("%s.%d.skipped", walFile, s.lsn))
+		if err := os.Rename(filepath.Join(dir, walFile), kept); err != nil {
+			return nil, err
+		}
+	}
+
+	wal, err := os.OpenFile(filepath.Join(dir, walFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	s.wal = wal
+
+	// The log replayed is folded into a new snapshot, which also drops a transaction torn at its end
+	if err := s.Checkpoint(ctx); err != nil {
+		wal.Close()
+		return nil, err
+	}
+
+	e.Binlog.AddSink(s)
+	return s, nil
+}
+
+// WriteTransaction implements the sql.BinlogSink interface. The transaction is on disk once it returns without an
+// error. It's called once the transaction has committed in memory, so an error doesn't undo it: it stays visible, and
+// is only written by the next checkpoint.
+func (s *Storage) WriteTransaction(ctx *sql.Context, tx sql.BinlogTransaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.wal == nil {
+		return ErrStorageClosed.New(s.dir)
+	}
+
+	events := s.persistentEvents(ctx, replica.FromBinlog("", tx).Events)
+	if len(events) == 0 {
+		return nil
+	}
+
+	b, err := encodeRecord(record{LSN: s.lsn + 1, Timestamp: tx.Timestamp, Events: events})
+	if err != nil {
+		return err
+	}
+	if _, err := s.wal.Write(b); err != nil {
+		s.wal.Truncate(s.walSize)
+		return err
+	}
+	if err := s.wal.Sync(); err != nil {
+		s.wal.Truncate(s.walSize)
+		return err
+	}
+
+	s.walSize += int64(len(b))
+	s.lsn++
+	s.schema = append(s.schema, schemaEvents(events)...)
+	return nil
+}
+
+// Checkpoint writes a snapshot of the databases, and empties the write ahead log, so that opening the storage doesn't
+// replay it. The snapshot is made from the tables as they are, so it should be taken when no transaction is being
+// committed, such as when the server starts or stops.
+func (s *Storage) Checkpoint(ctx *sql.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.wal == nil {
+		return ErrStorageClosed.New(s.dir)
+	}
+
+	path := filepath.Join(s.dir, snapshotFile)
+	f, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if err := s.writeSnapshot(ctx, f); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return err
+	}
+	syncDir(s.dir)
+
+	// The log is only emptied once the snapshot replacing it is in place
+	if err := s.wal.Truncate(0); err != nil {
+		return err
+	}
+	s.walSize = 0
+	return s.wal.Sync()
+}
+
+// Close closes the write ahead log. Transactions committed after the storage is closed fail.
+func (s *Storage) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.wal == nil {
+		return nil
+	}
+	err := s.wal.Close()
+	s.wal = nil
+	return err
+}
+
+// loadSnapshot runs the statements of the snapshot, and replaces the rows of its tables with the ones it has.
+func (s *Storage) loadSnapshot(ctx *sql.Context, applier *replica.Applier) error {
+	path := filepath.Join(s.dir, snapshotFile)
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	header, err := readRecord(r)
+	if err != nil {
+		return ErrCorruptSnapshot.New(path, err)
+	}
+	s.lsn = header.LSN
+	s.schema = header.Events
+	if _, err := applier.Apply(ctx, replica.Transaction{Events: header.Events}); err != nil {
+		return err
+	}
+
+	truncated := make(map[string]bool)
+	for {
+		rec, err := readRecord(r)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return ErrCorruptSnapshot.New(path, err)
+		}
+		if err := s.loadRows(ctx, rec, truncated); err != nil {
+			return err
+		}
+	}
+}
+
+// loadRows loads a record of the rows of a table of a snapshot into the table, which is emptied first the first time
+// one of its records is loaded.
+func (s *Storage) loadRows(ctx *sql.Context, rec record, truncated map[string]bool) error {
+	table, _, err := s.engine.Analyzer.Catalog.Table(ctx, rec.Database, rec.Table)
+	if err != nil {
+		return err
+	}
+
+	key := tableKey(rec.Database, rec.Table)
+	if !truncated[key] {
+		if t, ok := table.(sql.TruncateableTable); ok {
+			if _, err := t.Truncate(ctx); err != nil {
+				return err
+			}
+		}
+		truncated[key] = true
+	}
+
+	if len(rec.Events) > 0 {
+		insertable, ok := table.(sql.InsertableTable)
+		if !ok {
+			return replica.ErrTableNotWritable.New(table.Name())
+		}
+		inserter := insertable.Inserter(ctx)
+		for _, e := range rec.Events {
+			if err := inserter.Insert(ctx, e.After); err != nil {
+				inserter.Close(ctx)
+				return err
+			}
+		}
+		if err := inserter.Close(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Inserting rows moves the AUTO_INCREMENT value of a table, so it's set once all of them are in
+	if t, ok := table.(sql.AutoIncrementTable); ok && rec.AutoIncrement != nil {
+		setter := t.AutoIncrementSetter(ctx)
+		if err := setter.SetAutoIncrementValue(ctx, rec.AutoIncrement); err != nil {
+			setter.Close(ctx)
+			return err
+		}
+		return setter.Close(ctx)
+	}
+	return nil
+}
+
+// replayLog applies the transactions of the write ahead log committed after the snapshot, and returns how many of them
+// couldn't be applied. A transaction torn at the end of the log didn't finish committing, so it's ignored. A
+// transaction that can't be read or applied is skipped with a warning rather than making the whole storage impossible
+// to open, although the changes it made before failing stay applied.
+func (s *Storage) replayLog(ctx *sql.Context, applier *replica.Applier) (int, error) {
+	f, err := os.Open(filepath.Join(s.dir, walFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var skipped int
+	r := bufio.NewReader(f)
+	for {
+		rec, err := readRecord(r)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return skipped, nil
+		} else if err != nil {
+			logrus.WithField("dir", s.dir).WithField("err", err).Warn("skipping a transaction of the write ahead log that can't be read")
+			skipped++
+			continue
+		}
+
+		// Transactions already in the snapshot are there if a checkpoint stopped before emptying the log
+		if rec.LSN <= s.lsn {
+			continue
+		}
+		schema, err := s.applyRecord(ctx, applier, rec)
+		if err != nil {
+			logrus.WithField("dir", s.dir).WithField("lsn", rec.LSN).WithField("err", err).Warn("skipping a transaction of the write ahead log that can't be applied")
+			skipped++
+		}
+		s.lsn = rec.LSN
+		s.schema = append(s.schema, schema...)
+	}
+}
+
+// applyRecord applies a transaction of the write ahead log, and returns its statements that changed schemas, which
+// snapshots start with. Logs written before CREATE TABLE ... SELECT statements were written like MySQL's row-based
+// logging writes them have the statement after the rows inserted into the table it created. The statement is run
+// first then, and the table it creates is emptied before its rows are inserted again. Its CREATE TABLE statement is
+// returned in its place, so that loading a snapshot doesn't run its SELECT.
+func (s *Storage) applyRecord(ctx *sql.Context, applier *replica.Applier, rec record) ([]replica.RowEvent, error) {
+	events := make([]replica.RowEvent, 0, len(rec.Events))
+	var schema []replica.RowEvent
+	for _, e := range rec.Events {
+		if e.Type != replica.Query {
+			events = append(events, e)
+			continue
+		}
+
+		node, err := parse.Parse(ctx, e.Query)
+		ct, ok := node.(*plan.CreateTable)
+		if err != nil || !ok || ct.Select() == nil {
+			events = append(events, e)
+			schema = append(schema, e)
+			continue
+		}
+
+		create, err := s.createTableSelect(ctx, applier, e, ct)
+		if err != nil {
+			return nil, err
+		}
+		schema = append(schema, create...)
+	}
+
+	_, err := applier.Apply(ctx, replica.Transaction{Timestamp: rec.Timestamp, Events: events})
+	return schema, err
+}
+
+// createTableSelect runs the CREATE TABLE ... SELECT statement of the event given, empties the table it creates, and
+// returns the event of its CREATE TABLE statement, or no event if the table already existed.
+func (s *Storage) createTableSelect(ctx *sql.Context, applier *replica.Applier, e replica.RowEvent, ct *plan.CreateTable) ([]replica.RowEvent, error) {
+	dbName := databaseName(ct.Database(), e.Database)
+	db, err := s.engine.Analyzer.Catalog.Database(dbName)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists, err := db.GetTableInsensitive(ctx, ct.Name()); err != nil {
+		return nil, err
+	} else if exists && ct.IfNotExists() == plan.IfNotExists {
+		return nil, nil
+	}
+
+	if _, err := applier.Apply(ctx, replica.Transaction{Events: []replica.RowEvent{e}}); err != nil {
+		return nil, err
+	}
+	table, ok, err := db.GetTableInsensitive(ctx, ct.Name())
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, sql.ErrTableNotFound.New(ct.Name())
+	}
+	if t, ok := table.(sql.TruncateableTable); ok {
+		if _, err := t.Truncate(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	stmt, err := schemadiff.CreateTableStatement(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	return []replica.RowEvent{{Type: replica.Query, Database: e.Database, Query: stmt}}, nil
+}
+
+// writeSnapshot writes a snapshot to the file given and syncs it: a header with the statements that made the schemas
+// of the databases, then the rows of every table of the memory package.
+func (s *Storage) writeSnapshot(ctx *sql.Context, f *os.File) error {
+	w := bufio.NewWriter(f)
+	if err := writeRecord(w, record{LSN: s.lsn, Events: s.schema}); err != nil {
+		return err
+	}
+
+	for _, db := range s.engine.Analyzer.Catalog.AllDatabases() {
+		// The tables are taken from the database rather than from the session, which has its temporary tables
+		tabler, ok := db.(interface{ Tables() map[string]sql.Table })
+		if !ok {
+			continue
+		}
+		tables := tabler.Tables()
+		names := make([]string, 0, len(tables))
+		for name := range tables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			table, ok := tables[name].(*memory.Table)
+			if !ok {
+				continue
+			}
+			if err := writeTable(ctx, w, db.Name(), table); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// writeTable writes the rows of the table given as records of inserts, followed by a record with its AUTO_INCREMENT
+// value.
+func writeTable(ctx *sql.Context, w io.Writer, db string, table sql.Table) error {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	defer iter.Close(ctx)
+
+	var events []replica.RowEvent
+	for {
+		row, err := iter.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		events = append(events, replica.RowEvent{Type: replica.Insert, Database: db, Table: table.Name(), After: row})
+		if len(events) == snapshotBatchSize {
+			if err := writeRecord(w, record{Database: db, Table: table.Name(), Events: events}); err != nil {
+				return err
+			}
+			events = nil
+		}
+	}
+	if len(events) > 0 {
+		if err := writeRecord(w, record{Database: db, Table: table.Name(), Events: events}); err != nil {
+			return err
+		}
+	}
+
+	last := record{Database: db, Table: table.Name()}
+	if t, ok := table.(sql.AutoIncrementTable); ok && table.Schema().HasAutoIncrement() {
+		if last.AutoIncrement, err = t.PeekNextAutoIncrementValue(ctx); err != nil {
+			return err
+		}
+	}
+	return writeRecord(w, last)
+}
+
+func writeRecord(w io.Writer, rec record) error {
+	b, err := encodeRecord(rec)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// persistentEvents returns the events given without the ones of temporary tables, keeping track of the temporary
+// tables of the session of the context given. The statements creating and dropping temporary tables are recognized
+// by parsing them, since their tables are gone by the time they are written.
+func (s *Storage) persistentEvents(ctx *sql.Context, events []replica.RowEvent) []replica.RowEvent {
+	temporary := s.temporary[ctx.Session.ID()]
+
+	var persistent []replica.RowEvent
+	for _, e := range events {
+		if e.Type != replica.Query {
+			if !temporary[tableKey(e.Database, e.Table)] {
+				persistent = append(persistent, e)
+			}
+			continue
+		}
+
+		node, err := parse.Parse(ctx, e.Query)
+		if err != nil {
+			persistent = append(persistent, e)
+			continue
+		}
+
+		switch n := node.(type) {
+		case *plan.CreateTable:
+			if n.Temporary() == plan.IsTempTable {
+				if temporary == nil {
+					temporary = make(map[string]bool)
+					s.temporary[ctx.Session.ID()] = temporary
+				}
+				temporary[tableKey(databaseName(n.Database(), e.Database), n.Name())] = true
+				continue
+			}
+		case *plan.DropTable:
+			db := databaseName(n.Database(), e.Database)
+			dropsTemporary := len(n.TableNames()) > 0
+			for _, name := range n.TableNames() {
+				dropsTemporary = dropsTemporary && temporary[tableKey(db, name)]
+			}
+			if dropsTemporary {
+				for _, name := range n.TableNames() {
+					delete(temporary, tableKey(db, name))
+				}
+				continue
+			}
+		}
+		persistent = append(persistent, e)
+	}
+	return persistent
+}
+
+// schemaEvents returns the events given that change schemas.
+func schemaEvents(events []replica.RowEvent) []replica.RowEvent {
+	var schema []replica.RowEvent
+	for _, e := range events {
+		if e.Type == replica.Query {
+			schema = append(schema, e)
+		}
+	}
+	return schema
+}
+
+// databaseName returns the name of the database of a parsed statement, or the current database given if the
+// statement doesn't name one.
+func databaseName(db sql.Database, current string) string {
+	if db != nil && db.Name() != "" {
+		return db.Name()
+	}
+	return current
+}
+
+func tableKey(db, table string) string {
+	return strings.ToLower(db) + "." + strings.ToLower(table)
+}
+
+// syncDir syncs the directory given, so that the files renamed in it stay renamed. Not every platform can sync
+// directories, so errors are ignored.
+func syncDir(dir string) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	f.Sync()
+	f.Close()
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/replica"
+)
+
+func TestStorage(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+
+	// Every engine starts with the same database, which has a table created outside of SQL
+	open := func() (*sqle.Engine, *Storage, *sql.Context) {
+		db := memory.NewDatabase("mydb")
+		seeded := memory.NewTable("seeded", sql.Schema{{Name: "a", Type: sql.Int64, Source: "seeded", PrimaryKey: true}})
+		db.AddTable("seeded", seeded)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+		require.NoError(seeded.Insert(ctx, sql.NewRow(int64(1))))
+
+		e := sqle.New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), nil)
+		s, err := Open(ctx, e, dir)
+		require.NoError(err)
+		return e, s, ctx
+	}
+	query := func(e *sqle.Engine, ctx *sql.Context, q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err, q)
+		return rows
+	}
+	contents := func(e *sqle.Engine, ctx *sql.Context) [][]sql.Row {
+		return [][]sql.Row{
+			query(e, ctx, "SELECT * FROM t ORDER BY id"),
+			query(e, ctx, "SELECT * FROM seeded ORDER BY a"),
+			query(e, ctx, "SELECT * FROM v ORDER BY id"),
+			query(e, ctx, "SELECT * FROM other.o"),
+			query(e, ctx, "SELECT * FROM c ORDER BY id"),
+			query(e, ctx, "SHOW CREATE TABLE c"),
+			query(e, ctx, "SHOW TABLES"),
+		}
+	}
+
+	e, s, ctx := open()
+	for _, q := range []string{
+		"CREATE TABLE t (id bigint primary key auto_increment, name varchar(20), doc json, at datetime, amount decimal(10,2))",
+		"INSERT INTO t (name, doc, at, amount) VALUES ('a', '{\"k\": [1, 2]}', '2021-06-01 12:00:00', 1.5), ('b', NULL, NULL, NULL), ('c', '[]', NULL, 3)",
+		"UPDATE t SET name = 'bb' WHERE id = 2",
+		"DELETE FROM t WHERE id = 3",
+		"INSERT INTO seeded VALUES (2)",
+		"ALTER TABLE t ADD COLUMN flag int DEFAULT 7",
+		"CREATE TABLE dropped (a int)",
+		"INSERT INTO dropped VALUES (1)",
+		"DROP TABLE dropped",
+		"CREATE VIEW v AS SELECT id, name FROM t",
+		"CREATE DATABASE other",
+		"CREATE TABLE other.o (a int)",
+		"INSERT INTO other.o VALUES (42)",
+		"CREATE TABLE c AS SELECT id, name FROM t WHERE id > 1",
+		"INSERT INTO c VALUES (10, 'j')",
+		"CREATE TEMPORARY TABLE tmp (a int)",
+		"INSERT INTO tmp VALUES (1)",
+		"DROP TABLE tmp",
+	} {
+		query(e, ctx, q)
+	}
+	expected := contents(e, ctx)
+	require.Len(expected[0], 2)
+	require.Equal([]sql.Row{{int64(2), "bb"}, {int64(10), "j"}}, expected[4])
+	require.NoError(s.Close())
+	e.Close()
+
+	// The first reopening replays the log, and the second loads the snapshot it took
+	for i := 0; i < 2; i++ {
+		e, s, ctx = open()
+		require.Equal(expected, contents(e, ctx))
+		require.NoError(s.Close())
+		e.Close()
+	}
+
+	// Auto increment values continue from the stored rows, and a transaction torn at the end of the log is dropped
+	e, s, ctx = open()
+	query(e, ctx, "INSERT INTO t (name) VALUES ('d')")
+	require.Equal([]sql.Row{{int64(4)}}, query(e, ctx, "SELECT max(id) FROM t"))
+	require.NoError(s.Close())
+	e.Close()
+
+	wal, err := os.OpenFile(filepath.Join(dir, walFile), os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(err)
+	_, err = wal.Write([]byte{0xff, 0, 0, 0, 1, 2})
+	require.NoError(err)
+	require.NoError(wal.Close())
+
+	e, s, ctx = open()
+	defer e.Close()
+	defer s.Close()
+	require.Equal([]sql.Row{{int64(1), "a"}, {int64(2), "bb"}, {int64(4), "d"}}, query(e, ctx, "SELECT id, name FROM t ORDER BY id"))
+
+	require.NoError(s.Close())
+	_, iter, err := e.Query(ctx, "INSERT INTO t (name) VALUES ('e')")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.True(ErrStorageClosed.Is(err), "%v", err)
+}
+
+func TestStorageSkipsBadTransactions(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+
+	open := func() (*sqle.Engine, *Storage, *sql.Context) {
+		e := sqle.New(analyzer.NewDefault(memory.NewMemoryDBProvider(memory.NewDatabase("mydb"))), nil)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+		s, err := Open(ctx, e, dir)
+		require.NoError(err)
+		return e, s, ctx
+	}
+	query := func(e *sqle.Engine, ctx *sql.Context, q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err, q)
+		return rows
+	}
+
+	e, s, ctx := open()
+	query(e, ctx, "CREATE TABLE t (a bigint primary key, b varchar(10))")
+	query(e, ctx, "INSERT INTO t VALUES (1, 'one'), (2, 'two')")
+	lsn := s.lsn
+	require.NoError(s.Close())
+	e.Close()
+
+	// Logs written before CREATE TABLE ... SELECT statements were logged like MySQL's row-based logging does have the
+	// statement after the rows inserted into the table it created
+	wal, err := os.OpenFile(filepath.Join(dir, walFile), os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(err)
+	for _, rec := range []record{
+		{LSN: lsn + 1, Events: []replica.RowEvent{
+			{Type: replica.Insert, Database: "mydb", Table: "c", After: sql.Row{int64(2), "two"}},
+			{Type: replica.Query, Database: "mydb", Query: "CREATE TABLE c AS SELECT * FROM t WHERE a > 1"},
+		}},
+		{LSN: lsn + 2, Events: []replica.RowEvent{
+			{Type: replica.Insert, Database: "mydb", Table: "missing", After: sql.Row{int64(1)}},
+		}},
+		{LSN: lsn + 3, Events: []replica.RowEvent{
+			{Type: replica.Insert, Database: "mydb", Table: "t", After: sql.Row{int64(3), "three"}},
+		}},
+	} {
+		b, err := encodeRecord(rec)
+		require.NoError(err)
+		_, err = wal.Write(b)
+		require.NoError(err)
+	}
+	require.NoError(wal.Close())
+
+	// The transaction that can't be applied is skipped, and the log is kept aside
+	for i := 0; i < 2; i++ {
+		e, s, ctx = open()
+		require.Equal([]sql.Row{{int64(1), "one"}, {int64(2), "two"}, {int64(3), "three"}}, query(e, ctx, "SELECT * FROM t ORDER BY a"))
+		require.Equal([]sql.Row{{int64(2), "two"}}, query(e, ctx, "SELECT * FROM c ORDER BY a"))
+		require.NoError(s.Close())
+		e.Close()
+	}
+
+	_, err = os.Stat(filepath.Join(dir, fmt.Sprintf("%s.%d.skipped", walFile, lsn+3)))
+	require.NoError(err)
+}
@@ -44,11 +44,12 @@ func (f *memTable) Resolve(name string, _ *driver.Options) (string, sql.Database
 }
 
 func personMemTable(database, table string) (*memTable, Records) {
+	// TIMESTAMP values are stored in UTC, and read back as wall clock times in UTC
 	records := Records{
-		[]V{"John Doe", "john@doe.com", []V{"555-555-555"}, time.Now()},
-		[]V{"John Doe", "johnalt@doe.com", []V{}, time.Now()},
-		[]V{"Jane Doe", "jane@doe.com", []V{}, time.Now()},
-		[]V{"Evil Bob", "evilbob@gmail.com", []V{"555-666-555", "666-666-666"}, time.Now()},
+		[]V{"John Doe", "john@doe.com", []V{"555-555-555"}, time.Now().UTC()},
+		[]V{"John Doe", "johnalt@doe.com", []V{}, time.Now().UTC()},
+		[]V{"Jane Doe", "jane@doe.com", []V{}, time.Now().UTC()},
+		[]V{"Evil Bob", "evilbob@gmail.com", []V{"555-666-555", "666-666-666"}, time.Now().UTC()},
 	}
 
 	mtb := &memTable{
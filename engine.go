@@ -16,7 +16,9 @@ package sqle
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/memory"
 
@@ -26,6 +28,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/schemadiff"
 )
 
 // Config for the Engine.
@@ -34,15 +37,48 @@ type Config struct {
 	VersionPostfix string
 	// Auth used for authentication and authorization.
 	Auth auth.Auth
+	// Parallelism is the number of goroutines the partitions of tables are scanned with. If zero, the parallelism of
+	// the analyzer is kept. Sessions can override it with the query_parallelism system variable.
+	Parallelism int
+	// MemoryLimit is the number of bytes the operators of all running queries can hold together, such as the rows
+	// buffered by joins, sorts and aggregations. Queries that would go over it fail with an out of memory error. Zero
+	// means no limit. Sessions can limit the memory of each of their queries with the query_memory_limit system
+	// variable.
+	MemoryLimit uint64
+	// PlanCacheSize is the number of statements whose plans are kept to be reused when they're run again. If zero,
+	// plans aren't cached.
+	PlanCacheSize int
+	// CommitSequence numbers the writes committed through the engine for change data capture consumers. If nil,
+	// commits aren't numbered.
+	CommitSequence *sql.CommitSequence
+	// ReplicationConfigStore persists the replication configuration set by CHANGE REPLICATION SOURCE, CHANGE
+	// REPLICATION FILTER, START REPLICA and STOP REPLICA. If nil, the configuration is kept in memory.
+	ReplicationConfigStore sql.ReplicationConfigStore
 }
 
 // Engine is a SQL engine.
 type Engine struct {
-	Analyzer      *analyzer.Analyzer
-	Auth          auth.Auth
-	LS            *sql.LockSubsystem
-	ProcessList   sql.ProcessList
-	MemoryManager *sql.MemoryManager
+	Analyzer       *analyzer.Analyzer
+	Auth           auth.Auth
+	LS             *sql.LockSubsystem
+	ProcessList    sql.ProcessList
+	MemoryManager  *sql.MemoryManager
+	PlanCache      *PlanCache
+	BackgroundJobs *sql.BackgroundJobs
+	Statistics     *sql.StatisticsTracker
+	CommitSequence *sql.CommitSequence
+	Replication    sql.ReplicationConfigStore
+	BinlogFilters  *sql.ReplicationFilterSet
+	// Binlog sends the changes committed through the engine to the sinks added to it.
+	Binlog *sql.Binlog
+	// LoadDataReporter receives the reports of the LOAD DATA statements run through the engine, if not nil.
+	LoadDataReporter sql.LoadDataReporter
+	// LoadDataSources open the files of the LOAD DATA statements run through the engine that aren't on the local disk,
+	// such as URLs, from the sources added to them.
+	LoadDataSources *sql.LoadDataSources
+	// FunctionLimits bound the work done by single calls of expensive functions in the queries run through the engine,
+	// such as regular expressions matched against huge strings.
+	FunctionLimits *sql.FunctionLimits
 }
 
 type ColumnWithRawDefault struct {
@@ -56,6 +92,9 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 	var versionPostfix string
 	if cfg != nil {
 		versionPostfix = cfg.VersionPostfix
+		if cfg.Parallelism > 0 {
+			a.Parallelism = cfg.Parallelism
+		}
 	}
 
 	ls := sql.NewLockSubsystem()
@@ -75,15 +114,51 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 		au = cfg.Auth
 	}
 
+	memory := sql.NewMemoryManager(sql.ProcessMemory)
+	var planCache *PlanCache
+	var commitSequence *sql.CommitSequence
+	var replication sql.ReplicationConfigStore = sql.NewMemoryReplicationConfigStore()
+	if cfg != nil {
+		memory.SetLimit(cfg.MemoryLimit)
+		if cfg.PlanCacheSize > 0 {
+			planCache = NewPlanCache(cfg.PlanCacheSize)
+		}
+		commitSequence = cfg.CommitSequence
+		if cfg.ReplicationConfigStore != nil {
+			replication = cfg.ReplicationConfigStore
+		}
+	}
+
 	return &Engine{
-		Analyzer:      a,
-		MemoryManager: sql.NewMemoryManager(sql.ProcessMemory),
-		ProcessList:   NewProcessList(),
-		Auth:          au,
-		LS:            ls,
+		Analyzer:        a,
+		MemoryManager:   memory,
+		PlanCache:       planCache,
+		BackgroundJobs:  sql.NewBackgroundJobs(),
+		Statistics:      sql.NewStatisticsTracker(),
+		CommitSequence:  commitSequence,
+		Replication:     replication,
+		BinlogFilters:   sql.NewReplicationFilterSet(sql.ReplicationFilters{}),
+		Binlog:          sql.NewBinlog(),
+		LoadDataSources: sql.NewLoadDataSources(),
+		FunctionLimits:  sql.DefaultFunctionLimits(),
+		ProcessList:     NewProcessList(),
+		Auth:            au,
+		LS:              ls,
 	}
 }
 
+// Close cancels the background jobs of the engine and waits for them to finish. The engine can still run queries
+// afterwards, but not start background jobs.
+func (e *Engine) Close() error {
+	e.BackgroundJobs.Close()
+	return nil
+}
+
+// AddChangeListener adds a listener notified of the changes of rows committed through the engine from now on.
+func (e *Engine) AddChangeListener(l sql.ChangeListener) {
+	e.Binlog.AddSink(sql.NewChangeListenerSink(l))
+}
+
 // NewDefault creates a new default Engine.
 func NewDefault(pro sql.DatabaseProvider) *Engine {
 	a := analyzer.NewDefault(pro)
@@ -136,12 +211,48 @@ func (e *Engine) QueryNodeWithBindings(
 		err      error
 	)
 
-	if parsed == nil {
+	if ctx.EngineStatus == nil {
+		ctx.EngineStatus = e
+	}
+	if ctx.BackgroundJobs == nil {
+		ctx.BackgroundJobs = e.BackgroundJobs
+	}
+	if ctx.Statistics == nil {
+		ctx.Statistics = e.Statistics
+	}
+	if ctx.Replication == nil {
+		ctx.Replication = e.Replication
+	}
+	if ctx.BinlogFilters == nil {
+		ctx.BinlogFilters = e.BinlogFilters
+	}
+	if ctx.Binlog == nil {
+		ctx.Binlog = e.Binlog
+	}
+	if ctx.LoadData == nil {
+		ctx.LoadData = e.LoadDataReporter
+	}
+	if ctx.LoadDataSources == nil {
+		ctx.LoadDataSources = e.LoadDataSources
+	}
+	if ctx.FunctionLimits == nil {
+		ctx.FunctionLimits = e.FunctionLimits
+	}
+
+	var cached *cachedPlan
+	if parsed == nil && e.PlanCache != nil {
+		cached, _ = e.PlanCache.get(ctx, query)
+	}
+
+	if cached != nil {
+		parsed = cached.parsed
+	} else if parsed == nil {
 		parsed, err = parse.Parse(ctx, query)
 		if err != nil {
 			return nil, nil, err
 		}
 	}
+	unbound := parsed
 
 	err = e.authCheck(ctx, parsed)
 	if err != nil {
@@ -160,16 +271,33 @@ func (e *Engine) QueryNodeWithBindings(
 		}
 	}
 
-	analyzed, err = e.Analyzer.Analyze(ctx, parsed, nil)
+	analyzed, err = e.analyze(ctx, query, unbound, parsed, cached, len(bindings) > 0)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	ctx, cancel, err := withExecutionTimeout(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	setQueryMemoryLimit(ctx)
+
 	iter, err = analyzed.RowIter(ctx, nil)
+	if e.PlanCache != nil {
+		e.PlanCache.invalidateFor(parsed)
+	}
 	if err != nil {
+		if cancel != nil {
+			cancel()
+		}
 		return nil, nil, err
 	}
 
+	if cancel != nil {
+		iter = &deadlineIter{childIter: iter, ctx: ctx, cancel: cancel}
+	}
+
 	autoCommit, err := isSessionAutocommit(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -179,9 +307,72 @@ func (e *Engine) QueryNodeWithBindings(
 		iter = transactionCommittingIter{iter, transactionDatabase}
 	}
 
+	if e.CommitSequence != nil {
+		iter = &commitSequenceIter{childIter: iter, sequence: e.CommitSequence, parsed: parsed}
+	}
+
+	if ctx.Binlog != nil && ctx.Binlog.HasSinks() {
+		iter = &binlogIter{
+			childIter: iter,
+			binlog:    ctx.Binlog,
+			query:     query,
+			parsed:    parsed,
+			analyzed:  analyzed,
+			start:     ctx.Binlog.Len(ctx),
+			sequenced: e.CommitSequence != nil,
+		}
+	}
+
 	return analyzed.Schema(), iter, nil
 }
 
+// analyze analyzes the parsed statement given, reusing the plans in the plan cache of the engine and adding them to it
+// if it has one. The statement before applying any bindings is given as unbound, and bound tells whether there were
+// any.
+func (e *Engine) analyze(
+	ctx *sql.Context,
+	query string,
+	unbound, parsed sql.Node,
+	cached *cachedPlan,
+	bound bool,
+) (sql.Node, error) {
+	if e.PlanCache == nil || !selectStatementRegex.MatchString(query) {
+		return e.Analyzer.Analyze(ctx, parsed, nil)
+	}
+
+	if cached != nil && cached.analyzed != nil && !bound {
+		return e.Analyzer.FinishCachedAnalysis(ctx, cached.analyzed, nil)
+	}
+
+	analyzed, err := e.Analyzer.AnalyzeForCache(ctx, parsed, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &cachedPlan{parsed: unbound}
+	if !bound && cacheablePlan(analyzed) {
+		entry.analyzed = analyzed
+	}
+	if cached == nil || entry.analyzed != nil {
+		e.PlanCache.add(ctx, query, entry)
+	}
+
+	return e.Analyzer.FinishCachedAnalysis(ctx, analyzed, nil)
+}
+
+// QueryMemoryLimitSessionVar is the session variable with the number of bytes the operators of each query can hold.
+const QueryMemoryLimitSessionVar = "query_memory_limit"
+
+// setQueryMemoryLimit limits the memory account of the context given to the value of the query_memory_limit session
+// variable.
+func setQueryMemoryLimit(ctx *sql.Context) {
+	var limit uint64
+	if val, err := ctx.GetSessionVariable(ctx, QueryMemoryLimitSessionVar); err == nil {
+		limit, _ = val.(uint64)
+	}
+	ctx.MemoryAccount().SetLimit(limit)
+}
+
 const (
 	fakeReadCommittedEnvVar = "READ_COMMITTED_HACK"
 )
@@ -205,7 +396,7 @@ func (e *Engine) beginTransaction(ctx *sql.Context, parsed sql.Node) (string, er
 	if beginNewTransaction {
 		ctx.GetLogger().Tracef("beginning new transaction")
 		if len(transactionDatabase) > 0 {
-			database, err := e.Analyzer.Catalog.Database(transactionDatabase)
+			database, err := sql.GetDatabase(ctx, e.Analyzer.Catalog, transactionDatabase)
 			// if the database doesn't exist, just don't start a transaction on it, let other layers complain
 			if sql.ErrDatabaseNotFound.Is(err) {
 				return "", nil
@@ -280,6 +471,160 @@ func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
+// commitSequenceIter is a RowIter wrapper that records the writes and the ends of transactions of a session in the
+// commit sequence of the engine once the statement finishes successfully. It must wrap the transactionCommittingIter
+// of the statement, if any, so that a write still in a transaction when the statement closes is one that wasn't
+// committed yet.
+type commitSequenceIter struct {
+	childIter sql.RowIter
+	sequence  *sql.CommitSequence
+	parsed    sql.Node
+	failed    bool
+}
+
+func (i *commitSequenceIter) Next() (sql.Row, error) {
+	row, err := i.childIter.Next()
+	if err != nil && err != io.EOF {
+		i.failed = true
+	}
+	return row, err
+}
+
+func (i *commitSequenceIter) Close(ctx *sql.Context) error {
+	if err := i.childIter.Close(ctx); err != nil || i.failed {
+		return err
+	}
+
+	switch i.parsed.(type) {
+	case *plan.Commit, *plan.StartTransaction:
+		// Starting a transaction commits the current one
+		i.sequence.EndTransaction(ctx, true)
+	case *plan.Rollback:
+		i.sequence.EndTransaction(ctx, false)
+	default:
+		if plan.IsWriteNode(i.parsed) {
+			i.sequence.Wrote(ctx, ctx.GetTransaction() == nil)
+		}
+	}
+	return nil
+}
+
+// binlogIter is a RowIter wrapper that ends the binlog transaction of a session when the statement that commits or
+// rolls it back finishes, sending its events to the sinks of the binlog if it was committed. Statements that change
+// schemas are written to the binlog as they are, except CREATE TABLE ... SELECT statements, which are written like
+// MySQL's row-based logging does: as the CREATE TABLE statement of the table created, before the rows inserted into
+// it, so that replaying the binlog creates the table before inserting them and doesn't run the SELECT again. It must
+// wrap the commitSequenceIter of the statement, if any, so that a committed transaction is sent with its sequence
+// number.
+type binlogIter struct {
+	childIter sql.RowIter
+	binlog    *sql.Binlog
+	query     string
+	parsed    sql.Node
+	analyzed  sql.Node
+	// start is the number of events of the transaction before the statement wrote its own
+	start     int
+	sequenced bool
+	failed    bool
+}
+
+func (i *binlogIter) Next() (sql.Row, error) {
+	row, err := i.childIter.Next()
+	if err != nil && err != io.EOF {
+		i.failed = true
+	}
+	return row, err
+}
+
+func (i *binlogIter) Close(ctx *sql.Context) error {
+	if err := i.childIter.Close(ctx); err != nil || i.failed {
+		if ctx.GetTransaction() == nil {
+			_ = i.binlog.EndTransaction(ctx, false, 0)
+		}
+		return err
+	}
+
+	var seq uint64
+	if i.sequenced {
+		seq = uint64(ctx.GetLastQueryInfo(sql.LastCommitSequence))
+	}
+
+	switch i.parsed.(type) {
+	case *plan.Commit, *plan.StartTransaction:
+		// Starting a transaction commits the current one
+		return i.binlog.EndTransaction(ctx, true, seq)
+	case *plan.Rollback:
+		return i.binlog.EndTransaction(ctx, false, seq)
+	}
+
+	if ct, ok := i.parsed.(*plan.CreateTable); ok && ct.Select() != nil {
+		event, err := createTableSelectEvent(ctx, i.analyzed, ct)
+		if err != nil {
+			_ = i.binlog.EndTransaction(ctx, false, seq)
+			return err
+		}
+		i.binlog.Insert(ctx, i.start, event)
+		return i.binlog.EndTransaction(ctx, true, seq)
+	}
+
+	if plan.IsDDLNode(i.parsed) {
+		i.binlog.Write(ctx, sql.BinlogEvent{
+			Type:     sql.BinlogQuery,
+			Database: ctx.GetCurrentDatabase(),
+			Query:    i.query,
+		})
+		// Statements that change schemas commit the current transaction
+		return i.binlog.EndTransaction(ctx, true, seq)
+	}
+
+	if ctx.GetTransaction() == nil {
+		return i.binlog.EndTransaction(ctx, true, seq)
+	}
+	return nil
+}
+
+// createTableSelectEvent returns the binlog event of the CREATE TABLE ... SELECT statement given, whose analyzed
+// plan is given too: the CREATE TABLE statement of the table it created, without the SELECT.
+func createTableSelectEvent(ctx *sql.Context, analyzed sql.Node, ct *plan.CreateTable) (sql.BinlogEvent, error) {
+	var copier *plan.TableCopier
+	plan.Inspect(analyzed, func(n sql.Node) bool {
+		if tc, ok := n.(*plan.TableCopier); ok {
+			copier = tc
+		}
+		return copier == nil
+	})
+	if copier == nil {
+		return sql.BinlogEvent{}, fmt.Errorf("no table copier in the plan of %s", ct.String())
+	}
+
+	table, ok, err := copier.CreatedTable(ctx)
+	if err != nil {
+		return sql.BinlogEvent{}, err
+	}
+	if !ok {
+		return sql.BinlogEvent{}, sql.ErrTableNotFound.New(ct.Name())
+	}
+
+	stmt, err := schemadiff.CreateTableStatement(ctx, table)
+	if err != nil {
+		return sql.BinlogEvent{}, err
+	}
+
+	create := "CREATE TABLE "
+	if ct.Temporary() == plan.IsTempTable {
+		create = "CREATE TEMPORARY TABLE "
+	}
+	if ct.IfNotExists() == plan.IfNotExists {
+		create += "IF NOT EXISTS "
+	}
+
+	return sql.BinlogEvent{
+		Type:     sql.BinlogQuery,
+		Database: copier.Database().Name(),
+		Query:    create + strings.TrimPrefix(stmt, "CREATE TABLE "),
+	}, nil
+}
+
 func isSessionAutocommit(ctx *sql.Context) (bool, error) {
 	if readCommitted(ctx) {
 		return true, nil
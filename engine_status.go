@@ -0,0 +1,62 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var _ sql.EngineStatusProvider = (*Engine)(nil)
+
+// EngineStatus implements the sql.EngineStatusProvider interface. It reports:
+//   - memory_limit: the number of bytes the operators of all running queries can hold together, or zero if unlimited.
+//   - memory_used: the number of bytes currently held by the operators of all running queries.
+//   - memory_caches: the number of caches of the memory manager, such as the ones of subqueries and aggregations.
+//   - spill_files: the number of temporary files the operators of running queries have spilled rows to.
+//   - cached_plans: the number of statements in the plan cache.
+//   - running_queries: the number of queries in the process list.
+//   - background_jobs: the number of background jobs that are running or scheduled to run.
+func (e *Engine) EngineStatus() []sql.EngineStatusVariable {
+	var cachedPlans int
+	if e.PlanCache != nil {
+		cachedPlans = e.PlanCache.Len()
+	}
+
+	var activeJobs uint64
+	for _, job := range e.BackgroundJobs.Jobs() {
+		if job.State == sql.BackgroundJobRunning || job.State == sql.BackgroundJobScheduled {
+			activeJobs++
+		}
+	}
+
+	return []sql.EngineStatusVariable{
+		{Name: "memory_limit", Value: e.MemoryManager.Limit()},
+		{Name: "memory_used", Value: e.MemoryManager.Used()},
+		{Name: "memory_caches", Value: uint64(e.MemoryManager.NumCaches())},
+		{Name: "spill_files", Value: uint64(plan.OpenSpillFiles())},
+		{Name: "cached_plans", Value: uint64(cachedPlans)},
+		{Name: "running_queries", Value: uint64(len(e.ProcessList.Processes()))},
+		{Name: "background_jobs", Value: activeJobs},
+	}
+}
+
+// CachedPlans implements the sql.EngineStatusProvider interface.
+func (e *Engine) CachedPlans() []sql.CachedPlan {
+	if e.PlanCache == nil {
+		return nil
+	}
+	return e.PlanCache.cachedPlans()
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/information_schema"
+)
+
+func TestEngineStatusTables(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t", memory.NewTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	}))
+	provider := memory.NewMemoryDBProvider(db, information_schema.NewInformationSchemaDatabase())
+
+	e := New(analyzer.NewDefault(provider), &Config{PlanCacheSize: 10, MemoryLimit: 1024})
+	ctx := sql.NewEmptyContext().WithCurrentDB("mydb")
+
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	require.NoError(e.WarmUp(ctx, "SELECT a FROM t"))
+	query("SELECT * FROM t")
+
+	// The statement reading the status is cached before it's run
+	require.Equal([]sql.Row{
+		{"memory_limit", uint64(1024)},
+		{"cached_plans", uint64(3)},
+	}, query("SELECT variable_name, variable_value FROM information_schema.engine_status "+
+		"WHERE variable_name IN ('memory_limit', 'cached_plans')"))
+
+	require.Equal([]sql.Row{
+		{uint64(ctx.ID()), "mydb", "SELECT * FROM t", "YES"},
+		{nil, "mydb", "SELECT a FROM t", "YES"},
+	}, query("SELECT * FROM information_schema.cached_plans WHERE query LIKE '%FROM t'"))
+}
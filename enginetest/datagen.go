@@ -0,0 +1,200 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrUnsupportedGeneratedType is returned by GenerateRows for the columns whose type it can't generate values for.
+var ErrUnsupportedGeneratedType = errors.NewKind("cannot generate values for column %s of type %s")
+
+// generatedWords are the words the generated strings are made of.
+var generatedWords = []string{"first", "second", "third", "row", "test", "text", "value", "data", "foo", "bar"}
+
+// generatedTimeBase is the earliest time generated for time columns.
+var generatedTimeBase = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// GenerateRows returns n rows for a table with the schema given, generated from the seed given. The same schema, seed
+// and number of rows always give the same rows, so tests can compute the rows they expect from them instead of
+// listing them. The rows of tables with different names are different for the same seed.
+//
+// Primary keys are unique and increase with every row, so rows are returned in the order of their primary keys when
+// they're integers. Strings also end with the index of their row, so they stay unique for unique indexes. Nullable
+// columns that aren't part of the primary key are NULL in about one row out of ten.
+func GenerateRows(schema sql.Schema, seed int64, n int) ([]sql.Row, error) {
+	var pks []int
+	for i, col := range schema {
+		if col.PrimaryKey {
+			pks = append(pks, i)
+		}
+	}
+
+	// The keys of tables with several primary key columns are the digits of the index of their row, in a base big
+	// enough for all the rows.
+	radix := n
+	if len(pks) > 1 {
+		radix = 1
+		for pow(radix, len(pks)) < n {
+			radix++
+		}
+	}
+
+	h := fnv.New64a()
+	if len(schema) > 0 {
+		_, _ = h.Write([]byte(schema[0].Source))
+	}
+	rnd := rand.New(rand.NewSource(seed ^ int64(h.Sum64())))
+
+	rows := make([]sql.Row, n)
+	for i := range rows {
+		row := make(sql.Row, len(schema))
+		for j, col := range schema {
+			var v interface{}
+			var err error
+			if k := indexOf(pks, j); k >= 0 {
+				v, err = generateKey(col, i/pow(radix, len(pks)-k-1)%radix)
+			} else if col.Nullable && rnd.Intn(10) == 0 {
+				v = nil
+			} else {
+				v, err = generateValue(col, rnd, i)
+			}
+			if err != nil {
+				return nil, err
+			}
+			row[j] = v
+		}
+		rows[i] = row
+	}
+
+	return rows, nil
+}
+
+// CreateGeneratedTestData creates the same test tables as CreateSubsetTestData, but filled with rowsPerTable rows
+// generated by GenerateRows from the seed given instead of their usual rows. Passing a non-nil slice for
+// includedTables will restrict the table creation to just those tables named.
+func CreateGeneratedTestData(t *testing.T, harness Harness, includedTables []string, seed int64, rowsPerTable int) []sql.Database {
+	dbs := CreateSubsetTestData(t, harness, includedTables)
+
+	for _, db := range dbs {
+		ctx := NewContext(harness)
+		names, err := db.GetTableNames(ctx)
+		require.NoError(t, err)
+
+		for _, name := range names {
+			wrapInTransaction(t, db, harness, func() {
+				ctx := NewContext(harness)
+				table, ok, err := db.GetTableInsensitive(ctx, name)
+				require.NoError(t, err)
+				require.True(t, ok)
+
+				truncateable, ok := table.(sql.TruncateableTable)
+				require.True(t, ok, "Table must implement sql.TruncateableTable")
+				_, err = truncateable.Truncate(ctx)
+				require.NoError(t, err)
+
+				rows, err := GenerateRows(table.Schema(), seed, rowsPerTable)
+				require.NoError(t, err)
+				InsertRows(t, ctx, mustInsertableTable(t, table), rows...)
+			})
+		}
+	}
+
+	return dbs
+}
+
+// generateKey returns the value of the primary key column given for the index given, which is unique in the column.
+func generateKey(col *sql.Column, i int) (interface{}, error) {
+	switch {
+	case sql.IsInteger(col.Type), sql.IsFloat(col.Type), sql.IsDecimal(col.Type):
+		return convertGenerated(col, int64(i))
+	case sql.IsTime(col.Type):
+		return convertGenerated(col, generatedTimeBase.AddDate(0, 0, i))
+	case sql.IsText(col.Type):
+		return convertGenerated(col, strconv.Itoa(i))
+	default:
+		return nil, ErrUnsupportedGeneratedType.New(col.Name, col.Type.String())
+	}
+}
+
+// generateValue returns a random value of the column given for the row with the index given.
+func generateValue(col *sql.Column, rnd *rand.Rand, i int) (interface{}, error) {
+	t := col.Type
+	switch {
+	case sql.IsSigned(t):
+		return convertGenerated(col, int64(rnd.Intn(201)-100))
+	case sql.IsUnsigned(t):
+		return convertGenerated(col, uint64(rnd.Intn(201)))
+	case sql.IsFloat(t):
+		return convertGenerated(col, float64(rnd.Intn(200001)-100000)/100)
+	case sql.IsDecimal(t):
+		return convertGenerated(col, fmt.Sprintf("%.2f", float64(rnd.Intn(20001)-10000)/100))
+	case sql.IsTime(t):
+		return convertGenerated(col, generatedTimeBase.Add(time.Duration(rnd.Int63n(365*24*3600))*time.Second))
+	case sql.IsJSON(t):
+		return convertGenerated(col, map[string]interface{}{"a": float64(rnd.Intn(100)), "b": generatedWords[rnd.Intn(len(generatedWords))]})
+	case sql.IsText(t):
+		s := generatedWords[rnd.Intn(len(generatedWords))] + " " + strconv.Itoa(i)
+		if st, ok := t.(sql.StringType); ok && int64(len(s)) > st.MaxCharacterLength() {
+			s = s[int64(len(s))-st.MaxCharacterLength():]
+		}
+		return convertGenerated(col, s)
+	}
+
+	switch t := t.(type) {
+	case sql.EnumType:
+		return convertGenerated(col, t.Values()[rnd.Intn(len(t.Values()))])
+	case sql.SetType:
+		return convertGenerated(col, t.Values()[rnd.Intn(len(t.Values()))])
+	default:
+		return nil, ErrUnsupportedGeneratedType.New(col.Name, col.Type.String())
+	}
+}
+
+// convertGenerated converts a generated value to the type of the column given.
+func convertGenerated(col *sql.Column, v interface{}) (interface{}, error) {
+	converted, err := col.Type.Convert(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate value %v for column %s: %s", v, col.Name, err)
+	}
+	return converted, nil
+}
+
+func indexOf(values []int, v int) int {
+	for i, value := range values {
+		if value == v {
+			return i
+		}
+	}
+	return -1
+}
+
+func pow(base, exp int) int {
+	result := 1
+	for ; exp > 0; exp-- {
+		result *= base
+	}
+	return result
+}
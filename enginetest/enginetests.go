@@ -1324,6 +1324,14 @@ func TestCreateTable(t *testing.T, harness Harness) {
 		require.Equal(t, s, indexableTable.Schema())
 	})
 
+	t.Run("CREATE LIKE with check constraints", func(t *testing.T) {
+		TestQuery(t, harness, e, "CREATE TABLE t11pre (pk bigint primary key, v1 bigint, "+
+			"CONSTRAINT chk_v1 CHECK (v1 > 0))", []sql.Row(nil), nil, nil)
+		TestQuery(t, harness, e, "CREATE TABLE t11 LIKE t11pre", []sql.Row(nil), nil, nil)
+		AssertErr(t, e, harness, "INSERT INTO t11 VALUES (1, 0)", sql.ErrCheckConstraintViolated)
+		TestQuery(t, harness, e, "INSERT INTO t11 VALUES (1, 1)", []sql.Row{{sql.NewOkResult(1)}}, nil, nil)
+	})
+
 	t.Run("UNIQUE constraint in column definition", func(t *testing.T) {
 		TestQuery(t, harness, e, "CREATE TABLE t9 (a INTEGER NOT NULL PRIMARY KEY, "+
 			"b VARCHAR(10) UNIQUE)", []sql.Row(nil), nil, nil)
@@ -1502,6 +1510,21 @@ func TestRenameTable(t *testing.T, harness Harness) {
 	_, _, err = e.Query(NewContext(harness), "ALTER TABLE emptytable RENAME niltable")
 	require.Error(err)
 	require.True(sql.ErrTableAlreadyExists.Is(err))
+
+	// Statements renaming several tables are applied either fully or not at all
+	_, _, err = e.Query(NewContext(harness), "RENAME TABLE newTableName TO t1, emptytable TO othertable2")
+	require.Error(err)
+	require.True(sql.ErrTableAlreadyExists.Is(err))
+
+	_, ok, err = db.GetTableInsensitive(NewContext(harness), "newTableName")
+	require.NoError(err)
+	require.True(ok)
+
+	_, ok, err = db.GetTableInsensitive(NewContext(harness), "t1")
+	require.NoError(err)
+	require.False(ok)
+
+	TestQuery(t, harness, e, "SELECT newTableName.i FROM newTableName ORDER BY 1 LIMIT 1", []sql.Row{{int64(1)}}, nil, nil)
 }
 
 func TestRenameColumn(t *testing.T, harness Harness) {
@@ -1528,6 +1551,10 @@ func TestRenameColumn(t *testing.T, harness Harness) {
 	_, _, err = e.Query(NewContext(harness), "ALTER TABLE mytable RENAME COLUMN foo TO bar")
 	require.Error(err)
 	require.True(sql.ErrTableColumnNotFound.Is(err))
+
+	_, _, err = e.Query(NewContext(harness), "ALTER TABLE mytable RENAME COLUMN i2 TO S")
+	require.Error(err)
+	require.True(sql.ErrDuplicateColumn.Is(err))
 }
 
 func TestAddColumn(t *testing.T, harness Harness) {
@@ -1618,6 +1645,10 @@ func TestAddColumn(t *testing.T, harness Harness) {
 	_, _, err = e.Query(NewContext(harness), "ALTER TABLE mytable ADD COLUMN b INT NOT NULL DEFAULT 'yes'")
 	require.Error(err)
 	require.True(sql.ErrIncompatibleDefaultType.Is(err))
+
+	_, _, err = e.Query(NewContext(harness), "ALTER TABLE mytable ADD COLUMN s2 INT")
+	require.Error(err)
+	require.True(sql.ErrDuplicateColumn.Is(err))
 }
 
 func TestModifyColumn(t *testing.T, harness Harness) {
@@ -1678,6 +1709,10 @@ func TestModifyColumn(t *testing.T, harness Harness) {
 	_, _, err = e.Query(NewContext(harness), "ALTER TABLE not_exist MODIFY COLUMN i INT NOT NULL COMMENT 'hello'")
 	require.Error(err)
 	require.True(sql.ErrTableNotFound.Is(err))
+
+	_, _, err = e.Query(NewContext(harness), "ALTER TABLE mytable CHANGE COLUMN i s BIGINT NOT NULL")
+	require.Error(err)
+	require.True(sql.ErrDuplicateColumn.Is(err))
 }
 
 func TestDropColumn(t *testing.T, harness Harness) {
@@ -1704,6 +1739,10 @@ func TestDropColumn(t *testing.T, harness Harness) {
 	_, _, err = e.Query(NewContext(harness), "ALTER TABLE mytable DROP COLUMN s")
 	require.Error(err)
 	require.True(sql.ErrTableColumnNotFound.Is(err))
+
+	_, _, err = e.Query(NewContext(harness), "ALTER TABLE mytable DROP COLUMN i")
+	require.Error(err)
+	require.True(sql.ErrCantDropAllColumns.Is(err))
 }
 
 func TestCreateDatabase(t *testing.T, harness Harness) {
@@ -1844,6 +1883,47 @@ func TestDropDatabase(t *testing.T, harness Harness) {
 	})
 }
 
+func TestSessionDatabases(t *testing.T, harness Harness) {
+	e := NewEngine(t, harness)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(NewBaseSession())).WithCurrentDB("mydb")
+	other := sql.NewContext(context.Background(), sql.WithSession(NewBaseSession())).WithCurrentDB("mydb")
+
+	showDatabases := func(ctx *sql.Context) []sql.Row {
+		_, iter, err := e.Query(ctx, "SHOW DATABASES")
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		return rows
+	}
+
+	TestQueryWithContext(t, ctx, e, "CREATE TEMPORARY DATABASE scratch", []sql.Row{{sql.OkResult{RowsAffected: 1}}}, nil, nil)
+	AssertErrWithCtx(t, e, ctx, "CREATE TEMPORARY DATABASE scratch", sql.ErrDatabaseExists)
+	AssertErrWithCtx(t, e, ctx, "CREATE DATABASE scratch", sql.ErrDatabaseExists)
+
+	_, err := e.Analyzer.Catalog.Database("scratch")
+	require.True(t, sql.ErrDatabaseNotFound.Is(err))
+	require.Contains(t, showDatabases(ctx), sql.Row{"scratch"})
+	require.NotContains(t, showDatabases(other), sql.Row{"scratch"})
+
+	TestQueryWithContext(t, ctx, e, "CREATE TABLE scratch.t (pk int primary key, v int)", []sql.Row(nil), nil, nil)
+	TestQueryWithContext(t, ctx, e, "INSERT INTO scratch.t VALUES (1, 10), (2, 20)", []sql.Row{{sql.NewOkResult(2)}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, "USE scratch", []sql.Row(nil), nil, nil)
+	TestQueryWithContext(t, ctx, e, "SELECT t.v, mytable.s FROM t JOIN mydb.mytable ON t.pk = mytable.i ORDER BY 1",
+		[]sql.Row{{10, "first row"}, {20, "second row"}}, nil, nil)
+
+	AssertErrWithCtx(t, e, other, "USE scratch", sql.ErrDatabaseNotFound)
+	AssertErrWithCtx(t, e, other, "SELECT * FROM scratch.t", sql.ErrDatabaseNotFound)
+
+	TestQueryWithContext(t, ctx, e, "DROP DATABASE scratch", []sql.Row{{sql.OkResult{RowsAffected: 1}}}, nil, nil)
+	require.Equal(t, "", ctx.GetCurrentDatabase())
+	AssertErrWithCtx(t, e, ctx, "USE scratch", sql.ErrDatabaseNotFound)
+
+	TestQueryWithContext(t, ctx, e, "CREATE TEMPORARY DATABASE IF NOT EXISTS `Scratch2`", []sql.Row{{sql.OkResult{RowsAffected: 1}}}, nil, nil)
+	require.Contains(t, showDatabases(ctx), sql.Row{"Scratch2"})
+	ctx.Session.(sql.SessionDatabaseSession).DropAllSessionDatabases()
+	require.NotContains(t, showDatabases(ctx), sql.Row{"Scratch2"})
+}
+
 func TestCreateForeignKeys(t *testing.T, harness Harness) {
 	require := require.New(t)
 
@@ -68,12 +68,12 @@ var LoadDataScripts = []ScriptTest{
 		SetUpScript: []string{
 			"create table loadtable(pk longtext primary key, c1 int)",
 			"SET secure_file_priv='./testdata'",
-			"LOAD DATA INFILE 'test3.csv' INTO TABLE loadtable FIELDS TERMINATED BY ',' LINES STARTING BY 'xxx' IGNORE 1 LINES (`pk`, `c1`)",
+			"LOAD DATA INFILE 'test3.csv' IGNORE INTO TABLE loadtable FIELDS TERMINATED BY ',' LINES STARTING BY 'xxx' IGNORE 1 LINES (`pk`, `c1`)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:    "select * from loadtable",
-				Expected: []sql.Row{{"\"abc\"", int8(1)}, {"\"def\"", int8(2)}, {"\"hello\"", nil}},
+				Expected: []sql.Row{{"\"abc\"", int8(1)}, {"\"def\"", int8(2)}},
 			},
 		},
 	},
@@ -82,7 +82,7 @@ var LoadDataScripts = []ScriptTest{
 		SetUpScript: []string{
 			"create table loadtable(pk int primary key, c1 int)",
 			"SET secure_file_priv='./testdata'",
-			"LOAD DATA INFILE 'test1.txt' INTO TABLE loadtable FIELDS ENCLOSED BY '\"'",
+			"LOAD DATA INFILE 'test1.txt' INTO TABLE loadtable FIELDS ENCLOSED BY '\"' (pk)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
@@ -91,6 +91,73 @@ var LoadDataScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "LOAD DATA IGNORE skips invalid lines with a warning",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key, c1 varchar(10))",
+			"SET secure_file_priv='./testdata'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "LOAD DATA INFILE 'test6.csv' IGNORE INTO TABLE loadtable FIELDS TERMINATED BY ','",
+				Expected:        []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.LoadDataInfo{Records: 6, Skipped: 4, Warnings: 4}}}},
+				ExpectedWarning: 1261,
+			},
+			{
+				Query:    "select * from loadtable ORDER BY pk",
+				Expected: []sql.Row{{1, "one"}, {5, "five"}},
+			},
+		},
+	},
+	{
+		Name: "LOAD DATA REPLACE replaces the rows with the same key",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key, c1 varchar(10))",
+			"insert into loadtable values (1, 'old'), (3, 'three')",
+			"SET secure_file_priv='./testdata'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "LOAD DATA INFILE 'test2.csv' REPLACE INTO TABLE loadtable FIELDS TERMINATED BY ',' IGNORE 1 LINES",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 3, Info: plan.LoadDataInfo{Records: 2, Deleted: 1}}}},
+			},
+			{
+				Query:    "select * from loadtable ORDER BY pk",
+				Expected: []sql.Row{{1, "hi"}, {2, "hello"}, {3, "three"}},
+			},
+		},
+	},
+	{
+		Name: "LOAD DATA IGNORE skips the rows with the same key",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key, c1 varchar(10))",
+			"insert into loadtable values (1, 'old')",
+			"SET secure_file_priv='./testdata'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "LOAD DATA INFILE 'test2.csv' IGNORE INTO TABLE loadtable FIELDS TERMINATED BY ',' IGNORE 1 LINES",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.LoadDataInfo{Records: 2, Skipped: 1, Warnings: 1}}}},
+			},
+			{
+				Query:    "select * from loadtable ORDER BY pk",
+				Expected: []sql.Row{{1, "old"}, {2, "hello"}},
+			},
+		},
+	},
+	{
+		Name: "LOAD DATA reports the records it loaded",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key, c1 longtext)",
+			"SET secure_file_priv='./testdata'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "LOAD DATA INFILE 'test2.csv' INTO TABLE loadtable FIELDS TERMINATED BY ',' IGNORE 1 LINES",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 2, Info: plan.LoadDataInfo{Records: 2}}}},
+			},
+		},
+	},
 }
 
 var LoadDataErrorScripts = []ScriptTest{
@@ -147,6 +214,37 @@ var LoadDataErrorScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Load data stops at the first invalid line",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key, c1 varchar(10))",
+			"SET secure_file_priv='./testdata'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "LOAD DATA INFILE 'test6.csv' INTO TABLE loadtable FIELDS TERMINATED BY ','",
+				ExpectedErr: sql.ErrLoadDataMissingColumns,
+			},
+			{
+				Query:       "LOAD DATA INFILE 'test6.csv' INTO TABLE loadtable FIELDS TERMINATED BY ',' IGNORE 2 LINES",
+				ExpectedErr: sql.ErrLoadDataIncorrectValue,
+			},
+		},
+	},
+	{
+		Name: "Load data without IGNORE or REPLACE fails on a duplicate key",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key, c1 varchar(10))",
+			"insert into loadtable values (2, 'two')",
+			"SET secure_file_priv='./testdata'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "LOAD DATA INFILE 'test2.csv' INTO TABLE loadtable FIELDS TERMINATED BY ',' IGNORE 1 LINES",
+				ExpectedErr: sql.ErrPrimaryKeyViolation,
+			},
+		},
+	},
 	{
 		Name: "Load data with file not in secure_file_priv directory fails",
 		SetUpScript: []string{
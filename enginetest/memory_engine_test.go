@@ -343,6 +343,34 @@ func TestLoadData(t *testing.T) {
 	enginetest.TestLoadData(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestGeneratedTestData(t *testing.T) {
+	const seed, rows = 42, 100
+
+	harness := enginetest.NewDefaultMemoryHarness()
+	dbs := enginetest.CreateGeneratedTestData(t, harness, []string{"mytable", "one_pk", "two_pk", "niltable", "typestable"}, seed, rows)
+	engine := enginetest.NewEngineWithDbs(t, harness, dbs)
+
+	ctx := enginetest.NewContext(harness)
+	for _, name := range []string{"mytable", "two_pk", "niltable"} {
+		table, ok, err := dbs[0].GetTableInsensitive(ctx, name)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		expected, err := enginetest.GenerateRows(table.Schema(), seed, rows)
+		require.NoError(t, err)
+		again, err := enginetest.GenerateRows(table.Schema(), seed, rows)
+		require.NoError(t, err)
+		require.Equal(t, expected, again)
+
+		enginetest.TestQuery(t, harness, engine, "SELECT * FROM "+name+" ORDER BY 1, 2", expected, nil, nil)
+	}
+
+	enginetest.TestQuery(t, harness, engine, "SELECT COUNT(*) FROM typestable", []sql.Row{{int64(rows)}}, nil, nil)
+
+	_, err := enginetest.GenerateRows(sql.Schema{{Name: "pk", Type: sql.Int8, Source: "t", PrimaryKey: true}}, seed, 1000)
+	require.Error(t, err)
+}
+
 func TestLoadDataErrors(t *testing.T) {
 	enginetest.TestLoadDataErrors(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -437,6 +465,10 @@ func TestDropDatabase(t *testing.T) {
 	enginetest.TestDropDatabase(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestSessionDatabases(t *testing.T) {
+	enginetest.TestSessionDatabases(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestCreateForeignKeys(t *testing.T) {
 	enginetest.TestCreateForeignKeys(t, enginetest.NewDefaultMemoryHarness())
 }
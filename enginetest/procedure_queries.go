@@ -747,6 +747,128 @@ END;`,
 BEGIN
 	DECLARE abcdefg CONDITION FOR SQLSTATE '45000';
 	SIGNAL abcdef;
+END;`,
+		ExpectedErr: sql.ErrDeclareConditionNotFound,
+	},
+	{
+		Name: "DECLARE HANDLER",
+		SetUpScript: []string{
+			`CREATE PROCEDURE p1(x INT)
+BEGIN
+	DECLARE specialty CONDITION FOR SQLSTATE '45000';
+	DECLARE CONTINUE HANDLER FOR specialty SET @handled = CONCAT(@handled, 'specialty,');
+	DECLARE CONTINUE HANDLER FOR 1002, SQLSTATE '02000'
+	BEGIN
+		SET @handled = CONCAT(@handled, 'not found,');
+	END;
+	SET @handled = '';
+	IF x = 0 THEN
+		SIGNAL specialty;
+	ELSEIF x = 1 THEN
+		SIGNAL SQLSTATE '45000' SET MYSQL_ERRNO = 1002;
+	ELSEIF x = 2 THEN
+		SIGNAL SQLSTATE '02000';
+	ELSE
+		SIGNAL SQLSTATE '45001';
+	END IF;
+	SET @handled = CONCAT(@handled, 'done');
+END;`,
+			`CREATE PROCEDURE p2()
+BEGIN
+	DECLARE EXIT HANDLER FOR SQLEXCEPTION SET @handled = CONCAT(@handled, 'exit');
+	SET @handled = '';
+	SIGNAL SQLSTATE '45000';
+	SET @handled = CONCAT(@handled, 'done');
+END;`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CALL p1(0)",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @handled",
+				Expected: []sql.Row{{"specialty,done"}},
+			},
+			{
+				Query:    "CALL p1(1)",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @handled",
+				Expected: []sql.Row{{"not found,done"}},
+			},
+			{
+				Query:    "CALL p1(2)",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @handled",
+				Expected: []sql.Row{{"not found,done"}},
+			},
+			{
+				Query:          "CALL p1(3)",
+				ExpectedErrStr: "Unhandled user-defined exception condition (errno 1644) (sqlstate 45001)",
+			},
+			{
+				Query:    "CALL p2()",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @handled",
+				Expected: []sql.Row{{"exit"}},
+			},
+		},
+	},
+	{
+		Name: "RESIGNAL",
+		SetUpScript: []string{
+			`CREATE PROCEDURE p1(x INT)
+BEGIN
+	DECLARE EXIT HANDLER FOR SQLSTATE '45000'
+	BEGIN
+		IF x = 0 THEN
+			RESIGNAL;
+		ELSE
+			RESIGNAL SET MESSAGE_TEXT = 'Handled error', MYSQL_ERRNO = 1001;
+		END IF;
+	END;
+	SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'Original error';
+END;`,
+			`CREATE PROCEDURE p2()
+BEGIN
+	RESIGNAL;
+END;`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:          "CALL p1(0)",
+				ExpectedErrStr: "Original error (errno 1644) (sqlstate 45000)",
+			},
+			{
+				Query:          "CALL p1(1)",
+				ExpectedErrStr: "Handled error (errno 1001) (sqlstate 45000)",
+			},
+			{
+				Query:          "CALL p2()",
+				ExpectedErrStr: "RESIGNAL when handler not active (errno 1645) (sqlstate 0K000)",
+			},
+		},
+	},
+	{
+		Name: "DECLARE HANDLER before DECLARE CONDITION",
+		Query: `CREATE PROCEDURE p1()
+BEGIN
+	DECLARE CONTINUE HANDLER FOR SQLSTATE '45000' SET @handled = 1;
+	DECLARE cond_name CONDITION FOR SQLSTATE '45000';
+END;`,
+		ExpectedErr: sql.ErrDeclareOrderInvalid,
+	},
+	{
+		Name: "DECLARE HANDLER for non-existent condition name",
+		Query: `CREATE PROCEDURE p1()
+BEGIN
+	DECLARE CONTINUE HANDLER FOR abcdef SET @handled = 1;
 END;`,
 		ExpectedErr: sql.ErrDeclareConditionNotFound,
 	},
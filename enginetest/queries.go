@@ -176,6 +176,19 @@ var QueryTests = []QueryTest{
 			},
 		},
 	},
+	{
+		Query:    `SELECT 'abc', "it's", 1 + 1, null, CONCAT( 'a',  UPPER('b') ), 7 AS MyAlias, COUNT(*) FROM dual`,
+		Expected: []sql.Row{{"abc", "it's", int64(2), nil, "aB", int8(7), int64(1)}},
+		ExpectedColumns: sql.Schema{
+			{Name: "abc", Type: sql.LongText},
+			{Name: "it's", Type: sql.LongText},
+			{Name: "1 + 1", Type: sql.Int64},
+			{Name: "NULL", Type: sql.Null},
+			{Name: "CONCAT( 'a',  UPPER('b') )", Type: sql.LongText},
+			{Name: "MyAlias", Type: sql.Int8},
+			{Name: "COUNT(*)", Type: sql.Int64},
+		},
+	},
 	{
 		Query: "SELECT pk1, SUM(c1) FROM two_pk GROUP BY pk1 ORDER BY pk1;",
 		Expected: []sql.Row{
@@ -2495,6 +2508,18 @@ var QueryTests = []QueryTest{
 			{int64(1), "third row"},
 		},
 	},
+	{
+		Query: "SELECT CAST(3e0 AS CHAR), CAST(0.1e0 + 0.2e0 AS CHAR), CAST(1e300 AS CHAR), CAST(-1.5e-10 AS CHAR)",
+		Expected: []sql.Row{
+			{"3", "0.30000000000000004", "1e300", "-0.00000000015"},
+		},
+	},
+	{
+		Query: "SELECT CAST(1e14 AS CHAR), CAST(1e15 AS CHAR), CAST(1234567890123456.7e0 AS CHAR), CAST(1e-15 AS CHAR), CAST(1e-16 AS CHAR)",
+		Expected: []sql.Row{
+			{"100000000000000", "1e15", "1234567890123456.8", "0.000000000000001", "1e-16"},
+		},
+	},
 	{
 		Query: "SELECT CAST(-3 AS UNSIGNED) FROM mytable",
 		Expected: []sql.Row{
@@ -2860,10 +2885,11 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query: "select from_unixtime(i) from mytable order by 1",
+		// The wall clock times in the time zone of the session, which is the one of the server
 		Expected: []sql.Row{
-			{time.Unix(1, 0)},
-			{time.Unix(2, 0)},
-			{time.Unix(3, 0)},
+			{sql.TimestampToTimeZone(time.Unix(1, 0), time.Local)},
+			{sql.TimestampToTimeZone(time.Unix(2, 0), time.Local)},
+			{sql.TimestampToTimeZone(time.Unix(3, 0), time.Local)},
 		},
 	},
 	// TODO: add additional tests for other functions. Every function needs an engine test to ensure it works correctly
@@ -3090,7 +3116,7 @@ var QueryTests = []QueryTest{
 			{"offline_mode", int64(0)},
 			{"pseudo_slave_mode", int64(0)},
 			{"rbr_exec_mode", "STRICT"},
-			{"sql_mode", "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION"},
+			{"sql_mode", "ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION"},
 			{"ssl_fips_mode", "OFF"},
 		},
 	},
@@ -3600,6 +3626,18 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT n, COUNT(n) as cnt FROM bigtable GROUP BY n HAVING cnt > 2",
 		Expected: []sql.Row{{int64(1), int64(3)}, {int64(2), int64(3)}},
 	},
+	{
+		Query:    "SELECT n, COUNT(n) as cnt, cnt + 1 AS succ FROM bigtable GROUP BY n HAVING succ > 3",
+		Expected: []sql.Row{{int64(1), int64(3), int64(4)}, {int64(2), int64(3), int64(4)}},
+	},
+	{
+		Query:    "SELECT i AS x, x + 1 AS y, y + 10 AS z FROM mytable ORDER BY i",
+		Expected: []sql.Row{{int64(1), int64(2), int64(12)}, {int64(2), int64(3), int64(13)}, {int64(3), int64(4), int64(14)}},
+	},
+	{
+		Query:    "SELECT SUBSTRING(s, 1, 5) AS sub_s, SUBSTRING(SUB_S, 2, 3) AS sub_sub_s FROM mytable ORDER BY i",
+		Expected: []sql.Row{{"first", "irs"}, {"secon", "eco"}, {"third", "hir"}},
+	},
 	{
 		Query:    "SELECT n, MAX(n) FROM bigtable GROUP BY n HAVING COUNT(n) > 2",
 		Expected: []sql.Row{{int64(1), int64(1)}, {int64(2), int64(2)}},
@@ -3809,6 +3847,42 @@ var QueryTests = []QueryTest{
 		Query:    "select lpad(s, 13, ' ') from mytable order by i",
 		Expected: []sql.Row{{"    first row"}, {"   second row"}, {"    third row"}},
 	},
+	{
+		Query:    "select regexp_replace(s, '[a-z]+', 'x', 1, 2) from mytable order by i",
+		Expected: []sql.Row{{"first x"}, {"second x"}, {"third x"}},
+	},
+	{
+		Query:    "select regexp_substr(s, '[a-z]+') from mytable order by i",
+		Expected: []sql.Row{{"first"}, {"second"}, {"third"}},
+	},
+	{
+		Query:    "select elt(i, 'one', 'two'), field(s, 'third row', 'first row') from mytable order by i",
+		Expected: []sql.Row{{"one", int64(2)}, {"two", int64(0)}, {nil, int64(1)}},
+	},
+	{
+		Query:    "select quote(s), quote(null) from mytable order by i",
+		Expected: []sql.Row{{"'first row'", "NULL"}, {"'second row'", "NULL"}, {"'third row'", "NULL"}},
+	},
+	{
+		Query:    "select hex(aes_encrypt('text', 'key'))",
+		Expected: []sql.Row{{"15E36637363712FC2E699B9C95B75393"}},
+	},
+	{
+		Query:    "select aes_decrypt(aes_encrypt(s, 'key'), 'key') from mytable order by i",
+		Expected: []sql.Row{{[]byte("first row")}, {[]byte("second row")}, {[]byte("third row")}},
+	},
+	{
+		Query:    "select uncompress(compress(s)), uncompressed_length(compress(s)) from mytable order by i",
+		Expected: []sql.Row{{[]byte("first row"), uint32(9)}, {[]byte("second row"), uint32(10)}, {[]byte("third row"), uint32(9)}},
+	},
+	{
+		Query:    "select bin_to_uuid(uuid_to_bin('6ccd780c-baba-1026-9564-5b8c656024db', 1), 1), hex(uuid_to_bin('6ccd780c-baba-1026-9564-5b8c656024db', 1))",
+		Expected: []sql.Row{{"6ccd780c-baba-1026-9564-5b8c656024db", "1026BABA6CCD780C95645B8C656024DB"}},
+	},
+	{
+		Query:    "select is_uuid('{6ccd780c-baba-1026-9564-5b8c656024db}'), is_uuid('6ccd780c-baba'), is_uuid(null)",
+		Expected: []sql.Row{{int8(1), int8(0), nil}},
+	},
 	{
 		Query:    "select sqrt(i) from mytable order by i",
 		Expected: []sql.Row{{1.0}, {1.4142135623730951}, {1.7320508075688772}},
@@ -6553,7 +6627,7 @@ var errorQueries = []QueryErrorTest{
 		ExpectedErr: expression.ErrInvalidRegexp,
 	},
 	{
-		Query:       `SELECT SUBSTRING(s, 1, 10) AS sub_s, SUBSTRING(SUB_S, 2, 3) AS sub_sub_s FROM mytable`,
+		Query:       `SELECT SUBSTRING(SUB_S, 2, 3) AS sub_sub_s, SUBSTRING(s, 1, 10) AS sub_s FROM mytable`,
 		ExpectedErr: sql.ErrMisusedAlias,
 	},
 	{
@@ -15,9 +15,13 @@
 package enginetest
 
 import (
+	"math"
+	"time"
+
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -1086,6 +1090,7 @@ var ScriptTests = []ScriptTest{
 			`CREATE TABLE t3 AS SELECT pk FROM t1`,
 			`CREATE TABLE t4 AS SELECT pk, v1 FROM t1`,
 			`CREATE TABLE t5 SELECT * FROM t1 ORDER BY pk LIMIT 1`,
+			`CREATE TABLE t6 SELECT * FROM t1 WHERE pk > 1`,
 		},
 		Assertions: []ScriptTestAssertion{
 			{
@@ -1104,6 +1109,18 @@ var ScriptTests = []ScriptTest{
 				Query:    `SELECT * FROM t5`,
 				Expected: []sql.Row{{1, "1"}},
 			},
+			{
+				Query:    `SELECT * FROM t6`,
+				Expected: []sql.Row{{2, "2"}, {3, "3"}},
+			},
+			{
+				Query:    `CREATE TABLE IF NOT EXISTS t2 SELECT * FROM t1`,
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    `SELECT count(*) FROM t2`,
+				Expected: []sql.Row{{3}},
+			},
 			{
 				Query: `CREATE TABLE test SELECT * FROM t1`,
 				Expected: []sql.Row{sql.Row{sql.OkResult{
@@ -1310,6 +1327,1248 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Filters on a prefix of a composite index",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, a int, b int, c int, INDEX abc (a, b, c))",
+			"INSERT INTO t VALUES (1, 1, 1, 1), (2, 1, 2, 1), (3, 1, 3, 2), (4, 2, 1, 1), (5, 2, 2, 2), (6, 3, 3, 3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM t WHERE a = 1 AND b > 1 ORDER BY pk",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE a > 1 AND b = 2 ORDER BY pk",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE a >= 1 AND a < 3 AND b <= 2 ORDER BY pk",
+				Expected: []sql.Row{{1}, {2}, {4}, {5}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE a = 1 AND c = 2 ORDER BY pk",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE a = 2 AND b = 2 AND c = 2",
+				Expected: []sql.Row{{5}},
+			},
+		},
+	},
+	{
+		Name: "Filters on different indexes of the same table",
+		SetUpScript: []string{
+			"CREATE TABLE t2 (pk int PRIMARY KEY, a int, b int, INDEX a_idx (a), INDEX b_idx (b))",
+			"INSERT INTO t2 VALUES (1, 1, 1), (2, 1, 2), (3, 2, 2), (4, 3, 3), (5, NULL, 2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM t2 WHERE a = 1 OR b = 2 ORDER BY pk",
+				Expected: []sql.Row{{1}, {2}, {3}, {5}},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE a = 1 AND b = 2 ORDER BY pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE a > 1 OR b < 2 ORDER BY pk",
+				Expected: []sql.Row{{1}, {3}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE (a = 1 OR b = 3) AND b > 1 ORDER BY pk",
+				Expected: []sql.Row{{2}, {4}},
+			},
+		},
+	},
+	{
+		Name: "Row hashes",
+		SetUpScript: []string{
+			"CREATE TABLE a (x tinyint, y varchar(10))",
+			"CREATE TABLE b (x bigint, y text, z double)",
+			"INSERT INTO a VALUES (1, 'a'), (2, NULL)",
+			"INSERT INTO b VALUES (1, 'a', 1.0), (3, 'c', 2.5)",
+			"CREATE TABLE wide (c0 int, c1 int, c2 int, c3 int, c4 int, c5 int, c6 int, c7 int, c8 int, c9 int, c10 int, c11 int, c12 int, c13 int, c14 int, c15 int)",
+			"INSERT INTO wide VALUES (1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, NULL), (2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, NULL), (1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, NULL), (2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT row_hash(*) = row_hash(x, y), row_fingerprint(a.*) = row_fingerprint(x, y) FROM a",
+				Expected: []sql.Row{{true, true}, {true, true}},
+			},
+			{
+				Query:    "SELECT a.x FROM a JOIN b ON row_hash(a.*) = row_hash(b.x, b.y)",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT b.x FROM a JOIN b ON row_fingerprint(a.x) = row_fingerprint(b.z)",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT row_hash(1, 'a') = row_hash(1.0, 'a'), row_hash(CAST(1 AS DECIMAL(10,2))) = row_hash(1e0), row_hash(NULL) = row_hash(0)",
+				Expected: []sql.Row{{true, true, false}},
+			},
+			{
+				Query:    "SELECT DISTINCT * FROM wide ORDER BY c0",
+				Expected: []sql.Row{{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, nil}, {2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, nil}},
+			},
+		},
+	},
+	{
+		Name: "PIVOT and UNPIVOT with and without aliases",
+		SetUpScript: []string{
+			"CREATE TABLE sales (region varchar(10), quarter varchar(2), amount int)",
+			"INSERT INTO sales VALUES ('east', 'Q1', 10), ('east', 'Q2', 20), ('west', 'Q1', 5), ('east', 'Q1', 1)",
+			"CREATE TABLE quarterly (region varchar(10), Q1 int, Q2 int)",
+			"INSERT INTO quarterly VALUES ('east', 1, 2), ('west', 3, NULL)",
+			"CREATE TABLE regions (name varchar(10) primary key, manager varchar(10))",
+			"INSERT INTO regions VALUES ('east', 'ann'), ('west', 'bob')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM sales PIVOT (SUM(amount) FOR quarter IN ('Q1', 'Q2')) ORDER BY region",
+				Expected: []sql.Row{{"east", 11.0, 20.0}, {"west", 5.0, nil}},
+			},
+			{
+				Query:    "SELECT * FROM sales PIVOT (SUM(amount) FOR quarter IN ('Q1', 'Q2')) AS p ORDER BY p.region",
+				Expected: []sql.Row{{"east", 11.0, 20.0}, {"west", 5.0, nil}},
+			},
+			{
+				Query:    "SELECT p.region, p.Q2 FROM sales PIVOT (SUM(amount) FOR quarter IN ('Q1', 'Q2')) p WHERE p.Q1 > 6",
+				Expected: []sql.Row{{"east", 20.0}},
+			},
+			{
+				Query:    "SELECT r.manager, p.Q1 FROM sales PIVOT (SUM(amount) FOR quarter IN ('Q1', 'Q2')) AS p JOIN regions r ON p.region = r.name ORDER BY r.manager",
+				Expected: []sql.Row{{"ann", 11.0}, {"bob", 5.0}},
+			},
+			{
+				Query:    "SELECT manager, Q2 FROM sales PIVOT (SUM(amount) FOR quarter IN ('Q1', 'Q2')) JOIN regions ON region = name ORDER BY manager",
+				Expected: []sql.Row{{"ann", 20.0}, {"bob", nil}},
+			},
+			{
+				Query:    "SELECT * FROM quarterly UNPIVOT (amount FOR quarter IN (Q1, Q2)) ORDER BY region, quarter",
+				Expected: []sql.Row{{"east", "Q1", 1}, {"east", "Q2", 2}, {"west", "Q1", 3}},
+			},
+			{
+				Query:    "SELECT u.quarter, u.amount FROM quarterly UNPIVOT (amount FOR quarter IN (Q1, Q2)) AS u WHERE u.region = 'east' ORDER BY u.quarter",
+				Expected: []sql.Row{{"Q1", 1}, {"Q2", 2}},
+			},
+			{
+				Query:    "SELECT r.manager, u.quarter, u.amount FROM quarterly UNPIVOT (amount FOR quarter IN (Q1, Q2)) u JOIN regions r ON u.region = r.name ORDER BY 1, 2",
+				Expected: []sql.Row{{"ann", "Q1", 1}, {"ann", "Q2", 2}, {"bob", "Q1", 3}},
+			},
+			{
+				Query:    "SELECT manager, quarter FROM quarterly UNPIVOT (amount FOR quarter IN (Q1, Q2)) JOIN regions ON region = name WHERE amount > 1 ORDER BY 1, 2",
+				Expected: []sql.Row{{"ann", "Q2"}, {"bob", "Q1"}},
+			},
+		},
+	},
+	{
+		Name: "TIME_BUCKET in projections and GROUP BY",
+		SetUpScript: []string{
+			"CREATE TABLE ts (pk int primary key, t datetime, v int, index (t))",
+			"INSERT INTO ts VALUES (1, '2021-06-01 10:00:00', 1), (2, '2021-06-01 10:05:00', 2), (3, '2021-06-01 10:14:59', 3), (4, '2021-06-01 10:15:00', 4), (5, '2021-06-01 10:40:00', 5), (6, '2021-07-02 08:00:00', 6), (7, NULL, 7)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT pk, TIME_BUCKET(INTERVAL 15 MINUTE, t) FROM ts WHERE pk < 6 ORDER BY pk",
+				Expected: []sql.Row{
+					{1, time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)},
+					{2, time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)},
+					{3, time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)},
+					{4, time.Date(2021, 6, 1, 10, 15, 0, 0, time.UTC)},
+					{5, time.Date(2021, 6, 1, 10, 30, 0, 0, time.UTC)},
+				},
+			},
+			{
+				Query: "SELECT TIME_BUCKET(INTERVAL 15 MINUTE, t) AS b, COUNT(*), SUM(v) FROM ts GROUP BY b ORDER BY b",
+				Expected: []sql.Row{
+					{nil, 1, 7.0},
+					{time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC), 3, 6.0},
+					{time.Date(2021, 6, 1, 10, 15, 0, 0, time.UTC), 1, 4.0},
+					{time.Date(2021, 6, 1, 10, 30, 0, 0, time.UTC), 1, 5.0},
+					{time.Date(2021, 7, 2, 8, 0, 0, 0, time.UTC), 1, 6.0},
+				},
+			},
+			{
+				Query: "SELECT COUNT(*), MAX(v) FROM ts WHERE t IS NOT NULL GROUP BY TIME_BUCKET(INTERVAL 1 HOUR, t, '2021-06-01 00:30:00') ORDER BY 2",
+				Expected: []sql.Row{
+					{4, 4},
+					{1, 5},
+					{1, 6},
+				},
+			},
+			{
+				Query: "SELECT TIME_BUCKET(INTERVAL 1 MONTH, t) AS b, COUNT(*) FROM ts WHERE t IS NOT NULL GROUP BY 1 ORDER BY 1",
+				Expected: []sql.Row{
+					{time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), 5},
+					{time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), 1},
+				},
+			},
+			{
+				Query:       "SELECT INTERVAL 15 MINUTE FROM ts",
+				ExpectedErr: analyzer.ErrIntervalInvalidUse,
+			},
+		},
+	},
+	{
+		Name: "approximate aggregates with and without GROUP BY",
+		SetUpScript: []string{
+			"CREATE TABLE latencies (pk int primary key, host varchar(10), ms int)",
+			"INSERT INTO latencies VALUES (1, 'a', 10), (2, 'a', 20), (3, 'a', 20), (4, 'a', 40), (5, 'b', 5), (6, 'b', NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT APPROX_COUNT_DISTINCT(ms), APPROX_COUNT_DISTINCT(host) FROM latencies",
+				Expected: []sql.Row{{int64(4), int64(2)}},
+			},
+			{
+				Query:    "SELECT APPROX_PERCENTILE(ms, 0.5), APPROX_PERCENTILE(ms, 1) FROM latencies",
+				Expected: []sql.Row{{20.0, 40.0}},
+			},
+			{
+				Query:    "SELECT COUNT(*), APPROX_COUNT_DISTINCT(ms) FROM latencies WHERE ms > 100",
+				Expected: []sql.Row{{0, int64(0)}},
+			},
+			{
+				Query:    "SELECT host, APPROX_COUNT_DISTINCT(ms), APPROX_PERCENTILE(ms, 0) FROM latencies GROUP BY host ORDER BY host",
+				Expected: []sql.Row{{"a", int64(3), 10.0}, {"b", int64(1), 5.0}},
+			},
+			{
+				Query:       "SELECT APPROX_PERCENTILE(ms, 2) FROM latencies",
+				ExpectedErr: aggregation.ErrInvalidPercentile,
+			},
+		},
+	},
+	{
+		Name: "MEDIAN, PERCENTILE_CONT and PERCENTILE_DISC with and without GROUP BY",
+		SetUpScript: []string{
+			"CREATE TABLE prices (pk int primary key, shop varchar(10), price int)",
+			"INSERT INTO prices VALUES (1, 'a', 10), (2, 'a', 20), (3, 'a', 20), (4, 'a', 40), (5, 'b', 5), (6, 'b', NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT MEDIAN(price), PERCENTILE_CONT(price, 0.25), PERCENTILE_DISC(price, 0.5) FROM prices",
+				Expected: []sql.Row{{20.0, 10.0, int32(20)}},
+			},
+			{
+				Query:    "SELECT MEDIAN(price), PERCENTILE_DISC(price, 0.5) FROM prices WHERE price > 100",
+				Expected: []sql.Row{{nil, nil}},
+			},
+			{
+				Query:    "SELECT shop, MEDIAN(price), PERCENTILE_CONT(price, 0.75), PERCENTILE_DISC(price, 0.25) FROM prices GROUP BY shop ORDER BY shop",
+				Expected: []sql.Row{{"a", 20.0, 25.0, int32(10)}, {"b", 5.0, 5.0, int32(5)}},
+			},
+			{
+				Query:       "SELECT PERCENTILE_CONT(price, -1) FROM prices",
+				ExpectedErr: aggregation.ErrInvalidPercentile,
+			},
+		},
+	},
+	{
+		Name: "INSERT string function in expressions",
+		SetUpScript: []string{
+			"CREATE TABLE words (pk int primary key, w varchar(20))",
+			"INSERT INTO words VALUES (1, 'Quadratic'), (2, 'insert(')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT INSERT('Quadratic', 3, 4, 'What'), insert('Quadratic', -1, 4, 'What'), INSERT ('Quadratic', 3, 100, 'What')",
+				Expected: []sql.Row{{"QuWhattic", "Quadratic", "QuWhat"}},
+			},
+			{
+				Query:    "SELECT pk, INSERT(w, 1, 1, 'X') AS replaced FROM words WHERE INSERT(w, 2, 100, '') = 'Q' ORDER BY pk",
+				Expected: []sql.Row{{1, "Xuadratic"}},
+			},
+			{
+				Query:    "INSERT INTO words (pk, w) SELECT pk + 10, INSERT(w, 1, 2, 'ab') FROM words",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 2}}},
+			},
+			{
+				Query:    "SELECT w FROM words WHERE pk > 10 ORDER BY pk",
+				Expected: []sql.Row{{"abadratic"}, {"absert("}},
+			},
+		},
+	},
+	{
+		Name: "INSERT, UPDATE and DELETE on views over a single table",
+		SetUpScript: []string{
+			"CREATE TABLE base (pk int primary key, a int, b varchar(20))",
+			"INSERT INTO base VALUES (1, 10, 'x'), (2, 20, 'y'), (3, 30, 'z')",
+			"CREATE VIEW v AS SELECT pk, a AS val FROM base WHERE a >= 20",
+			"CREATE VIEW vall AS SELECT * FROM base",
+			"CREATE VIEW vagg AS SELECT count(*) AS c FROM base",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "UPDATE v SET val = val + 1 WHERE pk = 2",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "UPDATE v SET val = 0 WHERE pk = 1",
+				Expected: []sql.Row{{newUpdateResult(0, 0)}},
+			},
+			{
+				Query:    "DELETE FROM v WHERE v.val > 25",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "INSERT INTO v (pk, val) VALUES (4, 40)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "INSERT INTO vall VALUES (5, 50, 'w')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query: "SELECT * FROM base ORDER BY pk",
+				Expected: []sql.Row{
+					{int32(1), int32(10), "x"},
+					{int32(2), int32(21), "y"},
+					{int32(4), int32(40), nil},
+					{int32(5), int32(50), "w"},
+				},
+			},
+			{
+				Query:    "SELECT * FROM v ORDER BY pk",
+				Expected: []sql.Row{{int32(2), int32(21)}, {int32(4), int32(40)}, {int32(5), int32(50)}},
+			},
+			{
+				Query:       "UPDATE v SET b = 'q'",
+				ExpectedErr: sql.ErrColumnNotFound,
+			},
+			{
+				Query:       "UPDATE vagg SET c = 1",
+				ExpectedErr: sql.ErrViewNotUpdatable,
+			},
+			{
+				Query:       "INSERT INTO vagg VALUES (1)",
+				ExpectedErr: sql.ErrViewNotUpdatable,
+			},
+			{
+				Query:       "DELETE FROM vagg",
+				ExpectedErr: sql.ErrViewNotUpdatable,
+			},
+		},
+	},
+	{
+		Name: "Partitioned tables",
+		SetUpScript: []string{
+			"CREATE TABLE sales (id int primary key, amount int) PARTITION BY RANGE (id) (PARTITION p0 VALUES LESS THAN (10), PARTITION p1 VALUES LESS THAN (20), PARTITION pmax VALUES LESS THAN MAXVALUE)",
+			"INSERT INTO sales VALUES (1, 10), (15, 20), (25, 30)",
+			"CREATE TABLE colors (id int primary key, color varchar(10)) PARTITION BY LIST (id) (PARTITION warm VALUES IN (1, 2), PARTITION cold VALUES IN (3, 4))",
+			"INSERT INTO colors VALUES (1, 'red'), (3, 'blue')",
+			"CREATE TABLE hashed (id int primary key) PARTITION BY HASH (id) PARTITIONS 4",
+			"INSERT INTO hashed VALUES (1), (2), (3), (4), (5)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM sales WHERE id < 20 ORDER BY id",
+				Expected: []sql.Row{{int32(1), int32(10)}, {int32(15), int32(20)}},
+			},
+			{
+				Query:    "SELECT * FROM sales WHERE id >= 15 AND id BETWEEN 10 AND 30 ORDER BY id",
+				Expected: []sql.Row{{int32(15), int32(20)}, {int32(25), int32(30)}},
+			},
+			{
+				Query:    "UPDATE sales SET id = 5 WHERE id = 15",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT * FROM sales WHERE id < 10 ORDER BY id",
+				Expected: []sql.Row{{int32(1), int32(10)}, {int32(5), int32(20)}},
+			},
+			{
+				Query:    "SELECT * FROM colors WHERE id IN (3, 4)",
+				Expected: []sql.Row{{int32(3), "blue"}},
+			},
+			{
+				Query:       "INSERT INTO colors VALUES (5, 'green')",
+				ExpectedErr: sql.ErrNoPartitionForValue,
+			},
+			{
+				Query:    "SELECT count(*) FROM hashed WHERE id = 4",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:    "SELECT count(*) FROM hashed",
+				Expected: []sql.Row{{int64(5)}},
+			},
+		},
+	},
+	{
+		Name: "IN subqueries with duplicates and NULLs",
+		SetUpScript: []string{
+			"CREATE TABLE outer_t (a int, b varchar(10))",
+			"INSERT INTO outer_t VALUES (1, 'x'), (2, 'y'), (2, 'z'), (3, 'w'), (NULL, 'n')",
+			"CREATE TABLE inner_t (a int)",
+			"INSERT INTO inner_t VALUES (1), (1), (2), (2), (NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT b FROM outer_t WHERE a IN (SELECT a FROM inner_t) ORDER BY b",
+				Expected: []sql.Row{{"x"}, {"y"}, {"z"}},
+			},
+			{
+				Query:    "SELECT a, b FROM outer_t WHERE b <> 'y' AND a IN (SELECT a FROM inner_t WHERE a > 0) ORDER BY b",
+				Expected: []sql.Row{{int32(1), "x"}, {int32(2), "z"}},
+			},
+			{
+				Query:    "SELECT count(*) FROM outer_t WHERE a IN (SELECT a FROM inner_t WHERE a IS NULL)",
+				Expected: []sql.Row{{int64(0)}},
+			},
+			{
+				Query:    "SELECT b FROM outer_t WHERE a NOT IN (SELECT a FROM inner_t)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "UPDATE outer_t SET b = 'u' WHERE a IN (SELECT a FROM inner_t WHERE a = 1)",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT b FROM outer_t WHERE a IN (SELECT a FROM inner_t) ORDER BY b",
+				Expected: []sql.Row{{"u"}, {"y"}, {"z"}},
+			},
+		},
+	},
+	{
+		Name: "Binary strings",
+		SetUpScript: []string{
+			"CREATE TABLE bins (pk int primary key, b varbinary(10))",
+			"INSERT INTO bins VALUES (1, UNHEX('00FF')), (2, _binary 'abc'), (3, 'ABC')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM bins WHERE b = 'abc'",
+				Expected: []sql.Row{{int32(2)}},
+			},
+			{
+				Query:    "SELECT pk FROM bins WHERE b LIKE 'a%'",
+				Expected: []sql.Row{{int32(2)}},
+			},
+			{
+				Query:    "SELECT pk FROM bins WHERE b = UNHEX('00ff')",
+				Expected: []sql.Row{{int32(1)}},
+			},
+			{
+				Query:    "SELECT HEX(b) FROM bins ORDER BY pk",
+				Expected: []sql.Row{{"00FF"}, {"616263"}, {"414243"}},
+			},
+			{
+				Query:    "SELECT HEX(UNHEX('0aff')), HEX(UNHEX(HEX(_binary 'xyz')))",
+				Expected: []sql.Row{{"0AFF", "78797A"}},
+			},
+		},
+	},
+	{
+		Name: "BIT columns",
+		SetUpScript: []string{
+			"CREATE TABLE flags (pk int primary key, f bit(10), b bit)",
+			"INSERT INTO flags VALUES (1, b'1010', b'1'), (2, 9, 0), (3, b'1111111111', NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk, f, b FROM flags ORDER BY pk",
+				Expected: []sql.Row{{int32(1), uint64(10), uint64(1)}, {int32(2), uint64(9), uint64(0)}, {int32(3), uint64(1023), nil}},
+			},
+			{
+				Query:    "SELECT pk FROM flags WHERE f > 9 ORDER BY pk",
+				Expected: []sql.Row{{int32(1)}, {int32(3)}},
+			},
+			{
+				Query:    "SELECT pk FROM flags WHERE f = b'1001'",
+				Expected: []sql.Row{{int32(2)}},
+			},
+			{
+				Query:    "SELECT f + 0, f & b'11', CAST(f AS SIGNED) FROM flags WHERE pk = 1",
+				Expected: []sql.Row{{int64(10), uint64(2), int64(10)}},
+			},
+			{
+				Query:          "INSERT INTO flags VALUES (4, b'11111111111', 0)",
+				ExpectedErrStr: "2047 is beyond the maximum value that can be held by 10 bits",
+			},
+		},
+	},
+	{
+		Name: "NOT IN with NULLs",
+		SetUpScript: []string{
+			"CREATE TABLE nums (pk int primary key, n int)",
+			"CREATE TABLE vals (v int)",
+			"INSERT INTO nums VALUES (1, 1), (2, 2), (3, 3), (4, NULL)",
+			"INSERT INTO vals VALUES (1), (NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM nums WHERE n NOT IN (SELECT v FROM vals)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk FROM nums WHERE n NOT IN (SELECT v FROM vals WHERE v IS NOT NULL) ORDER BY pk",
+				Expected: []sql.Row{{int32(2)}, {int32(3)}},
+			},
+			{
+				Query:    "SELECT pk FROM nums WHERE n IN (SELECT v FROM vals) ORDER BY pk",
+				Expected: []sql.Row{{int32(1)}},
+			},
+			{
+				// Nothing is compared against an empty subquery, so NOT IN is true even for NULL
+				Query:    "SELECT pk FROM nums WHERE n NOT IN (SELECT v FROM vals WHERE v > 5) ORDER BY pk",
+				Expected: []sql.Row{{int32(1)}, {int32(2)}, {int32(3)}, {int32(4)}},
+			},
+			{
+				Query:    "SELECT pk FROM nums WHERE n NOT IN (1, 5, 7, 9, NULL)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk FROM nums WHERE n NOT IN (1, 5, 7, 9) ORDER BY pk",
+				Expected: []sql.Row{{int32(2)}, {int32(3)}},
+			},
+			{
+				Query:    "SELECT pk FROM nums WHERE n IN (1, 5, 7, 9, NULL) ORDER BY pk",
+				Expected: []sql.Row{{int32(1)}},
+			},
+			{
+				Query:    "SELECT pk FROM nums WHERE (pk, n) NOT IN ((1, NULL), (2, 20)) ORDER BY pk",
+				Expected: []sql.Row{{int32(2)}, {int32(3)}, {int32(4)}},
+			},
+			{
+				Query:    "SELECT pk FROM nums WHERE n NOT IN (SELECT pk + 0.0 FROM nums WHERE pk < 3) ORDER BY pk",
+				Expected: []sql.Row{{int32(3)}},
+			},
+		},
+	},
+	{
+		Name: "Zero and out of range dates depend on sql_mode",
+		SetUpScript: []string{
+			"CREATE TABLE dates (pk int primary key, d date, y year)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO dates VALUES (1, '0000-00-00', 0)",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "SET sql_mode = 'STRICT_TRANS_TABLES,NO_ZERO_DATE'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "INSERT INTO dates VALUES (2, '0000-00-00', 2000)",
+				ExpectedErr: sql.ErrIncorrectTimeValue,
+			},
+			{
+				Query:           "INSERT IGNORE INTO dates VALUES (2, '0000-00-00', 2000)",
+				Expected:        []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+				ExpectedWarning: 1264,
+			},
+			{
+				Query:       "INSERT INTO dates VALUES (3, '0500-01-01', 2001)",
+				ExpectedErr: sql.ErrConvertingToTimeOutOfRange,
+			},
+			{
+				Query:       "INSERT INTO dates VALUES (3, '2020-01-01', 2156)",
+				ExpectedErr: sql.ErrConvertingToYear,
+			},
+			{
+				Query:    "SET sql_mode = 'NO_ZERO_DATE'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:           "INSERT INTO dates VALUES (3, '0500-01-01', 2156)",
+				Expected:        []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+				ExpectedWarning: 1264,
+			},
+			{
+				Query:           "INSERT INTO dates VALUES (4, '0000-00-00', 1999)",
+				Expected:        []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+				ExpectedWarning: 1264,
+			},
+			{
+				Query:    "SELECT pk, y FROM dates ORDER BY pk",
+				Expected: []sql.Row{{int32(1), int16(0)}, {int32(2), int16(2000)}, {int32(3), int16(0)}, {int32(4), int16(1999)}},
+			},
+			{
+				Query:    "SELECT pk FROM dates WHERE d = '0000-00-00' ORDER BY pk",
+				Expected: []sql.Row{{int32(1)}, {int32(2)}, {int32(3)}, {int32(4)}},
+			},
+			{
+				Query:    "SET sql_mode = 'ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION'",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "out of range numbers are clamped by INSERT IGNORE and outside of strict mode",
+		SetUpScript: []string{
+			"CREATE TABLE clamped (pk int primary key, t tinyint, u tinyint unsigned, d decimal(5,2), f float)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO clamped VALUES (1, 300, 1, 1, 1)",
+				ExpectedErr: sql.ErrOutOfRange,
+			},
+			{
+				Query:           "INSERT IGNORE INTO clamped VALUES (1, 300, 1, 1, 1)",
+				Expected:        []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+				ExpectedWarning: 1264,
+			},
+			{
+				Query:    "SET sql_mode = ''",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:           "INSERT INTO clamped VALUES (2, -300, -1, 1, 1)",
+				Expected:        []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+				ExpectedWarning: 1264,
+			},
+			{
+				Query:           "INSERT INTO clamped VALUES (3, 1, '256', 12345.6, 1)",
+				Expected:        []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+				ExpectedWarning: 1264,
+			},
+			{
+				Query:           "INSERT INTO clamped VALUES (4, 1, 1, -1000, -1e300)",
+				Expected:        []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+				ExpectedWarning: 1264,
+			},
+			{
+				Query: "SELECT pk, t, u, d, f FROM clamped ORDER BY pk",
+				Expected: []sql.Row{
+					{int32(1), int8(127), uint8(1), "1.00", float32(1)},
+					{int32(2), int8(-128), uint8(0), "1.00", float32(1)},
+					{int32(3), int8(1), uint8(255), "999.99", float32(1)},
+					{int32(4), int8(1), uint8(1), "-999.99", float32(-math.MaxFloat32)},
+				},
+			},
+			{
+				Query:    "SET sql_mode = 'ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION'",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "sql_mode changes parsing, validation and writes",
+		SetUpScript: []string{
+			"CREATE TABLE modes (pk int primary key auto_increment, s varchar(5), i int)",
+			"INSERT INTO modes VALUES (1, 'ab', 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO modes VALUES (2, 'abcdefg', 2)",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query:           "INSERT IGNORE INTO modes VALUES (2, 'abcdefg', 2)",
+				Expected:        []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+				ExpectedWarning: 1265,
+			},
+			{
+				Query:       "SELECT s, i FROM modes GROUP BY s",
+				ExpectedErr: analyzer.ErrValidationGroupBy,
+			},
+			{
+				Query:    `SELECT "s" || 'x' FROM modes WHERE pk = 1`,
+				Expected: []sql.Row{{false}},
+			},
+			{
+				Query:    "SET sql_mode = 'PIPES_AS_CONCAT,ANSI_QUOTES'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    `SELECT "s" || '!', "modes"."i" FROM "modes" WHERE pk = 1`,
+				Expected: []sql.Row{{"ab!", int32(1)}},
+			},
+			{
+				Query:    "SELECT s, i FROM modes GROUP BY s ORDER BY s",
+				Expected: []sql.Row{{"ab", int32(1)}, {"abcde", int32(2)}},
+			},
+			{
+				Query:           "INSERT INTO modes VALUES (3, 'abcdefg', 3)",
+				Expected:        []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+				ExpectedWarning: 1265,
+			},
+			{
+				Query:    "INSERT INTO modes VALUES (0, 'zero', 0)",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "SET sql_mode = 'NO_AUTO_VALUE_ON_ZERO'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "INSERT INTO modes VALUES (0, 'zero', 0)",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "SELECT pk, s, i FROM modes ORDER BY pk",
+				Expected: []sql.Row{{int32(0), "zero", int32(0)}, {int32(1), "ab", int32(1)}, {int32(2), "abcde", int32(2)}, {int32(3), "abcde", int32(3)}, {int32(4), "zero", int32(0)}},
+			},
+			{
+				Query:    "SET sql_mode = 'ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION'",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "GROUP BY prefers table columns to select aliases",
+		SetUpScript: []string{
+			"CREATE TABLE letters (pk int primary key, s varchar(10))",
+			"INSERT INTO letters VALUES (1, 'aa'), (2, 'ab'), (3, 'b')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT SUBSTRING(s, 1, 1) AS s, COUNT(*) FROM letters GROUP BY s ORDER BY 1, 2",
+				Expected: []sql.Row{{"a", int64(1)}, {"a", int64(1)}, {"b", int64(1)}},
+			},
+			{
+				Query:    "SELECT SUBSTRING(s, 1, 1) AS initial, COUNT(*) FROM letters GROUP BY initial ORDER BY 1",
+				Expected: []sql.Row{{"a", int64(2)}, {"b", int64(1)}},
+			},
+			{
+				Query:       "SELECT pk FROM letters a JOIN letters b ON a.pk = b.pk",
+				ExpectedErr: sql.ErrAmbiguousColumnName,
+			},
+		},
+	},
+	{
+		Name: "INSERT ... SELECT maps and converts columns and reads self inserts first",
+		SetUpScript: []string{
+			"CREATE TABLE src (x int, y varchar(10))",
+			"INSERT INTO src VALUES (1, '10'), (2, '20'), (3, '30')",
+			"CREATE TABLE dst (pk int primary key auto_increment, a varchar(10), b int)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO dst (b, a) SELECT y, x FROM src ORDER BY x DESC",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 3}}},
+			},
+			{
+				Query:    "SELECT * FROM dst ORDER BY pk",
+				Expected: []sql.Row{{int32(1), "3", int32(30)}, {int32(2), "2", int32(20)}, {int32(3), "1", int32(10)}},
+			},
+			{
+				Query:    "INSERT INTO dst (a, b) SELECT a, b + 100 FROM dst WHERE pk IN (SELECT pk FROM dst WHERE b > 15) ORDER BY pk",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 2}}},
+			},
+			{
+				Query:    "SELECT * FROM dst WHERE pk > 3 ORDER BY pk",
+				Expected: []sql.Row{{int32(4), "3", int32(130)}, {int32(5), "2", int32(120)}},
+			},
+			{
+				Query:       "INSERT INTO dst (a, b) SELECT x, 'abc' FROM src",
+				ExpectedErr: sql.ErrInvalidValue,
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM dst",
+				Expected: []sql.Row{{int64(5)}},
+			},
+		},
+	},
+	{
+		Name: "tuple IN filters over composite indexes",
+		SetUpScript: []string{
+			"CREATE TABLE pairs (pk BIGINT PRIMARY KEY, a BIGINT, b BIGINT, c BIGINT, INDEX ab (a, b), INDEX abc (c, a, b));",
+			"INSERT INTO pairs VALUES (1, 1, 2, 1), (2, 1, 3, 1), (3, 3, 4, 2), (4, 3, NULL, 2), (5, 5, 6, 3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM pairs WHERE (a, b) IN ((1, 2), (3, 4)) ORDER BY pk",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM pairs WHERE (b, a) IN ((3, 1), (6, 5), (7, 7)) ORDER BY pk",
+				Expected: []sql.Row{{2}, {5}},
+			},
+			{
+				Query:    "SELECT pk FROM pairs WHERE (a, b) IN ((3, NULL), (1, 3)) ORDER BY pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT pk FROM pairs WHERE (c, a) IN ((1, 1), (2, 3)) ORDER BY pk",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM pairs WHERE (a, b) IN ((1, 2), (3, 4)) AND c = 2 ORDER BY pk",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT pk FROM pairs WHERE (a, pk) IN ((1, 2), (5, 5)) ORDER BY pk",
+				Expected: []sql.Row{{2}, {5}},
+			},
+		},
+	},
+	{
+		Name: "binary log administration statements without binary logging",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT @@log_bin",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SHOW MASTER STATUS",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW BINARY LOG STATUS",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW REPLICAS",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "SHOW BINARY LOGS",
+				ExpectedErr: sql.ErrNoBinaryLogging,
+			},
+			{
+				Query:    "SHOW BINLOG EVENTS",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "PURGE BINARY LOGS TO 'mysql-bin.000001'",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "set operations combine rows and apply ORDER BY and LIMIT to the result",
+		SetUpScript: []string{
+			"CREATE TABLE setl (i INT, f DOUBLE);",
+			"INSERT INTO setl VALUES (1, 1.5), (1, 1.5), (2, 2.5), (3, 3.5), (NULL, NULL);",
+			"CREATE TABLE setr (i BIGINT);",
+			"INSERT INTO setr VALUES (1), (2), (2), (NULL), (4);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT i FROM setl INTERSECT SELECT i FROM setr ORDER BY i",
+				Expected: []sql.Row{{nil}, {int64(1)}, {int64(2)}},
+			},
+			{
+				Query:    "SELECT i FROM setl INTERSECT ALL SELECT i FROM setr ORDER BY i",
+				Expected: []sql.Row{{nil}, {int64(1)}, {int64(2)}},
+			},
+			{
+				Query:    "SELECT i FROM setl EXCEPT SELECT i FROM setr",
+				Expected: []sql.Row{{int64(3)}},
+			},
+			{
+				Query:    "SELECT i FROM setl EXCEPT ALL SELECT i FROM setr ORDER BY i",
+				Expected: []sql.Row{{int64(1)}, {int64(3)}},
+			},
+			{
+				Query:    "SELECT i FROM setl EXCEPT SELECT i FROM setr INTERSECT SELECT 3 ORDER BY i",
+				Expected: []sql.Row{{nil}, {int64(1)}, {int64(2)}, {int64(3)}},
+			},
+			{
+				Query:    "SELECT i FROM setl UNION SELECT i FROM setr ORDER BY i DESC LIMIT 2",
+				Expected: []sql.Row{{int64(4)}, {int64(3)}},
+			},
+			{
+				Query:    "SELECT i FROM setl UNION ALL SELECT f FROM setl ORDER BY i LIMIT 3",
+				Expected: []sql.Row{{nil}, {nil}, {float64(1)}},
+			},
+			{
+				Query:    "SELECT i FROM setl WHERE i IN (SELECT i FROM setr INTERSECT SELECT 2) ORDER BY i",
+				Expected: []sql.Row{{int64(2)}},
+			},
+			{
+				Query:    "SELECT s.i FROM (SELECT i FROM setl EXCEPT SELECT i FROM setr) s",
+				Expected: []sql.Row{{int64(3)}},
+			},
+			{
+				Query:    "WITH c AS (SELECT i FROM setr EXCEPT ALL SELECT i FROM setl) SELECT i FROM c ORDER BY i",
+				Expected: []sql.Row{{int64(2)}, {int64(4)}},
+			},
+			{
+				Query:    "CREATE VIEW setv AS SELECT i FROM setl INTERSECT SELECT i FROM setr",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT i FROM setv ORDER BY i",
+				Expected: []sql.Row{{nil}, {int64(1)}, {int64(2)}},
+			},
+		},
+	},
+	{
+		Name: "correlated aggregate subqueries in projections",
+		SetUpScript: []string{
+			"CREATE TABLE customers (id INT PRIMARY KEY, name VARCHAR(10));",
+			"INSERT INTO customers VALUES (1, 'a'), (2, 'b'), (3, 'c');",
+			"CREATE TABLE orders (id INT PRIMARY KEY, customer_id INT, amount INT);",
+			"INSERT INTO orders VALUES (1, 1, 10), (2, 1, 20), (3, 2, 5), (4, NULL, 7);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id, (SELECT COUNT(*) FROM orders WHERE orders.customer_id = customers.id) FROM customers ORDER BY id",
+				Expected: []sql.Row{{int32(1), int64(2)}, {int32(2), int64(1)}, {int32(3), int64(0)}},
+			},
+			{
+				Query: `SELECT c.id,
+					(SELECT SUM(amount) FROM orders o WHERE o.customer_id = c.id) AS total,
+					(SELECT MAX(amount) FROM orders o WHERE o.customer_id = c.id AND o.amount < 20) AS m
+					FROM customers c ORDER BY c.id`,
+				Expected: []sql.Row{{int32(1), float64(30), int32(10)}, {int32(2), float64(5), int32(5)}, {int32(3), nil, nil}},
+			},
+			{
+				Query:    "SELECT name, (SELECT COUNT(*) FROM orders WHERE orders.customer_id = customers.id) + 1 AS n FROM customers WHERE id > 1 ORDER BY name",
+				Expected: []sql.Row{{"b", int64(2)}, {"c", int64(1)}},
+			},
+			{
+				Query:    "SELECT id, (SELECT COUNT(*) FROM orders WHERE orders.customer_id > customers.id) FROM customers ORDER BY id",
+				Expected: []sql.Row{{int32(1), int64(1)}, {int32(2), int64(0)}, {int32(3), int64(0)}},
+			},
+			{
+				Query: `SELECT id,
+					(SELECT COUNT(*) FROM orders WHERE orders.customer_id = customers.id),
+					(SELECT COUNT(*) FROM orders WHERE orders.customer_id > customers.id)
+					FROM customers ORDER BY id`,
+				Expected: []sql.Row{{int32(1), int64(2), int64(1)}, {int32(2), int64(1), int64(0)}, {int32(3), int64(0), int64(0)}},
+			},
+		},
+	},
+	{
+		Name: "replication control statements",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "START REPLICA",
+				ExpectedErr: sql.ErrReplicaNotConfigured,
+			},
+			{
+				Query:    "CHANGE REPLICATION SOURCE TO SOURCE_HOST = 'localhost', SOURCE_PORT = 3307, SOURCE_USER = 'repl', SOURCE_PASSWORD = 'pass', SOURCE_AUTO_POSITION = 1",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "CHANGE REPLICATION FILTER REPLICATE_DO_DB = (mydb), REPLICATE_IGNORE_TABLE = (mydb.tmp)",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:       "CHANGE REPLICATION FILTER REPLICATE_DO_TABLE = (tmp)",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+			{
+				Query:    "START REPLICA",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:       "CHANGE MASTER TO MASTER_HOST = 'otherhost'",
+				ExpectedErr: sql.ErrReplicaRunning,
+			},
+			{
+				Query:    "STOP SLAVE",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "CHANGE MASTER TO MASTER_HOST = 'otherhost'",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "lateral derived tables and JSON_TABLE",
+		SetUpScript: []string{
+			"create table people (id int primary key, name varchar(20), prefs json)",
+			"create table purchases (id int primary key, person_id int, total int)",
+			`insert into people values (1, 'alice', '{"colors": ["red", "blue"]}'), (2, 'bob', '{"colors": []}'), (3, 'carol', null)`,
+			"insert into purchases values (1, 1, 10), (2, 1, 30), (3, 2, 20)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: `select p.name, l.total from people p,
+					lateral (select total from purchases where person_id = p.id order by total desc limit 1) as l
+					order by p.id`,
+				Expected: []sql.Row{{"alice", int64(30)}, {"bob", int64(20)}},
+			},
+			{
+				Query: `select p.name, l.total from people p
+					left join lateral (select total from purchases where person_id = p.id order by total desc limit 1) l on true
+					order by p.id`,
+				Expected: []sql.Row{{"alice", int64(30)}, {"bob", int64(20)}, {"carol", nil}},
+			},
+			{
+				Query: `select * from json_table('[{"a": 1, "b": "x"}, {"a": 2}]', '$[*]' columns (
+					id for ordinality, a int path '$.a', b varchar(10) path '$.b' default '"none"' on empty)) as jt`,
+				Expected: []sql.Row{{uint64(1), int64(1), "x"}, {uint64(2), int64(2), "none"}},
+			},
+			{
+				Query: `select p.name, jt.color from people p,
+					json_table(p.prefs, '$.colors[*]' columns (color varchar(10) path '$')) as jt
+					order by p.id, jt.color`,
+				Expected: []sql.Row{{"alice", "blue"}, {"alice", "red"}},
+			},
+			{
+				Query: `select p.name, jt.n, jt.has_colors from people p
+					left join json_table(p.prefs, '$' columns (n for ordinality, has_colors int exists path '$.colors')) jt on true
+					order by p.id`,
+				Expected: []sql.Row{{"alice", uint64(1), int64(1)}, {"bob", uint64(1), int64(1)}, {"carol", nil, nil}},
+			},
+			{
+				Query:       `select * from json_table('{}', '$' columns (a int path '$.a' error on empty)) as jt`,
+				ExpectedErr: plan.ErrJSONTableMissingValue,
+			},
+		},
+	},
+	{
+		Name: "ROLLUP, CUBE and GROUPING SETS",
+		SetUpScript: []string{
+			"create table sales (region varchar(10), product varchar(10), amount int, primary key (region, product))",
+			"insert into sales values ('east', 'a', 10), ('east', 'b', 20), ('west', 'a', 30)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select region, product, sum(amount) from sales group by region, product with rollup order by region, product",
+				Expected: []sql.Row{
+					{nil, nil, float64(60)},
+					{"east", nil, float64(30)},
+					{"east", "a", float64(10)},
+					{"east", "b", float64(20)},
+					{"west", nil, float64(30)},
+					{"west", "a", float64(30)},
+				},
+			},
+			{
+				Query: "select region, product, sum(amount) from sales group by region, product with rollup",
+				Expected: []sql.Row{
+					{"east", "a", float64(10)},
+					{"east", "b", float64(20)},
+					{"east", nil, float64(30)},
+					{"west", "a", float64(30)},
+					{"west", nil, float64(30)},
+					{nil, nil, float64(60)},
+				},
+			},
+			{
+				Query: "select region, product, grouping(region, product), count(*) from sales group by cube(region, product)",
+				Expected: []sql.Row{
+					{"east", "a", int64(0), int64(1)},
+					{"east", "b", int64(0), int64(1)},
+					{"east", nil, int64(1), int64(2)},
+					{"west", "a", int64(0), int64(1)},
+					{"west", nil, int64(1), int64(1)},
+					{nil, "a", int64(2), int64(2)},
+					{nil, "b", int64(2), int64(1)},
+					{nil, nil, int64(3), int64(3)},
+				},
+			},
+			{
+				Query:    "select region, sum(amount) from sales group by grouping sets ((region), ()) having grouping(region) = 0",
+				Expected: []sql.Row{{"east", float64(30)}, {"west", float64(30)}},
+			},
+			{
+				Query:       "select region, grouping(product) from sales group by rollup(region)",
+				ExpectedErr: plan.ErrGroupingArgumentNotGrouped,
+			},
+		},
+	},
+	{
+		Name: "statistical and bitwise aggregates and GROUP_CONCAT NULL handling",
+		SetUpScript: []string{
+			"create table agg_vals (g int, i int, s varchar(10))",
+			"insert into agg_vals values (1, 2, 'a'), (1, 4, null), (1, 4, 'b'), (1, 4, 'a'), (2, 5, 'c'), (2, null, 'd'), (3, null, null)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select g, var_pop(i), variance(i), stddev_pop(i), std(i) from agg_vals where g = 1 group by g",
+				Expected: []sql.Row{{1, 0.75, 0.75, 0.8660254037844386, 0.8660254037844386}},
+			},
+			{
+				Query:    "select g, var_samp(i), stddev_samp(i) from agg_vals group by g order by g",
+				Expected: []sql.Row{{1, 1.0, 1.0}, {2, nil, nil}, {3, nil, nil}},
+			},
+			{
+				Query:    "select g, bit_and(i), bit_or(i), bit_xor(i) from agg_vals group by g order by g",
+				Expected: []sql.Row{{1, uint64(0), uint64(6), uint64(6)}, {2, uint64(5), uint64(5), uint64(5)}, {3, uint64(18446744073709551615), uint64(0), uint64(0)}},
+			},
+			{
+				Query:    "select g, group_concat(distinct s order by s desc separator '|') from agg_vals group by g order by g",
+				Expected: []sql.Row{{1, "b|a"}, {2, "d|c"}, {3, nil}},
+			},
+			{
+				Query:    "select g, group_concat(s, i order by i, s) from agg_vals group by g order by g",
+				Expected: []sql.Row{{1, "a2,a4,b4"}, {2, "c5"}, {3, nil}},
+			},
+		},
+	},
+	{
+		Name: "aggregate window functions with frames",
+		SetUpScript: []string{
+			"create table frames (pk int primary key, g int, v int)",
+			"insert into frames values (1, 1, 10), (2, 1, 20), (3, 1, 30), (4, 2, 5), (5, 2, 5), (6, 2, 8)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select pk, sum(v) over (partition by g order by pk rows between 1 preceding and current row) from frames order by pk",
+				Expected: []sql.Row{{1, 10.0}, {2, 30.0}, {3, 50.0}, {4, 5.0}, {5, 10.0}, {6, 13.0}},
+			},
+			{
+				Query:    "select pk, avg(v) over (partition by g order by pk rows between 1 preceding and 1 following) from frames order by pk",
+				Expected: []sql.Row{{1, 15.0}, {2, 20.0}, {3, 25.0}, {4, 5.0}, {5, 6.0}, {6, 6.5}},
+			},
+			{
+				Query:    "select pk, count(v) over (order by v range unbounded preceding) from frames order by pk",
+				Expected: []sql.Row{{1, 4}, {2, 5}, {3, 6}, {4, 2}, {5, 2}, {6, 3}},
+			},
+			{
+				Query:    "select pk, sum(v) over (order by v range between 5 preceding and current row) from frames order by pk",
+				Expected: []sql.Row{{1, 28.0}, {2, 20.0}, {3, 30.0}, {4, 10.0}, {5, 10.0}, {6, 18.0}},
+			},
+			{
+				Query:    "select pk, sum(v) over (partition by g order by v desc, pk rows unbounded preceding), count(v) over (partition by g) from frames order by pk",
+				Expected: []sql.Row{{1, 60.0, 3}, {2, 50.0, 3}, {3, 30.0, 3}, {4, 13.0, 3}, {5, 18.0, 3}, {6, 8.0, 3}},
+			},
+			{
+				Query:       "select pk, sum(v) over (order by g, v range between 1 preceding and current row) from frames",
+				ExpectedErr: sql.ErrWindowFrameRangeOrderBy,
+			},
+		},
+	},
+	{
+		Name: "date and time functions",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select last_day('2004-02-05'), last_day('2003-03-32')",
+				Expected: []sql.Row{{time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC), nil}},
+			},
+			{
+				Query:    "select timestampdiff(MONTH, '2003-02-01', '2003-05-01'), timestampdiff(minute, '2003-02-01', '2003-05-01 12:05:55')",
+				Expected: []sql.Row{{int64(3), int64(128885)}},
+			},
+			{
+				Query:    "select timestampadd(MINUTE, 1, '2003-01-02')",
+				Expected: []sql.Row{{time.Date(2003, 1, 2, 0, 1, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "select extract(year from '2019-07-02'), EXTRACT(YEAR_MONTH FROM '2019-07-02 01:02:03'), extract(day_minute from '2019-07-02 01:02:03')",
+				Expected: []sql.Row{{int64(2019), int64(201907), int64(20102)}},
+			},
+			{
+				Query:    "select convert_tz('2004-01-01 12:00:00', '+00:00', 'Europe/Paris'), convert_tz('2004-07-01 12:00:00', 'UTC', 'US/Eastern'), convert_tz('2004-01-01 12:00:00', 'UTC', 'Nowhere/Special')",
+				Expected: []sql.Row{{time.Date(2004, 1, 1, 13, 0, 0, 0, time.UTC), time.Date(2004, 7, 1, 8, 0, 0, 0, time.UTC), nil}},
+			},
+			{
+				Query:    "select date_format('2005-11-12 15:04:05', '%y %D %M %h:%i %p, %W %j'), date_format('not a date', '%Y')",
+				Expected: []sql.Row{{"05 12th November 03:04 PM, Saturday 316", nil}},
+			},
+		},
+	},
+	{
+		Name: "Localized dates and numbers",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT @@lc_time_names, DAYNAME('2021-03-07'), MONTHNAME('2021-03-07')",
+				Expected: []sql.Row{{"en_US", "Sunday", "March"}},
+			},
+			{
+				Query:    "SET lc_time_names = 'de_de'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @@lc_time_names, DAYNAME('2021-03-07'), MONTHNAME('2021-03-07'), DATE_FORMAT('2021-03-07', '%W, %e. %M %Y (%a %b)')",
+				Expected: []sql.Row{{"de_DE", "Sonntag", "März", "Sonntag, 7. März 2021 (So Mär)"}},
+			},
+			{
+				Query:    "SELECT FORMAT(12345.67, 2, 'de_DE'), FORMAT(12345.67, 2)",
+				Expected: []sql.Row{{"12.345,67", "12,345.67"}},
+			},
+			{
+				Query:       "SET lc_time_names = 'xx_XX'",
+				ExpectedErr: sql.ErrInvalidSystemVariableValue,
+			},
+			{
+				Query:    "SET lc_time_names = 'en_US'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @@lc_time_names, DAYNAME('2021-03-07')",
+				Expected: []sql.Row{{"en_US", "Sunday"}},
+			},
+		},
+	},
+	{
+		Name: "TIMESTAMP values in the time zone of the session",
+		SetUpScript: []string{
+			"CREATE TABLE tz (i int PRIMARY KEY, ts timestamp, KEY (ts))",
+			"SET time_zone = '+00:00'",
+			"INSERT INTO tz VALUES (1, '2021-06-01 20:00:00'), (2, '2021-06-02 10:00:00')",
+			"SET time_zone = '+05:00'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT i, ts, HOUR(ts), DATE_FORMAT(ts, '%Y-%m-%d %H:%i'), CAST(ts AS CHAR), CONCAT('at ', ts) FROM tz ORDER BY i",
+				Expected: []sql.Row{
+					{1, time.Date(2021, 6, 2, 1, 0, 0, 0, time.UTC), 1, "2021-06-02 01:00", "2021-06-02 01:00:00", "at 2021-06-02 01:00:00"},
+					{2, time.Date(2021, 6, 2, 15, 0, 0, 0, time.UTC), 15, "2021-06-02 15:00", "2021-06-02 15:00:00", "at 2021-06-02 15:00:00"},
+				},
+			},
+			{
+				Query:    "SELECT i FROM tz WHERE ts = '2021-06-02 01:00:00'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT i FROM tz WHERE ts < '2021-06-02 12:00:00'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT i FROM tz WHERE DATE(ts) = '2021-06-02' ORDER BY i",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT ts + INTERVAL 1 HOUR, ts - INTERVAL 2 HOUR FROM tz WHERE i = 1",
+				Expected: []sql.Row{{time.Date(2021, 6, 2, 2, 0, 0, 0, time.UTC), time.Date(2021, 6, 1, 23, 0, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "UPDATE tz SET ts = ts + INTERVAL 1 DAY WHERE ts > '2021-06-02 12:00:00'",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "INSERT INTO tz VALUES (3, '2021-06-04 05:00:00')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SET time_zone = '+00:00'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query: "SELECT i, ts FROM tz ORDER BY i",
+				Expected: []sql.Row{
+					{1, time.Date(2021, 6, 1, 20, 0, 0, 0, time.UTC)},
+					{2, time.Date(2021, 6, 3, 10, 0, 0, 0, time.UTC)},
+					{3, time.Date(2021, 6, 4, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			{
+				Query:    "SET time_zone = 'SYSTEM'",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "SHOW DIFF between two tables",
+		SetUpScript: []string{
+			"CREATE TABLE before_t (pk int PRIMARY KEY, v varchar(20), dropped int)",
+			"CREATE TABLE after_t (pk bigint PRIMARY KEY, v varchar(20))",
+			"INSERT INTO before_t VALUES (1, 'one', 10), (2, 'two', 20), (3, 'three', 30)",
+			"INSERT INTO after_t VALUES (1, 'one'), (3, 'THREE'), (4, 'four')",
+			"CREATE TABLE copy_t (pk bigint PRIMARY KEY, v varchar(20))",
+			"INSERT INTO copy_t SELECT * FROM after_t",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW DIFF FROM before_t TO after_t",
+				Expected: []sql.Row{
+					{"modified", 3, "three", 30, 3, "THREE"},
+					{"added", nil, nil, nil, 4, "four"},
+					{"removed", 2, "two", 20, nil, nil},
+				},
+			},
+			{
+				Query:    "SHOW DIFF FROM after_t TO copy_t",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "SHOW DIFF FROM before_t TO missing_t",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+		},
+	},
 }
 
 var CreateCheckConstraintsScripts = []ScriptTest{
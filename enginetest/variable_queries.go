@@ -265,6 +265,46 @@ var VariableQueries = []ScriptTest{
 			{55, 77},
 		},
 	},
+	{
+		Name: "set user vars with :=",
+		SetUpScript: []string{
+			`set @myvar := 1, @myvar2 := @myvar + 1`,
+		},
+		Query: "SELECT @myvar, @myvar2",
+		Expected: []sql.Row{
+			{1, 2},
+		},
+	},
+	{
+		Name: "assign user vars in expressions",
+		SetUpScript: []string{
+			"create table t (pk bigint primary key, name varchar(10))",
+			"insert into t values (1, 'a'), (2, 'b'), (3, 'c')",
+			"set @rownum = 0",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT @rownum := @rownum + 1 AS n, name FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, "a"}, {2, "b"}, {3, "c"}},
+			},
+			{
+				Query:    "SELECT @rownum",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT @r := @r + 1 AS n, name FROM t, (SELECT @r := 0) init ORDER BY pk",
+				Expected: []sql.Row{{1, "a"}, {2, "b"}, {3, "c"}},
+			},
+			{
+				Query:    "SELECT @a := 1, @a + 1, @b := @a * 10",
+				Expected: []sql.Row{{1, 2, 10}},
+			},
+			{
+				Query:    "SELECT @b",
+				Expected: []sql.Row{{10}},
+			},
+		},
+	},
 	{
 		Name: "set transaction",
 		Assertions: []ScriptTestAssertion{
@@ -0,0 +1,106 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+)
+
+// MaxExecutionTimeSessionVar is the system variable holding the default timeout, in milliseconds, for SELECT
+// statements.
+const MaxExecutionTimeSessionVar = "max_execution_time"
+
+var selectStatementRegex = regexp.MustCompile(`(?is)^[\s(]*(select|with)\b`)
+
+// executionTimeout returns the maximum execution time of the query given, taken from its MAX_EXECUTION_TIME hint or,
+// if it has none, from the max_execution_time session variable. As in MySQL, only SELECT statements can time out. A
+// zero duration means the query can run forever.
+func executionTimeout(ctx *sql.Context, query string) (time.Duration, error) {
+	if !selectStatementRegex.MatchString(query) {
+		return 0, nil
+	}
+
+	if ms, ok := parse.MaxExecutionTimeHint(query); ok {
+		return time.Duration(ms) * time.Millisecond, nil
+	}
+
+	val, err := ctx.GetSessionVariable(ctx, MaxExecutionTimeSessionVar)
+	if err != nil {
+		return 0, err
+	}
+
+	ms, ok := val.(int64)
+	if !ok || ms <= 0 {
+		return 0, nil
+	}
+
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// withExecutionTimeout returns a context with a deadline derived from the maximum execution time of the query given,
+// and the function that releases it. If the query has no timeout, the context is returned unchanged with a nil cancel
+// function.
+func withExecutionTimeout(ctx *sql.Context, query string) (*sql.Context, context.CancelFunc, error) {
+	timeout, err := executionTimeout(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if timeout <= 0 {
+		return ctx, nil, nil
+	}
+
+	newCtx, cancel := context.WithTimeout(ctx.Context, timeout)
+	return ctx.WithContext(newCtx), cancel, nil
+}
+
+// deadlineIter checks for the deadline of its context between rows, and reports an exceeded deadline as a MySQL
+// execution time error rather than a context error.
+type deadlineIter struct {
+	childIter sql.RowIter
+	ctx       *sql.Context
+	cancel    context.CancelFunc
+}
+
+func (d *deadlineIter) Next() (sql.Row, error) {
+	if err := d.ctx.Err(); err != nil {
+		return nil, d.translateErr(err)
+	}
+
+	row, err := d.childIter.Next()
+	if err != nil {
+		return nil, d.translateErr(err)
+	}
+
+	return row, nil
+}
+
+func (d *deadlineIter) translateErr(err error) error {
+	if err != io.EOF && d.ctx.Err() == context.DeadlineExceeded {
+		return sql.ErrMaxExecutionTimeExceeded.New()
+	}
+	return err
+}
+
+func (d *deadlineIter) Close(ctx *sql.Context) error {
+	defer d.cancel()
+	return d.childIter.Close(ctx)
+}
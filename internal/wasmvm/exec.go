@@ -0,0 +1,905 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasmvm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrTrap is returned when a function traps, such as on a division by zero or an access out of its memory.
+	ErrTrap = errors.NewKind("WebAssembly trap: %s")
+	// ErrInstructionLimit is returned when a call executes more instructions than its instance allows.
+	ErrInstructionLimit = errors.NewKind("WebAssembly call exceeded the limit of %d instructions")
+	// ErrMemoryLimit is returned when a module needs more memory than its instance allows.
+	ErrMemoryLimit = errors.NewKind("WebAssembly module needs %d pages of memory, more than the limit of %d")
+	// ErrNoFunction is returned when a module doesn't export the function called.
+	ErrNoFunction = errors.NewKind("WebAssembly module doesn't export a function %s")
+)
+
+const (
+	// maxCallDepth is the maximum depth of the calls of functions, after which they trap.
+	maxCallDepth = 1000
+	// maxTableSize is the maximum size of the table of a module.
+	maxTableSize = 1 << 20
+)
+
+// Limits bound the resources used by an instance.
+type Limits struct {
+	// MaxInstructions is the maximum number of instructions a call of an instance can execute. If zero, calls aren't
+	// limited.
+	MaxInstructions int64
+	// MaxMemoryPages is the maximum number of pages of memory of an instance. If zero, it's only limited by the module.
+	MaxMemoryPages uint32
+}
+
+// Instance is an instance of a module, with its own memory and globals. Instances are not safe for concurrent use.
+type Instance struct {
+	m        *Module
+	limits   Limits
+	maxPages uint32
+	memory   []byte
+	globals  []uint64
+	table    []int64
+	stack    []uint64
+	fuel     int64
+	depth    int
+}
+
+// trap is the value instances panic with when they trap.
+type trap struct {
+	err error
+}
+
+func trapf(format string, args ...interface{}) {
+	panic(trap{ErrTrap.New(fmt.Sprintf(format, args...))})
+}
+
+// Instantiate returns a new instance of the module, which initializes its memory, globals and table, and runs its start
+// function.
+func (m *Module) Instantiate(limits Limits) (in *Instance, err error) {
+	in = &Instance{m: m, limits: limits, maxPages: maxPages}
+	if m.hasMemMax {
+		in.maxPages = m.memMax
+	}
+	if limits.MaxMemoryPages > 0 && limits.MaxMemoryPages < in.maxPages {
+		in.maxPages = limits.MaxMemoryPages
+	}
+	if m.memMin > in.maxPages {
+		return nil, ErrMemoryLimit.New(m.memMin, in.maxPages)
+	}
+	if m.tableMin > maxTableSize {
+		return nil, ErrUnsupported.New("tables of more than a million functions")
+	}
+
+	in.memory = make([]byte, int(m.memMin)*PageSize)
+	for _, d := range m.data {
+		if uint64(d.offset)+uint64(len(d.data)) > uint64(len(in.memory)) {
+			return nil, ErrTrap.New("data segment out of bounds")
+		}
+		copy(in.memory[d.offset:], d.data)
+	}
+
+	in.globals = make([]uint64, len(m.globals))
+	for i, g := range m.globals {
+		in.globals[i] = g.init
+	}
+
+	in.table = make([]int64, m.tableMin)
+	for i := range in.table {
+		in.table[i] = -1
+	}
+	for _, e := range m.elements {
+		if uint64(e.offset)+uint64(len(e.funcs)) > uint64(len(in.table)) {
+			return nil, ErrTrap.New("element segment out of bounds")
+		}
+		for i, f := range e.funcs {
+			in.table[int(e.offset)+i] = int64(f)
+		}
+	}
+
+	if m.start >= 0 {
+		if _, err := in.call(uint32(m.start), nil); err != nil {
+			return nil, err
+		}
+	}
+	return in, nil
+}
+
+// Memory returns the memory of the instance, which is valid until its next call, since calls can grow it.
+func (in *Instance) Memory() []byte {
+	return in.memory
+}
+
+// Call calls the exported function with the name given with the arguments given, which are the bits of their values,
+// like the results returned: the bits of i32 and f32 values are the lower 32 bits.
+func (in *Instance) Call(name string, args ...uint64) ([]uint64, error) {
+	e, ok := in.m.exports[name]
+	if !ok || e.kind != exportFunc {
+		return nil, ErrNoFunction.New(name)
+	}
+	f := in.m.funcs[e.index]
+	if len(args) != len(f.typ.Params) {
+		return nil, ErrTrap.New(fmt.Sprintf("function %s takes %d arguments, but got %d", name, len(f.typ.Params), len(args)))
+	}
+	return in.call(e.index, args)
+}
+
+func (in *Instance) call(fi uint32, args []uint64) (results []uint64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case trap:
+				err = r.err
+			case error:
+				// Modules are only validated as far as needed to run them, so malformed ones can fail in other ways
+				err = ErrTrap.New(fmt.Sprintf("invalid module: %s", r))
+			default:
+				panic(r)
+			}
+			results = nil
+		}
+	}()
+
+	in.fuel = in.limits.MaxInstructions
+	in.depth = 0
+	in.stack = append(in.stack[:0], args...)
+	in.invoke(fi)
+	n := len(in.m.funcs[fi].typ.Results)
+	return append([]uint64(nil), in.stack[len(in.stack)-n:]...), nil
+}
+
+// invoke calls the function given with the arguments on the stack, which it replaces with its results.
+func (in *Instance) invoke(fi uint32) {
+	f := in.m.funcs[fi]
+	in.depth++
+	if in.depth > maxCallDepth {
+		trapf("call stack exhausted")
+	}
+
+	params := len(f.typ.Params)
+	base := len(in.stack) - params
+	locals := make([]uint64, params+len(f.locals))
+	copy(locals, in.stack[base:])
+	in.stack = in.stack[:base]
+	in.exec(f, locals)
+	in.depth--
+}
+
+// label is the target of the branches out of a block, or back to the start of a loop.
+type label struct {
+	pos    int
+	height int
+	arity  int
+	loop   bool
+}
+
+func (in *Instance) push(v uint64) {
+	in.stack = append(in.stack, v)
+}
+
+func (in *Instance) pop() uint64 {
+	v := in.stack[len(in.stack)-1]
+	in.stack = in.stack[:len(in.stack)-1]
+	return v
+}
+
+func (in *Instance) push32(v uint32) {
+	in.stack = append(in.stack, uint64(v))
+}
+
+func (in *Instance) pop32() uint32 {
+	return uint32(in.pop())
+}
+
+func (in *Instance) pushBool(b bool) {
+	if b {
+		in.push(1)
+	} else {
+		in.push(0)
+	}
+}
+
+func (in *Instance) pushF32(f float32) {
+	in.push32(math.Float32bits(f))
+}
+
+func (in *Instance) popF32() float32 {
+	return math.Float32frombits(in.pop32())
+}
+
+func (in *Instance) pushF64(f float64) {
+	in.push(math.Float64bits(f))
+}
+
+func (in *Instance) popF64() float64 {
+	return math.Float64frombits(in.pop())
+}
+
+// address returns the address of an access of the size given to the memory, trapping if it's out of bounds.
+func (in *Instance) address(r *reader, size uint64) uint64 {
+	r.u32()
+	offset := uint64(r.u32())
+	addr := uint64(in.pop32()) + offset
+	if addr+size > uint64(len(in.memory)) {
+		trapf("out of bounds memory access")
+	}
+	return addr
+}
+
+// charge uses the fuel of the instructions given.
+func (in *Instance) charge(n int64) {
+	if in.limits.MaxInstructions <= 0 {
+		return
+	}
+	in.fuel -= n
+	if in.fuel < 0 {
+		panic(trap{ErrInstructionLimit.New(in.limits.MaxInstructions)})
+	}
+}
+
+// exec runs the body of a function.
+func (in *Instance) exec(f *function, locals []uint64) {
+	r := &reader{data: f.body}
+	labels := []label{{pos: len(f.body), height: len(in.stack), arity: len(f.typ.Results)}}
+
+	// branch branches to the label of the depth given, returning whether it leaves the function
+	branch := func(depth uint32) bool {
+		i := len(labels) - 1 - int(depth)
+		l := labels[i]
+		copy(in.stack[l.height:], in.stack[len(in.stack)-l.arity:])
+		in.stack = in.stack[:l.height+l.arity]
+		if i == 0 {
+			return true
+		}
+		if l.loop {
+			labels = labels[:i+1]
+		} else {
+			labels = labels[:i]
+		}
+		r.pos = l.pos
+		return false
+	}
+
+	for {
+		in.charge(1)
+		if r.pos >= len(f.body) {
+			trapf("invalid module: function without an end")
+		}
+		pos := r.pos
+		op := r.byte()
+		switch op {
+		case opUnreachable:
+			trapf("unreachable")
+		case opNop:
+		case opBlock, opLoop:
+			b := f.blocks[pos]
+			l := label{pos: b.endPos, height: len(in.stack) - len(b.typ.Params), arity: len(b.typ.Results)}
+			if op == opLoop {
+				l = label{pos: b.bodyPos, height: l.height, arity: len(b.typ.Params), loop: true}
+			}
+			labels = append(labels, l)
+			r.pos = b.bodyPos
+		case opIf:
+			b := f.blocks[pos]
+			cond := in.pop32()
+			l := label{pos: b.endPos, height: len(in.stack) - len(b.typ.Params), arity: len(b.typ.Results)}
+			switch {
+			case cond != 0:
+				labels = append(labels, l)
+				r.pos = b.bodyPos
+			case b.elsePos >= 0:
+				labels = append(labels, l)
+				r.pos = b.elsePos
+			default:
+				r.pos = b.endPos
+			}
+		case opElse:
+			// The end of the instructions run when the condition of an if holds
+			l := labels[len(labels)-1]
+			labels = labels[:len(labels)-1]
+			r.pos = l.pos
+		case opEnd:
+			if len(labels) == 1 {
+				return
+			}
+			labels = labels[:len(labels)-1]
+		case opBr:
+			if branch(r.u32()) {
+				return
+			}
+		case opBrIf:
+			depth := r.u32()
+			if in.pop32() != 0 && branch(depth) {
+				return
+			}
+		case opBrTable:
+			n := r.u32()
+			i := in.pop32()
+			var depth uint32
+			for j := uint32(0); j <= n; j++ {
+				d := r.u32()
+				if j == i || j == n {
+					depth = d
+					break
+				}
+			}
+			if branch(depth) {
+				return
+			}
+		case opReturn:
+			branch(uint32(len(labels) - 1))
+			return
+		case opCall:
+			in.invoke(r.u32())
+		case opCallIndirect:
+			t := in.m.types[r.u32()]
+			r.byte()
+			i := in.pop32()
+			if uint64(i) >= uint64(len(in.table)) || in.table[i] < 0 {
+				trapf("undefined element")
+			}
+			fi := uint32(in.table[i])
+			if !sameType(in.m.funcs[fi].typ, t) {
+				trapf("indirect call type mismatch")
+			}
+			in.invoke(fi)
+		case opDrop:
+			in.pop()
+		case opSelect, opSelectTyped:
+			if op == opSelectTyped {
+				r.u32()
+				r.byte()
+			}
+			cond := in.pop32()
+			b := in.pop()
+			a := in.pop()
+			if cond != 0 {
+				in.push(a)
+			} else {
+				in.push(b)
+			}
+		case opLocalGet:
+			in.push(locals[r.u32()])
+		case opLocalSet:
+			locals[r.u32()] = in.pop()
+		case opLocalTee:
+			locals[r.u32()] = in.stack[len(in.stack)-1]
+		case opGlobalGet:
+			in.push(in.globals[r.u32()])
+		case opGlobalSet:
+			in.globals[r.u32()] = in.pop()
+
+		case opI32Load, opF32Load:
+			in.push32(binary.LittleEndian.Uint32(in.memory[in.address(r, 4):]))
+		case opI64Load, opF64Load:
+			in.push(binary.LittleEndian.Uint64(in.memory[in.address(r, 8):]))
+		case opI32Load8S:
+			in.push32(uint32(int32(int8(in.memory[in.address(r, 1)]))))
+		case opI32Load8U:
+			in.push32(uint32(in.memory[in.address(r, 1)]))
+		case opI32Load16S:
+			in.push32(uint32(int32(int16(binary.LittleEndian.Uint16(in.memory[in.address(r, 2):])))))
+		case opI32Load16U:
+			in.push32(uint32(binary.LittleEndian.Uint16(in.memory[in.address(r, 2):])))
+		case opI64Load8S:
+			in.push(uint64(int64(int8(in.memory[in.address(r, 1)]))))
+		case opI64Load8U:
+			in.push(uint64(in.memory[in.address(r, 1)]))
+		case opI64Load16S:
+			in.push(uint64(int64(int16(binary.LittleEndian.Uint16(in.memory[in.address(r, 2):])))))
+		case opI64Load16U:
+			in.push(uint64(binary.LittleEndian.Uint16(in.memory[in.address(r, 2):])))
+		case opI64Load32S:
+			in.push(uint64(int64(int32(binary.LittleEndian.Uint32(in.memory[in.address(r, 4):])))))
+		case opI64Load32U:
+			in.push(uint64(binary.LittleEndian.Uint32(in.memory[in.address(r, 4):])))
+		case opI32Store, opF32Store, opI64Store32:
+			v := in.pop()
+			binary.LittleEndian.PutUint32(in.memory[in.address(r, 4):], uint32(v))
+		case opI64Store, opF64Store:
+			v := in.pop()
+			binary.LittleEndian.PutUint64(in.memory[in.address(r, 8):], v)
+		case opI32Store8, opI64Store8:
+			v := in.pop()
+			in.memory[in.address(r, 1)] = byte(v)
+		case opI32Store16, opI64Store16:
+			v := in.pop()
+			binary.LittleEndian.PutUint16(in.memory[in.address(r, 2):], uint16(v))
+		case opMemorySize:
+			r.byte()
+			in.push32(uint32(len(in.memory) / PageSize))
+		case opMemoryGrow:
+			r.byte()
+			delta := uint64(in.pop32())
+			pages := uint64(len(in.memory) / PageSize)
+			if !in.m.hasMemory || pages+delta > uint64(in.maxPages) {
+				in.push32(math.MaxUint32)
+				break
+			}
+			in.charge(int64(delta))
+			in.memory = append(in.memory, make([]byte, int(delta)*PageSize)...)
+			in.push32(uint32(pages))
+
+		case opI32Const:
+			in.push32(uint32(r.s32()))
+		case opI64Const:
+			in.push(uint64(r.s64()))
+		case opF32Const:
+			in.push32(r.u32fixed())
+		case opF64Const:
+			in.push(r.u64fixed())
+
+		case opPrefixFC:
+			in.execFC(r)
+
+		default:
+			in.execNumeric(op)
+		}
+	}
+}
+
+func sameType(a, b FuncType) bool {
+	if len(a.Params) != len(b.Params) || len(a.Results) != len(b.Results) {
+		return false
+	}
+	for i := range a.Params {
+		if a.Params[i] != b.Params[i] {
+			return false
+		}
+	}
+	for i := range a.Results {
+		if a.Results[i] != b.Results[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// execFC runs the instructions prefixed with 0xfc.
+func (in *Instance) execFC(r *reader) {
+	switch sub := r.u32(); sub {
+	case fcMemoryCopy:
+		r.byte()
+		r.byte()
+		n, src, dst := uint64(in.pop32()), uint64(in.pop32()), uint64(in.pop32())
+		if src+n > uint64(len(in.memory)) || dst+n > uint64(len(in.memory)) {
+			trapf("out of bounds memory access")
+		}
+		in.charge(int64(n / 64))
+		copy(in.memory[dst:dst+n], in.memory[src:src+n])
+	case fcMemoryFill:
+		r.byte()
+		n, v, dst := uint64(in.pop32()), byte(in.pop32()), uint64(in.pop32())
+		if dst+n > uint64(len(in.memory)) {
+			trapf("out of bounds memory access")
+		}
+		in.charge(int64(n / 64))
+		for i := dst; i < dst+n; i++ {
+			in.memory[i] = v
+		}
+	default:
+		// The non-trapping conversions
+		var f float64
+		if sub%4 < 2 {
+			f = float64(in.popF32())
+		} else {
+			f = in.popF64()
+		}
+		signed := sub%2 == 0
+		if sub < 4 {
+			in.push32(uint32(truncSat(f, signed, 32)))
+		} else {
+			in.push(truncSat(f, signed, 64))
+		}
+	}
+}
+
+// truncSat truncates the float given to an integer of the size given, saturating it.
+func truncSat(f float64, signed bool, size uint) uint64 {
+	switch {
+	case math.IsNaN(f):
+		return 0
+	case signed:
+		min, max := -math.Ldexp(1, int(size)-1), math.Ldexp(1, int(size)-1)
+		if f < min {
+			return uint64(int64(-1) << (size - 1))
+		}
+		if f >= max {
+			return uint64(1)<<(size-1) - 1
+		}
+		return uint64(int64(f))
+	default:
+		if f <= -1 {
+			return 0
+		}
+		if f >= math.Ldexp(1, int(size)) {
+			return math.MaxUint64 >> (64 - size)
+		}
+		return uint64(f)
+	}
+}
+
+// trunc truncates the float given to an integer of the size given, trapping if it doesn't fit.
+func trunc(f float64, signed bool, size uint) uint64 {
+	if math.IsNaN(f) {
+		trapf("invalid conversion to integer")
+	}
+	t := math.Trunc(f)
+	if signed {
+		if t < -math.Ldexp(1, int(size)-1) || t >= math.Ldexp(1, int(size)-1) {
+			trapf("integer overflow")
+		}
+		return uint64(int64(t))
+	}
+	if t <= -1 || t >= math.Ldexp(1, int(size)) {
+		trapf("integer overflow")
+	}
+	return uint64(t)
+}
+
+// execNumeric runs the numeric instructions without immediates.
+func (in *Instance) execNumeric(op byte) {
+	switch {
+	case op == opI32Eqz:
+		in.pushBool(in.pop32() == 0)
+	case op >= opI32Eq && op <= opI32GeU:
+		b, a := in.pop32(), in.pop32()
+		in.pushBool(compareI32(op, a, b))
+	case op == opI64Eqz:
+		in.pushBool(in.pop() == 0)
+	case op >= opI64Eq && op <= opI64GeU:
+		b, a := in.pop(), in.pop()
+		in.pushBool(compareI64(op, a, b))
+	case op >= opF32Eq && op <= opF32Ge:
+		b, a := in.popF32(), in.popF32()
+		in.pushBool(compareFloat(op-opF32Eq, float64(a), float64(b)))
+	case op >= opF64Eq && op <= opF64Ge:
+		b, a := in.popF64(), in.popF64()
+		in.pushBool(compareFloat(op-opF64Eq, a, b))
+
+	case op == opI32Clz:
+		in.push32(uint32(bits.LeadingZeros32(in.pop32())))
+	case op == opI32Ctz:
+		in.push32(uint32(bits.TrailingZeros32(in.pop32())))
+	case op == opI32Popcnt:
+		in.push32(uint32(bits.OnesCount32(in.pop32())))
+	case op >= opI32Add && op <= opI32Rotr:
+		b, a := in.pop32(), in.pop32()
+		in.push32(binaryI32(op, a, b))
+	case op == opI64Clz:
+		in.push(uint64(bits.LeadingZeros64(in.pop())))
+	case op == opI64Ctz:
+		in.push(uint64(bits.TrailingZeros64(in.pop())))
+	case op == opI64Popcnt:
+		in.push(uint64(bits.OnesCount64(in.pop())))
+	case op >= opI64Add && op <= opI64Rotr:
+		b, a := in.pop(), in.pop()
+		in.push(binaryI64(op, a, b))
+
+	case op >= opF32Abs && op <= opF32Sqrt:
+		in.pushF32(float32(unaryFloat(op-opF32Abs, float64(in.popF32()), true)))
+	case op >= opF32Add && op <= opF32Copysign:
+		b, a := in.popF32(), in.popF32()
+		in.pushF32(binaryF32(op-opF32Add, a, b))
+	case op >= opF64Abs && op <= opF64Sqrt:
+		in.pushF64(unaryFloat(op-opF64Abs, in.popF64(), false))
+	case op >= opF64Add && op <= opF64Copysign:
+		b, a := in.popF64(), in.popF64()
+		in.pushF64(binaryF64(op-opF64Add, a, b))
+
+	case op >= opI32WrapI64 && op <= opF64ReinterpretI64:
+		in.convert(op)
+
+	case op == opI32Extend8S:
+		in.push32(uint32(int32(int8(in.pop32()))))
+	case op == opI32Extend16S:
+		in.push32(uint32(int32(int16(in.pop32()))))
+	case op == opI64Extend8S:
+		in.push(uint64(int64(int8(in.pop()))))
+	case op == opI64Extend16S:
+		in.push(uint64(int64(int16(in.pop()))))
+	case op == opI64Extend32S:
+		in.push(uint64(int64(int32(in.pop()))))
+
+	default:
+		trapf("invalid module: unknown instruction 0x%02x", op)
+	}
+}
+
+func compareI32(op byte, a, b uint32) bool {
+	switch op {
+	case opI32Eq:
+		return a == b
+	case opI32Ne:
+		return a != b
+	case opI32LtS:
+		return int32(a) < int32(b)
+	case opI32LtU:
+		return a < b
+	case opI32GtS:
+		return int32(a) > int32(b)
+	case opI32GtU:
+		return a > b
+	case opI32LeS:
+		return int32(a) <= int32(b)
+	case opI32LeU:
+		return a <= b
+	case opI32GeS:
+		return int32(a) >= int32(b)
+	default:
+		return a >= b
+	}
+}
+
+func compareI64(op byte, a, b uint64) bool {
+	switch op {
+	case opI64Eq:
+		return a == b
+	case opI64Ne:
+		return a != b
+	case opI64LtS:
+		return int64(a) < int64(b)
+	case opI64LtU:
+		return a < b
+	case opI64GtS:
+		return int64(a) > int64(b)
+	case opI64GtU:
+		return a > b
+	case opI64LeS:
+		return int64(a) <= int64(b)
+	case opI64LeU:
+		return a <= b
+	case opI64GeS:
+		return int64(a) >= int64(b)
+	default:
+		return a >= b
+	}
+}
+
+// compareFloat compares floats, with the offset of the comparison from eq.
+func compareFloat(op byte, a, b float64) bool {
+	switch op {
+	case 0:
+		return a == b
+	case 1:
+		return a != b
+	case 2:
+		return a < b
+	case 3:
+		return a > b
+	case 4:
+		return a <= b
+	default:
+		return a >= b
+	}
+}
+
+func binaryI32(op byte, a, b uint32) uint32 {
+	switch op {
+	case opI32Add:
+		return a + b
+	case opI32Sub:
+		return a - b
+	case opI32Mul:
+		return a * b
+	case opI32DivS:
+		if b == 0 {
+			trapf("integer divide by zero")
+		}
+		if int32(a) == math.MinInt32 && int32(b) == -1 {
+			trapf("integer overflow")
+		}
+		return uint32(int32(a) / int32(b))
+	case opI32DivU:
+		if b == 0 {
+			trapf("integer divide by zero")
+		}
+		return a / b
+	case opI32RemS:
+		if b == 0 {
+			trapf("integer divide by zero")
+		}
+		if int32(b) == -1 {
+			return 0
+		}
+		return uint32(int32(a) % int32(b))
+	case opI32RemU:
+		if b == 0 {
+			trapf("integer divide by zero")
+		}
+		return a % b
+	case opI32And:
+		return a & b
+	case opI32Or:
+		return a | b
+	case opI32Xor:
+		return a ^ b
+	case opI32Shl:
+		return a << (b % 32)
+	case opI32ShrS:
+		return uint32(int32(a) >> (b % 32))
+	case opI32ShrU:
+		return a >> (b % 32)
+	case opI32Rotl:
+		return bits.RotateLeft32(a, int(b%32))
+	default:
+		return bits.RotateLeft32(a, -int(b%32))
+	}
+}
+
+func binaryI64(op byte, a, b uint64) uint64 {
+	switch op {
+	case opI64Add:
+		return a + b
+	case opI64Sub:
+		return a - b
+	case opI64Mul:
+		return a * b
+	case opI64DivS:
+		if b == 0 {
+			trapf("integer divide by zero")
+		}
+		if int64(a) == math.MinInt64 && int64(b) == -1 {
+			trapf("integer overflow")
+		}
+		return uint64(int64(a) / int64(b))
+	case opI64DivU:
+		if b == 0 {
+			trapf("integer divide by zero")
+		}
+		return a / b
+	case opI64RemS:
+		if b == 0 {
+			trapf("integer divide by zero")
+		}
+		if int64(b) == -1 {
+			return 0
+		}
+		return uint64(int64(a) % int64(b))
+	case opI64RemU:
+		if b == 0 {
+			trapf("integer divide by zero")
+		}
+		return a % b
+	case opI64And:
+		return a & b
+	case opI64Or:
+		return a | b
+	case opI64Xor:
+		return a ^ b
+	case opI64Shl:
+		return a << (b % 64)
+	case opI64ShrS:
+		return uint64(int64(a) >> (b % 64))
+	case opI64ShrU:
+		return a >> (b % 64)
+	case opI64Rotl:
+		return bits.RotateLeft64(a, int(b%64))
+	default:
+		return bits.RotateLeft64(a, -int(b%64))
+	}
+}
+
+// unaryFloat runs the unary float operations, with the offset of the operation from abs. The operations are exact on
+// float32 values converted to float64, but for sqrt, which is rounded to a float32 once.
+func unaryFloat(op byte, f float64, single bool) float64 {
+	switch op {
+	case 0:
+		return math.Abs(f)
+	case 1:
+		return -f
+	case 2:
+		return math.Ceil(f)
+	case 3:
+		return math.Floor(f)
+	case 4:
+		return math.Trunc(f)
+	case 5:
+		return math.RoundToEven(f)
+	default:
+		if single {
+			return float64(float32(math.Sqrt(f)))
+		}
+		return math.Sqrt(f)
+	}
+}
+
+// binaryF32 runs the binary float32 operations, with the offset of the operation from add.
+func binaryF32(op byte, a, b float32) float32 {
+	switch op {
+	case 0:
+		return a + b
+	case 1:
+		return a - b
+	case 2:
+		return a * b
+	case 3:
+		return a / b
+	case 4:
+		return float32(math.Min(float64(a), float64(b)))
+	case 5:
+		return float32(math.Max(float64(a), float64(b)))
+	default:
+		return float32(math.Copysign(float64(a), float64(b)))
+	}
+}
+
+// binaryF64 runs the binary float64 operations, with the offset of the operation from add.
+func binaryF64(op byte, a, b float64) float64 {
+	switch op {
+	case 0:
+		return a + b
+	case 1:
+		return a - b
+	case 2:
+		return a * b
+	case 3:
+		return a / b
+	case 4:
+		return math.Min(a, b)
+	case 5:
+		return math.Max(a, b)
+	default:
+		return math.Copysign(a, b)
+	}
+}
+
+// convert runs the conversions between types.
+func (in *Instance) convert(op byte) {
+	switch op {
+	case opI32WrapI64:
+		in.push32(uint32(in.pop()))
+	case opI32TruncF32S, opI32TruncF32U:
+		in.push32(uint32(trunc(float64(in.popF32()), op == opI32TruncF32S, 32)))
+	case opI32TruncF64S, opI32TruncF64U:
+		in.push32(uint32(trunc(in.popF64(), op == opI32TruncF64S, 32)))
+	case opI64ExtendI32S:
+		in.push(uint64(int64(int32(in.pop32()))))
+	case opI64ExtendI32U:
+		in.push(uint64(in.pop32()))
+	case opI64TruncF32S, opI64TruncF32U:
+		in.push(trunc(float64(in.popF32()), op == opI64TruncF32S, 64))
+	case opI64TruncF64S, opI64TruncF64U:
+		in.push(trunc(in.popF64(), op == opI64TruncF64S, 64))
+	case opF32ConvertI32S:
+		in.pushF32(float32(int32(in.pop32())))
+	case opF32ConvertI32U:
+		in.pushF32(float32(in.pop32()))
+	case opF32ConvertI64S:
+		in.pushF32(float32(int64(in.pop())))
+	case opF32ConvertI64U:
+		in.pushF32(float32(in.pop()))
+	case opF32DemoteF64:
+		in.pushF32(float32(in.popF64()))
+	case opF64ConvertI32S:
+		in.pushF64(float64(int32(in.pop32())))
+	case opF64ConvertI32U:
+		in.pushF64(float64(in.pop32()))
+	case opF64ConvertI64S:
+		in.pushF64(float64(int64(in.pop())))
+	case opF64ConvertI64U:
+		in.pushF64(float64(in.pop()))
+	case opF64PromoteF32:
+		in.pushF64(float64(in.popF32()))
+	default:
+		// Reinterpretations keep the bits of values
+	}
+}
@@ -0,0 +1,719 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wasmvm is a sandboxed interpreter of WebAssembly modules. Modules can't import anything, so the only effects
+// of their functions are on their own memory and globals, and calls are bounded by the number of instructions they
+// execute and the memory they grow to.
+package wasmvm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+var (
+	// ErrInvalidModule is returned when a module can't be decoded.
+	ErrInvalidModule = errors.NewKind("invalid WebAssembly module: %s")
+	// ErrUnsupported is returned when a module uses a feature the interpreter doesn't support.
+	ErrUnsupported = errors.NewKind("unsupported WebAssembly module: %s")
+)
+
+// ValueType is the type of a WebAssembly value.
+type ValueType byte
+
+const (
+	I32 ValueType = 0x7f
+	I64 ValueType = 0x7e
+	F32 ValueType = 0x7d
+	F64 ValueType = 0x7c
+)
+
+func (t ValueType) String() string {
+	switch t {
+	case I32:
+		return "i32"
+	case I64:
+		return "i64"
+	case F32:
+		return "f32"
+	case F64:
+		return "f64"
+	default:
+		return fmt.Sprintf("type(0x%x)", byte(t))
+	}
+}
+
+// FuncType is the type of a function.
+type FuncType struct {
+	Params  []ValueType
+	Results []ValueType
+}
+
+func (t FuncType) String() string {
+	return fmt.Sprintf("%v -> %v", t.Params, t.Results)
+}
+
+// PageSize is the size of a page of memory.
+const PageSize = 64 << 10
+
+// maxPages is the maximum number of pages of the memory of 32-bit modules.
+const maxPages = 1 << 16
+
+// function is a function defined by a module.
+type function struct {
+	typ    FuncType
+	locals []ValueType
+	body   []byte
+	// blocks are the ends, and the else of ifs, of the blocks starting at each position of the body
+	blocks map[int]block
+}
+
+// block is the structure of a block, loop or if.
+type block struct {
+	typ FuncType
+	// bodyPos is the position of the first instruction of the block
+	bodyPos int
+	// elsePos is the position after the else of an if, or -1
+	elsePos int
+	// endPos is the position after the end of the block
+	endPos int
+}
+
+type global struct {
+	typ     ValueType
+	mutable bool
+	init    uint64
+}
+
+type dataSegment struct {
+	offset uint32
+	data   []byte
+}
+
+type elementSegment struct {
+	offset uint32
+	funcs  []uint32
+}
+
+// Module is a decoded WebAssembly module, which can be instantiated any number of times.
+type Module struct {
+	types     []FuncType
+	funcs     []*function
+	tableMin  uint32
+	hasTable  bool
+	hasMemory bool
+	memMin    uint32
+	memMax    uint32
+	hasMemMax bool
+	globals   []global
+	exports   map[string]export
+	start     int
+	data      []dataSegment
+	elements  []elementSegment
+}
+
+type exportKind byte
+
+const (
+	exportFunc   exportKind = 0
+	exportTable  exportKind = 1
+	exportMemory exportKind = 2
+	exportGlobal exportKind = 3
+)
+
+type export struct {
+	kind  exportKind
+	index uint32
+}
+
+// Decode decodes the binary module given.
+func Decode(bin []byte) (*Module, error) {
+	if len(bin) < 8 || !bytes.Equal(bin[:4], []byte("\x00asm")) {
+		return nil, ErrInvalidModule.New("missing magic number")
+	}
+	if binary.LittleEndian.Uint32(bin[4:8]) != 1 {
+		return nil, ErrUnsupported.New("only version 1 of the binary format is supported")
+	}
+
+	m := &Module{exports: make(map[string]export), start: -1}
+	var funcTypes []uint32
+	r := &reader{data: bin, pos: 8}
+	for !r.done() {
+		id := r.byte()
+		size := r.u32()
+		if r.err != nil {
+			break
+		}
+		if int(size) > len(r.data)-r.pos {
+			return nil, ErrInvalidModule.New("section exceeds the module")
+		}
+		s := &reader{data: r.data[:r.pos+int(size)], pos: r.pos}
+		r.pos += int(size)
+
+		var err error
+		switch id {
+		case 0:
+			// Custom sections, such as names, don't change what modules do
+		case 1:
+			err = m.decodeTypes(s)
+		case 2:
+			return nil, ErrUnsupported.New("modules can't import anything")
+		case 3:
+			n := s.u32()
+			for i := uint32(0); i < n && s.err == nil; i++ {
+				funcTypes = append(funcTypes, s.u32())
+			}
+		case 4:
+			err = m.decodeTable(s)
+		case 5:
+			err = m.decodeMemory(s)
+		case 6:
+			err = m.decodeGlobals(s)
+		case 7:
+			err = m.decodeExports(s)
+		case 8:
+			m.start = int(s.u32())
+		case 9:
+			err = m.decodeElements(s)
+		case 10:
+			err = m.decodeCode(s, funcTypes)
+		case 11:
+			err = m.decodeData(s)
+		case 12:
+			// The data count section is only needed to validate modules in a single pass
+		default:
+			return nil, ErrInvalidModule.New(fmt.Sprintf("unknown section %d", id))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if s.err != nil {
+			return nil, s.err
+		}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if len(m.funcs) != len(funcTypes) {
+		return nil, ErrInvalidModule.New("function and code sections don't match")
+	}
+	for _, e := range m.exports {
+		if e.kind == exportFunc && int(e.index) >= len(m.funcs) {
+			return nil, ErrInvalidModule.New("export of an unknown function")
+		}
+	}
+	if m.start >= len(m.funcs) {
+		return nil, ErrInvalidModule.New("unknown start function")
+	}
+	for _, e := range m.elements {
+		for _, f := range e.funcs {
+			if int(f) >= len(m.funcs) {
+				return nil, ErrInvalidModule.New("element of an unknown function")
+			}
+		}
+	}
+	return m, nil
+}
+
+// Func returns the type of the exported function with the name given.
+func (m *Module) Func(name string) (FuncType, bool) {
+	e, ok := m.exports[name]
+	if !ok || e.kind != exportFunc {
+		return FuncType{}, false
+	}
+	return m.funcs[e.index].typ, true
+}
+
+// HasMemory returns whether the module exports its memory with the name given.
+func (m *Module) HasMemory(name string) bool {
+	e, ok := m.exports[name]
+	return ok && e.kind == exportMemory
+}
+
+func (m *Module) decodeTypes(r *reader) error {
+	n := r.u32()
+	for i := uint32(0); i < n && r.err == nil; i++ {
+		if r.byte() != 0x60 {
+			return ErrInvalidModule.New("malformed function type")
+		}
+		var t FuncType
+		var err error
+		if t.Params, err = r.valueTypes(); err != nil {
+			return err
+		}
+		if t.Results, err = r.valueTypes(); err != nil {
+			return err
+		}
+		m.types = append(m.types, t)
+	}
+	return nil
+}
+
+func (m *Module) decodeTable(r *reader) error {
+	n := r.u32()
+	if n > 1 {
+		return ErrUnsupported.New("multiple tables")
+	}
+	if n == 1 {
+		if r.byte() != 0x70 {
+			return ErrUnsupported.New("tables of references other than functions")
+		}
+		m.hasTable = true
+		m.tableMin, _, _ = r.limits()
+	}
+	return nil
+}
+
+func (m *Module) decodeMemory(r *reader) error {
+	n := r.u32()
+	if n > 1 {
+		return ErrUnsupported.New("multiple memories")
+	}
+	if n == 1 {
+		m.hasMemory = true
+		m.memMin, m.memMax, m.hasMemMax = r.limits()
+		if m.memMin > maxPages || (m.hasMemMax && (m.memMax > maxPages || m.memMax < m.memMin)) {
+			return ErrInvalidModule.New("invalid memory limits")
+		}
+	}
+	return nil
+}
+
+func (m *Module) decodeGlobals(r *reader) error {
+	n := r.u32()
+	for i := uint32(0); i < n && r.err == nil; i++ {
+		g := global{typ: ValueType(r.byte())}
+		g.mutable = r.byte() == 1
+		v, err := m.constExpr(r)
+		if err != nil {
+			return err
+		}
+		g.init = v
+		m.globals = append(m.globals, g)
+	}
+	return nil
+}
+
+func (m *Module) decodeExports(r *reader) error {
+	n := r.u32()
+	for i := uint32(0); i < n && r.err == nil; i++ {
+		name := r.name()
+		kind := exportKind(r.byte())
+		index := r.u32()
+		if kind > exportGlobal {
+			return ErrInvalidModule.New("unknown export kind")
+		}
+		m.exports[name] = export{kind: kind, index: index}
+	}
+	return nil
+}
+
+func (m *Module) decodeElements(r *reader) error {
+	n := r.u32()
+	for i := uint32(0); i < n && r.err == nil; i++ {
+		if flags := r.u32(); flags != 0 {
+			return ErrUnsupported.New("passive or declarative element segments")
+		}
+		offset, err := m.constExpr(r)
+		if err != nil {
+			return err
+		}
+		e := elementSegment{offset: uint32(offset)}
+		count := r.u32()
+		for j := uint32(0); j < count && r.err == nil; j++ {
+			e.funcs = append(e.funcs, r.u32())
+		}
+		m.elements = append(m.elements, e)
+	}
+	return nil
+}
+
+func (m *Module) decodeData(r *reader) error {
+	n := r.u32()
+	for i := uint32(0); i < n && r.err == nil; i++ {
+		switch flags := r.u32(); flags {
+		case 0:
+		case 2:
+			if r.u32() != 0 {
+				return ErrUnsupported.New("multiple memories")
+			}
+		default:
+			return ErrUnsupported.New("passive data segments")
+		}
+		offset, err := m.constExpr(r)
+		if err != nil {
+			return err
+		}
+		size := r.u32()
+		m.data = append(m.data, dataSegment{offset: uint32(offset), data: r.bytes(int(size))})
+	}
+	return nil
+}
+
+func (m *Module) decodeCode(r *reader, funcTypes []uint32) error {
+	n := r.u32()
+	if int(n) != len(funcTypes) {
+		return ErrInvalidModule.New("function and code sections don't match")
+	}
+	for i := uint32(0); i < n && r.err == nil; i++ {
+		size := r.u32()
+		if r.err != nil || int(size) > len(r.data)-r.pos {
+			return ErrInvalidModule.New("function body exceeds the section")
+		}
+		body := &reader{data: r.data[:r.pos+int(size)], pos: r.pos}
+		r.pos += int(size)
+
+		if int(funcTypes[i]) >= len(m.types) {
+			return ErrInvalidModule.New("function of an unknown type")
+		}
+		f := &function{typ: m.types[funcTypes[i]]}
+		groups := body.u32()
+		for j := uint32(0); j < groups && body.err == nil; j++ {
+			count := body.u32()
+			t := ValueType(body.byte())
+			if uint64(len(f.locals))+uint64(count) > 50000 {
+				return ErrUnsupported.New("too many locals")
+			}
+			for k := uint32(0); k < count; k++ {
+				f.locals = append(f.locals, t)
+			}
+		}
+		if body.err != nil {
+			return body.err
+		}
+		f.body = body.data[body.pos:]
+		if err := m.decodeBlocks(f); err != nil {
+			return err
+		}
+		m.funcs = append(m.funcs, f)
+	}
+	return nil
+}
+
+// blockType decodes the type of a block.
+func (m *Module) blockType(r *reader) (FuncType, error) {
+	b := r.peek()
+	switch {
+	case b == 0x40:
+		r.pos++
+		return FuncType{}, nil
+	case b == byte(I32) || b == byte(I64) || b == byte(F32) || b == byte(F64):
+		r.pos++
+		return FuncType{Results: []ValueType{ValueType(b)}}, nil
+	default:
+		i := r.s64()
+		if i < 0 || int(i) >= len(m.types) {
+			return FuncType{}, ErrInvalidModule.New("block of an unknown type")
+		}
+		return m.types[i], nil
+	}
+}
+
+// decodeBlocks finds the ends of the blocks of the body of a function, checking that all its instructions are
+// supported.
+func (m *Module) decodeBlocks(f *function) error {
+	f.blocks = make(map[int]block)
+	r := &reader{data: f.body}
+	var open []int
+	for !r.done() {
+		pos := r.pos
+		op := r.byte()
+		switch op {
+		case opBlock, opLoop, opIf:
+			t, err := m.blockType(r)
+			if err != nil {
+				return err
+			}
+			f.blocks[pos] = block{typ: t, bodyPos: r.pos, elsePos: -1}
+			open = append(open, pos)
+		case opElse:
+			if len(open) == 0 {
+				return ErrInvalidModule.New("else outside of an if")
+			}
+			b := f.blocks[open[len(open)-1]]
+			b.elsePos = r.pos
+			f.blocks[open[len(open)-1]] = b
+		case opEnd:
+			if len(open) == 0 {
+				if !r.done() {
+					return ErrInvalidModule.New("instructions after the end of a function")
+				}
+				return nil
+			}
+			b := f.blocks[open[len(open)-1]]
+			b.endPos = r.pos
+			f.blocks[open[len(open)-1]] = b
+			open = open[:len(open)-1]
+		default:
+			if err := skipImmediates(r, op); err != nil {
+				return err
+			}
+		}
+		if r.err != nil {
+			return r.err
+		}
+	}
+	return ErrInvalidModule.New("function without an end")
+}
+
+// constExpr evaluates a constant expression.
+func (m *Module) constExpr(r *reader) (uint64, error) {
+	var v uint64
+	switch r.byte() {
+	case opI32Const:
+		v = uint64(uint32(r.s32()))
+	case opI64Const:
+		v = uint64(r.s64())
+	case opF32Const:
+		v = uint64(r.u32fixed())
+	case opF64Const:
+		v = r.u64fixed()
+	case opGlobalGet:
+		i := r.u32()
+		if int(i) >= len(m.globals) {
+			return 0, ErrInvalidModule.New("constant expression of an unknown global")
+		}
+		v = m.globals[i].init
+	default:
+		return 0, ErrUnsupported.New("constant expression")
+	}
+	if r.byte() != opEnd {
+		return 0, ErrUnsupported.New("constant expression")
+	}
+	return v, r.err
+}
+
+// skipImmediates skips the immediates of the instruction given, failing for unsupported instructions.
+func skipImmediates(r *reader, op byte) error {
+	switch {
+	case op == opUnreachable, op == opNop, op == opReturn, op == opDrop, op == opSelect:
+	case op == opSelectTyped:
+		if r.u32() != 1 {
+			return ErrInvalidModule.New("select of several values")
+		}
+		r.byte()
+	case op == opBr, op == opBrIf, op == opCall:
+		r.u32()
+	case op == opBrTable:
+		n := r.u32()
+		for i := uint32(0); i <= n && r.err == nil; i++ {
+			r.u32()
+		}
+	case op == opCallIndirect:
+		r.u32()
+		if r.byte() != 0 {
+			return ErrUnsupported.New("multiple tables")
+		}
+	case op >= opLocalGet && op <= opGlobalSet:
+		r.u32()
+	case op >= opI32Load && op <= opI64Store32:
+		r.u32()
+		r.u32()
+	case op == opMemorySize, op == opMemoryGrow:
+		r.byte()
+	case op == opI32Const:
+		r.s32()
+	case op == opI64Const:
+		r.s64()
+	case op == opF32Const:
+		r.u32fixed()
+	case op == opF64Const:
+		r.u64fixed()
+	case op >= opI32Eqz && op <= opI64Extend32S:
+	case op == opPrefixFC:
+		switch sub := r.u32(); {
+		case sub <= 7:
+		case sub == fcMemoryCopy:
+			r.byte()
+			r.byte()
+		case sub == fcMemoryFill:
+			r.byte()
+		default:
+			return ErrUnsupported.New(fmt.Sprintf("instruction 0xfc %d", sub))
+		}
+	default:
+		return ErrUnsupported.New(fmt.Sprintf("instruction 0x%02x", op))
+	}
+	return nil
+}
+
+// reader reads the binary format of modules. Errors are sticky: once reading fails, all reads return zero values.
+type reader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *reader) done() bool {
+	return r.err != nil || r.pos >= len(r.data)
+}
+
+func (r *reader) fail() {
+	if r.err == nil {
+		r.err = ErrInvalidModule.New("unexpected end")
+	}
+	r.pos = len(r.data)
+}
+
+func (r *reader) peek() byte {
+	if r.pos >= len(r.data) {
+		return 0
+	}
+	return r.data[r.pos]
+}
+
+func (r *reader) byte() byte {
+	if r.pos >= len(r.data) {
+		r.fail()
+		return 0
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *reader) bytes(n int) []byte {
+	if n < 0 || n > len(r.data)-r.pos {
+		r.fail()
+		return nil
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *reader) u32() uint32 {
+	v, n := readULEB(r.data[r.pos:], 32)
+	if n <= 0 {
+		r.fail()
+		return 0
+	}
+	r.pos += n
+	return uint32(v)
+}
+
+func (r *reader) s32() int32 {
+	v, n := readSLEB(r.data[r.pos:], 32)
+	if n <= 0 {
+		r.fail()
+		return 0
+	}
+	r.pos += n
+	return int32(v)
+}
+
+func (r *reader) s64() int64 {
+	v, n := readSLEB(r.data[r.pos:], 64)
+	if n <= 0 {
+		r.fail()
+		return 0
+	}
+	r.pos += n
+	return v
+}
+
+func (r *reader) u32fixed() uint32 {
+	b := r.bytes(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+func (r *reader) u64fixed() uint64 {
+	b := r.bytes(8)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b)
+}
+
+func (r *reader) name() string {
+	return string(r.bytes(int(r.u32())))
+}
+
+func (r *reader) limits() (min, max uint32, hasMax bool) {
+	switch r.byte() {
+	case 0:
+		return r.u32(), 0, false
+	case 1:
+		return r.u32(), r.u32(), true
+	default:
+		r.fail()
+		return 0, 0, false
+	}
+}
+
+func (r *reader) valueTypes() ([]ValueType, error) {
+	n := r.u32()
+	if n > 1000 {
+		return nil, ErrUnsupported.New("too many values")
+	}
+	types := make([]ValueType, n)
+	for i := range types {
+		types[i] = ValueType(r.byte())
+		switch types[i] {
+		case I32, I64, F32, F64:
+		default:
+			if r.err == nil {
+				return nil, ErrUnsupported.New(fmt.Sprintf("value type 0x%x", byte(types[i])))
+			}
+		}
+	}
+	return types, nil
+}
+
+// readULEB reads an unsigned LEB128 number of the size given, returning the number of bytes read, or 0 if the number
+// is malformed.
+func readULEB(b []byte, size uint) (uint64, int) {
+	var v uint64
+	var shift uint
+	for i := 0; i < len(b) && i < int((size+6)/7); i++ {
+		v |= uint64(b[i]&0x7f) << shift
+		if b[i]&0x80 == 0 {
+			if size < 64 && v >= 1<<size {
+				return 0, 0
+			}
+			return v, i + 1
+		}
+		shift += 7
+	}
+	return 0, 0
+}
+
+// readSLEB reads a signed LEB128 number of the size given, returning the number of bytes read, or 0 if the number is
+// malformed.
+func readSLEB(b []byte, size uint) (int64, int) {
+	var v int64
+	var shift uint
+	for i := 0; i < len(b) && i < int((size+6)/7); i++ {
+		v |= int64(b[i]&0x7f) << shift
+		shift += 7
+		if b[i]&0x80 == 0 {
+			if shift < 64 && b[i]&0x40 != 0 {
+				v |= -1 << shift
+			}
+			if size < 64 && (v < math.MinInt32 || v > math.MaxInt32) {
+				return 0, 0
+			}
+			return v, i + 1
+		}
+	}
+	return 0, 0
+}
@@ -0,0 +1,460 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasmvm
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// The tests in this file follow the assertions of the WebAssembly spec tests (assert_return, assert_trap and
+// assert_malformed / assert_invalid) for the behavior the interpreter has to get right to be safe to run untrusted
+// modules: traps, bounds checks of memory and tables, and rejecting malformed modules without panicking.
+
+var header = []byte("\x00asm\x01\x00\x00\x00")
+
+// funcModule is a module exporting the function f of the type given, without locals, with the instructions given as
+// its body, and a memory of one page if memory is true.
+func funcModule(typ FuncType, memory bool, instrs ...byte) []byte {
+	types := []byte{1, 0x60, byte(len(typ.Params))}
+	for _, t := range typ.Params {
+		types = append(types, byte(t))
+	}
+	types = append(types, byte(len(typ.Results)))
+	for _, t := range typ.Results {
+		types = append(types, byte(t))
+	}
+
+	bin := append([]byte(nil), header...)
+	bin = append(bin, section(1, types...)...)
+	bin = append(bin, section(3, 1, 0)...)
+	if memory {
+		bin = append(bin, section(5, 1, 0, 1)...)
+	}
+	bin = append(bin, section(7, 1, 1, 'f', 0, 0)...)
+	body := append([]byte{0}, instrs...)
+	body = append(body, opEnd)
+	return append(bin, section(10, append([]byte{1, byte(len(body))}, body...)...)...)
+}
+
+// uleb encodes an unsigned LEB128 number.
+func uleb(v uint64) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+// sleb encodes a signed LEB128 number.
+func sleb(v int64) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+// memarg encodes the alignment and offset of a memory access.
+func memarg(align, offset uint32) []byte {
+	return append(uleb(uint64(align)), uleb(uint64(offset))...)
+}
+
+func concat(parts ...[]byte) []byte {
+	var b []byte
+	for _, p := range parts {
+		b = append(b, p...)
+	}
+	return b
+}
+
+var (
+	i32Binary = FuncType{Params: []ValueType{I32, I32}, Results: []ValueType{I32}}
+	i64Binary = FuncType{Params: []ValueType{I64, I64}, Results: []ValueType{I64}}
+	f64ToI32  = FuncType{Params: []ValueType{F64}, Results: []ValueType{I32}}
+	f64ToI64  = FuncType{Params: []ValueType{F64}, Results: []ValueType{I64}}
+	i32ToI32  = FuncType{Params: []ValueType{I32}, Results: []ValueType{I32}}
+	i32ToNone = FuncType{Params: []ValueType{I32}}
+	i32x2     = FuncType{Params: []ValueType{I32, I32}}
+	i32x3     = FuncType{Params: []ValueType{I32, I32, I32}}
+	noneToI32 = FuncType{Results: []ValueType{I32}}
+)
+
+const (
+	minI32 = uint64(1 << 31)
+	negOne = uint64(math.MaxUint32)
+	minI64 = uint64(1 << 63)
+)
+
+func f64(f float64) uint64 {
+	return math.Float64bits(f)
+}
+
+func TestTraps(t *testing.T) {
+	binaryOp := func(op byte) []byte {
+		return []byte{opLocalGet, 0, opLocalGet, 1, op}
+	}
+	unaryOp := func(ops ...byte) []byte {
+		return append([]byte{opLocalGet, 0}, ops...)
+	}
+	load := func(op byte, offset uint32) []byte {
+		return concat([]byte{opLocalGet, 0, op}, memarg(0, offset))
+	}
+	store := func(op byte, offset uint32) []byte {
+		return concat([]byte{opLocalGet, 0, opLocalGet, 1, op}, memarg(0, offset))
+	}
+
+	testCases := []struct {
+		name     string
+		typ      FuncType
+		memory   bool
+		body     []byte
+		args     []uint64
+		expected []uint64
+		trap     string
+	}{
+		{name: "unreachable", typ: FuncType{}, body: []byte{opUnreachable}, trap: "unreachable"},
+		{name: "unreachable after a branch", typ: noneToI32, body: []byte{opBlock, 0x40, opBr, 0, opUnreachable, opEnd, opI32Const, 7}, expected: []uint64{7}},
+
+		{name: "i32.div_s by zero", typ: i32Binary, body: binaryOp(opI32DivS), args: []uint64{1, 0}, trap: "integer divide by zero"},
+		{name: "i32.div_s overflow", typ: i32Binary, body: binaryOp(opI32DivS), args: []uint64{minI32, negOne}, trap: "integer overflow"},
+		{name: "i32.div_s", typ: i32Binary, body: binaryOp(opI32DivS), args: []uint64{negOne - 6, 2}, expected: []uint64{negOne - 2}},
+		{name: "i32.div_u by zero", typ: i32Binary, body: binaryOp(opI32DivU), args: []uint64{1, 0}, trap: "integer divide by zero"},
+		{name: "i32.div_u", typ: i32Binary, body: binaryOp(opI32DivU), args: []uint64{minI32, negOne}, expected: []uint64{0}},
+		{name: "i32.rem_s by zero", typ: i32Binary, body: binaryOp(opI32RemS), args: []uint64{1, 0}, trap: "integer divide by zero"},
+		{name: "i32.rem_s of the minimum by -1", typ: i32Binary, body: binaryOp(opI32RemS), args: []uint64{minI32, negOne}, expected: []uint64{0}},
+		{name: "i32.rem_s", typ: i32Binary, body: binaryOp(opI32RemS), args: []uint64{negOne - 6, 2}, expected: []uint64{negOne}},
+		{name: "i32.rem_u by zero", typ: i32Binary, body: binaryOp(opI32RemU), args: []uint64{1, 0}, trap: "integer divide by zero"},
+		{name: "i64.div_s by zero", typ: i64Binary, body: binaryOp(opI64DivS), args: []uint64{1, 0}, trap: "integer divide by zero"},
+		{name: "i64.div_s overflow", typ: i64Binary, body: binaryOp(opI64DivS), args: []uint64{minI64, math.MaxUint64}, trap: "integer overflow"},
+		{name: "i64.div_u by zero", typ: i64Binary, body: binaryOp(opI64DivU), args: []uint64{1, 0}, trap: "integer divide by zero"},
+		{name: "i64.rem_s of the minimum by -1", typ: i64Binary, body: binaryOp(opI64RemS), args: []uint64{minI64, math.MaxUint64}, expected: []uint64{0}},
+		{name: "i64.rem_u by zero", typ: i64Binary, body: binaryOp(opI64RemU), args: []uint64{1, 0}, trap: "integer divide by zero"},
+
+		{name: "i32.trunc_f64_s of NaN", typ: f64ToI32, body: unaryOp(0xaa), args: []uint64{f64(math.NaN())}, trap: "invalid conversion to integer"},
+		{name: "i32.trunc_f64_s of 2^31", typ: f64ToI32, body: unaryOp(0xaa), args: []uint64{f64(1 << 31)}, trap: "integer overflow"},
+		{name: "i32.trunc_f64_s below 2^31", typ: f64ToI32, body: unaryOp(0xaa), args: []uint64{f64(2147483647.9)}, expected: []uint64{math.MaxInt32}},
+		{name: "i32.trunc_f64_s of -2^31", typ: f64ToI32, body: unaryOp(0xaa), args: []uint64{f64(-2147483648.9)}, expected: []uint64{minI32}},
+		{name: "i32.trunc_f64_s below -2^31", typ: f64ToI32, body: unaryOp(0xaa), args: []uint64{f64(-2147483649)}, trap: "integer overflow"},
+		{name: "i32.trunc_f64_u of -1", typ: f64ToI32, body: unaryOp(0xab), args: []uint64{f64(-1)}, trap: "integer overflow"},
+		{name: "i32.trunc_f64_u above -1", typ: f64ToI32, body: unaryOp(0xab), args: []uint64{f64(-0.9)}, expected: []uint64{0}},
+		{name: "i32.trunc_f64_u of 2^32", typ: f64ToI32, body: unaryOp(0xab), args: []uint64{f64(1 << 32)}, trap: "integer overflow"},
+		{name: "i64.trunc_f64_s of 2^63", typ: f64ToI64, body: unaryOp(0xb0), args: []uint64{f64(1 << 63)}, trap: "integer overflow"},
+		{name: "i64.trunc_f64_s of infinity", typ: f64ToI64, body: unaryOp(0xb0), args: []uint64{f64(math.Inf(-1))}, trap: "integer overflow"},
+		{name: "i32.trunc_sat_f64_s of NaN", typ: f64ToI32, body: unaryOp(opPrefixFC, 2), args: []uint64{f64(math.NaN())}, expected: []uint64{0}},
+		{name: "i32.trunc_sat_f64_s of 2^31", typ: f64ToI32, body: unaryOp(opPrefixFC, 2), args: []uint64{f64(1 << 31)}, expected: []uint64{math.MaxInt32}},
+		{name: "i32.trunc_sat_f64_s below -2^31", typ: f64ToI32, body: unaryOp(opPrefixFC, 2), args: []uint64{f64(-1e10)}, expected: []uint64{minI32}},
+		{name: "i32.trunc_sat_f64_u of -1", typ: f64ToI32, body: unaryOp(opPrefixFC, 3), args: []uint64{f64(-1)}, expected: []uint64{0}},
+		{name: "i64.trunc_sat_f64_u of infinity", typ: f64ToI64, body: unaryOp(opPrefixFC, 7), args: []uint64{f64(math.Inf(1))}, expected: []uint64{math.MaxUint64}},
+
+		{name: "i32.load at the end", typ: i32ToI32, memory: true, body: load(opI32Load, 0), args: []uint64{PageSize - 4}, expected: []uint64{0}},
+		{name: "i32.load across the end", typ: i32ToI32, memory: true, body: load(opI32Load, 0), args: []uint64{PageSize - 3}, trap: "out of bounds memory access"},
+		{name: "i32.load at the largest address", typ: i32ToI32, memory: true, body: load(opI32Load, 0), args: []uint64{math.MaxUint32}, trap: "out of bounds memory access"},
+		{name: "i32.load with an offset at the end", typ: i32ToI32, memory: true, body: load(opI32Load, 4), args: []uint64{PageSize - 8}, expected: []uint64{0}},
+		{name: "i32.load with an offset past the end", typ: i32ToI32, memory: true, body: load(opI32Load, PageSize), args: []uint64{0}, trap: "out of bounds memory access"},
+		{name: "i32.load with an offset wrapping around", typ: i32ToI32, memory: true, body: load(opI32Load, math.MaxUint32), args: []uint64{1}, trap: "out of bounds memory access"},
+		{name: "i32.load8_u at the end", typ: i32ToI32, memory: true, body: load(opI32Load8U, 0), args: []uint64{PageSize - 1}, expected: []uint64{0}},
+		{name: "i32.load8_u past the end", typ: i32ToI32, memory: true, body: load(opI32Load8U, 0), args: []uint64{PageSize}, trap: "out of bounds memory access"},
+		{name: "i32.load without memory", typ: i32ToI32, body: load(opI32Load, 0), args: []uint64{0}, trap: "out of bounds memory access"},
+		{name: "i32.store across the end", typ: i32x2, memory: true, body: store(opI32Store, 0), args: []uint64{PageSize - 2, 1}, trap: "out of bounds memory access"},
+		{name: "i32.store8 at the end", typ: i32x2, memory: true, body: store(opI32Store8, 0), args: []uint64{PageSize - 1, 1}},
+		{name: "i32.store16 with an offset past the end", typ: i32x2, memory: true, body: store(opI32Store16, 1), args: []uint64{PageSize - 2, 1}, trap: "out of bounds memory access"},
+		{name: "memory.fill to the end", typ: i32x3, memory: true, body: []byte{opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opPrefixFC, fcMemoryFill, 0}, args: []uint64{PageSize - 2, 7, 2}},
+		{name: "memory.fill past the end", typ: i32x3, memory: true, body: []byte{opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opPrefixFC, fcMemoryFill, 0}, args: []uint64{PageSize - 1, 7, 2}, trap: "out of bounds memory access"},
+		{name: "memory.fill of nothing at the end", typ: i32x3, memory: true, body: []byte{opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opPrefixFC, fcMemoryFill, 0}, args: []uint64{PageSize, 7, 0}},
+		{name: "memory.copy from past the end", typ: i32x3, memory: true, body: []byte{opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opPrefixFC, fcMemoryCopy, 0, 0}, args: []uint64{0, PageSize - 1, 2}, trap: "out of bounds memory access"},
+		{name: "memory.copy to past the end", typ: i32x3, memory: true, body: []byte{opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opPrefixFC, fcMemoryCopy, 0, 0}, args: []uint64{PageSize - 1, 0, 2}, trap: "out of bounds memory access"},
+		{name: "memory.copy of the largest size", typ: i32x3, memory: true, body: []byte{opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opPrefixFC, fcMemoryCopy, 0, 0}, args: []uint64{0, 0, math.MaxUint32}, trap: "out of bounds memory access"},
+		{name: "memory.grow without memory", typ: i32ToI32, body: []byte{opLocalGet, 0, opMemoryGrow, 0}, args: []uint64{1}, expected: []uint64{negOne}},
+		{name: "memory.grow past the maximum", typ: i32ToI32, memory: true, body: []byte{opLocalGet, 0, opMemoryGrow, 0}, args: []uint64{maxPages}, expected: []uint64{negOne}},
+
+		{name: "unbounded recursion", typ: FuncType{}, body: []byte{opCall, 0}, trap: "call stack exhausted"},
+		{name: "unbounded recursion with arguments", typ: i32ToNone, body: []byte{opLocalGet, 0, opCall, 0}, args: []uint64{1}, trap: "call stack exhausted"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			m, err := Decode(funcModule(tt.typ, tt.memory, tt.body...))
+			require.NoError(err)
+			in, err := m.Instantiate(Limits{MaxInstructions: 100000})
+			require.NoError(err)
+
+			for i := 0; i < 2; i++ {
+				results, err := in.Call("f", tt.args...)
+				if tt.trap != "" {
+					require.Error(err)
+					require.True(ErrTrap.Is(err), "unexpected error %v", err)
+					require.Contains(err.Error(), tt.trap)
+					require.Nil(results)
+					continue
+				}
+				require.NoError(err)
+				if tt.expected == nil {
+					require.Empty(results)
+				} else {
+					require.Equal(tt.expected, results)
+				}
+			}
+		})
+	}
+}
+
+func TestCallIndirect(t *testing.T) {
+	require := require.New(t)
+
+	// The table has the function answer, of type 0, at 0, and the function f, of type 1, at 1. Its element 2 isn't
+	// initialized.
+	bin := append([]byte(nil), header...)
+	bin = append(bin, section(1, 2, 0x60, 0, 1, byte(I32), 0x60, 1, byte(I32), 1, byte(I32))...)
+	bin = append(bin, section(3, 2, 0, 1)...)
+	bin = append(bin, section(4, 1, 0x70, 0, 3)...)
+	bin = append(bin, section(7, 1, 1, 'f', 0, 1)...)
+	bin = append(bin, section(9, 1, 0, opI32Const, 0, opEnd, 2, 0, 1)...)
+	answer := []byte{0, opI32Const, 42, opEnd}
+	f := []byte{0, opLocalGet, 0, opCallIndirect, 0, 0, opEnd}
+	code := append([]byte{2, byte(len(answer))}, answer...)
+	code = append(code, byte(len(f)))
+	code = append(code, f...)
+	bin = append(bin, section(10, code...)...)
+
+	m, err := Decode(bin)
+	require.NoError(err)
+	in, err := m.Instantiate(Limits{})
+	require.NoError(err)
+
+	results, err := in.Call("f", 0)
+	require.NoError(err)
+	require.Equal([]uint64{42}, results)
+
+	for _, tt := range []struct {
+		elem uint64
+		trap string
+	}{
+		{1, "indirect call type mismatch"},
+		{2, "undefined element"},
+		{3, "undefined element"},
+		{math.MaxUint32, "undefined element"},
+	} {
+		_, err = in.Call("f", tt.elem)
+		require.Error(err)
+		require.True(ErrTrap.Is(err), "unexpected error %v", err)
+		require.Contains(err.Error(), tt.trap)
+	}
+}
+
+func TestMalformedModules(t *testing.T) {
+	withSections := func(sections ...[]byte) []byte {
+		return concat(append([][]byte{header}, sections...)...)
+	}
+	noneType := section(1, 1, 0x60, 0, 0)
+	oneFunc := section(3, 1, 0)
+	code := func(body ...byte) []byte {
+		return section(10, append([]byte{1, byte(len(body) + 1), 0}, body...)...)
+	}
+
+	testCases := []struct {
+		name string
+		bin  []byte
+		err  *errors.Kind
+	}{
+		{"empty", nil, ErrInvalidModule},
+		{"magic only", []byte("\x00asm"), ErrInvalidModule},
+		{"bad magic", []byte("\x00wasm\x01\x00\x00"), ErrInvalidModule},
+		{"text format", []byte("(module)"), ErrInvalidModule},
+		{"version 2", []byte("\x00asm\x02\x00\x00\x00"), ErrUnsupported},
+		{"truncated section header", withSections([]byte{1}), ErrInvalidModule},
+		{"section exceeding the module", withSections([]byte{1, 10, 0}), ErrInvalidModule},
+		{"overlong section size", withSections([]byte{0, 0x80, 0x80, 0x80, 0x80, 0x80, 0x00}), ErrInvalidModule},
+		{"section size out of range", withSections([]byte{0, 0xff, 0xff, 0xff, 0xff, 0x7f}), ErrInvalidModule},
+		{"unknown section", withSections(section(13)), ErrInvalidModule},
+		{"malformed function type", withSections(section(1, 1, 0x61, 0, 0)), ErrInvalidModule},
+		{"unknown value type", withSections(section(1, 1, 0x60, 1, 0x7b, 0)), ErrUnsupported},
+		{"truncated type section", withSections(section(1, 2, 0x60, 0, 0)), ErrInvalidModule},
+		{"function of an unknown type", withSections(noneType, section(3, 1, 1), code(opEnd)), ErrInvalidModule},
+		{"function without code", withSections(noneType, oneFunc), ErrInvalidModule},
+		{"code without function", withSections(noneType, code(opEnd)), ErrInvalidModule},
+		{"function body exceeding the section", withSections(noneType, oneFunc, section(10, 1, 5, 0, opEnd)), ErrInvalidModule},
+		{"function without an end", withSections(noneType, oneFunc, code(opNop)), ErrInvalidModule},
+		{"block without an end", withSections(noneType, oneFunc, code(opBlock, 0x40, opEnd)), ErrInvalidModule},
+		{"block of an unknown type", withSections(noneType, oneFunc, code(opBlock, 0x05, opEnd, opEnd)), ErrInvalidModule},
+		{"else outside of an if", withSections(noneType, oneFunc, code(opElse, opEnd)), ErrInvalidModule},
+		{"instructions after the end", withSections(noneType, oneFunc, code(opEnd, opNop)), ErrInvalidModule},
+		{"unknown instruction", withSections(noneType, oneFunc, code(0xff, opEnd)), ErrUnsupported},
+		{"unsupported 0xfc instruction", withSections(noneType, oneFunc, code(opPrefixFC, 8, 0, 0, opEnd)), ErrUnsupported},
+		{"truncated immediate", withSections(noneType, oneFunc, code(opI32Const, 0x80)), ErrInvalidModule},
+		{"i32 constant out of range", withSections(noneType, oneFunc, code(opI32Const, 0xff, 0xff, 0xff, 0xff, 0x0f, opEnd)), ErrInvalidModule},
+		{"too many locals", withSections(noneType, oneFunc, section(10, 1, 7, 1, 0xff, 0xff, 0xff, 0xff, 0x0f, byte(I32))), ErrUnsupported},
+		{"import", withSections(section(2, 0)), ErrUnsupported},
+		{"multiple memories", withSections(section(5, 2, 0, 1, 0, 1)), ErrUnsupported},
+		{"memory minimum above its maximum", withSections(section(5, 1, 1, 2, 1)), ErrInvalidModule},
+		{"memory above 4GiB", withSections(section(5, 1, 0, 0x81, 0x80, 0x04)), ErrInvalidModule},
+		{"malformed limits", withSections(section(5, 1, 2, 1)), ErrInvalidModule},
+		{"table of external references", withSections(section(4, 1, 0x6f, 0, 1)), ErrUnsupported},
+		{"unknown export kind", withSections(section(7, 1, 1, 'f', 4, 0)), ErrInvalidModule},
+		{"export of an unknown function", withSections(section(7, 1, 1, 'f', 0, 0)), ErrInvalidModule},
+		{"unknown start function", withSections(section(8, 0)), ErrInvalidModule},
+		{"element of an unknown function", withSections(section(4, 1, 0x70, 0, 1), section(9, 1, 0, opI32Const, 0, opEnd, 1, 0)), ErrInvalidModule},
+		{"global of an unknown global", withSections(section(6, 1, byte(I32), 0, opGlobalGet, 0, opEnd)), ErrInvalidModule},
+		{"unsupported constant expression", withSections(section(6, 1, byte(I32), 0, opI32Const, 1, opI32Const, 1, opI32Add, opEnd)), ErrUnsupported},
+		{"passive data segment", withSections(section(5, 1, 0, 1), section(11, 1, 1, 0)), ErrUnsupported},
+		{"data segment exceeding the section", withSections(section(5, 1, 0, 1), section(11, 1, 0, opI32Const, 0, opEnd, 10, 1)), ErrInvalidModule},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.bin)
+			require.Error(t, err)
+			require.True(t, tt.err.Is(err), "unexpected error %v", err)
+		})
+	}
+}
+
+func TestInstantiationTraps(t *testing.T) {
+	require := require.New(t)
+
+	// Segments are checked against the memory and table when they're copied to them
+	data := concat(header, section(5, 1, 0, 1), section(11, 1, 0, opI32Const, 0x80, 0x80, 0x04, opEnd, 1, 1))
+	m, err := Decode(data)
+	require.NoError(err)
+	_, err = m.Instantiate(Limits{})
+	require.True(ErrTrap.Is(err), "unexpected error %v", err)
+
+	elements := concat(header, section(1, 1, 0x60, 0, 0), section(3, 1, 0), section(4, 1, 0x70, 0, 1),
+		section(9, 1, 0, opI32Const, 1, opEnd, 1, 0), section(10, 1, 2, 0, opEnd))
+	m, err = Decode(elements)
+	require.NoError(err)
+	_, err = m.Instantiate(Limits{})
+	require.True(ErrTrap.Is(err), "unexpected error %v", err)
+
+	// Traps of the start function fail the instantiation
+	start := concat(header, section(1, 1, 0x60, 0, 0), section(3, 1, 0), section(8, 0), section(10, 1, 3, 0, opUnreachable, opEnd))
+	m, err = Decode(start)
+	require.NoError(err)
+	_, err = m.Instantiate(Limits{})
+	require.True(ErrTrap.Is(err), "unexpected error %v", err)
+}
+
+// TestCorruptModules checks that modules damaged in every way a byte can be damaged are either rejected or run
+// without the interpreter panicking, however invalid what their functions do is. The modules are those of the other
+// tests, truncated at every length and with every byte changed.
+func TestCorruptModules(t *testing.T) {
+	modules := map[string][]byte{
+		"test":   testModule(),
+		"div":    funcModule(i32Binary, false, opLocalGet, 0, opLocalGet, 1, opI32DivS),
+		"store":  funcModule(i32x2, true, concat([]byte{opLocalGet, 0, opLocalGet, 1, opI64Store}, memarg(3, 8))...),
+		"branch": funcModule(noneToI32, false, opBlock, byte(I32), opI32Const, 1, opI32Const, 0, opBrIf, 0, opEnd),
+		"fill":   funcModule(i32x3, true, opLocalGet, 0, opLocalGet, 1, opLocalGet, 2, opPrefixFC, fcMemoryFill, 0),
+	}
+
+	expected := []*errors.Kind{ErrInvalidModule, ErrUnsupported, ErrTrap, ErrInstructionLimit, ErrMemoryLimit}
+	check := func(t *testing.T, name string, err error) {
+		if err == nil {
+			return
+		}
+		for _, kind := range expected {
+			if kind.Is(err) {
+				return
+			}
+		}
+		t.Fatalf("%s: unexpected error %v", name, err)
+	}
+
+	run := func(t *testing.T, name string, bin []byte) {
+		m, err := Decode(bin)
+		check(t, name, err)
+		if err != nil {
+			return
+		}
+		in, err := m.Instantiate(Limits{MaxInstructions: 10000, MaxMemoryPages: 4})
+		check(t, name, err)
+		if err != nil {
+			return
+		}
+		for export, e := range m.exports {
+			if e.kind != exportFunc {
+				continue
+			}
+			typ, _ := m.Func(export)
+			args := make([]uint64, len(typ.Params))
+			for _, arg := range []uint64{0, 1, PageSize - 1, math.MaxUint32} {
+				for i := range args {
+					args[i] = arg
+				}
+				_, err := in.Call(export, args...)
+				check(t, fmt.Sprintf("%s: %s%v", name, export, args), err)
+			}
+		}
+	}
+
+	for name, bin := range modules {
+		t.Run(name, func(t *testing.T) {
+			for i := range bin {
+				run(t, fmt.Sprintf("truncated to %d bytes", i), bin[:i])
+			}
+			corrupt := make([]byte, len(bin))
+			for i := range bin {
+				for _, b := range []byte{0x00, 0x01, 0x7f, 0x80, 0xff, bin[i] ^ 0x01, bin[i] ^ 0x40} {
+					copy(corrupt, bin)
+					corrupt[i] = b
+					run(t, fmt.Sprintf("byte %d set to 0x%02x", i, b), corrupt)
+				}
+			}
+		})
+	}
+}
+
+func TestLEB(t *testing.T) {
+	require := require.New(t)
+
+	for _, v := range []uint64{0, 1, 0x7f, 0x80, math.MaxUint32} {
+		got, n := readULEB(uleb(v), 32)
+		require.Equal(v, got)
+		require.Equal(len(uleb(v)), n)
+	}
+	for _, v := range []int64{0, 1, -1, 63, -64, 64, -65, math.MaxInt32, math.MinInt32} {
+		got, n := readSLEB(sleb(v), 32)
+		require.Equal(v, got)
+		require.Equal(len(sleb(v)), n)
+	}
+	for _, v := range []int64{math.MaxInt64, math.MinInt64} {
+		got, n := readSLEB(sleb(v), 64)
+		require.Equal(v, got)
+		require.Equal(len(sleb(v)), n)
+	}
+
+	// Numbers with more bytes than their size allows, or with more bits, are malformed
+	_, n := readULEB(uleb(math.MaxUint32+1), 32)
+	require.Zero(n)
+	_, n = readULEB([]byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x00}, 32)
+	require.Zero(n)
+	_, n = readSLEB(sleb(math.MaxInt32+1), 32)
+	require.Zero(n)
+	_, n = readULEB([]byte{0x80}, 32)
+	require.Zero(n)
+}
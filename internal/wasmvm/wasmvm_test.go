@@ -0,0 +1,200 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasmvm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// section encodes a section of a module.
+func section(id byte, contents ...byte) []byte {
+	return append([]byte{id, byte(len(contents))}, contents...)
+}
+
+// testModule is a module with the functions:
+//
+//	add(i32, i32) -> i32
+//	fac(i64) -> i64, recursive
+//	sum(i32) -> i32, the sum of 1 to n with a loop
+//	spin(), which loops forever
+//	div(i32, i32) -> i32, signed
+//	grow(i32) -> i32, which grows the memory
+//	poke(i32, i32), which stores an i32
+//	peek(i32) -> i32, which loads an i32
+//
+// and a memory of one to four pages, with "hi" at address 16.
+func testModule() []byte {
+	bin := []byte("\x00asm\x01\x00\x00\x00")
+	bin = append(bin, section(1,
+		5,
+		0x60, 2, 0x7f, 0x7f, 1, 0x7f,
+		0x60, 1, 0x7e, 1, 0x7e,
+		0x60, 1, 0x7f, 1, 0x7f,
+		0x60, 0, 0,
+		0x60, 2, 0x7f, 0x7f, 0,
+	)...)
+	bin = append(bin, section(3, 8, 0, 1, 2, 3, 0, 2, 4, 2)...)
+	bin = append(bin, section(5, 1, 1, 1, 4)...)
+
+	exports := []byte{9}
+	for i, name := range []string{"add", "fac", "sum", "spin", "div", "grow", "poke", "peek"} {
+		exports = append(exports, byte(len(name)))
+		exports = append(exports, name...)
+		exports = append(exports, 0, byte(i))
+	}
+	exports = append(exports, 6, 'm', 'e', 'm', 'o', 'r', 'y', 2, 0)
+	bin = append(bin, section(7, exports...)...)
+
+	bodies := [][]byte{
+		{0, 0x20, 0, 0x20, 1, 0x6a, 0x0b},
+		{0, 0x20, 0, 0x50, 0x04, 0x7e, 0x42, 1, 0x05, 0x20, 0, 0x20, 0, 0x42, 1, 0x7d, 0x10, 1, 0x7e, 0x0b, 0x0b},
+		{1, 1, 0x7f, 0x02, 0x40, 0x03, 0x40, 0x20, 0, 0x45, 0x0d, 1, 0x20, 1, 0x20, 0, 0x6a, 0x21, 1, 0x20, 0, 0x41, 1, 0x6b, 0x21, 0, 0x0c, 0, 0x0b, 0x0b, 0x20, 1, 0x0b},
+		{0, 0x03, 0x40, 0x0c, 0, 0x0b, 0x0b},
+		{0, 0x20, 0, 0x20, 1, 0x6d, 0x0b},
+		{0, 0x20, 0, 0x40, 0, 0x0b},
+		{0, 0x20, 0, 0x20, 1, 0x36, 2, 0, 0x0b},
+		{0, 0x20, 0, 0x28, 2, 0, 0x0b},
+	}
+	code := []byte{byte(len(bodies))}
+	for _, b := range bodies {
+		code = append(code, byte(len(b)))
+		code = append(code, b...)
+	}
+	bin = append(bin, section(10, code...)...)
+	bin = append(bin, section(11, 1, 0, 0x41, 16, 0x0b, 2, 'h', 'i')...)
+	return bin
+}
+
+func TestDecode(t *testing.T) {
+	require := require.New(t)
+
+	m, err := Decode(testModule())
+	require.NoError(err)
+	typ, ok := m.Func("add")
+	require.True(ok)
+	require.Equal(FuncType{Params: []ValueType{I32, I32}, Results: []ValueType{I32}}, typ)
+	_, ok = m.Func("memory")
+	require.False(ok)
+	require.True(m.HasMemory("memory"))
+
+	_, err = Decode([]byte("not wasm"))
+	require.True(ErrInvalidModule.Is(err))
+
+	// Functions can't be imported, since the server doesn't give modules anything to import
+	imports := append([]byte("\x00asm\x01\x00\x00\x00"), section(2, 0)...)
+	_, err = Decode(imports)
+	require.True(ErrUnsupported.Is(err))
+
+	truncated := testModule()
+	_, err = Decode(truncated[:len(truncated)-3])
+	require.True(ErrInvalidModule.Is(err))
+}
+
+func TestCall(t *testing.T) {
+	require := require.New(t)
+
+	m, err := Decode(testModule())
+	require.NoError(err)
+	in, err := m.Instantiate(Limits{})
+	require.NoError(err)
+
+	neg := uint64(uint32(math.MaxUint32 - 4))
+	testCases := []struct {
+		name     string
+		args     []uint64
+		expected uint64
+	}{
+		{"add", []uint64{1, 2}, 3},
+		{"add", []uint64{math.MaxUint32, 2}, 1},
+		{"fac", []uint64{20}, 2432902008176640000},
+		{"sum", []uint64{100}, 5050},
+		{"div", []uint64{neg, 2}, uint64(uint32(math.MaxUint32 - 1))},
+	}
+	for _, tt := range testCases {
+		results, err := in.Call(tt.name, tt.args...)
+		require.NoError(err)
+		require.Equal([]uint64{tt.expected}, results, tt.name)
+	}
+
+	_, err = in.Call("div", 1, 0)
+	require.True(ErrTrap.Is(err))
+	_, err = in.Call("missing")
+	require.True(ErrNoFunction.Is(err))
+	_, err = in.Call("add", 1)
+	require.True(ErrTrap.Is(err))
+
+	// Runaway recursion traps rather than exhausting the stack of the server
+	_, err = in.Call("fac", math.MaxUint32)
+	require.True(ErrTrap.Is(err))
+}
+
+func TestMemory(t *testing.T) {
+	require := require.New(t)
+
+	m, err := Decode(testModule())
+	require.NoError(err)
+	in, err := m.Instantiate(Limits{MaxMemoryPages: 2})
+	require.NoError(err)
+	require.Equal("hi", string(in.Memory()[16:18]))
+
+	_, err = in.Call("poke", 100, 0x01020304)
+	require.NoError(err)
+	require.Equal([]byte{4, 3, 2, 1}, in.Memory()[100:104])
+	results, err := in.Call("peek", 100)
+	require.NoError(err)
+	require.Equal([]uint64{0x01020304}, results)
+
+	_, err = in.Call("peek", PageSize-2)
+	require.True(ErrTrap.Is(err))
+
+	// Memory only grows up to the limit of the instance, even if the module allows more
+	results, err = in.Call("grow", 1)
+	require.NoError(err)
+	require.Equal([]uint64{1}, results)
+	require.Len(in.Memory(), 2*PageSize)
+	results, err = in.Call("grow", 1)
+	require.NoError(err)
+	require.Equal([]uint64{math.MaxUint32}, results)
+
+	// Modules needing more memory than the limit can't be instantiated
+	large, err := Decode(append([]byte("\x00asm\x01\x00\x00\x00"), section(5, 1, 0, 3)...))
+	require.NoError(err)
+	_, err = large.Instantiate(Limits{MaxMemoryPages: 2})
+	require.True(ErrMemoryLimit.Is(err))
+	_, err = large.Instantiate(Limits{})
+	require.NoError(err)
+}
+
+func TestInstructionLimit(t *testing.T) {
+	require := require.New(t)
+
+	m, err := Decode(testModule())
+	require.NoError(err)
+	in, err := m.Instantiate(Limits{MaxInstructions: 1000})
+	require.NoError(err)
+
+	_, err = in.Call("spin")
+	require.True(ErrInstructionLimit.Is(err))
+
+	// The limit applies to each call
+	results, err := in.Call("sum", 10)
+	require.NoError(err)
+	require.Equal([]uint64{55}, results)
+	_, err = in.Call("sum", 1000)
+	require.True(ErrInstructionLimit.Is(err))
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+)
+
+func TestSelectIntoOutfile(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+
+	db := memory.NewDatabase("mydb")
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), nil)
+	defer e.Close()
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	for _, q := range []string{
+		"CREATE TABLE t (pk int primary key, s varchar(20), f double, d decimal(10,2), dt date, ts datetime)",
+		`INSERT INTO t VALUES (1, 'a\tb', 0.5, 1.5, '2021-06-01', '2021-06-01 12:30:00'), (2, NULL, 1e21, NULL, NULL, NULL), (3, 'say "hi"', -2, 3, '2021-01-31', '2020-02-29 00:00:01')`,
+	} {
+		_, err := query(q)
+		require.NoError(err, q)
+	}
+
+	tsv := filepath.Join(dir, "t.tsv")
+	rows, err := query("SELECT * FROM t ORDER BY pk INTO OUTFILE '" + tsv + "'")
+	require.NoError(err)
+	require.Equal([]sql.Row{{sql.NewOkResult(3)}}, rows)
+	contents, err := ioutil.ReadFile(tsv)
+	require.NoError(err)
+	require.Equal("1\ta\\\tb\t0.5\t1.50\t2021-06-01\t2021-06-01 12:30:00\n"+
+		"2\t\\N\t1e21\t\\N\t\\N\t\\N\n"+
+		"3\tsay \"hi\"\t-2\t3.00\t2021-01-31\t2020-02-29 00:00:01\n", string(contents))
+
+	csv := filepath.Join(dir, "t.csv")
+	_, err = query("SELECT pk, s, d, dt FROM t ORDER BY pk INTO OUTFILE '" + csv + "' FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"' ESCAPED BY '' LINES TERMINATED BY '\\r\\n'")
+	require.NoError(err)
+	contents, err = ioutil.ReadFile(csv)
+	require.NoError(err)
+	require.Equal("1,\"a\tb\",1.50,2021-06-01\r\n2,NULL,NULL,NULL\r\n3,\"say \"\"hi\"\"\",3.00,2021-01-31\r\n", string(contents))
+
+	// Values are cast to text as they're written
+	rows, err = query("SELECT CAST(f AS CHAR), CAST(d AS CHAR), CAST(dt AS CHAR), CAST(ts AS CHAR) FROM t WHERE pk = 1")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"0.5", "1.50", "2021-06-01", "2021-06-01 12:30:00"}}, rows)
+
+	// Files are never overwritten
+	_, err = query("SELECT * FROM t INTO OUTFILE '" + tsv + "'")
+	require.True(sql.ErrOutfileExists.Is(err))
+
+	// Files are read back by LOAD DATA, NULLs included
+	back := filepath.Join(dir, "back.tsv")
+	_, err = query("SELECT * FROM t WHERE pk > 1 INTO OUTFILE '" + back + "'")
+	require.NoError(err)
+	_, err = query("CREATE TABLE t2 (pk int primary key, s varchar(20), f double, d decimal(10,2), dt date, ts datetime)")
+	require.NoError(err)
+	_, err = query("LOAD DATA INFILE '" + back + "' INTO TABLE t2")
+	require.NoError(err)
+	rows, err = query("SELECT count(*) FROM t2 JOIN t ON row_hash(t.*) = row_hash(t2.*)")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(2)}}, rows)
+}
@@ -0,0 +1,344 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+)
+
+func TestLoadDataReport(t *testing.T) {
+	require := require.New(t)
+
+	file := filepath.Join(t.TempDir(), "data.csv")
+	require.NoError(ioutil.WriteFile(file, []byte("pk,c1\n1,one\n2\nthree,3\n4,four\n"), 0644))
+
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t", memory.NewTable("t", sql.Schema{
+		{Name: "pk", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "c1", Type: sql.Text, Source: "t", Nullable: true},
+	}))
+
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), &Config{})
+	defer e.Close()
+
+	var reports []sql.LoadDataReport
+	e.LoadDataReporter = sql.LoadDataReporterFunc(func(ctx *sql.Context, report sql.LoadDataReport) {
+		reports = append(reports, report)
+	})
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	_, iter, err := e.Query(ctx, "LOAD DATA INFILE '"+file+"' IGNORE INTO TABLE t FIELDS TERMINATED BY ',' IGNORE 1 LINES")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	require.Equal([]sql.LoadDataReport{{
+		File:   file,
+		Table:  "t",
+		Loaded: 2,
+		Rejected: []sql.LoadDataRejectedRow{
+			{Line: 3, Reason: sql.ErrLoadDataMissingColumns.New(3).Error()},
+			{Line: 4, Reason: sql.ErrLoadDataIncorrectValue.New("bigint", "three", "pk", 4).Error()},
+		},
+	}}, reports)
+	require.Len(ctx.Warnings(), 2)
+}
+
+func TestLoadDataParallel(t *testing.T) {
+	require := require.New(t)
+
+	// The file is longer than a few chunks, with a header line and an invalid line in every chunk.
+	var data strings.Builder
+	data.WriteString("pk,c1\n")
+	var expected []sql.Row
+	var rejected []sql.LoadDataRejectedRow
+	for i := 1; i <= 5000; i++ {
+		line := int64(i + 1)
+		if i%1000 == 0 {
+			fmt.Fprintf(&data, "x%d,bad\n", i)
+			err := sql.ErrLoadDataIncorrectValue.New("bigint", fmt.Sprintf("x%d", i), "pk", line)
+			rejected = append(rejected, sql.LoadDataRejectedRow{Line: line, Reason: err.Error()})
+			continue
+		}
+		fmt.Fprintf(&data, "%d,line %d\n", i, line)
+		expected = append(expected, sql.Row{int64(i), fmt.Sprintf("line %d", line)})
+	}
+
+	file := filepath.Join(t.TempDir(), "data.csv")
+	require.NoError(ioutil.WriteFile(file, []byte(data.String()), 0644))
+
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t", memory.NewTable("t", sql.Schema{
+		{Name: "pk", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "c1", Type: sql.Text, Source: "t", Nullable: true},
+	}))
+
+	a := analyzer.NewBuilder(memory.NewMemoryDBProvider(db)).WithParallelism(4).Build()
+	e := New(a, &Config{})
+	defer e.Close()
+
+	var reports []sql.LoadDataReport
+	e.LoadDataReporter = sql.LoadDataReporterFunc(func(ctx *sql.Context, report sql.LoadDataReport) {
+		reports = append(reports, report)
+	})
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	_, iter, err := e.Query(ctx, "LOAD DATA INFILE '"+file+"' IGNORE INTO TABLE t FIELDS TERMINATED BY ',' IGNORE 1 LINES")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	require.Equal([]sql.LoadDataReport{{
+		File:     file,
+		Table:    "t",
+		Loaded:   int64(len(expected)),
+		Rejected: rejected,
+	}}, reports)
+
+	_, iter, err = e.Query(ctx, "SELECT * FROM t ORDER BY pk")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal(expected, rows)
+
+	// Without IGNORE, the first invalid line of the file is the error of the statement.
+	_, iter, err = e.Query(ctx, "DELETE FROM t")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	_, iter, err = e.Query(ctx, "LOAD DATA INFILE '"+file+"' INTO TABLE t FIELDS TERMINATED BY ',' IGNORE 1 LINES")
+	if err == nil {
+		_, err = sql.RowIterToRows(ctx, iter)
+	}
+	require.Error(err)
+	require.Equal(rejected[0].Reason, err.Error())
+}
+
+func TestLoadDataCharset(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"latin1.csv": []byte("1,caf\xe9\n2,\x80uro\n"),
+		"gbk.csv":    []byte("1,\xd6\xd0\xce\xc4\n2,abc\n"),
+		"utf8.csv":   []byte("1,café\n2,中文\n"),
+	}
+	for name, data := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), data, 0644))
+	}
+
+	tests := []struct {
+		file     string
+		query    string
+		expected []sql.Row
+		err      *errors.Kind
+	}{
+		{
+			file:     "latin1.csv",
+			query:    "LOAD DATA INFILE '%s' INTO TABLE t CHARACTER SET latin1 FIELDS TERMINATED BY ','",
+			expected: []sql.Row{{int64(1), "café"}, {int64(2), "€uro"}},
+		},
+		{
+			file:     "gbk.csv",
+			query:    "LOAD DATA INFILE '%s' IGNORE INTO TABLE t CHARSET 'gbk' FIELDS TERMINATED BY ','",
+			expected: []sql.Row{{int64(1), "中文"}, {int64(2), "abc"}},
+		},
+		{
+			file:     "utf8.csv",
+			query:    "LOAD DATA INFILE '%s' INTO TABLE t CHARACTER SET utf8mb4 FIELDS TERMINATED BY ','",
+			expected: []sql.Row{{int64(1), "café"}, {int64(2), "中文"}},
+		},
+		{
+			file:     "utf8.csv",
+			query:    "LOAD DATA INFILE '%s' INTO TABLE t FIELDS TERMINATED BY ','",
+			expected: []sql.Row{{int64(1), "café"}, {int64(2), "中文"}},
+		},
+		{
+			file:  "latin1.csv",
+			query: "LOAD DATA INFILE '%s' INTO TABLE t CHARACTER SET nope FIELDS TERMINATED BY ','",
+			err:   sql.ErrCharacterSetNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+
+			db := memory.NewDatabase("mydb")
+			db.AddTable("t", memory.NewTable("t", sql.Schema{
+				{Name: "pk", Type: sql.Int64, Source: "t", PrimaryKey: true},
+				{Name: "c1", Type: sql.Text, Source: "t", Nullable: true},
+			}))
+
+			e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), &Config{})
+			defer e.Close()
+
+			ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+			query := fmt.Sprintf(tt.query, filepath.Join(dir, tt.file))
+			_, iter, err := e.Query(ctx, query)
+			if err == nil {
+				_, err = sql.RowIterToRows(ctx, iter)
+			}
+			if tt.err != nil {
+				require.Error(err)
+				require.True(tt.err.Is(err), "unexpected error %v", err)
+				return
+			}
+			require.NoError(err)
+
+			_, iter, err = e.Query(ctx, "SELECT * FROM t ORDER BY pk")
+			require.NoError(err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(err)
+			require.Equal(tt.expected, rows)
+		})
+	}
+}
+
+func TestLoadDataCompressed(t *testing.T) {
+	dir := t.TempDir()
+
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	_, err := w.Write([]byte("1,one\n2,two\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	var zst bytes.Buffer
+	zw, err := zstd.NewWriter(&zst)
+	require.NoError(t, err)
+	_, err = zw.Write([]byte("1,one\n2,two\n"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	files := map[string][]byte{
+		"data.csv.gz":  gz.Bytes(),
+		"data.csv":     gz.Bytes(),
+		"data.csv.zst": zst.Bytes(),
+		"data.zst.csv": zst.Bytes(),
+		"corrupt.gz":   gz.Bytes()[:len(gz.Bytes())/2],
+		"corrupt.zst":  zst.Bytes()[:len(zst.Bytes())/2],
+	}
+	for name, data := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), data, 0644))
+	}
+
+	tests := []struct {
+		file     string
+		expected []sql.Row
+	}{
+		{file: "data.csv.gz", expected: []sql.Row{{int64(1), "one"}, {int64(2), "two"}}},
+		{file: "data.csv", expected: []sql.Row{{int64(1), "one"}, {int64(2), "two"}}},
+		{file: "data.csv.zst", expected: []sql.Row{{int64(1), "one"}, {int64(2), "two"}}},
+		{file: "data.zst.csv", expected: []sql.Row{{int64(1), "one"}, {int64(2), "two"}}},
+		{file: "corrupt.gz"},
+		{file: "corrupt.zst"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			require := require.New(t)
+
+			db := memory.NewDatabase("mydb")
+			db.AddTable("t", memory.NewTable("t", sql.Schema{
+				{Name: "pk", Type: sql.Int64, Source: "t", PrimaryKey: true},
+				{Name: "c1", Type: sql.Text, Source: "t", Nullable: true},
+			}))
+
+			e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), &Config{})
+			defer e.Close()
+
+			ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+			query := fmt.Sprintf("LOAD DATA INFILE '%s' INTO TABLE t FIELDS TERMINATED BY ','", filepath.Join(dir, tt.file))
+			_, iter, err := e.Query(ctx, query)
+			if err == nil {
+				_, err = sql.RowIterToRows(ctx, iter)
+			}
+			if tt.expected == nil {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			_, iter, err = e.Query(ctx, "SELECT * FROM t ORDER BY pk")
+			require.NoError(err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(err)
+			require.Equal(tt.expected, rows)
+		})
+	}
+}
+
+func TestLoadDataSources(t *testing.T) {
+	require := require.New(t)
+
+	file := filepath.Join(t.TempDir(), "data.csv")
+	require.NoError(ioutil.WriteFile(file, []byte("3,three\n"), 0644))
+
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t", memory.NewTable("t", sql.Schema{
+		{Name: "pk", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "c1", Type: sql.Text, Source: "t", Nullable: true},
+	}))
+
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), &Config{})
+	defer e.Close()
+
+	objects := map[string]string{"bucket/data.csv": "1,one\n2,two\n"}
+	e.LoadDataSources.AddSource(sql.NewURLLoadDataSource(func(ctx *sql.Context, u *url.URL) (io.ReadCloser, error) {
+		data, ok := objects[u.Host+u.Path]
+		if !ok {
+			return nil, fmt.Errorf("no such object: %s", u)
+		}
+		return ioutil.NopCloser(strings.NewReader(data)), nil
+	}, "mem"))
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	for _, f := range []string{"mem://bucket/data.csv", file} {
+		_, iter, err := e.Query(ctx, "LOAD DATA INFILE '"+f+"' INTO TABLE t FIELDS TERMINATED BY ','")
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	}
+
+	_, iter, err := e.Query(ctx, "LOAD DATA INFILE 'mem://bucket/missing.csv' INTO TABLE t FIELDS TERMINATED BY ','")
+	if err == nil {
+		_, err = sql.RowIterToRows(ctx, iter)
+	}
+	require.Error(err)
+	require.True(sql.ErrLoadDataCannotOpen.Is(err), "unexpected error %v", err)
+
+	_, iter, err = e.Query(ctx, "SELECT * FROM t ORDER BY pk")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(1), "one"}, {int64(2), "two"}, {int64(3), "three"}}, rows)
+}
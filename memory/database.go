@@ -38,6 +38,11 @@ var _ sql.TableRenamer = (*Database)(nil)
 var _ sql.TriggerDatabase = (*Database)(nil)
 var _ sql.StoredProcedureDatabase = (*Database)(nil)
 var _ sql.ViewDatabase = (*Database)(nil)
+var _ sql.TemporaryTableCreator = (*Database)(nil)
+var _ sql.TemporaryTableDatabase = (*Database)(nil)
+var _ sql.PartitionedTableCreator = (*Database)(nil)
+var _ sql.FunctionDatabase = (*Database)(nil)
+var _ sql.UserFunctionDatabase = (*Database)(nil)
 
 // BaseDatabase is an in-memory database that can't store views, only for testing the engine
 type BaseDatabase struct {
@@ -45,6 +50,8 @@ type BaseDatabase struct {
 	tables            map[string]sql.Table
 	triggers          []sql.TriggerDefinition
 	storedProcedures  []sql.StoredProcedureDetails
+	userFunctions     []sql.UserFunctionDetails
+	functions         map[string]sql.Function
 	primaryKeyIndexes bool
 }
 
@@ -83,6 +90,12 @@ func (d *BaseDatabase) Tables() map[string]sql.Table {
 }
 
 func (d *BaseDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	if sess, ok := temporaryTableSession(ctx); ok {
+		if tbl, ok := sess.GetTemporaryTable(d.name, tblName); ok {
+			return tbl, true, nil
+		}
+	}
+
 	tbl, ok := sql.GetTableInsensitive(tblName, d.tables)
 	return tbl, ok, nil
 }
@@ -167,8 +180,62 @@ func (d *BaseDatabase) CreateTable(ctx *sql.Context, name string, schema sql.Sch
 	return nil
 }
 
-// DropTable drops the table with the given name
+// CreatePartitionedTable creates a table with the given name and schema, whose rows are split into partitions by the
+// scheme given.
+func (d *BaseDatabase) CreatePartitionedTable(ctx *sql.Context, name string, schema sql.Schema, scheme *sql.PartitionScheme) error {
+	_, ok := d.tables[name]
+	if ok {
+		return sql.ErrTableAlreadyExists.New(name)
+	}
+
+	table := NewTableWithPartitionScheme(name, schema, scheme)
+	if d.primaryKeyIndexes {
+		table.EnablePrimaryKeyIndexes()
+	}
+	d.tables[name] = table
+	return nil
+}
+
+// CreateTemporaryTable creates a table with the given name and schema that only exists in the session of the context
+// given, until it's dropped or the session is closed.
+func (d *BaseDatabase) CreateTemporaryTable(ctx *sql.Context, name string, schema sql.Schema) error {
+	sess, ok := temporaryTableSession(ctx)
+	if !ok {
+		return sql.ErrTemporaryTableNotSupported.New()
+	}
+
+	table := NewTable(name, schema)
+	table.temporary = true
+	if d.primaryKeyIndexes {
+		table.EnablePrimaryKeyIndexes()
+	}
+	return sess.AddTemporaryTable(d.name, table)
+}
+
+// GetAllTemporaryTables returns the temporary tables of this database in the session of the context given.
+func (d *BaseDatabase) GetAllTemporaryTables(ctx *sql.Context) ([]sql.Table, error) {
+	sess, ok := temporaryTableSession(ctx)
+	if !ok {
+		return nil, nil
+	}
+	return sess.GetAllTemporaryTables(d.name), nil
+}
+
+func temporaryTableSession(ctx *sql.Context) (sql.TemporaryTableSession, bool) {
+	if ctx == nil || ctx.Session == nil {
+		return nil, false
+	}
+	sess, ok := ctx.Session.(sql.TemporaryTableSession)
+	return sess, ok
+}
+
+// DropTable drops the table with the given name. Temporary tables of the session are dropped before the persistent
+// tables with the same name, as they shadow them.
 func (d *BaseDatabase) DropTable(ctx *sql.Context, name string) error {
+	if sess, ok := temporaryTableSession(ctx); ok && sess.DropTemporaryTable(d.name, name) {
+		return nil
+	}
+
 	_, ok := d.tables[name]
 	if !ok {
 		return sql.ErrTableNotFound.New(name)
@@ -190,10 +257,17 @@ func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) er
 		return sql.ErrTableAlreadyExists.New(newName)
 	}
 
-	tbl.(*Table).name = newName
+	tbl.(*Table).rename(newName)
 	d.tables[newName] = tbl
 	delete(d.tables, oldName)
 
+	// Foreign keys of other tables keep referencing the renamed table, as in MySQL
+	for _, other := range d.tables {
+		if other, ok := other.(*Table); ok {
+			other.renameReferencedTable(oldName, newName)
+		}
+	}
+
 	return nil
 }
 
@@ -246,6 +320,24 @@ func (d *BaseDatabase) SaveStoredProcedure(ctx *sql.Context, spd sql.StoredProce
 	return nil
 }
 
+// RegisterFunction adds the functions given to the functions of the database, which other databases don't see.
+func (d *BaseDatabase) RegisterFunction(fns ...sql.Function) {
+	if d.functions == nil {
+		d.functions = make(map[string]sql.Function)
+	}
+	for _, fn := range fns {
+		d.functions[strings.ToLower(fn.FunctionName())] = fn
+	}
+}
+
+// Function implements sql.FunctionDatabase
+func (d *BaseDatabase) Function(ctx *sql.Context, name string) (sql.Function, error) {
+	if fn, ok := d.functions[strings.ToLower(name)]; ok {
+		return fn, nil
+	}
+	return nil, sql.ErrFunctionNotFound.New(d.name + "." + name)
+}
+
 // DropStoredProcedure implements sql.StoredProcedureDatabase
 func (d *BaseDatabase) DropStoredProcedure(ctx *sql.Context, name string) error {
 	loweredName := strings.ToLower(name)
@@ -263,6 +355,39 @@ func (d *BaseDatabase) DropStoredProcedure(ctx *sql.Context, name string) error
 	return nil
 }
 
+// GetUserFunctions implements sql.UserFunctionDatabase
+func (d *BaseDatabase) GetUserFunctions(ctx *sql.Context) ([]sql.UserFunctionDetails, error) {
+	var ufds []sql.UserFunctionDetails
+	for _, ufd := range d.userFunctions {
+		ufds = append(ufds, ufd)
+	}
+	return ufds, nil
+}
+
+// SaveUserFunction implements sql.UserFunctionDatabase
+func (d *BaseDatabase) SaveUserFunction(ctx *sql.Context, ufd sql.UserFunctionDetails) error {
+	loweredName := strings.ToLower(ufd.Name)
+	for _, existingUfd := range d.userFunctions {
+		if strings.ToLower(existingUfd.Name) == loweredName {
+			return sql.ErrUserFunctionAlreadyExists.New(ufd.Name)
+		}
+	}
+	d.userFunctions = append(d.userFunctions, ufd)
+	return nil
+}
+
+// DropUserFunction implements sql.UserFunctionDatabase
+func (d *BaseDatabase) DropUserFunction(ctx *sql.Context, name string) error {
+	loweredName := strings.ToLower(name)
+	for i, ufd := range d.userFunctions {
+		if strings.ToLower(ufd.Name) == loweredName {
+			d.userFunctions = append(d.userFunctions[:i], d.userFunctions[i+1:]...)
+			return nil
+		}
+	}
+	return sql.ErrUserFunctionDoesNotExist.New(name)
+}
+
 func (d *Database) CreateView(ctx *sql.Context, name string, selectStatement string) error {
 	_, ok := d.views[name]
 	if ok {
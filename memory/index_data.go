@@ -0,0 +1,492 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// indexData holds the sorted data of the indexes of a table, by the expressions they're made of. It's shared by the
+// copies of the table, so it's guarded by its own lock. The data of an index is built the first time a lookup needs
+// it, and then kept up to date by the edits of the table as they're applied. Changes replacing the rows of the table
+// wholesale, like a rolled back statement or a schema change, drop the data, which is rebuilt on the next lookup.
+type indexData struct {
+	mu     sync.Mutex
+	sorted map[string]*sortedIndex
+}
+
+func newIndexData() *indexData {
+	return &indexData{sorted: make(map[string]*sortedIndex)}
+}
+
+// sortedIndex is the data of an index: for each partition of the table, the entries of its rows sorted by the values
+// of the index's expressions. Entries refer to their rows by an id that doesn't change when the rows before them are
+// deleted, and rows with equal values are sorted by their id, which follows the order of the rows in the partition.
+type sortedIndex struct {
+	exprs      []sql.Expression
+	partitions map[string][]indexEntry
+	ids        map[string]*rowIDs
+}
+
+// indexEntry is the entry of a row in a sortedIndex.
+type indexEntry struct {
+	key sql.Row
+	id  int
+}
+
+func indexDataKey(exprs []sql.Expression) string {
+	strs := make([]string, len(exprs))
+	for i, e := range exprs {
+		strs[i] = strings.ToLower(e.String())
+	}
+	return strings.Join(strs, ",")
+}
+
+// indexPositions returns the positions of the rows of a partition in any of the ranges given, in ascending order, as
+// found in the data of the index made of the expressions given. It returns false if they can't be found that way.
+func (t *Table) indexPositions(exprs []sql.Expression, partition string, ranges sql.RangeCollection) ([]int, bool, error) {
+	t.indexData.mu.Lock()
+	defer t.indexData.mu.Unlock()
+
+	s, err := t.sortedIndex(exprs)
+	if err != nil {
+		return nil, false, nil
+	}
+	return s.positions(partition, ranges)
+}
+
+// sortedIndex returns the data of the index made of the expressions given, building it if it doesn't exist yet. The
+// lock of the index data must be held.
+func (t *Table) sortedIndex(exprs []sql.Expression) (*sortedIndex, error) {
+	key := indexDataKey(exprs)
+	if s, ok := t.indexData.sorted[key]; ok {
+		return s, nil
+	}
+
+	s := &sortedIndex{
+		exprs:      exprs,
+		partitions: make(map[string][]indexEntry, len(t.partitions)),
+		ids:        make(map[string]*rowIDs, len(t.partitions)),
+	}
+	for k, rows := range t.partitions {
+		entries := make([]indexEntry, len(rows))
+		for i, row := range rows {
+			key, err := s.keyOf(row)
+			if err != nil {
+				return nil, err
+			}
+			entries[i] = indexEntry{key: key, id: i}
+		}
+
+		var err error
+		sort.Slice(entries, func(i, j int) bool {
+			cmp, cmpErr := s.compare(entries[i], entries[j])
+			if cmpErr != nil && err == nil {
+				err = cmpErr
+			}
+			return cmp < 0
+		})
+		if err != nil {
+			return nil, err
+		}
+		s.partitions[k] = entries
+		s.ids[k] = newRowIDs(len(rows))
+	}
+
+	t.indexData.sorted[key] = s
+	return s, nil
+}
+
+// dropIndexData drops the data of all the indexes of the table, after its rows were replaced.
+func (t *Table) dropIndexData() {
+	if t.indexData == nil {
+		return
+	}
+
+	t.indexData.mu.Lock()
+	defer t.indexData.mu.Unlock()
+	t.indexData.sorted = make(map[string]*sortedIndex)
+}
+
+// indexRowInserted adds the row appended to a partition to the data of the indexes of the table.
+func (t *Table) indexRowInserted(partition string, row sql.Row) {
+	t.updateIndexData(func(s *sortedIndex) error {
+		return s.insert(partition, s.idsOf(partition).add(), row)
+	})
+}
+
+// indexRowDeleted removes the row at the position given of a partition, which was removed from it, from the data of
+// the indexes of the table. The rows after it move down a position, which their ids account for.
+func (t *Table) indexRowDeleted(partition string, pos int, row sql.Row) {
+	t.updateIndexData(func(s *sortedIndex) error {
+		ids := s.idsOf(partition)
+		id := ids.id(pos)
+		if err := s.remove(partition, id, row); err != nil {
+			return err
+		}
+		ids.remove(id)
+		return nil
+	})
+}
+
+// indexRowReplaced updates the data of the indexes of the table after the row at the position given of a partition
+// was replaced by another.
+func (t *Table) indexRowReplaced(partition string, pos int, oldRow, newRow sql.Row) {
+	t.updateIndexData(func(s *sortedIndex) error {
+		id := s.idsOf(partition).id(pos)
+		if err := s.remove(partition, id, oldRow); err != nil {
+			return err
+		}
+		return s.insert(partition, id, newRow)
+	})
+}
+
+// updateIndexData applies an edit to the data of each index of the table. The data of an index the edit fails on is
+// dropped, to be rebuilt by the next lookup on it.
+func (t *Table) updateIndexData(edit func(s *sortedIndex) error) {
+	if t.indexData == nil {
+		return
+	}
+
+	t.indexData.mu.Lock()
+	defer t.indexData.mu.Unlock()
+	for key, s := range t.indexData.sorted {
+		if err := edit(s); err != nil {
+			delete(t.indexData.sorted, key)
+		}
+	}
+}
+
+// idsOf returns the ids of the rows of a partition, which is empty if the index was built before the partition had
+// rows.
+func (s *sortedIndex) idsOf(partition string) *rowIDs {
+	ids, ok := s.ids[partition]
+	if !ok {
+		ids = newRowIDs(0)
+		s.ids[partition] = ids
+	}
+	return ids
+}
+
+func (s *sortedIndex) keyOf(row sql.Row) (sql.Row, error) {
+	key := make(sql.Row, len(s.exprs))
+	for i, e := range s.exprs {
+		v, err := e.Eval(sql.NewEmptyContext(), row)
+		if err != nil {
+			return nil, err
+		}
+		key[i] = v
+	}
+	return key, nil
+}
+
+func (s *sortedIndex) compare(a, b indexEntry) (int, error) {
+	for i, e := range s.exprs {
+		cmp, err := e.Type().Compare(a.key[i], b.key[i])
+		if err != nil {
+			return 0, err
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+
+	switch {
+	case a.id < b.id:
+		return -1, nil
+	case a.id > b.id:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// find returns the index in the entries of a partition of the first entry that isn't less than the one given.
+func (s *sortedIndex) find(partition string, entry indexEntry) (int, error) {
+	entries := s.partitions[partition]
+	return search(len(entries), func(i int) (bool, error) {
+		cmp, err := s.compare(entries[i], entry)
+		return cmp >= 0, err
+	})
+}
+
+func (s *sortedIndex) insert(partition string, id int, row sql.Row) error {
+	key, err := s.keyOf(row)
+	if err != nil {
+		return err
+	}
+	entry := indexEntry{key: key, id: id}
+	i, err := s.find(partition, entry)
+	if err != nil {
+		return err
+	}
+
+	entries := append(s.partitions[partition], indexEntry{})
+	copy(entries[i+1:], entries[i:])
+	entries[i] = entry
+	s.partitions[partition] = entries
+	return nil
+}
+
+func (s *sortedIndex) remove(partition string, id int, row sql.Row) error {
+	key, err := s.keyOf(row)
+	if err != nil {
+		return err
+	}
+	i, err := s.find(partition, indexEntry{key: key, id: id})
+	if err != nil {
+		return err
+	}
+
+	entries := s.partitions[partition]
+	if i == len(entries) || entries[i].id != id {
+		return fmt.Errorf("no index entry for row %d of partition %s", id, partition)
+	}
+	s.partitions[partition] = append(entries[:i], entries[i+1:]...)
+	return nil
+}
+
+// positions returns the positions of the rows of a partition in any of the ranges given, in ascending order. It
+// returns false if the keys of the ranges can't be compared with the values of the index the way the expressions of
+// a lookup compare them, in which case the rows must be found by evaluating those expressions instead.
+func (s *sortedIndex) positions(partition string, ranges sql.RangeCollection) ([]int, bool, error) {
+	for _, rang := range ranges {
+		if len(rang) > len(s.exprs) {
+			return nil, false, nil
+		}
+		for i, rangeColumn := range rang {
+			for _, rce := range rangeColumn {
+				if !boundFits(s.exprs[i].Type(), rce.LowerBound) || !boundFits(s.exprs[i].Type(), rce.UpperBound) {
+					return nil, false, nil
+				}
+			}
+		}
+	}
+
+	entries := s.partitions[partition]
+	ids := s.idsOf(partition)
+	found := make(map[int]bool)
+	for _, rang := range ranges {
+		if len(rang) == 0 {
+			for _, e := range entries {
+				found[ids.position(e.id)] = true
+			}
+			continue
+		}
+
+		// The entries in the ranges of the first column are found by a binary search, and then matched against the
+		// ranges of the other columns
+		typ := s.exprs[0].Type()
+		for _, rce := range rang[0] {
+			lo, err := search(len(entries), func(i int) (bool, error) {
+				below, _ := valueCuts(entries[i].key[0], typ)
+				cmp, err := rce.LowerBound.Compare(below, typ)
+				return cmp <= 0, err
+			})
+			if err != nil {
+				return nil, false, err
+			}
+			hi, err := search(len(entries), func(i int) (bool, error) {
+				_, above := valueCuts(entries[i].key[0], typ)
+				cmp, err := rce.UpperBound.Compare(above, typ)
+				return cmp < 0, err
+			})
+			if err != nil {
+				return nil, false, err
+			}
+
+			for i := lo; i < hi; i++ {
+				ok, err := s.inRange(rang, entries[i].key)
+				if err != nil {
+					return nil, false, err
+				}
+				if ok {
+					found[ids.position(entries[i].id)] = true
+				}
+			}
+		}
+	}
+
+	positions := make([]int, 0, len(found))
+	for pos := range found {
+		positions = append(positions, pos)
+	}
+	sort.Ints(positions)
+	return positions, true, nil
+}
+
+// inRange returns whether the values of a key are in the ranges of all the columns of the range given but the first.
+func (s *sortedIndex) inRange(rang sql.Range, key sql.Row) (bool, error) {
+	for i := 1; i < len(rang); i++ {
+		typ := s.exprs[i].Type()
+		below, above := valueCuts(key[i], typ)
+
+		var in bool
+		for _, rce := range rang[i] {
+			lower, err := rce.LowerBound.Compare(below, typ)
+			if err != nil {
+				return false, err
+			}
+			upper, err := rce.UpperBound.Compare(above, typ)
+			if err != nil {
+				return false, err
+			}
+			if lower <= 0 && upper >= 0 {
+				in = true
+				break
+			}
+		}
+		if !in {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// valueCuts returns the cuts immediately below and above the value given.
+func valueCuts(v interface{}, typ sql.Type) (sql.RangeCut, sql.RangeCut) {
+	r := sql.ClosedRangeColumnExpr(v, v, typ)
+	return r.LowerBound, r.UpperBound
+}
+
+// boundFits returns whether the key of a bound of a range compares with the values of an index of the type given as
+// the expressions of a lookup compare them. Keys of another kind than the type, or that the type can't hold exactly,
+// are compared differently by those expressions, so they can't be looked up in the sorted values.
+func boundFits(typ sql.Type, cut sql.RangeCut) bool {
+	switch cut.(type) {
+	case sql.AboveAll, sql.BelowAll:
+		return true
+	}
+
+	key := sql.GetRangeCutKey(cut)
+	if key == nil {
+		return true
+	}
+
+	switch {
+	case sql.IsTextOnly(typ):
+		_, ok := key.(string)
+		return ok
+	case sql.IsNumber(typ):
+		converted, err := typ.Convert(key)
+		if err != nil {
+			return false
+		}
+		cmp, err := sql.Float64.Compare(key, converted)
+		return err == nil && cmp == 0
+	case sql.IsTime(typ):
+		// Times are compared as datetimes
+		key, err := sql.Datetime.Convert(key)
+		if err != nil {
+			return false
+		}
+		converted, err := typ.Convert(key)
+		if err != nil {
+			return false
+		}
+		t, ok := converted.(time.Time)
+		return ok && t.Equal(key.(time.Time))
+	default:
+		return false
+	}
+}
+
+// search is sort.Search for a function that can fail.
+func search(n int, f func(int) (bool, error)) (int, error) {
+	var err error
+	i := sort.Search(n, func(i int) bool {
+		if err != nil {
+			return true
+		}
+		ok, fErr := f(i)
+		if fErr != nil {
+			err = fErr
+			return true
+		}
+		return ok
+	})
+	return i, err
+}
+
+// rowIDs maps the positions of the rows of a partition to the ids of their index entries. Rows get increasing ids as
+// they're appended, and keep them when the rows before them are deleted. It's a Fenwick tree counting the rows still
+// in the partition by id, so that both directions of the mapping and its edits take logarithmic time.
+type rowIDs struct {
+	// tree is 1-based: tree[i] is the number of rows left with ids in [i - lowbit(i), i)
+	tree []int
+}
+
+// newRowIDs returns the ids of a partition with the number of rows given, which are their positions.
+func newRowIDs(n int) *rowIDs {
+	tree := make([]int, n+1)
+	for i := 1; i <= n; i++ {
+		tree[i]++
+		if j := i + i&-i; j <= n {
+			tree[j] += tree[i]
+		}
+	}
+	return &rowIDs{tree: tree}
+}
+
+// add returns the id of a row appended to the partition.
+func (r *rowIDs) add() int {
+	i := len(r.tree)
+	r.tree = append(r.tree, 1+r.count(i-1)-r.count(i-i&-i))
+	return i - 1
+}
+
+// remove removes the row with the id given from the partition.
+func (r *rowIDs) remove(id int) {
+	for i := id + 1; i < len(r.tree); i += i & -i {
+		r.tree[i]--
+	}
+}
+
+// position returns the position of the row with the id given.
+func (r *rowIDs) position(id int) int {
+	return r.count(id+1) - 1
+}
+
+// id returns the id of the row at the position given.
+func (r *rowIDs) id(pos int) int {
+	step := 1
+	for step*2 < len(r.tree) {
+		step *= 2
+	}
+
+	i, left := 0, pos+1
+	for ; step > 0; step /= 2 {
+		if i+step < len(r.tree) && r.tree[i+step] < left {
+			i += step
+			left -= r.tree[i]
+		}
+	}
+	return i
+}
+
+// count returns the number of rows left with ids lower than the one given.
+func (r *rowIDs) count(id int) int {
+	var n int
+	for i := id; i > 0; i -= i & -i {
+		n += r.tree[i]
+	}
+	return n
+}
@@ -15,7 +15,9 @@
 package memory
 
 import (
+	"fmt"
 	"io"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -41,11 +43,33 @@ func (eil *IndexLookup) String() string {
 }
 
 func (eil *IndexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
-	return &indexValIter{
-		tbl:             eil.idx.MemTable(),
-		partition:       p,
-		matchExpression: eil.EvalExpression(),
-	}, nil
+	positions, err := eil.positions(p)
+	if err != nil {
+		return nil, err
+	}
+	return &indexValIter{positions: positions}, nil
+}
+
+// positions returns the positions of the rows of the partition given matching this lookup, in ascending order. They're
+// found in the sorted data of the index if the lookup has ranges, and by evaluating the expression of the lookup on
+// every row of the partition otherwise.
+func (eil *IndexLookup) positions(p sql.Partition) ([]int, error) {
+	tbl := eil.idx.MemTable()
+	if _, ok := tbl.partitions[string(p.Key())]; !ok {
+		return nil, sql.ErrPartitionNotFound.New(p.Key())
+	}
+
+	if eil.ranges != nil && tbl.indexData != nil {
+		positions, ok, err := tbl.indexPositions(eil.idx.ColumnExpressions(), string(p.Key()), eil.ranges)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return positions, nil
+		}
+	}
+
+	return matchingPositions(tbl, p, eil.EvalExpression())
 }
 
 func (eil *IndexLookup) Indexes() []string {
@@ -66,62 +90,231 @@ func (eil *IndexLookup) Ranges() sql.RangeCollection {
 	return eil.ranges
 }
 
-// indexValIter does a very simple and verifiable iteration over the table values for a given index. It does this
-// by iterating over all the table rows for a Partition and evaluating each of them for inclusion in the index. This is
-// not an efficient way to store an index, and is only suitable for testing the correctness of index code in the engine.
+// indexValIter iterates over the positions of the rows of a partition found by a lookup.
 type indexValIter struct {
-	tbl             *Table
-	partition       sql.Partition
-	matchExpression sql.Expression
-	values          [][]byte
-	i               int
+	positions []int
+	i         int
 }
 
 func (u *indexValIter) Next() ([]byte, error) {
-	err := u.initValues()
+	if u.i >= len(u.positions) {
+		return nil, io.EOF
+	}
+
+	encoded, err := EncodeIndexValue(&IndexValue{
+		Pos: u.positions[u.i],
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if u.i < len(u.values) {
-		valBytes := u.values[u.i]
-		u.i++
-		return valBytes, nil
+	u.i++
+	return encoded, nil
+}
+
+func (u *indexValIter) Close(_ *sql.Context) error {
+	return nil
+}
+
+// matchingPositions returns the positions of the rows of a partition for which the expression given is true.
+func matchingPositions(tbl *Table, p sql.Partition, expr sql.Expression) ([]int, error) {
+	rows, ok := tbl.partitions[string(p.Key())]
+	if !ok {
+		return nil, sql.ErrPartitionNotFound.New(p.Key())
 	}
 
-	return nil, io.EOF
+	var positions []int
+	for i, row := range rows {
+		res, err := sql.EvaluateCondition(sql.NewEmptyContext(), expr, row)
+		if err != nil {
+			return nil, err
+		}
+
+		if sql.IsTrue(res) {
+			positions = append(positions, i)
+		}
+	}
+
+	return positions, nil
+}
+
+// evalLookup is a lookup of this package, which selects the rows of a table matching an expression.
+type evalLookup interface {
+	sql.IndexLookup
+	EvalExpression() sql.Expression
+	positions(p sql.Partition) ([]int, error)
 }
 
-func (u *indexValIter) initValues() error {
-	if u.values == nil {
-		rows, ok := u.tbl.partitions[string(u.partition.Key())]
-		if !ok {
-			return sql.ErrPartitionNotFound.New(u.partition.Key())
+var _ sql.MergeableIndexLookup = (*IndexLookup)(nil)
+
+// IsMergeable implements sql.MergeableIndexLookup.
+func (eil *IndexLookup) IsMergeable(lookup sql.IndexLookup) bool {
+	return isMergeable(eil, lookup)
+}
+
+// Union implements sql.MergeableIndexLookup.
+func (eil *IndexLookup) Union(lookups ...sql.IndexLookup) (sql.IndexLookup, error) {
+	return newMergedIndexLookup(eil, lookups, true)
+}
+
+// Intersection implements sql.MergeableIndexLookup.
+func (eil *IndexLookup) Intersection(lookups ...sql.IndexLookup) (sql.IndexLookup, error) {
+	return newMergedIndexLookup(eil, lookups, false)
+}
+
+// MergedIndexLookup is the union or intersection of lookups on different indexes of the same table.
+type MergedIndexLookup struct {
+	Unions        []sql.IndexLookup
+	Intersections []sql.IndexLookup
+}
+
+var _ sql.MergeableIndexLookup = (*MergedIndexLookup)(nil)
+var _ sql.DriverIndexLookup = (*MergedIndexLookup)(nil)
+
+func newMergedIndexLookup(lookup evalLookup, lookups []sql.IndexLookup, union bool) (*MergedIndexLookup, error) {
+	all := []sql.IndexLookup{lookup}
+	for _, l := range lookups {
+		if !isMergeable(lookup, l) {
+			return nil, fmt.Errorf("cannot merge index lookup %s with %s", lookup, l)
 		}
+		all = append(all, l)
+	}
 
-		for i, row := range rows {
-			res, err := sql.EvaluateCondition(sql.NewEmptyContext(), u.matchExpression, row)
-			if err != nil {
-				return err
-			}
+	if union {
+		return &MergedIndexLookup{Unions: all}, nil
+	}
+	return &MergedIndexLookup{Intersections: all}, nil
+}
 
-			if sql.IsTrue(res) {
-				encoded, err := EncodeIndexValue(&IndexValue{
-					Pos: i,
-				})
+func isMergeable(lookup evalLookup, other sql.IndexLookup) bool {
+	if _, ok := other.(evalLookup); !ok {
+		return false
+	}
+	idx, otherIdx := lookup.Index(), other.Index()
+	return idx.Database() == otherIdx.Database() && idx.Table() == otherIdx.Table()
+}
 
-				if err != nil {
-					return err
-				}
+func (m *MergedIndexLookup) lookups() []sql.IndexLookup {
+	if len(m.Unions) > 0 {
+		return m.Unions
+	}
+	return m.Intersections
+}
 
-				u.values = append(u.values, encoded)
-			}
+// EvalExpression returns the expression the rows of this lookup match.
+func (m *MergedIndexLookup) EvalExpression() sql.Expression {
+	var result sql.Expression
+	for _, l := range m.lookups() {
+		expr := l.(evalLookup).EvalExpression()
+		switch {
+		case result == nil:
+			result = expr
+		case len(m.Unions) > 0:
+			result = or(result, expr)
+		default:
+			result = and(result, expr)
 		}
 	}
+	return result
+}
 
-	return nil
+// Index implements sql.IndexLookup. It returns the index of the first lookup merged.
+func (m *MergedIndexLookup) Index() sql.Index {
+	return m.lookups()[0].Index()
 }
 
-func (u *indexValIter) Close(_ *sql.Context) error {
+// Ranges implements sql.IndexLookup. A merged lookup spans several indexes, so it has no ranges of its own.
+func (m *MergedIndexLookup) Ranges() sql.RangeCollection {
 	return nil
 }
+
+// IsMergeable implements sql.MergeableIndexLookup.
+func (m *MergedIndexLookup) IsMergeable(lookup sql.IndexLookup) bool {
+	return isMergeable(m, lookup)
+}
+
+// Union implements sql.MergeableIndexLookup.
+func (m *MergedIndexLookup) Union(lookups ...sql.IndexLookup) (sql.IndexLookup, error) {
+	return newMergedIndexLookup(m, lookups, true)
+}
+
+// Intersection implements sql.MergeableIndexLookup.
+func (m *MergedIndexLookup) Intersection(lookups ...sql.IndexLookup) (sql.IndexLookup, error) {
+	return newMergedIndexLookup(m, lookups, false)
+}
+
+// Values implements sql.DriverIndexLookup.
+func (m *MergedIndexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
+	positions, err := m.positions(p)
+	if err != nil {
+		return nil, err
+	}
+	return &indexValIter{positions: positions}, nil
+}
+
+// positions returns the positions of the rows of the partition given found by any of the lookups merged for a union,
+// or by all of them for an intersection, in ascending order.
+func (m *MergedIndexLookup) positions(p sql.Partition) ([]int, error) {
+	var result []int
+	for i, l := range m.lookups() {
+		positions, err := l.(evalLookup).positions(p)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result = positions
+		} else {
+			result = mergePositions(result, positions, len(m.Unions) > 0)
+		}
+	}
+	return result, nil
+}
+
+// mergePositions returns the union or the intersection of two ascending lists of positions, in ascending order.
+func mergePositions(a, b []int, union bool) []int {
+	var merged []int
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			if union {
+				merged = append(merged, a[i])
+			}
+			i++
+		case a[i] > b[j]:
+			if union {
+				merged = append(merged, b[j])
+			}
+			j++
+		default:
+			merged = append(merged, a[i])
+			i++
+			j++
+		}
+	}
+	if union {
+		merged = append(merged, a[i:]...)
+		merged = append(merged, b[j:]...)
+	}
+	return merged
+}
+
+// Indexes implements sql.DriverIndexLookup.
+func (m *MergedIndexLookup) Indexes() []string {
+	var ids []string
+	for _, l := range m.lookups() {
+		ids = append(ids, l.(sql.DriverIndexLookup).Indexes()...)
+	}
+	return ids
+}
+
+func (m *MergedIndexLookup) String() string {
+	strs := make([]string, len(m.lookups()))
+	for i, l := range m.lookups() {
+		strs[i] = l.String()
+	}
+	if len(m.Unions) > 0 {
+		return strings.Join(strs, " OR ")
+	}
+	return strings.Join(strs, " AND ")
+}
@@ -17,6 +17,7 @@ type memoryDBProvider struct {
 
 var _ sql.DatabaseProvider = memoryDBProvider{}
 var _ sql.MutableDatabaseProvider = memoryDBProvider{}
+var _ sql.SessionDatabaseProvider = memoryDBProvider{}
 
 func NewMemoryDBProvider(dbs ...sql.Database) sql.MutableDatabaseProvider {
 	dbMap := make(map[string]sql.Database, len(dbs))
@@ -92,3 +93,8 @@ func (d memoryDBProvider) DropDatabase(ctx *sql.Context, name string) (err error
 	delete(d.dbs, strings.ToLower(name))
 	return
 }
+
+// NewSessionDatabase implements SessionDatabaseProvider.
+func (d memoryDBProvider) NewSessionDatabase(ctx *sql.Context, name string) (sql.Database, error) {
+	return NewDatabase(name), nil
+}
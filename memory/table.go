@@ -22,6 +22,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	errors "gopkg.in/src-d/go-errors.v1"
@@ -39,6 +40,7 @@ type Table struct {
 	foreignKeys      []sql.ForeignKeyConstraint
 	checks           []sql.CheckDefinition
 	pkIndexesEnabled bool
+	temporary        bool
 
 	// pushdown info
 	filters    []sql.Expression // currently unused, filter pushdown is significantly broken right now
@@ -49,11 +51,16 @@ type Table struct {
 	partitions    map[string][]sql.Row
 	partitionKeys [][]byte
 
+	// Declared partitioning, and the partitions read if they were pruned
+	partitionScheme    *sql.PartitionScheme
+	selectedPartitions map[string]bool
+
 	// Insert bookkeeping
 	insertPartIdx int
 
 	// Indexed lookups
-	lookup sql.IndexLookup
+	lookup    sql.IndexLookup
+	indexData *indexData
 
 	// AUTO_INCREMENT bookkeeping
 	autoIncVal interface{}
@@ -67,6 +74,7 @@ var _ sql.DeletableTable = (*Table)(nil)
 var _ sql.ReplaceableTable = (*Table)(nil)
 var _ sql.TruncateableTable = (*Table)(nil)
 var _ sql.DriverIndexableTable = (*Table)(nil)
+var _ sql.BatchedIndexAddressableTable = (*Table)(nil)
 var _ sql.AlterableTable = (*Table)(nil)
 var _ sql.IndexAlterableTable = (*Table)(nil)
 var _ sql.IndexedTable = (*Table)(nil)
@@ -78,6 +86,8 @@ var _ sql.AutoIncrementTable = (*Table)(nil)
 var _ sql.StatisticsTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
 var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
+var _ sql.TemporaryTable = (*Table)(nil)
+var _ sql.PartitionedTable = (*Table)(nil)
 
 // NewTable creates a new Table with the given name and schema.
 func NewTable(name string, schema sql.Schema) *Table {
@@ -114,16 +124,36 @@ func NewPartitionedTable(name string, schema sql.Schema, numPartitions int) *Tab
 		schema:        schema,
 		partitions:    partitions,
 		partitionKeys: keys,
+		indexData:     newIndexData(),
 		autoIncVal:    autoIncVal,
 		autoColIdx:    autoIncIdx,
 	}
 }
 
+// NewTableWithPartitionScheme creates a new Table with the given name and schema, whose rows are split into partitions
+// by the scheme given. The keys of its partitions are the names of the partitions of the scheme.
+func NewTableWithPartitionScheme(name string, schema sql.Schema, scheme *sql.PartitionScheme) *Table {
+	t := NewPartitionedTable(name, schema, 0)
+	t.partitions = make(map[string][]sql.Row, len(scheme.Partitions))
+	t.partitionKeys = nil
+	for _, p := range scheme.Partitions {
+		t.partitions[p.Name] = []sql.Row{}
+		t.partitionKeys = append(t.partitionKeys, []byte(p.Name))
+	}
+	t.partitionScheme = scheme
+	return t
+}
+
 // Name implements the sql.Table interface.
 func (t *Table) Name() string {
 	return t.name
 }
 
+// IsTemporary implements the sql.TemporaryTable interface.
+func (t *Table) IsTemporary() bool {
+	return t.temporary
+}
+
 // Schema implements the sql.Table interface.
 func (t *Table) Schema() sql.Schema {
 	return t.schema
@@ -142,6 +172,9 @@ func (t *Table) GetPartition(key string) []sql.Row {
 func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 	var keys [][]byte
 	for _, k := range t.partitionKeys {
+		if t.selectedPartitions != nil && !t.selectedPartitions[string(k)] {
+			continue
+		}
 		if rows, ok := t.partitions[string(k)]; ok && len(rows) > 0 {
 			keys = append(keys, k)
 		}
@@ -151,9 +184,42 @@ func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 
 // PartitionCount implements the sql.PartitionCounter interface.
 func (t *Table) PartitionCount(ctx *sql.Context) (int64, error) {
+	if t.selectedPartitions != nil {
+		return int64(len(t.selectedPartitions)), nil
+	}
 	return int64(len(t.partitions)), nil
 }
 
+// PartitionScheme implements the sql.PartitionedTable interface.
+func (t *Table) PartitionScheme() *sql.PartitionScheme {
+	return t.partitionScheme
+}
+
+// WithPartitions implements the sql.PartitionedTable interface.
+func (t *Table) WithPartitions(names []string) sql.Table {
+	nt := *t
+	nt.selectedPartitions = make(map[string]bool, len(names))
+	for _, name := range names {
+		nt.selectedPartitions[name] = true
+	}
+	return &nt
+}
+
+// insertPartition returns the key of the partition a new row is inserted into. It's the partition the row belongs to
+// if the table has a partitioning scheme, or the next of its partitions in turn otherwise.
+func (t *Table) insertPartition(ctx *sql.Context, row sql.Row) (string, error) {
+	if t.partitionScheme != nil {
+		return t.partitionScheme.PartitionOf(ctx, row)
+	}
+
+	key := string(t.partitionKeys[t.insertPartIdx])
+	t.insertPartIdx++
+	if t.insertPartIdx == len(t.partitionKeys) {
+		t.insertPartIdx = 0
+	}
+	return key, nil
+}
+
 // PartitionRows implements the sql.PartitionRows interface.
 func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
 	rows, ok := t.partitions[string(partition.Key())]
@@ -403,6 +469,7 @@ func (t *Table) Truncate(ctx *sql.Context) (int, error) {
 		count += len(t.partitions[key])
 		t.partitions[key] = nil
 	}
+	t.dropIndexData()
 	return count, nil
 }
 
@@ -493,6 +560,8 @@ func (t *Table) GetNextAutoIncrementValue(ctx *sql.Context, insertVal interface{
 
 func (t *Table) AddColumn(ctx *sql.Context, column *sql.Column, order *sql.ColumnOrder) error {
 	newColIdx := t.addColumnToSchema(ctx, column, order)
+	t.updateIndexes("", "")
+	t.updatePartitionScheme("", "")
 	return t.insertValueInRows(ctx, newColIdx, column.Default)
 }
 
@@ -556,10 +625,24 @@ func (t *Table) insertValueInRows(ctx *sql.Context, idx int, colDefault *sql.Col
 		}
 		t.partitions[k] = newP
 	}
+	t.dropIndexData()
 	return nil
 }
 
 func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
+	if t.partitionScheme != nil {
+		var partitioned bool
+		sql.Inspect(t.partitionScheme.Expression, func(e sql.Expression) bool {
+			if gf, ok := e.(*expression.GetField); ok && strings.EqualFold(gf.Name(), columnName) {
+				partitioned = true
+			}
+			return !partitioned
+		})
+		if partitioned {
+			return sql.ErrInvalidPartitionScheme.New(fmt.Sprintf("column %s is used in the partitioning function", columnName))
+		}
+	}
+
 	droppedCol := t.dropColumnFromSchema(ctx, columnName)
 	for k, p := range t.partitions {
 		newP := make([]sql.Row, len(p))
@@ -571,6 +654,8 @@ func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
 		}
 		t.partitions[k] = newP
 	}
+	t.updateIndexes(columnName, "")
+	t.updatePartitionScheme("", "")
 	return nil
 }
 
@@ -620,6 +705,7 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 		}
 	}
 
+	t.dropIndexData()
 	for k, p := range t.partitions {
 		newP := make([]sql.Row, len(p))
 		for i, row := range p {
@@ -641,9 +727,105 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 
 	_ = t.dropColumnFromSchema(ctx, columnName)
 	t.addColumnToSchema(ctx, column, order)
+	t.updateIndexes(columnName, column.Name)
+	t.updatePartitionScheme(columnName, column.Name)
 	return nil
 }
 
+// updatePartitionScheme updates the fields of the partitioning expression of the table after its schema changes,
+// renaming the column given.
+func (t *Table) updatePartitionScheme(oldName, newName string) {
+	if t.partitionScheme == nil {
+		return
+	}
+
+	expr, _ := expression.TransformUp(t.partitionScheme.Expression, func(e sql.Expression) (sql.Expression, error) {
+		if gf, ok := e.(*expression.GetField); ok {
+			name := gf.Name()
+			if oldName != "" && strings.EqualFold(name, oldName) {
+				name = newName
+			}
+			idx := t.schema.IndexOf(name, t.name)
+			col := t.schema[idx]
+			return expression.NewGetFieldWithTable(idx, col.Type, t.name, col.Name, col.Nullable), nil
+		}
+		return e, nil
+	})
+
+	scheme := *t.partitionScheme
+	scheme.Expression = expr
+	t.partitionScheme = &scheme
+}
+
+// rename renames the table, along with the source of its columns and its indexes.
+func (t *Table) rename(name string) {
+	t.name = name
+
+	schema := make(sql.Schema, len(t.schema))
+	for i, col := range t.schema {
+		renamed := *col
+		renamed.Source = name
+		schema[i] = &renamed
+	}
+	t.schema = schema
+
+	t.updateIndexes("", "")
+}
+
+// renameReferencedTable updates the foreign keys of the table referencing the table named oldName to reference
+// newName.
+func (t *Table) renameReferencedTable(oldName, newName string) {
+	for i, fk := range t.foreignKeys {
+		if strings.EqualFold(fk.ReferencedTable, oldName) {
+			t.foreignKeys[i].ReferencedTable = newName
+		}
+	}
+}
+
+// updateIndexes rebuilds the expressions of the indexes of the table after its schema changed, since they refer to
+// columns by their position. The column named oldName, if any, was renamed to newName, or dropped if newName is empty.
+// As in MySQL, dropped columns are removed from the indexes they're part of, and indexes left without columns are
+// dropped.
+func (t *Table) updateIndexes(oldName, newName string) {
+	t.dropIndexData()
+	for name, idx := range t.indexes {
+		memIdx, ok := idx.(*Index)
+		if !ok {
+			continue
+		}
+
+		var exprs []sql.Expression
+		for _, expr := range memIdx.Exprs {
+			gf, ok := expr.(*expression.GetField)
+			if !ok {
+				exprs = append(exprs, expr)
+				continue
+			}
+
+			colName := gf.Name()
+			if oldName != "" && strings.EqualFold(colName, oldName) {
+				if newName == "" {
+					continue
+				}
+				colName = newName
+			}
+
+			i, field := t.getField(colName)
+			exprs = append(exprs, expression.NewGetFieldWithTable(i, field.Type, t.name, field.Name, field.Nullable))
+		}
+
+		if len(exprs) == 0 {
+			delete(t.indexes, name)
+			continue
+		}
+
+		updated := *memIdx
+		updated.TableName = t.name
+		updated.Exprs = exprs
+		t.indexes[name] = &updated
+	}
+}
+
 func checkRow(schema sql.Schema, row sql.Row) error {
 	if len(row) != len(schema) {
 		return sql.ErrUnexpectedRowLength.New(len(schema), len(row))
@@ -693,6 +875,16 @@ func (t *Table) DebugString() string {
 		kind += fmt.Sprintf("Filtered on [%s]", strings.Join(filters, ", "))
 	}
 
+	if t.selectedPartitions != nil {
+		var partitions []string
+		for _, k := range t.partitionKeys {
+			if t.selectedPartitions[string(k)] {
+				partitions = append(partitions, string(k))
+			}
+		}
+		kind += fmt.Sprintf("Partitions [%s]", strings.Join(partitions, ", "))
+	}
+
 	if len(kind) == 0 {
 		return t.name
 	}
@@ -759,6 +951,325 @@ func (t *FilteredTable) WithProjection(colNames []string) sql.Table {
 	return &nt
 }
 
+// ExpressionProjectedTable is a Table that computes some simple expressions over its columns when they're pushed down
+// to it. Like FilteredTable, it's only used in unit tests, so that the query plans of the engine tests don't change.
+type ExpressionProjectedTable struct {
+	*Table
+	exprs []sql.Expression
+}
+
+var _ sql.ExpressionProjectedTable = (*ExpressionProjectedTable)(nil)
+
+func NewExpressionProjectedTable(name string, schema sql.Schema) *ExpressionProjectedTable {
+	return &ExpressionProjectedTable{
+		Table: NewTable(name, schema),
+	}
+}
+
+// projectableFunctions are the functions that ExpressionProjectedTable computes.
+var projectableFunctions = map[string]bool{
+	"substring": true,
+	"left":      true,
+	"right":     true,
+	"lower":     true,
+	"upper":     true,
+}
+
+// HandledProjections implements the sql.ExpressionProjectedTable interface. Casts and some string functions over the
+// columns of the table and literals are handled.
+func (t *ExpressionProjectedTable) HandledProjections(exprs []sql.Expression) []sql.Expression {
+	var handled []sql.Expression
+	for _, e := range exprs {
+		ok := true
+		sql.Inspect(e, func(e sql.Expression) bool {
+			switch e := e.(type) {
+			case nil:
+			case *expression.GetField:
+				ok = e.Table() == t.name && t.schema.Contains(e.Name(), t.name)
+			case *expression.Literal, *expression.Convert:
+			case sql.FunctionExpression:
+				ok = projectableFunctions[e.FunctionName()]
+			default:
+				ok = false
+			}
+			return ok
+		})
+
+		if ok {
+			handled = append(handled, e)
+		}
+	}
+
+	return handled
+}
+
+// WithProjectedExpressions implements the sql.ExpressionProjectedTable interface.
+func (t *ExpressionProjectedTable) WithProjectedExpressions(ctx *sql.Context, exprs []sql.Expression) sql.Table {
+	if len(exprs) == 0 {
+		return t
+	}
+
+	nt := *t
+	nt.exprs = exprs
+	return &nt
+}
+
+// WithProjection implements the sql.ProjectedTable interface.
+func (t *ExpressionProjectedTable) WithProjection(colNames []string) sql.Table {
+	table := t.Table.WithProjection(colNames)
+
+	nt := *t
+	nt.Table = table.(*Table)
+	return &nt
+}
+
+// Schema implements the sql.Table interface.
+func (t *ExpressionProjectedTable) Schema() sql.Schema {
+	schema := t.Table.Schema()
+	if len(t.exprs) == 0 {
+		return schema
+	}
+
+	schema = append(sql.Schema{}, schema...)
+	for _, e := range t.exprs {
+		schema = append(schema, &sql.Column{
+			Name:     e.String(),
+			Type:     e.Type(),
+			Source:   t.name,
+			Nullable: e.IsNullable(),
+		})
+	}
+	return schema
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *ExpressionProjectedTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	iter, err := t.Table.PartitionRows(ctx, partition)
+	if err != nil || len(t.exprs) == 0 {
+		return iter, err
+	}
+
+	return &expressionProjectionIter{ctx: ctx, exprs: t.exprs, iter: iter}, nil
+}
+
+// expressionProjectionIter appends the values of some expressions to the rows of a table.
+type expressionProjectionIter struct {
+	ctx   *sql.Context
+	exprs []sql.Expression
+	iter  sql.RowIter
+}
+
+func (i *expressionProjectionIter) Next() (sql.Row, error) {
+	row, err := i.iter.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(sql.Row, len(row), len(row)+len(i.exprs))
+	copy(result, row)
+	for _, e := range i.exprs {
+		v, err := e.Eval(i.ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+
+	return result, nil
+}
+
+func (i *expressionProjectionIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
+}
+
+// ZoneMapTable is a Table that keeps statistics of the values of its columns in each partition, computed when asked
+// for. Like FilteredTable, it's only used in unit tests, so that the query plans of the engine tests don't change.
+type ZoneMapTable struct {
+	*Table
+}
+
+var _ sql.ZoneMapTable = (*ZoneMapTable)(nil)
+
+func NewZoneMapTable(name string, schema sql.Schema, numPartitions int) *ZoneMapTable {
+	return &ZoneMapTable{
+		Table: NewPartitionedTable(name, schema, numPartitions),
+	}
+}
+
+// WithProjection implements the sql.ProjectedTable interface.
+func (t *ZoneMapTable) WithProjection(colNames []string) sql.Table {
+	table := t.Table.WithProjection(colNames)
+
+	nt := *t
+	nt.Table = table.(*Table)
+	return &nt
+}
+
+// PartitionStatistics implements the sql.ZoneMapTable interface.
+func (t *ZoneMapTable) PartitionStatistics(ctx *sql.Context, partition sql.Partition) (sql.PartitionStatistics, error) {
+	rows, ok := t.partitions[string(partition.Key())]
+	if !ok {
+		return sql.PartitionStatistics{}, sql.ErrPartitionNotFound.New(partition.Key())
+	}
+
+	stats := sql.PartitionStatistics{
+		RowCount: uint64(len(rows)),
+		Columns:  make(map[string]sql.ColumnStatistics, len(t.schema)),
+	}
+
+	for i, col := range t.schema {
+		var colStats sql.ColumnStatistics
+		for _, row := range rows {
+			v := row[i]
+			if v == nil {
+				colStats.NullCount++
+				continue
+			}
+
+			if colStats.Min == nil {
+				colStats.Min, colStats.Max = v, v
+				continue
+			}
+
+			cmp, err := col.Type.Compare(v, colStats.Min)
+			if err != nil {
+				return sql.PartitionStatistics{}, err
+			}
+			if cmp < 0 {
+				colStats.Min = v
+			}
+
+			cmp, err = col.Type.Compare(v, colStats.Max)
+			if err != nil {
+				return sql.PartitionStatistics{}, err
+			}
+			if cmp > 0 {
+				colStats.Max = v
+			}
+		}
+		stats.Columns[strings.ToLower(col.Name)] = colStats
+	}
+
+	return stats, nil
+}
+
+// RowFetchTable is a Table that supports late materialization by fetching its rows by primary key. Like FilteredTable,
+// it's only used in unit tests, so that the query plans of the engine tests don't change.
+type RowFetchTable struct {
+	*Table
+}
+
+var _ sql.RowFetchTable = (*RowFetchTable)(nil)
+
+func NewRowFetchTable(name string, schema sql.Schema) *RowFetchTable {
+	return &RowFetchTable{
+		Table: NewTable(name, schema),
+	}
+}
+
+// WithProjection implements the sql.ProjectedTable interface.
+func (t *RowFetchTable) WithProjection(colNames []string) sql.Table {
+	table := t.Table.WithProjection(colNames)
+
+	nt := *t
+	nt.Table = table.(*Table)
+	return &nt
+}
+
+// FetchRows implements the sql.RowFetchTable interface.
+func (t *RowFetchTable) FetchRows(ctx *sql.Context, keys []sql.Row) (sql.RowIter, error) {
+	var pkIdxs []int
+	for i, col := range t.schema {
+		if col.PrimaryKey {
+			pkIdxs = append(pkIdxs, i)
+		}
+	}
+
+	rows := make([]sql.Row, len(keys))
+	for _, k := range t.partitionKeys {
+		for _, row := range t.partitions[string(k)] {
+			for i, key := range keys {
+				if rows[i] != nil {
+					continue
+				}
+
+				matches, err := pkEquals(t.schema, pkIdxs, row, key)
+				if err != nil {
+					return nil, err
+				}
+				if matches {
+					rows[i] = row
+				}
+			}
+		}
+	}
+
+	for i, row := range rows {
+		if row == nil {
+			return nil, errRowNotFound.New(keys[i])
+		}
+	}
+
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// pkEquals returns whether the primary key columns of the row given are equal to the key given.
+func pkEquals(schema sql.Schema, pkIdxs []int, row, key sql.Row) (bool, error) {
+	for i, idx := range pkIdxs {
+		cmp, err := schema[idx].Type.Compare(row[idx], key[i])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// AnalyzableTable is a Table whose number of rows is only counted by ANALYZE TABLE, like the statistics of tables
+// stored on disk, so it's stale after the table changes. Like FilteredTable, it's only used in unit tests, so that the
+// query plans of the engine tests don't change.
+type AnalyzableTable struct {
+	*Table
+	numRows *uint64
+}
+
+var _ sql.AnalyzableTable = (*AnalyzableTable)(nil)
+
+func NewAnalyzableTable(name string, schema sql.Schema) *AnalyzableTable {
+	return &AnalyzableTable{
+		Table:   NewTable(name, schema),
+		numRows: new(uint64),
+	}
+}
+
+// WithProjection implements the sql.ProjectedTable interface.
+func (t *AnalyzableTable) WithProjection(colNames []string) sql.Table {
+	table := t.Table.WithProjection(colNames)
+
+	nt := *t
+	nt.Table = table.(*Table)
+	return &nt
+}
+
+// NumRows implements the sql.StatisticsTable interface. It returns the number of rows the table had when it was last
+// analyzed.
+func (t *AnalyzableTable) NumRows(ctx *sql.Context) (uint64, error) {
+	return atomic.LoadUint64(t.numRows), nil
+}
+
+// AnalyzeTable implements the sql.AnalyzableTable interface.
+func (t *AnalyzableTable) AnalyzeTable(ctx *sql.Context) error {
+	numRows, err := t.Table.NumRows(ctx)
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreUint64(t.numRows, numRows)
+	return nil
+}
+
 // WithProjection implements the sql.ProjectedTable interface.
 func (t *Table) WithProjection(colNames []string) sql.Table {
 	if len(colNames) == 0 {
@@ -1009,6 +1520,23 @@ func (t *Table) WithIndexLookup(lookup sql.IndexLookup) sql.Table {
 	return &nt
 }
 
+// IndexLookupBatch implements the sql.BatchedIndexAddressableTable interface.
+func (t *Table) IndexLookupBatch(ctx *sql.Context, lookups []sql.IndexLookup) ([]sql.RowIter, error) {
+	iters := make([]sql.RowIter, len(lookups))
+	for i, lookup := range lookups {
+		table := t.WithIndexLookup(lookup)
+		partitions, err := table.Partitions(ctx)
+		if err != nil {
+			for _, iter := range iters[:i] {
+				_ = iter.Close(ctx)
+			}
+			return nil, err
+		}
+		iters[i] = sql.NewTableRowIter(ctx, table, partitions)
+	}
+	return iters, nil
+}
+
 // IndexKeyValues implements the sql.IndexableTable interface.
 func (t *Table) IndexKeyValues(
 	ctx *sql.Context,
@@ -1085,6 +1613,7 @@ func (t *Table) CreatePrimaryKey(ctx *sql.Context, columns []sql.IndexColumn) er
 	t.schema = potentialSchema
 	t.partitions = newTable.partitions
 	t.partitionKeys = newTable.partitionKeys
+	t.dropIndexData()
 
 	return nil
 }
@@ -1110,7 +1639,12 @@ func copyschema(sch sql.Schema) sql.Schema {
 }
 
 func copyTable(t *Table, newSch sql.Schema) (*Table, error) {
-	newTable := NewPartitionedTable(t.name, newSch, len(t.partitions))
+	var newTable *Table
+	if t.partitionScheme != nil {
+		newTable = NewTableWithPartitionScheme(t.name, newSch, t.partitionScheme)
+	} else {
+		newTable = NewPartitionedTable(t.name, newSch, len(t.partitions))
+	}
 	for _, partition := range t.partitions {
 		for _, partitionRow := range partition {
 			err := newTable.Insert(sql.NewEmptyContext(), partitionRow)
@@ -1198,6 +1732,8 @@ func (i *partitionIndexKeyValueIter) Close(ctx *sql.Context) error {
 
 var errColumnNotFound = errors.NewKind("could not find column %s")
 
+var errRowNotFound = errors.NewKind("could not find row with primary key %v")
+
 type indexKeyValueIter struct {
 	key     string
 	iter    sql.RowIter
@@ -16,6 +16,7 @@ package memory
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -55,6 +56,7 @@ func (t *tableEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) e
 	t.table.insertPartIdx = t.initialInsert
 	t.table.autoIncVal = t.initialAutoIncVal
 	t.table.partitions = t.initialPartitions
+	t.table.dropIndexData()
 	t.ea.Clear()
 	return nil
 }
@@ -68,6 +70,9 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 	if err := checkRow(t.table.schema, row); err != nil {
 		return err
 	}
+	if err := t.checkPartition(ctx, row); err != nil {
+		return err
+	}
 
 	partitionRow, added, err := t.ea.Get(row)
 	if err != nil {
@@ -126,6 +131,9 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 	if err := checkRow(t.table.schema, newRow); err != nil {
 		return err
 	}
+	if err := t.checkPartition(ctx, newRow); err != nil {
+		return err
+	}
 
 	err := t.ea.Delete(oldRow)
 	if err != nil {
@@ -162,6 +170,15 @@ func (t *tableEditor) SetAutoIncrementValue(ctx *sql.Context, val interface{}) e
 	return nil
 }
 
+// checkPartition returns an error if the table is partitioned and has no partition for the row given.
+func (t *tableEditor) checkPartition(ctx *sql.Context, row sql.Row) error {
+	if t.table.partitionScheme == nil {
+		return nil
+	}
+	_, err := t.table.partitionScheme.PartitionOf(ctx, row)
+	return err
+}
+
 func (t *tableEditor) pkColumnIndexes() []int {
 	var pkColIdxes []int
 	for _, column := range t.table.schema {
@@ -216,63 +233,58 @@ func NewTableEditAccumulator(t *Table) tableEditAccumulator {
 
 	return &pkTableEditAccumulator{
 		table:   t,
-		adds:    make(map[uint64]sql.Row),
-		deletes: make(map[uint64]sql.Row),
+		adds:    make(map[string]sql.Row),
+		deletes: make(map[string]sql.Row),
 	}
 }
 
 // pkTableEditAccumulator manages the updates of keyed tables. It uses a map to efficiently toggle edits.
 type pkTableEditAccumulator struct {
 	table   *Table
-	adds    map[uint64]sql.Row
-	deletes map[uint64]sql.Row
+	adds    map[string]sql.Row
+	deletes map[string]sql.Row
 }
 
 var _ tableEditAccumulator = (*pkTableEditAccumulator)(nil)
 
 // Insert implements the tableEditAccumulator interface.
 func (pke *pkTableEditAccumulator) Insert(value sql.Row) error {
-	pks := pke.getPks(value)
-
-	pkHash, err := sql.HashOf(pks)
+	key, err := pke.pkKey(value)
 	if err != nil {
 		return err
 	}
 
-	delete(pke.deletes, pkHash)
-	pke.adds[pkHash] = value
+	delete(pke.deletes, key)
+	pke.adds[key] = value
 	return nil
 }
 
 // Delete implements the tableEditAccumulator interface.
 func (pke *pkTableEditAccumulator) Delete(value sql.Row) error {
-	pks := pke.getPks(value)
-
-	pkHash, err := sql.HashOf(pks)
+	key, err := pke.pkKey(value)
 	if err != nil {
 		return err
 	}
 
-	delete(pke.adds, pkHash)
-	pke.deletes[pkHash] = value
+	delete(pke.adds, key)
+	pke.deletes[key] = value
 
 	return nil
 }
 
 // Get implements the tableEditAccumulator interface.
 func (pke *pkTableEditAccumulator) Get(value sql.Row) (sql.Row, bool, error) {
-	pks := pke.getPks(value)
-	pkHash, err := sql.HashOf(pks)
+	key, err := pke.pkKey(value)
 	if err != nil {
 		return nil, false, err
 	}
 
-	r, exists := pke.adds[pkHash]
+	r, exists := pke.adds[key]
 	if exists {
 		return r, true, nil
 	}
 
-	r, exists = pke.deletes[pkHash]
+	r, exists = pke.deletes[key]
 	if exists {
 		return r, false, nil
 	}
@@ -310,16 +322,15 @@ func (pke *pkTableEditAccumulator) ApplyEdits(ctx *sql.Context) error {
 
 // Clear implements the tableEditAccumulator interface.
 func (pke *pkTableEditAccumulator) Clear() {
-	pke.adds = make(map[uint64]sql.Row)
-	pke.deletes = make(map[uint64]sql.Row)
+	pke.adds = make(map[string]sql.Row)
+	pke.deletes = make(map[string]sql.Row)
 }
 
 // pkColumnIndexes returns the indexes of the primary partitionKeys in the initialized table.
 func (pke *pkTableEditAccumulator) pkColumnIndexes() []int {
 	var pkColIdxes []int
-	for _, column := range pke.table.schema {
+	for idx, column := range pke.table.schema {
 		if column.PrimaryKey {
-			idx, _ := pke.table.getField(column.Name)
 			pkColIdxes = append(pkColIdxes, idx)
 		}
 	}
@@ -338,6 +349,32 @@ func (pke *pkTableEditAccumulator) getPks(r sql.Row) sql.Row {
 	return ret
 }
 
+// pkKey returns the key the edits of a row are tracked by. It's the sort key of the row's primary key, so rows with
+// equal primary keys get the same key even when their values have different Go types. Primary keys with types that
+// have no sort key are hashed instead.
+func (pke *pkTableEditAccumulator) pkKey(r sql.Row) (string, error) {
+	pks := pke.getPks(r)
+
+	types := make([]sql.Type, 0, len(pks))
+	for _, idx := range pke.pkColumnIndexes() {
+		typ := pke.table.schema[idx].Type
+		if !sql.HasSortKey(typ) {
+			hash, err := sql.HashOf(pks)
+			if err != nil {
+				return "", err
+			}
+			return strconv.FormatUint(hash, 16), nil
+		}
+		types = append(types, typ)
+	}
+
+	key, err := sql.EncodeRowSortKey(types, pks)
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
+
 // deleteHelper deletes the given row from the table.
 func (pke *pkTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Table, row sql.Row) error {
 	if err := checkRow(table.schema, row); err != nil {
@@ -355,6 +392,7 @@ func (pke *pkTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Table,
 			if len(pkColIdxes) > 0 {
 				if columnsMatch(pkColIdxes, partitionRow, row) {
 					table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
+					table.indexRowDeleted(partitionIndex, partitionRowIndex, partitionRow)
 					break
 				}
 			}
@@ -367,6 +405,7 @@ func (pke *pkTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Table,
 
 			if matches {
 				table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
+				table.indexRowDeleted(partitionIndex, partitionRowIndex, partitionRow)
 				break
 			}
 		}
@@ -380,10 +419,9 @@ func (pke *pkTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Table,
 
 // insertHelper inserts the given row into the given table.
 func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table, row sql.Row) error {
-	key := string(table.partitionKeys[table.insertPartIdx])
-	table.insertPartIdx++
-	if table.insertPartIdx == len(table.partitionKeys) {
-		table.insertPartIdx = 0
+	key, err := table.insertPartition(ctx, row)
+	if err != nil {
+		return err
 	}
 
 	pkColIdxes := pke.pkColumnIndexes()
@@ -404,9 +442,12 @@ func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table,
 	}
 
 	if savedPartitionRowIndex > -1 {
+		oldRow := table.partitions[savedPartitionIndex][savedPartitionRowIndex]
 		table.partitions[savedPartitionIndex][savedPartitionRowIndex] = row
+		table.indexRowReplaced(savedPartitionIndex, savedPartitionRowIndex, oldRow, row)
 	} else {
 		table.partitions[key] = append(table.partitions[key], row)
+		table.indexRowInserted(key, row)
 	}
 
 	return nil
@@ -506,6 +547,7 @@ func (k *keylessTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Tabl
 
 			if matches {
 				table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
+				table.indexRowDeleted(partitionIndex, partitionRowIndex, partitionRow)
 				break
 			}
 		}
@@ -519,13 +561,13 @@ func (k *keylessTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Tabl
 
 // insertHelper inserts into a keyless table.
 func (k *keylessTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table, row sql.Row) error {
-	key := string(table.partitionKeys[table.insertPartIdx])
-	table.insertPartIdx++
-	if table.insertPartIdx == len(table.partitionKeys) {
-		table.insertPartIdx = 0
+	key, err := table.insertPartition(ctx, row)
+	if err != nil {
+		return err
 	}
 
 	table.partitions[key] = append(table.partitions[key], row)
+	table.indexRowInserted(key, row)
 
 	return nil
 }
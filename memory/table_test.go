@@ -17,6 +17,7 @@ package memory_test
 import (
 	"fmt"
 	"io"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -417,3 +418,190 @@ func TestTableIndexKeyValueIter(t *testing.T) {
 		})
 	}
 }
+
+func TestAlterIndexedColumns(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t"},
+		{Name: "b", Type: sql.Int64, Source: "t"},
+		{Name: "c", Type: sql.Int64, Source: "t"},
+	})
+	require.NoError(table.CreateIndex(ctx, "ab", sql.IndexUsing_BTree, sql.IndexConstraint_None, []sql.IndexColumn{{Name: "a"}, {Name: "b"}}, ""))
+	require.NoError(table.CreateIndex(ctx, "c", sql.IndexUsing_BTree, sql.IndexConstraint_None, []sql.IndexColumn{{Name: "c"}}, ""))
+
+	indexExprs := func() map[string][]string {
+		indexes, err := table.GetIndexes(ctx)
+		require.NoError(err)
+		exprs := make(map[string][]string)
+		for _, idx := range indexes {
+			for _, e := range idx.(*memory.Index).Exprs {
+				exprs[idx.ID()] = append(exprs[idx.ID()], fmt.Sprintf("%s@%d", e, e.(*expression.GetField).Index()))
+			}
+		}
+		return exprs
+	}
+
+	require.NoError(table.AddColumn(ctx, &sql.Column{Name: "d", Type: sql.Int64, Nullable: true}, &sql.ColumnOrder{First: true}))
+	require.Equal(map[string][]string{
+		"ab": {"t.a@1", "t.b@2"},
+		"c":  {"t.c@3"},
+	}, indexExprs())
+
+	require.NoError(table.ModifyColumn(ctx, "b", &sql.Column{Name: "b2", Type: sql.Int64, Nullable: true}, nil))
+	require.Equal(map[string][]string{
+		"ab": {"t.a@1", "t.b2@2"},
+		"c":  {"t.c@3"},
+	}, indexExprs())
+
+	require.NoError(table.DropColumn(ctx, "a"))
+	require.NoError(table.DropColumn(ctx, "c"))
+	require.Equal(map[string][]string{
+		"ab": {"t.b2@1"},
+	}, indexExprs())
+}
+
+func TestIndexLookupsAfterEdits(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "b", Type: sql.Text, Source: "t", Nullable: true},
+	}, 2)
+	require.NoError(table.CreateIndex(ctx, "b", sql.IndexUsing_BTree, sql.IndexConstraint_None, []sql.IndexColumn{{Name: "b"}}, ""))
+	for i := int64(1); i <= 6; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(i, fmt.Sprintf("v%d", i%3))))
+	}
+
+	indexes, err := table.GetIndexes(ctx)
+	require.NoError(err)
+	lookupRows := func(ranges ...sql.Range) []sql.Row {
+		lookup, err := indexes[0].NewLookup(ctx, ranges...)
+		require.NoError(err)
+		rows := getAllRows(t, table.WithIndexLookup(lookup))
+		sort.Slice(rows, func(i, j int) bool { return rows[i][0].(int64) < rows[j][0].(int64) })
+		return rows
+	}
+	equals := func(key interface{}) sql.Range {
+		return sql.Range{{sql.ClosedRangeColumnExpr(key, key, sql.Text)}}
+	}
+
+	require.Equal([]sql.Row{{int64(1), "v1"}, {int64(4), "v1"}}, lookupRows(equals("v1")))
+	require.Equal([]sql.Row{{int64(1), "v1"}, {int64(3), "v0"}, {int64(4), "v1"}, {int64(6), "v0"}},
+		lookupRows(sql.Range{{sql.LessOrEqualRangeColumnExpr("v1", sql.Text)}}))
+
+	// Lookups see the edits of statements once they're applied, and not the ones of statements rolled back
+	editor := table.Updater(ctx)
+	editor.StatementBegin(ctx)
+	require.NoError(editor.Update(ctx, sql.NewRow(int64(1), "v1"), sql.NewRow(int64(1), "v2")))
+	require.NoError(editor.Close(ctx))
+
+	deleter := table.Deleter(ctx)
+	deleter.StatementBegin(ctx)
+	require.NoError(deleter.Delete(ctx, sql.NewRow(int64(3), "v0")))
+	require.NoError(deleter.Close(ctx))
+
+	inserter := table.Inserter(ctx)
+	inserter.StatementBegin(ctx)
+	require.NoError(inserter.Insert(ctx, sql.NewRow(int64(7), "v1")))
+	require.NoError(inserter.Insert(ctx, sql.NewRow(int64(8), nil)))
+	require.NoError(inserter.Close(ctx))
+
+	require.Equal([]sql.Row{{int64(4), "v1"}, {int64(7), "v1"}}, lookupRows(equals("v1")))
+	require.Equal([]sql.Row{{int64(1), "v2"}, {int64(2), "v2"}, {int64(5), "v2"}}, lookupRows(equals("v2")))
+	require.Equal([]sql.Row{{int64(8), nil}}, lookupRows(equals(nil)))
+
+	inserter = table.Inserter(ctx)
+	inserter.StatementBegin(ctx)
+	require.NoError(inserter.Insert(ctx, sql.NewRow(int64(9), "v1")))
+	require.NoError(inserter.Close(ctx))
+	require.NoError(inserter.DiscardChanges(ctx, nil))
+
+	require.Equal([]sql.Row{{int64(4), "v1"}, {int64(7), "v1"}}, lookupRows(equals("v1")))
+	require.Equal([]sql.Row{{int64(6), "v0"}}, lookupRows(equals("v0"), sql.Range{{sql.EmptyRangeColumnExpr(sql.Text)}}))
+}
+
+func TestIndexLookupsAfterManyEdits(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "b", Type: sql.Int64, Source: "t", Nullable: true},
+	}, 3)
+	require.NoError(table.CreateIndex(ctx, "b", sql.IndexUsing_BTree, sql.IndexConstraint_None, []sql.IndexColumn{{Name: "b"}}, ""))
+	for i := int64(0); i < 200; i++ {
+		require.NoError(table.Insert(ctx, sql.NewRow(i, i%7)))
+	}
+
+	indexes, err := table.GetIndexes(ctx)
+	require.NoError(err)
+	lookupKeys := func(b int64) []int64 {
+		lookup, err := indexes[0].NewLookup(ctx, sql.Range{{sql.ClosedRangeColumnExpr(b, b, sql.Int64)}})
+		require.NoError(err)
+		var keys []int64
+		for _, row := range getAllRows(t, table.WithIndexLookup(lookup)) {
+			keys = append(keys, row[0].(int64))
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		return keys
+	}
+
+	// Lookups from several sessions build the data of the index at the same time
+	start := make(chan struct{})
+	done := make(chan []int64)
+	for i := 0; i < 8; i++ {
+		go func() {
+			<-start
+			done <- lookupKeys(3)
+		}()
+	}
+	close(start)
+	for i := 0; i < 8; i++ {
+		require.Len(<-done, 29)
+	}
+
+	expected := make(map[int64]int64)
+	for i := int64(0); i < 200; i++ {
+		expected[i] = i % 7
+	}
+
+	deleter := table.Deleter(ctx)
+	deleter.StatementBegin(ctx)
+	for i := int64(0); i < 200; i += 3 {
+		require.NoError(deleter.Delete(ctx, sql.NewRow(i, i%7)))
+		delete(expected, i)
+	}
+	require.NoError(deleter.Close(ctx))
+
+	updater := table.Updater(ctx)
+	updater.StatementBegin(ctx)
+	for i := int64(1); i < 200; i += 5 {
+		if _, ok := expected[i]; ok {
+			require.NoError(updater.Update(ctx, sql.NewRow(i, i%7), sql.NewRow(i, int64(3))))
+			expected[i] = 3
+		}
+	}
+	require.NoError(updater.Close(ctx))
+
+	inserter := table.Inserter(ctx)
+	inserter.StatementBegin(ctx)
+	for i := int64(200); i < 220; i++ {
+		require.NoError(inserter.Insert(ctx, sql.NewRow(i, i%7)))
+		expected[i] = i % 7
+	}
+	require.NoError(inserter.Close(ctx))
+
+	for b := int64(0); b < 7; b++ {
+		var keys []int64
+		for a, v := range expected {
+			if v == b {
+				keys = append(keys, a)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		require.Equal(keys, lookupKeys(b), "b = %d", b)
+	}
+}
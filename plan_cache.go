@@ -0,0 +1,302 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// ErrWarmUp is returned by Engine.WarmUp when one of the statements given can't be parsed or analyzed.
+var ErrWarmUp = errors.NewKind("unable to warm up statement %q: %s")
+
+// PlanCache keeps the plans of SELECT statements so that running the same statement again skips parsing and analyzing
+// it. Statements are identified by their normalized text, the session that runs them and its current database.
+//
+// All plans are discarded after any DDL statement, since it can change the schemas they were analyzed against, and
+// after any SET statement, since the values of variables can change how statements are analyzed. Integrators that
+// change schemas by other means must call Invalidate.
+//
+// Statements run with bindings, such as prepared statements with placeholders, only reuse their parsed tree, since
+// their analysis depends on the values bound to them.
+//
+// The plans of statements given to Engine.WarmUp are kept apart from the rest: they're shared by every session with
+// the same current database, sql_mode and time_zone, never evicted, and only discarded after DDL statements or by
+// Invalidate. Sessions with temporary tables in their current database don't use them, since their temporary tables
+// may shadow the tables the plans read.
+type PlanCache struct {
+	cache *lru.Cache
+	mu    sync.RWMutex
+	warm  map[warmPlanKey]*cachedPlan
+}
+
+type warmPlanKey struct {
+	db       string
+	query    string
+	sqlMode  string
+	timeZone string
+}
+
+type planCacheKey struct {
+	session uint32
+	db      string
+	query   string
+}
+
+// cachedPlan is the parsed tree of a statement and, if it can be reused, its analyzed plan.
+type cachedPlan struct {
+	parsed   sql.Node
+	analyzed sql.Node
+}
+
+// NewPlanCache returns a new PlanCache holding the plans of up to size statements.
+func NewPlanCache(size int) *PlanCache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &PlanCache{
+		cache: cache,
+		warm:  make(map[warmPlanKey]*cachedPlan),
+	}
+}
+
+// Invalidate discards all the plans in the cache, including the ones of warmed up statements.
+func (c *PlanCache) Invalidate() {
+	c.cache.Purge()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.warm = make(map[warmPlanKey]*cachedPlan)
+}
+
+// Len returns the number of statements in the cache.
+func (c *PlanCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cache.Len() + len(c.warm)
+}
+
+// cachedPlans returns the statements in the cache: first the ones of sessions, from the least to the most recently
+// used, and then the warmed up ones, sorted by database and query.
+func (c *PlanCache) cachedPlans() []sql.CachedPlan {
+	var plans []sql.CachedPlan
+	for _, k := range c.cache.Keys() {
+		v, ok := c.cache.Peek(k)
+		if !ok {
+			continue
+		}
+
+		key := k.(planCacheKey)
+		plans = append(plans, sql.CachedPlan{
+			Session:  key.session,
+			Database: key.db,
+			Query:    key.query,
+			Analyzed: v.(*cachedPlan).analyzed != nil,
+		})
+	}
+
+	c.mu.RLock()
+	warm := make([]sql.CachedPlan, 0, len(c.warm))
+	for key, p := range c.warm {
+		warm = append(warm, sql.CachedPlan{
+			Database: key.db,
+			Query:    key.query,
+			Analyzed: p.analyzed != nil,
+		})
+	}
+	c.mu.RUnlock()
+
+	sort.Slice(warm, func(i, j int) bool {
+		if warm[i].Database != warm[j].Database {
+			return warm[i].Database < warm[j].Database
+		}
+		return warm[i].Query < warm[j].Query
+	})
+
+	return append(plans, warm...)
+}
+
+func (c *PlanCache) get(ctx *sql.Context, query string) (*cachedPlan, bool) {
+	key := newPlanCacheKey(ctx, query)
+	if v, ok := c.cache.Get(key); ok {
+		return v.(*cachedPlan), true
+	}
+
+	if hasTemporaryTables(ctx) {
+		return nil, false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	p, ok := c.warm[newWarmPlanKey(ctx, query)]
+	return p, ok
+}
+
+func (c *PlanCache) add(ctx *sql.Context, query string, p *cachedPlan) {
+	c.cache.Add(newPlanCacheKey(ctx, query), p)
+}
+
+func (c *PlanCache) addWarm(ctx *sql.Context, query string, p *cachedPlan) {
+	if hasTemporaryTables(ctx) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.warm[newWarmPlanKey(ctx, query)] = p
+}
+
+// invalidateFor discards the plans made stale by running the statement given: all of them after DDL statements, and
+// all but the ones of warmed up statements after SET statements.
+func (c *PlanCache) invalidateFor(n sql.Node) {
+	if plan.IsDDLNode(n) {
+		c.Invalidate()
+	} else if _, ok := n.(*plan.Set); ok {
+		c.cache.Purge()
+	}
+}
+
+func newPlanCacheKey(ctx *sql.Context, query string) planCacheKey {
+	return planCacheKey{
+		session: ctx.ID(),
+		db:      ctx.GetCurrentDatabase(),
+		query:   normalizeQuery(query),
+	}
+}
+
+// newWarmPlanKey returns the key of the warmed up plan of the query given, which includes the session variables that
+// change how statements are analyzed.
+func newWarmPlanKey(ctx *sql.Context, query string) warmPlanKey {
+	return warmPlanKey{
+		db:       ctx.GetCurrentDatabase(),
+		query:    normalizeQuery(query),
+		sqlMode:  sessionVariableString(ctx, "sql_mode"),
+		timeZone: sessionVariableString(ctx, "time_zone"),
+	}
+}
+
+func sessionVariableString(ctx *sql.Context, name string) string {
+	val, err := ctx.GetSessionVariable(ctx, name)
+	if err != nil {
+		return ""
+	}
+	s, _ := val.(string)
+	return s
+}
+
+// hasTemporaryTables returns whether the session of the context given has temporary tables in its current database.
+func hasTemporaryTables(ctx *sql.Context) bool {
+	tts, ok := ctx.Session.(sql.TemporaryTableSession)
+	return ok && len(tts.GetAllTemporaryTables(ctx.GetCurrentDatabase())) > 0
+}
+
+// normalizeQuery collapses the runs of whitespace of the query given outside of quotes into single spaces, and drops
+// its leading and trailing whitespace and semicolons.
+func normalizeQuery(query string) string {
+	var sb strings.Builder
+	var quote rune
+	space := false
+	for _, r := range strings.Trim(query, " \t\r\n;") {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == ' ' || r == '\t' || r == '\r' || r == '\n':
+			space = true
+			continue
+		}
+
+		if space {
+			sb.WriteByte(' ')
+			space = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// cacheablePlan returns whether the analyzed plan given can be executed more than once. Plans with nodes or
+// expressions that keep state between executions, such as cached subquery results, and plans that depend on the
+// values of user variables, which could have been folded into them during analysis, can't.
+func cacheablePlan(n sql.Node) bool {
+	if !n.Resolved() {
+		return false
+	}
+
+	cacheable := true
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n.(type) {
+		case *plan.HashLookup, sql.Disposable:
+			cacheable = false
+		}
+		return cacheable
+	})
+
+	plan.InspectExpressions(n, func(e sql.Expression) bool {
+		switch e.(type) {
+		case *plan.Subquery, *expression.UserVar, *expression.BindVar, sql.Disposable:
+			cacheable = false
+		}
+		return cacheable
+	})
+
+	return cacheable
+}
+
+// WarmUp parses and analyzes the statements given with the context given, so that statements referencing tables,
+// columns or functions that don't exist are reported before they're run. If the engine has a plan cache, the plans of
+// the SELECT statements are kept in it and reused by every session that runs them with the same current database,
+// sql_mode and time_zone as the context given, which saves their first executions from being analyzed. These plans
+// are kept until a DDL statement is run or the cache is invalidated.
+//
+// The first statement that can't be parsed or analyzed stops the warm up, returning an ErrWarmUp.
+func (e *Engine) WarmUp(ctx *sql.Context, queries ...string) error {
+	for _, query := range queries {
+		parsed, err := parse.Parse(ctx, query)
+		if err != nil {
+			return ErrWarmUp.New(query, err)
+		}
+
+		analyzed, err := e.Analyzer.AnalyzeForCache(ctx, parsed, nil)
+		if err != nil {
+			return ErrWarmUp.New(query, err)
+		}
+
+		if e.PlanCache == nil || !selectStatementRegex.MatchString(query) {
+			continue
+		}
+
+		entry := &cachedPlan{parsed: parsed}
+		if cacheablePlan(analyzed) {
+			entry.analyzed = analyzed
+		}
+		e.PlanCache.addWarm(ctx, query, entry)
+	}
+
+	return nil
+}
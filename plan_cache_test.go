@@ -0,0 +1,148 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+)
+
+func TestPlanCache(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	table := memory.NewTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	})
+	db.AddTable("t", table)
+
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), &Config{PlanCacheSize: 10})
+	ctx := sql.NewEmptyContext().WithCurrentDB("mydb")
+
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	query("INSERT INTO t VALUES (1)")
+	require.Equal(0, e.PlanCache.Len())
+
+	require.Equal([]sql.Row{{int64(1)}}, query("SELECT * FROM t"))
+	require.Equal(1, e.PlanCache.Len())
+
+	// The cached plan reads the current rows of the table
+	query("INSERT INTO t VALUES (2)")
+	require.Equal([]sql.Row{{int64(1)}, {int64(2)}}, query("SELECT  *\n FROM t;"))
+	require.Equal(1, e.PlanCache.Len())
+
+	// Plans with subqueries keep their results between executions, so only their parsed tree is cached
+	require.Equal([]sql.Row{{int64(2)}}, query("SELECT a FROM t WHERE a = (SELECT MAX(a) FROM t)"))
+	require.Equal(2, e.PlanCache.Len())
+	cached, ok := e.PlanCache.get(ctx, "SELECT a FROM t WHERE a = (SELECT MAX(a) FROM t)")
+	require.True(ok)
+	require.Nil(cached.analyzed)
+
+	// DDL discards every plan
+	query("ALTER TABLE t ADD COLUMN b INT")
+	require.Equal(0, e.PlanCache.Len())
+	require.Equal([]sql.Row{{int64(1), nil}, {int64(2), nil}}, query("SELECT * FROM t"))
+}
+
+func TestWarmUp(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	table := memory.NewTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	})
+	db.AddTable("t", table)
+
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), &Config{PlanCacheSize: 10})
+	ctx := sql.NewEmptyContext().WithCurrentDB("mydb")
+
+	err := e.WarmUp(ctx, "SELECT * FROM t", "INSERT INTO t VALUES (1)", "SELECT b FROM t")
+	require.True(ErrWarmUp.Is(err))
+	require.Equal(1, e.PlanCache.Len())
+
+	require.NoError(e.WarmUp(ctx, "SELECT a FROM t WHERE a > 0"))
+	require.Equal(2, e.PlanCache.Len())
+
+	// Warmed up plans are shared by other sessions and survive SET statements
+	other := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	cached, ok := e.PlanCache.get(other, "SELECT  a FROM t WHERE a > 0;")
+	require.True(ok)
+	require.NotNil(cached.analyzed)
+
+	_, iter, err := e.Query(other, "SET @@session.autocommit = 1")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(other, iter)
+	require.NoError(err)
+	require.Equal(2, e.PlanCache.Len())
+
+	_, iter, err = e.Query(other, "INSERT INTO t VALUES (1)")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(other, iter)
+	require.NoError(err)
+
+	_, iter, err = e.Query(other, "SELECT a FROM t WHERE a > 0")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(other, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(1)}}, rows)
+
+	// Not in other databases
+	_, ok = e.PlanCache.get(sql.NewEmptyContext().WithCurrentDB("otherdb"), "SELECT a FROM t WHERE a > 0")
+	require.False(ok)
+
+	// Nor in sessions with another sql_mode or time_zone, which change how statements are analyzed
+	strict := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	require.NoError(strict.SetSessionVariable(strict, "sql_mode", "STRICT_ALL_TABLES"))
+	_, ok = e.PlanCache.get(strict, "SELECT a FROM t WHERE a > 0")
+	require.False(ok)
+
+	utc := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	require.NoError(utc.SetSessionVariable(utc, "time_zone", "+00:00"))
+	_, ok = e.PlanCache.get(utc, "SELECT a FROM t WHERE a > 0")
+	require.False(ok)
+
+	// Nor in sessions with temporary tables, which may shadow the tables of the plan
+	session := sql.NewBaseSession()
+	require.NoError(session.AddTemporaryTable("mydb", memory.NewTable("t", table.Schema())))
+	temp := sql.NewContext(context.Background(), sql.WithSession(session)).WithCurrentDB("mydb")
+	_, ok = e.PlanCache.get(temp, "SELECT a FROM t WHERE a > 0")
+	require.False(ok)
+
+	// DDL discards them too
+	_, iter, err = e.Query(other, "ALTER TABLE t ADD COLUMN b INT")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(other, iter)
+	require.NoError(err)
+	require.Equal(0, e.PlanCache.Len())
+	require.NoError(e.WarmUp(ctx, "SELECT b FROM t"))
+}
+
+func TestNormalizeQuery(t *testing.T) {
+	require.Equal(t, "SELECT a FROM t", normalizeQuery("  SELECT  a\n\tFROM t ;"))
+	require.Equal(t, "SELECT 'a  b' FROM `t  1`", normalizeQuery("SELECT 'a  b'  FROM `t  1`"))
+}
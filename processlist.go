@@ -16,6 +16,7 @@ package sqle
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -38,7 +39,7 @@ func NewProcessList() *ProcessList {
 	}
 }
 
-// Processes returns the list of current running processes.
+// Processes returns the list of current running processes, ordered by pid.
 func (pl *ProcessList) Processes() []sql.Process {
 	pl.mu.RLock()
 	defer pl.mu.RUnlock()
@@ -50,9 +51,14 @@ func (pl *ProcessList) Processes() []sql.Process {
 		for n, p := range p.Progress {
 			progress[n] = p
 		}
+		p.Progress = progress
 		result = append(result, p)
 	}
 
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Pid < result[j].Pid
+	})
+
 	return result
 }
 
@@ -77,6 +83,8 @@ func (pl *ProcessList) AddProcess(
 		Query:      query,
 		Progress:   make(map[string]sql.TableProgress),
 		User:       ctx.Session.Client().User,
+		Host:       ctx.Session.Client().Address,
+		Database:   ctx.GetCurrentDatabase(),
 		StartedAt:  time.Now(),
 		Kill:       cancel,
 	}
@@ -47,6 +47,7 @@ func TestProcessList(t *testing.T) {
 			"b": {sql.Progress{Name: "b", Done: 0, Total: 6}, map[string]sql.PartitionProgress{}},
 		},
 		User:      "foo",
+		Host:      "127.0.0.1:34567",
 		Query:     "SELECT foo",
 		StartedAt: p.procs[ctx.Pid()].StartedAt,
 	}
@@ -0,0 +1,64 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/replica"
+)
+
+// catalogProvider is the catalog of an engine as a database provider, for the appliers of the engine.
+type catalogProvider struct {
+	sql.Catalog
+}
+
+var _ sql.DatabaseProvider = catalogProvider{}
+
+// HasDatabase implements the sql.DatabaseProvider interface.
+func (p catalogProvider) HasDatabase(name string) bool {
+	return p.HasDB(name)
+}
+
+// NewApplier returns an applier of replicated or recovered transactions to the databases of the engine, in the mode
+// given, which runs the statements of query events as queries of the engine. The executed GTIDs are the transactions
+// already applied to the databases, or nil if there are none.
+func (e *Engine) NewApplier(mode replica.ApplyMode, executed *replica.GTIDSet) *replica.Applier {
+	applier := replica.NewApplier(catalogProvider{e.Analyzer.Catalog}, mode, executed)
+	applier.SetQueryRunner(e.runQuery)
+	return applier
+}
+
+// Recover replays the transactions of a captured change stream into the databases of the engine, up to the target
+// given, and returns how many of them were applied. This is the machinery integrators use for point-in-time recovery
+// of their storage backends: a backup of the databases is restored, and the transactions committed since, such as the
+// ones received by the binlog sinks of an engine and converted with replica.FromBinlog, are replayed up to a GTID or
+// a time. Transactions are applied in idempotent mode, so the stream may start before the position of the backup.
+func (e *Engine) Recover(ctx *sql.Context, source replica.TransactionSource, target replica.RecoveryTarget) (int, error) {
+	return replica.Recover(ctx, e.NewApplier(replica.ApplyIdempotent, nil), source, target)
+}
+
+// runQuery runs the query given in the database given, and discards its results.
+func (e *Engine) runQuery(ctx *sql.Context, database, query string) error {
+	current := ctx.GetCurrentDatabase()
+	ctx.SetCurrentDatabase(database)
+	defer ctx.SetCurrentDatabase(current)
+
+	_, iter, err := e.Query(ctx, query)
+	if err != nil {
+		return err
+	}
+	_, err = sql.RowIterToRows(ctx, iter)
+	return err
+}
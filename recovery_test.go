@@ -0,0 +1,87 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/replica"
+)
+
+func TestRecover(t *testing.T) {
+	require := require.New(t)
+
+	const sourceID = "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+
+	newEngine := func() (*Engine, *sql.Context) {
+		db := memory.NewDatabase("mydb")
+		e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), &Config{CommitSequence: sql.NewCommitSequence()})
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+		return e, ctx
+	}
+	query := func(e *Engine, ctx *sql.Context, q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	// The change stream of the source is captured by a binlog sink, with a transaction committed every minute
+	source, ctx := newEngine()
+	defer source.Close()
+	start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	var captured []replica.Transaction
+	source.Binlog.AddSink(sql.BinlogSinkFunc(func(ctx *sql.Context, tx sql.BinlogTransaction) error {
+		tx.Timestamp = start.Add(time.Duration(len(captured)) * time.Minute)
+		captured = append(captured, replica.FromBinlog(sourceID, tx))
+		return nil
+	}))
+
+	query(source, ctx, "CREATE TABLE t (a bigint primary key, b bigint)")
+	query(source, ctx, "INSERT INTO t VALUES (1, 10), (2, 20)")
+	query(source, ctx, "UPDATE t SET b = 11 WHERE a = 1")
+	query(source, ctx, "DELETE FROM t WHERE a = 2")
+	require.Len(captured, 4)
+	require.Equal(&replica.GTID{SourceID: sourceID, Sequence: 2}, captured[1].GTID)
+	require.Equal(replica.Query, captured[0].Events[0].Type)
+
+	recoverTo := func(target replica.RecoveryTarget, expectedApplied int) []sql.Row {
+		e, ctx := newEngine()
+		defer e.Close()
+		applied, err := e.Recover(ctx, replica.NewTransactionSource(captured), target)
+		require.NoError(err)
+		require.Equal(expectedApplied, applied)
+		return query(e, ctx, "SELECT * FROM t ORDER BY a")
+	}
+
+	require.Equal([]sql.Row{{int64(1), int64(11)}}, recoverTo(replica.RecoveryTarget{}, 4))
+	require.Equal([]sql.Row{{int64(1), int64(10)}, {int64(2), int64(20)}}, recoverTo(replica.RecoveryTarget{GTID: captured[1].GTID}, 2))
+	require.Equal([]sql.Row{{int64(1), int64(11)}, {int64(2), int64(20)}}, recoverTo(replica.RecoveryTarget{Time: start.Add(150 * time.Second)}, 3))
+
+	e, ctx := newEngine()
+	defer e.Close()
+	_, err := e.Recover(ctx, replica.NewTransactionSource(captured), replica.RecoveryTarget{
+		GTID: &replica.GTID{SourceID: sourceID, Sequence: 10},
+	})
+	require.True(replica.ErrRecoveryTargetNotReached.Is(err))
+}
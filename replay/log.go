@@ -0,0 +1,209 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrInvalidLog is returned when a log can't be parsed.
+var ErrInvalidLog = errors.NewKind("invalid %s log at line %d: %s")
+
+// Entry is a query captured in a log, which is replayed on the connection it was run on.
+type Entry struct {
+	// Time is when the query was run, or when it started if the log gives its duration.
+	Time time.Time
+	// Connection is the ID of the connection the query was run on.
+	Connection uint32
+	// Database is the current database of the connection when the query was run, if any.
+	Database string
+	// Query is the text of the query.
+	Query string
+	// Duration is how long the query took when it was captured, if the log gives it.
+	Duration time.Duration
+}
+
+// generalLogLine matches the lines of the general log starting a command, with its time, connection ID, command and
+// argument. The lines that don't match it continue the argument of the previous command.
+var generalLogLine = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}T\S+)\s+(\d+)\s+([A-Za-z ]+?)\t(.*)$`)
+
+// generalLogConnect matches the argument of the Connect commands, which gives the database of the connection if any.
+var generalLogConnect = regexp.MustCompile(`^\S+ on (\S*)`)
+
+// generalLogHeaders start the lines the server writes to the general log when it starts.
+var generalLogHeaders = []string{"Tcp port:", "Time                 Id Command"}
+
+// ParseGeneralLog returns the queries of a general query log, as written by the server when general_log is ON and
+// log_output is FILE. The Query and Execute commands are returned, and the database of each connection is tracked
+// through its Connect and Init DB commands. General logs don't give the duration of the queries.
+func ParseGeneralLog(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	databases := make(map[uint32]string)
+	// query is whether the last command was a query, which the lines that don't start a command continue.
+	query := false
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<30)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		m := generalLogLine.FindStringSubmatch(line)
+		if m == nil {
+			if isGeneralLogHeader(line) {
+				query = false
+			} else if query {
+				entries[len(entries)-1].Query += "\n" + line
+			}
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339Nano, m[1])
+		if err != nil {
+			return nil, ErrInvalidLog.New("general", n, err.Error())
+		}
+		id, err := strconv.ParseUint(m[2], 10, 32)
+		if err != nil {
+			return nil, ErrInvalidLog.New("general", n, err.Error())
+		}
+		conn := uint32(id)
+
+		query = false
+		switch m[3] {
+		case "Connect":
+			if db := generalLogConnect.FindStringSubmatch(m[4]); db != nil {
+				databases[conn] = db[1]
+			}
+		case "Init DB":
+			databases[conn] = m[4]
+		case "Quit":
+			delete(databases, conn)
+		case "Query", "Execute":
+			query = true
+			entries = append(entries, Entry{Time: t, Connection: conn, Database: databases[conn], Query: m[4]})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func isGeneralLogHeader(line string) bool {
+	if strings.Contains(line, ", Version: ") {
+		return true
+	}
+	for _, header := range generalLogHeaders {
+		if strings.HasPrefix(line, header) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// slowLogTime matches the header of the slow log giving the time the next query ended.
+	slowLogTime = regexp.MustCompile(`^# Time: (\S+)`)
+	// slowLogUser matches the header of the slow log giving the connection of the next query.
+	slowLogUser = regexp.MustCompile(`^# User@Host: .*Id:\s*(\d+)`)
+	// slowLogQueryTime matches the header of the slow log giving the duration of the next query, in seconds.
+	slowLogQueryTime = regexp.MustCompile(`^# Query_time: ([\d.]+)`)
+	// slowLogUse matches the USE statements the server writes before the queries run in another database than the
+	// previous one.
+	slowLogUse = regexp.MustCompile("(?i)^use `?([^`;]*)`?;$")
+	// slowLogTimestamp matches the SET statements the server writes before each query with its start time.
+	slowLogTimestamp = regexp.MustCompile(`(?i)^SET timestamp=(\d+);$`)
+)
+
+// ParseSlowLog returns the queries of a slow query log, as written by the server when slow_query_log is ON and
+// log_output is FILE, with how long they took. The database of each connection is tracked through the USE statements
+// of the log.
+func ParseSlowLog(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	databases := make(map[uint32]string)
+
+	var entry Entry
+	// ended is when the next query ended, which older servers only write once for all the queries ending in the
+	// same second.
+	var ended time.Time
+	var query []string
+	flush := func() {
+		if len(query) > 0 {
+			entry.Query = strings.TrimSuffix(strings.Join(query, "\n"), ";")
+			entry.Database = databases[entry.Connection]
+			entry.Time = ended.Add(-entry.Duration)
+			entries = append(entries, entry)
+		}
+		query = nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<30)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		if strings.HasPrefix(line, "# ") {
+			if len(query) > 0 {
+				flush()
+				entry = Entry{Connection: entry.Connection}
+			}
+
+			if m := slowLogTime.FindStringSubmatch(line); m != nil {
+				t, err := time.Parse(time.RFC3339Nano, m[1])
+				if err != nil {
+					return nil, ErrInvalidLog.New("slow", n, err.Error())
+				}
+				ended = t
+			} else if m := slowLogUser.FindStringSubmatch(line); m != nil {
+				id, err := strconv.ParseUint(m[1], 10, 32)
+				if err != nil {
+					return nil, ErrInvalidLog.New("slow", n, err.Error())
+				}
+				entry.Connection = uint32(id)
+			} else if m := slowLogQueryTime.FindStringSubmatch(line); m != nil {
+				seconds, err := strconv.ParseFloat(m[1], 64)
+				if err != nil {
+					return nil, ErrInvalidLog.New("slow", n, err.Error())
+				}
+				entry.Duration = time.Duration(seconds * float64(time.Second))
+			}
+			continue
+		}
+
+		if len(query) == 0 {
+			if m := slowLogUse.FindStringSubmatch(line); m != nil {
+				databases[entry.Connection] = m[1]
+				continue
+			}
+			if slowLogTimestamp.MatchString(line) || isGeneralLogHeader(line) || strings.TrimSpace(line) == "" {
+				continue
+			}
+		}
+		query = append(query, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return entries, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGeneralLog(t *testing.T) {
+	require := require.New(t)
+
+	log := "/usr/sbin/mysqld, Version: 8.0.26 (MySQL Community Server - GPL). started with:\n" +
+		"Tcp port: 3306  Unix socket: /var/run/mysqld/mysqld.sock\n" +
+		"Time                 Id Command    Argument\n" +
+		"2021-09-01T10:00:00.000000Z\t    8 Connect\troot@localhost on mydb using Socket\n" +
+		"2021-09-01T10:00:00.500000Z\t    8 Query\tSELECT *\n" +
+		"FROM mytable\n" +
+		"2021-09-01T10:00:01.000000Z\t    9 Connect\troot@localhost on  using TCP/IP\n" +
+		"2021-09-01T10:00:01.250000Z\t    9 Init DB\tother\n" +
+		"2021-09-01T10:00:01.500000Z\t    9 Query\tSELECT 1\n" +
+		"2021-09-01T10:00:02.000000Z\t    8 Prepare\tSELECT ?\n" +
+		"2021-09-01T10:00:02.000000Z\t    8 Execute\tSELECT 2\n" +
+		"2021-09-01T10:00:03.000000Z\t    8 Quit\t\n"
+
+	entries, err := ParseGeneralLog(strings.NewReader(log))
+	require.NoError(err)
+
+	at := func(s string) time.Time {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		require.NoError(err)
+		return t
+	}
+	require.Equal([]Entry{
+		{Time: at("2021-09-01T10:00:00.5Z"), Connection: 8, Database: "mydb", Query: "SELECT *\nFROM mytable"},
+		{Time: at("2021-09-01T10:00:01.5Z"), Connection: 9, Database: "other", Query: "SELECT 1"},
+		{Time: at("2021-09-01T10:00:02Z"), Connection: 8, Database: "mydb", Query: "SELECT 2"},
+	}, entries)
+
+	_, err = ParseGeneralLog(strings.NewReader("2021-09-31T10:00:00Z\t8 Query\tSELECT 1\n"))
+	require.True(ErrInvalidLog.Is(err))
+}
+
+func TestParseSlowLog(t *testing.T) {
+	require := require.New(t)
+
+	log := "/usr/sbin/mysqld, Version: 8.0.26 (MySQL Community Server - GPL). started with:\n" +
+		"Tcp port: 3306  Unix socket: /var/run/mysqld/mysqld.sock\n" +
+		"Time                 Id Command    Argument\n" +
+		"# Time: 2021-09-01T10:00:02.000000Z\n" +
+		"# User@Host: root[root] @ localhost []  Id:     8\n" +
+		"# Query_time: 2.000000  Lock_time: 0.000100 Rows_sent: 3  Rows_examined: 3\n" +
+		"use mydb;\n" +
+		"SET timestamp=1630490400;\n" +
+		"SELECT *\n" +
+		"FROM mytable;\n" +
+		"# User@Host: root[root] @ localhost []  Id:     9\n" +
+		"# Query_time: 0.500000  Lock_time: 0.000000 Rows_sent: 1  Rows_examined: 0\n" +
+		"SET timestamp=1630490401;\n" +
+		"SELECT 1;\n"
+
+	entries, err := ParseSlowLog(strings.NewReader(log))
+	require.NoError(err)
+
+	ended := time.Date(2021, 9, 1, 10, 0, 2, 0, time.UTC)
+	require.Equal([]Entry{
+		{Time: ended.Add(-2 * time.Second), Connection: 8, Database: "mydb", Query: "SELECT *\nFROM mytable", Duration: 2 * time.Second},
+		{Time: ended.Add(-time.Second / 2), Connection: 9, Query: "SELECT 1", Duration: time.Second / 2},
+	}, entries)
+}
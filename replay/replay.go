@@ -0,0 +1,190 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replay replays the queries captured in the general or slow query log of a server against an engine, with
+// their original timing or as fast as possible, and compares how long they take with how long they took when they
+// were captured. It lets integrators validate changes to their storage engines against production workloads.
+//
+// The queries of each captured connection are replayed in order on a session of their own, and the queries of
+// different connections concurrently, like they were run when they were captured.
+package replay
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Options configure how queries are replayed.
+type Options struct {
+	// Speed is how fast the queries are started compared to when they were captured: 1 replays them with their
+	// original timing, 2 twice as fast, and so on. Queries are never started before the previous query of their
+	// connection is done. If 0, every query is started as soon as the previous query of its connection is done.
+	Speed float64
+	// NewSession creates the session the queries of a captured connection are replayed on. If nil, base sessions are
+	// created.
+	NewSession func(conn uint32) sql.Session
+}
+
+// Result is the result of replaying an Entry.
+type Result struct {
+	Entry Entry
+	// Duration is how long the query took when replayed, including reading all its rows.
+	Duration time.Duration
+	// Err is the error of the query, if it failed.
+	Err error
+}
+
+// Slowdown returns how many times longer the query took when replayed than when it was captured, or 0 if the
+// duration of the captured query isn't known.
+func (r Result) Slowdown() float64 {
+	if r.Entry.Duration <= 0 {
+		return 0
+	}
+	return float64(r.Duration) / float64(r.Entry.Duration)
+}
+
+// Report is the results of a replay.
+type Report struct {
+	// Results are the results of the entries replayed, in the same order.
+	Results []Result
+	// Duration is how long the whole replay took.
+	Duration time.Duration
+}
+
+// Errors returns the results of the queries that failed.
+func (r *Report) Errors() []Result {
+	var results []Result
+	for _, result := range r.Results {
+		if result.Err != nil {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// Regressions returns the results of the queries that succeeded but took more than the given factor times longer
+// than when they were captured. Queries whose captured duration isn't known are left out.
+func (r *Report) Regressions(factor float64) []Result {
+	var results []Result
+	for _, result := range r.Results {
+		if result.Err == nil && result.Slowdown() > factor {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// Replay runs the entries given against the engine and reports how long each one took. It only returns an error if
+// the ctx is canceled, and errors of the queries themselves are reported in their results.
+func Replay(ctx context.Context, e *sqle.Engine, entries []Entry, opts Options) (*Report, error) {
+	newSession := opts.NewSession
+	if newSession == nil {
+		newSession = func(conn uint32) sql.Session {
+			return sql.NewBaseSessionWithClientServer("", sql.Client{}, conn)
+		}
+	}
+
+	var first time.Time
+	connections := make(map[uint32][]int)
+	var order []uint32
+	for i, entry := range entries {
+		if i == 0 || entry.Time.Before(first) {
+			first = entry.Time
+		}
+		if _, ok := connections[entry.Connection]; !ok {
+			order = append(order, entry.Connection)
+		}
+		connections[entry.Connection] = append(connections[entry.Connection], i)
+	}
+
+	report := &Report{Results: make([]Result, len(entries))}
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(order))
+	for _, conn := range order {
+		wg.Add(1)
+		go func(conn uint32, indexes []int) {
+			defer wg.Done()
+
+			session := newSession(conn)
+			for _, i := range indexes {
+				entry := entries[i]
+				if opts.Speed > 0 {
+					at := start.Add(time.Duration(float64(entry.Time.Sub(first)) / opts.Speed))
+					if err := sleepUntil(ctx, at); err != nil {
+						errs <- err
+						return
+					}
+				} else if err := ctx.Err(); err != nil {
+					errs <- err
+					return
+				}
+
+				if entry.Database != "" {
+					session.SetCurrentDatabase(entry.Database)
+				}
+				report.Results[i] = run(sql.NewContext(ctx, sql.WithSession(session)), e, entry)
+			}
+		}(conn, connections[conn])
+	}
+	wg.Wait()
+	report.Duration = time.Since(start)
+
+	close(errs)
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// sleepUntil waits for the time given, or returns the error of the ctx if it's canceled before.
+func sleepUntil(ctx context.Context, t time.Time) error {
+	wait := time.Until(t)
+	if wait <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run runs the query of the entry given and reads all its rows.
+func run(ctx *sql.Context, e *sqle.Engine, entry Entry) Result {
+	start := time.Now()
+	_, iter, err := e.Query(ctx, entry.Query)
+	if err == nil {
+		for err == nil {
+			_, err = iter.Next()
+		}
+		if err == io.EOF {
+			err = nil
+		}
+		if closeErr := iter.Close(ctx); err == nil {
+			err = closeErr
+		}
+	}
+	return Result{Entry: entry, Duration: time.Since(start), Err: err}
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+)
+
+func newEngine(t *testing.T) *sqle.Engine {
+	db := memory.NewDatabase("mydb")
+	table := memory.NewTable("mytable", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable", PrimaryKey: true},
+	})
+	ctx := sql.NewEmptyContext()
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, table.Insert(ctx, sql.NewRow(i)))
+	}
+	db.AddTable("mytable", table)
+
+	e := sqle.New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), &sqle.Config{})
+	t.Cleanup(func() { e.Close() })
+	return e
+}
+
+func TestReplay(t *testing.T) {
+	require := require.New(t)
+	e := newEngine(t)
+
+	start := time.Now()
+	entries := []Entry{
+		{Time: start, Connection: 1, Database: "mydb", Query: "SELECT * FROM mytable", Duration: time.Hour},
+		{Time: start, Connection: 2, Database: "mydb", Query: "SELECT * FROM missing"},
+		{Time: start.Add(100 * time.Millisecond), Connection: 1, Query: "SELECT COUNT(*) FROM mytable", Duration: time.Nanosecond},
+	}
+
+	report, err := Replay(context.Background(), e, entries, Options{Speed: 1})
+	require.NoError(err)
+	require.Len(report.Results, 3)
+	// The last query is started with its original timing.
+	require.True(report.Duration >= 100*time.Millisecond, "replay took %s", report.Duration)
+
+	for i, result := range report.Results {
+		require.Equal(entries[i], result.Entry)
+	}
+	require.Equal([]Result{report.Results[1]}, report.Errors())
+	require.True(sql.ErrTableNotFound.Is(report.Results[1].Err))
+	// The database of the connection is kept for the queries that don't give one.
+	require.NoError(report.Results[2].Err)
+	require.Equal([]Result{report.Results[2]}, report.Regressions(2))
+	require.Equal(float64(0), report.Results[1].Slowdown())
+
+	report, err = Replay(context.Background(), e, entries, Options{})
+	require.NoError(err)
+	require.Len(report.Errors(), 1)
+}
+
+func TestReplayCanceled(t *testing.T) {
+	e := newEngine(t)
+
+	start := time.Now()
+	entries := []Entry{
+		{Time: start, Connection: 1, Database: "mydb", Query: "SELECT 1"},
+		{Time: start.Add(time.Hour), Connection: 1, Database: "mydb", Query: "SELECT 2"},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := Replay(ctx, e, entries, Options{Speed: 1})
+	require.Equal(t, context.DeadlineExceeded, err)
+}
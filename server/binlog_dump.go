@@ -0,0 +1,282 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+const (
+	// comRegisterReplica is COM_REGISTER_SLAVE, which vitess doesn't export.
+	comRegisterReplica = 0x15
+	// binlogDumpNonBlock is the flag of dump commands asking for the events written so far only.
+	binlogDumpNonBlock = 0x01
+	// binlogThroughGTID is the flag of COM_BINLOG_DUMP_GTID commands sending the GTIDs the replica has executed.
+	binlogThroughGTID = 0x04
+	// clientSSL is the capability flag of clients asking to upgrade their connection to TLS.
+	clientSSL = 0x0800
+	// maxPacketSize is the maximum size of the payload of a packet. Larger payloads are split into several packets.
+	maxPacketSize = 1<<24 - 1
+	// serverStatusAutocommit is the status flag of the OK and EOF packets sent to replicas.
+	serverStatusAutocommit = 0x0002
+	// errFatalReadingBinlog is the code of the errors of dumps, ER_MASTER_FATAL_ERROR_READING_BINLOG.
+	errFatalReadingBinlog = 1236
+	// errMalformedPacket is the code of the errors of replication commands that can't be read, ER_MALFORMED_PACKET.
+	errMalformedPacket = 1835
+)
+
+// binlogHeartbeatPeriod is how long a dump waits for new events before it sends a heartbeat event to the replica,
+// which is half the default of @@replica_net_timeout.
+var binlogHeartbeatPeriod = 30 * time.Second
+
+// replicationConn is a connection that answers the replication commands of replicas with the events of a replication
+// source. Vitess doesn't let handlers answer these commands, so they're read from the connection before vitess reads
+// them, and answered without vitess. Other packets are passed through to vitess as they are.
+//
+// Commands are the packets clients send with a sequence number of 0. Connections upgraded to TLS can't be read, so
+// replicas must connect without TLS.
+type replicationConn struct {
+	net.Conn
+	source sql.ReplicationSource
+	// handshaken is set once the first packet of the client, its handshake response, has been read
+	handshaken bool
+	// passthrough is set once the connection is upgraded to TLS
+	passthrough bool
+	// buf holds the bytes read from the connection that haven't been passed to vitess yet
+	buf []byte
+	// remaining is the number of bytes of the packet passed to vitess that haven't been read from the connection yet
+	remaining int
+}
+
+// newReplicationConn returns a connection answering the replication commands read from the connection given with
+// the events of the replication source given.
+func newReplicationConn(conn net.Conn, source sql.ReplicationSource) *replicationConn {
+	return &replicationConn{Conn: conn, source: source}
+}
+
+// Read implements the net.Conn interface.
+func (c *replicationConn) Read(p []byte) (int, error) {
+	for {
+		if len(c.buf) > 0 {
+			n := copy(p, c.buf)
+			c.buf = c.buf[n:]
+			return n, nil
+		}
+		if c.passthrough {
+			return c.Conn.Read(p)
+		}
+		if c.remaining > 0 {
+			if len(p) > c.remaining {
+				p = p[:c.remaining]
+			}
+			n, err := c.Conn.Read(p)
+			c.remaining -= n
+			return n, err
+		}
+
+		header := make([]byte, 4)
+		if _, err := io.ReadFull(c.Conn, header); err != nil {
+			return 0, err
+		}
+		length := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+		seq := header[3]
+
+		if !c.handshaken {
+			c.handshaken = true
+			payload := make([]byte, length)
+			if _, err := io.ReadFull(c.Conn, payload); err != nil {
+				return 0, err
+			}
+			// An SSL request is the fixed part of a handshake response, with the SSL capability
+			if length == 32 && binary.LittleEndian.Uint32(payload)&clientSSL != 0 {
+				c.passthrough = true
+			}
+			c.buf = append(header, payload...)
+			continue
+		}
+
+		if seq != 0 || length == 0 || length == maxPacketSize {
+			c.buf, c.remaining = header, length
+			continue
+		}
+
+		command := make([]byte, 1)
+		if _, err := io.ReadFull(c.Conn, command); err != nil {
+			return 0, err
+		}
+		switch command[0] {
+		case comRegisterReplica, mysql.ComBinlogDump, mysql.ComBinlogDumpGTID:
+		default:
+			c.buf, c.remaining = append(header, command...), length-1
+			continue
+		}
+
+		payload := make([]byte, length-1)
+		if _, err := io.ReadFull(c.Conn, payload); err != nil {
+			return 0, err
+		}
+		if err := c.handleCommand(command[0], payload); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// handleCommand answers the replication command given. Dumps that wait for new events only end with an error.
+func (c *replicationConn) handleCommand(command byte, payload []byte) error {
+	seq := uint8(1)
+	switch command {
+	case comRegisterReplica:
+		if len(payload) < 4 {
+			return c.writeError(&seq, errMalformedPacket, "malformed COM_REGISTER_SLAVE packet")
+		}
+		logrus.WithField("server_id", binary.LittleEndian.Uint32(payload)).Info("replica registered")
+		return c.writeOK(&seq)
+
+	case mysql.ComBinlogDump:
+		// binlog-pos (4), flags (2), server-id (4), binlog-filename (EOF)
+		if len(payload) < 10 {
+			return c.writeError(&seq, errMalformedPacket, "malformed COM_BINLOG_DUMP packet")
+		}
+		pos := binary.LittleEndian.Uint32(payload[0:4])
+		flags := binary.LittleEndian.Uint16(payload[4:6])
+		name := string(payload[10:])
+		stream, err := c.source.Dump(name, uint64(pos))
+		if err != nil {
+			return c.writeError(&seq, errFatalReadingBinlog, err.Error())
+		}
+		return c.dump(&seq, stream, flags&binlogDumpNonBlock != 0)
+
+	default:
+		// flags (2), server-id (4), binlog-filename-len (4), binlog-filename, binlog-pos (8), and if the GTIDs of the
+		// replica are sent, data-size (4) and the SID block of the GTIDs
+		if len(payload) < 10 {
+			return c.writeError(&seq, errMalformedPacket, "malformed COM_BINLOG_DUMP_GTID packet")
+		}
+		flags := binary.LittleEndian.Uint16(payload[0:2])
+		nameLen := int(binary.LittleEndian.Uint32(payload[6:10]))
+		if len(payload) < 10+nameLen+8 {
+			return c.writeError(&seq, errMalformedPacket, "malformed COM_BINLOG_DUMP_GTID packet")
+		}
+		name := string(payload[10 : 10+nameLen])
+		pos := binary.LittleEndian.Uint64(payload[10+nameLen:])
+		data := payload[10+nameLen+8:]
+
+		var stream sql.BinlogStream
+		var err error
+		if flags&binlogThroughGTID != 0 || len(data) >= 4 {
+			var executed mysql.Mysql56GTIDSet
+			if len(data) >= 4 {
+				size := int(binary.LittleEndian.Uint32(data))
+				if len(data) < 4+size {
+					return c.writeError(&seq, errMalformedPacket, "malformed COM_BINLOG_DUMP_GTID packet")
+				}
+				executed, err = mysql.NewMysql56GTIDSetFromSIDBlock(data[4 : 4+size])
+				if err != nil {
+					return c.writeError(&seq, errMalformedPacket, err.Error())
+				}
+			}
+			stream, err = c.source.DumpGTIDs(executed.String())
+		} else {
+			stream, err = c.source.Dump(name, pos)
+		}
+		if err != nil {
+			return c.writeError(&seq, errFatalReadingBinlog, err.Error())
+		}
+		return c.dump(&seq, stream, flags&binlogDumpNonBlock != 0)
+	}
+}
+
+// dump sends the events of the stream given to the replica. Dumps that don't wait for new events end with an EOF
+// packet, while other dumps send heartbeat events while there are no new events, until the replica goes away.
+func (c *replicationConn) dump(seq *uint8, stream sql.BinlogStream, nonBlock bool) error {
+	// Vitess sets write deadlines before its own writes only
+	if err := c.Conn.SetWriteDeadline(time.Time{}); err != nil {
+		return err
+	}
+
+	file, pos := stream.Position()
+	logrus.WithField("file", file).WithField("pos", pos).Info("binlog dump started")
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), binlogHeartbeatPeriod)
+		if nonBlock {
+			cancel()
+		}
+		ev, err := stream.Next(ctx)
+		cancel()
+
+		if err == context.DeadlineExceeded || err == context.Canceled {
+			if nonBlock {
+				return c.writePacket(seq, []byte{mysql.EOFPacket, 0, 0, serverStatusAutocommit, 0})
+			}
+			ev = stream.Heartbeat()
+		} else if err != nil {
+			if err := c.writeError(seq, errFatalReadingBinlog, err.Error()); err != nil {
+				return err
+			}
+			return err
+		}
+
+		if err := c.writePacket(seq, append([]byte{mysql.OKPacket}, ev...)); err != nil {
+			return err
+		}
+	}
+}
+
+// writeOK writes an OK packet.
+func (c *replicationConn) writeOK(seq *uint8) error {
+	return c.writePacket(seq, []byte{mysql.OKPacket, 0, 0, serverStatusAutocommit, 0, 0, 0})
+}
+
+// writeError writes an error packet.
+func (c *replicationConn) writeError(seq *uint8, code uint16, message string) error {
+	payload := make([]byte, 3, 9+len(message))
+	payload[0] = mysql.ErrPacket
+	binary.LittleEndian.PutUint16(payload[1:3], code)
+	payload = append(payload, '#')
+	payload = append(payload, mysql.SSUnknownSQLState...)
+	payload = append(payload, message...)
+	return c.writePacket(seq, payload)
+}
+
+// writePacket writes the payload given as packets, splitting it if it's too large for one.
+func (c *replicationConn) writePacket(seq *uint8, payload []byte) error {
+	for {
+		n := len(payload)
+		if n > maxPacketSize {
+			n = maxPacketSize
+		}
+		header := []byte{byte(n), byte(n >> 8), byte(n >> 16), *seq}
+		*seq++
+		if _, err := c.Conn.Write(append(header, payload[:n]...)); err != nil {
+			return fmt.Errorf("error writing to replica: %s", err)
+		}
+		payload = payload[n:]
+		// Payloads of the maximum size are followed by a packet with the rest, even if it's empty
+		if n < maxPacketSize {
+			return nil
+		}
+	}
+}
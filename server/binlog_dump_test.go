@@ -0,0 +1,212 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/auth"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlog"
+)
+
+const testServerUUID = "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+
+func TestBinlogDump(t *testing.T) {
+	require := require.New(t)
+	defer sql.InitSystemVariables()
+
+	log, err := binlog.NewLog(binlog.Config{ServerID: 7, ServerUUID: testServerUUID})
+	require.NoError(err)
+
+	port, err := getFreePort()
+	require.NoError(err)
+	e := sqle.NewDefault(memory.NewMemoryDBProvider(memory.NewDatabase("test")))
+	s, err := NewServer(Config{
+		Protocol:  "tcp",
+		Address:   "localhost:" + port,
+		Auth:      auth.NewNativeSingle("root", "", auth.AllPermissions),
+		BinaryLog: log,
+	}, e, DefaultSessionBuilder)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	portNum, err := strconv.Atoi(port)
+	require.NoError(err)
+	params := &mysql.ConnParams{Host: "localhost", Port: portNum, Uname: "root", DbName: "test"}
+	ctx := context.Background()
+
+	conn, err := mysql.Connect(ctx, params)
+	require.NoError(err)
+	defer conn.Close()
+
+	for _, q := range []string{
+		"CREATE TABLE t (id BIGINT PRIMARY KEY, name VARCHAR(20))",
+		"INSERT INTO t VALUES (1, 'a')",
+		"INSERT INTO t VALUES (2, 'b')",
+	} {
+		_, err := conn.ExecuteFetch(q, 0, false)
+		require.NoError(err)
+	}
+
+	// Replicas check these before they ask for the binary log
+	r, err := conn.ExecuteFetch("SELECT @@GLOBAL.gtid_mode, @@GLOBAL.server_id, @@GLOBAL.server_uuid, @@GLOBAL.binlog_checksum", 1, false)
+	require.NoError(err)
+	var values []string
+	for _, v := range r.Rows[0] {
+		values = append(values, v.ToString())
+	}
+	require.Equal([]string{"ON", "7", testServerUUID, "CRC32"}, values)
+
+	// Replicas using GTID auto-positioning only get the transactions they miss
+	replica, err := mysql.Connect(ctx, params)
+	require.NoError(err)
+	defer replica.Close()
+
+	executed, err := mysql.ParsePosition("MySQL56", testServerUUID+":1-2")
+	require.NoError(err)
+	require.NoError(replica.SendBinlogDumpCommand(2, executed))
+
+	f := log.Format()
+	readTransaction := func() (string, []string) {
+		var gtid string
+		var queries []string
+		for {
+			ev, err := replica.ReadBinlogEvent()
+			require.NoError(err)
+			require.True(ev.IsValid())
+			ev, _, err = ev.StripChecksum(f)
+			require.NoError(err)
+			switch {
+			case ev.IsGTID():
+				g, _, err := ev.GTID(f)
+				require.NoError(err)
+				gtid = g.String()
+			case ev.IsQuery():
+				q, err := ev.Query(f)
+				require.NoError(err)
+				queries = append(queries, q.SQL)
+			case ev.IsXID():
+				return gtid, queries
+			}
+		}
+	}
+	gtid, queries := readTransaction()
+	require.Equal(testServerUUID+":3", gtid)
+	require.Equal([]string{"BEGIN"}, queries)
+
+	// Dumps follow the transactions committed later
+	_, err = conn.ExecuteFetch("DELETE FROM t WHERE id = 1", 0, false)
+	require.NoError(err)
+	gtid, _ = readTransaction()
+	require.Equal(testServerUUID+":4", gtid)
+
+	// The connections of replicas still run queries, and dumps of missing files fail
+	conn2, err := mysql.Connect(ctx, params)
+	require.NoError(err)
+	defer conn2.Close()
+	require.NoError(conn2.WriteComBinlogDump(3, "binlog.000009", 4, 0))
+	_, err = conn2.ReadBinlogEvent()
+	require.Error(err)
+	require.Contains(err.Error(), "binlog.000009")
+	_, err = conn2.ExecuteFetch("SELECT 1", 1, false)
+	require.NoError(err)
+}
+
+func TestBinlogDumpHeartbeats(t *testing.T) {
+	require := require.New(t)
+	defer sql.InitSystemVariables()
+
+	period := binlogHeartbeatPeriod
+	binlogHeartbeatPeriod = 10 * time.Millisecond
+	defer func() {
+		binlogHeartbeatPeriod = period
+	}()
+
+	log, err := binlog.NewLog(binlog.Config{ServerUUID: testServerUUID})
+	require.NoError(err)
+	port, err := getFreePort()
+	require.NoError(err)
+	e := sqle.NewDefault(memory.NewMemoryDBProvider(memory.NewDatabase("test")))
+	s, err := NewServer(Config{
+		Protocol:  "tcp",
+		Address:   "localhost:" + port,
+		Auth:      auth.NewNativeSingle("root", "", auth.AllPermissions),
+		BinaryLog: log,
+	}, e, DefaultSessionBuilder)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	portNum, err := strconv.Atoi(port)
+	require.NoError(err)
+	replica, err := mysql.Connect(context.Background(), &mysql.ConnParams{Host: "localhost", Port: portNum, Uname: "root"})
+	require.NoError(err)
+	defer replica.Close()
+
+	require.NoError(replica.WriteComBinlogDump(2, "", 4, 0))
+	var types []byte
+	for len(types) < 4 {
+		ev, err := replica.ReadBinlogEvent()
+		require.NoError(err)
+		types = append(types, ev.(interface{ Bytes() []byte }).Bytes()[4])
+	}
+	// A rotate event, the format description and previous GTIDs events of the file, and a heartbeat event
+	require.Equal([]byte{4, 15, 35, 27}, types)
+}
+
+func TestReplicationConnRegisterReplica(t *testing.T) {
+	require := require.New(t)
+
+	log, err := binlog.NewLog(binlog.Config{ServerUUID: testServerUUID})
+	require.NoError(err)
+	server, client := net.Pipe()
+	conn := newReplicationConn(server, log)
+
+	handshake := []byte{5, 0, 0, 1, 1, 2, 3, 4, 5}
+	register := []byte{5, 0, 0, 0, comRegisterReplica, 2, 0, 0, 0}
+	query := []byte{9, 0, 0, 0, mysql.ComQuery, 'S', 'E', 'L', 'E', 'C', 'T', ' ', '1'}
+	ok := make(chan []byte, 1)
+	go func() {
+		_, _ = client.Write(handshake)
+		_, _ = client.Write(register)
+		packet := make([]byte, 11)
+		_, _ = io.ReadFull(client, packet)
+		ok <- packet
+		_, _ = client.Write(query)
+	}()
+
+	// Replication commands are answered, while other packets are read as they are
+	read := make([]byte, len(handshake))
+	_, err = io.ReadFull(conn, read)
+	require.NoError(err)
+	require.Equal(handshake, read)
+	read = make([]byte, len(query))
+	_, err = io.ReadFull(conn, read)
+	require.NoError(err)
+	require.Equal(query, read)
+	require.Equal([]byte{7, 0, 0, 1, mysql.OKPacket, 0, 0, serverStatusAutocommit, 0, 0, 0}, <-ok)
+}
@@ -52,6 +52,7 @@ type SessionManager struct {
 	mu          *sync.Mutex
 	builder     SessionBuilder
 	sessions    map[uint32]sql.Session
+	connections map[uint32]*mysql.Conn
 	pid         uint64
 }
 
@@ -73,6 +74,7 @@ func NewSessionManager(
 		mu:          new(sync.Mutex),
 		builder:     builder,
 		sessions:    make(map[uint32]sql.Session),
+		connections: make(map[uint32]*mysql.Conn),
 	}
 }
 
@@ -83,6 +85,14 @@ func (s *SessionManager) nextPid() uint64 {
 	return s.pid
 }
 
+// AddConn registers the connection given, whose session is created by its first command, so that it can be killed
+// before then.
+func (s *SessionManager) AddConn(conn *mysql.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.connections[conn.ConnectionID] = conn
+}
+
 // NewSession creates a Session for the given connection and saves it to the session pool.
 func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error {
 	var err error
@@ -95,6 +105,8 @@ func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error
 		return err
 	}
 
+	s.connections[conn.ConnectionID] = conn
+
 	logger := s.sessions[conn.ConnectionID].GetLogger()
 	if logger == nil {
 		log := logrus.StandardLogger()
@@ -170,6 +182,10 @@ func (s *SessionManager) NewContextWithQuery(conn *mysql.Conn, query string) (*s
 		sql.WithMemoryManager(s.memory),
 		sql.WithProcessList(s.processlist),
 		sql.WithRootSpan(s.tracer.StartSpan("query")),
+		sql.WithServices(sql.Services{
+			KillConnection: s.KillConnection,
+			HasConnection:  s.HasConnection,
+		}),
 	)
 
 	return context, nil
@@ -180,5 +196,48 @@ func (s *SessionManager) NewContextWithQuery(conn *mysql.Conn, query string) (*s
 func (s *SessionManager) CloseConn(conn *mysql.Conn) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	dropTemporaryTables(s.sessions[conn.ConnectionID])
+	dropSessionDatabases(s.sessions[conn.ConnectionID])
 	delete(s.sessions, conn.ConnectionID)
+	delete(s.connections, conn.ConnectionID)
+}
+
+// KillConnection closes the connection with the id given and removes its session. Queries running on the connection
+// must be cancelled separately through the process list.
+func (s *SessionManager) KillConnection(connID uint32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if conn, ok := s.connections[connID]; ok {
+		dropTemporaryTables(s.sessions[connID])
+		dropSessionDatabases(s.sessions[connID])
+		delete(s.sessions, connID)
+		delete(s.connections, connID)
+		conn.Close()
+	}
+
+	return nil
+}
+
+// HasConnection returns whether there is a connection with the id given.
+func (s *SessionManager) HasConnection(connID uint32) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.connections[connID]
+	return ok
+}
+
+// dropTemporaryTables drops the temporary tables of the session given, which only live as long as its connection.
+func dropTemporaryTables(sess sql.Session) {
+	if sess, ok := sess.(sql.TemporaryTableSession); ok {
+		sess.DropAllTemporaryTables()
+	}
+}
+
+// dropSessionDatabases drops the session databases of the session given, which only live as long as its connection.
+func dropSessionDatabases(sess sql.Session) {
+	if sess, ok := sess.(sql.SessionDatabaseSession); ok {
+		sess.DropAllSessionDatabases()
+	}
 }
@@ -19,7 +19,6 @@ import (
 	"net"
 	"regexp"
 	"strconv"
-	"strings"
 	"sync"
 	"time"
 
@@ -41,8 +40,6 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
-var regKillCmd = regexp.MustCompile(`^kill (?:(query|connection) )?(\d+)$`)
-
 var errConnectionNotFound = errors.NewKind("connection not found: %c")
 
 // ErrRowTimeout will be returned if the wait for the row is longer than the connection timeout
@@ -59,10 +56,12 @@ const tcpCheckerSleepTime = 1
 
 // Handler is a connection handler for a SQLe engine.
 type Handler struct {
-	mu          sync.Mutex
-	e           *sqle.Engine
-	sm          *SessionManager
-	readTimeout time.Duration
+	mu              sync.Mutex
+	e               *sqle.Engine
+	sm              *SessionManager
+	readTimeout     time.Duration
+	sessionAffinity SessionAffinity
+	sessionState    SessionStateFunc
 }
 
 // NewHandler creates a new Handler given a SQLe engine.
@@ -76,6 +75,7 @@ func NewHandler(e *sqle.Engine, sm *SessionManager, rt time.Duration) *Handler {
 
 // NewConnection reports that a new connection has been established.
 func (h *Handler) NewConnection(c *mysql.Conn) {
+	h.sm.AddConn(c)
 	logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID).Infof("NewConnection")
 }
 
@@ -117,6 +117,18 @@ func (h *Handler) ConnectionClosed(c *mysql.Conn) {
 		logrus.Errorf("unable to unlock tables on session close: %s", err)
 	}
 
+	// The named locks acquired with GET_LOCK are released, so that other connections waiting for them can go on.
+	if h.e.LS != nil {
+		if _, err := h.e.LS.ReleaseAll(ctx); err != nil {
+			logrus.Errorf("unable to release locks on session close: %s", err)
+		}
+	}
+
+	// The changes of a transaction left open are rolled back
+	if h.e.Binlog != nil {
+		_ = h.e.Binlog.EndTransaction(ctx, false, 0)
+	}
+
 	logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID).Infof("ConnectionClosed")
 }
 
@@ -249,15 +261,6 @@ func (h *Handler) doQuery(
 		return err
 	}
 
-	handled, err := h.handleKill(ctx, c, query)
-	if err != nil {
-		return err
-	}
-
-	if handled {
-		return callback(&sqltypes.Result{})
-	}
-
 	ctx.SetLogger(ctx.GetLogger().
 		WithField("query", string(queryLoggingRegex.ReplaceAll([]byte(query), []byte(" ")))))
 	ctx.GetLogger().Debugf("Starting query")
@@ -311,6 +314,7 @@ func (h *Handler) doQuery(
 		}
 	}()
 
+	lastCommitSequence := ctx.GetLastQueryInfo(sql.LastCommitSequence)
 	schema, rows, err := h.e.QueryNodeWithBindings(ctx, query, parsed, sqlBindings)
 	if err != nil {
 		ctx.GetLogger().WithError(err).Warn("error running query")
@@ -402,6 +406,10 @@ rowLoop:
 			ctx.GetLogger().Tracef("spooling result row %s", outputRow)
 			r.Rows = append(r.Rows, outputRow)
 			r.RowsAffected++
+		case <-ctx.Done():
+			// The query was killed or its connection closed
+			close(quit)
+			return ctx.Err()
 		case <-timer.C:
 			if h.readTimeout != 0 {
 				// Cancel and return so Vitess can call the CloseConnection callback
@@ -425,6 +433,9 @@ rowLoop:
 	if err = setResultInfo(ctx, c, r, parsed); err != nil {
 		return err
 	}
+	if err = h.setSessionState(ctx, c, r, parsed, lastCommitSequence); err != nil {
+		return err
+	}
 
 	switch len(r.Rows) {
 	case 0:
@@ -517,12 +528,16 @@ func (h *Handler) errorWrappedDoQuery(
 	callback func(*sqltypes.Result) error,
 ) error {
 	err := h.doQuery(c, query, bindings, callback)
-	err, ok := sql.CastSQLError(err)
+	sqlErr, ok := sql.CastSQLError(err)
 	if ok {
 		return nil
-	} else {
-		return err
 	}
+
+	// Keep the error in the session, so that SHOW WARNINGS and SHOW ERRORS can report it
+	if sess := h.sm.session(c); sess != nil {
+		sess.Warn(&sql.Warning{Level: "Error", Code: sqlErr.Num, Message: sqlErr.Message})
+	}
+	return sqlErr
 }
 
 // Periodically polls the connection socket to determine if it is has been closed by the client, sending an error on
@@ -578,6 +593,9 @@ func maybeGetTCPConn(conn net.Conn) (*net.TCPConn, bool) {
 	if ok {
 		conn = wrap.Conn
 	}
+	if replication, ok := conn.(*replicationConn); ok {
+		conn = replication.Conn
+	}
 
 	tcp, ok := conn.(*net.TCPConn)
 	if ok {
@@ -612,44 +630,8 @@ func (h *Handler) WarningCount(c *mysql.Conn) uint16 {
 	return 0
 }
 
-func (h *Handler) handleKill(ctx *sql.Context, conn *mysql.Conn, query string) (bool, error) {
-	q := strings.ToLower(query)
-	// TODO: move this to parser, normal execution path
-	s := regKillCmd.FindStringSubmatch(q)
-	if s == nil {
-		return false, nil
-	}
-
-	ctx.GetLogger().Info("killing query")
-
-	id, err := strconv.ParseUint(s[2], 10, 32)
-	if err != nil {
-		return false, err
-	}
-
-	// KILL CONNECTION and KILL should close the connection. KILL QUERY only
-	// cancels the query.
-	//
-	// https://dev.mysql.com/doc/refman/8.0/en/kill.html
-	//
-	// KILL [CONNECTION | QUERY] processlist_id
-	// - KILL QUERY terminates the statement the connection is currently executing,
-	// but leaves the connection itself intact.
-
-	// - KILL CONNECTION is the same as KILL with no modifier:
-	// It terminates the connection associated with the given processlist_id,
-	// after terminating any statement the connection is executing.
-	connID := uint32(id)
-	ctx.ProcessList.Kill(connID)
-	if s[1] != "query" {
-		ctx.GetLogger().Info("kill connection")
-		h.sm.CloseConn(conn)
-		conn.Close()
-	}
-
-	return true, nil
-}
-
+// rowToSQL returns the values of the row given of the schema given as they're sent to clients. Types render their
+// values with the rules of sql.RenderValue, which CAST(... AS CHAR) and SELECT ... INTO OUTFILE share.
 func rowToSQL(s sql.Schema, row sql.Row) ([]sqltypes.Value, error) {
 	o := make([]sqltypes.Value, len(row))
 	var err error
@@ -672,14 +654,20 @@ func schemaToFields(s sql.Schema) []*query.Field {
 	fields := make([]*query.Field, len(s))
 	for i, c := range s {
 		var charset uint32 = mysql.CharacterSetUtf8
+		var flags uint32
 		if sql.IsBlob(c.Type) {
 			charset = mysql.CharacterSetBinary
+			flags = uint32(query.MySqlFlag_BINARY_FLAG)
+		} else if sql.IsBit(c.Type) {
+			charset = mysql.CharacterSetBinary
+			flags = uint32(query.MySqlFlag_UNSIGNED_FLAG)
 		}
 
 		fields[i] = &query.Field{
 			Name:    c.Name,
 			Type:    c.Type.Type(),
 			Charset: charset,
+			Flags:   flags,
 		}
 	}
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -249,6 +250,76 @@ func TestHandlerKill(t *testing.T) {
 
 	require.Len(handler.sm.sessions, 1)
 	assertNoConnProcesses(t, e, conn1.ConnectionID)
+
+	// Connections that don't exist are unknown threads
+	for _, query := range []string{"KILL 42", "KILL QUERY 42"} {
+		err = handler.ComQuery(conn2, query, func(res *sqltypes.Result) error {
+			return nil
+		})
+		require.Error(err)
+		sqlErr, ok := err.(*mysql.SQLError)
+		require.True(ok)
+		require.Equal(mysql.ERNoSuchThread, sqlErr.Number())
+		require.Equal("Unknown thread id: 42", sqlErr.Message)
+	}
+}
+
+func TestHandlerKillInterruptsLongFunctions(t *testing.T) {
+	for _, query := range []string{"SELECT SLEEP(100)", "SELECT BENCHMARK(1000000000000, MD5('foo'))"} {
+		t.Run(query, func(t *testing.T) {
+			require := require.New(t)
+			e := setupMemDB(require)
+
+			handler := NewHandler(
+				e,
+				NewSessionManager(
+					testSessionBuilder,
+					opentracing.NoopTracer{},
+					func(db string) bool { return db == "test" },
+					e.MemoryManager,
+					e.ProcessList,
+					"foo",
+				),
+				0,
+			)
+
+			conn1 := newConn(1)
+			handler.NewConnection(conn1)
+			handler.ComInitDB(conn1, "test")
+
+			conn2 := newConn(2)
+			handler.NewConnection(conn2)
+			handler.ComInitDB(conn2, "test")
+
+			done := make(chan error, 1)
+			go func() {
+				done <- handler.ComQuery(conn1, query, func(res *sqltypes.Result) error {
+					return nil
+				})
+			}()
+
+			require.Eventually(func() bool {
+				for _, p := range e.ProcessList.Processes() {
+					if p.Connection == conn1.ConnectionID {
+						return true
+					}
+				}
+				return false
+			}, 5*time.Second, 10*time.Millisecond)
+
+			err := handler.ComQuery(conn2, "KILL QUERY 1", func(res *sqltypes.Result) error {
+				return nil
+			})
+			require.NoError(err)
+
+			select {
+			case err := <-done:
+				require.Error(err)
+			case <-time.After(2 * time.Second):
+				require.Fail("the query wasn't interrupted")
+			}
+		})
+	}
 }
 
 func assertNoConnProcesses(t *testing.T, e *sqle.Engine, conn uint32) {
@@ -261,6 +332,86 @@ func assertNoConnProcesses(t *testing.T, e *sqle.Engine, conn uint32) {
 	}
 }
 
+func TestHandlerDropsTemporaryTablesOnClose(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+				return sql.NewBaseSessionWithClientServer(addr, sql.Client{Capabilities: conn.Capabilities}, conn.ConnectionID), nil
+			},
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+		0,
+	)
+
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	handler.ComInitDB(conn, "test")
+
+	err := handler.ComQuery(conn, "CREATE TEMPORARY TABLE test (c1 int, c2 int)", func(res *sqltypes.Result) error {
+		return nil
+	})
+	require.NoError(err)
+
+	sess, ok := handler.sm.sessions[conn.ConnectionID].(sql.TemporaryTableSession)
+	require.True(ok)
+	require.Len(sess.GetAllTemporaryTables("test"), 1)
+
+	handler.ConnectionClosed(conn)
+	require.Len(sess.GetAllTemporaryTables("test"), 0)
+	require.Len(handler.sm.sessions, 0)
+}
+
+func TestHandlerReleasesLocksOnClose(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+				return sql.NewBaseSessionWithClientServer(addr, sql.Client{Capabilities: conn.Capabilities}, conn.ConnectionID), nil
+			},
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+		0,
+	)
+
+	conn1 := newConn(1)
+	handler.NewConnection(conn1)
+	conn2 := newConn(2)
+	handler.NewConnection(conn2)
+
+	getLock := func(conn *mysql.Conn) interface{} {
+		var locked interface{}
+		err := handler.ComQuery(conn, "SELECT GET_LOCK('job', 0)", func(res *sqltypes.Result) error {
+			locked = res.Rows[0][0].ToString()
+			return nil
+		})
+		require.NoError(err)
+		return locked
+	}
+
+	require.Equal("1", getLock(conn1))
+	require.Equal("0", getLock(conn2))
+
+	handler.ConnectionClosed(conn1)
+	state, _ := e.LS.GetLockState("job")
+	require.Equal(sql.LockFree, state)
+	require.Equal("1", getLock(conn2))
+}
+
 func TestSchemaToFields(t *testing.T) {
 	require := require.New(t)
 
@@ -268,12 +419,17 @@ func TestSchemaToFields(t *testing.T) {
 		{Name: "foo", Type: sql.Blob},
 		{Name: "bar", Type: sql.Text},
 		{Name: "baz", Type: sql.Int64},
+		{Name: "qux", Type: sql.MustCreateBinary(query.Type_VARBINARY, 10)},
+		{Name: "quux", Type: sql.MustCreateBitType(10)},
 	}
 
+	binaryFlag := uint32(query.MySqlFlag_BINARY_FLAG)
 	expected := []*query.Field{
-		{Name: "foo", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary},
+		{Name: "foo", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, Flags: binaryFlag},
 		{Name: "bar", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8},
 		{Name: "baz", Type: query.Type_INT64, Charset: mysql.CharacterSetUtf8},
+		{Name: "qux", Type: query.Type_VARBINARY, Charset: mysql.CharacterSetBinary, Flags: binaryFlag},
+		{Name: "quux", Type: query.Type_BIT, Charset: mysql.CharacterSetBinary, Flags: uint32(query.MySqlFlag_UNSIGNED_FLAG)},
 	}
 
 	fields := schemaToFields(schema)
@@ -561,3 +717,151 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlerSessionAffinity(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	conn := newConn(1)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		0,
+	)
+	handler.sessionAffinity = &NodeAffinity{Node: "node1", now: func() time.Time { return time.Unix(0, 42) }}
+
+	var changes []byte
+	handler.sessionState = func(c *mysql.Conn, r *sqltypes.Result, stateChanges []byte) {
+		changes = stateChanges
+	}
+	require.NoError(handler.ComInitDB(conn, "test"))
+
+	run := func(q string) *sqltypes.Result {
+		var result *sqltypes.Result
+		require.NoError(handler.ComQuery(conn, q, func(r *sqltypes.Result) error {
+			result = r
+			return nil
+		}))
+		return result
+	}
+
+	// Reads don't issue tokens
+	run("SELECT * FROM test LIMIT 1")
+	require.Nil(changes)
+	require.Zero(conn.StatusFlags & serverSessionStateChanged)
+
+	run("INSERT INTO test VALUES (20000)")
+	require.Equal(encodeSystemVariableChange(AffinityTokenSessionVar, "node1:42"), changes)
+	require.NotZero(conn.StatusFlags & serverSessionStateChanged)
+
+	r := run("SELECT @@session_affinity_token")
+	require.Equal("node1:42", r.Rows[0][0].ToString())
+	require.Zero(conn.StatusFlags & serverSessionStateChanged)
+}
+
+func TestHandlerCommitSequence(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.CommitSequence = sql.NewCommitSequence()
+	conn := newConn(1)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		0,
+	)
+
+	var changes []byte
+	handler.sessionState = func(c *mysql.Conn, r *sqltypes.Result, stateChanges []byte) {
+		changes = stateChanges
+	}
+	require.NoError(handler.ComInitDB(conn, "test"))
+
+	run := func(q string) *sqltypes.Result {
+		changes = nil
+		var result *sqltypes.Result
+		require.NoError(handler.ComQuery(conn, q, func(r *sqltypes.Result) error {
+			result = r
+			return nil
+		}))
+		return result
+	}
+
+	run("INSERT INTO test VALUES (20000)")
+	require.Equal(encodeSystemVariableChange(sql.LastCommitSequence, "1"), changes)
+
+	r := run("SELECT LAST_COMMIT_SEQUENCE()")
+	require.Equal("1", r.Rows[0][0].ToString())
+	require.Nil(changes)
+
+	run("DELETE FROM test WHERE c1 = 20000")
+	require.Equal(encodeSystemVariableChange(sql.LastCommitSequence, "2"), changes)
+	require.Equal(uint64(2), e.CommitSequence.Value())
+}
+
+func TestHandlerShowErrors(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	conn := newConn(1)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		0,
+	)
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, "test"))
+
+	run := func(q string) *sqltypes.Result {
+		var result *sqltypes.Result
+		require.NoError(handler.ComQuery(conn, q, func(r *sqltypes.Result) error {
+			result = r
+			return nil
+		}))
+		return result
+	}
+
+	err := handler.ComQuery(conn, "SELECT * FROM nonexistent", func(r *sqltypes.Result) error {
+		return nil
+	})
+	require.Error(err)
+
+	r := run("SHOW ERRORS")
+	require.Len(r.Rows, 1)
+	require.Equal("Error", r.Rows[0][0].ToString())
+	require.Equal("1146", r.Rows[0][1].ToString())
+
+	r = run("SHOW COUNT(*) ERRORS")
+	require.Equal("1", r.Rows[0][0].ToString())
+	require.Equal(uint16(1), handler.WarningCount(conn))
+}
+
+func TestEncodeSystemVariableChange(t *testing.T) {
+	require.Equal(t, []byte{0x00, 0x04, 0x01, 'a', 0x01, 'b'}, encodeSystemVariableChange("a", "b"))
+
+	long := strings.Repeat("x", 300)
+	changes := encodeSystemVariableChange("a", long)
+	require.Equal(t, []byte{0x00, 0xfc, 0x31, 0x01, 0x01, 'a', 0xfc, 0x2c, 0x01}, changes[:9])
+	require.Len(t, changes, 9+300)
+}
@@ -16,11 +16,15 @@ package server
 
 import (
 	"net"
+
+	"github.com/dolthub/go-mysql-server/sql"
 )
 
 type Listener struct {
 	net.Listener
 	h *Handler
+	// replicationSource answers the replication commands of the connections accepted, if set
+	replicationSource sql.ReplicationSource
 }
 
 // NewListener creates a new Listener.
@@ -29,9 +33,13 @@ func NewListener(protocol, address string, handler *Handler) (*Listener, error)
 	if err != nil {
 		return nil, err
 	}
-	return &Listener{l, handler}, nil
+	return &Listener{Listener: l, h: handler}, nil
 }
 
 func (l *Listener) Accept() (net.Conn, error) {
-	return l.Listener.Accept()
+	conn, err := l.Listener.Accept()
+	if err != nil || l.replicationSource == nil {
+		return conn, err
+	}
+	return newReplicationConn(conn, l.replicationSource), nil
 }
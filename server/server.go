@@ -19,6 +19,7 @@ import (
 	"github.com/opentracing/opentracing-go"
 
 	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
 )
 
 // NewDefaultServer creates a Server with the default session builder.
@@ -48,6 +49,31 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder) (*Server, error) {
 		cfg.MaxConnections = 0
 	}
 
+	e.BinlogFilters.SetFilters(cfg.BinlogFilters)
+	for _, sink := range cfg.BinlogSinks {
+		e.Binlog.AddSink(sink)
+	}
+	for _, l := range cfg.ChangeListeners {
+		e.AddChangeListener(l)
+	}
+	if cfg.BinaryLog != nil {
+		e.Binlog.SetBinaryLog(cfg.BinaryLog)
+	}
+	for _, source := range cfg.LoadDataSources {
+		e.LoadDataSources.AddSource(source)
+	}
+	if cfg.FunctionLimits != nil {
+		e.FunctionLimits = cfg.FunctionLimits
+	}
+	if cfg.LoadDataReporter != nil {
+		e.LoadDataReporter = cfg.LoadDataReporter
+	}
+	if cfg.ReplicaFilters != nil {
+		if err := setReplicaFilters(e, *cfg.ReplicaFilters); err != nil {
+			return nil, err
+		}
+	}
+
 	handler := NewHandler(e,
 		NewSessionManager(
 			sb,
@@ -57,11 +83,19 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder) (*Server, error) {
 			e.ProcessList,
 			cfg.Address),
 		cfg.ConnReadTimeout)
+	handler.sessionAffinity = cfg.SessionAffinity
+	handler.sessionState = cfg.SessionState
 	a := cfg.Auth.Mysql()
 	l, err := NewListener(cfg.Protocol, cfg.Address, handler)
 	if err != nil {
 		return nil, err
 	}
+	if source, ok := cfg.BinaryLog.(sql.ReplicationSource); ok {
+		if err := setReplicationSourceVariables(source); err != nil {
+			return nil, err
+		}
+		l.replicationSource = source
+	}
 
 	listenerCfg := mysql.ListenerConfig{
 		Listener:           l,
@@ -86,6 +120,30 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder) (*Server, error) {
 	return &Server{Listener: vtListnr, h: handler}, nil
 }
 
+// setReplicaFilters replaces the filters saved in the replication configuration store of the engine given.
+func setReplicaFilters(e *sqle.Engine, filters sql.ReplicationFilters) error {
+	ctx := sql.NewEmptyContext()
+	config, err := e.Replication.LoadReplicaConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	config.Filters = filters
+	return e.Replication.SaveReplicaConfig(ctx, config)
+}
+
+// setReplicationSourceVariables sets the system variables replicas check before they read the binary log of a
+// replication source.
+func setReplicationSourceVariables(source sql.ReplicationSource) error {
+	return sql.SystemVariables.AssignValues(map[string]interface{}{
+		"log_bin":                  1,
+		"gtid_mode":                "ON",
+		"enforce_gtid_consistency": "ON",
+		"server_id":                source.ServerID(),
+		"server_uuid":              source.ServerUUID(),
+	})
+}
+
 // Start starts accepting connections on the server.
 func (s *Server) Start() error {
 	s.Listener.Accept()
@@ -56,6 +56,38 @@ type Config struct {
 	RequireSecureTransport bool
 	// NoDefaults prevents using persisted configuration for new server sessions
 	NoDefaults bool
+	// SessionAffinity issues the session affinity tokens of sessions after they write. If |nil|, no tokens are issued.
+	SessionAffinity SessionAffinity
+	// SessionState attaches session state changes, such as new session affinity tokens, to the OK packets of
+	// statements. If |nil|, session state changes aren't sent to clients.
+	SessionState SessionStateFunc
+	// BinlogFilters are the databases and tables whose changes are included in the binary log, or any other stream of
+	// changes the integrator produces for replicas. They're set on the engine, where they can be changed at runtime.
+	BinlogFilters sql.ReplicationFilters
+	// BinlogSinks receive the transactions committed through the server, with their changes that pass the binlog
+	// filters. They're added to the binlog of the engine.
+	BinlogSinks []sql.BinlogSink
+	// ChangeListeners are notified of the changes of rows committed through the server that pass the binlog filters.
+	// They're added to the engine.
+	ChangeListeners []sql.ChangeListener
+	// BinaryLog is the binary log the server writes the transactions committed through it to, such as a binlog.Log,
+	// which the binary log statements describe. If |nil|, the server doesn't write a binary log. If it's a
+	// sql.ReplicationSource, MySQL replicas can replicate from the server, connecting without TLS.
+	BinaryLog sql.BinaryLog
+	// LoadDataReporter receives the reports of the LOAD DATA statements run through the server, listing the lines
+	// rejected by LOAD DATA IGNORE. It's set on the engine.
+	LoadDataReporter sql.LoadDataReporter
+	// LoadDataSources open the files of the LOAD DATA statements run through the server that aren't on its local disk,
+	// such as objects in object storage or HTTP(S) URLs, with sql.NewHTTPLoadDataSource. They're added to the engine.
+	LoadDataSources []sql.LoadDataSource
+	// FunctionLimits bound the work done by single calls of expensive functions, such as regular expressions matched
+	// against huge strings, and whether exceeding them fails queries or returns NULL. If |nil|, the default limits of
+	// the engine are kept.
+	FunctionLimits *sql.FunctionLimits
+	// ReplicaFilters are the databases and tables whose changes the server applies when it runs as a replica. If
+	// |nil|, the filters saved in the replication configuration store of the engine are kept. They can be changed at
+	// runtime with CHANGE REPLICATION FILTER.
+	ReplicaFilters *sql.ReplicationFilters
 }
 
 func (c Config) NewConfig() (Config, error) {
@@ -0,0 +1,133 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// AffinityTokenSessionVar is the session variable that holds the last session affinity token issued to a session.
+const AffinityTokenSessionVar = "session_affinity_token"
+
+// serverSessionStateChanged is the SERVER_SESSION_STATE_CHANGED status flag, set in OK packets that carry session
+// state information.
+const serverSessionStateChanged = 0x4000
+
+// sessionTrackSystemVariables is the type of the session state changes of system variables.
+const sessionTrackSystemVariables = 0x00
+
+// SessionAffinity issues the session affinity tokens sent to clients after their statements write. Proxies that route
+// the requests of a client to several engine nodes read the token to keep sending the requests of the session to the
+// node that saw its writes, so that the session reads its own writes.
+type SessionAffinity interface {
+	// Token returns the affinity token for the session of the context given, which just wrote.
+	Token(ctx *sql.Context) (string, error)
+}
+
+// NodeAffinity is a SessionAffinity whose tokens name the node that issued them and the time of the write, as
+// "<node>:<unix time in nanoseconds>", so that proxies can stop routing the session to the node once its writes are
+// visible everywhere.
+type NodeAffinity struct {
+	Node string
+	now  func() time.Time
+}
+
+var _ SessionAffinity = (*NodeAffinity)(nil)
+
+// NewNodeAffinity creates a NodeAffinity for the node named.
+func NewNodeAffinity(node string) *NodeAffinity {
+	return &NodeAffinity{Node: node, now: time.Now}
+}
+
+// Token implements SessionAffinity.
+func (a *NodeAffinity) Token(ctx *sql.Context) (string, error) {
+	return fmt.Sprintf("%s:%d", a.Node, a.now().UnixNano()), nil
+}
+
+// SessionStateFunc attaches the session state changes of a statement to its result, so that they're sent in its OK
+// packet along with the SERVER_SESSION_STATE_CHANGED status flag. |changes| is the session state information of the
+// OK packet, without its length. Since sending it depends on the wire protocol library, servers built on a library
+// that tracks session state must provide this function for session affinity tokens and commit sequence numbers to
+// reach clients.
+type SessionStateFunc func(c *mysql.Conn, r *sqltypes.Result, changes []byte)
+
+// setSessionState reports the changes to the state of the session made by a statement in its OK packet: the session
+// affinity token issued if the statement wrote, and the commit sequence number assigned if it committed a write.
+// |lastCommitSequence| is the last commit sequence number of the session before the statement ran.
+func (h *Handler) setSessionState(ctx *sql.Context, c *mysql.Conn, r *sqltypes.Result, parsedQuery sql.Node, lastCommitSequence int64) error {
+	c.StatusFlags &= ^uint16(serverSessionStateChanged)
+
+	var changes []byte
+	if h.sessionAffinity != nil && plan.IsWriteNode(parsedQuery) {
+		token, err := h.sessionAffinity.Token(ctx)
+		if err != nil {
+			return err
+		}
+		if err := ctx.SetSessionVariable(ctx, AffinityTokenSessionVar, token); err != nil {
+			return err
+		}
+		changes = append(changes, encodeSystemVariableChange(AffinityTokenSessionVar, token)...)
+	}
+
+	if seq := ctx.GetLastQueryInfo(sql.LastCommitSequence); seq != lastCommitSequence {
+		changes = append(changes, encodeSystemVariableChange(sql.LastCommitSequence, strconv.FormatInt(seq, 10))...)
+	}
+
+	if h.sessionState != nil && len(changes) > 0 {
+		c.StatusFlags |= uint16(serverSessionStateChanged)
+		h.sessionState(c, r, changes)
+	}
+	return nil
+}
+
+// encodeSystemVariableChange returns the session state information of an OK packet reporting that the system
+// variable given changed to the value given.
+// See https://dev.mysql.com/doc/internals/en/packet-OK_Packet.html
+func encodeSystemVariableChange(name, value string) []byte {
+	var data []byte
+	data = appendLenEncString(data, name)
+	data = appendLenEncString(data, value)
+
+	changes := []byte{sessionTrackSystemVariables}
+	changes = appendLenEncInt(changes, uint64(len(data)))
+	return append(changes, data...)
+}
+
+func appendLenEncString(data []byte, s string) []byte {
+	data = appendLenEncInt(data, uint64(len(s)))
+	return append(data, s...)
+}
+
+func appendLenEncInt(data []byte, i uint64) []byte {
+	switch {
+	case i < 251:
+		return append(data, byte(i))
+	case i < 1<<16:
+		return append(data, 0xfc, byte(i), byte(i>>8))
+	case i < 1<<24:
+		return append(data, 0xfd, byte(i), byte(i>>8), byte(i>>16))
+	default:
+		return append(data, 0xfe, byte(i), byte(i>>8), byte(i>>16), byte(i>>24),
+			byte(i>>32), byte(i>>40), byte(i>>48), byte(i>>56))
+	}
+}
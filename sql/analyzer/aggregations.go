@@ -48,14 +48,14 @@ func flattenAggregationExpressions(ctx *sql.Context, a *Analyzer, n sql.Node, sc
 				return n, nil
 			}
 
-			return flattenedGroupBy(ctx, n.SelectedExprs, n.GroupByExprs, n.Child)
+			return flattenedGroupBy(ctx, n.SelectedExprs, n.GroupByExprs, n.GroupingSets, n.Child)
 		default:
 			return n, nil
 		}
 	})
 }
 
-func flattenedGroupBy(ctx *sql.Context, projection, grouping []sql.Expression, child sql.Node) (sql.Node, error) {
+func flattenedGroupBy(ctx *sql.Context, projection, grouping []sql.Expression, groupingSets [][]int, child sql.Node) (sql.Node, error) {
 	newProjection, newAggregates, err := replaceAggregatesWithGetFieldProjections(ctx, projection)
 	if err != nil {
 		return nil, err
@@ -63,7 +63,7 @@ func flattenedGroupBy(ctx *sql.Context, projection, grouping []sql.Expression, c
 
 	return plan.NewProject(
 		newProjection,
-		plan.NewGroupBy(newAggregates, grouping, child),
+		plan.NewGroupBy(newAggregates, grouping, child).WithGroupingSets(groupingSets),
 	), nil
 }
 
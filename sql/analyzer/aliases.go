@@ -73,6 +73,9 @@ func getTableAliases(n sql.Node, scope *Scope) (TableAliases, error) {
 				analysisErr = passAliases.add(at, rt)
 			case *plan.IndexedTableAccess:
 				analysisErr = passAliases.add(at, t)
+			case *plan.Pivot, *plan.Unpivot:
+				// The table of a pivot is hidden by it, so the alias names the pivoted rows
+				analysisErr = passAliases.add(at, at)
 			case *plan.UnresolvedTable:
 				panic("Table not resolved")
 			default:
@@ -102,9 +105,13 @@ func getTableAliases(n sql.Node, scope *Scope) (TableAliases, error) {
 			rt := getResolvedTable(node.Destination)
 			analysisErr = passAliases.add(rt, rt)
 			return false
-		case *plan.ResolvedTable, *plan.SubqueryAlias, *plan.ValueDerivedTable, *plan.TransformedNamedNode:
+		case *plan.ResolvedTable, *plan.SubqueryAlias, *plan.ValueDerivedTable, *plan.TransformedNamedNode, *plan.JSONTable:
 			analysisErr = passAliases.add(node.(sql.Nameable), node.(sql.Nameable))
 			return false
+		case *plan.LateralJoin:
+			right := node.Right.(sql.Nameable)
+			analysisErr = passAliases.add(right, right)
+			return analysisErr == nil
 		case *plan.DecoratedNode:
 			aliasFn(node.Child)
 			return false
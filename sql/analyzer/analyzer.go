@@ -151,6 +151,15 @@ func (ab *Builder) RemoveValidationRule(name string) *Builder {
 	return ab
 }
 
+// AddAfterAllRule adds a new rule to the analyzer after all other rules, once the plan has been wrapped for process
+// tracking and parallelized.
+func (ab *Builder) AddAfterAllRule(name string, fn RuleFunc) *Builder {
+	// Copy the rules so the default rules shared by every builder aren't modified
+	ab.afterAllRules = append(append([]Rule(nil), ab.afterAllRules...), Rule{name, fn})
+
+	return ab
+}
+
 // RemoveAfterAllRule removes a default rule from the analyzer which would occur after all other rules
 func (ab *Builder) RemoveAfterAllRule(name string) *Builder {
 	ab.afterAllRules = duplicateRulesWithout(ab.afterAllRules, name)
@@ -258,7 +267,8 @@ type Analyzer struct {
 	Debug bool
 	// Whether to output the query plan at each step of the analyzer
 	Verbose bool
-	// A stack of debugger context. See PushDebugContext, PopDebugContext
+	// A stack of debugger context. See PushDebugContext, PopDebugContext. Every analysis works on its own copy of the
+	// analyzer, so the stack is never shared between statements analyzed concurrently.
 	contextStack []string
 	Parallelism  int
 	// Batches of Rules to apply.
@@ -348,6 +358,18 @@ func (a *Analyzer) Analyze(ctx *sql.Context, n sql.Node, scope *Scope) (sql.Node
 	return a.analyzeWithSelector(ctx, n, scope, analyzeAll)
 }
 
+// AnalyzeForCache analyzes the node given through every batch of rules but the last one, whose rules depend on the
+// execution of the plan, such as process tracking. The result can be kept to be executed more than once, finishing its
+// analysis every time with FinishCachedAnalysis.
+func (a *Analyzer) AnalyzeForCache(ctx *sql.Context, n sql.Node, scope *Scope) (sql.Node, error) {
+	return a.analyzeThroughBatch(ctx, n, scope, "post-validation")
+}
+
+// FinishCachedAnalysis applies the rules left by AnalyzeForCache to the node given, which is left unchanged.
+func (a *Analyzer) FinishCachedAnalysis(ctx *sql.Context, n sql.Node, scope *Scope) (sql.Node, error) {
+	return a.analyzeStartingAtBatch(ctx, n, scope, "after-all")
+}
+
 func (a *Analyzer) analyzeThroughBatch(ctx *sql.Context, n sql.Node, scope *Scope, until string) (sql.Node, error) {
 	stop := false
 	return a.analyzeWithSelector(ctx, n, scope, func(desc string) bool {
@@ -368,6 +390,8 @@ func (a *Analyzer) analyzeWithSelector(ctx *sql.Context, n sql.Node, scope *Scop
 		//"plan": , n.String(),
 	})
 
+	a = a.newAnalysis()
+
 	var err error
 	a.Log("starting analysis of node of type: %T", n)
 	for _, batch := range a.Batches {
@@ -393,6 +417,16 @@ func (a *Analyzer) analyzeWithSelector(ctx *sql.Context, n sql.Node, scope *Scop
 	return n, err
 }
 
+// newAnalysis returns a shallow copy of this analyzer to run a single analysis with. The state mutated while analyzing,
+// such as the debug context stack and the stored procedures loaded for the statement, belongs to the copy, which makes
+// it safe to analyze different statements concurrently with the same analyzer.
+func (a *Analyzer) newAnalysis() *Analyzer {
+	analysis := *a
+	analysis.contextStack = make([]string, len(a.contextStack), len(a.contextStack)+1)
+	copy(analysis.contextStack, a.contextStack)
+	return &analysis
+}
+
 func (a *Analyzer) analyzeStartingAtBatch(ctx *sql.Context, n sql.Node, scope *Scope, startAt string) (sql.Node, error) {
 	start := false
 	return a.analyzeWithSelector(ctx, n, scope, func(desc string) bool {
@@ -17,6 +17,8 @@ package analyzer
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -24,6 +26,7 @@ import (
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -324,3 +327,91 @@ func TestReorderProjectionUnresolvedChild(t *testing.T) {
 	require.NoError(err)
 	require.True(result.Resolved())
 }
+
+func TestConcurrentAnalysis(t *testing.T) {
+	require := require.New(t)
+
+	a, queries := concurrentAnalysisSetup(200)
+	a.PushDebugContext("base")
+
+	ctx := sql.NewContext(context.Background()).WithCurrentDB("mydb")
+	expected := make([]sql.Node, len(queries))
+	for i, q := range queries {
+		n, err := parse.Parse(ctx, q)
+		require.NoError(err)
+		expected[i], err = a.Analyze(ctx, n, nil)
+		require.NoError(err)
+	}
+
+	results := make([]sql.Node, len(queries))
+	errs := make([]error, len(queries))
+	var wg sync.WaitGroup
+	for i := range queries {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx := sql.NewContext(context.Background()).WithCurrentDB("mydb")
+			n, err := parse.Parse(ctx, queries[i])
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i], errs[i] = a.Analyze(ctx, n, nil)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range queries {
+		require.NoError(errs[i], queries[i])
+		require.Equal(expected[i].String(), results[i].String(), queries[i])
+	}
+	require.Equal([]string{"base"}, a.contextStack)
+}
+
+func BenchmarkConcurrentAnalysis(b *testing.B) {
+	a, queries := concurrentAnalysisSetup(1000)
+
+	var next int64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		ctx := sql.NewContext(context.Background()).WithCurrentDB("mydb")
+		for pb.Next() {
+			q := queries[int(atomic.AddInt64(&next, 1))%len(queries)]
+			n, err := parse.Parse(ctx, q)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := a.Analyze(ctx, n, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// concurrentAnalysisSetup returns an analyzer over a small database along with n distinct statements to analyze.
+func concurrentAnalysisSetup(n int) (*Analyzer, []string) {
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t1", memory.NewTable("t1", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "t1", PrimaryKey: true},
+		{Name: "s", Type: sql.Text, Source: "t1"},
+	}))
+	db.AddTable("t2", memory.NewTable("t2", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "t2", PrimaryKey: true},
+		{Name: "f", Type: sql.Float64, Source: "t2"},
+	}))
+
+	shapes := []string{
+		"SELECT i, upper(s) FROM t1 WHERE i > %d",
+		"SELECT s FROM t1 WHERE i = %d ORDER BY s",
+		"SELECT t1.i, t2.f FROM t1 JOIN t2 ON t1.i = t2.i WHERE t2.f < %d",
+		"SELECT count(*), s FROM t1 WHERE i <> %d GROUP BY s",
+		"SELECT i FROM t2 WHERE i IN (SELECT i FROM t1 WHERE i > %d)",
+		"SELECT concat(s, 'x'), abs(i - %d) FROM t1 LIMIT 10",
+	}
+	queries := make([]string, n)
+	for i := range queries {
+		queries[i] = fmt.Sprintf(shapes[i%len(shapes)], i)
+	}
+
+	return withoutProcessTracking(NewDefault(sql.NewDatabaseProvider(db))), queries
+}
@@ -49,7 +49,9 @@ func getUpdateAccumulatorType(n sql.Node) (plan.RowUpdateType, error) {
 	case *plan.TriggerExecutor:
 		return getUpdateAccumulatorType(n.Left())
 	case *plan.InsertInto:
-		if n.IsReplace {
+		if isLoadData(n.Source) {
+			return plan.UpdateTypeLoadData, nil
+		} else if n.IsReplace {
 			return plan.UpdateTypeReplace, nil
 		} else if len(n.OnDupExprs) > 0 {
 			return plan.UpdateTypeDuplicateKeyUpdate, nil
@@ -79,3 +81,15 @@ func getUpdateAccumulatorType(n sql.Node) (plan.RowUpdateType, error) {
 
 	return -1, fmt.Errorf("unexpected node type: %T", n)
 }
+
+// isLoadData returns whether the rows of the insert source given are read from a file by LOAD DATA.
+func isLoadData(source sql.Node) bool {
+	var found bool
+	plan.Inspect(source, func(n sql.Node) bool {
+		if _, ok := n.(*plan.LoadData); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/dolthub/go-mysql-server/internal/similartext"
 	"github.com/dolthub/go-mysql-server/sql"
@@ -25,8 +26,11 @@ import (
 )
 
 type Catalog struct {
-	provider         sql.DatabaseProvider
-	builtInFunctions function.Registry
+	provider sql.DatabaseProvider
+	// builtInFunctions holds a function.Registry that is never modified once stored, so that looking up functions
+	// doesn't need any locking. Registering functions stores a new copy of the registry, see RegisterFunction.
+	builtInFunctions atomic.Value
+	functionsMu      sync.Mutex
 	mu               sync.RWMutex
 	locks            sessionLocks
 }
@@ -39,11 +43,12 @@ type sessionLocks map[uint32]dbLocks
 
 // NewCatalog returns a new empty Catalog with the given provider
 func NewCatalog(provider sql.DatabaseProvider) sql.Catalog {
-	return &Catalog{
-		provider:         provider,
-		builtInFunctions: function.NewRegistry(),
-		locks:            make(sessionLocks),
+	c := &Catalog{
+		provider: provider,
+		locks:    make(sessionLocks),
 	}
+	c.builtInFunctions.Store(function.NewRegistry())
+	return c
 }
 
 func NewDatabaseProvider(dbs ...sql.Database) sql.DatabaseProvider {
@@ -85,6 +90,28 @@ func (c *Catalog) RemoveDatabase(ctx *sql.Context, dbName string) error {
 	}
 }
 
+// CreateSessionDatabase creates a new Database with the provider and adds it to the session of the context given, which
+// drops it when it's closed.
+func (c *Catalog) CreateSessionDatabase(ctx *sql.Context, dbName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	provider, ok := c.provider.(sql.SessionDatabaseProvider)
+	if !ok {
+		return sql.ErrSessionDatabaseNotSupported.New()
+	}
+	sess, ok := ctx.Session.(sql.SessionDatabaseSession)
+	if !ok {
+		return sql.ErrSessionDatabaseNotSupported.New()
+	}
+
+	db, err := provider.NewSessionDatabase(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	return sess.AddSessionDatabase(db)
+}
+
 func (c *Catalog) HasDB(db string) bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -153,17 +180,34 @@ func (c *Catalog) UnlockTables(ctx *sql.Context, id uint32) error {
 	return nil
 }
 
+// database returns the database with the given name, which is a database of the session of the context given if it has
+// one with that name.
+func (c *Catalog) database(ctx *sql.Context, dbName string) (sql.Database, error) {
+	if db, ok := sql.GetSessionDatabase(ctx, dbName); ok {
+		return db, nil
+	}
+	return c.provider.Database(dbName)
+}
+
 // Table returns the table in the given database with the given name.
 func (c *Catalog) Table(ctx *sql.Context, dbName, tableName string) (sql.Table, sql.Database, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	db, err := c.Database(dbName)
+	db, err := c.database(ctx, dbName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Temporary tables shadow the persistent tables with the same name
+	tbl, ok, err := sql.GetTemporaryTable(ctx, db, tableName)
 	if err != nil {
 		return nil, nil, err
+	} else if ok {
+		return tbl, db, nil
 	}
 
-	tbl, ok, err := db.GetTableInsensitive(ctx, tableName)
+	tbl, ok, err = db.GetTableInsensitive(ctx, tableName)
 	if err != nil {
 		return nil, nil, err
 	} else if !ok {
@@ -179,7 +223,7 @@ func (c *Catalog) TableAsOf(ctx *sql.Context, dbName, tableName string, asOf int
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	db, err := c.Database(dbName)
+	db, err := c.database(ctx, dbName)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -203,12 +247,28 @@ func (c *Catalog) TableAsOf(ctx *sql.Context, dbName, tableName string, asOf int
 // RegisterFunction registers the functions given, adding them to the built-in functions.
 // Integrators with custom functions should typically use the FunctionProvider interface instead.
 func (c *Catalog) RegisterFunction(fns ...sql.Function) {
+	c.functionsMu.Lock()
+	defer c.functionsMu.Unlock()
+
+	current := c.functions()
+	registry := make(function.Registry, len(current)+len(fns))
+	for name, fn := range current {
+		registry[name] = fn
+	}
+
 	for _, fn := range fns {
-		err := c.builtInFunctions.Register(fn)
+		err := registry.Register(fn)
 		if err != nil {
 			panic(err)
 		}
 	}
+
+	c.builtInFunctions.Store(registry)
+}
+
+// functions returns the current registry of built-in functions, which must not be modified.
+func (c *Catalog) functions() function.Registry {
+	return c.builtInFunctions.Load().(function.Registry)
 }
 
 // Function returns the function with the name given, or sql.ErrFunctionNotFound if it doesn't exist
@@ -222,7 +282,7 @@ func (c *Catalog) Function(name string) (sql.Function, error) {
 		}
 	}
 
-	return c.builtInFunctions.Function(name)
+	return c.functions().Function(name)
 }
 
 func suggestSimilarTables(db sql.Database, ctx *sql.Context, tableName string) error {
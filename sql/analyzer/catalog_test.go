@@ -16,12 +16,15 @@ package analyzer
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 func TestAllDatabases(t *testing.T) {
@@ -79,6 +82,49 @@ func TestCatalogTable(t *testing.T) {
 	require.Equal(mytable, table)
 }
 
+func TestCatalogConcurrentFunctions(t *testing.T) {
+	require := require.New(t)
+
+	c := NewCatalog(sql.NewDatabaseProvider(memory.NewDatabase("foo")))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10*100)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		name := fmt.Sprintf("fn%d", i)
+		go func() {
+			defer wg.Done()
+			c.RegisterFunction(sql.NewFunction0(name, func() sql.Expression {
+				return expression.NewLiteral(name, sql.LongText)
+			}))
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if _, err := c.Function("upper"); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(err)
+	}
+
+	for i := 0; i < 10; i++ {
+		f, err := c.Function(fmt.Sprintf("fn%d", i))
+		require.NoError(err)
+		require.Equal(fmt.Sprintf("fn%d", i), f.FunctionName())
+	}
+
+	require.Panics(func() {
+		c.RegisterFunction(sql.NewFunction0("fn0", nil))
+	})
+}
+
 func TestCatalogUnlockTables(t *testing.T) {
 	require := require.New(t)
 
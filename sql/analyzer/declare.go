@@ -85,16 +85,23 @@ func resolveDeclarationsInner(ctx *sql.Context, a *Analyzer, node sql.Node, scop
 		// Documentation on the ordering of DECLARE statements.
 		// BEGIN/END is treated specially for scope regarding DECLARE statements.
 		// https://dev.mysql.com/doc/refman/8.0/en/declare.html
+		// Handlers must be declared after the conditions.
 		lastStatementDeclare := true
+		handlerSeen := false
 		for _, child := range children {
 			switch child := child.(type) {
 			case *plan.DeclareCondition:
-				if !lastStatementDeclare {
+				if !lastStatementDeclare || handlerSeen {
 					return nil, sql.ErrDeclareOrderInvalid.New()
 				}
 				if err := scope.AddCondition(child); err != nil {
 					return nil, err
 				}
+			case *plan.DeclareHandler:
+				if !lastStatementDeclare {
+					return nil, sql.ErrDeclareOrderInvalid.New()
+				}
+				handlerSeen = true
 			default:
 				lastStatementDeclare = false
 			}
@@ -102,7 +109,7 @@ func resolveDeclarationsInner(ctx *sql.Context, a *Analyzer, node sql.Node, scop
 	} else {
 		for _, child := range children {
 			switch child.(type) {
-			case *plan.DeclareCondition:
+			case *plan.DeclareCondition, *plan.DeclareHandler:
 				return nil, sql.ErrDeclareOrderInvalid.New()
 			}
 		}
@@ -113,6 +120,8 @@ func resolveDeclarationsInner(ctx *sql.Context, a *Analyzer, node sql.Node, scop
 		switch child := child.(type) {
 		case *plan.Procedure, *plan.Block, *plan.IfElseBlock, *plan.IfConditional:
 			newChild, err = resolveDeclarationsInner(ctx, a, child, scope)
+		case *plan.DeclareHandler:
+			newChild, err = resolveDeclareHandler(ctx, a, child, scope)
 		case *plan.BeginEndBlock, *plan.TriggerBeginEndBlock:
 			newChild, err = resolveDeclarationsInner(ctx, a, child, newDeclarationScope(scope))
 		case *plan.SignalName:
@@ -124,6 +133,18 @@ func resolveDeclarationsInner(ctx *sql.Context, a *Analyzer, node sql.Node, scop
 				return nil, sql.ErrSignalOnlySqlState.New()
 			}
 			newChild = plan.NewSignal(condition.SqlStateValue, child.Signal.Info)
+		case *plan.Resignal:
+			newChild = child
+			if child.Name != "" {
+				condition := scope.GetCondition(child.Name)
+				if condition == nil {
+					return nil, sql.ErrDeclareConditionNotFound.New(child.Name)
+				}
+				if condition.SqlStateValue == "" {
+					return nil, sql.ErrSignalOnlySqlState.New()
+				}
+				newChild = plan.NewResignal(condition.SqlStateValue, "", child.Info)
+			}
 		default:
 			newChild = child
 		}
@@ -134,3 +155,26 @@ func resolveDeclarationsInner(ctx *sql.Context, a *Analyzer, node sql.Node, scop
 	}
 	return node.WithChildren(newChildren...)
 }
+
+// resolveDeclareHandler replaces the condition names of the handler given with the conditions they were declared for,
+// and resolves the declarations referenced by its statement.
+func resolveDeclareHandler(ctx *sql.Context, a *Analyzer, handler *plan.DeclareHandler, scope *declarationScope) (sql.Node, error) {
+	conditions := make([]plan.HandlerCondition, len(handler.Conditions))
+	for i, c := range handler.Conditions {
+		conditions[i] = c
+		if c.Type != plan.HandlerConditionType_ConditionName {
+			continue
+		}
+		condition := scope.GetCondition(c.Name)
+		if condition == nil {
+			return nil, sql.ErrDeclareConditionNotFound.New(c.Name)
+		}
+		if condition.SqlStateValue != "" {
+			conditions[i] = plan.HandlerCondition{Type: plan.HandlerConditionType_SqlState, SqlStateValue: condition.SqlStateValue}
+		} else {
+			conditions[i] = plan.HandlerCondition{Type: plan.HandlerConditionType_MysqlErrCode, MysqlErrCode: condition.MysqlErrCode}
+		}
+	}
+
+	return resolveDeclarationsInner(ctx, a, plan.NewDeclareHandler(handler.Action, conditions, handler.Statement), scope)
+}
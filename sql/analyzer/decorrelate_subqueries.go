@@ -0,0 +1,268 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// scalarSubqueryJoinAlias is the prefix of the name given to the subquery aliases created by
+// decorrelateScalarSubqueries.
+const scalarSubqueryJoinAlias = "__scalar_subquery"
+
+// decorrelateScalarSubqueries rewrites correlated scalar subqueries in the projections of a query, of the form
+// `(SELECT agg(...) FROM t WHERE t.a = outer.b AND ...)`, into a left join of the rest of the query with the aggregate
+// of the table grouped by its correlated columns. The subquery is then evaluated once, instead of once for every row
+// of the outer query. This is only done when it doesn't change the results of the query:
+//   - the subquery is a single aggregate without GROUP BY, so it returns exactly one row for every outer row, and
+//     outer rows without a matching group get the value of the aggregate over no rows;
+//   - the outer scope is only referenced by equalities between integer columns of the same type, so every outer row
+//     matches at most one group, just like it would match the same rows in the subquery;
+//   - the subquery is deterministic and only reads a single table, so it can be evaluated only once.
+func decorrelateScalarSubqueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("decorrelate_scalar_subqueries")
+	defer span.Finish()
+
+	if !canDoPushdown(n) || len(scope.Schema()) > 0 || isUpdateOrDelete(n) {
+		return n, nil
+	}
+
+	var aliases int
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		project, ok := n.(*plan.Project)
+		if !ok {
+			return n, nil
+		}
+
+		scopeLen := len(project.Child.Schema())
+		node := project.Child
+		// Subqueries that are left in the projections would be evaluated with the columns of the joins appended to
+		// their scope row, so either all of them are decorrelated or none is.
+		var subqueries, joined int
+		projections := make([]sql.Expression, len(project.Projections))
+		for i, p := range project.Projections {
+			var decorrelatedAny bool
+			var err error
+			projections[i], err = expression.TransformUp(p, func(e sql.Expression) (sql.Expression, error) {
+				s, ok := e.(*plan.Subquery)
+				if !ok {
+					return e, nil
+				}
+				subqueries++
+
+				decorrelated, ok := decorrelateScalarSubquery(s, scopeLen)
+				if !ok {
+					return e, nil
+				}
+
+				name := fmt.Sprintf("%s%d", scalarSubqueryJoinAlias, aliases)
+				aliases++
+				joined++
+				decorrelatedAny = true
+				a.Log("decorrelating subquery %s into a join with %s", s.QueryString, name)
+
+				left := len(node.Schema())
+				alias := plan.NewSubqueryAlias(name, "", decorrelated.query)
+				schema := alias.Schema()
+
+				conds := make([]sql.Expression, len(decorrelated.outer))
+				for k, outer := range decorrelated.outer {
+					col := schema[k+1]
+					right := expression.NewGetFieldWithTable(left+k+1, col.Type, name, col.Name, col.Nullable)
+					conds[k] = expression.NewEquals(outer, right)
+				}
+				node = plan.NewLeftJoin(node, alias, expression.JoinAnd(conds...))
+
+				col := schema[0]
+				var value sql.Expression = expression.NewGetFieldWithTable(left, col.Type, name, col.Name, true)
+				if decorrelated.zeroOnEmpty {
+					return function.NewCoalesce(value, expression.NewLiteral(int64(0), sql.Int64))
+				}
+				return value, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			// The column keeps the name it had when it was the subquery itself.
+			if _, ok := p.(*expression.Alias); !ok && decorrelatedAny {
+				projections[i] = expression.NewAlias(expression.ExpressionToColumn(p).Name, projections[i])
+			}
+		}
+
+		if node == project.Child || joined != subqueries {
+			return n, nil
+		}
+		return plan.NewProject(projections, node), nil
+	})
+}
+
+// decorrelatedSubquery is a correlated scalar subquery rewritten to be evaluated once for all the outer rows.
+type decorrelatedSubquery struct {
+	// query returns the aggregate of the subquery for each group of its correlated columns, in its first column,
+	// followed by the correlated columns. Its field indexes don't include the outer scope.
+	query sql.Node
+	// outer are the outer scope columns that are compared with the correlated columns of the query, in order.
+	outer []sql.Expression
+	// zeroOnEmpty is whether the aggregate is 0, rather than NULL, when no row of the subquery matches.
+	zeroOnEmpty bool
+}
+
+// decorrelateScalarSubquery returns the subquery given rewritten as an aggregate grouped by its correlated columns,
+// if it can be joined with its outer scope, whose schema has the length given.
+func decorrelateScalarSubquery(s *plan.Subquery, scopeLen int) (*decorrelatedSubquery, bool) {
+	if !s.Resolved() || !isDeterminstic(s.Query) {
+		return nil, false
+	}
+
+	groupBy, ok := s.Query.(*plan.GroupBy)
+	if !ok || len(groupBy.GroupByExprs) > 0 || len(groupBy.SelectedExprs) != 1 {
+		return nil, false
+	}
+
+	agg := groupBy.SelectedExprs[0]
+	if alias, ok := agg.(*expression.Alias); ok {
+		agg = alias.Child
+	}
+
+	var zeroOnEmpty bool
+	switch agg.(type) {
+	case *aggregation.Count, *aggregation.CountDistinct:
+		zeroOnEmpty = true
+	case *aggregation.Sum, *aggregation.Min, *aggregation.Max, *aggregation.Avg:
+	default:
+		return nil, false
+	}
+
+	var outer []sql.Expression
+	var inner []sql.Expression
+	supported := true
+	child, err := plan.TransformUp(groupBy.Child, func(n sql.Node) (sql.Node, error) {
+		switch n := n.(type) {
+		case *plan.Filter:
+			var rest []sql.Expression
+			for _, e := range splitConjunction(n.Expression) {
+				o, i, ok := correlatedEquality(e, scopeLen)
+				if !ok {
+					rest = append(rest, e)
+					continue
+				}
+				outer = append(outer, o)
+				inner = append(inner, i)
+			}
+			if len(rest) == 0 {
+				return n.Child, nil
+			}
+			return plan.NewFilter(expression.JoinAnd(rest...), n.Child), nil
+		case *plan.IndexedTableAccess:
+			// Lookups keyed on the outer row can't be used once the subquery is evaluated on its own. The filter
+			// the lookup was built for is kept above it, so the whole table is read and filtered instead.
+			if nodeHasGetFieldReferenceBetween(n, 0, scopeLen) {
+				return n.ResolvedTable, nil
+			}
+			return n, nil
+		case *plan.DecoratedNode, *plan.TableAlias, *plan.ResolvedTable, *plan.Project:
+			return n, nil
+		default:
+			supported = false
+			return n, nil
+		}
+	})
+	if err != nil || !supported || len(outer) == 0 {
+		return nil, false
+	}
+
+	if nodeHasGetFieldReferenceBetween(child, 0, scopeLen) || expressionHasGetFieldReferenceBetween(agg, 0, scopeLen) {
+		return nil, false
+	}
+
+	hasSubquery := false
+	plan.InspectExpressions(child, func(e sql.Expression) bool {
+		if _, ok := e.(*plan.Subquery); ok {
+			hasSubquery = true
+		}
+		return !hasSubquery
+	})
+	if hasSubquery {
+		return nil, false
+	}
+
+	shift := func(e sql.Expression) (sql.Expression, error) {
+		if gf, ok := e.(*expression.GetField); ok {
+			return gf.WithIndex(gf.Index() - scopeLen), nil
+		}
+		return e, nil
+	}
+
+	child, err = plan.TransformExpressionsUp(child, shift)
+	if err != nil {
+		return nil, false
+	}
+
+	selected := make([]sql.Expression, len(inner)+1)
+	selected[0], err = expression.TransformUp(agg, shift)
+	if err != nil {
+		return nil, false
+	}
+	for i, e := range inner {
+		selected[i+1], err = expression.TransformUp(e, shift)
+		if err != nil {
+			return nil, false
+		}
+	}
+
+	return &decorrelatedSubquery{
+		query:       plan.NewGroupBy(selected, selected[1:], child),
+		outer:       outer,
+		zeroOnEmpty: zeroOnEmpty,
+	}, true
+}
+
+// correlatedEquality returns the outer and inner columns of the expression given if it's an equality between a column
+// of the outer scope, whose schema has the length given, and a column of the subquery with the same integer type.
+func correlatedEquality(e sql.Expression, scopeLen int) (outer, inner sql.Expression, ok bool) {
+	eq, ok := e.(*expression.Equals)
+	if !ok {
+		return nil, nil, false
+	}
+
+	left, ok := eq.Left().(*expression.GetField)
+	if !ok {
+		return nil, nil, false
+	}
+	right, ok := eq.Right().(*expression.GetField)
+	if !ok {
+		return nil, nil, false
+	}
+
+	if left.Index() >= scopeLen {
+		left, right = right, left
+	}
+	if left.Index() >= scopeLen || right.Index() < scopeLen {
+		return nil, nil, false
+	}
+
+	if !sql.IsInteger(left.Type()) || left.Type().String() != right.Type().String() {
+		return nil, nil, false
+	}
+
+	return left, right, true
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestDecorrelateScalarSubqueries(t *testing.T) {
+	mytable := memory.NewTable("mytable", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable"},
+		{Name: "s", Type: sql.Text, Source: "mytable"},
+	})
+	othertable := memory.NewTable("othertable", sql.Schema{
+		{Name: "s2", Type: sql.Text, Source: "othertable"},
+		{Name: "i2", Type: sql.Int64, Source: "othertable", Nullable: true},
+	})
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	s := expression.NewGetFieldWithTable(1, sql.Text, "mytable", "s", false)
+
+	// Subquery expressions are resolved with the outer row prepended to their own rows.
+	s2 := expression.NewGetFieldWithTable(2, sql.Text, "othertable", "s2", false)
+	i2 := expression.NewGetFieldWithTable(3, sql.Int64, "othertable", "i2", true)
+	aggregate := func(agg sql.Expression, cond sql.Expression) *plan.Subquery {
+		return plan.NewSubquery(
+			plan.NewGroupBy(
+				[]sql.Expression{agg},
+				nil,
+				plan.NewFilter(cond, plan.NewResolvedTable(othertable, nil, nil)),
+			),
+			"select agg from othertable where cond",
+		)
+	}
+
+	count := aggregate(aggregation.NewCount(i2), expression.NewEquals(i2, i))
+
+	shiftedI2 := expression.NewGetFieldWithTable(1, sql.Int64, "othertable", "i2", true)
+	shiftedS2 := expression.NewGetFieldWithTable(0, sql.Text, "othertable", "s2", false)
+	countName := aggregation.NewCount(shiftedI2).String()
+	coalesce, err := function.NewCoalesce(
+		expression.NewGetFieldWithTable(2, sql.Int64, "__scalar_subquery0", countName, true),
+		expression.NewLiteral(int64(0), sql.Int64),
+	)
+	require.NoError(t, err)
+
+	a := NewDefault(sql.NewDatabaseProvider())
+
+	tests := []analyzerFnTestCase{
+		{
+			name: "correlated count subquery",
+			node: plan.NewProject(
+				[]sql.Expression{i, count},
+				plan.NewResolvedTable(mytable, nil, nil),
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{i, expression.NewAlias(expression.ExpressionToColumn(count).Name, coalesce)},
+				plan.NewLeftJoin(
+					plan.NewResolvedTable(mytable, nil, nil),
+					plan.NewSubqueryAlias("__scalar_subquery0", "", plan.NewGroupBy(
+						[]sql.Expression{aggregation.NewCount(shiftedI2), shiftedI2},
+						[]sql.Expression{shiftedI2},
+						plan.NewResolvedTable(othertable, nil, nil),
+					)),
+					expression.NewEquals(i, expression.NewGetFieldWithTable(3, sql.Int64, "__scalar_subquery0", "i2", true)),
+				),
+			),
+		},
+		{
+			name: "correlated max subquery with another condition",
+			node: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("m", aggregate(
+					aggregation.NewMax(i2),
+					expression.NewAnd(
+						expression.NewEquals(i, i2),
+						expression.NewEquals(s2, expression.NewLiteral("a", sql.LongText)),
+					),
+				))},
+				plan.NewResolvedTable(mytable, nil, nil),
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("m", expression.NewGetFieldWithTable(
+					2, sql.Int64, "__scalar_subquery0", aggregation.NewMax(shiftedI2).String(), true,
+				))},
+				plan.NewLeftJoin(
+					plan.NewResolvedTable(mytable, nil, nil),
+					plan.NewSubqueryAlias("__scalar_subquery0", "", plan.NewGroupBy(
+						[]sql.Expression{aggregation.NewMax(shiftedI2), shiftedI2},
+						[]sql.Expression{shiftedI2},
+						plan.NewFilter(
+							expression.NewEquals(shiftedS2, expression.NewLiteral("a", sql.LongText)),
+							plan.NewResolvedTable(othertable, nil, nil),
+						),
+					)),
+					expression.NewEquals(i, expression.NewGetFieldWithTable(3, sql.Int64, "__scalar_subquery0", "i2", true)),
+				),
+			),
+		},
+		{
+			name: "correlated by a comparison other than equality",
+			node: plan.NewProject(
+				[]sql.Expression{aggregate(aggregation.NewCount(i2), expression.NewGreaterThan(i2, i))},
+				plan.NewResolvedTable(mytable, nil, nil),
+			),
+		},
+		{
+			name: "correlated by columns of different types",
+			node: plan.NewProject(
+				[]sql.Expression{aggregate(aggregation.NewCount(i2), expression.NewEquals(s2, s))},
+				plan.NewResolvedTable(mytable, nil, nil),
+			),
+		},
+		{
+			name: "uncorrelated subquery",
+			node: plan.NewProject(
+				[]sql.Expression{aggregate(aggregation.NewCount(i2), expression.NewEquals(s2, expression.NewLiteral("a", sql.LongText)))},
+				plan.NewResolvedTable(mytable, nil, nil),
+			),
+		},
+		{
+			name: "subquery inside a subquery",
+			node: plan.NewProject(
+				[]sql.Expression{i, count},
+				plan.NewResolvedTable(mytable, nil, nil),
+			),
+			scope: newScope(plan.NewFilter(expression.NewLiteral(true, sql.Boolean), plan.NewResolvedTable(othertable, nil, nil))),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, a, getRule("decorrelate_scalar_subqueries"))
+}
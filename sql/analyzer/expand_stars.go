@@ -19,6 +19,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -37,6 +38,11 @@ func expandStars(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.N
 			return n, nil
 		}
 
+		n, err := expandStarsInRowHashes(a, n, tableAliases)
+		if err != nil {
+			return nil, err
+		}
+
 		switch n := n.(type) {
 		case *plan.Project:
 			if !n.Child.Resolved() {
@@ -59,7 +65,7 @@ func expandStars(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.N
 				return nil, err
 			}
 
-			return plan.NewGroupBy(expanded, n.GroupByExprs, n.Child), nil
+			return plan.NewGroupBy(expanded, n.GroupByExprs, n.Child).WithGroupingSets(n.GroupingSets), nil
 		case *plan.Window:
 			if !n.Child.Resolved() {
 				return n, nil
@@ -105,3 +111,43 @@ func expandStarsForExpressions(a *Analyzer, exprs []sql.Expression, schema sql.S
 	a.Log("resolved * to expressions %s", expressions)
 	return expressions, nil
 }
+
+// expandStarsInRowHashes replaces the stars among the arguments of the row hash functions of the node given with the
+// columns of its children, which are those of both sides of joins.
+func expandStarsInRowHashes(a *Analyzer, n sql.Node, tableAliases TableAliases) (sql.Node, error) {
+	ne, ok := n.(sql.Expressioner)
+	if !ok || len(n.Children()) == 0 {
+		return n, nil
+	}
+	var hasStars bool
+	for _, e := range ne.Expressions() {
+		sql.Inspect(e, func(e sql.Expression) bool {
+			if rh, ok := e.(*function.RowHash); ok && !rh.Resolved() {
+				hasStars = true
+			}
+			return !hasStars
+		})
+	}
+	if !hasStars {
+		return n, nil
+	}
+	var schema sql.Schema
+	for _, child := range n.Children() {
+		if !child.Resolved() {
+			return n, nil
+		}
+		schema = append(schema, child.Schema()...)
+	}
+
+	return plan.TransformExpressions(n, func(e sql.Expression) (sql.Expression, error) {
+		rh, ok := e.(*function.RowHash)
+		if !ok || rh.Resolved() {
+			return e, nil
+		}
+		expanded, err := expandStarsForExpressions(a, rh.Children(), schema, tableAliases)
+		if err != nil {
+			return nil, err
+		}
+		return rh.WithChildren(expanded...)
+	})
+}
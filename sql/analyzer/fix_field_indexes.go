@@ -167,6 +167,17 @@ func FixFieldIndexesForExpressions(ctx *sql.Context, a *Analyzer, node sql.Node,
 		if err != nil {
 			return nil, err
 		}
+	case *plan.MergeJoin:
+		// The keys of both sides are evaluated on rows of the join, like its condition
+		exprs, err := FixFieldIndexesOnExpressions(ctx, scope, a, j.Schema(), j.Expressions()...)
+		if err != nil {
+			return nil, err
+		}
+
+		n, err = j.WithExpressions(exprs...)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return n, nil
@@ -0,0 +1,57 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// wideDistinctColumns is the number of columns from which the rows of a Distinct node are hashed with ROW_HASH.
+const wideDistinctColumns = 16
+
+// hashWideDistinct makes Distinct nodes with wide rows hash them with ROW_HASH of all their columns, which encodes
+// values directly rather than formatting each of them like sql.HashOf does.
+func hashWideDistinct(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		distinct, ok := n.(*plan.Distinct)
+		if !ok || distinct.Hash != nil {
+			return n, nil
+		}
+
+		schema := distinct.Child.Schema()
+		if len(schema) < wideDistinctColumns {
+			return n, nil
+		}
+
+		columns := make([]sql.Expression, len(schema))
+		for i, col := range schema {
+			columns[i] = expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable)
+		}
+		hash, err := function.NewRowHash(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		a.Log("distinct of %d columns hashed with row hashes", len(schema))
+		return distinct.WithHash(hash), nil
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestHashWideDistinct(t *testing.T) {
+	var wideSchema sql.Schema
+	var columns []sql.Expression
+	for i := 0; i < wideDistinctColumns; i++ {
+		name := fmt.Sprintf("c%d", i)
+		wideSchema = append(wideSchema, &sql.Column{Name: name, Type: sql.Int64, Source: "wide", Nullable: true})
+		columns = append(columns, expression.NewGetFieldWithTable(i, sql.Int64, "wide", name, true))
+	}
+	wide := plan.NewResolvedTable(memory.NewTable("wide", wideSchema), nil, nil)
+	narrow := plan.NewResolvedTable(memory.NewTable("narrow", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "narrow"},
+		{Name: "b", Type: sql.Text, Source: "narrow"},
+	}), nil, nil)
+
+	hash, err := function.NewRowHash(columns...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runTestCases(t, nil, []analyzerFnTestCase{
+		{
+			name:     "wide rows hashed with row hashes",
+			node:     plan.NewDistinct(wide),
+			expected: plan.NewDistinct(wide).WithHash(hash),
+		},
+		{
+			name: "narrow rows unchanged",
+			node: plan.NewDistinct(narrow),
+		},
+		{
+			name:     "nested distinct",
+			node:     plan.NewLimit(expression.NewLiteral(int8(1), sql.Int8), plan.NewDistinct(wide)),
+			expected: plan.NewLimit(expression.NewLiteral(int8(1), sql.Int8), plan.NewDistinct(wide).WithHash(hash)),
+		},
+	}, NewDefault(nil), getRule("hash_wide_distinct"))
+}
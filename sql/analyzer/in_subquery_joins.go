@@ -0,0 +1,180 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// inSubqueryJoinAlias is the prefix of the name given to the subquery aliases created by convertInSubqueriesToJoins.
+const inSubqueryJoinAlias = "__in_subquery"
+
+// convertInSubqueriesToJoins rewrites filters of the form `col IN (SELECT ...)` over a single table into an inner join
+// of the table with the distinct results of the subquery, which is later turned into a hash lookup. This is only done
+// when it doesn't change the results of the query:
+//   - the IN is a top level conjunct of the filter, so a NULL result is treated just like a false one, which is also
+//     what happens when no row of the subquery matches in the join;
+//   - the subquery doesn't reference the outer scope and is deterministic, so it can be evaluated only once;
+//   - the column and the subquery have the same type, so equality in the join matches the IN semantics;
+//   - the results of the subquery are deduplicated, so every row of the table is returned at most once.
+func convertInSubqueriesToJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("in_subquery_joins")
+	defer span.Finish()
+
+	// The rows of subqueries are prefixed with the rows of their outer scope, which the join would have to account for.
+	if !canDoPushdown(n) || len(scope.Schema()) > 0 || isUpdateOrDelete(n) {
+		return n, nil
+	}
+
+	var aliases int
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, nil
+		}
+
+		tableNode, ok := singleTableNode(filter.Child)
+		if !ok {
+			return n, nil
+		}
+
+		var rest []sql.Expression
+		var lefts []*expression.GetField
+		var subqueries []sql.Node
+		for _, e := range splitConjunction(filter.Expression) {
+			left, query, ok := joinableInSubquery(e, tableNode.Name(), len(filter.Child.Schema()))
+			if !ok {
+				rest = append(rest, e)
+				continue
+			}
+			lefts = append(lefts, left)
+			subqueries = append(subqueries, query)
+		}
+
+		if len(subqueries) == 0 {
+			return n, nil
+		}
+
+		a.Log("converting IN subqueries on table %s to joins", tableNode.Name())
+
+		var node sql.Node = filter.Child
+		if len(rest) > 0 {
+			node = plan.NewFilter(expression.JoinAnd(rest...), filter.Child)
+		}
+
+		for i, query := range subqueries {
+			name := fmt.Sprintf("%s%d", inSubqueryJoinAlias, aliases)
+			aliases++
+
+			col := query.Schema()[0]
+			right := expression.NewGetFieldWithTable(len(node.Schema()), col.Type, name, col.Name, col.Nullable)
+			alias := plan.NewSubqueryAlias(name, "", plan.NewDistinct(query))
+			node = plan.NewInnerJoin(node, alias, expression.NewEquals(lefts[i], right))
+		}
+
+		projections := make([]sql.Expression, len(filter.Schema()))
+		for i, col := range filter.Schema() {
+			projections[i] = expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable)
+		}
+
+		return plan.NewProject(projections, node), nil
+	})
+}
+
+// joinableInSubquery returns the column and the query of the subquery of the given expression if it's an IN subquery
+// that can be turned into a join with the table given, whose schema has the length given. The query returned has its
+// field indexes rewritten to work without the outer scope row.
+func joinableInSubquery(e sql.Expression, table string, scopeLen int) (*expression.GetField, sql.Node, bool) {
+	in, ok := e.(*plan.InSubquery)
+	if !ok {
+		return nil, nil, false
+	}
+
+	left, ok := in.Left.(*expression.GetField)
+	if !ok || !onlyUsesTable(left, table) {
+		return nil, nil, false
+	}
+
+	subquery, ok := in.Right.(*plan.Subquery)
+	if !ok || !subquery.Resolved() {
+		return nil, nil, false
+	}
+
+	schema := subquery.Query.Schema()
+	if len(schema) != 1 || left.Type().String() != schema[0].Type.String() {
+		return nil, nil, false
+	}
+
+	if !isDeterminstic(subquery.Query) || !isSelfContainedQuery(subquery.Query) ||
+		nodeHasGetFieldReferenceBetween(subquery.Query, 0, scopeLen) {
+		return nil, nil, false
+	}
+
+	query, err := plan.TransformExpressionsUp(subquery.Query, func(e sql.Expression) (sql.Expression, error) {
+		if gf, ok := e.(*expression.GetField); ok {
+			return gf.WithIndex(gf.Index() - scopeLen), nil
+		}
+		return e, nil
+	})
+	if err != nil {
+		return nil, nil, false
+	}
+
+	return left, query, true
+}
+
+// isSelfContainedQuery returns whether the query given only evaluates against its own rows, so that the field indexes
+// of all its expressions can be shifted to remove the outer scope row. Nodes that evaluate their children or
+// subqueries in a different scope are not supported.
+func isSelfContainedQuery(n sql.Node) bool {
+	supported := true
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n.(type) {
+		case *plan.SubqueryAlias, *plan.Union, plan.JoinNode, *plan.IndexedJoin, *plan.StripRowNode,
+			*plan.CachedResults, *plan.IndexedInSubqueryFilter:
+			supported = false
+			return false
+		}
+		return true
+	})
+	if !supported {
+		return false
+	}
+
+	plan.InspectExpressions(n, func(e sql.Expression) bool {
+		if _, ok := e.(*plan.Subquery); ok {
+			supported = false
+		}
+		return supported
+	})
+	return supported
+}
+
+// isUpdateOrDelete returns whether the node given modifies the rows of its child, which must keep being a table.
+func isUpdateOrDelete(n sql.Node) bool {
+	var found bool
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n.(type) {
+		case *plan.Update, *plan.DeleteFrom:
+			found = true
+		}
+		return !found
+	})
+	return found
+}
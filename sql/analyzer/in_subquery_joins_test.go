@@ -0,0 +1,119 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestConvertInSubqueriesToJoins(t *testing.T) {
+	mytable := memory.NewTable("mytable", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable"},
+		{Name: "s", Type: sql.Text, Source: "mytable"},
+	})
+	othertable := memory.NewTable("othertable", sql.Schema{
+		{Name: "s2", Type: sql.Text, Source: "othertable"},
+		{Name: "i2", Type: sql.Int64, Source: "othertable", Nullable: true},
+	})
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	s := expression.NewGetFieldWithTable(1, sql.Text, "mytable", "s", false)
+	sEquals := expression.NewEquals(s, expression.NewLiteral("a", sql.LongText))
+
+	// Subquery expressions are resolved with the outer row prepended to their own rows.
+	subquery := plan.NewSubquery(
+		plan.NewProject(
+			[]sql.Expression{expression.NewGetFieldWithTable(3, sql.Int64, "othertable", "i2", true)},
+			plan.NewResolvedTable(othertable, nil, nil),
+		),
+		"select i2 from othertable",
+	)
+	correlated := plan.NewSubquery(
+		plan.NewProject(
+			[]sql.Expression{expression.NewGetFieldWithTable(3, sql.Int64, "othertable", "i2", true)},
+			plan.NewFilter(
+				expression.NewEquals(expression.NewGetFieldWithTable(2, sql.Text, "othertable", "s2", false), s),
+				plan.NewResolvedTable(othertable, nil, nil),
+			),
+		),
+		"select i2 from othertable where s2 = s",
+	)
+	otherType := plan.NewSubquery(
+		plan.NewProject(
+			[]sql.Expression{expression.NewGetFieldWithTable(2, sql.Text, "othertable", "s2", false)},
+			plan.NewResolvedTable(othertable, nil, nil),
+		),
+		"select s2 from othertable",
+	)
+
+	a := NewDefault(sql.NewDatabaseProvider())
+
+	tests := []analyzerFnTestCase{
+		{
+			name: "uncorrelated IN subquery",
+			node: plan.NewFilter(
+				expression.NewAnd(plan.NewInSubquery(i, subquery), sEquals),
+				plan.NewResolvedTable(mytable, nil, nil),
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{i, s},
+				plan.NewInnerJoin(
+					plan.NewFilter(sEquals, plan.NewResolvedTable(mytable, nil, nil)),
+					plan.NewSubqueryAlias("__in_subquery0", "", plan.NewDistinct(
+						plan.NewProject(
+							[]sql.Expression{expression.NewGetFieldWithTable(1, sql.Int64, "othertable", "i2", true)},
+							plan.NewResolvedTable(othertable, nil, nil),
+						),
+					)),
+					expression.NewEquals(i, expression.NewGetFieldWithTable(2, sql.Int64, "__in_subquery0", "i2", true)),
+				),
+			),
+		},
+		{
+			name: "correlated IN subquery",
+			node: plan.NewFilter(plan.NewInSubquery(i, correlated), plan.NewResolvedTable(mytable, nil, nil)),
+		},
+		{
+			name: "NOT IN subquery",
+			node: plan.NewFilter(
+				expression.NewNot(plan.NewInSubquery(i, subquery)),
+				plan.NewResolvedTable(mytable, nil, nil),
+			),
+		},
+		{
+			name: "IN subquery in a disjunction",
+			node: plan.NewFilter(
+				expression.NewOr(plan.NewInSubquery(i, subquery), sEquals),
+				plan.NewResolvedTable(mytable, nil, nil),
+			),
+		},
+		{
+			name: "IN subquery of a different type",
+			node: plan.NewFilter(plan.NewInSubquery(i, otherType), plan.NewResolvedTable(mytable, nil, nil)),
+		},
+		{
+			name:  "IN subquery inside a subquery",
+			node:  plan.NewFilter(plan.NewInSubquery(i, subquery), plan.NewResolvedTable(mytable, nil, nil)),
+			scope: newScope(plan.NewFilter(expression.NewLiteral(true, sql.Boolean), plan.NewResolvedTable(othertable, nil, nil))),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, a, getRule("in_subquery_joins"))
+}
@@ -188,33 +188,37 @@ func (r *indexAnalyzer) MatchingIndexes(ctx *sql.Context, db string, table strin
 func (r *indexAnalyzer) ExpressionsWithIndexes(db string, exprs ...sql.Expression) [][]sql.Expression {
 	var results [][]sql.Expression
 
-	// First find matches in the native indexes
+	// First find matches in the native indexes. An index matches if the expressions cover a prefix of at least two of
+	// its columns, since the index can then be used to look up the leading columns and scan the rest.
 	for _, idxes := range r.indexesByTable {
-	Indexes:
 		for _, idx := range idxes {
-			if ln := len(idx.Expressions()); ln <= len(exprs) && ln > 1 {
-				var used = make(map[int]bool)
-				var matched []sql.Expression
-				for _, ie := range idx.Expressions() {
-					var found bool
-					for i, e := range exprs {
-						if used[i] {
-							continue
-						}
-
-						if ie == e.String() {
-							used[i] = true
-							found = true
-							matched = append(matched, e)
-							break
-						}
+			if len(idx.Expressions()) < 2 {
+				continue
+			}
+
+			var used = make(map[int]bool)
+			var matched []sql.Expression
+			for _, ie := range idx.Expressions() {
+				var found bool
+				for i, e := range exprs {
+					if used[i] {
+						continue
 					}
 
-					if !found {
-						continue Indexes
+					if ie == e.String() {
+						used[i] = true
+						found = true
+						matched = append(matched, e)
+						break
 					}
 				}
 
+				if !found {
+					break
+				}
+			}
+
+			if len(matched) > 1 {
 				results = append(results, matched)
 			}
 		}
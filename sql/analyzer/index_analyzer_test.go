@@ -75,6 +75,35 @@ func TestMatchingIndexes(t *testing.T) {
 	require.Equal(t, dummy4, ia.MatchingIndex(ctx, testDb, testTable, v2, v3))
 }
 
+func TestExpressionsWithIndexes(t *testing.T) {
+	const testDb = "mydb"
+	const testTable = "test"
+
+	v1 := expression.NewLiteral(1, sql.Int64)
+	v2 := expression.NewLiteral(2, sql.Int64)
+	v3 := expression.NewLiteral(3, sql.Int64)
+	v4 := expression.NewLiteral(4, sql.Int64)
+
+	ia := &indexAnalyzer{
+		indexesByTable: map[string][]sql.Index{
+			"test": {
+				&dummyIdx{id: "single", expr: []sql.Expression{v1}, database: testDb, table: testTable},
+				&dummyIdx{id: "composite", expr: []sql.Expression{v1, v2, v3}, database: testDb, table: testTable},
+			},
+		},
+	}
+
+	// Full matches and prefixes of at least two columns are matched, in index order
+	require.Equal(t, [][]sql.Expression{{v1, v2, v3}}, ia.ExpressionsWithIndexes(testDb, v3, v2, v1))
+	require.Equal(t, [][]sql.Expression{{v1, v2}}, ia.ExpressionsWithIndexes(testDb, v2, v1))
+	require.Equal(t, [][]sql.Expression{{v1, v2}}, ia.ExpressionsWithIndexes(testDb, v4, v2, v1))
+
+	// Single column prefixes and expressions that skip a column of the index are not
+	require.Empty(t, ia.ExpressionsWithIndexes(testDb, v1))
+	require.Empty(t, ia.ExpressionsWithIndexes(testDb, v1, v3))
+	require.Empty(t, ia.ExpressionsWithIndexes(testDb, v2, v3))
+}
+
 type dummyIdx struct {
 	id       string
 	expr     []sql.Expression
@@ -30,6 +30,9 @@ type indexLookup struct {
 	exprs   []sql.Expression
 	lookup  sql.IndexLookup
 	indexes []sql.Index
+	// merged is true when the lookup combines lookups on different indexes, in which case it has no ranges of its own
+	// and can only be combined with other lookups through sql.MergeableIndexLookup.
+	merged bool
 }
 
 type indexLookupsByTable map[string]*indexLookup
@@ -127,7 +130,7 @@ func getIndexes(
 		for table, leftIdx := range leftIndexes {
 			foundRightIdx := false
 			if rightIdx, ok := rightIndexes[table]; ok {
-				if canMergeIndexes(leftIdx.lookup, rightIdx.lookup) {
+				if canMergeRanges(leftIdx, rightIdx) {
 					var allRanges []sql.Range
 					allRanges = append([]sql.Range{}, leftIdx.lookup.Ranges()...)
 					allRanges = append(allRanges, rightIdx.lookup.Ranges()...)
@@ -144,6 +147,15 @@ func getIndexes(
 					result[table] = leftIdx
 					foundRightIdx = true
 					delete(rightIndexes, table)
+				} else if canMergeIndexLookup(leftIdx, rightIdx) {
+					// Lookups on different indexes can be answered with the union of both lookups
+					newLookup, err := leftIdx.lookup.(sql.MergeableIndexLookup).Union(rightIdx.lookup)
+					if err != nil {
+						return nil, err
+					}
+					result[table] = mergedIndexLookup(newLookup, leftIdx, rightIdx)
+					foundRightIdx = true
+					delete(rightIndexes, table)
 				} else {
 					// Since we can return one index per table, if we can't merge the right-hand index from this table with the
 					// left-hand index, return no indexes. Returning a single one will lead to incorrect results from e.g.
@@ -166,6 +178,9 @@ func getIndexes(
 		}
 	case *expression.InTuple, *expression.HashInTuple:
 		cmp := e.(expression.Comparer)
+		if left, ok := cmp.Left().(expression.Tuple); ok && len(left) > 1 {
+			return getTupleInIndexLookups(ctx, ia, e, left, cmp.Right(), tableAliases)
+		}
 		if !isEvaluable(cmp.Left()) && isEvaluable(cmp.Right()) {
 			gf := expression.ExtractGetField(cmp.Left())
 			if gf == nil {
@@ -589,7 +604,8 @@ func indexesIntersection(ctx *sql.Context, left, right indexLookupsByTable) (ind
 	var result = make(indexLookupsByTable)
 
 	for table, idx := range left {
-		if idx2, ok := right[table]; ok && canMergeIndexes(idx.lookup, idx2.lookup) {
+		idx2, ok := right[table]
+		if ok && canMergeRanges(idx, idx2) {
 			newRangeCollections, err := idx.lookup.Ranges().Intersect(idx2.lookup.Ranges())
 			if err != nil || newRangeCollections == nil {
 				continue
@@ -599,6 +615,13 @@ func indexesIntersection(ctx *sql.Context, left, right indexLookupsByTable) (ind
 				return nil, err
 			}
 			idx.indexes = append(idx.indexes, idx2.indexes...)
+		} else if ok && canMergeIndexLookup(idx, idx2) {
+			// Lookups on different indexes can be answered with the intersection of both lookups
+			newLookup, err := idx.lookup.(sql.MergeableIndexLookup).Intersection(idx2.lookup)
+			if err != nil {
+				return nil, err
+			}
+			idx = mergedIndexLookup(newLookup, idx, idx2)
 		}
 
 		result[table] = idx
@@ -615,6 +638,92 @@ func indexesIntersection(ctx *sql.Context, left, right indexLookupsByTable) (ind
 	return result, nil
 }
 
+// getTupleInIndexLookups returns the index lookup for an expression of the form `(a, b) IN ((1, 2), (3, 4))`, where
+// all the columns on the left belong to the same table and are covered by a single index. Each tuple on the right is
+// turned into a point lookup over the columns of the index, and the lookup returned is the union of all of them.
+func getTupleInIndexLookups(
+	ctx *sql.Context,
+	ia *indexAnalyzer,
+	e sql.Expression,
+	left expression.Tuple,
+	right sql.Expression,
+	tableAliases TableAliases,
+) (indexLookupsByTable, error) {
+	rightTuple, ok := right.(expression.Tuple)
+	if !ok || !isEvaluable(rightTuple) {
+		return nil, nil
+	}
+
+	var table string
+	for _, col := range left {
+		gf, ok := col.(*expression.GetField)
+		if !ok || (table != "" && gf.Table() != table) {
+			return nil, nil
+		}
+		table = gf.Table()
+	}
+
+	colExprs := normalizeExpressions(ctx, tableAliases, left...)
+	idx := ia.MatchingIndex(ctx, ctx.GetCurrentDatabase(), table, colExprs...)
+	if idx == nil {
+		return nil, nil
+	}
+
+	var toUnion []sql.Range
+	for _, el := range rightTuple {
+		elTuple, ok := el.(expression.Tuple)
+		if !ok || len(elTuple) != len(left) {
+			return nil, nil
+		}
+
+		builder := sql.NewIndexBuilder(ctx, idx)
+		matchesNull := false
+		for i, valExpr := range elTuple {
+			val, err := valExpr.Eval(sql.NewEmptyContext(), nil)
+			if err != nil {
+				return nil, err
+			}
+			// A tuple with a NULL element never compares equal to any row, so it adds nothing to the lookup.
+			if val == nil {
+				matchesNull = true
+				break
+			}
+			builder = builder.Equals(ctx, colExprs[i].String(), val)
+		}
+		if matchesNull {
+			continue
+		}
+
+		ranges := builder.Range()
+		if ranges == nil {
+			return nil, nil
+		}
+		toUnion = append(toUnion, ranges)
+	}
+
+	if len(toUnion) == 0 {
+		return nil, nil
+	}
+
+	allRanges, err := sql.SimplifyRanges(toUnion...)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup, err := idx.NewLookup(ctx, allRanges...)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexLookupsByTable{
+		table: &indexLookup{
+			exprs:   []sql.Expression{e},
+			indexes: []sql.Index{idx},
+			lookup:  lookup,
+		},
+	}, nil
+}
+
 func getMultiColumnIndexes(
 	ctx *sql.Context,
 	exprs []sql.Expression,
@@ -977,7 +1086,7 @@ func containsBindvars(e sql.Expression) bool {
 func canMergeIndexLookups(leftIndexes, rightIndexes indexLookupsByTable) bool {
 	for table, leftIdx := range leftIndexes {
 		if rightIdx, ok := rightIndexes[table]; ok {
-			if !canMergeIndexes(leftIdx.lookup, rightIdx.lookup) {
+			if !canMergeIndexLookup(leftIdx, rightIdx) {
 				return false
 			}
 		}
@@ -985,6 +1094,32 @@ func canMergeIndexLookups(leftIndexes, rightIndexes indexLookupsByTable) bool {
 	return true
 }
 
+// canMergeIndexLookup returns whether the two lookups given can be combined, either through their ranges or as lookups
+// on different indexes.
+func canMergeIndexLookup(a, b *indexLookup) bool {
+	if canMergeRanges(a, b) {
+		return true
+	}
+	mergeable, ok := a.lookup.(sql.MergeableIndexLookup)
+	return ok && b.lookup != nil && mergeable.IsMergeable(b.lookup)
+}
+
+// canMergeRanges returns whether the two lookups given are on the same index, so that they can be combined through
+// their ranges.
+func canMergeRanges(a, b *indexLookup) bool {
+	return !a.merged && !b.merged && canMergeIndexes(a.lookup, b.lookup)
+}
+
+// mergedIndexLookup returns the indexLookup for a lookup resulting from merging the two lookups given.
+func mergedIndexLookup(lookup sql.IndexLookup, a, b *indexLookup) *indexLookup {
+	return &indexLookup{
+		exprs:   append(append([]sql.Expression{}, a.exprs...), b.exprs...),
+		lookup:  lookup,
+		indexes: append(append([]sql.Index{}, a.indexes...), b.indexes...),
+		merged:  true,
+	}
+}
+
 func canMergeIndexes(a, b sql.IndexLookup) bool {
 	if a == nil || b == nil {
 		return false
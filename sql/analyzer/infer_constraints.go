@@ -0,0 +1,340 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// InferConstraintsSessionVar is the session variable that enables the infer_constraints rule.
+const InferConstraintsSessionVar = "optimizer_infer_constraints"
+
+// inferConstraints infers which columns can't be NULL and which nodes return unique rows from the schemas of tables,
+// the filters above them and the joins between them, and uses those facts to simplify the plan. LEFT and RIGHT joins
+// are turned into inner joins when a filter above them rejects the rows where the columns of their outer side are NULL,
+// which are the only rows the outer join adds. IS NULL checks of columns that can't be NULL are replaced by their
+// result, dropping the filters that are always true. DISTINCT is removed over rows that are already unique, such as
+// rows holding the primary key of a table. The rule is only applied when the optimizer_infer_constraints session
+// variable is enabled.
+func inferConstraints(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (sql.Node, error) {
+	if !node.Resolved() || !inferConstraintsEnabled(ctx) {
+		return node, nil
+	}
+
+	span, _ := ctx.Span("infer_constraints")
+	defer span.Finish()
+
+	return plan.TransformUp(node, func(node sql.Node) (sql.Node, error) {
+		switch node := node.(type) {
+		case *plan.Filter:
+			child, err := rejectOuterJoins(node.Child, nullRejectedColumns(node.Expression))
+			if err != nil {
+				return nil, err
+			}
+			if child != node.Child {
+				a.Log("outer joins below filter %s turned into inner joins", node.Expression)
+			}
+
+			e, err := simplifyNullChecks(node.Expression, notNullColumns(child))
+			if err != nil {
+				return nil, err
+			}
+			if isTrue(e) {
+				return child, nil
+			}
+			return plan.NewFilter(e, child), nil
+		case *plan.Distinct:
+			if hasUniqueRows(node.Child) {
+				a.Log("distinct removed over unique rows")
+				return node.Child, nil
+			}
+			return node, nil
+		case *plan.OrderedDistinct:
+			if hasUniqueRows(node.Child) {
+				a.Log("distinct removed over unique rows")
+				return node.Child, nil
+			}
+			return node, nil
+		default:
+			return node, nil
+		}
+	})
+}
+
+// inferConstraintsEnabled returns whether the infer_constraints rule is enabled for the session of the context given.
+func inferConstraintsEnabled(ctx *sql.Context) bool {
+	val, err := ctx.GetSessionVariable(ctx, InferConstraintsSessionVar)
+	if err != nil {
+		return false
+	}
+
+	enabled, ok := val.(int8)
+	return ok && enabled == 1
+}
+
+// columnKey identifies a column by the lowercase name of its table and its own lowercase name.
+type columnKey struct {
+	table, name string
+}
+
+type columnSet map[columnKey]struct{}
+
+func newColumnKey(table, name string) columnKey {
+	return columnKey{table: strings.ToLower(table), name: strings.ToLower(name)}
+}
+
+func (s columnSet) add(key columnKey) {
+	s[key] = struct{}{}
+}
+
+func (s columnSet) has(key columnKey) bool {
+	_, ok := s[key]
+	return ok
+}
+
+// hasAnyOf returns whether any of the columns of the schema given is in the set.
+func (s columnSet) hasAnyOf(schema sql.Schema) bool {
+	for _, col := range schema {
+		if s.has(newColumnKey(col.Source, col.Name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// notNullColumns returns the columns of the rows of the node given that can't be NULL.
+func notNullColumns(node sql.Node) columnSet {
+	switch node := node.(type) {
+	case *plan.ResolvedTable, *plan.TableAlias, *plan.IndexedTableAccess:
+		cols := make(columnSet)
+		for _, col := range node.Schema() {
+			if !col.Nullable {
+				cols.add(newColumnKey(col.Source, col.Name))
+			}
+		}
+		return cols
+	case *plan.Filter:
+		cols := notNullColumns(node.Child)
+		for key := range nullRejectedColumns(node.Expression) {
+			cols.add(key)
+		}
+		return cols
+	case *plan.InnerJoin:
+		cols := notNullColumns(node.Left())
+		for key := range notNullColumns(node.Right()) {
+			cols.add(key)
+		}
+		for key := range nullRejectedColumns(node.Cond) {
+			cols.add(key)
+		}
+		return cols
+	case *plan.CrossJoin:
+		cols := notNullColumns(node.Left())
+		for key := range notNullColumns(node.Right()) {
+			cols.add(key)
+		}
+		return cols
+	case *plan.LeftJoin:
+		return notNullColumns(node.Left())
+	case *plan.RightJoin:
+		return notNullColumns(node.Right())
+	case *plan.Project, *plan.Sort, *plan.Limit, *plan.Offset, *plan.Distinct, *plan.OrderedDistinct:
+		// Projections only keep the columns they project, and the facts of the columns they drop aren't used above
+		return notNullColumns(node.Children()[0])
+	default:
+		return make(columnSet)
+	}
+}
+
+// nullRejectedColumns returns the columns that can't be NULL in the rows for which the expression given is true.
+func nullRejectedColumns(e sql.Expression) columnSet {
+	cols := make(columnSet)
+	switch e := e.(type) {
+	case *expression.And:
+		for key := range nullRejectedColumns(e.Left) {
+			cols.add(key)
+		}
+		for key := range nullRejectedColumns(e.Right) {
+			cols.add(key)
+		}
+	case *expression.Or:
+		right := nullRejectedColumns(e.Right)
+		for key := range nullRejectedColumns(e.Left) {
+			if right.has(key) {
+				cols.add(key)
+			}
+		}
+	case *expression.Not:
+		if isNull, ok := e.Child.(*expression.IsNull); ok {
+			if gf, ok := isNull.Child.(*expression.GetField); ok {
+				cols.add(newColumnKey(gf.Table(), gf.Name()))
+			}
+		}
+	case *expression.Equals, *expression.GreaterThan, *expression.GreaterThanOrEqual, *expression.LessThan,
+		*expression.LessThanOrEqual, *expression.InTuple, *expression.Like, *expression.Regexp, *expression.Between:
+		// These are NULL when any of their operands is NULL
+		for _, child := range e.Children() {
+			if gf, ok := child.(*expression.GetField); ok {
+				cols.add(newColumnKey(gf.Table(), gf.Name()))
+			}
+		}
+	}
+	return cols
+}
+
+// rejectOuterJoins turns the outer joins of the node given into inner joins when some of the columns of their outer
+// side are rejected when NULL by a filter above them. It returns the node given if there are none.
+func rejectOuterJoins(node sql.Node, rejected columnSet) (sql.Node, error) {
+	if len(rejected) == 0 {
+		return node, nil
+	}
+
+	switch n := node.(type) {
+	case *plan.LeftJoin:
+		if !rejected.hasAnyOf(n.Right().Schema()) {
+			return rejectOuterJoinChildren(n, rejected, true, false)
+		}
+		return rejectOuterJoinChildren(withComment(plan.NewInnerJoin(n.Left(), n.Right(), n.Cond), n.Comment()), rejected, true, true)
+	case *plan.RightJoin:
+		if !rejected.hasAnyOf(n.Left().Schema()) {
+			return rejectOuterJoinChildren(n, rejected, false, true)
+		}
+		return rejectOuterJoinChildren(withComment(plan.NewInnerJoin(n.Left(), n.Right(), n.Cond), n.Comment()), rejected, true, true)
+	case *plan.InnerJoin, *plan.CrossJoin:
+		return rejectOuterJoinChildren(n, rejected, true, true)
+	default:
+		return node, nil
+	}
+}
+
+// rejectOuterJoinChildren applies rejectOuterJoins to the left and right children of the join given, as requested.
+func rejectOuterJoinChildren(join sql.Node, rejected columnSet, left, right bool) (sql.Node, error) {
+	children := join.Children()
+	newChildren := make([]sql.Node, len(children))
+	copy(newChildren, children)
+
+	var err error
+	if left {
+		if newChildren[0], err = rejectOuterJoins(children[0], rejected); err != nil {
+			return nil, err
+		}
+	}
+	if right {
+		if newChildren[1], err = rejectOuterJoins(children[1], rejected); err != nil {
+			return nil, err
+		}
+	}
+
+	if newChildren[0] == children[0] && newChildren[1] == children[1] {
+		return join, nil
+	}
+	return join.WithChildren(newChildren...)
+}
+
+func withComment(join *plan.InnerJoin, comment string) sql.Node {
+	if comment == "" {
+		return join
+	}
+	return join.WithComment(comment)
+}
+
+// simplifyNullChecks replaces the IS NULL checks of the columns given, which can't be NULL, by false, and folds the
+// expressions using them.
+func simplifyNullChecks(e sql.Expression, notNull columnSet) (sql.Expression, error) {
+	if len(notNull) == 0 {
+		return e, nil
+	}
+
+	return expression.TransformUp(e, func(e sql.Expression) (sql.Expression, error) {
+		switch e := e.(type) {
+		case *expression.IsNull:
+			if gf, ok := e.Child.(*expression.GetField); ok && notNull.has(newColumnKey(gf.Table(), gf.Name())) {
+				return expression.NewLiteral(false, sql.Boolean), nil
+			}
+		case *expression.Not:
+			if isTrue(e.Child) {
+				return expression.NewLiteral(false, sql.Boolean), nil
+			}
+			if isFalse(e.Child) {
+				return expression.NewLiteral(true, sql.Boolean), nil
+			}
+		case *expression.And:
+			switch {
+			case isFalse(e.Left) || isTrue(e.Right):
+				return e.Left, nil
+			case isFalse(e.Right) || isTrue(e.Left):
+				return e.Right, nil
+			}
+		case *expression.Or:
+			switch {
+			case isTrue(e.Left) || isFalse(e.Right):
+				return e.Left, nil
+			case isTrue(e.Right) || isFalse(e.Left):
+				return e.Right, nil
+			}
+		}
+		return e, nil
+	})
+}
+
+// hasUniqueRows returns whether the rows of the node given are known to be distinct, because they hold the primary
+// key of a table.
+func hasUniqueRows(node sql.Node) bool {
+	key := uniqueKey(node)
+	return len(key) > 0
+}
+
+// uniqueKey returns the columns whose values are unique in the rows of the node given, or nil if there are none known.
+func uniqueKey(node sql.Node) columnSet {
+	switch node := node.(type) {
+	case *plan.ResolvedTable, *plan.TableAlias, *plan.IndexedTableAccess:
+		var key columnSet
+		for _, col := range node.Schema() {
+			if col.PrimaryKey {
+				if key == nil {
+					key = make(columnSet)
+				}
+				key.add(newColumnKey(col.Source, col.Name))
+			}
+		}
+		return key
+	case *plan.Filter, *plan.Sort, *plan.Limit, *plan.Offset:
+		return uniqueKey(node.Children()[0])
+	case *plan.Project:
+		key := uniqueKey(node.Child)
+		if key == nil {
+			return nil
+		}
+
+		projected := make(columnSet)
+		for _, e := range node.Projections {
+			if gf, ok := e.(*expression.GetField); ok {
+				projected.add(newColumnKey(gf.Table(), gf.Name()))
+			}
+		}
+		for col := range key {
+			if !projected.has(col) {
+				return nil
+			}
+		}
+		return key
+	default:
+		return nil
+	}
+}
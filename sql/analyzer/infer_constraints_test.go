@@ -0,0 +1,113 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestInferConstraints(t *testing.T) {
+	t1 := plan.NewResolvedTable(memory.NewTable("t1", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t1", PrimaryKey: true},
+		{Name: "b", Type: sql.Int64, Source: "t1", Nullable: true},
+	}), nil, nil)
+	t2 := plan.NewResolvedTable(memory.NewTable("t2", sql.Schema{
+		{Name: "c", Type: sql.Int64, Source: "t2", PrimaryKey: true},
+		{Name: "d", Type: sql.Int64, Source: "t2", Nullable: true},
+	}), nil, nil)
+
+	a := expression.NewGetFieldWithTable(0, sql.Int64, "t1", "a", false)
+	b := expression.NewGetFieldWithTable(1, sql.Int64, "t1", "b", true)
+	c := expression.NewGetFieldWithTable(2, sql.Int64, "t2", "c", false)
+	d := expression.NewGetFieldWithTable(3, sql.Int64, "t2", "d", true)
+	one := expression.NewLiteral(int64(1), sql.Int64)
+	cond := expression.NewEquals(a, c)
+
+	tests := []analyzerFnTestCase{
+		{
+			name:     "left join rejected by filter on its right side",
+			node:     plan.NewFilter(expression.NewGreaterThan(d, one), plan.NewLeftJoin(t1, t2, cond)),
+			expected: plan.NewFilter(expression.NewGreaterThan(d, one), plan.NewInnerJoin(t1, t2, cond)),
+		},
+		{
+			name: "left join with filter on its left side",
+			node: plan.NewFilter(expression.NewGreaterThan(b, one), plan.NewLeftJoin(t1, t2, cond)),
+		},
+		{
+			name: "left join with null-safe filter on its right side",
+			node: plan.NewFilter(expression.NewNullSafeEquals(d, one), plan.NewLeftJoin(t1, t2, cond)),
+		},
+		{
+			name:     "right join rejected by filter on its left side",
+			node:     plan.NewFilter(expression.NewEquals(b, one), plan.NewRightJoin(t1, t2, cond)),
+			expected: plan.NewFilter(expression.NewEquals(b, one), plan.NewInnerJoin(t1, t2, cond)),
+		},
+		{
+			name: "right join with filter on its right side",
+			node: plan.NewFilter(expression.NewNot(expression.NewIsNull(d)), plan.NewRightJoin(t1, t2, cond)),
+		},
+		{
+			name: "left join below inner join",
+			node: plan.NewFilter(
+				expression.NewEquals(d, one),
+				plan.NewCrossJoin(plan.NewLeftJoin(t1, t2, cond), plan.NewTableAlias("t3", t1)),
+			),
+			expected: plan.NewFilter(
+				expression.NewEquals(d, one),
+				plan.NewCrossJoin(plan.NewInnerJoin(t1, t2, cond), plan.NewTableAlias("t3", t1)),
+			),
+		},
+		{
+			name:     "check of a column of the outer side that can't be null",
+			node:     plan.NewFilter(expression.NewNot(expression.NewIsNull(c)), plan.NewLeftJoin(t1, t2, cond)),
+			expected: plan.NewInnerJoin(t1, t2, cond),
+		},
+		{
+			name: "check of a nullable column",
+			node: plan.NewFilter(expression.NewIsNull(b), t1),
+		},
+		{
+			name:     "check of a primary key",
+			node:     plan.NewFilter(expression.NewOr(expression.NewIsNull(a), expression.NewEquals(b, one)), t1),
+			expected: plan.NewFilter(expression.NewEquals(b, one), t1),
+		},
+		{
+			name:     "distinct primary keys",
+			node:     plan.NewDistinct(plan.NewProject([]sql.Expression{b, a}, plan.NewFilter(expression.NewEquals(b, one), t1))),
+			expected: plan.NewProject([]sql.Expression{b, a}, plan.NewFilter(expression.NewEquals(b, one), t1)),
+		},
+		{
+			name: "distinct without primary keys",
+			node: plan.NewDistinct(plan.NewProject([]sql.Expression{b}, t1)),
+		},
+	}
+
+	ctx := sql.NewEmptyContext()
+	require.NoError(t, ctx.SetSessionVariable(ctx, InferConstraintsSessionVar, int8(1)))
+	runTestCases(t, ctx, tests, NewDefault(sql.NewDatabaseProvider()), getRule("infer_constraints"))
+
+	// The rule isn't applied unless enabled
+	runTestCases(t, sql.NewEmptyContext(), []analyzerFnTestCase{{
+		name: "disabled",
+		node: plan.NewFilter(expression.NewGreaterThan(d, one), plan.NewLeftJoin(t1, t2, cond)),
+	}}, NewDefault(sql.NewDatabaseProvider()), getRule("infer_constraints"))
+}
@@ -180,6 +180,9 @@ func validateValueCount(columnNames []string, values sql.Node) error {
 	return nil
 }
 
+// assertCompatibleSchemas checks that the expressions given, which map the rows of the insert source with the schema
+// given to the destination table, can be inserted. Values of the source are converted to the types of the destination
+// columns when inserted, like MySQL does, so a value that can't be converted is only an error when it's inserted.
 func assertCompatibleSchemas(projExprs []sql.Expression, schema sql.Schema) error {
 	for _, expr := range projExprs {
 		switch e := expr.(type) {
@@ -188,14 +191,8 @@ func assertCompatibleSchemas(projExprs []sql.Expression, schema sql.Schema) erro
 			*sql.ColumnDefaultValue:
 			continue
 		case *expression.GetField:
-			otherCol := schema[e.Index()]
-			// special case: null field type, will get checked at execution time
-			if otherCol.Type == sql.Null {
-				continue
-			}
-			_, err := expr.Type().Convert(otherCol.Type.Zero())
-			if err != nil {
-				return plan.ErrInsertIntoIncompatibleTypes.New(otherCol.Type.String(), expr.Type().String())
+			if e.Index() >= len(schema) {
+				return plan.ErrInsertIntoMismatchValueCount.New()
 			}
 		default:
 			return plan.ErrInsertIntoUnsupportedValues.New(expr)
@@ -0,0 +1,35 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// resolveIntoOutfile resolves IntoOutfile nodes by analyzing their query and assigning it back.
+func resolveIntoOutfile(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	i, ok := n.(*plan.IntoOutfile)
+	if !ok {
+		return n, nil
+	}
+
+	q, err := a.Analyze(ctx, i.Query(), scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.WithQuery(stripQueryProcess(q)), nil
+}
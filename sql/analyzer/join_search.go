@@ -17,6 +17,7 @@ package analyzer
 import (
 	"fmt"
 	"math"
+	"math/bits"
 	"strconv"
 	"strings"
 
@@ -310,52 +311,190 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 				return err
 			}
 		}
-		indexes := make([]int, len(jo.commutes))
-		for i := range jo.commutes {
-			indexes[i] = i
+
+		budget := joinSearchBudget(ctx)
+		n := len(jo.commutes)
+		switch {
+		case permutationCount(n, budget) <= budget:
+			jo.order, jo.cost = jo.exhaustiveAccessOrder(joinIndexes)
+		case n < 32 && n<<uint(n) <= budget:
+			jo.order, jo.cost = jo.subsetAccessOrder(joinIndexes)
+		default:
+			jo.order, jo.cost = jo.greedyAccessOrder(joinIndexes)
 		}
-		lowestCost := uint64(math.MaxUint64)
-		accessOrders := permutations(indexes)
-		lowestCostIdx := 0
-		for i, accessOrder := range accessOrders {
-			cost, err := jo.estimateAccessOrderCost(ctx, accessOrder, joinIndexes, lowestCost)
-			if err != nil {
-				return err
+	}
+
+	return nil
+}
+
+// JoinSearchBudgetSessionVar is the session variable bounding the work done to find the access order of a list of
+// commutable joins. When every order of the tables can be costed within the budget, all of them are. Otherwise, the
+// cheapest order is found by dynamic programming over the subsets of tables if that fits in the budget, or
+// approximated greedily if it doesn't.
+const JoinSearchBudgetSessionVar = "join_search_budget"
+
+// defaultJoinSearchBudget costs every order of up to 8 tables.
+const defaultJoinSearchBudget = 40320
+
+// joinSearchBudget returns the search budget for the join orders of the session.
+func joinSearchBudget(ctx *sql.Context) int {
+	val, err := ctx.GetSessionVariable(ctx, JoinSearchBudgetSessionVar)
+	if err != nil {
+		return defaultJoinSearchBudget
+	}
+
+	if budget, ok := val.(int64); ok && budget > 0 {
+		return int(budget)
+	}
+	return defaultJoinSearchBudget
+}
+
+// permutationCount returns the number of permutations of n elements, or a number larger than |limit| if there are
+// more than that.
+func permutationCount(n int, limit int) int {
+	count := 1
+	for i := 2; i <= n; i++ {
+		count *= i
+		if count > limit {
+			return limit + 1
+		}
+	}
+	return count
+}
+
+// exhaustiveAccessOrder costs every order of the commutable nodes and returns the cheapest one.
+func (jo *joinOrderNode) exhaustiveAccessOrder(joinIndexes joinIndexesByTable) ([]int, uint64) {
+	indexes := make([]int, len(jo.commutes))
+	for i := range jo.commutes {
+		indexes[i] = i
+	}
+	lowestCost := uint64(math.MaxUint64)
+	accessOrders := permutations(indexes)
+	lowestCostIdx := 0
+	for i, accessOrder := range accessOrders {
+		cost := jo.estimateAccessOrderCost(accessOrder, joinIndexes, lowestCost)
+		if cost < lowestCost {
+			lowestCost = cost
+			lowestCostIdx = i
+		}
+	}
+	return accessOrders[lowestCostIdx], lowestCost
+}
+
+// subsetAccessOrder returns the cheapest order of the commutable nodes, found by computing the cheapest order of
+// every subset of them from the cheapest orders of its subsets with one node less. This is exact, since the cost of
+// accessing a node only depends on the cost of the nodes before it and on which nodes those are.
+func (jo *joinOrderNode) subsetAccessOrder(joinIndexes joinIndexesByTable) ([]int, uint64) {
+	n := len(jo.commutes)
+	size := 1 << uint(n)
+	costs := make([]uint64, size)
+	last := make([]int, size)
+	schemas := make([]sql.Schema, size)
+
+	costs[0] = 1
+	for set := 1; set < size; set++ {
+		lowest := bits.TrailingZeros(uint(set))
+		schemas[set] = append(append(sql.Schema(nil), schemas[set&(set-1)]...), jo.commutes[lowest].schema()...)
+
+		found := false
+		for idx := 0; idx < n; idx++ {
+			if set&(1<<uint(idx)) == 0 {
+				continue
 			}
-			if cost < lowestCost {
-				lowestCost = cost
-				lowestCostIdx = i
+			prev := set &^ (1 << uint(idx))
+			cost := jo.accessCost(costs[prev], idx, prev == 0, schemas[set], joinIndexes)
+			if !found || cost < costs[set] {
+				costs[set] = cost
+				last[set] = idx
+				found = true
 			}
 		}
-		jo.order = accessOrders[lowestCostIdx]
-		jo.cost = lowestCost
 	}
 
-	return nil
+	order := make([]int, n)
+	set := size - 1
+	for i := n - 1; i >= 0; i-- {
+		order[i] = last[set]
+		set &^= 1 << uint(last[set])
+	}
+	return order, costs[size-1]
+}
+
+// greedyAccessOrder approximates the cheapest order of the commutable nodes. Starting from each of them, it builds an
+// order by repeatedly appending the node that is cheapest to access next, and returns the cheapest of those orders.
+func (jo *joinOrderNode) greedyAccessOrder(joinIndexes joinIndexesByTable) ([]int, uint64) {
+	n := len(jo.commutes)
+	var bestOrder []int
+	bestCost := uint64(math.MaxUint64)
+
+	for first := 0; first < n; first++ {
+		used := make([]bool, n)
+		used[first] = true
+		order := []int{first}
+		availableSchemaForKeys := append(sql.Schema(nil), jo.commutes[first].schema()...)
+		cost := jo.accessCost(1, first, true, availableSchemaForKeys, joinIndexes)
+
+		for len(order) < n {
+			next := -1
+			nextCost := uint64(math.MaxUint64)
+			for idx := 0; idx < n; idx++ {
+				if used[idx] {
+					continue
+				}
+				schema := append(availableSchemaForKeys[:len(availableSchemaForKeys):len(availableSchemaForKeys)], jo.commutes[idx].schema()...)
+				if c := jo.accessCost(cost, idx, false, schema, joinIndexes); next == -1 || c < nextCost {
+					next, nextCost = idx, c
+				}
+			}
+
+			used[next] = true
+			order = append(order, next)
+			availableSchemaForKeys = append(availableSchemaForKeys, jo.commutes[next].schema()...)
+			cost = nextCost
+		}
+
+		if bestOrder == nil || cost < bestCost {
+			bestOrder, bestCost = order, cost
+		}
+	}
+
+	return bestOrder, bestCost
 }
 
-func (jo *joinOrderNode) estimateAccessOrderCost(ctx *sql.Context, accessOrder []int, joinIndexes joinIndexesByTable, lowestCost uint64) (uint64, error) {
+func (jo *joinOrderNode) estimateAccessOrderCost(accessOrder []int, joinIndexes joinIndexesByTable, lowestCost uint64) uint64 {
 	cost := uint64(1)
 	var availableSchemaForKeys sql.Schema
 	for i, idx := range accessOrder {
 		if cost >= lowestCost {
-			return cost, nil
+			return cost
 		}
 		availableSchemaForKeys = append(availableSchemaForKeys, jo.commutes[idx].schema()...)
-		if jo.commutes[idx].node != nil {
-			indexes := joinIndexes[strings.ToLower(jo.commutes[idx].node.Name())]
-			_, isSubquery := jo.commutes[idx].node.(*plan.SubqueryAlias)
-			_, isValuesTable := jo.commutes[idx].node.(*plan.ValueDerivedTable)
-			if i == 0 || isSubquery || isValuesTable || indexes.getUsableIndex(availableSchemaForKeys) == nil {
-				cost *= jo.commutes[idx].cost
-			} else {
-				cost += 1
+		cost = jo.accessCost(cost, idx, i == 0, availableSchemaForKeys, joinIndexes)
+	}
+	return cost
+}
+
+// accessCost returns the cost of accessing the commutable node with the index given after nodes that cost |cost| to
+// access, given the schema available for index lookup keys. A node that isn't |first| and can be accessed with an
+// index lookup only adds to the cost, while any other node multiplies it. The cost saturates instead of overflowing.
+func (jo *joinOrderNode) accessCost(cost uint64, idx int, first bool, availableSchemaForKeys sql.Schema, joinIndexes joinIndexesByTable) uint64 {
+	node := jo.commutes[idx]
+	if node.node != nil && !first {
+		indexes := joinIndexes[strings.ToLower(node.node.Name())]
+		_, isSubquery := node.node.(*plan.SubqueryAlias)
+		_, isValuesTable := node.node.(*plan.ValueDerivedTable)
+		if !isSubquery && !isValuesTable && indexes.getUsableIndex(availableSchemaForKeys) != nil {
+			if cost == math.MaxUint64 {
+				return cost
 			}
-		} else {
-			cost *= jo.commutes[idx].cost
+			return cost + 1
 		}
 	}
-	return cost, nil
+
+	if node.cost != 0 && cost > math.MaxUint64/node.cost {
+		return math.MaxUint64
+	}
+	return cost * node.cost
 }
 
 func (jo *joinOrderNode) schema() sql.Schema {
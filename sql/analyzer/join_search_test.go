@@ -19,8 +19,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -603,6 +606,88 @@ func TestBuildJoinTree(t *testing.T) {
 	}
 }
 
+func TestJoinAccessOrderSearch(t *testing.T) {
+	// chain returns the commutable nodes of a chain of n tables where each table can be looked up by index from its
+	// neighbors, and all tables but the one at |smallest| have the same cost.
+	chain := func(n, smallest int) (*joinOrderNode, joinIndexesByTable) {
+		jo := &joinOrderNode{}
+		joinIndexes := make(joinIndexesByTable)
+		for i := 0; i < n; i++ {
+			name := fmt.Sprintf("t%d", i)
+			table := memory.NewTable(name, sql.Schema{{Name: "col", Source: name, Type: sql.Int64}})
+
+			cost := uint64(1000)
+			if i == smallest {
+				cost = 5
+			}
+			jo.commutes = append(jo.commutes, joinOrderNode{node: plan.NewResolvedTable(table, nil, nil), cost: cost})
+
+			for _, neighbor := range []int{i - 1, i + 1} {
+				if neighbor < 0 || neighbor >= n {
+					continue
+				}
+				joinIndexes[name] = append(joinIndexes[name], &joinIndex{
+					table:         name,
+					index:         &memory.Index{Name: name + "_col"},
+					comparandCols: []*expression.GetField{gf(0, fmt.Sprintf("t%d", neighbor), "col")},
+				})
+			}
+		}
+		return jo, joinIndexes
+	}
+
+	// connected checks that every table after the first one is a neighbor of a table before it
+	connected := func(t *testing.T, order []int) {
+		seen := map[int]bool{order[0]: true}
+		for _, idx := range order[1:] {
+			require.True(t, seen[idx-1] || seen[idx+1], "order %v", order)
+			seen[idx] = true
+		}
+	}
+
+	t.Run("small join", func(t *testing.T) {
+		jo, joinIndexes := chain(6, 2)
+
+		order, cost := jo.exhaustiveAccessOrder(joinIndexes)
+		require.Equal(t, uint64(10), cost)
+		require.Equal(t, 2, order[0])
+
+		order, cost = jo.subsetAccessOrder(joinIndexes)
+		require.Equal(t, uint64(10), cost)
+		require.Equal(t, 2, order[0])
+		connected(t, order)
+
+		order, cost = jo.greedyAccessOrder(joinIndexes)
+		require.Equal(t, uint64(10), cost)
+		require.Equal(t, 2, order[0])
+		connected(t, order)
+	})
+
+	t.Run("large join", func(t *testing.T) {
+		jo, joinIndexes := chain(14, 9)
+
+		order, cost := jo.subsetAccessOrder(joinIndexes)
+		require.Equal(t, uint64(18), cost)
+		require.Equal(t, 9, order[0])
+		connected(t, order)
+
+		order, cost = jo.greedyAccessOrder(joinIndexes)
+		require.Equal(t, uint64(18), cost)
+		require.Equal(t, 9, order[0])
+		connected(t, order)
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		require.Equal(t, 720, permutationCount(6, 40320))
+		require.Equal(t, 41, permutationCount(20, 40))
+
+		ctx := sql.NewEmptyContext()
+		require.Equal(t, defaultJoinSearchBudget, joinSearchBudget(ctx))
+		require.NoError(t, ctx.SetSessionVariable(ctx, JoinSearchBudgetSessionVar, int64(100)))
+		require.Equal(t, 100, joinSearchBudget(ctx))
+	})
+}
+
 // jc == join cond
 func jc(leftTable, rightTable string) *joinCond {
 	return &joinCond{
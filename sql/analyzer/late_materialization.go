@@ -0,0 +1,144 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyLateMaterialization rewrites filters over tables that can fetch their rows by primary key, when the query uses
+// wide columns of the table that the filter doesn't need. The table is then read projected on only the columns of the
+// filter and its primary key, and the full rows are fetched for the rows that pass the filter.
+func applyLateMaterialization(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, ctx := ctx.Span("apply_late_materialization")
+	defer span.Finish()
+
+	if !n.Resolved() || len(scope.Schema()) > 0 || !canDoPushdown(n) {
+		return n, nil
+	}
+
+	// Updates and deletes match the rows they modify against the rows of their tables
+	isDML := false
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n.(type) {
+		case *plan.Update, *plan.DeleteFrom, *plan.RowUpdateAccumulator:
+			isDML = true
+		}
+		return !isDML
+	})
+	if isDML {
+		return n, nil
+	}
+
+	usedFields := getFieldsByTable(ctx, n)
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, nil
+		}
+		return lateMaterializedFilter(a, filter, usedFields)
+	})
+}
+
+// lateMaterializedFilter returns the filter given wrapped in a LateMaterialization node if it's worth it, or the
+// filter unchanged otherwise.
+func lateMaterializedFilter(a *Analyzer, filter *plan.Filter, usedFields fieldsByTable) (sql.Node, error) {
+	tableNode, ok := singleTableNode(filter.Child)
+	if !ok {
+		return filter, nil
+	}
+
+	rt := getResolvedTable(tableNode)
+	table, ok := rt.Table.(sql.RowFetchTable)
+	if !ok {
+		return filter, nil
+	}
+
+	var filterFields []string
+	hasSubquery := false
+	sql.Inspect(filter.Expression, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			filterFields = append(filterFields, strings.ToLower(e.Name()))
+		case *plan.Subquery:
+			hasSubquery = true
+		}
+		return !hasSubquery
+	})
+	if hasSubquery {
+		return filter, nil
+	}
+
+	var used []string
+	for _, field := range usedFields[strings.ToLower(tableNode.Name())] {
+		used = append(used, strings.ToLower(field))
+	}
+
+	var narrowColumns []string
+	hasPrimaryKey, hasWideColumns := false, false
+	for _, col := range table.Schema() {
+		name := strings.ToLower(col.Name)
+		switch {
+		case col.PrimaryKey:
+			hasPrimaryKey = true
+			narrowColumns = append(narrowColumns, col.Name)
+		case stringContains(filterFields, name):
+			narrowColumns = append(narrowColumns, col.Name)
+		case stringContains(used, name) && (sql.IsTextBlob(col.Type) || sql.IsJSON(col.Type)):
+			hasWideColumns = true
+		}
+	}
+
+	if !hasPrimaryKey || !hasWideColumns {
+		return filter, nil
+	}
+
+	narrowNode, err := withTable(filter.Child, table.WithProjection(narrowColumns))
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := filter.WithChildren(narrowNode)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Log("table %q read with late materialization", tableNode.Name())
+	return plan.NewLateMaterialization(table, child), nil
+}
+
+// singleTableNode returns the table node that the node given reads from, if it reads from a single table through
+// aliases and decorations only.
+func singleTableNode(n sql.Node) (NameableNode, bool) {
+	for {
+		switch node := n.(type) {
+		case *plan.ResolvedTable:
+			return node, true
+		case *plan.TableAlias:
+			if _, ok := singleTableNode(node.Child); ok {
+				return node, true
+			}
+			return nil, false
+		case *plan.DecoratedNode:
+			n = node.Child
+		default:
+			return nil, false
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestApplyLateMaterialization(t *testing.T) {
+	schema := sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "mytable", PrimaryKey: true},
+		{Name: "i", Type: sql.Int32, Source: "mytable"},
+		{Name: "body", Type: sql.LongText, Source: "mytable"},
+	}
+	table := memory.NewRowFetchTable("mytable", schema)
+	plainTable := memory.NewTable("mytable", schema)
+
+	filterExpr := expression.NewEquals(
+		expression.NewGetFieldWithTable(1, sql.Int32, "mytable", "i", false),
+		expression.NewLiteral(int32(1), sql.Int32),
+	)
+	bodyField := expression.NewGetFieldWithTable(2, sql.LongText, "mytable", "body", false)
+	idField := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "id", false)
+
+	a := NewDefault(sql.NewDatabaseProvider())
+
+	tests := []analyzerFnTestCase{
+		{
+			name: "wide column fetched late",
+			node: plan.NewProject(
+				[]sql.Expression{bodyField},
+				plan.NewFilter(filterExpr, plan.NewResolvedTable(table, nil, nil)),
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{bodyField},
+				plan.NewLateMaterialization(
+					table,
+					plan.NewFilter(filterExpr, plan.NewResolvedTable(table.WithProjection([]string{"id", "i"}), nil, nil)),
+				),
+			),
+		},
+		{
+			name: "no wide column used",
+			node: plan.NewProject(
+				[]sql.Expression{idField},
+				plan.NewFilter(filterExpr, plan.NewResolvedTable(table, nil, nil)),
+			),
+		},
+		{
+			name: "table can't fetch rows",
+			node: plan.NewProject(
+				[]sql.Expression{bodyField},
+				plan.NewFilter(filterExpr, plan.NewResolvedTable(plainTable, nil, nil)),
+			),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, a, getRule("apply_late_materialization"))
+}
@@ -0,0 +1,394 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyMergeJoins replaces inner joins with merge joins when the join condition compares columns of both sides for
+// equality, and both sides can return their rows sorted by those columns: either because they read a table through an
+// ordered index, or because they are sorted already. When the join is sorted on its keys, the sides are sorted instead
+// of the join result if needed, and the merge join, which returns rows in key order, makes the sort unnecessary.
+func applyMergeJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, ctx := ctx.Span("apply_merge_joins")
+	defer span.Finish()
+
+	if !n.Resolved() || len(scope.Schema()) > 0 {
+		return n, nil
+	}
+
+	// Updates and deletes on joins expect the join nodes they were planned with
+	isDML := false
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n.(type) {
+		case *plan.Update, *plan.DeleteFrom:
+			isDML = true
+		}
+		return !isDML
+	})
+	if isDML {
+		return n, nil
+	}
+
+	ia, err := getIndexesForNode(ctx, a, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		switch n := n.(type) {
+		case *plan.InnerJoin:
+			mj, err := mergeJoinFor(ctx, ia, n, false)
+			if err != nil {
+				return nil, err
+			}
+			if mj == nil {
+				return n, nil
+			}
+
+			a.Log("replacing join %s with a merge join", n.Cond)
+			return mj, nil
+		case *plan.Sort:
+			switch child := n.Child.(type) {
+			case *plan.MergeJoin:
+				if mergeJoinSorts(child, n.SortFields) {
+					a.Log("removing sort %s on the keys of a merge join", n)
+					return child, nil
+				}
+			case *plan.InnerJoin:
+				mj, err := mergeJoinFor(ctx, ia, child, true)
+				if err != nil {
+					return nil, err
+				}
+				if mj != nil && mergeJoinSorts(mj, n.SortFields) {
+					a.Log("replacing sorted join %s with a merge join", child.Cond)
+					return mj, nil
+				}
+			}
+		}
+		return n, nil
+	})
+}
+
+// mergeJoinFor returns a merge join equivalent to the join given, or nil if it can't be answered with one. If
+// sortInputs is true, sides of the join that aren't sorted on the join keys are sorted, so a merge join is always
+// returned for joins with equality conditions.
+func mergeJoinFor(ctx *sql.Context, ia *indexAnalyzer, j *plan.InnerJoin, sortInputs bool) (*plan.MergeJoin, error) {
+	leftFields, rightFields := mergeJoinFields(j)
+	if len(leftFields) == 0 {
+		return nil, nil
+	}
+
+	leftLen := len(j.Left().Schema())
+	leftInputs := mergeJoinInputs(ctx, ia, j.Left(), leftFields, 0)
+	rightInputs := mergeJoinInputs(ctx, ia, j.Right(), rightFields, leftLen)
+	if sortInputs {
+		leftInputs = append(leftInputs, sortedMergeJoinInput(j.Left(), leftFields, nil, 0))
+	}
+
+	for _, left := range leftInputs {
+		var right *mergeJoinInput
+		for _, r := range rightInputs {
+			if sameOrder(left.order, r.order) {
+				right = &r
+				break
+			}
+		}
+		if right == nil {
+			if !sortInputs {
+				continue
+			}
+			sorted := sortedMergeJoinInput(j.Right(), rightFields, left.order, leftLen)
+			right = &sorted
+		}
+
+		leftKeys := make([]sql.Expression, len(left.order))
+		rightKeys := make([]sql.Expression, len(left.order))
+		for i, pos := range left.order {
+			leftKeys[i] = leftFields[pos]
+			rightKeys[i] = rightFields[pos]
+		}
+
+		leftNode, err := left.node(ctx)
+		if err != nil || leftNode == nil {
+			return nil, err
+		}
+		rightNode, err := right.node(ctx)
+		if err != nil || rightNode == nil {
+			return nil, err
+		}
+
+		return plan.NewMergeJoin(leftNode, rightNode, plan.JoinTypeInner, j.Cond, leftKeys, rightKeys), nil
+	}
+
+	return nil, nil
+}
+
+// mergeJoinFields returns the pairs of columns of the left and right side of the join given that the join condition
+// compares for equality.
+func mergeJoinFields(j *plan.InnerJoin) ([]*expression.GetField, []*expression.GetField) {
+	leftSchema, rightSchema := j.Left().Schema(), j.Right().Schema()
+
+	var leftFields, rightFields []*expression.GetField
+	for _, e := range splitConjunction(j.Cond) {
+		eq, ok := e.(*expression.Equals)
+		if !ok {
+			continue
+		}
+
+		l, ok := eq.Left().(*expression.GetField)
+		if !ok {
+			continue
+		}
+		r, ok := eq.Right().(*expression.GetField)
+		if !ok {
+			continue
+		}
+
+		if schemaContainsField(rightSchema, l) && schemaContainsField(leftSchema, r) {
+			l, r = r, l
+		}
+		if !schemaContainsField(leftSchema, l) || !schemaContainsField(rightSchema, r) {
+			continue
+		}
+
+		// Keys are compared with the type of the left side, so both sides must agree on it
+		if l.Type().Type() != r.Type().Type() {
+			continue
+		}
+
+		leftFields = append(leftFields, l)
+		rightFields = append(rightFields, r)
+	}
+
+	return leftFields, rightFields
+}
+
+// mergeJoinInput is a way to read a side of a join sorted on its join keys. Order holds the positions of the join keys
+// in the order the rows are sorted by, and node returns the side of the join reading rows in that order.
+type mergeJoinInput struct {
+	order []int
+	node  func(ctx *sql.Context) (sql.Node, error)
+}
+
+// mergeJoinInputs returns the ways the side of the join given can return its rows sorted by the fields given: through
+// the ordered indexes of its table, or as it is if it's sorted already. Offset is the position of the first column of
+// this side in the rows of the join.
+func mergeJoinInputs(ctx *sql.Context, ia *indexAnalyzer, n sql.Node, fields []*expression.GetField, offset int) []mergeJoinInput {
+	if s, ok := n.(*plan.Sort); ok {
+		if order, ok := sortFieldsOrder(s.SortFields, fields); ok {
+			return []mergeJoinInput{{order: order, node: func(*sql.Context) (sql.Node, error) { return n, nil }}}
+		}
+		return nil
+	}
+
+	name, rt := mergeJoinTable(n)
+	if rt == nil {
+		return nil
+	}
+
+	var inputs []mergeJoinInput
+	for _, idx := range orderedIndexes(ctx, ia, name, rt) {
+		order, ok := indexPrefixOrder(idx, rt, fields)
+		if !ok {
+			continue
+		}
+
+		idx := idx
+		keys := make([]sql.Expression, len(order))
+		for i, pos := range order {
+			keys[i] = fields[pos].WithIndex(fields[pos].Index() - offset)
+		}
+		inputs = append(inputs, mergeJoinInput{order: order, node: func(ctx *sql.Context) (sql.Node, error) {
+			return orderedTableAccess(ctx, n, rt, idx, keys)
+		}})
+	}
+
+	return inputs
+}
+
+// sortedMergeJoinInput returns an input that sorts the side of the join given on the fields given, in the order given,
+// or in the order of the fields if order is nil.
+func sortedMergeJoinInput(n sql.Node, fields []*expression.GetField, order []int, offset int) mergeJoinInput {
+	if order == nil {
+		order = make([]int, len(fields))
+		for i := range order {
+			order[i] = i
+		}
+	}
+
+	sortFields := make([]sql.SortField, len(order))
+	for i, pos := range order {
+		sortFields[i] = sql.SortField{
+			Column:       fields[pos].WithIndex(fields[pos].Index() - offset),
+			Order:        sql.Ascending,
+			NullOrdering: sql.NullsFirst,
+		}
+	}
+
+	return mergeJoinInput{order: order, node: func(*sql.Context) (sql.Node, error) {
+		return plan.NewSort(sortFields, n), nil
+	}}
+}
+
+// sortFieldsOrder returns, for each of the first len(fields) sort fields given, the position of the field that
+// matches it. It returns false if those sort fields aren't exactly the fields given, in ascending order.
+func sortFieldsOrder(sortFields []sql.SortField, fields []*expression.GetField) ([]int, bool) {
+	if len(sortFields) < len(fields) {
+		return nil, false
+	}
+
+	order := make([]int, len(fields))
+	used := make([]bool, len(fields))
+SortFields:
+	for i, sf := range sortFields[:len(fields)] {
+		gf, ok := sf.Column.(*expression.GetField)
+		if !ok || sf.Order != sql.Ascending {
+			return nil, false
+		}
+
+		for pos, field := range fields {
+			if !used[pos] && sameField(gf, field) {
+				order[i] = pos
+				used[pos] = true
+				continue SortFields
+			}
+		}
+		return nil, false
+	}
+
+	return order, true
+}
+
+// mergeJoinSorts returns whether the rows of the merge join given are already sorted as the sort fields given ask. An
+// inner merge join returns its rows sorted by its keys, which are never NULL, so any prefix of its keys in ascending
+// order is satisfied, whichever side of the join condition the sort fields name.
+func mergeJoinSorts(mj *plan.MergeJoin, sortFields []sql.SortField) bool {
+	if mj.JoinType() != plan.JoinTypeInner || len(sortFields) > len(mj.LeftKeys) {
+		return false
+	}
+
+	for i, sf := range sortFields {
+		gf, ok := sf.Column.(*expression.GetField)
+		if !ok || sf.Order != sql.Ascending {
+			return false
+		}
+
+		left := mj.LeftKeys[i].(*expression.GetField)
+		right := mj.RightKeys[i].(*expression.GetField)
+		if !sameField(gf, left) && !sameField(gf, right) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameField(a, b *expression.GetField) bool {
+	return strings.EqualFold(a.Table(), b.Table()) && strings.EqualFold(a.Name(), b.Name())
+}
+
+func sameOrder(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeJoinTable returns the name and the table of a join child that can be read through an index, or a nil table if
+// the child is anything other than a table or an aliased table.
+func mergeJoinTable(n sql.Node) (string, *plan.ResolvedTable) {
+	switch n := n.(type) {
+	case *plan.ResolvedTable:
+		return n.Name(), n
+	case *plan.TableAlias:
+		if rt, ok := n.Child.(*plan.ResolvedTable); ok {
+			return n.Name(), rt
+		}
+	}
+	return "", nil
+}
+
+// orderedIndexes returns the indexes of the table given that return their rows in ascending order.
+func orderedIndexes(ctx *sql.Context, ia *indexAnalyzer, name string, rt *plan.ResolvedTable) []sql.Index {
+	var db string
+	if rt.Database != nil {
+		db = rt.Database.Name()
+	}
+
+	var indexes []sql.Index
+	for _, idx := range ia.IndexesByTable(ctx, db, name) {
+		if oi, ok := idx.(sql.OrderedIndex); ok && oi.Order() == sql.IndexOrderAsc {
+			indexes = append(indexes, idx)
+		}
+	}
+	return indexes
+}
+
+// indexPrefixOrder returns, for each of the first len(fields) expressions of the index given, the position of the
+// field that matches it. It returns false if those expressions aren't exactly the fields given.
+func indexPrefixOrder(idx sql.Index, rt *plan.ResolvedTable, fields []*expression.GetField) ([]int, bool) {
+	idxExprs := idx.Expressions()
+	if len(idxExprs) < len(fields) {
+		return nil, false
+	}
+
+	order := make([]int, len(fields))
+	used := make([]bool, len(fields))
+IndexExpressions:
+	for i := range fields {
+		for pos, field := range fields {
+			if !used[pos] && idxExprs[i] == field.WithTable(rt.Name()).String() {
+				order[i] = pos
+				used[pos] = true
+				continue IndexExpressions
+			}
+		}
+		return nil, false
+	}
+
+	return order, true
+}
+
+// orderedTableAccess returns the join child given with its table replaced by a lookup on every row of the index
+// given, so that its rows are returned in index order. It returns nil if the index can't build such a lookup.
+func orderedTableAccess(ctx *sql.Context, n sql.Node, rt *plan.ResolvedTable, idx sql.Index, keys []sql.Expression) (sql.Node, error) {
+	columnTypes := idx.ColumnExpressionTypes(ctx)
+	rang := make(sql.Range, len(keys))
+	for i := range keys {
+		rang[i] = sql.RangeColumn{sql.AllRangeColumnExpr(columnTypes[i].Type)}
+	}
+
+	lookup, err := idx.NewLookup(ctx, rang)
+	if err != nil || lookup == nil {
+		return nil, err
+	}
+
+	access := plan.NewStaticIndexedTableAccess(rt, lookup, idx, keys)
+	if ta, ok := n.(*plan.TableAlias); ok {
+		return ta.WithChildren(access)
+	}
+	return access, nil
+}
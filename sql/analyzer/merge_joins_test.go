@@ -0,0 +1,154 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// orderedIdx is a memory index over a table whose rows were inserted in index order
+type orderedIdx struct {
+	*memory.Index
+}
+
+func (i orderedIdx) Order() sql.IndexOrder {
+	return sql.IndexOrderAsc
+}
+
+func TestMergeJoinFor(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	left := memory.NewTable("l", sql.Schema{
+		{Name: "a", Source: "l", Type: sql.Int64},
+	})
+	right := memory.NewTable("r", sql.Schema{
+		{Name: "b", Source: "r", Type: sql.Int64},
+	})
+	for _, v := range []int64{1, 2, 3} {
+		require.NoError(left.Insert(ctx, sql.NewRow(v)))
+	}
+	for _, v := range []int64{2, 3, 3, 4} {
+		require.NoError(right.Insert(ctx, sql.NewRow(v)))
+	}
+
+	leftField := expression.NewGetFieldWithTable(0, sql.Int64, "l", "a", false)
+	rightField := expression.NewGetFieldWithTable(1, sql.Int64, "r", "b", false)
+	leftIdx := &memory.Index{TableName: "l", Tbl: left, Name: "l_a", Exprs: []sql.Expression{leftField}}
+	rightIdx := &memory.Index{TableName: "r", Tbl: right, Name: "r_b", Exprs: []sql.Expression{rightField.WithIndex(0)}}
+
+	join := plan.NewInnerJoin(
+		plan.NewResolvedTable(left, nil, nil),
+		plan.NewResolvedTable(right, nil, nil),
+		expression.NewEquals(rightField, leftField),
+	)
+
+	// Indexes that don't return their rows in order can't be merged
+	ia := &indexAnalyzer{indexesByTable: map[string][]sql.Index{
+		"l": {leftIdx},
+		"r": {rightIdx},
+	}}
+	mj, err := mergeJoinFor(ctx, ia, join, false)
+	require.NoError(err)
+	require.Nil(mj)
+
+	ia = &indexAnalyzer{indexesByTable: map[string][]sql.Index{
+		"l": {orderedIdx{leftIdx}},
+		"r": {orderedIdx{rightIdx}},
+	}}
+	mj, err = mergeJoinFor(ctx, ia, join, false)
+	require.NoError(err)
+	require.NotNil(mj)
+	require.Equal([]sql.Expression{leftField}, mj.LeftKeys)
+	require.Equal([]sql.Expression{rightField}, mj.RightKeys)
+	require.IsType(&plan.IndexedTableAccess{}, mj.Left())
+	require.IsType(&plan.IndexedTableAccess{}, mj.Right())
+
+	iter, err := mj.RowIter(ctx, nil)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{int64(2), int64(2)},
+		{int64(3), int64(3)},
+		{int64(3), int64(3)},
+	}, rows)
+}
+
+func TestMergeJoinForSortedJoin(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	left := memory.NewTable("l", sql.Schema{
+		{Name: "a", Source: "l", Type: sql.Int64},
+	})
+	right := memory.NewTable("r", sql.Schema{
+		{Name: "b", Source: "r", Type: sql.Int64},
+	})
+	for _, v := range []int64{3, 1, 2} {
+		require.NoError(left.Insert(ctx, sql.NewRow(v)))
+	}
+	for _, v := range []int64{4, 3, 2, 3} {
+		require.NoError(right.Insert(ctx, sql.NewRow(v)))
+	}
+
+	leftField := expression.NewGetFieldWithTable(0, sql.Int64, "l", "a", false)
+	rightField := expression.NewGetFieldWithTable(1, sql.Int64, "r", "b", false)
+
+	join := plan.NewInnerJoin(
+		plan.NewResolvedTable(left, nil, nil),
+		plan.NewResolvedTable(right, nil, nil),
+		expression.NewEquals(leftField, rightField),
+	)
+	sorted := plan.NewSort([]sql.SortField{{Column: rightField, Order: sql.Ascending}}, join)
+
+	// Without ordered indexes, the join is only replaced when its result must be sorted on the join keys
+	n, err := applyMergeJoins(ctx, NewDefault(nil), join, nil)
+	require.NoError(err)
+	require.Equal(join, n)
+
+	n, err = applyMergeJoins(ctx, NewDefault(nil), sorted, nil)
+	require.NoError(err)
+
+	mj, ok := n.(*plan.MergeJoin)
+	require.True(ok)
+	require.IsType(&plan.Sort{}, mj.Left())
+	require.IsType(&plan.Sort{}, mj.Right())
+
+	iter, err := mj.RowIter(ctx, nil)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{int64(2), int64(2)},
+		{int64(3), int64(3)},
+		{int64(3), int64(3)},
+	}, rows)
+
+	// Sorts on other columns are still needed
+	desc := plan.NewSort([]sql.SortField{{Column: rightField, Order: sql.Descending}}, join)
+	n, err = applyMergeJoins(ctx, NewDefault(nil), desc, nil)
+	require.NoError(err)
+	require.Equal(desc, n)
+}
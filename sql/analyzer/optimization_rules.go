@@ -234,6 +234,7 @@ func expressionSources(expr sql.Expression) []string {
 // evalFilter simplifies the expressions in Filter nodes where possible. This involves removing redundant parts of AND
 // and OR expressions, as well as replacing evaluable expressions with their literal result. Filters that can
 // statically be determined to be true or false are replaced with the child node or an empty result, respectively.
+// Filters that contradict themselves, such as x = 1 AND x = 2, are replaced with an empty result too.
 func evalFilter(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (sql.Node, error) {
 	if !node.Resolved() {
 		return node, nil
@@ -302,8 +303,8 @@ func evalFilter(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (sql
 			return nil, err
 		}
 
-		if isFalse(e) {
-			return plan.EmptyTable, nil
+		if isFalse(e) || isContradiction(e) {
+			return plan.NewEmptyTableWithSchema(filter.Schema()), nil
 		}
 
 		if isTrue(e) {
@@ -339,3 +340,130 @@ func isTrue(e sql.Expression) bool {
 	}
 	return false
 }
+
+// isContradiction returns whether the conjunction given can't be true for any row, because it compares the same column
+// for equality with two different values, or with NULL, or checks that a column it compares for equality is NULL.
+func isContradiction(e sql.Expression) bool {
+	values := make(map[columnKey]*expression.Literal)
+	nulls := make(columnSet)
+	for _, e := range splitConjunction(e) {
+		switch e := e.(type) {
+		case *expression.Equals:
+			gf, lit := getFieldAndLiteral(e.Left(), e.Right())
+			if gf == nil {
+				continue
+			}
+			if lit.Value() == nil {
+				return true
+			}
+
+			key := newColumnKey(gf.Table(), gf.Name())
+			prev, ok := values[key]
+			if !ok {
+				values[key] = lit
+				continue
+			}
+			if differentValues(gf.Type(), prev, lit) {
+				return true
+			}
+		case *expression.IsNull:
+			if gf, ok := e.Child.(*expression.GetField); ok {
+				nulls.add(newColumnKey(gf.Table(), gf.Name()))
+			}
+		}
+	}
+
+	for key := range values {
+		if nulls.has(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// getFieldAndLiteral returns the field and the literal of a comparison between them, or nils if the comparison isn't
+// between a field and a literal.
+func getFieldAndLiteral(left, right sql.Expression) (*expression.GetField, *expression.Literal) {
+	if gf, ok := left.(*expression.GetField); ok {
+		if lit, ok := right.(*expression.Literal); ok {
+			return gf, lit
+		}
+	}
+	if gf, ok := right.(*expression.GetField); ok {
+		if lit, ok := left.(*expression.Literal); ok {
+			return gf, lit
+		}
+	}
+	return nil, nil
+}
+
+// differentValues returns whether the two literals given are known to be different values of the column type given.
+// Only literals of the same type as each other, and of the same kind as the column, are compared, since comparisons
+// between other types convert their values in ways that may make different literals equal.
+func differentValues(typ sql.Type, left, right *expression.Literal) bool {
+	if left.Type() != right.Type() {
+		return false
+	}
+	if !(sql.IsNumber(typ) && sql.IsNumber(left.Type())) && !(sql.IsText(typ) && sql.IsText(left.Type())) {
+		return false
+	}
+
+	cmp, err := typ.Compare(left.Value(), right.Value())
+	return err == nil && cmp != 0
+}
+
+// pruneEmptyResults replaces the nodes that are known to return no rows with an empty table of the same schema, so
+// that the tables below them are never scanned. A node returns no rows when it's a LIMIT 0, or when it only returns
+// rows computed from the rows of a child that returns none, such as a filter, a projection or an inner join over an
+// empty table. Aggregations are left alone, since they return a row even when their child returns none.
+func pruneEmptyResults(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (sql.Node, error) {
+	if !node.Resolved() {
+		return node, nil
+	}
+
+	switch node.(type) {
+	case *plan.Update, *plan.DeleteFrom:
+		// These need the tables they modify, even when no rows are modified
+		return node, nil
+	}
+
+	span, _ := ctx.Span("prune_empty_results")
+	defer span.Finish()
+
+	return plan.TransformUp(node, func(node sql.Node) (sql.Node, error) {
+		if isEmptyResult(node) {
+			a.Log("node %T returns no rows, replaced with an empty table", node)
+			return plan.NewEmptyTableWithSchema(node.Schema()), nil
+		}
+		return node, nil
+	})
+}
+
+// isEmptyResult returns whether the node given is known to return no rows, while not being an empty table already.
+func isEmptyResult(node sql.Node) bool {
+	switch node := node.(type) {
+	case *plan.Limit:
+		if node.CalcFoundRows {
+			return false
+		}
+		if lit, ok := node.Limit.(*expression.Literal); ok {
+			limit, err := sql.Int64.Convert(lit.Value())
+			if err == nil && limit == int64(0) {
+				return true
+			}
+		}
+		return plan.IsEmptyTable(node.Child)
+	case *plan.Filter, *plan.Project, *plan.Sort, *plan.Offset, *plan.Distinct, *plan.OrderedDistinct:
+		return plan.IsEmptyTable(node.Children()[0])
+	case *plan.InnerJoin:
+		return plan.IsEmptyTable(node.Left()) || plan.IsEmptyTable(node.Right())
+	case *plan.CrossJoin:
+		return plan.IsEmptyTable(node.Left()) || plan.IsEmptyTable(node.Right())
+	case *plan.LeftJoin:
+		return plan.IsEmptyTable(node.Left())
+	case *plan.RightJoin:
+		return plan.IsEmptyTable(node.Right())
+	default:
+		return false
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -386,6 +387,50 @@ func TestEvalFilter(t *testing.T) {
 			),
 			plan.EmptyTable,
 		},
+		{
+			and(
+				eq(col(0, "foo", "bar"), lit(5)),
+				eq(lit(4), col(0, "foo", "bar")),
+			),
+			plan.EmptyTable,
+		},
+		{
+			and(
+				eq(col(0, "foo", "bar"), lit(5)),
+				eq(col(0, "foo", "bar"), lit(5)),
+			),
+			plan.NewFilter(
+				and(
+					eq(col(0, "foo", "bar"), lit(5)),
+					eq(col(0, "foo", "bar"), lit(5)),
+				),
+				plan.NewResolvedTable(inner, nil, nil),
+			),
+		},
+		{
+			and(
+				eq(col(0, "foo", "bar"), lit(5)),
+				eq(col(0, "foo", "bar"), litT("4", sql.LongText)),
+			),
+			plan.NewFilter(
+				and(
+					eq(col(0, "foo", "bar"), lit(5)),
+					eq(col(0, "foo", "bar"), litT("4", sql.LongText)),
+				),
+				plan.NewResolvedTable(inner, nil, nil),
+			),
+		},
+		{
+			and(
+				eq(col(0, "foo", "bar"), lit(5)),
+				expression.NewIsNull(col(0, "foo", "bar")),
+			),
+			plan.EmptyTable,
+		},
+		{
+			eq(col(0, "foo", "bar"), litNull()),
+			plan.EmptyTable,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -399,6 +444,69 @@ func TestEvalFilter(t *testing.T) {
 	}
 }
 
+func TestPruneEmptyResults(t *testing.T) {
+	t1 := plan.NewResolvedTable(memory.NewTable("t1", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t1"},
+	}), nil, nil)
+	t2 := plan.NewResolvedTable(memory.NewTable("t2", sql.Schema{
+		{Name: "b", Type: sql.Int64, Source: "t2"},
+	}), nil, nil)
+	empty := plan.NewEmptyTableWithSchema(t2.Schema())
+	project := plan.NewProject([]sql.Expression{gf(0, "t1", "a")}, t1)
+	join := plan.NewInnerJoin(t1, empty, eq(gf(0, "t1", "a"), gf(1, "t2", "b")))
+	limit := plan.NewLimit(lit(0), project)
+	limit.CalcFoundRows = true
+	leftJoin := plan.NewLeftJoin(empty, t1, eq(gf(1, "t1", "a"), gf(0, "t2", "b")))
+
+	tests := []analyzerFnTestCase{
+		{
+			name:     "limit 0",
+			node:     plan.NewLimit(lit(0), project),
+			expected: plan.NewEmptyTableWithSchema(project.Schema()),
+		},
+		{
+			name: "limit 0 counting found rows",
+			node: limit,
+		},
+		{
+			name: "limit 1",
+			node: plan.NewLimit(lit(1), project),
+		},
+		{
+			name: "nodes over an empty inner join",
+			node: plan.NewSort(
+				[]sql.SortField{{Column: gf(0, "t1", "a")}},
+				plan.NewProject([]sql.Expression{gf(0, "t1", "a")}, join),
+			),
+			expected: plan.NewEmptyTableWithSchema(project.Schema()),
+		},
+		{
+			name: "left join with an empty right side",
+			node: plan.NewLeftJoin(t1, empty, eq(gf(0, "t1", "a"), gf(1, "t2", "b"))),
+		},
+		{
+			name:     "left join with an empty left side",
+			node:     leftJoin,
+			expected: plan.NewEmptyTableWithSchema(leftJoin.Schema()),
+		},
+		{
+			name: "aggregation over an empty table",
+			node: plan.NewGroupBy(
+				[]sql.Expression{aggregation.NewCount(lit(1))},
+				nil,
+				plan.NewFilter(eq(gf(0, "t2", "b"), lit(1)), empty),
+			),
+			expected: plan.NewGroupBy(
+				[]sql.Expression{aggregation.NewCount(lit(1))},
+				nil,
+				empty,
+			),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, NewDefault(sql.NewDatabaseProvider()), getRule("prune_empty_results"))
+}
+
 func TestRemoveUnnecessaryConverts(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -0,0 +1,154 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyOrderedGroupBy reads the table of a GroupBy through an ordered index when its grouping expressions are the
+// columns of a prefix of the index, or expressions monotonic in them such as TIME_BUCKET(INTERVAL 15 MINUTE, ts). The
+// rows of every group then come one after the other, so the groups are aggregated as the rows stream instead of being
+// held in memory together.
+func applyOrderedGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, ctx := ctx.Span("apply_ordered_group_by")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	var ia *indexAnalyzer
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		gb, ok := n.(*plan.GroupBy)
+		if !ok || len(gb.GroupByExprs) == 0 || len(gb.GroupingSets) > 0 || gb.InputSorted() {
+			return n, nil
+		}
+
+		if ia == nil {
+			var err error
+			ia, err = getIndexesForNode(ctx, a, n)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		ordered, err := orderedGroupBy(ctx, ia, gb)
+		if err != nil {
+			return nil, err
+		}
+		if ordered == nil {
+			return n, nil
+		}
+
+		a.Log("reading the table of group by %s through an ordered index", gb)
+		return ordered, nil
+	})
+}
+
+// orderedGroupBy returns the GroupBy given with its table read through an ordered index that sorts its rows on the
+// grouping expressions, or nil if its table has no such index.
+func orderedGroupBy(ctx *sql.Context, ia *indexAnalyzer, gb *plan.GroupBy) (*plan.GroupBy, error) {
+	fields := groupingFields(gb.GroupByExprs)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	child, err := orderedGroupByInput(ctx, ia, gb.Child, fields)
+	if err != nil || child == nil {
+		return nil, err
+	}
+
+	n, err := gb.WithChildren(child)
+	if err != nil {
+		return nil, err
+	}
+
+	ordered := n.(*plan.GroupBy)
+	if !ordered.InputSorted() {
+		return nil, nil
+	}
+	return ordered, nil
+}
+
+// groupingFields returns the fields the grouping expressions given are made of: the expressions themselves if they are
+// fields, or the fields they are monotonic in. It returns nil if any of them is something else.
+func groupingFields(groupByExprs []sql.Expression) []*expression.GetField {
+	var fields []*expression.GetField
+	for _, e := range groupByExprs {
+		if m, ok := e.(sql.MonotonicExpression); ok && m.MonotonicArgument() != nil {
+			e = m.MonotonicArgument()
+		}
+
+		field, ok := e.(*expression.GetField)
+		if !ok {
+			return nil
+		}
+
+		seen := false
+		for _, f := range fields {
+			if strings.EqualFold(f.Table(), field.Table()) && strings.EqualFold(f.Name(), field.Name()) {
+				seen = true
+			}
+		}
+		if !seen {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// orderedGroupByInput returns the input of a GroupBy with its table read through an ordered index whose first columns
+// are the fields given, or nil if the input isn't a table, possibly filtered, with such an index.
+func orderedGroupByInput(ctx *sql.Context, ia *indexAnalyzer, n sql.Node, fields []*expression.GetField) (sql.Node, error) {
+	if filter, ok := n.(*plan.Filter); ok {
+		child, err := orderedGroupByInput(ctx, ia, filter.Child, fields)
+		if err != nil || child == nil {
+			return nil, err
+		}
+		return filter.WithChildren(child)
+	}
+
+	name, rt := mergeJoinTable(n)
+	if rt == nil {
+		return nil, nil
+	}
+
+	for _, idx := range orderedIndexes(ctx, ia, name, rt) {
+		order, ok := indexPrefixOrder(idx, rt, fields)
+		if !ok {
+			continue
+		}
+
+		keys := make([]sql.Expression, len(order))
+		for i, pos := range order {
+			keys[i] = fields[pos]
+		}
+
+		access, err := orderedTableAccess(ctx, n, rt, idx, keys)
+		if err != nil {
+			return nil, err
+		}
+		if access != nil {
+			return access, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestOrderedGroupBy(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewTable("ts", sql.Schema{
+		{Name: "t", Source: "ts", Type: sql.Datetime},
+		{Name: "v", Source: "ts", Type: sql.Int64},
+	})
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	for i, minutes := range []int{0, 5, 14, 15, 40, 44, 46} {
+		require.NoError(table.Insert(ctx, sql.NewRow(start.Add(time.Duration(minutes)*time.Minute), int64(i+1))))
+	}
+
+	tField := expression.NewGetFieldWithTable(0, sql.Datetime, "ts", "t", false)
+	vField := expression.NewGetFieldWithTable(1, sql.Int64, "ts", "v", false)
+	bucket, err := function.NewTimeBucket(
+		expression.NewInterval(expression.NewLiteral(int64(15), sql.Int64), "MINUTE"),
+		tField,
+	)
+	require.NoError(err)
+
+	tIdx := &memory.Index{TableName: "ts", Tbl: table, Name: "ts_t", Exprs: []sql.Expression{tField}}
+	tvIdx := &memory.Index{TableName: "ts", Tbl: table, Name: "ts_t_v", Exprs: []sql.Expression{tField, vField}}
+
+	gb := plan.NewGroupBy(
+		[]sql.Expression{bucket, aggregation.NewSum(vField)},
+		[]sql.Expression{bucket},
+		plan.NewResolvedTable(table, nil, nil),
+	)
+
+	// Indexes that don't return their rows in order don't help
+	ia := &indexAnalyzer{indexesByTable: map[string][]sql.Index{"ts": {tIdx}}}
+	ordered, err := orderedGroupBy(ctx, ia, gb)
+	require.NoError(err)
+	require.Nil(ordered)
+
+	ia = &indexAnalyzer{indexesByTable: map[string][]sql.Index{"ts": {orderedIdx{tIdx}}}}
+	ordered, err = orderedGroupBy(ctx, ia, gb)
+	require.NoError(err)
+	require.NotNil(ordered)
+	require.IsType(&plan.IndexedTableAccess{}, ordered.Child)
+	require.True(ordered.InputSorted())
+
+	iter, err := ordered.RowIter(ctx, nil)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{start, float64(6)},
+		{start.Add(15 * time.Minute), float64(4)},
+		{start.Add(30 * time.Minute), float64(11)},
+		{start.Add(45 * time.Minute), float64(7)},
+	}, rows)
+
+	// Rows of a bucket aren't sorted on the columns after its datetime
+	byBucketAndValue := plan.NewGroupBy(
+		[]sql.Expression{bucket, vField},
+		[]sql.Expression{bucket, vField},
+		plan.NewResolvedTable(table, nil, nil),
+	)
+	ia = &indexAnalyzer{indexesByTable: map[string][]sql.Index{"ts": {orderedIdx{tvIdx}}}}
+	ordered, err = orderedGroupBy(ctx, ia, byBucketAndValue)
+	require.NoError(err)
+	require.Nil(ordered)
+}
@@ -39,23 +39,46 @@ func shouldParallelize(node sql.Node, scope *Scope) bool {
 	return !plan.IsNoRowNode(node)
 }
 
+// QueryParallelismSessionVar is the session variable that overrides the parallelism of the analyzer for the queries of
+// a session. Zero uses the analyzer's parallelism.
+const QueryParallelismSessionVar = "query_parallelism"
+
+// queryParallelism returns the number of goroutines the tables of a query are scanned with.
+func queryParallelism(ctx *sql.Context, a *Analyzer) int {
+	val, err := ctx.GetSessionVariable(ctx, QueryParallelismSessionVar)
+	if err != nil {
+		return a.Parallelism
+	}
+
+	if parallelism, ok := val.(int64); ok && parallelism > 0 {
+		return int(parallelism)
+	}
+	return a.Parallelism
+}
+
 func parallelize(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (sql.Node, error) {
-	if a.Parallelism <= 1 || !node.Resolved() {
+	parallelism := queryParallelism(ctx, a)
+	if parallelism <= 1 || !node.Resolved() {
 		return node, nil
 	}
 
+	node, err := parallelizeLoadData(node, parallelism)
+	if err != nil {
+		return nil, err
+	}
+
 	proc, ok := node.(*plan.QueryProcess)
 	if (ok && !shouldParallelize(proc.Child, nil)) || !shouldParallelize(node, scope) {
 		return node, nil
 	}
 
-	node, err := plan.TransformUp(node, func(node sql.Node) (sql.Node, error) {
+	node, err = plan.TransformUp(node, func(node sql.Node) (sql.Node, error) {
 		if !isParallelizable(node) {
 			return node, nil
 		}
-		ParallelQueryCounter.With("parallelism", strconv.Itoa(a.Parallelism)).Add(1)
+		ParallelQueryCounter.With("parallelism", strconv.Itoa(parallelism)).Add(1)
 
-		return plan.NewExchange(a.Parallelism, node), nil
+		return plan.NewExchange(parallelism, node), nil
 	})
 
 	if err != nil {
@@ -65,6 +88,32 @@ func parallelize(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (sq
 	return plan.TransformUp(node, removeRedundantExchanges)
 }
 
+// parallelizeLoadData sets the parallelism of the LOAD DATA statements in the node given, whose files are then parsed
+// in chunks by that many goroutines. The rows are still inserted one by one, in the order of the file.
+func parallelizeLoadData(node sql.Node, parallelism int) (sql.Node, error) {
+	return plan.TransformUp(node, func(node sql.Node) (sql.Node, error) {
+		insert, ok := node.(*plan.InsertInto)
+		if !ok || !isLoadData(insert.Source) {
+			return node, nil
+		}
+
+		source, err := plan.TransformUp(insert.Source, func(node sql.Node) (sql.Node, error) {
+			ld, ok := node.(*plan.LoadData)
+			if !ok {
+				return node, nil
+			}
+			nld := *ld
+			nld.Parallelism = parallelism
+			return &nld, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return insert.WithSource(source), nil
+	})
+}
+
 // removeRedundantExchanges removes all the exchanges except for the topmost
 // of all.
 func removeRedundantExchanges(node sql.Node) (sql.Node, error) {
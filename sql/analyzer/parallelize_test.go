@@ -71,6 +71,27 @@ func TestParallelize(t *testing.T) {
 	require.Equal(expected, result)
 }
 
+func TestParallelizeSessionParallelism(t *testing.T) {
+	require := require.New(t)
+	table := memory.NewTable("t", nil)
+	rule := getRuleFrom(OnceAfterAll, "parallelize")
+	node := plan.NewFilter(
+		expression.NewLiteral(1, sql.Int64),
+		plan.NewResolvedTable(table, nil, nil),
+	)
+
+	ctx := sql.NewEmptyContext()
+	result, err := rule.Apply(ctx, &Analyzer{Parallelism: 1}, node, nil)
+	require.NoError(err)
+	require.Equal(node, result)
+
+	// The session's parallelism overrides the analyzer's
+	require.NoError(ctx.SetSessionVariable(ctx, QueryParallelismSessionVar, int64(3)))
+	result, err = rule.Apply(ctx, &Analyzer{Parallelism: 1}, node, nil)
+	require.NoError(err)
+	require.Equal(plan.NewExchange(3, node), result)
+}
+
 func TestParallelizeCreateIndex(t *testing.T) {
 	require := require.New(t)
 	table := memory.NewTable("t", nil)
@@ -18,9 +18,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/dolthub/vitess/go/vt/sqlparser"
-
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -43,12 +42,12 @@ func processTruncate(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 		var db sql.Database
 		var err error
 		if truncatePlan.DatabaseName() == "" {
-			db, err = a.Catalog.Database(ctx.GetCurrentDatabase())
+			db, err = sql.GetDatabase(ctx, a.Catalog, ctx.GetCurrentDatabase())
 			if err != nil {
 				return nil, err
 			}
 		} else {
-			db, err = a.Catalog.Database(truncatePlan.DatabaseName())
+			db, err = sql.GetDatabase(ctx, a.Catalog, truncatePlan.DatabaseName())
 			if err != nil {
 				return nil, err
 			}
@@ -77,7 +76,7 @@ func deleteToTruncate(ctx *sql.Context, a *Analyzer, deletePlan *plan.DeleteFrom
 	}
 
 	tblFound := false
-	currentDb, err := a.Catalog.Database(ctx.GetCurrentDatabase())
+	currentDb, err := sql.GetDatabase(ctx, a.Catalog, ctx.GetCurrentDatabase())
 	if err != nil {
 		return nil, err
 	}
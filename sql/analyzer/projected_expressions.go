@@ -0,0 +1,142 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// pushdownProjectedExpressions pushes the expressions of projections down to the tables they're computed over, for
+// tables that implement sql.ExpressionProjectedTable. The table appends the values of the expressions it handles to
+// its rows, and the projection just reads them.
+func pushdownProjectedExpressions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, ctx := ctx.Span("pushdown_projected_expressions")
+	defer span.Finish()
+
+	// Fields from an outer scope are prepended to rows, so the indexes of the columns appended by the table can't be
+	// known here
+	if !canDoPushdown(n) || len(scope.Schema()) > 0 {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		project, ok := n.(*plan.Project)
+		if !ok {
+			return n, nil
+		}
+		return pushdownProjectExpressions(ctx, a, project)
+	})
+}
+
+// pushdownProjectExpressions pushes the expressions of the projection given down to the table it projects, if it
+// projects a single table that can compute them.
+func pushdownProjectExpressions(ctx *sql.Context, a *Analyzer, project *plan.Project) (sql.Node, error) {
+	child := project.Child
+	for {
+		filter, ok := child.(*plan.Filter)
+		if !ok {
+			break
+		}
+		child = filter.Child
+	}
+
+	tableNode, ok := singleTableNode(child)
+	if !ok {
+		return project, nil
+	}
+
+	rt := getResolvedTable(tableNode)
+	table, ok := rt.Table.(sql.ExpressionProjectedTable)
+	if !ok {
+		return project, nil
+	}
+
+	var candidates []sql.Expression
+	seen := make(map[string]bool)
+	for _, e := range project.Projections {
+		e = unaliased(e)
+		if !isProjectableExpression(e) || seen[e.String()] {
+			continue
+		}
+		seen[e.String()] = true
+		candidates = append(candidates, e)
+	}
+
+	handled := table.HandledProjections(candidates)
+	if len(handled) == 0 {
+		return project, nil
+	}
+
+	// The values of the expressions are appended to the rows of the table, after its columns
+	width := len(rt.Schema())
+	fields := make(map[string]sql.Expression)
+	for i, e := range handled {
+		fields[e.String()] = expression.NewGetFieldWithTable(width+i, e.Type(), tableNode.Name(), e.String(), e.IsNullable())
+	}
+
+	projections := make([]sql.Expression, len(project.Projections))
+	for i, e := range project.Projections {
+		projections[i] = e
+		if field, ok := fields[unaliased(e).String()]; ok {
+			if alias, ok := e.(*expression.Alias); ok {
+				projections[i] = expression.NewAlias(alias.Name(), field)
+			} else {
+				projections[i] = field
+			}
+		}
+	}
+
+	newChild, err := withTable(project.Child, table.WithProjectedExpressions(ctx, handled))
+	if err != nil {
+		return nil, err
+	}
+
+	a.Log("pushed down %d projected expressions to table %q", len(handled), tableNode.Name())
+	return plan.NewProject(projections, newChild), nil
+}
+
+// unaliased returns the expression under the alias given, or the expression itself if it isn't an alias.
+func unaliased(e sql.Expression) sql.Expression {
+	if alias, ok := e.(*expression.Alias); ok {
+		return alias.Child
+	}
+	return e
+}
+
+// isProjectableExpression returns whether the expression given could be computed by a table: it must be
+// deterministic, use some of the columns of the table and be something more than just a column.
+func isProjectableExpression(e sql.Expression) bool {
+	if _, ok := e.(*expression.GetField); ok {
+		return false
+	}
+
+	hasField, ok := false, true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			// Tables store TIMESTAMP values in UTC rather than in the time zone of the session
+			hasField, ok = true, e.Type() != sql.Timestamp
+		case *plan.Subquery, sql.Aggregation:
+			ok = false
+		case sql.NonDeterministicExpression:
+			ok = !e.IsNonDeterministic()
+		}
+		return ok
+	})
+
+	return ok && hasField
+}
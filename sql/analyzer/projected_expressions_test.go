@@ -0,0 +1,84 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestPushdownProjectedExpressions(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	schema := sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable"},
+		{Name: "t", Type: sql.Text, Source: "mytable"},
+	}
+	table := memory.NewExpressionProjectedTable("mytable", schema)
+	require.NoError(t, table.Insert(ctx, sql.NewRow(int64(1), "a")))
+	require.NoError(t, table.Insert(ctx, sql.NewRow(int64(2), "b")))
+	plainTable := memory.NewTable("mytable", schema)
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	convert := expression.NewConvert(i, expression.ConvertToChar)
+	filter := expression.NewGreaterThan(i, expression.NewLiteral(int64(1), sql.Int64))
+
+	a := NewDefault(sql.NewDatabaseProvider())
+
+	tests := []analyzerFnTestCase{
+		{
+			name: "cast pushed down",
+			node: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("c", convert), i},
+				plan.NewFilter(filter, plan.NewResolvedTable(table, nil, nil)),
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{
+					expression.NewAlias("c", expression.NewGetFieldWithTable(2, convert.Type(), "mytable", convert.String(), convert.IsNullable())),
+					i,
+				},
+				plan.NewFilter(filter, plan.NewResolvedTable(table.WithProjectedExpressions(ctx, []sql.Expression{convert}), nil, nil)),
+			),
+		},
+		{
+			name: "columns only",
+			node: plan.NewProject(
+				[]sql.Expression{i},
+				plan.NewResolvedTable(table, nil, nil),
+			),
+		},
+		{
+			name: "table can't compute expressions",
+			node: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("c", convert)},
+				plan.NewResolvedTable(plainTable, nil, nil),
+			),
+		},
+	}
+
+	runTestCases(t, ctx, tests, a, getRule("pushdown_projected_expressions"))
+
+	node, err := getRule("pushdown_projected_expressions").Apply(ctx, a, tests[0].node, nil)
+	require.NoError(t, err)
+
+	rows, err := sql.NodeToRows(ctx, node)
+	require.NoError(t, err)
+	require.Equal(t, []sql.Row{{"2", int64(2)}}, rows)
+}
@@ -88,7 +88,8 @@ func pruneColumnsIsSafe(n sql.Node) bool {
 		}
 		return isSafe
 	})
-	return isSafe
+	// Neither if there is a lateral join, whose right side depends on the schema of its left side.
+	return isSafe && !hasLateralJoin(n)
 }
 
 func columnsUsedByNode(n sql.Node) usedColumns {
@@ -280,7 +281,7 @@ func pruneGroupBy(a *Analyzer, n *plan.GroupBy, columns usedColumns) sql.Node {
 		return n.Child
 	}
 
-	return plan.NewGroupBy(remaining, n.GroupByExprs, n.Child)
+	return plan.NewGroupBy(remaining, n.GroupByExprs, n.Child).WithGroupingSets(n.GroupingSets)
 }
 
 func shouldPruneExpr(e sql.Expression, cols usedColumns) bool {
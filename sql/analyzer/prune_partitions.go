@@ -0,0 +1,224 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// prunePartitions restricts the partitioned tables that are filtered in a plan to the partitions that can hold rows
+// matching the filter, according to their partitioning scheme. Only the predicates on the column a table is
+// partitioned by are used.
+func prunePartitions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("prune_partitions")
+	defer span.Finish()
+
+	if !canDoPushdown(n) {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, nil
+		}
+
+		tableNode, ok := singleTableNode(filter.Child)
+		if !ok {
+			return n, nil
+		}
+
+		rt := getResolvedTable(tableNode)
+		table, ok := rt.Table.(sql.PartitionedTable)
+		if !ok || table.PartitionScheme() == nil {
+			return n, nil
+		}
+
+		scheme := table.PartitionScheme()
+		column, ok := scheme.Expression.(*expression.GetField)
+		if !ok {
+			return n, nil
+		}
+
+		var selected map[string]bool
+		for _, e := range splitConjunction(filter.Expression) {
+			if !plan.IsPrunablePredicate(e) || !onlyUsesTable(e, tableNode.Name()) {
+				continue
+			}
+
+			names, ok, err := partitionsForPredicate(scheme, column.Name(), e)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			matching := make(map[string]bool, len(names))
+			for _, name := range names {
+				if selected == nil || selected[name] {
+					matching[name] = true
+				}
+			}
+			selected = matching
+		}
+
+		if selected == nil || len(selected) == len(scheme.Partitions) {
+			return n, nil
+		}
+
+		var names []string
+		for _, name := range scheme.Names() {
+			if selected[name] {
+				names = append(names, name)
+			}
+		}
+
+		child, err := withTable(filter.Child, table.WithPartitions(names))
+		if err != nil {
+			return nil, err
+		}
+
+		a.Log("pruning partitions of table %q to %v", tableNode.Name(), names)
+		return filter.WithChildren(child)
+	})
+}
+
+// partitionsForPredicate returns the partitions that can hold the rows matching the prunable predicate given, and
+// whether the predicate can tell partitions apart at all.
+func partitionsForPredicate(scheme *sql.PartitionScheme, column string, e sql.Expression) ([]string, bool, error) {
+	isColumn := func(e sql.Expression) bool {
+		return strings.EqualFold(e.(*expression.GetField).Name(), column)
+	}
+	typ := scheme.Expression.Type()
+
+	switch e := e.(type) {
+	case *expression.IsNull:
+		if !isColumn(e.Child) {
+			return nil, false, nil
+		}
+		names, err := scheme.PartitionsForValues([]interface{}{nil})
+		return names, err == nil, err
+	case *expression.Not:
+		return nil, false, nil
+	case *expression.Between:
+		if !isColumn(e.Val) {
+			return nil, false, nil
+		}
+		lower, ok := partitionValue(typ, e.Lower)
+		if !ok {
+			return nil, false, nil
+		}
+		upper, ok := partitionValue(typ, e.Upper)
+		if !ok {
+			return nil, false, nil
+		}
+		if lower == nil || upper == nil {
+			return nil, true, nil
+		}
+		names, err := scheme.PartitionsForRange(lower, true, upper, true)
+		return names, err == nil, err
+	case *expression.InTuple:
+		if !isColumn(e.Left()) {
+			return nil, false, nil
+		}
+		var values []interface{}
+		for _, el := range e.Right().(expression.Tuple) {
+			v, ok := partitionValue(typ, el)
+			if !ok {
+				return nil, false, nil
+			}
+			// NULL is never in a list of values
+			if v != nil {
+				values = append(values, v)
+			}
+		}
+		names, err := scheme.PartitionsForValues(values)
+		return names, err == nil, err
+	}
+
+	c := e.(expression.Comparer)
+	field, literal, flipped := c.Left(), c.Right(), false
+	if isLiteral(field) {
+		field, literal, flipped = literal, field, true
+	}
+	if !isColumn(field) {
+		return nil, false, nil
+	}
+
+	v, ok := partitionValue(typ, literal)
+	if !ok {
+		return nil, false, nil
+	}
+	// Comparisons with NULL never match
+	if v == nil {
+		return nil, true, nil
+	}
+
+	var names []string
+	var err error
+	switch e.(type) {
+	case *expression.Equals:
+		names, err = scheme.PartitionsForValues([]interface{}{v})
+	case *expression.LessThan, *expression.LessThanOrEqual:
+		// column < value bounds the column from above, value < column from below
+		_, inclusive := e.(*expression.LessThanOrEqual)
+		if flipped {
+			names, err = scheme.PartitionsForRange(v, inclusive, nil, false)
+		} else {
+			names, err = scheme.PartitionsForRange(nil, false, v, inclusive)
+		}
+	default:
+		_, inclusive := e.(*expression.GreaterThanOrEqual)
+		if flipped {
+			names, err = scheme.PartitionsForRange(nil, false, v, inclusive)
+		} else {
+			names, err = scheme.PartitionsForRange(v, inclusive, nil, false)
+		}
+	}
+	return names, err == nil, err
+}
+
+// partitionValue returns the value of the literal given converted to the type of a partitioning expression, or false
+// if the conversion loses information, in which case partitions can't be pruned by it.
+func partitionValue(typ sql.Type, e sql.Expression) (interface{}, bool) {
+	v, err := e.Eval(nil, nil)
+	if err != nil {
+		return nil, false
+	}
+	if v == nil {
+		return nil, true
+	}
+
+	converted, err := typ.Convert(v)
+	if err != nil {
+		return nil, false
+	}
+
+	cmp, err := e.Type().Compare(v, converted)
+	if err != nil || cmp != 0 {
+		return nil, false
+	}
+	return converted, true
+}
+
+func isLiteral(e sql.Expression) bool {
+	_, ok := e.(*expression.Literal)
+	return ok
+}
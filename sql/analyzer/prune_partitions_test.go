@@ -0,0 +1,83 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestPrunePartitions(t *testing.T) {
+	schema := sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable"},
+		{Name: "t", Type: sql.Text, Source: "mytable"},
+	}
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	text := expression.NewGetFieldWithTable(1, sql.Text, "mytable", "t", false)
+
+	scheme, err := sql.NewPartitionScheme(sql.PartitionByRange, i, []sql.PartitionDefinition{
+		{Name: "p0", LessThan: 10},
+		{Name: "p1", LessThan: 20},
+		{Name: "p2"},
+	})
+	require.NoError(t, err)
+	table := memory.NewTableWithPartitionScheme("mytable", schema, scheme)
+
+	lessThan := expression.NewLessThan(i, expression.NewLiteral(int64(15), sql.Int64))
+	equals := expression.NewEquals(expression.NewLiteral(int8(12), sql.Int8), i)
+	other := expression.NewEquals(text, expression.NewLiteral("a", sql.LongText))
+	inexact := expression.NewGreaterThan(i, expression.NewLiteral(1.5, sql.Float64))
+
+	a := NewDefault(sql.NewDatabaseProvider())
+
+	tests := []analyzerFnTestCase{
+		{
+			name: "range predicate",
+			node: plan.NewFilter(expression.NewAnd(lessThan, other), plan.NewResolvedTable(table, nil, nil)),
+			expected: plan.NewFilter(
+				expression.NewAnd(lessThan, other),
+				plan.NewResolvedTable(table.WithPartitions([]string{"p0", "p1"}), nil, nil),
+			),
+		},
+		{
+			name: "predicates intersected",
+			node: plan.NewFilter(expression.NewAnd(lessThan, equals), plan.NewResolvedTable(table, nil, nil)),
+			expected: plan.NewFilter(
+				expression.NewAnd(lessThan, equals),
+				plan.NewResolvedTable(table.WithPartitions([]string{"p1"}), nil, nil),
+			),
+		},
+		{
+			name: "predicate on another column",
+			node: plan.NewFilter(other, plan.NewResolvedTable(table, nil, nil)),
+		},
+		{
+			name: "literal not exactly convertible",
+			node: plan.NewFilter(inexact, plan.NewResolvedTable(table, nil, nil)),
+		},
+		{
+			name: "table without partitioning",
+			node: plan.NewFilter(lessThan, plan.NewResolvedTable(memory.NewTable("mytable", schema), nil, nil)),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, a, getRule("prune_partitions"))
+}
@@ -162,7 +162,8 @@ func canDoPushdown(n sql.Node) bool {
 		return false
 	}
 
-	return true
+	// The right side of a lateral join is analyzed with the schema of the left side as its scope, which must not change.
+	return !hasLateralJoin(n)
 }
 
 // Pushing down a filter is incompatible with the secondary table in a Left or Right join. If we push a predicate on
@@ -421,8 +422,8 @@ func pushdownFiltersToTable(
 	var newTableNode sql.Node = tableNode
 
 	// Push any filters for this table onto the table itself if it's a sql.FilteredTable
-	if ft, ok := table.(sql.FilteredTable); ok && len(filters.availableFiltersForTable(ctx, tableNode.Name())) > 0 {
-		tableFilters := filters.availableFiltersForTable(ctx, tableNode.Name())
+	if ft, ok := table.(sql.FilteredTable); ok && len(tableSideFilters(filters.availableFiltersForTable(ctx, tableNode.Name()))) > 0 {
+		tableFilters := tableSideFilters(filters.availableFiltersForTable(ctx, tableNode.Name()))
 		handled := ft.HandledFilters(normalizeExpressions(ctx, tableAliases, tableFilters...))
 		filters.markFiltersHandled(handled...)
 
@@ -452,6 +453,30 @@ func pushdownFiltersToTable(
 	}
 }
 
+// tableSideFilters returns the filters given that a table can evaluate on the rows it stores. Those using TIMESTAMP
+// columns can't be, since the tables store TIMESTAMP values in UTC rather than in the time zone of the session.
+func tableSideFilters(filters []sql.Expression) []sql.Expression {
+	var result []sql.Expression
+	for _, f := range filters {
+		if !usesTimestampColumn(f) {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// usesTimestampColumn returns whether the expression given reads a TIMESTAMP column.
+func usesTimestampColumn(e sql.Expression) bool {
+	found := false
+	sql.Inspect(e, func(e sql.Expression) bool {
+		if gf, ok := e.(*expression.GetField); ok && gf.Type() == sql.Timestamp {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
 // pushdownFiltersToAboveTable introduces a filter node with the given predicate
 func pushdownFiltersToAboveTable(
 	ctx *sql.Context,
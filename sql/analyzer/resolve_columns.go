@@ -19,12 +19,13 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/internal/similartext"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -141,7 +142,7 @@ func (a availableNames) indexTable(alias, name string, nestingLevel int) {
 
 // nesting levels returns all levels present, from inner to outer
 func (a availableNames) nestingLevels() []int {
-	levels := make([]int, len(a))
+	levels := make([]int, 0, len(a))
 	for level := range a {
 		levels = append(levels, level)
 	}
@@ -212,16 +213,23 @@ func getNodeAvailableNames(n sql.Node, scope *Scope) availableNames {
 	for i, n := range append(append(([]sql.Node)(nil), n), scope.InnerToOuter()...) {
 		plan.Inspect(n, func(n sql.Node) bool {
 			switch n := n.(type) {
-			case *plan.SubqueryAlias, *plan.ResolvedTable, *plan.ValueDerivedTable:
+			case *plan.SubqueryAlias, *plan.ResolvedTable, *plan.ValueDerivedTable, *plan.JSONTable:
 				name := strings.ToLower(n.(sql.Nameable).Name())
 				names.indexTable(name, name, i)
 				return false
+			case *plan.LateralJoin:
+				name := strings.ToLower(n.Right.(sql.Nameable).Name())
+				names.indexTable(name, name, i)
+				return true
 			case *plan.TableAlias:
 				switch t := n.Child.(type) {
 				case *plan.ResolvedTable, *plan.UnresolvedTable, *plan.SubqueryAlias:
 					name := strings.ToLower(t.(sql.Nameable).Name())
 					alias := strings.ToLower(n.Name())
 					names.indexTable(alias, name, i)
+				case *plan.Pivot, *plan.Unpivot:
+					alias := strings.ToLower(n.Name())
+					names.indexTable(alias, alias, i)
 				}
 				return false
 			}
@@ -326,13 +334,41 @@ func qualifyExpression(e sql.Expression, symbols availableNames) (sql.Expression
 	default:
 		// If any other kind of expression has a star, just replace it
 		// with an unqualified star because it cannot be expanded.
-		return expression.TransformUp(e, func(e sql.Expression) (sql.Expression, error) {
-			if _, ok := e.(*expression.Star); ok {
-				return expression.NewStar(), nil
-			}
-			return e, nil
-		})
+		return unqualifyStars(e)
+	}
+}
+
+// unqualifyStars replaces the stars of the expression given with unqualified stars, but for the stars of row hashes,
+// which expand them, qualified ones included.
+func unqualifyStars(e sql.Expression) (sql.Expression, error) {
+	e, _, err := unqualifyStarsIn(e)
+	return e, err
+}
+
+func unqualifyStarsIn(e sql.Expression) (sql.Expression, bool, error) {
+	switch e.(type) {
+	case *expression.Star:
+		return expression.NewStar(), true, nil
+	case *function.RowHash:
+		return e, false, nil
+	}
+
+	children := e.Children()
+	newChildren := make([]sql.Expression, len(children))
+	var changed bool
+	for i, child := range children {
+		c, ok, err := unqualifyStarsIn(child)
+		if err != nil {
+			return nil, false, err
+		}
+		newChildren[i] = c
+		changed = changed || ok
 	}
+	if !changed {
+		return e, false, nil
+	}
+	e, err := e.WithChildren(newChildren...)
+	return e, true, err
 }
 
 func getColumnsInNodes(nodes []sql.Node, names availableNames, nestingLevel int) {
@@ -351,16 +387,26 @@ func getColumnsInNodes(nodes []sql.Node, names availableNames, nestingLevel int)
 
 	for _, node := range nodes {
 		switch n := node.(type) {
-		case *plan.TableAlias, *plan.ResolvedTable, *plan.SubqueryAlias, *plan.ValueDerivedTable:
+		case *plan.TableAlias, *plan.ResolvedTable, *plan.SubqueryAlias, *plan.ValueDerivedTable, *plan.JSONTable:
 			for _, col := range n.Schema() {
 				names.indexColumn(col.Source, col.Name, nestingLevel)
 			}
+		case *plan.LateralJoin:
+			// The columns of the right side are only known once it's resolved
+			nodes := []sql.Node{n.Child}
+			if n.Right.Resolved() {
+				nodes = append(nodes, n.Right)
+			}
+			getColumnsInNodes(nodes, names, nestingLevel)
 		case *plan.Project:
 			indexExpressions(n.Projections)
 		case *plan.GroupBy:
 			indexExpressions(n.SelectedExprs)
 		case *plan.Window:
 			indexExpressions(n.SelectExprs)
+		case *plan.Union:
+			// The columns of a set operation are named after its left side
+			getColumnsInNodes([]sql.Node{n.Left()}, names, nestingLevel)
 		default:
 			getColumnsInNodes(n.Children(), names, nestingLevel)
 		}
@@ -404,6 +450,11 @@ func resolveColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sq
 			return nil, err
 		}
 
+		n, err = inlineSelectAliases(a, n, columns)
+		if err != nil {
+			return nil, err
+		}
+
 		return plan.TransformExpressionsWithNode(n, func(n sql.Node, e sql.Expression) (sql.Expression, error) {
 			uc, ok := e.(column)
 			if !ok || e.Resolved() {
@@ -415,6 +466,89 @@ func resolveColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sq
 	})
 }
 
+// inlineSelectAliases replaces the references to aliases defined earlier in the select expressions of the node given
+// with the expressions they alias, so that `SELECT a + 1 AS b, b * 2 AS c` is resolved as
+// `SELECT a + 1 AS b, (a + 1) * 2 AS c`. This is an extension to MySQL, and only applies to names that don't match any
+// column of the node's children or outer scopes. Non-deterministic expressions aren't inlined, since evaluating them
+// again could return a different value than the one of the alias.
+func inlineSelectAliases(a *Analyzer, n sql.Node, columns map[tableCol]indexedCol) (sql.Node, error) {
+	var exprs []sql.Expression
+	switch n := n.(type) {
+	case *plan.Project:
+		exprs = n.Projections
+	case *plan.GroupBy:
+		exprs = n.SelectedExprs
+	case *plan.Window:
+		exprs = n.SelectExprs
+	default:
+		return n, nil
+	}
+
+	aliases := make(map[string]sql.Expression)
+	inlined := make([]sql.Expression, len(exprs))
+	var changed bool
+	for i, e := range exprs {
+		e, err := expression.TransformUp(e, func(e sql.Expression) (sql.Expression, error) {
+			col, ok := e.(column)
+			if !ok || e.Resolved() || col.Table() != "" {
+				return e, nil
+			}
+
+			name := strings.ToLower(col.Name())
+			if _, ok := columns[tableCol{col: name}]; ok {
+				return e, nil
+			}
+
+			aliased, ok := aliases[name]
+			if !ok {
+				return e, nil
+			}
+
+			a.Log("inlining alias %s", name)
+			changed = true
+			return aliased, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		inlined[i] = e
+		if alias, ok := e.(*expression.Alias); ok && isDeterministicExpression(alias.Child) {
+			aliases[strings.ToLower(alias.Name())] = alias.Child
+		}
+	}
+
+	if !changed {
+		return n, nil
+	}
+
+	switch n := n.(type) {
+	case *plan.Project:
+		return plan.NewProject(inlined, n.Child), nil
+	case *plan.GroupBy:
+		return plan.NewGroupBy(inlined, n.GroupByExprs, n.Child).WithGroupingSets(n.GroupingSets), nil
+	default:
+		return plan.NewWindow(inlined, n.(*plan.Window).Child), nil
+	}
+}
+
+// isDeterministicExpression returns whether the expression given always returns the same result for the same row.
+func isDeterministicExpression(e sql.Expression) bool {
+	res := true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		if s, ok := e.(*plan.Subquery); ok {
+			if !isDeterminstic(s.Query) {
+				res = false
+			}
+			return false
+		} else if nd, ok := e.(sql.NonDeterministicExpression); ok && nd.IsNonDeterministic() {
+			res = false
+		}
+		return res
+	})
+	return res
+}
+
 // indexColumns returns a map of column identifiers to their index in the node's schema. Columns from outer scopes are
 // included as well, with lower indexes (prepended to node schema) but lower precedence (overwritten by inner nodes in
 // map)
@@ -626,12 +760,31 @@ func pushdownGroupByAliases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sc
 			}
 		}
 
+		// Names in the grouping refer to the columns of the tables in the FROM clause before the aliases of the
+		// selected expressions, so aliases shadowing one of those columns are not pushed down, unless the grouping
+		// has the aliased expression itself, as it does when grouping by the position of the selected expression.
+		childColumns := make(availableNames)
+		getColumnsInNodes([]sql.Node{g.Child}, childColumns, 0)
+
+		var groupingExprs = make(map[string]struct{})
+		for _, g := range g.GroupByExprs {
+			groupingExprs[g.String()] = struct{}{}
+		}
+
+		groupedByAlias := func(alias *expression.Alias) (byName bool, byExpr bool) {
+			name := strings.ToLower(alias.Name())
+			_, ok := groupingColumns[name]
+			byName = ok && len(childColumns.tablesForColumnAtLevel(name, 0)) == 0
+			_, byExpr = groupingExprs[alias.Child.String()]
+			return byName, byExpr
+		}
+
 		var selectedColumns = make(map[string]struct{})
 		for _, agg := range g.SelectedExprs {
 			// This alias is going to be pushed down, so don't bother gathering
 			// its requirements.
-			if alias, ok := agg.(*expression.Alias); ok {
-				if _, ok := groupingColumns[strings.ToLower(alias.Name())]; ok {
+			if alias, ok := agg.(*expression.Alias); ok && !containsAggregation(alias) {
+				if byName, byExpr := groupedByAlias(alias); byName || byExpr {
 					continue
 				}
 			}
@@ -661,11 +814,13 @@ func pushdownGroupByAliases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sc
 			// Only if the alias is required in the grouping set needsReorder
 			// to true. If it's not required, there's no need for a reorder if
 			// no other alias is required.
-			_, ok = groupingColumns[name]
-			if ok {
+			byName, byExpr := groupedByAlias(alias)
+			if byName || byExpr {
 				aliases[name] = len(newSelectedExprs)
 				needsReorder = true
-				delete(groupingColumns, name)
+				if byName {
+					delete(groupingColumns, name)
+				}
 
 				projection = append(projection, expr)
 				replacedAliases[alias.Child.String()] = alias.Name()
@@ -749,7 +904,7 @@ func pushdownGroupByAliases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sc
 		return plan.NewGroupBy(
 			newSelectedExprs, newGroupBys,
 			plan.NewProject(projection, g.Child),
-		), nil
+		).WithGroupingSets(g.GroupingSets), nil
 	})
 }
 
@@ -79,9 +79,9 @@ func TestMisusedAlias(t *testing.T) {
 
 	node := plan.NewProject(
 		[]sql.Expression{
-			expression.NewAlias("alias_i", uc("i")),
 			// like most missing column error cases, this error takes 2 passes to manifest and gets deferred on the first pass
 			&deferredColumn{uc("alias_i")},
+			expression.NewAlias("alias_i", uc("i")),
 		},
 		plan.NewResolvedTable(table, nil, nil),
 	)
@@ -90,6 +90,42 @@ func TestMisusedAlias(t *testing.T) {
 	require.EqualError(err, sql.ErrMisusedAlias.New("alias_i").Error())
 }
 
+func TestInlineSelectAliases(t *testing.T) {
+	require := require.New(t)
+	f := getRule("resolve_columns")
+
+	table := memory.NewTable("mytable", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable"},
+	})
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	one := expression.NewLiteral(int8(1), sql.Int8)
+
+	node := plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("x", expression.NewPlus(uqc("mytable", "i"), one)),
+			expression.NewAlias("y", expression.NewPlus(uc("x"), one)),
+			expression.NewAlias("z", expression.NewPlus(uc("Y"), uc("x"))),
+		},
+		plan.NewResolvedTable(table, nil, nil),
+	)
+
+	result, err := f.Apply(sql.NewEmptyContext(), nil, node, nil)
+	require.NoError(err)
+
+	x := expression.NewPlus(i, one)
+	y := expression.NewPlus(x, one)
+	expected := plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("x", x),
+			expression.NewAlias("y", y),
+			expression.NewAlias("z", expression.NewPlus(y, x)),
+		},
+		plan.NewResolvedTable(table, nil, nil),
+	)
+	require.Equal(expected, result)
+}
+
 func TestQualifyVariables(t *testing.T) {
 	assert := assert.New(t)
 	f := getRule("qualify_columns")
@@ -667,4 +703,24 @@ func TestPushdownGroupByAliases(t *testing.T) {
 	require.NoError(err)
 
 	require.Equal(expected, result)
+
+	// Grouping by a name that is both an alias and a column of the table groups by the column
+	table := memory.NewTable("table", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "table"},
+		{Name: "b", Type: sql.Int64, Source: "table"},
+	})
+	node = plan.NewGroupBy(
+		[]sql.Expression{
+			expression.NewAlias("b", uc("a")),
+		},
+		[]sql.Expression{
+			uc("b"),
+		},
+		plan.NewResolvedTable(table, nil, nil),
+	)
+
+	result, err = pushdownGroupByAliases(sql.NewEmptyContext(), a, node, nil)
+	require.NoError(err)
+
+	require.Equal(node, result)
 }
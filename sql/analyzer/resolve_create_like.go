@@ -64,6 +64,15 @@ func resolveCreateLike(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 			})
 		}
 	}
+	checks, err := loadChecksFromTable(ctx, likeTable)
+	if err != nil {
+		return nil, err
+	}
+	// Check constraint names are unique in a database, so the copies get names generated for the new table
+	for _, check := range checks {
+		check.Name = ""
+	}
+
 	origSch := likeTable.Schema()
 	newSch := make(sql.Schema, len(origSch))
 	for i, col := range origSch {
@@ -75,6 +84,7 @@ func resolveCreateLike(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 	tableSpec := &plan.TableSpec{
 		Schema:  newSch,
 		IdxDefs: idxDefs,
+		ChDefs:  checks,
 	}
 
 	return plan.NewCreateTable(planCreate.Database(), planCreate.Name(), planCreate.IfNotExists(), planCreate.Temporary(), tableSpec), nil
@@ -2,6 +2,7 @@ package analyzer
 
 import (
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -36,7 +37,41 @@ func resolveCreateSelect(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 		return nil, err
 	}
 
-	return plan.NewTableCopier(planCreate.Database(), stripQueryProcess(analyzedCreate), stripQueryProcess(analyzedSelect), plan.CopierProps{}), nil
+	analyzedCreate = stripQueryProcess(analyzedCreate)
+	source := stripQueryProcess(analyzedSelect)
+	if len(inputSpec.Schema) > 0 {
+		source = projectOnCreatedTable(analyzedCreate.Schema(), source)
+	}
+
+	return plan.NewTableCopier(planCreate.Database(), analyzedCreate, source, plan.CopierProps{}), nil
+}
+
+// projectOnCreatedTable projects the rows of the select given on the schema of the table created with them. The
+// columns declared by the CREATE TABLE statement come first in the table, so the columns of the select are matched by
+// name, and the declared columns that aren't selected take their default value.
+func projectOnCreatedTable(tableSchema sql.Schema, selectNode sql.Node) sql.Node {
+	selectSchema := selectNode.Schema()
+	projections := make([]sql.Expression, len(tableSchema))
+	for i, col := range tableSchema {
+		for j, selectCol := range selectSchema {
+			if col.Name == selectCol.Name {
+				projections[i] = expression.NewGetField(j, selectCol.Type, selectCol.Name, selectCol.Nullable)
+				break
+			}
+		}
+
+		if projections[i] != nil {
+			continue
+		}
+
+		if col.Default != nil {
+			projections[i] = col.Default
+		} else {
+			projections[i] = expression.NewLiteral(nil, col.Type)
+		}
+	}
+
+	return plan.NewProject(projections, selectNode)
 }
 
 // mergeSchemas takes in the table spec of the CREATE TABLE and merges it with the schema used by the
@@ -0,0 +1,86 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestCreateSelectWithDeclaredColumns(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	src := memory.NewTable("src", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "src", PrimaryKey: true},
+		{Name: "b", Type: sql.Text, Source: "src"},
+	})
+	db.AddTable("src", src)
+
+	ctx := sql.NewEmptyContext().WithCurrentDB("mydb")
+	for _, row := range []sql.Row{{int64(1), "one"}, {int64(2), "two"}} {
+		require.NoError(src.Insert(ctx, row))
+	}
+
+	// CREATE TABLE dst (c BIGINT, a BIGINT PRIMARY KEY) SELECT b, a FROM src
+	create := plan.NewCreateTableSelect(
+		db,
+		"dst",
+		plan.NewProject(
+			[]sql.Expression{expression.NewUnresolvedColumn("b"), expression.NewUnresolvedColumn("a")},
+			plan.NewUnresolvedTable("src", ""),
+		),
+		&plan.TableSpec{Schema: sql.Schema{
+			{Name: "c", Type: sql.Int64, Nullable: true},
+			{Name: "a", Type: sql.Int64, PrimaryKey: true},
+		}},
+		plan.IfNotExistsOption(false),
+		plan.TempTableOption(false),
+	)
+
+	a := NewDefault(memory.NewMemoryDBProvider(db))
+	analyzed, err := a.Analyze(ctx, create, nil)
+	require.NoError(err)
+
+	iter, err := analyzed.RowIter(ctx, nil)
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	dst, ok, err := db.GetTableInsensitive(ctx, "dst")
+	require.NoError(err)
+	require.True(ok)
+
+	var names []string
+	for _, col := range dst.Schema() {
+		names = append(names, col.Name)
+	}
+	require.Equal([]string{"c", "a", "b"}, names)
+
+	iter, err = plan.NewResolvedTable(dst, db, nil).RowIter(ctx, nil)
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.ElementsMatch([]sql.Row{
+		{nil, int64(1), "one"},
+		{nil, int64(2), "two"},
+	}, rows)
+}
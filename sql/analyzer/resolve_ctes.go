@@ -195,7 +195,7 @@ func liftCommonTableExpressions(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
 		if union, isUnion := n.(*plan.Union); isUnion {
 			if cte, isCTE := union.Left().(*plan.With); isCTE {
-				return plan.NewWith(plan.NewUnion(cte.Child, union.Right()), cte.CTEs), nil
+				return plan.NewWith(plan.NewSetOp(union.Op, union.Distinct, cte.Child, union.Right()), cte.CTEs), nil
 			}
 			l, err := liftCommonTableExpressions(ctx, a, union.Left(), scope)
 			if err != nil {
@@ -206,9 +206,9 @@ func liftCommonTableExpressions(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 				return nil, err
 			}
 			if _, isCTE := l.(*plan.With); isCTE {
-				return liftCommonTableExpressions(ctx, a, plan.NewUnion(l, r), scope)
+				return liftCommonTableExpressions(ctx, a, plan.NewSetOp(union.Op, union.Distinct, l, r), scope)
 			}
-			return plan.NewUnion(l, r), nil
+			return plan.NewSetOp(union.Op, union.Distinct, l, r), nil
 		}
 		if distinct, isDistinct := n.(*plan.Distinct); isDistinct {
 			if cte, isCTE := distinct.Child.(*plan.With); isCTE {
@@ -47,7 +47,7 @@ func resolveDatabase(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 			return n, nil
 		}
 
-		db, err := a.Catalog.Database(dbName)
+		db, err := sql.GetDatabase(ctx, a.Catalog, dbName)
 		if err != nil {
 			return nil, err
 		}
@@ -15,8 +15,12 @@
 package analyzer
 
 import (
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation/window"
+	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -46,7 +50,7 @@ func resolveFunctionsInExpr(ctx *sql.Context, a *Analyzer) sql.TransformExprFunc
 		}
 
 		n := uf.Name()
-		f, err := a.Catalog.Function(n)
+		f, err := resolveFunction(ctx, a, uf)
 		if err != nil {
 			return nil, err
 		}
@@ -64,9 +68,85 @@ func resolveFunctionsInExpr(ctx *sql.Context, a *Analyzer) sql.TransformExprFunc
 			if err != nil {
 				return nil, err
 			}
+		} else if agg, ok := rf.(sql.Aggregation); ok && isNonEmptyWindow(uf.Window) {
+			// Aggregations over an empty OVER () are evaluated over all the rows by the window node itself.
+			rf = window.NewAggregate(agg, uf.Window)
 		}
 
 		a.Log("resolved function %q", n)
 		return rf, nil
 	}
 }
+
+// resolveFunction returns the function called by the unresolved function given. Functions qualified with a database are
+// the functions of that database. Unqualified ones are the global functions of the catalog, or the functions of the
+// current database if there's no global function with their name.
+func resolveFunction(ctx *sql.Context, a *Analyzer, uf *expression.UnresolvedFunction) (sql.Function, error) {
+	if uf.Database != "" {
+		return databaseFunction(ctx, a, uf.Database, uf.Name())
+	}
+
+	f, err := a.Catalog.Function(uf.Name())
+	if err == nil || !sql.ErrFunctionNotFound.Is(err) {
+		return f, err
+	}
+
+	if db := ctx.GetCurrentDatabase(); db != "" {
+		if df, dbErr := databaseFunction(ctx, a, db, uf.Name()); dbErr == nil {
+			return df, nil
+		} else if !sql.ErrFunctionNotFound.Is(dbErr) && !sql.ErrDatabaseNotFound.Is(dbErr) {
+			return nil, dbErr
+		}
+	}
+	return nil, err
+}
+
+// databaseFunction returns the function with the name given of the database given.
+func databaseFunction(ctx *sql.Context, a *Analyzer, dbName, name string) (sql.Function, error) {
+	db, err := a.Catalog.Database(dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	if fdb, ok := db.(sql.FunctionDatabase); ok {
+		f, err := fdb.Function(ctx, name)
+		if err == nil || !sql.ErrFunctionNotFound.Is(err) {
+			return f, err
+		}
+	}
+
+	if udb, ok := db.(sql.UserFunctionDatabase); ok {
+		return userFunction(ctx, udb, name)
+	}
+	return nil, sql.ErrFunctionNotFound.New(dbName + "." + name)
+}
+
+// userFunction returns the user-defined function with the name given of the database given, built from its CREATE
+// FUNCTION statement.
+func userFunction(ctx *sql.Context, db sql.UserFunctionDatabase, name string) (sql.Function, error) {
+	ufds, err := db.GetUserFunctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ufd := range ufds {
+		if !strings.EqualFold(ufd.Name, name) {
+			continue
+		}
+		parsed, err := parse.Parse(ctx, ufd.CreateStatement)
+		if err != nil {
+			return nil, err
+		}
+		cf, ok := parsed.(*plan.CreateFunction)
+		if !ok {
+			return nil, sql.ErrFunctionCreateStatementInvalid.New(ufd.CreateStatement)
+		}
+		return cf.Function, nil
+	}
+	return nil, sql.ErrFunctionNotFound.New(db.Name() + "." + name)
+}
+
+// isNonEmptyWindow returns whether the window given has a partition, an order or a frame.
+func isNonEmptyWindow(w *sql.Window) bool {
+	return w != nil && (len(w.PartitionBy) > 0 || len(w.OrderBy) > 0 || w.Frame != nil)
+}
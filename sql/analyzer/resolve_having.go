@@ -189,7 +189,7 @@ func addColumnsToGroupBy(node sql.Node, columns []sql.Expression) (sql.Node, err
 		}
 		return node.WithChildren(child)
 	case *plan.GroupBy:
-		return plan.NewGroupBy(append(node.SelectedExprs, columns...), node.GroupByExprs, node.Child), nil
+		return plan.NewGroupBy(append(node.SelectedExprs, columns...), node.GroupByExprs, node.Child).WithGroupingSets(node.GroupingSets), nil
 	default:
 		return nil, errHavingNeedsGroupBy.New()
 	}
@@ -0,0 +1,75 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// resolveLateralJoins analyzes the right side of lateral joins with the left side of the join as its outer scope, so
+// that it can reference the columns of the tables preceding it. This is done once the left side is resolved, since
+// its schema is needed to resolve the outer columns.
+func resolveLateralJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, ctx := ctx.Span("resolve_lateral_joins")
+	defer span.Finish()
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		j, ok := n.(*plan.LateralJoin)
+		if !ok || !j.Child.Resolved() || j.Right.Resolved() {
+			return n, nil
+		}
+
+		subqueryCtx, cancelFunc := ctx.NewSubContext()
+		defer cancelFunc()
+		subScope := scope.newScope(j)
+
+		switch right := j.Right.(type) {
+		case *plan.SubqueryAlias:
+			child, err := a.Analyze(subqueryCtx, right.Child, subScope)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(right.Columns) > 0 && schemaLength(child) != len(right.Columns) {
+				return nil, sql.ErrColumnCountMismatch.New()
+			}
+
+			sa, err := right.WithChildren(stripQueryProcess(child))
+			if err != nil {
+				return nil, err
+			}
+			return j.WithRight(sa), nil
+		default:
+			analyzed, err := a.Analyze(subqueryCtx, right, subScope)
+			if err != nil {
+				return nil, err
+			}
+			return j.WithRight(stripQueryProcess(analyzed)), nil
+		}
+	})
+}
+
+// hasLateralJoin returns whether the node given contains a lateral join.
+func hasLateralJoin(n sql.Node) bool {
+	var found bool
+	plan.Inspect(n, func(n sql.Node) bool {
+		if _, ok := n.(*plan.LateralJoin); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -138,7 +138,7 @@ func reorderSort(sort *plan.Sort, missingCols []string) (sql.Node, error) {
 			expressions,
 			plan.NewSort(
 				sort.SortFields,
-				plan.NewGroupBy(newExpressions, child.GroupByExprs, child.Child),
+				plan.NewGroupBy(newExpressions, child.GroupByExprs, child.Child).WithGroupingSets(child.GroupingSets),
 			),
 		), nil
 	case *plan.Window:
@@ -161,18 +161,18 @@ func pushSortDown(sort *plan.Sort) (sql.Node, error) {
 	case *plan.Project:
 		return plan.NewProject(
 			child.Projections,
-			plan.NewSort(sort.SortFields, child.Child),
+			plan.NewSort(sortFieldsForSchema(sort.SortFields, child.Child.Schema()), child.Child),
 		), nil
 	case *plan.GroupBy:
 		return plan.NewGroupBy(
 			child.SelectedExprs,
 			child.GroupByExprs,
-			plan.NewSort(sort.SortFields, child.Child),
-		), nil
+			plan.NewSort(sortFieldsForSchema(sort.SortFields, child.Child.Schema()), child.Child),
+		).WithGroupingSets(child.GroupingSets), nil
 	case *plan.Window:
 		return plan.NewWindow(
 			child.SelectExprs,
-			plan.NewSort(sort.SortFields, child.Child),
+			plan.NewSort(sortFieldsForSchema(sort.SortFields, child.Child.Schema()), child.Child),
 		), nil
 	case *plan.ResolvedTable:
 		return sort, nil
@@ -193,6 +193,32 @@ func pushSortDown(sort *plan.Sort) (sql.Node, error) {
 	}
 }
 
+// sortFieldsForSchema returns the sort fields given with the indexes of their resolved columns pointing to the same
+// columns in the schema given, which is the one of the node the sort was pushed down to. Most plans get their field
+// indexes fixed once the columns are pruned, but not all of them are.
+func sortFieldsForSchema(sortFields []sql.SortField, schema sql.Schema) []sql.SortField {
+	fields := make([]sql.SortField, len(sortFields))
+	for i, f := range sortFields {
+		// The transformation never fails
+		column, _ := expression.TransformUp(f.Column, func(e sql.Expression) (sql.Expression, error) {
+			gf, ok := e.(*expression.GetField)
+			if !ok {
+				return e, nil
+			}
+			for idx, col := range schema {
+				if strings.EqualFold(col.Source, gf.Table()) && strings.EqualFold(col.Name, gf.Name()) {
+					return gf.WithIndex(idx), nil
+				}
+			}
+			return e, nil
+		})
+
+		fields[i] = f
+		fields[i].Column = column
+	}
+	return fields
+}
+
 func resolveOrderByLiterals(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
 		sort, ok := n.(*plan.Sort)
@@ -116,9 +116,9 @@ func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 				}
 				hasdiff = true
 
-				// TODO: Principled type coercion...
-				les[i] = expression.NewConvert(les[i], expression.ConvertToChar)
-				res[i] = expression.NewConvert(res[i], expression.ConvertToChar)
+				castTo := unionConvertType(ls[i].Type, rs[i].Type)
+				les[i] = expression.NewConvert(les[i], castTo)
+				res[i] = expression.NewConvert(res[i], castTo)
 
 				// Preserve schema names across the conversion.
 				les[i] = expression.NewAlias(ls[i].Name, les[i])
@@ -136,3 +136,25 @@ func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 		return n, nil
 	})
 }
+
+// unionConvertType returns the type both sides of a union column are converted to when their types differ. Numbers
+// keep being numbers, using the narrowest conversion that holds the values of both sides, and everything else is
+// converted to strings.
+// TODO: Principled type coercion for temporal types and NULL columns
+func unionConvertType(l, r sql.Type) string {
+	switch {
+	case !sql.IsNumber(l) || !sql.IsNumber(r):
+		return expression.ConvertToChar
+	case sql.IsFloat(l) || sql.IsFloat(r):
+		return expression.ConvertToDouble
+	case sql.IsDecimal(l) || sql.IsDecimal(r):
+		return expression.ConvertToDecimal
+	case sql.IsUnsigned(l) && sql.IsUnsigned(r):
+		return expression.ConvertToUnsigned
+	case l == sql.Uint64 || r == sql.Uint64:
+		// Signed and unsigned 64 bit values only fit together in a decimal
+		return expression.ConvertToDecimal
+	default:
+		return expression.ConvertToSigned
+	}
+}
@@ -87,7 +87,7 @@ func TestMergeUnionSchemas(t *testing.T) {
 			errors.New("this is an error"),
 		},
 		{
-			"Mismatched Types Coerced to Strings",
+			"Mismatched Integer Types Coerced to Signed",
 			plan.NewUnion(
 				plan.NewProject(
 					[]sql.Expression{expression.NewLiteral(int64(1), sql.Int64)},
@@ -102,7 +102,7 @@ func TestMergeUnionSchemas(t *testing.T) {
 				plan.NewProject(
 					[]sql.Expression{
 						expression.NewAlias("1", expression.NewConvert(
-							expression.NewGetField(0, sql.Int64, "1", false), "char")),
+							expression.NewGetField(0, sql.Int64, "1", false), "signed")),
 					},
 					plan.NewProject(
 						[]sql.Expression{expression.NewLiteral(int64(1), sql.Int64)},
@@ -112,7 +112,7 @@ func TestMergeUnionSchemas(t *testing.T) {
 				plan.NewProject(
 					[]sql.Expression{
 						expression.NewAlias("3", expression.NewConvert(
-							expression.NewGetField(0, sql.Int32, "3", false), "char")),
+							expression.NewGetField(0, sql.Int32, "3", false), "signed")),
 					},
 					plan.NewProject(
 						[]sql.Expression{expression.NewLiteral(int32(3), sql.Int32)},
@@ -122,6 +122,42 @@ func TestMergeUnionSchemas(t *testing.T) {
 			),
 			nil,
 		},
+		{
+			"Mismatched Types Coerced to Strings",
+			plan.NewUnion(
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral(int64(1), sql.Int64)},
+					plan.NewResolvedTable(dualTable, nil, nil),
+				),
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral("3", sql.LongText)},
+					plan.NewResolvedTable(dualTable, nil, nil),
+				),
+			),
+			plan.NewUnion(
+				plan.NewProject(
+					[]sql.Expression{
+						expression.NewAlias("1", expression.NewConvert(
+							expression.NewGetField(0, sql.Int64, "1", false), "char")),
+					},
+					plan.NewProject(
+						[]sql.Expression{expression.NewLiteral(int64(1), sql.Int64)},
+						plan.NewResolvedTable(dualTable, nil, nil),
+					),
+				),
+				plan.NewProject(
+					[]sql.Expression{
+						expression.NewAlias(`"3"`, expression.NewConvert(
+							expression.NewGetField(0, sql.LongText, `"3"`, false), "char")),
+					},
+					plan.NewProject(
+						[]sql.Expression{expression.NewLiteral("3", sql.LongText)},
+						plan.NewResolvedTable(dualTable, nil, nil),
+					),
+				),
+			),
+			nil,
+		},
 	}
 	for _, c := range testCases {
 		t.Run(c.name, func(t *testing.T) {
@@ -32,48 +32,15 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.
 			return n, nil
 		}
 
-		viewName := urt.Name()
-		dbName := urt.Database
-		if dbName == "" {
-			dbName = ctx.GetCurrentDatabase()
-		}
-
-		var view *sql.View
-
-		if dbName != "" {
-			db, err := a.Catalog.Database(dbName)
-			if err != nil {
-				return nil, err
-			}
-
-			if vdb, ok := db.(sql.ViewDatabase); ok {
-				viewDef, ok, err := vdb.GetView(ctx, viewName)
-				if err != nil {
-					return nil, err
-				}
-
-				if ok {
-					query, err := parse.Parse(ctx, viewDef)
-					if err != nil {
-						return nil, err
-					}
-
-					view = plan.NewSubqueryAlias(viewName, viewDef, query).AsView()
-				}
-			}
+		view, err := getView(ctx, a, urt)
+		if err != nil {
+			return nil, err
 		}
-
-		// If we didn't find the view from the database directly, use the in-session registry
-		var err error
 		if view == nil {
-			view, err = ctx.GetViewRegistry().View(dbName, viewName)
-			if sql.ErrViewDoesNotExist.Is(err) {
-				return n, nil
-			} else if err != nil {
-				return nil, err
-			}
+			return n, nil
 		}
 
+		viewName := urt.Name()
 		a.Log("view resolved: %q", viewName)
 
 		query := view.Definition().Children()[0]
@@ -98,6 +65,49 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.
 	})
 }
 
+// getView returns the view named by |urt|, looking first in its database and then in the session's view registry.
+// Returns nil if there is no such view.
+func getView(ctx *sql.Context, a *Analyzer, urt *plan.UnresolvedTable) (*sql.View, error) {
+	viewName := urt.Name()
+	dbName := urt.Database
+	if dbName == "" {
+		dbName = ctx.GetCurrentDatabase()
+	}
+
+	if dbName != "" {
+		db, err := sql.GetDatabase(ctx, a.Catalog, dbName)
+		if err != nil {
+			return nil, err
+		}
+
+		if vdb, ok := db.(sql.ViewDatabase); ok {
+			viewDef, ok, err := vdb.GetView(ctx, viewName)
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				query, err := parse.Parse(ctx, viewDef)
+				if err != nil {
+					return nil, err
+				}
+
+				return plan.NewSubqueryAlias(viewName, viewDef, query).AsView(), nil
+			}
+		}
+	}
+
+	// If we didn't find the view from the database directly, use the in-session registry
+	view, err := ctx.GetViewRegistry().View(dbName, viewName)
+	if sql.ErrViewDoesNotExist.Is(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return view, nil
+}
+
 func applyAsOfToView(n sql.Node, a *Analyzer, asOf sql.Expression) (sql.Node, error) {
 	a.Log("applying AS OF clause to view definition")
 
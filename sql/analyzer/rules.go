@@ -25,6 +25,7 @@ var OnceBeforeDefault = []Rule{
 	{"load_stored_procedures", loadStoredProcedures},
 	{"resolve_variables", resolveVariables},
 	{"resolve_set_variables", resolveSetVariables},
+	{"resolve_updatable_views", resolveUpdatableViews},
 	{"resolve_views", resolveViews},
 	{"lift_common_table_expressions", liftCommonTableExpressions},
 	{"resolve_common_table_expressions", resolveCommonTableExpressions},
@@ -37,6 +38,7 @@ var OnceBeforeDefault = []Rule{
 	{"resolve_subqueries", resolveSubqueries},
 	{"resolve_unions", resolveUnions},
 	{"resolve_describe_query", resolveDescribeQuery},
+	{"resolve_into_outfile", resolveIntoOutfile},
 	{"check_unique_table_names", checkUniqueTableNames},
 	{"resolve_declarations", resolveDeclarations},
 	{"validate_create_trigger", validateCreateTrigger},
@@ -55,6 +57,7 @@ var DefaultRules = []Rule{
 	{"pushdown_sort", pushdownSort},
 	{"pushdown_groupby_aliases", pushdownGroupByAliases},
 	{"pushdown_subquery_alias_filters", pushdownSubqueryAliasFilters},
+	{"resolve_lateral_joins", resolveLateralJoins},
 	{"qualify_columns", qualifyColumns},
 	{"resolve_columns", resolveColumns},
 	{"validate_check_constraint", validateCreateCheck},
@@ -68,6 +71,7 @@ var DefaultRules = []Rule{
 	{"resolve_subquery_exprs", resolveSubqueryExpressions},
 	{"move_join_conds_to_filter", moveJoinConditionsToFilter},
 	{"eval_filter", evalFilter},
+	{"prune_empty_results", pruneEmptyResults},
 	{"optimize_distinct", optimizeDistinct},
 }
 
@@ -81,13 +85,22 @@ var OnceAfterDefault = []Rule{
 	{"resolve_column_defaults", resolveColumnDefaults},
 	{"resolve_generators", resolveGenerators},
 	{"remove_unnecessary_converts", removeUnnecessaryConverts},
+	{"infer_constraints", inferConstraints},
 	{"assign_catalog", assignCatalog},
 	{"prune_columns", pruneColumns},
+	{"apply_merge_joins", applyMergeJoins},
 	{"optimize_joins", constructJoinPlan},
 	{"pushdown_filters", pushdownFilters},
+	{"apply_ordered_group_by", applyOrderedGroupBy},
 	{"subquery_indexes", applyIndexesFromOuterScope},
 	{"in_subquery_indexes", applyIndexesForSubqueryComparisons},
+	{"in_subquery_joins", convertInSubqueriesToJoins},
+	{"decorrelate_scalar_subqueries", decorrelateScalarSubqueries},
 	{"pushdown_projections", pushdownProjections},
+	{"apply_late_materialization", applyLateMaterialization},
+	{"pushdown_projected_expressions", pushdownProjectedExpressions},
+	{"apply_zone_maps", applyZoneMaps},
+	{"prune_partitions", prunePartitions},
 	{"set_join_scope_len", setJoinScopeLen},
 	{"erase_projection", eraseProjection},
 	// One final pass at analyzing subqueries to handle rewriting field indexes after changes to outer scope by
@@ -97,6 +110,7 @@ var OnceAfterDefault = []Rule{
 	{"cache_subquery_aliases_in_joins", cacheSubqueryAlisesInJoins},
 	{"apply_hash_lookups", applyHashLookups},
 	{"apply_hash_in", applyHashIn},
+	{"hash_wide_distinct", hashWideDistinct},
 	{"resolve_insert_rows", resolveInsertRows},
 	{"apply_triggers", applyTriggers},
 	{"apply_procedures", applyProcedures},
@@ -116,6 +116,9 @@ func getTable(node sql.Node) sql.Table {
 		case *plan.IndexedTableAccess:
 			table = n.Table
 			return false
+		case *plan.Pivot, *plan.Unpivot:
+			// The rows of a pivot aren't the rows of its table
+			return false
 		}
 		return true
 	})
@@ -18,11 +18,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/dolthub/vitess/go/vt/sqlparser"
-
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -155,7 +154,7 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 
 	// TODO: database should be dependent on the table being inserted / updated, but we don't have that info available
 	//  from the table object yet.
-	database, err := a.Catalog.Database(db)
+	database, err := sql.GetDatabase(ctx, a.Catalog, db)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,292 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// updatableView is a view simple enough that writes to it can be routed to its single base table: a projection of
+// plain columns, optionally filtered, over one table.
+type updatableView struct {
+	name    string
+	table   *plan.UnresolvedTable
+	alias   string
+	filter  sql.Expression
+	star    bool
+	columns []string
+	// baseColumns maps the lowercase name of each view column to the name of the base table column it projects
+	baseColumns map[string]string
+}
+
+// resolveUpdatableViews rewrites INSERT, UPDATE and DELETE statements whose target is a view into statements against
+// the view's base table. Views that aren't a simple projection of a single table can't be written to.
+func resolveUpdatableViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("resolve_updatable_views")
+	defer span.Finish()
+
+	switch n := n.(type) {
+	case *plan.InsertInto:
+		urt, ok := n.Destination.(*plan.UnresolvedTable)
+		if !ok {
+			return n, nil
+		}
+		statement := "INSERT"
+		if n.IsReplace {
+			statement = "REPLACE"
+		}
+		view, err := getUpdatableView(ctx, a, urt, statement)
+		if err != nil || view == nil {
+			return n, err
+		}
+		a.Log("insert into view %q routed to table %q", view.name, view.table.Name())
+		return view.rewriteInsert(n)
+	case *plan.Update:
+		urt, ok := getDMLTarget(n.Child)
+		if !ok {
+			return n, nil
+		}
+		view, err := getUpdatableView(ctx, a, urt, "UPDATE")
+		if err != nil || view == nil {
+			return n, err
+		}
+		a.Log("update of view %q routed to table %q", view.name, view.table.Name())
+		return view.rewriteTarget(n)
+	case *plan.DeleteFrom:
+		urt, ok := getDMLTarget(n.Child)
+		if !ok {
+			return n, nil
+		}
+		view, err := getUpdatableView(ctx, a, urt, "DELETE")
+		if err != nil || view == nil {
+			return n, err
+		}
+		a.Log("delete from view %q routed to table %q", view.name, view.table.Name())
+		return view.rewriteTarget(n)
+	default:
+		return n, nil
+	}
+}
+
+// getDMLTarget returns the table an UPDATE or DELETE writes to, if the statement has a single target.
+func getDMLTarget(n sql.Node) (*plan.UnresolvedTable, bool) {
+	switch n := n.(type) {
+	case *plan.UnresolvedTable:
+		return n, true
+	case *plan.UpdateSource:
+		return getDMLTarget(n.Child)
+	case *plan.Filter:
+		return getDMLTarget(n.Child)
+	case *plan.Sort:
+		return getDMLTarget(n.Child)
+	case *plan.Limit:
+		return getDMLTarget(n.Child)
+	case *plan.Offset:
+		return getDMLTarget(n.Child)
+	default:
+		return nil, false
+	}
+}
+
+// getUpdatableView returns the updatable view named by |urt|, nil if |urt| isn't a view, or an error if it's a view
+// that |statement| can't write to.
+func getUpdatableView(ctx *sql.Context, a *Analyzer, urt *plan.UnresolvedTable, statement string) (*updatableView, error) {
+	view, err := getView(ctx, a, urt)
+	if err != nil || view == nil {
+		return nil, err
+	}
+
+	query, err := parse.Parse(ctx, view.TextDefinition())
+	if err != nil {
+		return nil, err
+	}
+
+	uv, ok := newUpdatableView(view.Name(), query)
+	if !ok || urt.AsOf != nil {
+		return nil, sql.ErrViewNotUpdatable.New(view.Name(), statement)
+	}
+
+	if urt.Database != "" && uv.table.Database == "" {
+		uv.table, err = uv.table.WithDatabase(urt.Database)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return uv, nil
+}
+
+func newUpdatableView(name string, query sql.Node) (*updatableView, bool) {
+	project, ok := query.(*plan.Project)
+	if !ok {
+		return nil, false
+	}
+
+	view := &updatableView{
+		name:        name,
+		baseColumns: make(map[string]string),
+	}
+
+	child := project.Child
+	if filter, ok := child.(*plan.Filter); ok {
+		view.filter = filter.Expression
+		child = filter.Child
+	}
+
+	switch t := child.(type) {
+	case *plan.UnresolvedTable:
+		if t.AsOf != nil {
+			return nil, false
+		}
+		view.table = t
+		view.alias = t.Name()
+	case *plan.TableAlias:
+		urt, ok := t.Child.(*plan.UnresolvedTable)
+		if !ok || urt.AsOf != nil {
+			return nil, false
+		}
+		view.table = urt
+		view.alias = t.Name()
+	default:
+		return nil, false
+	}
+
+	for _, e := range project.Projections {
+		var viewCol string
+		var col *expression.UnresolvedColumn
+		switch e := e.(type) {
+		case *expression.Star:
+			if e.Table != "" && !strings.EqualFold(e.Table, view.alias) {
+				return nil, false
+			}
+			view.star = true
+			continue
+		case *expression.UnresolvedColumn:
+			col, viewCol = e, e.Name()
+		case *expression.Alias:
+			if col, ok = e.Child.(*expression.UnresolvedColumn); !ok {
+				return nil, false
+			}
+			viewCol = e.Name()
+		default:
+			return nil, false
+		}
+
+		if col.Table() != "" && !strings.EqualFold(col.Table(), view.alias) {
+			return nil, false
+		}
+		view.columns = append(view.columns, col.Name())
+		view.baseColumns[strings.ToLower(viewCol)] = col.Name()
+	}
+
+	return view, true
+}
+
+// baseColumn returns the name of the base table column that the view column |name| projects.
+func (v *updatableView) baseColumn(name string) (string, error) {
+	if col, ok := v.baseColumns[strings.ToLower(name)]; ok {
+		return col, nil
+	}
+	if v.star {
+		return name, nil
+	}
+	return "", sql.ErrColumnNotFound.New(name)
+}
+
+// qualify replaces the columns in |e| that belong to |table| with columns of the base table. When |mapColumns| is
+// true, the columns are view columns and are renamed to the base table columns they project.
+func (v *updatableView) qualify(e sql.Expression, table string, mapColumns bool) (sql.Expression, error) {
+	return expression.TransformUp(e, func(e sql.Expression) (sql.Expression, error) {
+		col, ok := e.(*expression.UnresolvedColumn)
+		if !ok {
+			return e, nil
+		}
+		if col.Table() != "" && !strings.EqualFold(col.Table(), table) {
+			return e, nil
+		}
+
+		name := col.Name()
+		if mapColumns {
+			var err error
+			if name, err = v.baseColumn(name); err != nil {
+				return nil, err
+			}
+		}
+		return expression.NewUnresolvedQualifiedColumn(v.table.Name(), name), nil
+	})
+}
+
+// rewriteTarget rewrites an UPDATE or DELETE of the view into one of its base table, restricted to the rows the view
+// selects.
+func (v *updatableView) rewriteTarget(n sql.Node) (sql.Node, error) {
+	n, err := plan.TransformExpressionsUp(n, func(e sql.Expression) (sql.Expression, error) {
+		return v.qualify(e, v.name, true)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		if _, ok := n.(*plan.UnresolvedTable); !ok {
+			return n, nil
+		}
+		if v.filter == nil {
+			return v.table, nil
+		}
+		filter, err := v.qualify(v.filter, v.alias, false)
+		if err != nil {
+			return nil, err
+		}
+		return plan.NewFilter(filter, v.table), nil
+	})
+}
+
+// rewriteInsert rewrites an INSERT into the view into one into its base table. Rows are written whether or not the
+// view's filter would select them, as MySQL does for views without a CHECK OPTION.
+func (v *updatableView) rewriteInsert(n *plan.InsertInto) (sql.Node, error) {
+	var columns []string
+	if len(n.ColumnNames) > 0 {
+		columns = make([]string, len(n.ColumnNames))
+		for i, name := range n.ColumnNames {
+			col, err := v.baseColumn(name)
+			if err != nil {
+				return nil, err
+			}
+			columns[i] = col
+		}
+	} else if !v.star {
+		columns = v.columns
+	}
+
+	node, err := plan.TransformExpressions(n, func(e sql.Expression) (sql.Expression, error) {
+		return v.qualify(e, v.name, true)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ii := *node.(*plan.InsertInto)
+	ii.Destination = v.table
+	ii.ColumnNames = columns
+	if v.table.Database != "" {
+		return ii.WithDatabase(sql.UnresolvedDatabase(v.table.Database))
+	}
+	return &ii, nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestResolveUpdatableViews(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	require.NoError(t, db.CreateView(ctx, "v", "select pk, a as val from base where a >= 20"))
+	require.NoError(t, db.CreateView(ctx, "vall", "select * from base b"))
+	require.NoError(t, db.CreateView(ctx, "vagg", "select count(*) as c from base"))
+
+	a := NewBuilder(sql.NewDatabaseProvider(db)).Build()
+
+	uc := expression.NewUnresolvedColumn
+	uqc := expression.NewUnresolvedQualifiedColumn
+	base := plan.NewUnresolvedTable("base", "")
+	viewFilter := expression.NewGreaterThanOrEqual(uqc("base", "a"), expression.NewLiteral(int8(20), sql.Int8))
+
+	testCases := []analyzerFnTestCase{
+		{
+			name: "update through a view",
+			node: plan.NewUpdate(
+				plan.NewFilter(
+					expression.NewEquals(uqc("v", "pk"), lit(2)),
+					plan.NewUnresolvedTable("v", ""),
+				),
+				[]sql.Expression{expression.NewSetField(uc("val"), lit(1))},
+			),
+			expected: plan.NewUpdate(
+				plan.NewFilter(
+					expression.NewEquals(uqc("base", "pk"), lit(2)),
+					plan.NewFilter(viewFilter, base),
+				),
+				[]sql.Expression{expression.NewSetField(uqc("base", "a"), lit(1))},
+			),
+		},
+		{
+			name: "delete through a view",
+			node: plan.NewDeleteFrom(
+				plan.NewFilter(
+					expression.NewEquals(uc("val"), lit(2)),
+					plan.NewUnresolvedTable("vall", ""),
+				),
+			),
+			expected: plan.NewDeleteFrom(
+				plan.NewFilter(
+					expression.NewEquals(uqc("base", "val"), lit(2)),
+					base,
+				),
+			),
+		},
+		{
+			name: "insert through a view",
+			node: plan.NewInsertInto(
+				sql.UnresolvedDatabase(""),
+				plan.NewUnresolvedTable("v", ""),
+				plan.NewValues([][]sql.Expression{{lit(1), lit(2)}}),
+				false, []string{"val", "pk"}, nil, false,
+			),
+			expected: plan.NewInsertInto(
+				sql.UnresolvedDatabase(""),
+				base,
+				plan.NewValues([][]sql.Expression{{lit(1), lit(2)}}),
+				false, []string{"a", "pk"}, nil, false,
+			),
+		},
+		{
+			name: "insert without columns uses the view's columns",
+			node: plan.NewInsertInto(
+				sql.UnresolvedDatabase(""),
+				plan.NewUnresolvedTable("v", ""),
+				plan.NewValues([][]sql.Expression{{lit(1), lit(2)}}),
+				false, nil, nil, false,
+			),
+			expected: plan.NewInsertInto(
+				sql.UnresolvedDatabase(""),
+				base,
+				plan.NewValues([][]sql.Expression{{lit(1), lit(2)}}),
+				false, []string{"pk", "a"}, nil, false,
+			),
+		},
+		{
+			name: "column not in the view",
+			node: plan.NewUpdate(
+				plan.NewUnresolvedTable("v", ""),
+				[]sql.Expression{expression.NewSetField(uc("b"), lit(1))},
+			),
+			err: sql.ErrColumnNotFound,
+		},
+		{
+			name: "view with an aggregation",
+			node: plan.NewDeleteFrom(plan.NewUnresolvedTable("vagg", "")),
+			err:  sql.ErrViewNotUpdatable,
+		},
+		{
+			name: "table is left alone",
+			node: plan.NewDeleteFrom(base),
+		},
+	}
+
+	runTestCases(t, ctx, testCases, a, getRule("resolve_updatable_views"))
+}
@@ -68,7 +68,7 @@ var (
 	// correctly used.
 	ErrIntervalInvalidUse = errors.NewKind(
 		"invalid use of an interval, which can only be used with DATE_ADD, " +
-			"DATE_SUB and +/- operators to subtract from or add to a date",
+			"DATE_SUB, TIME_BUCKET and +/- operators to subtract from or add to a date",
 	)
 	// ErrExplodeInvalidUse is returned when an EXPLODE function is used
 	// outside a Project node.
@@ -233,6 +233,12 @@ func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 	span, _ := ctx.Span("validate_group_by")
 	defer span.Finish()
 
+	// Selecting columns that aren't grouped by is only rejected with ONLY_FULL_GROUP_BY, otherwise they get the values
+	// of any row of the group.
+	if !sql.LoadSqlMode(ctx).ModeEnabled(sql.SqlModeOnlyFullGroupBy) {
+		return n, nil
+	}
+
 	switch n := n.(type) {
 	case *plan.GroupBy:
 		// Allow the parser use the GroupBy node to eval the aggregation functions
@@ -427,7 +433,7 @@ func validateIntervalUsage(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sco
 		}
 
 		switch e := e.(type) {
-		case *function.DateAdd, *function.DateSub:
+		case *function.DateAdd, *function.DateSub, *function.TimeBucket:
 			return false
 		case *expression.Arithmetic:
 			if e.Op == "+" || e.Op == "-" {
@@ -700,11 +706,7 @@ func validateReadOnlyTransaction(ctx *sql.Context, a *Analyzer, n sql.Node, scop
 
 	isTempTable := func(table sql.Table) bool {
 		tt, isTempTable := table.(sql.TemporaryTable)
-		if !isTempTable {
-			valid = false
-		}
-
-		return tt.IsTemporary()
+		return isTempTable && tt.IsTemporary()
 	}
 
 	temporaryTableSearch := func(node sql.Node) bool {
@@ -753,8 +755,8 @@ func validateReadOnlyTransaction(ctx *sql.Context, a *Analyzer, n sql.Node, scop
 }
 
 // validateAggregations returns an error if an Aggregation
-// expression node appears outside of a GroupBy or Window node. Only GroupBy
-// and Window nodes know how to evaluate Aggregation expressions.
+// expression node appears outside of a GroupBy, Window or Pivot node. Only
+// these nodes know how to evaluate Aggregation expressions.
 //
 // See https://github.com/dolthub/go-mysql-server/issues/542 for some queries
 // that should be supported but that currently trigger this validation because
@@ -776,6 +778,8 @@ func validateAggregations(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scop
 		if gb, ok := n.(*plan.GroupBy); ok {
 			return checkExpressions(gb.GroupByExprs)
 		} else if _, ok := n.(*plan.Window); ok {
+		} else if p, ok := n.(*plan.Pivot); ok {
+			return checkExpressions(append([]sql.Expression{p.Column}, p.Values...))
 		} else if n, ok := n.(sql.Expressioner); ok {
 			return checkExpressions(n.Expressions())
 		}
@@ -143,6 +143,12 @@ func TestValidateGroupByErr(t *testing.T) {
 
 	_, err = vr.Apply(sql.NewEmptyContext(), nil, p, nil)
 	require.Error(err)
+
+	// Without ONLY_FULL_GROUP_BY, non-aggregated columns are allowed
+	ctx := sql.NewEmptyContext()
+	require.NoError(ctx.SetSessionVariable(ctx, "sql_mode", "STRICT_TRANS_TABLES"))
+	_, err = vr.Apply(ctx, nil, p, nil)
+	require.NoError(err)
 }
 
 func TestValidateSchemaSource(t *testing.T) {
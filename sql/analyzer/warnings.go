@@ -26,7 +26,7 @@ func clearWarnings(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (
 	}
 
 	switch ch := children[0].(type) {
-	case plan.ShowWarnings:
+	case plan.ShowWarnings, *plan.ShowWarningsCount:
 		return node, nil
 	case *plan.Offset:
 		clearWarnings(ctx, a, ch, scope)
@@ -0,0 +1,87 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyZoneMaps wraps the tables that keep statistics of their partitions and are filtered in a plan.PrunedTable,
+// which skips the partitions that can't match the predicates of the filter.
+func applyZoneMaps(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("apply_zone_maps")
+	defer span.Finish()
+
+	if !canDoPushdown(n) {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, nil
+		}
+
+		tableNode, ok := singleTableNode(filter.Child)
+		if !ok {
+			return n, nil
+		}
+
+		rt := getResolvedTable(tableNode)
+		if _, ok := rt.Table.(*plan.PrunedTable); ok {
+			return n, nil
+		}
+
+		table, ok := rt.Table.(sql.ZoneMapTable)
+		if !ok {
+			return n, nil
+		}
+
+		var predicates []sql.Expression
+		for _, e := range splitConjunction(filter.Expression) {
+			// Zone maps are computed over the TIMESTAMP values stored in UTC, not the ones of the session
+			if plan.IsPrunablePredicate(e) && onlyUsesTable(e, tableNode.Name()) && !usesTimestampColumn(e) {
+				predicates = append(predicates, e)
+			}
+		}
+		if len(predicates) == 0 {
+			return n, nil
+		}
+
+		child, err := withTable(filter.Child, plan.NewPrunedTable(table, predicates))
+		if err != nil {
+			return nil, err
+		}
+
+		a.Log("pruning partitions of table %q with zone maps", tableNode.Name())
+		return filter.WithChildren(child)
+	})
+}
+
+// onlyUsesTable returns whether all the fields of the expression given belong to the table given.
+func onlyUsesTable(e sql.Expression, table string) bool {
+	ok := true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		if gf, isField := e.(*expression.GetField); isField && !strings.EqualFold(gf.Table(), table) {
+			ok = false
+		}
+		return ok
+	})
+	return ok
+}
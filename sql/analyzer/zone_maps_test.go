@@ -0,0 +1,66 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestApplyZoneMaps(t *testing.T) {
+	schema := sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable"},
+		{Name: "t", Type: sql.Text, Source: "mytable"},
+	}
+	table := memory.NewZoneMapTable("mytable", schema, 2)
+	plainTable := memory.NewTable("mytable", schema)
+
+	prunable := expression.NewGreaterThan(
+		expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false),
+		expression.NewLiteral(int64(1), sql.Int64),
+	)
+	other := expression.NewEquals(
+		expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false),
+		expression.NewGetFieldWithTable(1, sql.Text, "mytable", "t", false),
+	)
+	filter := expression.NewAnd(prunable, other)
+
+	a := NewDefault(sql.NewDatabaseProvider())
+
+	tests := []analyzerFnTestCase{
+		{
+			name: "filter with prunable predicates",
+			node: plan.NewFilter(filter, plan.NewResolvedTable(table, nil, nil)),
+			expected: plan.NewFilter(
+				filter,
+				plan.NewResolvedTable(plan.NewPrunedTable(table, []sql.Expression{prunable}), nil, nil),
+			),
+		},
+		{
+			name: "filter without prunable predicates",
+			node: plan.NewFilter(other, plan.NewResolvedTable(table, nil, nil)),
+		},
+		{
+			name: "table without zone maps",
+			node: plan.NewFilter(filter, plan.NewResolvedTable(plainTable, nil, nil)),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, a, getRule("apply_zone_maps"))
+}
@@ -0,0 +1,241 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrBackgroundJobNotFound is returned when cancelling a background job that doesn't exist or already finished.
+var ErrBackgroundJobNotFound = errors.NewKind("background job %d not found")
+
+// ErrBackgroundJobsClosed is returned when starting a background job after the engine was closed.
+var ErrBackgroundJobsClosed = errors.NewKind("background jobs are closed")
+
+// BackgroundJobFunc is the function run by a background job. It must return once the context given is done.
+type BackgroundJobFunc func(ctx *Context) error
+
+// BackgroundJobState is the state of a background job.
+type BackgroundJobState string
+
+const (
+	// BackgroundJobScheduled is the state of periodic jobs waiting for their next run.
+	BackgroundJobScheduled BackgroundJobState = "scheduled"
+	// BackgroundJobRunning is the state of jobs that are running.
+	BackgroundJobRunning BackgroundJobState = "running"
+	// BackgroundJobFinished is the state of jobs that ran successfully and won't run again.
+	BackgroundJobFinished BackgroundJobState = "finished"
+	// BackgroundJobFailed is the state of jobs whose last run returned an error. Periodic jobs stop on errors.
+	BackgroundJobFailed BackgroundJobState = "failed"
+	// BackgroundJobCancelled is the state of jobs cancelled before they finished.
+	BackgroundJobCancelled BackgroundJobState = "cancelled"
+)
+
+// maxDoneBackgroundJobs is the number of jobs that are no longer running kept to report their status.
+const maxDoneBackgroundJobs = 100
+
+// BackgroundJobStatus is the status of a background job.
+type BackgroundJobStatus struct {
+	ID   uint64
+	Name string
+	// Interval is the time between the runs of periodic jobs, or zero for jobs that run once.
+	Interval time.Duration
+	State    BackgroundJobState
+	// Runs is the number of times the job started running.
+	Runs uint64
+	// LastRun is the time the job last started running, or the zero time if it never did.
+	LastRun time.Time
+	// Error is the error of the last run of the job, if it failed.
+	Error string
+}
+
+// BackgroundJobs runs and keeps track of the jobs an engine runs in the background, such as building indexes or
+// maintenance tasks registered by integrators. Jobs run with a copy of the context they're started with, whose
+// underlying context is done when the job is cancelled or the jobs are closed along with their engine.
+type BackgroundJobs struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.Mutex
+	nextID uint64
+	jobs   map[uint64]*backgroundJob
+	done   []uint64
+	closed bool
+}
+
+type backgroundJob struct {
+	status BackgroundJobStatus
+	cancel context.CancelFunc
+}
+
+// NewBackgroundJobs returns a new, empty BackgroundJobs.
+func NewBackgroundJobs() *BackgroundJobs {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &BackgroundJobs{
+		ctx:    ctx,
+		cancel: cancel,
+		jobs:   make(map[uint64]*backgroundJob),
+	}
+}
+
+// Run starts running the function given in the background once, returning the id of the job.
+func (b *BackgroundJobs) Run(ctx *Context, name string, fn BackgroundJobFunc) (uint64, error) {
+	return b.start(ctx, name, 0, fn)
+}
+
+// Schedule starts running the function given in the background every interval, starting after the first interval
+// elapses, and returns the id of the job. The job runs until it's cancelled or one of its runs fails.
+func (b *BackgroundJobs) Schedule(ctx *Context, name string, interval time.Duration, fn BackgroundJobFunc) (uint64, error) {
+	return b.start(ctx, name, interval, fn)
+}
+
+func (b *BackgroundJobs) start(ctx *Context, name string, interval time.Duration, fn BackgroundJobFunc) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return 0, ErrBackgroundJobsClosed.New()
+	}
+
+	b.nextID++
+	jobCtx, cancel := context.WithCancel(b.ctx)
+	job := &backgroundJob{
+		status: BackgroundJobStatus{
+			ID:       b.nextID,
+			Name:     name,
+			Interval: interval,
+			State:    BackgroundJobScheduled,
+		},
+		cancel: cancel,
+	}
+	if interval == 0 {
+		job.status.State = BackgroundJobRunning
+	}
+	b.jobs[job.status.ID] = job
+
+	b.wg.Add(1)
+	go b.run(ctx.WithContext(jobCtx), job, fn)
+
+	return job.status.ID, nil
+}
+
+// run runs the job given until it's done, updating its status.
+func (b *BackgroundJobs) run(ctx *Context, job *backgroundJob, fn BackgroundJobFunc) {
+	defer b.wg.Done()
+	defer job.cancel()
+
+	var ticker *time.Ticker
+	if job.status.Interval > 0 {
+		ticker = time.NewTicker(job.status.Interval)
+		defer ticker.Stop()
+	}
+
+	for {
+		if ticker != nil {
+			select {
+			case <-ctx.Done():
+				b.finish(job, BackgroundJobCancelled, nil)
+				return
+			case <-ticker.C:
+			}
+		}
+
+		b.mu.Lock()
+		job.status.State = BackgroundJobRunning
+		job.status.Runs++
+		job.status.LastRun = time.Now()
+		b.mu.Unlock()
+
+		err := fn(ctx)
+		switch {
+		case ctx.Err() != nil:
+			b.finish(job, BackgroundJobCancelled, nil)
+			return
+		case err != nil:
+			b.finish(job, BackgroundJobFailed, err)
+			return
+		case ticker == nil:
+			b.finish(job, BackgroundJobFinished, nil)
+			return
+		}
+
+		b.mu.Lock()
+		job.status.State = BackgroundJobScheduled
+		b.mu.Unlock()
+	}
+}
+
+// finish records the final state of the job given, forgetting the oldest jobs that are done if there are too many.
+func (b *BackgroundJobs) finish(job *backgroundJob, state BackgroundJobState, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	job.status.State = state
+	if err != nil {
+		job.status.Error = err.Error()
+	}
+
+	b.done = append(b.done, job.status.ID)
+	if len(b.done) > maxDoneBackgroundJobs {
+		delete(b.jobs, b.done[0])
+		b.done = b.done[1:]
+	}
+}
+
+// Cancel cancels the running or scheduled job with the id given.
+func (b *BackgroundJobs) Cancel(id uint64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	job, ok := b.jobs[id]
+	if !ok || (job.status.State != BackgroundJobRunning && job.status.State != BackgroundJobScheduled) {
+		return ErrBackgroundJobNotFound.New(id)
+	}
+
+	job.cancel()
+	return nil
+}
+
+// Jobs returns the status of the jobs that are running or scheduled, and of the last ones that are done, sorted by id.
+func (b *BackgroundJobs) Jobs() []BackgroundJobStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	jobs := make([]BackgroundJobStatus, 0, len(b.jobs))
+	for _, job := range b.jobs {
+		jobs = append(jobs, job.status)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].ID < jobs[j].ID
+	})
+	return jobs
+}
+
+// Close cancels every job and waits for them to return. No jobs can be started afterwards.
+func (b *BackgroundJobs) Close() {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+
+	b.cancel()
+	b.wg.Wait()
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackgroundJobs(t *testing.T) {
+	require := require.New(t)
+
+	jobs := NewBackgroundJobs()
+	ctx := NewEmptyContext()
+
+	state := func(id uint64) BackgroundJobState {
+		for _, job := range jobs.Jobs() {
+			if job.ID == id {
+				return job.State
+			}
+		}
+		return ""
+	}
+	eventually := func(id uint64, expected BackgroundJobState) {
+		require.Eventually(func() bool {
+			return state(id) == expected
+		}, time.Second, time.Millisecond)
+	}
+
+	once, err := jobs.Run(ctx, "once", func(ctx *Context) error {
+		return nil
+	})
+	require.NoError(err)
+	eventually(once, BackgroundJobFinished)
+
+	failed, err := jobs.Run(ctx, "failed", func(ctx *Context) error {
+		return fmt.Errorf("boom")
+	})
+	require.NoError(err)
+	eventually(failed, BackgroundJobFailed)
+	require.Equal("boom", jobs.Jobs()[1].Error)
+
+	runs := make(chan struct{}, 10)
+	periodic, err := jobs.Schedule(ctx, "periodic", time.Millisecond, func(ctx *Context) error {
+		select {
+		case runs <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+	require.NoError(err)
+	<-runs
+	<-runs
+
+	blocked, err := jobs.Run(ctx, "blocked", func(ctx *Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(err)
+	eventually(blocked, BackgroundJobRunning)
+
+	require.NoError(jobs.Cancel(periodic))
+	eventually(periodic, BackgroundJobCancelled)
+	require.True(ErrBackgroundJobNotFound.Is(jobs.Cancel(periodic)))
+	require.True(ErrBackgroundJobNotFound.Is(jobs.Cancel(42)))
+
+	jobs.Close()
+	require.Equal(BackgroundJobCancelled, state(blocked))
+
+	_, err = jobs.Run(ctx, "closed", func(ctx *Context) error {
+		return nil
+	})
+	require.True(ErrBackgroundJobsClosed.Is(err))
+}
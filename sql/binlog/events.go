@@ -0,0 +1,360 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// The types of the events written to the log, which vitess doesn't export.
+const (
+	queryEvent             = 2
+	rotateEvent            = 4
+	heartbeatEvent         = 27
+	formatDescriptionEvent = 15
+	xidEvent               = 16
+	tableMapEvent          = 19
+	writeRowsEvent         = 30
+	updateRowsEvent        = 31
+	deleteRowsEvent        = 32
+	gtidEvent              = 33
+	previousGTIDsEvent     = 35
+)
+
+// eventTypeNames are the names of the event types, as listed by SHOW BINLOG EVENTS.
+var eventTypeNames = map[byte]string{
+	queryEvent:             "Query",
+	rotateEvent:            "Rotate",
+	heartbeatEvent:         "Heartbeat",
+	formatDescriptionEvent: "Format_desc",
+	xidEvent:               "Xid",
+	tableMapEvent:          "Table_map",
+	writeRowsEvent:         "Write_rows",
+	updateRowsEvent:        "Update_rows",
+	deleteRowsEvent:        "Delete_rows",
+	gtidEvent:              "Gtid",
+	previousGTIDsEvent:     "Previous_gtids",
+}
+
+const (
+	// headerLength is the length of the header of events, which starts with their timestamp, type and server ID, and
+	// ends with their length, the position of the next event and their flags.
+	headerLength = 19
+	// checksumLength is the length of the CRC32 checksum ending every event.
+	checksumLength = 4
+	// stmtEndFlag is the flag of the last rows event of a statement.
+	stmtEndFlag = 0x0001
+	// artificialFlag is the flag of the events sent to replicas that aren't in the log, such as the rotate event
+	// starting a dump.
+	artificialFlag = 0x0020
+)
+
+// eventBytes returns the bytes of an event built by vitess.
+func eventBytes(ev mysql.BinlogEvent) []byte {
+	return ev.(interface{ Bytes() []byte }).Bytes()
+}
+
+// setPosition sets the position of the next event of the header of the event given, and computes its checksum again.
+func setPosition(ev []byte, next uint64) {
+	binary.LittleEndian.PutUint32(ev[13:17], uint32(next))
+	binary.LittleEndian.PutUint32(ev[len(ev)-checksumLength:], crc32.ChecksumIEEE(ev[:len(ev)-checksumLength]))
+}
+
+// gtidEventData returns the data of a GTID event of the source and sequence number given, in the format of MySQL 5.6.
+func gtidEventData(sid [16]byte, gno uint64) []byte {
+	data := make([]byte, 1+16+8)
+	// The transaction commits on its own, so it's flagged as a commit
+	data[0] = 1
+	copy(data[1:17], sid[:])
+	binary.LittleEndian.PutUint64(data[17:], gno)
+	return data
+}
+
+// gtidOf returns the sequence number of the GTID of a GTID event.
+func gtidOf(ev []byte) uint64 {
+	return binary.LittleEndian.Uint64(ev[headerLength+1+16:])
+}
+
+// previousGTIDsEventData returns the data of a previous GTIDs event of the transactions of the source given with
+// sequence numbers up to the one given.
+func previousGTIDsEventData(sid [16]byte, gno uint64) []byte {
+	if gno == 0 {
+		return make([]byte, 8)
+	}
+
+	data := make([]byte, 8+16+8+16)
+	binary.LittleEndian.PutUint64(data[0:8], 1)
+	copy(data[8:24], sid[:])
+	binary.LittleEndian.PutUint64(data[24:32], 1)
+	// Intervals are written as their first sequence number, and the sequence number after their last one
+	binary.LittleEndian.PutUint64(data[32:40], 1)
+	binary.LittleEndian.PutUint64(data[40:48], gno+1)
+	return data
+}
+
+// column is how a column of a table is written to the log: its MySQL binary log type, and the metadata of the type
+// written to the table map events of the table.
+type column struct {
+	typ      byte
+	metadata uint16
+}
+
+// columnOf returns how values of the type given are written to the log. Integers, floats, dates and times and strings
+// are written like MySQL writes them. Values of other types, such as decimals, enums, sets and JSON documents, are
+// written as the blobs of their string representations.
+func columnOf(t sql.Type) column {
+	switch t.Type() {
+	case sqltypes.Null:
+		return column{typ: mysql.TypeNull}
+	case sqltypes.Int8, sqltypes.Uint8:
+		return column{typ: mysql.TypeTiny}
+	case sqltypes.Int16, sqltypes.Uint16:
+		return column{typ: mysql.TypeShort}
+	case sqltypes.Int24, sqltypes.Uint24:
+		return column{typ: mysql.TypeInt24}
+	case sqltypes.Int32, sqltypes.Uint32:
+		return column{typ: mysql.TypeLong}
+	case sqltypes.Int64, sqltypes.Uint64, sqltypes.Bit:
+		return column{typ: mysql.TypeLongLong}
+	case sqltypes.Float32:
+		return column{typ: mysql.TypeFloat, metadata: 4}
+	case sqltypes.Float64:
+		return column{typ: mysql.TypeDouble, metadata: 8}
+	case sqltypes.Year:
+		return column{typ: mysql.TypeYear}
+	case sqltypes.Date:
+		return column{typ: mysql.TypeDate}
+	case sqltypes.Datetime:
+		return column{typ: mysql.TypeDateTime2, metadata: 6}
+	case sqltypes.Timestamp:
+		return column{typ: mysql.TypeTimestamp2, metadata: 6}
+	case sqltypes.Char, sqltypes.VarChar, sqltypes.Binary, sqltypes.VarBinary:
+		if st, ok := t.(sql.StringType); ok && st.MaxByteLength() <= math.MaxUint16 {
+			return column{typ: mysql.TypeVarchar, metadata: uint16(st.MaxByteLength())}
+		}
+	}
+	return column{typ: mysql.TypeBlob, metadata: 4}
+}
+
+// intSizes are the number of bytes of the integer types.
+var intSizes = map[byte]int{
+	mysql.TypeTiny:     1,
+	mysql.TypeShort:    2,
+	mysql.TypeInt24:    3,
+	mysql.TypeLong:     4,
+	mysql.TypeLongLong: 8,
+}
+
+// appendValue appends the non-NULL value given of a column of the type given to the data of a row.
+func appendValue(data []byte, t sql.Type, c column, v interface{}) ([]byte, error) {
+	switch c.typ {
+	case mysql.TypeNull:
+		return data, nil
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLongLong:
+		n, err := intBits(t, v)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < intSizes[c.typ]; i++ {
+			data = append(data, byte(n>>(8*i)))
+		}
+		return data, nil
+	case mysql.TypeFloat:
+		f, err := sql.Float32.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint32(data, math.Float32bits(f.(float32))), nil
+	case mysql.TypeDouble:
+		f, err := sql.Float64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint64(data, math.Float64bits(f.(float64))), nil
+	case mysql.TypeYear:
+		y, err := sql.Year.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		if y.(int16) == 0 {
+			return append(data, 0), nil
+		}
+		return append(data, byte(y.(int16)-1900)), nil
+	case mysql.TypeDate, mysql.TypeDateTime2, mysql.TypeTimestamp2:
+		converted, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		tm, ok := converted.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("unexpected value of type %s: %v", t, converted)
+		}
+		return appendTime(data, c.typ, tm), nil
+	}
+
+	var b []byte
+	switch c.typ {
+	case mysql.TypeVarchar:
+		converted, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		b = stringBytes(converted)
+	default:
+		val, err := t.SQL(v)
+		if err != nil {
+			return nil, err
+		}
+		b = val.ToBytes()
+	}
+
+	switch {
+	case c.typ == mysql.TypeBlob:
+		data = appendUint32(data, uint32(len(b)))
+	case c.metadata > 255:
+		data = append(data, byte(len(b)), byte(len(b)>>8))
+	default:
+		data = append(data, byte(len(b)))
+	}
+	return append(data, b...), nil
+}
+
+// intBits returns the bits of an integer value of the type given.
+func intBits(t sql.Type, v interface{}) (uint64, error) {
+	converted, err := t.Convert(v)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n := converted.(type) {
+	case int8:
+		return uint64(n), nil
+	case int16:
+		return uint64(n), nil
+	case int32:
+		return uint64(n), nil
+	case int64:
+		return uint64(n), nil
+	case uint8:
+		return uint64(n), nil
+	case uint16:
+		return uint64(n), nil
+	case uint32:
+		return uint64(n), nil
+	case uint64:
+		return n, nil
+	default:
+		return 0, fmt.Errorf("unexpected value of type %s: %v", t, converted)
+	}
+}
+
+// appendTime appends a date, a datetime or a timestamp with microseconds.
+func appendTime(data []byte, typ byte, t time.Time) []byte {
+	switch typ {
+	case mysql.TypeDate:
+		d := uint32(t.Day()) | uint32(t.Month())<<5 | uint32(t.Year())<<9
+		return append(data, byte(d), byte(d>>8), byte(d>>16))
+	case mysql.TypeTimestamp2:
+		data = append(data, byte(t.Unix()>>24), byte(t.Unix()>>16), byte(t.Unix()>>8), byte(t.Unix()))
+	default:
+		ym := uint64(t.Year())*13 + uint64(t.Month())
+		ymd := ym<<5 | uint64(t.Day())
+		hms := uint64(t.Hour())<<12 | uint64(t.Minute())<<6 | uint64(t.Second())
+		// Datetimes are signed, with an offset making the sign bit set for positive ones
+		dt := (ymd<<17 | hms) + 0x8000000000
+		data = append(data, byte(dt>>32), byte(dt>>24), byte(dt>>16), byte(dt>>8), byte(dt))
+	}
+	micros := t.Nanosecond() / int(time.Microsecond)
+	return append(data, byte(micros>>16), byte(micros>>8), byte(micros))
+}
+
+func stringBytes(v interface{}) []byte {
+	switch v := v.(type) {
+	case string:
+		return []byte(v)
+	case []byte:
+		return v
+	default:
+		return []byte(fmt.Sprint(v))
+	}
+}
+
+func appendUint32(data []byte, n uint32) []byte {
+	return append(data, byte(n), byte(n>>8), byte(n>>16), byte(n>>24))
+}
+
+func appendUint64(data []byte, n uint64) []byte {
+	return appendUint32(appendUint32(data, uint32(n)), uint32(n>>32))
+}
+
+// tableMap returns the table map of the table given.
+func tableMap(db, table string, schema sql.Schema) (*mysql.TableMap, []column, error) {
+	// Column counts are written as a single byte by vitess
+	if len(schema) > 250 {
+		return nil, nil, fmt.Errorf("table %s.%s has too many columns to be written to the binary log", db, table)
+	}
+
+	tm := &mysql.TableMap{
+		Database:  db,
+		Name:      table,
+		Types:     make([]byte, len(schema)),
+		CanBeNull: mysql.NewServerBitmap(len(schema)),
+		Metadata:  make([]uint16, len(schema)),
+	}
+	columns := make([]column, len(schema))
+	for i, col := range schema {
+		columns[i] = columnOf(col.Type)
+		tm.Types[i] = columns[i].typ
+		tm.Metadata[i] = columns[i].metadata
+		tm.CanBeNull.Set(i, col.Nullable)
+	}
+	return tm, columns, nil
+}
+
+// rowImage returns the NULL bitmap and the data of the row given.
+func rowImage(schema sql.Schema, columns []column, row sql.Row) (mysql.Bitmap, []byte, error) {
+	nulls := mysql.NewServerBitmap(len(schema))
+	var data []byte
+	for i, col := range schema {
+		if i >= len(row) || row[i] == nil {
+			nulls.Set(i, true)
+			continue
+		}
+		var err error
+		data, err = appendValue(data, col.Type, columns[i], row[i])
+		if err != nil {
+			return mysql.Bitmap{}, nil, err
+		}
+	}
+	return nulls, data, nil
+}
+
+// allColumns returns a bitmap of all the columns of a table.
+func allColumns(count int) mysql.Bitmap {
+	b := mysql.NewServerBitmap(count)
+	for i := 0; i < count; i++ {
+		b.Set(i, true)
+	}
+	return b
+}
@@ -0,0 +1,634 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package binlog writes the transactions committed through an engine as a binary log in the format of MySQL, which
+// MySQL replicas and change data capture tools such as Debezium can read with the replication protocol.
+package binlog
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/google/uuid"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+const (
+	// DefaultBaseName is the base name of the files of a log without one.
+	DefaultBaseName = "binlog"
+	// DefaultMaxFileSize is the size after which a log without a maximum file size rotates to a new file, which is the
+	// default of @@max_binlog_size.
+	DefaultMaxFileSize = 1 << 30
+	// firstEventPos is the position of the first event of a file, after the magic number of binary log files.
+	firstEventPos = 4
+	// serverVersion is the server version written to the format description events of the files.
+	serverVersion = "8.0.11-log"
+	// mysql56Flavor is the vitess flavor of the GTIDs of the log.
+	mysql56Flavor = "MySQL56"
+)
+
+// Config configures a Log.
+type Config struct {
+	// ServerID is the server ID of the events, which identifies the server among those of a replication topology. If
+	// zero, it's 1.
+	ServerID uint32
+	// ServerUUID is the source ID of the GTIDs of the transactions. If empty, a random one is generated.
+	ServerUUID string
+	// BaseName is the base name of the files, which are numbered after it, as in binlog.000001. If empty, it's
+	// DefaultBaseName.
+	BaseName string
+	// MaxFileSize is the size after which the log rotates to a new file. A transaction is never split between files,
+	// so files may grow past it. If zero, it's DefaultMaxFileSize.
+	MaxFileSize uint64
+}
+
+// Log is a binary log kept in memory, which writes the transactions it receives as a binlog sink as row based events:
+// each transaction is a GTID event, a BEGIN query event, table map and rows events for the changed rows, and an XID
+// event, while each statement changing a schema is a GTID event and a query event of its own. Files are kept until
+// they're purged.
+type Log struct {
+	mu       sync.Mutex
+	serverID uint32
+	sid      uuid.UUID
+	baseName string
+	maxSize  uint64
+	format   mysql.BinlogFormat
+	files    []*file
+	// gno is the sequence number of the GTID of the last transaction written
+	gno uint64
+	// tables are the IDs of the table map events of the tables written, by database and table name
+	tables      map[string]tableID
+	nextTableID uint64
+	// written is closed and replaced every time events are written, to wake up the streamers waiting for them
+	written chan struct{}
+}
+
+var _ sql.ReplicationSource = (*Log)(nil)
+
+// file is a file of the log.
+type file struct {
+	name   string
+	index  int
+	events []event
+	// prevGNO is the sequence number of the GTID of the last transaction written to the previous files
+	prevGNO uint64
+	// size is the size of the file, which is the position of the event written next
+	size uint64
+}
+
+// event is an event of a file, with the info describing it in SHOW BINLOG EVENTS.
+type event struct {
+	pos  uint64
+	data []byte
+	info string
+}
+
+// tableID is the table map ID of a table, which changes when the columns of the table do.
+type tableID struct {
+	id      uint64
+	columns string
+}
+
+// NewLog creates a new Log with a first empty file.
+func NewLog(cfg Config) (*Log, error) {
+	sid := uuid.New()
+	if cfg.ServerUUID != "" {
+		var err error
+		sid, err = uuid.Parse(cfg.ServerUUID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid server UUID %q: %s", cfg.ServerUUID, err)
+		}
+	}
+
+	l := &Log{
+		serverID:    cfg.ServerID,
+		sid:         sid,
+		baseName:    cfg.BaseName,
+		maxSize:     cfg.MaxFileSize,
+		format:      mysql.NewMySQL56BinlogFormat(),
+		tables:      make(map[string]tableID),
+		nextTableID: 1,
+		written:     make(chan struct{}),
+	}
+	if l.serverID == 0 {
+		l.serverID = 1
+	}
+	if l.baseName == "" {
+		l.baseName = DefaultBaseName
+	}
+	if l.maxSize == 0 {
+		l.maxSize = DefaultMaxFileSize
+	}
+	l.format.ServerVersion = serverVersion
+
+	l.newFile(time.Now())
+	return l, nil
+}
+
+// ServerID implements the sql.ReplicationSource interface.
+func (l *Log) ServerID() uint32 {
+	return l.serverID
+}
+
+// ServerUUID implements the sql.ReplicationSource interface.
+func (l *Log) ServerUUID() string {
+	return l.sid.String()
+}
+
+// Format returns the format of the events of the log.
+func (l *Log) Format() mysql.BinlogFormat {
+	return l.format
+}
+
+func (l *Log) stream(ts uint32) *mysql.FakeBinlogStream {
+	return &mysql.FakeBinlogStream{ServerID: l.serverID, Timestamp: ts}
+}
+
+// newFile starts a new file, which starts with a format description event and the GTIDs of the transactions written
+// to the previous files.
+func (l *Log) newFile(now time.Time) {
+	index := 1
+	if len(l.files) > 0 {
+		index = l.files[len(l.files)-1].index + 1
+	}
+	f := &file{name: fmt.Sprintf("%s.%06d", l.baseName, index), index: index, size: firstEventPos, prevGNO: l.gno}
+	l.files = append(l.files, f)
+
+	s := l.stream(uint32(now.Unix()))
+	f.append(eventBytes(mysql.NewFormatDescriptionEvent(l.format, s)),
+		fmt.Sprintf("Server ver: %s, Binlog ver: %d", l.format.ServerVersion, l.format.FormatVersion))
+	f.append(s.Packetize(l.format, previousGTIDsEvent, 0, previousGTIDsEventData(l.sid, l.gno)), l.gtidSet())
+}
+
+// current returns the file written to.
+func (l *Log) current() *file {
+	return l.files[len(l.files)-1]
+}
+
+// file returns the file with the name given, or nil if there isn't one.
+func (l *Log) file(name string) *file {
+	for _, f := range l.files {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// next returns the file written after the one given, or nil if it's the file written to.
+func (l *Log) next(f *file) *file {
+	for i := range l.files[:len(l.files)-1] {
+		if l.files[i] == f {
+			return l.files[i+1]
+		}
+	}
+	return nil
+}
+
+// append writes an event to the file, setting the position of the next event of its header.
+func (f *file) append(data []byte, info string) {
+	setPosition(data, f.size+uint64(len(data)))
+	f.events = append(f.events, event{pos: f.size, data: data, info: info})
+	f.size += uint64(len(data))
+}
+
+// gtidSet returns the set of the GTIDs written to the log.
+func (l *Log) gtidSet() string {
+	return gtidSetUpTo(l.sid, l.gno)
+}
+
+// gtidSetUpTo returns the set of the GTIDs of the source given with sequence numbers up to the one given.
+func gtidSetUpTo(sid uuid.UUID, gno uint64) string {
+	switch gno {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("%s:1", sid)
+	default:
+		return fmt.Sprintf("%s:1-%d", sid, gno)
+	}
+}
+
+// pendingEvent is an event of a transaction being written.
+type pendingEvent struct {
+	data []byte
+	info string
+}
+
+// pendingTransaction is a transaction being written, which ends with an XID event if it changes rows.
+type pendingTransaction struct {
+	events []pendingEvent
+	xid    bool
+}
+
+// WriteTransaction implements the sql.BinlogSink interface.
+func (l *Log) WriteTransaction(ctx *sql.Context, tx sql.BinlogTransaction) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ts := uint32(tx.Timestamp.Unix())
+	if tx.Timestamp.IsZero() {
+		ts = uint32(time.Now().Unix())
+	}
+
+	// The events are encoded before any is written, so that a transaction is written entirely or not at all
+	var txs []pendingTransaction
+	var rows []sql.BinlogEvent
+	for _, e := range tx.Events {
+		if e.Type != sql.BinlogQuery {
+			rows = append(rows, e)
+			continue
+		}
+		// Statements changing schemas commit the transaction they're part of, and are written on their own
+		if len(rows) > 0 {
+			events, err := l.rowsTransaction(ts, rows)
+			if err != nil {
+				return err
+			}
+			txs = append(txs, pendingTransaction{events: events, xid: true})
+			rows = nil
+		}
+		txs = append(txs, pendingTransaction{events: l.queryTransaction(ts, e)})
+	}
+	if len(rows) > 0 {
+		events, err := l.rowsTransaction(ts, rows)
+		if err != nil {
+			return err
+		}
+		txs = append(txs, pendingTransaction{events: events, xid: true})
+	}
+
+	s := l.stream(ts)
+	for _, tx := range txs {
+		l.gno++
+		f := l.current()
+		f.append(s.Packetize(l.format, gtidEvent, 0, gtidEventData(l.sid, l.gno)),
+			fmt.Sprintf("SET @@SESSION.GTID_NEXT= '%s:%d'", l.sid, l.gno))
+		for _, e := range tx.events {
+			f.append(e.data, e.info)
+		}
+		if tx.xid {
+			// Transactions are identified by the sequence number of their GTID
+			xid := make([]byte, 8)
+			binary.LittleEndian.PutUint64(xid, l.gno)
+			f.append(s.Packetize(l.format, xidEvent, 0, xid), fmt.Sprintf("COMMIT /* xid=%d */", l.gno))
+		}
+	}
+
+	if l.current().size >= l.maxSize {
+		l.rotate(time.Now())
+	}
+
+	close(l.written)
+	l.written = make(chan struct{})
+	return nil
+}
+
+// queryTransaction returns the events of a statement changing a schema.
+func (l *Log) queryTransaction(ts uint32, e sql.BinlogEvent) []pendingEvent {
+	q := mysql.Query{Database: e.Database, SQL: e.Query}
+	return []pendingEvent{{data: eventBytes(mysql.NewQueryEvent(l.format, l.stream(ts), q)), info: queryInfo(q)}}
+}
+
+func queryInfo(q mysql.Query) string {
+	if q.Database == "" {
+		return q.SQL
+	}
+	return fmt.Sprintf("use `%s`; %s", q.Database, q.SQL)
+}
+
+// rowsTransaction returns the events of a transaction changing the rows given, but its XID event. Consecutive changes
+// of the rows of the same table in the same way are written as a single rows event.
+func (l *Log) rowsTransaction(ts uint32, changes []sql.BinlogEvent) ([]pendingEvent, error) {
+	s := l.stream(ts)
+	begin := mysql.Query{Database: changes[0].Database, SQL: "BEGIN"}
+	events := []pendingEvent{{data: eventBytes(mysql.NewQueryEvent(l.format, s, begin)), info: "BEGIN"}}
+
+	for start := 0; start < len(changes); {
+		end := start + 1
+		for end < len(changes) && changes[end].Type == changes[start].Type &&
+			changes[end].Database == changes[start].Database && changes[end].Table == changes[start].Table {
+			end++
+		}
+
+		e := changes[start]
+		tm, columns, err := tableMap(e.Database, e.Table, e.Schema)
+		if err != nil {
+			return nil, err
+		}
+		id := l.tableID(e.Database, e.Table, tm)
+		events = append(events, pendingEvent{
+			data: eventBytes(mysql.NewTableMapEvent(l.format, s, id, tm)),
+			info: fmt.Sprintf("table_id: %d (%s.%s)", id, e.Database, e.Table),
+		})
+
+		rows := mysql.Rows{}
+		if end == len(changes) {
+			rows.Flags = stmtEndFlag
+		}
+		if e.Type != sql.BinlogInsert {
+			rows.IdentifyColumns = allColumns(len(e.Schema))
+		}
+		if e.Type != sql.BinlogDelete {
+			rows.DataColumns = allColumns(len(e.Schema))
+		}
+		for _, change := range changes[start:end] {
+			var row mysql.Row
+			if e.Type != sql.BinlogInsert {
+				row.NullIdentifyColumns, row.Identify, err = rowImage(e.Schema, columns, change.Before)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if e.Type != sql.BinlogDelete {
+				row.NullColumns, row.Data, err = rowImage(e.Schema, columns, change.After)
+				if err != nil {
+					return nil, err
+				}
+			}
+			rows.Rows = append(rows.Rows, row)
+		}
+
+		var ev mysql.BinlogEvent
+		switch e.Type {
+		case sql.BinlogInsert:
+			ev = mysql.NewWriteRowsEvent(l.format, s, id, rows)
+		case sql.BinlogUpdate:
+			ev = mysql.NewUpdateRowsEvent(l.format, s, id, rows)
+		default:
+			ev = mysql.NewDeleteRowsEvent(l.format, s, id, rows)
+		}
+		info := fmt.Sprintf("table_id: %d", id)
+		if rows.Flags&stmtEndFlag != 0 {
+			info += " flags: STMT_END_F"
+		}
+		events = append(events, pendingEvent{data: eventBytes(ev), info: info})
+		start = end
+	}
+	return events, nil
+}
+
+// tableID returns the ID of the table map events of the table given, which is assigned the first time the table is
+// written, and again when its columns change.
+func (l *Log) tableID(db, table string, tm *mysql.TableMap) uint64 {
+	key := strings.ToLower(db) + "." + strings.ToLower(table)
+	columns := fmt.Sprintf("%v%v", tm.Types, tm.Metadata)
+	if t, ok := l.tables[key]; ok && t.columns == columns {
+		return t.id
+	}
+
+	id := l.nextTableID
+	l.nextTableID++
+	l.tables[key] = tableID{id: id, columns: columns}
+	return id
+}
+
+// rotate ends the file written to with a rotate event, and starts a new file.
+func (l *Log) rotate(now time.Time) {
+	name := fmt.Sprintf("%s.%06d", l.baseName, l.current().index+1)
+	l.current().append(eventBytes(mysql.NewRotateEvent(l.format, l.stream(0), firstEventPos, name)),
+		fmt.Sprintf("%s;pos=%d", name, firstEventPos))
+	l.newFile(now)
+}
+
+// Rotate ends the file written to, and starts writing to a new one, like FLUSH BINARY LOGS.
+func (l *Log) Rotate() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rotate(time.Now())
+	close(l.written)
+	l.written = make(chan struct{})
+}
+
+// Files implements the sql.BinaryLog interface.
+func (l *Log) Files() []sql.BinaryLogFile {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	files := make([]sql.BinaryLogFile, len(l.files))
+	for i, f := range l.files {
+		files[i] = sql.BinaryLogFile{Name: f.name, Size: f.size}
+	}
+	return files
+}
+
+// Position implements the sql.BinaryLog interface.
+func (l *Log) Position() (string, uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.current().name, l.current().size
+}
+
+// ExecutedGTIDs implements the sql.BinaryLog interface.
+func (l *Log) ExecutedGTIDs() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.gtidSet()
+}
+
+// Events implements the sql.BinaryLog interface.
+func (l *Log) Events(name string) ([]sql.BinaryLogEventInfo, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f := l.file(name)
+	if f == nil {
+		return nil, sql.ErrBinaryLogNotFound.New(name)
+	}
+
+	events := make([]sql.BinaryLogEventInfo, len(f.events))
+	for i, e := range f.events {
+		events[i] = sql.BinaryLogEventInfo{
+			LogName:   f.name,
+			Pos:       e.pos,
+			EventType: eventTypeNames[e.data[4]],
+			ServerID:  l.serverID,
+			EndLogPos: e.pos + uint64(len(e.data)),
+			Info:      e.info,
+		}
+	}
+	return events, nil
+}
+
+// Purge implements the sql.BinaryLog interface.
+func (l *Log) Purge(name string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, f := range l.files {
+		if f.name == name {
+			l.files = l.files[i:]
+			return nil
+		}
+	}
+	return sql.ErrBinaryLogNotFound.New(name)
+}
+
+// Dump implements the sql.ReplicationSource interface.
+func (l *Log) Dump(name string, pos uint64) (sql.BinlogStream, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f := l.files[0]
+	if name != "" {
+		f = l.file(name)
+		if f == nil {
+			return nil, sql.ErrBinaryLogNotFound.New(name)
+		}
+	}
+	return l.dump(f, pos), nil
+}
+
+// DumpGTIDs implements the sql.ReplicationSource interface. The stream starts from the newest file whose previous
+// transactions are all in the set given, and skips the transactions in the set that follow.
+func (l *Log) DumpGTIDs(executed string) (sql.BinlogStream, error) {
+	set, err := mysql.ParsePosition(mysql56Flavor, executed)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i := len(l.files) - 1; i >= 0; i-- {
+		f := l.files[i]
+		previous, err := mysql.ParsePosition(mysql56Flavor, gtidSetUpTo(l.sid, f.prevGNO))
+		if err != nil {
+			return nil, err
+		}
+		if set.GTIDSet.Contains(previous.GTIDSet) {
+			s := l.dump(f, firstEventPos)
+			s.executed = set.GTIDSet
+			return s, nil
+		}
+	}
+	return nil, sql.ErrBinaryLogPurged.New(gtidSetUpTo(l.sid, l.files[0].prevGNO))
+}
+
+// dump returns a Streamer of the events of the log from the file and position given.
+func (l *Log) dump(f *file, pos uint64) *Streamer {
+	if pos < firstEventPos {
+		pos = firstEventPos
+	}
+
+	// Dumps start with an artificial rotate event telling replicas the file they're reading, followed by the format
+	// description event of the file if they start past it.
+	rotate := eventBytes(mysql.NewRotateEvent(l.format, l.stream(0), pos, f.name))
+	binary.LittleEndian.PutUint16(rotate[17:19], artificialFlag)
+	setPosition(rotate, 0)
+	pending := [][]byte{rotate}
+	if pos > firstEventPos {
+		fde := append([]byte(nil), f.events[0].data...)
+		setPosition(fde, 0)
+		pending = append(pending, fde)
+	}
+
+	return &Streamer{log: l, file: f.name, pos: pos, pending: pending}
+}
+
+// Streamer reads the events of a Log from a position. Once it has read all the events of the log, it waits for new
+// ones to be written.
+type Streamer struct {
+	log     *Log
+	file    string
+	pos     uint64
+	pending [][]byte
+	// executed are the GTIDs of the transactions the streamer skips, if it's started from a GTID set
+	executed mysql.GTIDSet
+	// skipping is set while the streamer skips the events of a transaction
+	skipping bool
+}
+
+var _ sql.BinlogStream = (*Streamer)(nil)
+
+// Position implements the sql.BinlogStream interface.
+func (s *Streamer) Position() (string, uint64) {
+	return s.file, s.pos
+}
+
+// Heartbeat implements the sql.BinlogStream interface.
+func (s *Streamer) Heartbeat() []byte {
+	ev := s.log.stream(0).Packetize(s.log.format, heartbeatEvent, artificialFlag, []byte(s.file))
+	setPosition(ev, s.pos)
+	return ev
+}
+
+// skip returns whether the event given is part of a transaction the streamer skips.
+func (s *Streamer) skip(ev []byte) bool {
+	if s.executed == nil {
+		return false
+	}
+	switch ev[4] {
+	case gtidEvent:
+		gtid := mysql.Mysql56GTID{Server: mysql.SID(s.log.sid), Sequence: int64(gtidOf(ev))}
+		s.skipping = s.executed.ContainsGTID(gtid)
+	case rotateEvent, formatDescriptionEvent, previousGTIDsEvent:
+		s.skipping = false
+	}
+	return s.skipping
+}
+
+// Next implements the sql.BinlogStream interface. An error is returned if the file the streamer reads is purged.
+func (s *Streamer) Next(ctx context.Context) ([]byte, error) {
+	if len(s.pending) > 0 {
+		ev := s.pending[0]
+		s.pending = s.pending[1:]
+		return ev, nil
+	}
+
+	for {
+		s.log.mu.Lock()
+		f := s.log.file(s.file)
+		if f == nil {
+			s.log.mu.Unlock()
+			return nil, sql.ErrBinaryLogNotFound.New(s.file)
+		}
+
+		if s.pos >= f.size {
+			if next := s.log.next(f); next != nil {
+				s.file, s.pos = next.name, firstEventPos
+				s.log.mu.Unlock()
+				continue
+			}
+		} else {
+			i := sort.Search(len(f.events), func(i int) bool {
+				return f.events[i].pos >= s.pos
+			})
+			if i < len(f.events) {
+				e := f.events[i]
+				s.pos = e.pos + uint64(len(e.data))
+				s.log.mu.Unlock()
+				if s.skip(e.data) {
+					continue
+				}
+				return e.data, nil
+			}
+		}
+
+		written := s.log.written
+		s.log.mu.Unlock()
+		select {
+		case <-written:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
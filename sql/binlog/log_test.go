@@ -0,0 +1,294 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+const testUUID = "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+
+var testSchema = sql.Schema{
+	{Name: "id", Type: sql.Int64, Source: "t"},
+	{Name: "name", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 20), Source: "t", Nullable: true},
+	{Name: "f", Type: sql.Float64, Source: "t", Nullable: true},
+	{Name: "d", Type: sql.Datetime, Source: "t", Nullable: true},
+	{Name: "u", Type: sql.Uint8, Source: "t", Nullable: true},
+	{Name: "j", Type: sql.JSON, Source: "t", Nullable: true},
+}
+
+// readEvents decodes the events given, checking their checksums and positions.
+func readEvents(t *testing.T, events [][]byte) []mysql.BinlogEvent {
+	var decoded []mysql.BinlogEvent
+	for _, data := range events {
+		require.Equal(t, crc32.ChecksumIEEE(data[:len(data)-4]), binary.LittleEndian.Uint32(data[len(data)-4:]))
+		ev := mysql.NewMysql56BinlogEvent(data)
+		require.True(t, ev.IsValid())
+		decoded = append(decoded, ev)
+	}
+	return decoded
+}
+
+func dumpAll(t *testing.T, l *Log, file string, pos uint64) [][]byte {
+	s, err := l.Dump(file, pos)
+	require.NoError(t, err)
+
+	var events [][]byte
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		ev, err := s.Next(ctx)
+		cancel()
+		if err == context.DeadlineExceeded {
+			return events
+		}
+		require.NoError(t, err)
+		events = append(events, ev)
+	}
+}
+
+func TestLog(t *testing.T) {
+	require := require.New(t)
+
+	l, err := NewLog(Config{ServerID: 7, ServerUUID: testUUID})
+	require.NoError(err)
+	ctx := sql.NewEmptyContext()
+
+	d := time.Date(2021, 11, 8, 12, 30, 45, 123456000, time.UTC)
+	require.NoError(l.WriteTransaction(ctx, sql.BinlogTransaction{Timestamp: d, Events: []sql.BinlogEvent{
+		{Type: sql.BinlogQuery, Database: "mydb", Query: "CREATE TABLE t (...)"},
+		{Type: sql.BinlogInsert, Database: "mydb", Table: "t", Schema: testSchema, After: sql.Row{int64(1), "a", 1.5, d, uint8(200), sql.MustJSON(`{"a": 1}`)}},
+		{Type: sql.BinlogInsert, Database: "mydb", Table: "t", Schema: testSchema, After: sql.Row{int64(2), nil, nil, nil, nil, nil}},
+		{Type: sql.BinlogUpdate, Database: "mydb", Table: "t", Schema: testSchema, Before: sql.Row{int64(2), nil, nil, nil, nil, nil}, After: sql.Row{int64(2), "b", nil, nil, nil, nil}},
+	}}))
+	require.Equal(testUUID+":1-2", l.ExecutedGTIDs())
+
+	file, pos := l.Position()
+	require.Equal("binlog.000001", file)
+	require.Equal([]sql.BinaryLogFile{{Name: "binlog.000001", Size: pos}}, l.Files())
+
+	infos, err := l.Events("binlog.000001")
+	require.NoError(err)
+	var types []string
+	for _, e := range infos {
+		types = append(types, e.EventType)
+		require.Equal(uint32(7), e.ServerID)
+	}
+	require.Equal([]string{"Format_desc", "Previous_gtids", "Gtid", "Query", "Gtid", "Query", "Table_map", "Write_rows", "Table_map", "Update_rows", "Xid"}, types)
+	require.Equal("use `mydb`; CREATE TABLE t (...)", infos[3].Info)
+	require.Equal("SET @@SESSION.GTID_NEXT= '"+testUUID+":2'", infos[4].Info)
+	require.Equal("table_id: 1 (mydb.t)", infos[6].Info)
+	require.Equal("table_id: 1 flags: STMT_END_F", infos[9].Info)
+	require.Equal("COMMIT /* xid=2 */", infos[10].Info)
+	require.Equal(pos, infos[10].EndLogPos)
+
+	// Dumps start with an artificial rotate event, and are decoded by vitess like MySQL binary logs
+	events := readEvents(t, dumpAll(t, l, "", 0))
+	require.Len(events, len(infos)+1)
+	require.True(events[0].IsRotate())
+	require.True(events[1].IsFormatDescription())
+	f, err := events[1].Format()
+	require.NoError(err)
+
+	ev, _, err := events[5].StripChecksum(f)
+	require.NoError(err)
+	gtid, _, err := ev.GTID(f)
+	require.NoError(err)
+	require.Equal(testUUID+":2", gtid.String())
+
+	ev, _, err = events[7].StripChecksum(f)
+	require.NoError(err)
+	tm, err := ev.TableMap(f)
+	require.NoError(err)
+	require.Equal("t", tm.Name)
+	require.Equal("mydb", tm.Database)
+
+	ev, _, err = events[8].StripChecksum(f)
+	require.NoError(err)
+	require.True(ev.IsWriteRows())
+	rows, err := ev.Rows(f, tm)
+	require.NoError(err)
+	require.Len(rows.Rows, 2)
+	values, err := rows.StringValuesForTests(tm, 0)
+	require.NoError(err)
+	require.Equal([]string{"1", "a", "1.5E+00", "2021-11-08 12:30:45.123456", "200", `{"a":1}`}, values)
+	values, err = rows.StringValuesForTests(tm, 1)
+	require.NoError(err)
+	require.Equal([]string{"2", "NULL", "NULL", "NULL", "NULL", "NULL"}, values)
+
+	ev, _, err = events[10].StripChecksum(f)
+	require.NoError(err)
+	require.True(ev.IsUpdateRows())
+	rows, err = ev.Rows(f, tm)
+	require.NoError(err)
+	values, err = rows.StringIdentifiesForTests(tm, 0)
+	require.NoError(err)
+	require.Equal("NULL", values[1])
+	values, err = rows.StringValuesForTests(tm, 0)
+	require.NoError(err)
+	require.Equal("b", values[1])
+
+	// Values that can't be converted to the type of their column fail the whole transaction
+	require.Error(l.WriteTransaction(ctx, sql.BinlogTransaction{Events: []sql.BinlogEvent{
+		{Type: sql.BinlogDelete, Database: "mydb", Table: "t", Schema: testSchema, Before: sql.Row{"x", nil, nil, nil, nil, nil}},
+	}}))
+	_, newPos := l.Position()
+	require.Equal(pos, newPos)
+
+	_, err = l.Events("binlog.000002")
+	require.True(sql.ErrBinaryLogNotFound.Is(err))
+}
+
+func TestLogRotation(t *testing.T) {
+	require := require.New(t)
+
+	l, err := NewLog(Config{ServerUUID: testUUID, BaseName: "mysql-bin", MaxFileSize: 500})
+	require.NoError(err)
+	ctx := sql.NewEmptyContext()
+
+	s, err := l.Dump("", 0)
+	require.NoError(err)
+	for i := 0; i < 3; i++ {
+		_, err := s.Next(context.Background())
+		require.NoError(err)
+	}
+
+	// Streamers wait for new events
+	received := make(chan []byte)
+	go func() {
+		ev, err := s.Next(context.Background())
+		require.NoError(err)
+		received <- ev
+	}()
+
+	insert := sql.BinlogTransaction{Events: []sql.BinlogEvent{
+		{Type: sql.BinlogInsert, Database: "mydb", Table: "t", Schema: testSchema, After: sql.Row{int64(1), "a", 1.5, nil, nil, nil}},
+	}}
+	require.NoError(l.WriteTransaction(ctx, insert))
+	require.True(mysql.NewMysql56BinlogEvent(<-received).IsGTID())
+
+	require.NoError(l.WriteTransaction(ctx, insert))
+	var names []string
+	for _, f := range l.Files() {
+		names = append(names, f.Name)
+	}
+	require.Equal([]string{"mysql-bin.000001", "mysql-bin.000002"}, names)
+
+	infos, err := l.Events("mysql-bin.000001")
+	require.NoError(err)
+	require.Equal("Rotate", infos[len(infos)-1].EventType)
+	require.Equal("mysql-bin.000002;pos=4", infos[len(infos)-1].Info)
+	infos, err = l.Events("mysql-bin.000002")
+	require.NoError(err)
+	require.Equal(testUUID+":1-2", infos[1].Info)
+
+	// Streamers follow the log to the next file
+	events := readEvents(t, dumpAll(t, l, "mysql-bin.000001", infos[0].Pos))
+	require.True(events[0].IsRotate())
+
+	var rest [][]byte
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		ev, err := s.Next(ctx)
+		cancel()
+		if err != nil {
+			break
+		}
+		rest = append(rest, ev)
+	}
+	events = readEvents(t, rest)
+	require.True(events[len(events)-3].IsRotate())
+	require.True(events[len(events)-2].IsFormatDescription())
+	require.True(events[len(events)-1].IsPreviousGTIDs())
+	file, pos := s.Position()
+	require.Equal("mysql-bin.000002", file)
+	require.Equal(infos[len(infos)-1].EndLogPos, pos)
+
+	require.NoError(l.Purge("mysql-bin.000002"))
+	require.Len(l.Files(), 1)
+	_, err = l.Dump("mysql-bin.000001", 4)
+	require.True(sql.ErrBinaryLogNotFound.Is(err))
+}
+
+func TestLogDumpGTIDs(t *testing.T) {
+	require := require.New(t)
+
+	l, err := NewLog(Config{ServerUUID: testUUID, MaxFileSize: 500})
+	require.NoError(err)
+	ctx := sql.NewEmptyContext()
+
+	insert := sql.BinlogTransaction{Events: []sql.BinlogEvent{
+		{Type: sql.BinlogInsert, Database: "mydb", Table: "t", Schema: testSchema, After: sql.Row{int64(1), "a", 1.5, nil, nil, nil}},
+	}}
+	for i := 0; i < 3; i++ {
+		require.NoError(l.WriteTransaction(ctx, insert))
+	}
+	require.Len(l.Files(), 2)
+
+	gtids := func(events []mysql.BinlogEvent) []string {
+		var gtids []string
+		for _, ev := range events {
+			if ev.IsGTID() {
+				ev, _, err := ev.StripChecksum(l.Format())
+				require.NoError(err)
+				gtid, _, err := ev.GTID(l.Format())
+				require.NoError(err)
+				gtids = append(gtids, gtid.String())
+			}
+		}
+		return gtids
+	}
+
+	// Replicas get the transactions they miss, from the newest file whose previous transactions they have
+	s, err := l.DumpGTIDs(testUUID + ":1")
+	require.NoError(err)
+	events := readEvents(t, dumpAll(t, l, "", 0))
+	require.Equal([]string{testUUID + ":1", testUUID + ":2", testUUID + ":3"}, gtids(events))
+
+	var rest [][]byte
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		ev, err := s.Next(ctx)
+		cancel()
+		if err != nil {
+			break
+		}
+		rest = append(rest, ev)
+	}
+	events = readEvents(t, rest)
+	require.True(events[0].IsRotate())
+	require.Equal([]string{testUUID + ":2", testUUID + ":3"}, gtids(events))
+
+	s, err = l.DumpGTIDs(testUUID + ":1-2")
+	require.NoError(err)
+	file, _ := s.Position()
+	require.Equal("binlog.000002", file)
+	require.True(mysql.NewMysql56BinlogEvent(s.Heartbeat()).IsValid())
+
+	require.NoError(l.Purge("binlog.000002"))
+	_, err = l.DumpGTIDs("")
+	require.True(sql.ErrBinaryLogPurged.Is(err))
+	_, err = l.DumpGTIDs(testUUID + ":1-2")
+	require.NoError(err)
+}
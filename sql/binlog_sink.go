@@ -0,0 +1,334 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BinlogEventType is the kind of change of a BinlogEvent.
+type BinlogEventType byte
+
+const (
+	// BinlogInsert events add the After row to their table.
+	BinlogInsert BinlogEventType = iota
+	// BinlogUpdate events replace the Before row of their table with the After row.
+	BinlogUpdate
+	// BinlogDelete events remove the Before row from their table.
+	BinlogDelete
+	// BinlogQuery events are statements that change the schema of their database, such as CREATE TABLE, which are
+	// sent as their Query.
+	BinlogQuery
+)
+
+func (t BinlogEventType) String() string {
+	switch t {
+	case BinlogInsert:
+		return "insert"
+	case BinlogUpdate:
+		return "update"
+	case BinlogDelete:
+		return "delete"
+	case BinlogQuery:
+		return "query"
+	default:
+		return "unknown"
+	}
+}
+
+// BinlogEvent is a change written to the binary log: the change of a single row of a table, or a statement that
+// changes the schema of a database.
+type BinlogEvent struct {
+	Type     BinlogEventType
+	Database string
+	// Table is the table of row events, and Schema is its schema.
+	Table  string
+	Schema Schema
+	Before Row
+	After  Row
+	// Query is the statement of query events.
+	Query string
+}
+
+// BinlogTransaction is the events of a transaction, in the order they were written, sent to the sinks of a Binlog
+// once it commits.
+type BinlogTransaction struct {
+	// Sequence is the commit sequence number of the session that committed the transaction, or zero if the engine
+	// doesn't number its commits.
+	Sequence uint64
+	// Timestamp is the time the transaction was committed.
+	Timestamp time.Time
+	Events    []BinlogEvent
+}
+
+// BinlogSink receives the transactions committed through an engine, so that integrators can ship its changes
+// elsewhere, such as to a message queue or to object storage, without being MySQL replicas.
+type BinlogSink interface {
+	// WriteTransaction receives a committed transaction. Transactions are written one at a time, in the order they
+	// were committed. An error is returned to the client that committed the transaction, which stays committed.
+	WriteTransaction(ctx *Context, tx BinlogTransaction) error
+}
+
+// BinlogSinkFunc is a function that implements the BinlogSink interface.
+type BinlogSinkFunc func(ctx *Context, tx BinlogTransaction) error
+
+var _ BinlogSink = BinlogSinkFunc(nil)
+
+// WriteTransaction implements the BinlogSink interface.
+func (f BinlogSinkFunc) WriteTransaction(ctx *Context, tx BinlogTransaction) error {
+	return f(ctx, tx)
+}
+
+// RowChange is the change of a row of a table committed through an engine.
+type RowChange struct {
+	Database string
+	Table    string
+	Schema   Schema
+	// Operation is BinlogInsert, BinlogUpdate or BinlogDelete.
+	Operation BinlogEventType
+	// Old is the row before the change, which inserts don't have, and New the row after it, which deletes don't have.
+	Old Row
+	New Row
+}
+
+// ChangeListener is notified of the changes of rows committed through an engine, so that integrators can invalidate
+// caches or capture the changes without writing a binary log. Like the sinks of the binlog of the engine, listeners
+// only receive the changes that pass the binlog filters of the engine.
+type ChangeListener interface {
+	// RowsChanged receives the changes of the rows of a transaction once it commits, in the order they were made.
+	// Transactions are received one at a time, in the order they were committed. Changes of schemas aren't received.
+	RowsChanged(ctx *Context, changes []RowChange)
+}
+
+// ChangeListenerFunc is a function that implements the ChangeListener interface.
+type ChangeListenerFunc func(ctx *Context, changes []RowChange)
+
+var _ ChangeListener = ChangeListenerFunc(nil)
+
+// RowsChanged implements the ChangeListener interface.
+func (f ChangeListenerFunc) RowsChanged(ctx *Context, changes []RowChange) {
+	f(ctx, changes)
+}
+
+// NewChangeListenerSink returns a binlog sink notifying the listener given of the changes of rows of the transactions
+// it receives.
+func NewChangeListenerSink(l ChangeListener) BinlogSink {
+	return BinlogSinkFunc(func(ctx *Context, tx BinlogTransaction) error {
+		var changes []RowChange
+		for _, e := range tx.Events {
+			if e.Type == BinlogQuery {
+				continue
+			}
+			changes = append(changes, RowChange{
+				Database:  e.Database,
+				Table:     e.Table,
+				Schema:    e.Schema,
+				Operation: e.Type,
+				Old:       e.Before,
+				New:       e.After,
+			})
+		}
+		if len(changes) > 0 {
+			l.RowsChanged(ctx, changes)
+		}
+		return nil
+	})
+}
+
+// BinaryLogFile is a file of a BinaryLog, as listed by SHOW BINARY LOGS.
+type BinaryLogFile struct {
+	Name string
+	Size uint64
+}
+
+// BinaryLogEventInfo describes an event of a file of a BinaryLog, as listed by SHOW BINLOG EVENTS.
+type BinaryLogEventInfo struct {
+	LogName   string
+	Pos       uint64
+	EventType string
+	ServerID  uint32
+	EndLogPos uint64
+	Info      string
+}
+
+// BinaryLog is a sink that writes the transactions it receives as the row based events of a binary log in the format
+// of MySQL, so that MySQL replicas and change data capture tools can follow the changes committed through an engine.
+// Engines only write a binary log when one is set on their Binlog, which the binary log statements then describe.
+type BinaryLog interface {
+	BinlogSink
+	// Files returns the files of the log, oldest first.
+	Files() []BinaryLogFile
+	// Position returns the file written to, and the position in it the next event will be written at.
+	Position() (file string, pos uint64)
+	// ExecutedGTIDs returns the set of the GTIDs of the transactions written to the log, in the format of
+	// @@gtid_executed.
+	ExecutedGTIDs() string
+	// Events describes the events of the file given, in the order they were written.
+	Events(file string) ([]BinaryLogEventInfo, error)
+	// Purge deletes the files written before the file given.
+	Purge(file string) error
+}
+
+// ReplicationSource is a BinaryLog that replicas can read with the replication protocol. Servers answer the
+// COM_BINLOG_DUMP and COM_BINLOG_DUMP_GTID commands of replicas with the events of their binary log when it's a
+// replication source.
+type ReplicationSource interface {
+	BinaryLog
+	// ServerID returns the server ID of the events of the log.
+	ServerID() uint32
+	// ServerUUID returns the source ID of the GTIDs of the transactions of the log.
+	ServerUUID() string
+	// Dump returns a stream of the events of the log from the file and position given. An empty file name starts from
+	// the oldest file.
+	Dump(file string, pos uint64) (BinlogStream, error)
+	// DumpGTIDs returns a stream of the events of the transactions of the log whose GTIDs aren't in the set given, in
+	// the format of @@gtid_executed, which is how replicas using GTID auto-positioning ask for the transactions they
+	// miss. An error is returned if some of these transactions were purged.
+	DumpGTIDs(executed string) (BinlogStream, error)
+}
+
+// BinlogStream is a stream of the events of a binary log, which waits for new events once it has returned them all.
+type BinlogStream interface {
+	// Next returns the next event, in the format of MySQL, waiting for one to be written until the context given is
+	// done. The event returned must not be modified.
+	Next(ctx context.Context) ([]byte, error)
+	// Position returns the file and the position of the event returned next.
+	Position() (file string, pos uint64)
+	// Heartbeat returns a heartbeat event telling the position of the stream, which is sent to replicas while there
+	// are no new events.
+	Heartbeat() []byte
+}
+
+// Binlog collects the events written by the statements of each session, and sends them to its sinks once the
+// transaction they're part of commits. Events are only collected while the binlog has sinks, and only the changes to
+// the databases and tables that pass the binlog filters of the context are collected.
+type Binlog struct {
+	mu    sync.Mutex
+	sinks []BinlogSink
+	log   BinaryLog
+	// pending has the events of the transactions that haven't ended yet, by session
+	pending map[uint32][]BinlogEvent
+}
+
+// NewBinlog creates a new Binlog without sinks.
+func NewBinlog() *Binlog {
+	return &Binlog{pending: make(map[uint32][]BinlogEvent)}
+}
+
+// AddSink adds a sink receiving the transactions committed from now on.
+func (b *Binlog) AddSink(s BinlogSink) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sinks = append(b.sinks, s)
+}
+
+// SetBinaryLog adds the binary log given as a sink, and makes it the binary log of the engine described by the binary
+// log statements.
+func (b *Binlog) SetBinaryLog(l BinaryLog) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sinks = append(b.sinks, l)
+	b.log = l
+}
+
+// BinaryLog returns the binary log of the engine, or nil if it doesn't write one.
+func (b *Binlog) BinaryLog() BinaryLog {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.log
+}
+
+// HasSinks returns whether the binlog has any sinks, and so whether it collects events.
+func (b *Binlog) HasSinks() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.sinks) > 0
+}
+
+// Write adds the events given to the transaction of the session of the context given.
+func (b *Binlog) Write(ctx *Context, events ...BinlogEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.sinks) == 0 {
+		return
+	}
+
+	b.pending[ctx.Session.ID()] = append(b.pending[ctx.Session.ID()], filterBinlogEvents(ctx, events)...)
+}
+
+// Len returns the number of events of the transaction of the session of the context given so far.
+func (b *Binlog) Len(ctx *Context) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.pending[ctx.Session.ID()])
+}
+
+// Insert adds the events given to the transaction of the session of the context given before its event at the index
+// given, such as the number of events returned by Len before a statement wrote its own.
+func (b *Binlog) Insert(ctx *Context, at int, events ...BinlogEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.sinks) == 0 {
+		return
+	}
+
+	pending := b.pending[ctx.Session.ID()]
+	if at > len(pending) {
+		at = len(pending)
+	}
+	events = filterBinlogEvents(ctx, events)
+	inserted := make([]BinlogEvent, 0, len(pending)+len(events))
+	inserted = append(inserted, pending[:at]...)
+	inserted = append(inserted, events...)
+	b.pending[ctx.Session.ID()] = append(inserted, pending[at:]...)
+}
+
+// filterBinlogEvents returns the events given that pass the binlog filters of the context given.
+func filterBinlogEvents(ctx *Context, events []BinlogEvent) []BinlogEvent {
+	if ctx.BinlogFilters == nil {
+		return events
+	}
+
+	var filtered []BinlogEvent
+	for _, e := range events {
+		if ctx.BinlogFilters.ShouldReplicate(e.Database, e.Table) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+// EndTransaction ends the transaction of the session of the context given. If it was committed and wrote any events,
+// they're sent to the sinks with the sequence number given, and otherwise they're discarded.
+func (b *Binlog) EndTransaction(ctx *Context, committed bool, seq uint64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	events := b.pending[ctx.Session.ID()]
+	delete(b.pending, ctx.Session.ID())
+	if !committed || len(events) == 0 {
+		return nil
+	}
+
+	tx := BinlogTransaction{Sequence: seq, Timestamp: time.Now(), Events: events}
+	for _, s := range b.sinks {
+		if err := s.WriteTransaction(ctx, tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinlog(t *testing.T) {
+	require := require.New(t)
+
+	b := NewBinlog()
+	ctx1 := NewContext(context.Background(), WithSession(NewBaseSessionWithClientServer("", Client{}, 1)))
+	ctx2 := NewContext(context.Background(), WithSession(NewBaseSessionWithClientServer("", Client{}, 2)))
+
+	insert := BinlogEvent{Type: BinlogInsert, Database: "mydb", Table: "t", After: Row{int64(1)}}
+	del := BinlogEvent{Type: BinlogDelete, Database: "mydb", Table: "t", Before: Row{int64(1)}}
+
+	// Events aren't collected without sinks
+	b.Write(ctx1, insert)
+	require.False(b.HasSinks())
+
+	var written []BinlogTransaction
+	b.AddSink(BinlogSinkFunc(func(ctx *Context, tx BinlogTransaction) error {
+		require.False(tx.Timestamp.IsZero())
+		tx.Timestamp = time.Time{}
+		written = append(written, tx)
+		return nil
+	}))
+	require.True(b.HasSinks())
+	require.NoError(b.EndTransaction(ctx1, true, 0))
+	require.Empty(written)
+
+	b.Write(ctx1, insert)
+	b.Write(ctx2, del)
+	b.Write(ctx1, del)
+	require.NoError(b.EndTransaction(ctx1, true, 1))
+	require.NoError(b.EndTransaction(ctx2, false, 0))
+	require.Equal([]BinlogTransaction{{Sequence: 1, Events: []BinlogEvent{insert, del}}}, written)
+
+	// Changes that don't pass the filters aren't collected
+	ctx1.BinlogFilters = NewReplicationFilterSet(ReplicationFilters{IgnoreTables: []string{"mydb.t"}})
+	other := BinlogEvent{Type: BinlogInsert, Database: "mydb", Table: "u", After: Row{int64(2)}}
+	b.Write(ctx1, insert, other)
+	require.NoError(b.EndTransaction(ctx1, true, 2))
+	require.Equal(BinlogTransaction{Sequence: 2, Events: []BinlogEvent{other}}, written[1])
+}
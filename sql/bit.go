@@ -17,7 +17,6 @@ package sql
 import (
 	"encoding/binary"
 	"fmt"
-	"strconv"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -173,7 +172,8 @@ func (t bitType) Promote() Type {
 	return promotedBitType
 }
 
-// SQL implements Type interface.
+// SQL implements Type interface. Like MySQL, the value is sent as a big-endian binary string of the minimum number of
+// bytes that hold all the bits of the type.
 func (t bitType) SQL(v interface{}) (sqltypes.Value, error) {
 	if v == nil {
 		return sqltypes.NULL, nil
@@ -182,7 +182,9 @@ func (t bitType) SQL(v interface{}) (sqltypes.Value, error) {
 	if err != nil {
 		return sqltypes.Value{}, err
 	}
-	return sqltypes.MakeTrusted(sqltypes.Bit, strconv.AppendUint(nil, value.(uint64), 10)), nil
+	bytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(bytes, value.(uint64))
+	return sqltypes.MakeTrusted(sqltypes.Bit, bytes[8-(int(t.numOfBits)+7)/8:]), nil
 }
 
 // String implements Type interface.
@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dolthub/vitess/go/vt/proto/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -133,6 +134,28 @@ func TestBitConvert(t *testing.T) {
 	}
 }
 
+func TestBitSQL(t *testing.T) {
+	tests := []struct {
+		typ           Type
+		val           interface{}
+		expectedBytes []byte
+	}{
+		{MustCreateBitType(1), uint64(1), []byte{1}},
+		{MustCreateBitType(8), uint64(0xa5), []byte{0xa5}},
+		{MustCreateBitType(10), uint64(0x2ff), []byte{0x2, 0xff}},
+		{MustCreateBitType(64), uint64(0x0102030405060708), []byte{1, 2, 3, 4, 5, 6, 7, 8}},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.typ, test.val), func(t *testing.T) {
+			val, err := test.typ.SQL(test.val)
+			require.NoError(t, err)
+			assert.Equal(t, query.Type_BIT, val.Type())
+			assert.Equal(t, test.expectedBytes, val.ToBytes())
+		})
+	}
+}
+
 func TestBitString(t *testing.T) {
 	tests := []struct {
 		typ         Type
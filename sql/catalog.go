@@ -30,6 +30,10 @@ type Catalog interface {
 	// RemoveDatabase removes the  database named, or returns an error if the operation isn't supported or fails.
 	RemoveDatabase(ctx *Context, dbName string) error
 
+	// CreateSessionDatabase creates a new database that only exists in the session of the context given, or returns an
+	// error if the operation isn't supported or fails.
+	CreateSessionDatabase(ctx *Context, dbName string) error
+
 	// Table returns the table with the name given in the db with the name given
 	Table(ctx *Context, dbName, tableName string) (Table, Database, error)
 
@@ -0,0 +1,73 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+)
+
+// characterSetEncodings are the encodings of the bytes of the character sets that can be converted to and from the
+// UTF-8 strings the engine works with. The bytes of utf8 and its subsets are used as they are, like binary ones, and
+// latin1 is cp1252, like in MySQL, rather than ISO 8859-1.
+var characterSetEncodings = map[CharacterSet]encoding.Encoding{
+	CharacterSet_ascii:    encoding.Nop,
+	CharacterSet_big5:     traditionalchinese.Big5,
+	CharacterSet_binary:   encoding.Nop,
+	CharacterSet_cp1250:   charmap.Windows1250,
+	CharacterSet_cp1251:   charmap.Windows1251,
+	CharacterSet_cp1256:   charmap.Windows1256,
+	CharacterSet_cp1257:   charmap.Windows1257,
+	CharacterSet_cp850:    charmap.CodePage850,
+	CharacterSet_cp852:    charmap.CodePage852,
+	CharacterSet_cp866:    charmap.CodePage866,
+	CharacterSet_cp932:    japanese.ShiftJIS,
+	CharacterSet_eucjpms:  japanese.EUCJP,
+	CharacterSet_euckr:    korean.EUCKR,
+	CharacterSet_gb18030:  simplifiedchinese.GB18030,
+	CharacterSet_gb2312:   simplifiedchinese.GBK,
+	CharacterSet_gbk:      simplifiedchinese.GBK,
+	CharacterSet_greek:    charmap.ISO8859_7,
+	CharacterSet_hebrew:   charmap.ISO8859_8,
+	CharacterSet_koi8r:    charmap.KOI8R,
+	CharacterSet_koi8u:    charmap.KOI8U,
+	CharacterSet_latin1:   charmap.Windows1252,
+	CharacterSet_latin2:   charmap.ISO8859_2,
+	CharacterSet_latin5:   charmap.ISO8859_9,
+	CharacterSet_latin7:   charmap.ISO8859_13,
+	CharacterSet_macroman: charmap.Macintosh,
+	CharacterSet_sjis:     japanese.ShiftJIS,
+	CharacterSet_tis620:   charmap.Windows874,
+	CharacterSet_ucs2:     unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM),
+	CharacterSet_ujis:     japanese.EUCJP,
+	CharacterSet_utf16:    unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM),
+	CharacterSet_utf16le:  unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM),
+	CharacterSet_utf32:    utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM),
+	CharacterSet_utf8mb3:  encoding.Nop,
+	CharacterSet_utf8mb4:  encoding.Nop,
+}
+
+// Encoding returns the encoding of the bytes of the character set, which converts them to and from UTF-8, or false if
+// the engine can't convert the character set.
+func (cs CharacterSet) Encoding() (encoding.Encoding, bool) {
+	enc, ok := characterSetEncodings[cs]
+	return enc, ok
+}
@@ -56,24 +56,35 @@ func insensitiveCompare(a, b string) int {
 	return strings.Compare(lowerA, lowerB)
 }
 
+func insensitiveSortKey(s string) []byte {
+	return []byte(strings.ToLower(s))
+}
+
+func sensitiveSortKey(s string) []byte {
+	return []byte(s)
+}
+
 // Collation represents the collation of a string.
 type Collation struct {
 	Name        string
 	CharSet     CharacterSet
 	Compare     func(as, bs string) int
 	LikeMatcher func(likeStr string) (regex.DisposableMatcher, error)
+	// SortKey returns a binary-comparable key for the string given, such that comparing the keys of two strings with
+	// bytes.Compare gives the same result as comparing the strings with Compare.
+	SortKey func(s string) []byte
 }
 
 var Collations = map[string]Collation{}
 
 func newCollation(name string, cs CharacterSet) Collation {
-	c := Collation{Name: name, CharSet: cs, Compare: insensitiveCompare, LikeMatcher: insensitiveLikeMatcher}
+	c := Collation{Name: name, CharSet: cs, Compare: insensitiveCompare, LikeMatcher: insensitiveLikeMatcher, SortKey: insensitiveSortKey}
 	Collations[name] = c
 	return c
 }
 
 func newCSCollation(name string, cs CharacterSet) Collation {
-	c := Collation{Name: name, CharSet: cs, Compare: strings.Compare, LikeMatcher: sensitiveLikeMatcher}
+	c := Collation{Name: name, CharSet: cs, Compare: strings.Compare, LikeMatcher: sensitiveLikeMatcher, SortKey: sensitiveSortKey}
 	Collations[name] = c
 	return c
 }
@@ -0,0 +1,144 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"sync"
+)
+
+// CommitListener is called with the sequence number of each commit, in the order of the sequence numbers.
+type CommitListener func(ctx *Context, seq uint64)
+
+// CommitSequence numbers the writes committed through an engine with monotonically increasing sequence numbers, so
+// that change data capture (CDC) consumers can tell clients when they've caught up with a write. The last sequence
+// number assigned to a session is returned by the LAST_COMMIT_SEQUENCE() function and sent in the OK packet of the
+// statement that committed it. Consumers learn the sequence numbers of commits through the listeners of the sequence,
+// and keep the last one they processed in a Watermark that clients can wait on for their sequence number.
+type CommitSequence struct {
+	Watermark
+
+	mu        sync.Mutex
+	last      uint64
+	listeners []CommitListener
+	// pending has the sessions that wrote in a transaction that hasn't ended yet
+	pending map[uint32]bool
+}
+
+// NewCommitSequence creates a new CommitSequence.
+func NewCommitSequence() *CommitSequence {
+	return &CommitSequence{pending: make(map[uint32]bool)}
+}
+
+// AddListener adds a listener called after each commit. Listeners are called one at a time, so they must not block.
+func (s *CommitSequence) AddListener(l CommitListener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners = append(s.listeners, l)
+}
+
+// Wrote records that a statement of the session of the context given wrote. The write is assigned a sequence number
+// right away if it was committed, or when the transaction it's part of commits otherwise.
+func (s *CommitSequence) Wrote(ctx *Context, committed bool) {
+	if committed {
+		s.commit(ctx)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[ctx.Session.ID()] = true
+}
+
+// EndTransaction records that the transaction of the session of the context given ended, assigning a sequence number
+// to it if it was committed and wrote.
+func (s *CommitSequence) EndTransaction(ctx *Context, committed bool) {
+	s.mu.Lock()
+	wrote := s.pending[ctx.Session.ID()]
+	delete(s.pending, ctx.Session.ID())
+	s.mu.Unlock()
+
+	if wrote && committed {
+		s.commit(ctx)
+	}
+}
+
+// commit assigns the next sequence number to a commit of the session of the context given, stores it as the last
+// commit sequence number of the session and notifies the listeners.
+func (s *CommitSequence) commit(ctx *Context) uint64 {
+	s.mu.Lock()
+	s.last++
+	seq := s.last
+	for _, l := range s.listeners {
+		l(ctx, seq)
+	}
+	s.mu.Unlock()
+
+	ctx.SetLastQueryInfo(LastCommitSequence, int64(seq))
+	s.Advance(seq)
+	return seq
+}
+
+// Watermark is a sequence number that only moves forward and can be waited on. The zero value is ready to use.
+type Watermark struct {
+	mu      sync.Mutex
+	value   uint64
+	changed chan struct{}
+}
+
+// Value returns the current sequence number of the watermark.
+func (w *Watermark) Value() uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.value
+}
+
+// Advance moves the watermark to the sequence number given, unless it's already past it, and wakes up the waiters
+// it reached.
+func (w *Watermark) Advance(seq uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if seq <= w.value {
+		return
+	}
+
+	w.value = seq
+	if w.changed != nil {
+		close(w.changed)
+		w.changed = nil
+	}
+}
+
+// Wait blocks until the watermark reaches the sequence number given, or the context given is done.
+func (w *Watermark) Wait(ctx context.Context, seq uint64) error {
+	for {
+		w.mu.Lock()
+		if w.value >= seq {
+			w.mu.Unlock()
+			return nil
+		}
+		if w.changed == nil {
+			w.changed = make(chan struct{})
+		}
+		changed := w.changed
+		w.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
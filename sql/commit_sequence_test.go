@@ -0,0 +1,86 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitSequence(t *testing.T) {
+	require := require.New(t)
+
+	s := NewCommitSequence()
+	var notified []uint64
+	s.AddListener(func(ctx *Context, seq uint64) {
+		notified = append(notified, seq)
+	})
+
+	ctx1 := NewContext(context.Background(), WithSession(NewBaseSessionWithClientServer("", Client{}, 1)))
+	ctx2 := NewContext(context.Background(), WithSession(NewBaseSessionWithClientServer("", Client{}, 2)))
+
+	s.Wrote(ctx1, true)
+	require.Equal(int64(1), ctx1.GetLastQueryInfo(LastCommitSequence))
+
+	// Writes in a transaction are numbered when it commits
+	s.Wrote(ctx2, false)
+	s.Wrote(ctx2, false)
+	s.Wrote(ctx1, true)
+	require.Equal(int64(0), ctx2.GetLastQueryInfo(LastCommitSequence))
+	s.EndTransaction(ctx2, true)
+	require.Equal(int64(3), ctx2.GetLastQueryInfo(LastCommitSequence))
+
+	// Rolled back transactions and transactions that didn't write aren't numbered
+	s.Wrote(ctx1, false)
+	s.EndTransaction(ctx1, false)
+	s.EndTransaction(ctx1, true)
+	require.Equal(int64(2), ctx1.GetLastQueryInfo(LastCommitSequence))
+
+	require.Equal([]uint64{1, 2, 3}, notified)
+	require.Equal(uint64(3), s.Value())
+}
+
+func TestWatermark(t *testing.T) {
+	require := require.New(t)
+
+	var w Watermark
+	require.NoError(w.Wait(context.Background(), 0))
+
+	done := make(chan error)
+	go func() {
+		done <- w.Wait(context.Background(), 2)
+	}()
+
+	w.Advance(1)
+	select {
+	case <-done:
+		require.Fail("wait returned before the watermark reached its sequence number")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	w.Advance(3)
+	require.NoError(<-done)
+
+	// The watermark never moves back
+	w.Advance(2)
+	require.Equal(uint64(3), w.Value())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Equal(context.Canceled, w.Wait(ctx, 4))
+}
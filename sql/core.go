@@ -82,6 +82,14 @@ type NonDeterministicExpression interface {
 	IsNonDeterministic() bool
 }
 
+// MonotonicExpression is an expression whose value never decreases as the value of one of its children increases, so
+// that rows sorted on that child come sorted on the expression too.
+type MonotonicExpression interface {
+	Expression
+	// MonotonicArgument returns the child the expression is monotonic in, or nil if it isn't for its other children.
+	MonotonicArgument() Expression
+}
+
 // Aggregation implements an aggregation expression, where an
 // aggregation buffer is created for each grouping (NewBuffer). Rows for the
 // grouping should be fed to the buffer with |Update| and the buffer should be
@@ -247,6 +255,29 @@ type ProjectedTable interface {
 	WithProjection(colNames []string) Table
 }
 
+// ExpressionProjectedTable is a ProjectedTable that can also compute simple expressions over its own columns, such as
+// substrings or casts, so that the engine doesn't need to.
+type ExpressionProjectedTable interface {
+	ProjectedTable
+	// HandledProjections returns the subset of the expressions given that the table is able to compute.
+	HandledProjections(exprs []Expression) []Expression
+	// WithProjectedExpressions returns a table that computes the expressions given, which are a subset of the ones
+	// returned by HandledProjections. Their values are appended to every row in the same order, and its schema has a
+	// column for each of them, named after the expression, appended to the schema of the table.
+	WithProjectedExpressions(ctx *Context, exprs []Expression) Table
+}
+
+// RowFetchTable is a ProjectedTable that can fetch its rows by primary key. When a query filters such a table on some
+// of its columns but returns wide ones, such as TEXT, BLOB or JSON columns, the table is first read projected on the
+// filtered and primary key columns, and the full rows are fetched only for the rows that pass the filter. This is
+// known as late materialization, and it saves reading the wide columns of the rows that are filtered out.
+type RowFetchTable interface {
+	ProjectedTable
+	// FetchRows returns the full rows with the primary keys given, in the same order. Each key holds the values of the
+	// primary key columns of a row, in schema order, and every key belongs to a row of the table.
+	FetchRows(ctx *Context, keys []Row) (RowIter, error)
+}
+
 // StatisticsTable is a table that can provide information about its number of rows and other facts to improve query
 // planning performance.
 type StatisticsTable interface {
@@ -257,6 +288,44 @@ type StatisticsTable interface {
 	DataLength(ctx *Context) (uint64, error)
 }
 
+// AnalyzableTable is a StatisticsTable whose statistics are computed by ANALYZE TABLE instead of being kept up to date
+// as the table changes. The engine counts the rows changed by the statements that write to the table, and refreshes its
+// statistics in the background once the fraction of its rows changed since they were last computed reaches the
+// stats_auto_recalc_threshold system variable.
+type AnalyzableTable interface {
+	StatisticsTable
+	// AnalyzeTable recomputes the statistics of the table.
+	AnalyzeTable(ctx *Context) error
+}
+
+// ColumnStatistics summarizes the values of a column in a partition of a table.
+type ColumnStatistics struct {
+	// Min and Max are the smallest and largest non-NULL values of the column. Both are nil if the column has no
+	// non-NULL values.
+	Min, Max interface{}
+	// NullCount is the number of NULL values of the column.
+	NullCount uint64
+}
+
+// PartitionStatistics summarizes the rows of a partition of a table.
+type PartitionStatistics struct {
+	// RowCount is the number of rows of the partition.
+	RowCount uint64
+	// Columns holds the statistics of the columns of the partition, by lowercase column name. Columns without
+	// statistics are missing.
+	Columns map[string]ColumnStatistics
+}
+
+// ZoneMapTable is a table that keeps statistics of the values of its columns in each of its partitions, also known as
+// zone maps. Scans of the table that filter it skip the partitions whose statistics show that none of their rows can
+// match the filter, which is a large win for tables whose partitions hold sorted or clustered values.
+type ZoneMapTable interface {
+	Table
+	// PartitionStatistics returns the statistics of the partition given. Statistics must include every row of the
+	// partition, but their bounds don't need to be tight.
+	PartitionStatistics(ctx *Context, partition Partition) (PartitionStatistics, error)
+}
+
 // IndexUsing is the desired storage type.
 type IndexUsing byte
 
@@ -302,6 +371,15 @@ type IndexAddressableTable interface {
 	WithIndexLookup(IndexLookup) Table
 }
 
+// BatchedIndexAddressableTable is an IndexAddressableTable that can return the rows of many index lookups in a single
+// call. Index joins use it to look up the rows matching a batch of rows of the primary table at once, which saves
+// integrators with remote storage a round trip per lookup.
+type BatchedIndexAddressableTable interface {
+	IndexAddressableTable
+	// IndexLookupBatch returns an iterator over the rows of each of the lookups given, in the same order.
+	IndexLookupBatch(*Context, []IndexLookup) ([]RowIter, error)
+}
+
 // IndexAlterableTable represents a table that supports index modification operations.
 type IndexAlterableTable interface {
 	Table
@@ -524,6 +602,17 @@ type FunctionProvider interface {
 	Function(name string) (Function, error)
 }
 
+// FunctionDatabase is a Database with functions of its own, such as the custom functions of a tenant of a multi-tenant
+// integration, which other databases don't see. They're called qualified with the name of their database, as in
+// mydb.myfunc(), or unqualified when their database is the current one, as long as no global function has their name.
+type FunctionDatabase interface {
+	Database
+
+	// Function returns the function of the database with the name provided, case-insensitive, or
+	// ErrFunctionNotFound if the database doesn't have one.
+	Function(ctx *Context, name string) (Function, error)
+}
+
 // Database represents the database.
 type Database interface {
 	Nameable
@@ -626,7 +715,8 @@ type TriggerDatabase interface {
 }
 
 // TemporaryTableDatabase is a database that can query the session (which manages the temporary table state) to
-// retrieve the name of all temporary tables.
+// retrieve the name of all temporary tables. The engine resolves table names to these tables before the persistent
+// tables of the database, so temporary tables shadow the persistent tables with the same name.
 type TemporaryTableDatabase interface {
 	GetAllTemporaryTables(ctx *Context) ([]Table, error)
 }
@@ -823,6 +913,34 @@ type StoredProcedureDatabase interface {
 	DropStoredProcedure(ctx *Context, name string) error
 }
 
+// UserFunctionDetails are the details of a user-defined function, created with CREATE FUNCTION. Integrators only need
+// to store and retrieve the given details for a function, as the engine handles all parsing and processing.
+type UserFunctionDetails struct {
+	Name            string    // The name of this function. Names must be unique within a database.
+	CreateStatement string    // The CREATE statement for this function.
+	CreatedAt       time.Time // The time that the function was created.
+	ModifiedAt      time.Time // The time of the last modification to the function.
+}
+
+// UserFunctionDatabase is a database that supports the creation of user-defined functions, such as functions compiled
+// to WebAssembly. They're called like the functions of a FunctionDatabase: qualified with the name of their database,
+// or unqualified when their database is the current one, as long as no global function has their name. Integrators
+// only need to store and retrieve UserFunctionDetails, while verifying that all functions have a unique name without
+// regard to case-sensitivity.
+type UserFunctionDatabase interface {
+	Database
+
+	// GetUserFunctions returns all UserFunctionDetails for the database.
+	GetUserFunctions(ctx *Context) ([]UserFunctionDetails, error)
+
+	// SaveUserFunction stores the given UserFunctionDetails to the database. The integrator should verify that the name
+	// of the new function is unique amongst existing functions.
+	SaveUserFunction(ctx *Context, ufd UserFunctionDetails) error
+
+	// DropUserFunction removes the UserFunctionDetails with the matching name from the database.
+	DropUserFunction(ctx *Context, name string) error
+}
+
 // EvaluateCondition evaluates a condition, which is an expression whose value
 // will be nil or coerced boolean.
 func EvaluateCondition(ctx *Context, cond Expression, row Row) (interface{}, error) {
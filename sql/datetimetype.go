@@ -42,6 +42,10 @@ var (
 
 	ErrConvertingToTimeOutOfRange = errors.NewKind("value %q is outside of %v range")
 
+	// ErrIncorrectTimeValue is returned when writing a zero date to a column while NO_ZERO_DATE and a strict mode are
+	// enabled in the sql_mode.
+	ErrIncorrectTimeValue = errors.NewKind("Incorrect %s value: '%s' for column '%s'")
+
 	// datetimeTypeMaxDatetime is the maximum representable Datetime/Date value.
 	datetimeTypeMaxDatetime = time.Date(9999, 12, 31, 23, 59, 59, 999999000, time.UTC)
 
@@ -217,6 +221,13 @@ func (t datetimeType) ConvertWithoutRangeCheck(v interface{}) (time.Time, error)
 	return res, nil
 }
 
+// IsZeroTime returns whether the value given is the zero date '0000-00-00', as returned by the Convert method of
+// datetime types.
+func IsZeroTime(v interface{}) bool {
+	t, ok := v.(time.Time)
+	return ok && t.Equal(zeroTime)
+}
+
 func (t datetimeType) MustConvert(v interface{}) interface{} {
 	value, err := t.Convert(v)
 	if err != nil {
@@ -246,39 +257,8 @@ func (t datetimeType) SQL(v interface{}) (sqltypes.Value, error) {
 	vt := v.(time.Time)
 
 	switch t.baseType {
-	case sqltypes.Date:
-		if vt.Equal(zeroTime) {
-			return sqltypes.MakeTrusted(
-				sqltypes.Date,
-				[]byte(vt.Format(zeroDateStr)),
-			), nil
-		}
-		return sqltypes.MakeTrusted(
-			sqltypes.Date,
-			[]byte(vt.Format(DateLayout)),
-		), nil
-	case sqltypes.Datetime:
-		if vt.Equal(zeroTime) {
-			return sqltypes.MakeTrusted(
-				sqltypes.Datetime,
-				[]byte(vt.Format(zeroTimestampDatetimeStr)),
-			), nil
-		}
-		return sqltypes.MakeTrusted(
-			sqltypes.Datetime,
-			[]byte(vt.Format(TimestampDatetimeLayout)),
-		), nil
-	case sqltypes.Timestamp:
-		if vt.Equal(zeroTime) {
-			return sqltypes.MakeTrusted(
-				sqltypes.Timestamp,
-				[]byte(vt.Format(zeroTimestampDatetimeStr)),
-			), nil
-		}
-		return sqltypes.MakeTrusted(
-			sqltypes.Timestamp,
-			[]byte(vt.Format(TimestampDatetimeLayout)),
-		), nil
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp:
+		return sqltypes.MakeTrusted(t.baseType, []byte(renderTemporal(t.baseType, vt))), nil
 	default:
 		panic(ErrInvalidBaseType.New(t.baseType.String(), "datetime"))
 	}
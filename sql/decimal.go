@@ -124,7 +124,7 @@ func (t decimalType) Convert(v interface{}) (interface{}, error) {
 	if !dec.Valid {
 		return nil, nil
 	}
-	return dec.Decimal.StringFixed(int32(t.scale)), nil
+	return renderDecimal(dec.Decimal, int32(t.scale)), nil
 }
 
 // Precision returns the precision, or total number of digits, that may be held.
@@ -233,7 +233,7 @@ func (t decimalType) String() string {
 
 // Zero implements Type interface. Returns a uint64 value.
 func (t decimalType) Zero() interface{} {
-	return decimal.NewFromInt(0).StringFixed(int32(t.scale))
+	return renderDecimal(decimal.NewFromInt(0), int32(t.scale))
 }
 
 // ExclusiveUpperBound returns the exclusive upper bound for this Decimal.
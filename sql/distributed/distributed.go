@@ -0,0 +1,179 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package distributed is a framework for running queries over tables whose data is sharded across several processes.
+// A coordinator replaces the Exchange nodes of its analyzed plans with RemoteExchange nodes, which encode the plan
+// fragment under the exchange, send it to workers along with the partitions each of them should read, and merge the
+// rows they send back. Integrators provide the transport between the coordinator and the workers by implementing
+// Worker, and run the fragments received by a worker with ExecuteFragment.
+//
+// Partitions are identified by their keys, so the tables of the coordinator and of the workers must return partitions
+// with the same keys. A fragment only reads the partitions it's sent, from the tables of the worker's catalog.
+package distributed
+
+import (
+	"io"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planencoding"
+)
+
+// ErrNoWorkers is returned when running a RemoteExchange on a cluster without workers.
+var ErrNoWorkers = errors.NewKind("cluster has no workers to send plan fragments to")
+
+// ErrInvalidWorker is returned when a cluster assigns a partition to a worker it doesn't have.
+var ErrInvalidWorker = errors.NewKind("partition %q assigned to worker %d, but the cluster has %d workers")
+
+// Fragment is the part of a plan under a RemoteExchange, sent to a worker to be run on some of the partitions of the
+// table it reads.
+type Fragment struct {
+	// Plan is the plan fragment, encoded with planencoding.Encode
+	Plan []byte `json:"plan"`
+	// Partitions are the keys of the partitions the fragment reads
+	Partitions [][]byte `json:"partitions"`
+}
+
+// Worker runs plan fragments, usually by sending them to another process that runs them with ExecuteFragment and
+// sending back the rows it returns.
+type Worker interface {
+	// Execute runs the fragment given and returns an iterator over its rows.
+	Execute(ctx *sql.Context, fragment *Fragment) (sql.RowIter, error)
+}
+
+// Cluster is the set of workers the fragments of a RemoteExchange are sent to.
+type Cluster struct {
+	Workers []Worker
+	// Assign returns the index in Workers of the worker that reads the partition given, usually the one that holds
+	// its data. If it's nil, partitions are spread over the workers in turn.
+	Assign func(partition sql.Partition) int
+}
+
+// NewCluster creates a Cluster that spreads partitions over the workers given in turn.
+func NewCluster(workers ...Worker) *Cluster {
+	return &Cluster{Workers: workers}
+}
+
+// assign returns the partition keys each worker of the cluster reads.
+func (c *Cluster) assign(ctx *sql.Context, partitions sql.PartitionIter) (_ [][][]byte, rerr error) {
+	defer func() {
+		if err := partitions.Close(ctx); rerr == nil {
+			rerr = err
+		}
+	}()
+
+	if len(c.Workers) == 0 {
+		return nil, ErrNoWorkers.New()
+	}
+
+	assigned := make([][][]byte, len(c.Workers))
+	for i := 0; ; i++ {
+		p, err := partitions.Next()
+		if err == io.EOF {
+			return assigned, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		worker := i % len(c.Workers)
+		if c.Assign != nil {
+			worker = c.Assign(p)
+		}
+		if worker < 0 || worker >= len(c.Workers) {
+			return nil, ErrInvalidWorker.New(string(p.Key()), worker, len(c.Workers))
+		}
+		assigned[worker] = append(assigned[worker], p.Key())
+	}
+}
+
+// ExecuteFragment decodes the fragment given with the catalog given and runs it on the partitions it was sent. Workers
+// call it to run the fragments they receive.
+func ExecuteFragment(ctx *sql.Context, catalog sql.Catalog, fragment *Fragment) (sql.RowIter, error) {
+	node, err := planencoding.Decode(ctx, catalog, fragment.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err = plan.TransformUp(node, func(n sql.Node) (sql.Node, error) {
+		rt, ok := n.(*plan.ResolvedTable)
+		if !ok {
+			return n, nil
+		}
+		return rt.WithTable(newPartitionsTable(rt.Table, fragment.Partitions))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return node.RowIter(ctx, nil)
+}
+
+// partitionsTable is a table restricted to some of its partitions.
+type partitionsTable struct {
+	sql.Table
+	keys [][]byte
+}
+
+func newPartitionsTable(table sql.Table, keys [][]byte) *partitionsTable {
+	return &partitionsTable{Table: table, keys: keys}
+}
+
+// Underlying implements sql.TableWrapper.
+func (t *partitionsTable) Underlying() sql.Table {
+	return t.Table
+}
+
+// Partitions implements sql.Table.
+func (t *partitionsTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	iter, err := t.Table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := make(map[string]struct{}, len(t.keys))
+	for _, key := range t.keys {
+		missing[string(key)] = struct{}{}
+	}
+	return &partitionsIter{PartitionIter: iter, missing: missing}, nil
+}
+
+// partitionsIter returns the partitions of a table whose keys are in |missing|, and fails once all the partitions of
+// the table have been returned if any of them wasn't found.
+type partitionsIter struct {
+	sql.PartitionIter
+	missing map[string]struct{}
+}
+
+// Next implements sql.PartitionIter.
+func (i *partitionsIter) Next() (sql.Partition, error) {
+	for {
+		p, err := i.PartitionIter.Next()
+		if err == io.EOF {
+			for key := range i.missing {
+				return nil, sql.ErrPartitionNotFound.New(key)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := i.missing[string(p.Key())]; ok {
+			delete(i.missing, string(p.Key()))
+			return p, nil
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distributed_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/distributed"
+)
+
+// testWorker runs the fragments it receives against its own catalog, after sending them through their wire format,
+// and records the partitions it was asked to read.
+type testWorker struct {
+	catalog    sql.Catalog
+	mu         sync.Mutex
+	partitions []string
+	err        error
+}
+
+func (w *testWorker) Execute(ctx *sql.Context, fragment *distributed.Fragment) (sql.RowIter, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+
+	data, err := json.Marshal(fragment)
+	if err != nil {
+		return nil, err
+	}
+	var received distributed.Fragment
+	if err := json.Unmarshal(data, &received); err != nil {
+		return nil, err
+	}
+
+	w.mu.Lock()
+	for _, key := range received.Partitions {
+		w.partitions = append(w.partitions, string(key))
+	}
+	w.mu.Unlock()
+
+	return distributed.ExecuteFragment(ctx, w.catalog, &received)
+}
+
+func newProvider(t *testing.T) sql.DatabaseProvider {
+	table := memory.NewPartitionedTable("mytable", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable", PrimaryKey: true},
+		{Name: "s", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 20), Source: "mytable"},
+	}, 4)
+	table.EnablePrimaryKeyIndexes()
+	for i := int64(1); i <= 8; i++ {
+		require.NoError(t, table.Insert(sql.NewEmptyContext(), sql.NewRow(i, fmt.Sprintf("row %d", i))))
+	}
+
+	db := memory.NewDatabase("mydb")
+	db.AddTable("mytable", table)
+	return memory.NewMemoryDBProvider(db)
+}
+
+func newEngine(pro sql.DatabaseProvider, cluster *distributed.Cluster) *sqle.Engine {
+	a := analyzer.NewBuilder(pro).
+		WithParallelism(2).
+		AddAfterAllRule("distribute_exchanges", distributed.DistributeExchanges(cluster)).
+		Build()
+	return sqle.New(a, nil)
+}
+
+func query(ctx *sql.Context, e *sqle.Engine, q string) ([]sql.Row, error) {
+	_, iter, err := e.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowIterToRows(ctx, iter)
+}
+
+func TestRemoteExchange(t *testing.T) {
+	require := require.New(t)
+	pro := newProvider(t)
+
+	w1 := &testWorker{catalog: analyzer.NewCatalog(pro)}
+	w2 := &testWorker{catalog: analyzer.NewCatalog(pro)}
+	e := newEngine(pro, distributed.NewCluster(w1, w2))
+
+	ctx := sql.NewEmptyContext().WithCurrentDB("mydb")
+	rows, err := query(ctx, e, "SELECT i, s FROM mytable WHERE s > 'row 2'")
+	require.NoError(err)
+
+	sort.Slice(rows, func(i, j int) bool { return rows[i][0].(int64) < rows[j][0].(int64) })
+	var expected []sql.Row
+	for i := int64(3); i <= 8; i++ {
+		expected = append(expected, sql.NewRow(i, fmt.Sprintf("row %d", i)))
+	}
+	require.Equal(expected, rows)
+
+	// Partitions are spread over the workers in turn, each of them read once
+	require.Len(w1.partitions, 2)
+	require.Len(w2.partitions, 2)
+	require.ElementsMatch([]string{"0", "1", "2", "3"}, append(w1.partitions, w2.partitions...))
+
+	// Queries that can't be split by partition, such as index lookups, run in this process
+	rows, err = query(ctx, e, "SELECT s FROM mytable WHERE i = 3")
+	require.NoError(err)
+	require.Equal([]sql.Row{{"row 3"}}, rows)
+	require.Len(w1.partitions, 2)
+}
+
+func TestRemoteExchangeErrors(t *testing.T) {
+	pro := newProvider(t)
+	ctx := sql.NewEmptyContext().WithCurrentDB("mydb")
+
+	t.Run("no workers", func(t *testing.T) {
+		_, err := query(ctx, newEngine(pro, distributed.NewCluster()), "SELECT i FROM mytable")
+		require.True(t, distributed.ErrNoWorkers.Is(err))
+	})
+
+	t.Run("invalid worker", func(t *testing.T) {
+		cluster := distributed.NewCluster(&testWorker{catalog: analyzer.NewCatalog(pro)})
+		cluster.Assign = func(sql.Partition) int { return 1 }
+		_, err := query(ctx, newEngine(pro, cluster), "SELECT i FROM mytable")
+		require.True(t, distributed.ErrInvalidWorker.Is(err))
+	})
+
+	t.Run("worker error", func(t *testing.T) {
+		w := &testWorker{catalog: analyzer.NewCatalog(pro), err: fmt.Errorf("connection refused")}
+		cluster := distributed.NewCluster(&testWorker{catalog: analyzer.NewCatalog(pro)}, w)
+		_, err := query(ctx, newEngine(pro, cluster), "SELECT i FROM mytable")
+		require.EqualError(t, err, "connection refused")
+	})
+
+	t.Run("missing partition", func(t *testing.T) {
+		plan := []byte(`{"version":1,"node":{"type":"ResolvedTable","database":"mydb","table":"mytable","columns":["i","s"]}}`)
+		iter, err := distributed.ExecuteFragment(ctx, analyzer.NewCatalog(pro), &distributed.Fragment{
+			Plan:       plan,
+			Partitions: [][]byte{[]byte("0"), []byte("unknown")},
+		})
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.True(t, sql.ErrPartitionNotFound.Is(err))
+	})
+}
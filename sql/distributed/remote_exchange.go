@@ -0,0 +1,201 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distributed
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planencoding"
+)
+
+// RemoteExchange is a node that runs the plan under it on the workers of a cluster, each of them reading some of the
+// partitions of the table in the plan, and returns the rows of all of them in no particular order. Like Exchange, it
+// can only be put over plans whose rows for a partition don't depend on the other partitions.
+type RemoteExchange struct {
+	plan.UnaryNode
+	Cluster *Cluster
+}
+
+var _ sql.Node = (*RemoteExchange)(nil)
+
+// NewRemoteExchange creates a new RemoteExchange node.
+func NewRemoteExchange(cluster *Cluster, child sql.Node) *RemoteExchange {
+	return &RemoteExchange{
+		UnaryNode: plan.UnaryNode{Child: child},
+		Cluster:   cluster,
+	}
+}
+
+// RowIter implements the sql.Node interface.
+func (e *RemoteExchange) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var t sql.Table
+	plan.Inspect(e.Child, func(n sql.Node) bool {
+		if table, ok := n.(sql.Table); ok {
+			t = table
+			return false
+		}
+		return true
+	})
+	if t == nil {
+		return nil, plan.ErrNoPartitionable.New()
+	}
+
+	partitions, err := t.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assigned, err := e.Cluster.assign(ctx, partitions)
+	if err != nil {
+		return nil, err
+	}
+
+	fragment, err := planencoding.Encode(e.Child)
+	if err != nil {
+		return nil, err
+	}
+
+	iterCtx, cancel := ctx.NewSubContext()
+	eg, egCtx := iterCtx.NewErrgroup()
+	rows := make(chan sql.Row, len(e.Cluster.Workers)*16)
+	for i, keys := range assigned {
+		if len(keys) == 0 {
+			continue
+		}
+		worker, f := e.Cluster.Workers[i], &Fragment{Plan: fragment, Partitions: keys}
+		eg.Go(func() error {
+			iter, err := worker.Execute(egCtx, f)
+			if err != nil {
+				return err
+			}
+			return sendRows(egCtx, iter, rows)
+		})
+	}
+
+	iter := &remoteExchangeIter{rows: rows, cancel: cancel}
+	go func() {
+		iter.err = eg.Wait()
+		close(rows)
+	}()
+
+	return iter, nil
+}
+
+func (e *RemoteExchange) String() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("RemoteExchange(workers=%d)", len(e.Cluster.Workers))
+	_ = p.WriteChildren(e.Child.String())
+	return p.String()
+}
+
+func (e *RemoteExchange) DebugString() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("RemoteExchange(workers=%d)", len(e.Cluster.Workers))
+	_ = p.WriteChildren(sql.DebugString(e.Child))
+	return p.String()
+}
+
+// WithChildren implements the Node interface.
+func (e *RemoteExchange) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
+	}
+
+	return NewRemoteExchange(e.Cluster, children[0]), nil
+}
+
+// sendRows sends all the rows of |iter| to |rows| and closes it.
+func sendRows(ctx *sql.Context, iter sql.RowIter, rows chan<- sql.Row) (rerr error) {
+	defer func() {
+		if err := iter.Close(ctx); rerr == nil {
+			rerr = err
+		}
+	}()
+
+	for {
+		r, err := iter.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		select {
+		case rows <- r:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// remoteExchangeIter returns the rows sent by the workers of a RemoteExchange. |err| is set to the first error
+// returned by a worker before |rows| is closed.
+type remoteExchangeIter struct {
+	rows   chan sql.Row
+	cancel context.CancelFunc
+	err    error
+}
+
+// Next implements sql.RowIter.
+func (i *remoteExchangeIter) Next() (sql.Row, error) {
+	r, ok := <-i.rows
+	if !ok {
+		if i.err != nil {
+			return nil, i.err
+		}
+		return nil, io.EOF
+	}
+	return r, nil
+}
+
+// Close implements sql.RowIter. It stops the workers that are still sending rows and waits for them to finish.
+func (i *remoteExchangeIter) Close(ctx *sql.Context) error {
+	i.cancel()
+	for range i.rows {
+	}
+
+	if i.err != nil && !errors.Is(i.err, context.Canceled) {
+		return i.err
+	}
+	return nil
+}
+
+// DistributeExchanges returns an analyzer rule that replaces the Exchange nodes of plans with RemoteExchange nodes
+// that run on the cluster given. Exchanges over plans that can't be encoded are left alone. Since Exchange nodes are
+// added when the plan is parallelized, the rule must be added with analyzer.Builder.AddAfterAllRule, and the analyzer
+// must have a parallelism greater than one.
+func DistributeExchanges(cluster *Cluster) analyzer.RuleFunc {
+	return func(ctx *sql.Context, a *analyzer.Analyzer, n sql.Node, scope *analyzer.Scope) (sql.Node, error) {
+		return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+			exchange, ok := n.(*plan.Exchange)
+			if !ok {
+				return n, nil
+			}
+
+			if _, err := planencoding.Encode(exchange.Child); err != nil {
+				a.Log("exchange not distributed: %s", err)
+				return n, nil
+			}
+
+			return NewRemoteExchange(cluster, exchange.Child), nil
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// EngineStatusProvider reports the internal state of an engine, such as its memory usage and the contents of its plan
+// cache, for operators debugging a live server. It's shown by the engine_status and cached_plans tables of
+// information_schema.
+type EngineStatusProvider interface {
+	// EngineStatus returns the current value of each status variable of the engine.
+	EngineStatus() []EngineStatusVariable
+	// CachedPlans returns the statements whose plans are kept in the plan cache of the engine.
+	CachedPlans() []CachedPlan
+}
+
+// EngineStatusVariable is the name and current value of a status variable of an engine.
+type EngineStatusVariable struct {
+	Name  string
+	Value uint64
+}
+
+// CachedPlan describes a statement kept in the plan cache of an engine.
+type CachedPlan struct {
+	// Session is the id of the session the plan belongs to, or zero if it's shared by every session.
+	Session uint32
+	// Database is the current database the statement was analyzed with.
+	Database string
+	// Query is the normalized text of the statement.
+	Query string
+	// Analyzed is whether the analyzed plan is kept, rather than only the parsed statement.
+	Analyzed bool
+}
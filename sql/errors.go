@@ -143,6 +143,18 @@ var (
 	// ErrTriggerDoesNotExist is returned when a stored procedure does not exist.
 	ErrStoredProcedureDoesNotExist = errors.NewKind(`stored procedure "%s" does not exist`)
 
+	// ErrUserFunctionsNotSupported is returned when attempting to create a user-defined function on a database that doesn't support them.
+	ErrUserFunctionsNotSupported = errors.NewKind(`database "%s" doesn't support user-defined functions`)
+
+	// ErrUserFunctionAlreadyExists is returned when a user-defined function with the same name already exists.
+	ErrUserFunctionAlreadyExists = errors.NewKind(`function "%s" already exists`)
+
+	// ErrUserFunctionDoesNotExist is returned when a user-defined function does not exist.
+	ErrUserFunctionDoesNotExist = errors.NewKind(`function "%s" does not exist`)
+
+	// ErrFunctionCreateStatementInvalid is returned when a UserFunctionDatabase returns a CREATE FUNCTION statement that is invalid.
+	ErrFunctionCreateStatementInvalid = errors.NewKind(`Invalid CREATE FUNCTION statement: %s`)
+
 	// ErrProcedureCreateStatementInvalid is returned when a StoredProcedureDatabase returns a CREATE PROCEDURE statement that is invalid.
 	ErrProcedureCreateStatementInvalid = errors.NewKind(`Invalid CREATE PROCEDURE statement: %s`)
 
@@ -209,6 +221,13 @@ var (
 	// list with a different number of columns than the schema of the table.
 	ErrColumnCountMismatch = errors.NewKind("In definition of view, derived table or common table expression, SELECT list and column names list have different column counts")
 
+	// ErrInvalidWindowFrame is returned when the bounds of a window frame don't make a valid frame.
+	ErrInvalidWindowFrame = errors.NewKind("invalid window frame: %s")
+
+	// ErrWindowFrameRangeOrderBy is returned when a RANGE window frame with an offset doesn't have exactly one ORDER
+	// BY expression.
+	ErrWindowFrameRangeOrderBy = errors.NewKind("window with a RANGE frame with an offset requires exactly one ORDER BY expression, of numeric type")
+
 	// ErrUuidUnableToParse is returned when a UUID is unable to be parsed.
 	ErrUuidUnableToParse = errors.NewKind("unable to parse '%s' to UUID: %s")
 
@@ -218,6 +237,33 @@ var (
 	// ErrLoadDataCharacterLength is returned when a symbol is of the wrong character length for a LOAD DATA operation.
 	ErrLoadDataCharacterLength = errors.NewKind("%s must be 1 character long")
 
+	// ErrLoadDataMissingColumns is returned when a line of a LOAD DATA file has fewer fields than there are input
+	// columns.
+	ErrLoadDataMissingColumns = errors.NewKind("Row %d doesn't contain data for all columns")
+
+	// ErrLoadDataTruncatedRow is returned when a line of a LOAD DATA file has more fields than there are input columns.
+	ErrLoadDataTruncatedRow = errors.NewKind("Row %d was truncated; it contained more data than there were input columns")
+
+	// ErrLoadDataIncorrectValue is returned when a field of a LOAD DATA file can't be converted to the type of its
+	// column.
+	ErrLoadDataIncorrectValue = errors.NewKind("Incorrect %s value: '%s' for column '%s' at row %d")
+
+	// ErrLoadDataNullValue is returned when a field of a LOAD DATA file is NULL for a column that isn't nullable.
+	ErrLoadDataNullValue = errors.NewKind("NULL supplied to NOT NULL column '%s' at row %d")
+
+	// ErrLoadDataUnenclosedField is returned when a field of a LOAD DATA file isn't enclosed by the ENCLOSED BY
+	// character.
+	ErrLoadDataUnenclosedField = errors.NewKind("Field %d of row %d is not properly enclosed")
+
+	// ErrOutfileExists is returned when the file of SELECT ... INTO OUTFILE already exists.
+	ErrOutfileExists = errors.NewKind("File '%s' already exists")
+
+	// ErrUnknownTimeZone is returned when a time zone is neither an offset from UTC nor the name of a known time zone.
+	ErrUnknownTimeZone = errors.NewKind("Unknown or incorrect time zone: '%s'")
+
+	// ErrFunctionLimitExceeded is returned when a call of a function exceeds one of the FunctionLimits of its context.
+	ErrFunctionLimitExceeded = errors.NewKind("%s exceeded the limit of %v on %s")
+
 	// ErrJSONObjectAggNullKey is returned when JSON_OBJECTAGG is run on a table with NULL keys
 	ErrJSONObjectAggNullKey = errors.NewKind("JSON documents may not contain NULL member names")
 
@@ -249,6 +295,16 @@ var (
 	// ErrPartitionNotFound is thrown when a partition key on a table is not found
 	ErrPartitionNotFound = errors.NewKind("partition not found %q")
 
+	// ErrNoPartitionForValue is returned when a row is written to a partitioned table that has no partition for the
+	// value of its partitioning expression
+	ErrNoPartitionForValue = errors.NewKind("table has no partition for value %v")
+
+	// ErrInvalidPartitionScheme is returned when a table is declared with a partitioning scheme that isn't valid
+	ErrInvalidPartitionScheme = errors.NewKind("invalid partitioning: %s")
+
+	// ErrPartitioningNotSupported is returned when creating a partitioned table in a database that doesn't support them
+	ErrPartitioningNotSupported = errors.NewKind("database %s does not support partitioned tables")
+
 	// ErrInsertIntoNonNullableProvidedNull is called when a null value is inserted into a non-nullable column
 	ErrInsertIntoNonNullableProvidedNull = errors.NewKind("column name '%v' is non-nullable but attempted to set a value of null")
 
@@ -278,6 +334,10 @@ var (
 	// support.
 	ErrTemporaryTableNotSupported = errors.NewKind("database does not support temporary tables")
 
+	// ErrSessionDatabaseNotSupported is returned when a session database is created with a database provider that
+	// doesn't support them, or in a session that can't hold them.
+	ErrSessionDatabaseNotSupported = errors.NewKind("database provider does not support session databases")
+
 	// ErrInvalidSyntax is returned for syntax errors that aren't picked up by the parser, e.g. the wrong type of
 	// expression used in part of statement.
 	ErrInvalidSyntax = errors.NewKind("Invalid syntax: %s")
@@ -350,8 +410,46 @@ var (
 	// ErrViewDoesNotExist is returned when a DROP VIEW statement drops a view that does not exist
 	ErrViewDoesNotExist = errors.NewKind("the view %s.%s does not exist")
 
+	// ErrViewNotUpdatable is returned when an INSERT, UPDATE or DELETE targets a view that can't be mapped onto a
+	// single base table
+	ErrViewNotUpdatable = errors.NewKind("the target table %s of the %s is not updatable")
+
 	// ErrSessionDoesNotSupportPersistence is thrown when a feature is not already supported
 	ErrSessionDoesNotSupportPersistence = errors.NewKind("session does not support persistence")
+
+	// ErrMaxExecutionTimeExceeded is returned when a query runs for longer than its maximum execution time
+	ErrMaxExecutionTimeExceeded = errors.NewKind("Query execution was interrupted, maximum statement execution time exceeded")
+
+	// ErrDuplicateColumn is returned when an ALTER TABLE statement adds or renames a column to a name the table
+	// already has.
+	ErrDuplicateColumn = errors.NewKind("Duplicate column name '%s'")
+
+	// ErrCantDropAllColumns is returned when an ALTER TABLE statement drops the only column of a table.
+	ErrCantDropAllColumns = errors.NewKind("You can't delete all columns with ALTER TABLE; use DROP TABLE instead")
+
+	// ErrNoBinaryLogging is returned by the statements that manage binary log files when the engine doesn't write a
+	// binary log.
+	ErrNoBinaryLogging = errors.NewKind("You are not using binary logging")
+
+	// ErrBinaryLogNotFound is returned when a binary log file named by a statement doesn't exist.
+	ErrBinaryLogNotFound = errors.NewKind("Target log not found in binlog index: %s")
+
+	// ErrBinaryLogPurged is returned when a replica asks for transactions that were purged from the binary log.
+	ErrBinaryLogPurged = errors.NewKind("Cannot replicate because the source purged required binary logs. Replicate the missing transactions from elsewhere, or provision a new replica from backup. The GTIDs of the transactions purged are %s")
+
+	// ErrReplicaNotConfigured is returned by START REPLICA when no source server was set with CHANGE REPLICATION
+	// SOURCE.
+	ErrReplicaNotConfigured = errors.NewKind("This server is not configured as replica. Fix in config file or with CHANGE REPLICATION SOURCE TO")
+
+	// ErrReplicaRunning is returned when changing the replication configuration of a running replica.
+	ErrReplicaRunning = errors.NewKind("This operation cannot be performed with a running replica; run STOP REPLICA first")
+
+	// ErrNoReplicationConfigStore is returned by the replication statements when the context has no store for the
+	// replication configuration.
+	ErrNoReplicationConfigStore = errors.NewKind("replication is not supported by this server")
+
+	// ErrUnknownThreadID is returned by KILL when there is no connection with the id given.
+	ErrUnknownThreadID = errors.NewKind("Unknown thread id: %d")
 )
 
 func CastSQLError(err error) (*mysql.SQLError, bool) {
@@ -380,7 +478,7 @@ func CastSQLError(err error) (*mysql.SQLError, bool) {
 		code = mysql.ERDupEntry
 	case ErrUniqueKeyViolation.Is(err):
 		code = mysql.ERDupEntry
-	case ErrPartitionNotFound.Is(err):
+	case ErrPartitionNotFound.Is(err), ErrNoPartitionForValue.Is(err):
 		code = 1526 // TODO: Needs to be added to vitess
 	case ErrForeignKeyChildViolation.Is(err):
 		code = mysql.ErNoReferencedRow2 // test with mysql returns 1452 vs 1216
@@ -388,6 +486,8 @@ func CastSQLError(err error) (*mysql.SQLError, bool) {
 		code = mysql.ERRowIsReferenced2 // test with mysql returns 1451 vs 1215
 	case ErrDuplicateEntry.Is(err):
 		code = mysql.ERDupEntry
+	case ErrIncorrectTimeValue.Is(err):
+		code = 1292 // TODO: Needs to be added to vitess
 	case ErrInvalidJSONText.Is(err):
 		code = 3141 // TODO: Needs to be added to vitess
 	case ErrMultiplePrimaryKeysDefined.Is(err):
@@ -402,6 +502,40 @@ func CastSQLError(err error) (*mysql.SQLError, bool) {
 		code = 1792 // TODO: Needs to be added to vitess
 	case ErrCantDropIndex.Is(err):
 		code = 1553 // TODO: Needs to be added to vitess
+	case ErrMaxExecutionTimeExceeded.Is(err):
+		code = 3024 // TODO: Needs to be added to vitess
+	case ErrDuplicateColumn.Is(err):
+		code = 1060 // TODO: Needs to be added to vitess
+	case ErrCantDropAllColumns.Is(err):
+		code = 1090 // TODO: Needs to be added to vitess
+	case ErrViewNotUpdatable.Is(err):
+		code = 1288 // TODO: Needs to be added to vitess
+	case ErrAmbiguousColumnName.Is(err), ErrAmbiguousColumnInOrderBy.Is(err):
+		code = 1052 // TODO: Needs to be added to vitess
+	case ErrNoBinaryLogging.Is(err):
+		code = 1381 // TODO: Needs to be added to vitess
+	case ErrBinaryLogNotFound.Is(err):
+		code = 1373 // TODO: Needs to be added to vitess
+	case ErrBinaryLogPurged.Is(err):
+		code = 1236 // TODO: Needs to be added to vitess
+	case ErrReplicaNotConfigured.Is(err):
+		code = 1200 // TODO: Needs to be added to vitess
+	case ErrReplicaRunning.Is(err):
+		code = 1198 // TODO: Needs to be added to vitess
+	case ErrOutfileExists.Is(err):
+		code = mysql.ERFileExists
+	case ErrLoadDataMissingColumns.Is(err):
+		code = 1261 // TODO: Needs to be added to vitess
+	case ErrLoadDataTruncatedRow.Is(err):
+		code = 1262 // TODO: Needs to be added to vitess
+	case ErrLoadDataNullValue.Is(err):
+		code = 1263 // TODO: Needs to be added to vitess
+	case ErrLoadDataIncorrectValue.Is(err):
+		code = 1366 // TODO: Needs to be added to vitess
+	case ErrUnknownTimeZone.Is(err):
+		code = 1298 // TODO: Needs to be added to vitess
+	case ErrUnknownThreadID.Is(err):
+		code = mysql.ERNoSuchThread
 	default:
 		code = mysql.ERUnknownError
 	}
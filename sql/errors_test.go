@@ -17,6 +17,10 @@ func TestSQLErrorCast(t *testing.T) {
 		code int
 	}{
 		{ErrTableNotFound.New("table not found err"), mysql.ERNoSuchTable},
+		{ErrAmbiguousColumnName.New("i", "a, b"), 1052},
+		{ErrNoBinaryLogging.New(), 1381},
+		{ErrReplicaNotConfigured.New(), 1200},
+		{ErrReplicaRunning.New(), 1198},
 		{ErrInvalidType.New("unhandled mysql error"), mysql.ERUnknownError},
 		{fmt.Errorf("generic error"), mysql.ERUnknownError},
 		{nil, mysql.ERUnknownError},
@@ -0,0 +1,194 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package export writes rows as delimited text, such as the files of SELECT ... INTO OUTFILE, and dumps tables and
+// query results in such formats for embedders. Values are rendered with sql.RenderValue, so they read the same as
+// they do for clients.
+package export
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Format is how rows are written, with the FIELDS and LINES options of SELECT ... INTO OUTFILE and LOAD DATA.
+type Format struct {
+	FieldsTerminatedBy string
+	FieldsEnclosedBy   string
+	// FieldsOptionallyEnclosed only encloses the fields of string types, rather than all of them.
+	FieldsOptionallyEnclosed bool
+	// FieldsEscapedBy is the character escaping special characters. Without any, NULL is written as NULL, and the
+	// enclosing character is doubled in enclosed fields.
+	FieldsEscapedBy   string
+	LinesStartingBy   string
+	LinesTerminatedBy string
+}
+
+// DefaultFormat is the format of SELECT ... INTO OUTFILE without FIELDS or LINES options: fields terminated by tabs,
+// lines by newlines, and special characters escaped with \.
+var DefaultFormat = Format{
+	FieldsTerminatedBy: "\t",
+	FieldsEscapedBy:    `\`,
+	LinesTerminatedBy:  "\n",
+}
+
+// CSVFormat is the format of comma separated values, with strings enclosed in double quotes.
+var CSVFormat = Format{
+	FieldsTerminatedBy:       ",",
+	FieldsEnclosedBy:         `"`,
+	FieldsOptionallyEnclosed: true,
+	LinesTerminatedBy:        "\n",
+}
+
+// Writer writes rows of a schema in a format.
+type Writer struct {
+	w      *bufio.Writer
+	schema sql.Schema
+	format Format
+	// escaped are the characters escaped with the escape character.
+	escaped string
+}
+
+// NewWriter returns a Writer writing rows of the schema given to w in the format given. Writes are buffered until
+// Flush.
+func NewWriter(w io.Writer, schema sql.Schema, format Format) *Writer {
+	escaped := format.FieldsEscapedBy + format.FieldsEnclosedBy + "\x00"
+	if format.FieldsEnclosedBy == "" {
+		if format.FieldsTerminatedBy != "" {
+			escaped += format.FieldsTerminatedBy[:1]
+		}
+		if format.LinesTerminatedBy != "" {
+			escaped += format.LinesTerminatedBy[:1]
+		}
+	}
+	return &Writer{w: bufio.NewWriter(w), schema: schema, format: format, escaped: escaped}
+}
+
+// WriteRow writes the row given.
+func (w *Writer) WriteRow(row sql.Row) error {
+	f := w.format
+	var b strings.Builder
+	b.WriteString(f.LinesStartingBy)
+	for i, v := range row {
+		if i > 0 {
+			b.WriteString(f.FieldsTerminatedBy)
+		}
+		if v == nil {
+			b.WriteString(sql.NullMarker(f.FieldsEscapedBy))
+			continue
+		}
+
+		var typ sql.Type
+		if i < len(w.schema) {
+			typ = w.schema[i].Type
+		}
+		s, err := sql.RenderValue(typ, v)
+		if err != nil {
+			return err
+		}
+
+		enclosed := f.FieldsEnclosedBy != "" && (!f.FieldsOptionallyEnclosed || isString(typ))
+		if enclosed {
+			b.WriteString(f.FieldsEnclosedBy)
+		}
+		w.writeEscaped(&b, s)
+		if enclosed {
+			b.WriteString(f.FieldsEnclosedBy)
+		}
+	}
+	b.WriteString(f.LinesTerminatedBy)
+
+	_, err := w.w.WriteString(b.String())
+	return err
+}
+
+// writeEscaped writes the field given, escaping its special characters.
+func (w *Writer) writeEscaped(b *strings.Builder, s string) {
+	f := w.format
+	if f.FieldsEscapedBy == "" {
+		if f.FieldsEnclosedBy != "" {
+			s = strings.ReplaceAll(s, f.FieldsEnclosedBy, f.FieldsEnclosedBy+f.FieldsEnclosedBy)
+		}
+		b.WriteString(s)
+		return
+	}
+
+	for _, r := range s {
+		if !strings.ContainsRune(w.escaped, r) {
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteString(f.FieldsEscapedBy)
+		if r == 0 {
+			b.WriteByte('0')
+		} else {
+			b.WriteRune(r)
+		}
+	}
+}
+
+// Flush writes the rows buffered to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// isString returns whether values of the type given are strings, which are the values enclosed with optionally
+// enclosed fields. Values of unknown types are rendered as strings.
+func isString(typ sql.Type) bool {
+	if typ == nil || sql.IsText(typ) {
+		return true
+	}
+	switch typ.(type) {
+	case sql.EnumType, sql.SetType:
+		return true
+	}
+	return false
+}
+
+// Rows writes the rows of the iterator given, of the schema given, to w in the format given, and returns the number
+// of rows written. The iterator is closed.
+func Rows(ctx *sql.Context, w io.Writer, schema sql.Schema, iter sql.RowIter, format Format) (n int64, err error) {
+	defer func() {
+		if cerr := iter.Close(ctx); err == nil {
+			err = cerr
+		}
+	}()
+
+	ew := NewWriter(w, schema, format)
+	for {
+		row, err := iter.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return n, err
+		}
+		if err := ew.WriteRow(row); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, ew.Flush()
+}
+
+// Table writes all the rows of the table given to w in the format given, and returns the number of rows written.
+func Table(ctx *sql.Context, w io.Writer, table sql.Table, format Format) (int64, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return Rows(ctx, w, table.Schema(), sql.NewTableRowIter(ctx, table, partitions), format)
+}
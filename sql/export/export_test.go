@@ -0,0 +1,105 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestWriter(t *testing.T) {
+	schema := sql.Schema{
+		{Name: "i", Type: sql.Int64},
+		{Name: "s", Type: sql.LongText},
+		{Name: "f", Type: sql.Float64},
+	}
+
+	tests := []struct {
+		name     string
+		format   Format
+		rows     []sql.Row
+		expected string
+	}{
+		{
+			name:   "default",
+			format: DefaultFormat,
+			rows: []sql.Row{
+				{int64(1), "a\tb\nc\\d\x00", 0.25},
+				{nil, "", nil},
+			},
+			expected: "1\ta\\\tb\\\nc\\\\d\\0\t0.25\n\\N\t\t\\N\n",
+		},
+		{
+			name:   "csv",
+			format: CSVFormat,
+			rows: []sql.Row{
+				{int64(1), `say "hi", bye`, 1e-7},
+				{int64(2), nil, -1.5},
+			},
+			expected: "1,\"say \"\"hi\"\", bye\",0.0000001\n2,NULL,-1.5\n",
+		},
+		{
+			name: "enclosed and escaped",
+			format: Format{
+				FieldsTerminatedBy: ";",
+				FieldsEnclosedBy:   "'",
+				FieldsEscapedBy:    "#",
+				LinesStartingBy:    "> ",
+				LinesTerminatedBy:  "\r\n",
+			},
+			rows: []sql.Row{
+				{int64(1), "it's;#", nil},
+			},
+			expected: "> '1';'it#'s;##';#N\r\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			var buf bytes.Buffer
+			w := NewWriter(&buf, schema, tt.format)
+			for _, row := range tt.rows {
+				require.NoError(w.WriteRow(row))
+			}
+			require.NoError(w.Flush())
+			require.Equal(tt.expected, buf.String())
+		})
+	}
+}
+
+func TestTable(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("t", sql.Schema{
+		{Name: "pk", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "d", Type: sql.MustCreateDecimalType(5, 2), Source: "t", Nullable: true},
+	}, 2)
+	for _, row := range []sql.Row{{int64(1), "1.5"}, {int64(2), nil}, {int64(3), "0"}} {
+		require.NoError(table.Insert(ctx, row))
+	}
+
+	var buf bytes.Buffer
+	n, err := Table(ctx, &buf, table, CSVFormat)
+	require.NoError(err)
+	require.Equal(int64(3), n)
+	require.ElementsMatch([]string{"1,1.50", "2,NULL", "3,0.00"}, strings.Fields(buf.String()))
+}
@@ -20,10 +20,10 @@ import (
 	"strings"
 	"time"
 
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 )
 
 var (
@@ -124,18 +124,19 @@ func (a *Arithmetic) IsNullable() bool {
 
 // Type returns the greatest type for given operation.
 func (a *Arithmetic) Type() sql.Type {
+	lType, rType := bitToUnsigned(a.Left.Type()), bitToUnsigned(a.Right.Type())
 	switch strings.ToLower(a.Op) {
 	case sqlparser.PlusStr, sqlparser.MinusStr, sqlparser.MultStr, sqlparser.DivStr:
 		if isInterval(a.Left) || isInterval(a.Right) {
 			return sql.Datetime
 		}
 
-		if sql.IsTime(a.Left.Type()) && sql.IsTime(a.Right.Type()) {
+		if sql.IsTime(lType) && sql.IsTime(rType) {
 			return sql.Int64
 		}
 
-		if sql.IsInteger(a.Left.Type()) && sql.IsInteger(a.Right.Type()) {
-			if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
+		if sql.IsInteger(lType) && sql.IsInteger(rType) {
+			if sql.IsUnsigned(lType) && sql.IsUnsigned(rType) {
 				return sql.Uint64
 			}
 			return sql.Int64
@@ -147,7 +148,7 @@ func (a *Arithmetic) Type() sql.Type {
 		return sql.Uint64
 
 	case sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr, sqlparser.IntDivStr, sqlparser.ModStr:
-		if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
+		if sql.IsUnsigned(lType) && sql.IsUnsigned(rType) {
 			return sql.Uint64
 		}
 		return sql.Int64
@@ -156,6 +157,14 @@ func (a *Arithmetic) Type() sql.Type {
 	return sql.Float64
 }
 
+// bitToUnsigned returns the type given, except for BIT types, whose values are used as unsigned integers in arithmetic.
+func bitToUnsigned(t sql.Type) sql.Type {
+	if sql.IsBit(t) {
+		return sql.Uint64
+	}
+	return t
+}
+
 func isInterval(expr sql.Expression) bool {
 	_, ok := expr.(*Interval)
 	return ok
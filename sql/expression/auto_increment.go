@@ -80,13 +80,17 @@ func (i *AutoIncrement) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	}
 
 	// todo: |given| is int8 while |i.Right.Zero()| is int64
-	// When a row passes in 0 as the auto_increment value it is equivalent to NULL.
+	// When a row passes in 0 as the auto_increment value it is equivalent to NULL, unless NO_AUTO_VALUE_ON_ZERO is set,
+	// in which case it's a value like any other.
 	cmp, err := i.Type().Compare(given, i.Type().Zero())
 	if err != nil {
 		return nil, err
 	}
 
 	if cmp == 0 {
+		if sql.LoadSqlMode(ctx).ModeEnabled(sql.SqlModeNoAutoValueOnZero) {
+			return i.Type().Convert(given)
+		}
 		given = nil
 	}
 
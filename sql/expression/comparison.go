@@ -168,7 +168,8 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 		return left, right, c.Left().Type(), nil
 	}
 
-	if sql.IsNumber(leftType) || sql.IsNumber(rightType) {
+	// BIT values are compared as the unsigned integers they hold
+	if sql.IsNumber(leftType) || sql.IsNumber(rightType) || sql.IsBit(leftType) || sql.IsBit(rightType) {
 		if sql.IsDecimal(leftType) || sql.IsDecimal(rightType) {
 			//TODO: We need to set to the actual DECIMAL type
 			l, r, err := convertLeftAndRight(left, right, ConvertToDecimal)
@@ -218,6 +219,16 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 		return l, r, sql.Datetime, nil
 	}
 
+	// A binary string compared to a non-binary one is compared as a binary string, byte by byte.
+	if sql.IsBlob(leftType) || sql.IsBlob(rightType) {
+		l, r, err := convertLeftAndRight(left, right, ConvertToBinary)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return l, r, sql.LongBlob, nil
+	}
+
 	left, right, err := convertLeftAndRight(left, right, ConvertToChar)
 	if err != nil {
 		return nil, nil, nil, err
@@ -422,6 +433,9 @@ func (re *Regexp) compareRegexp(ctx *sql.Context, row sql.Row) (interface{}, err
 	if err != nil {
 		return nil, err
 	}
+	if ok, err := ctx.FunctionLimits.CheckRegexpInput(ctx, "REGEXP", left.(string)); !ok {
+		return nil, err
+	}
 
 	var matcher regex.DisposableMatcher
 
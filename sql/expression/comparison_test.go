@@ -268,6 +268,25 @@ func TestInvalidRegexp(t *testing.T) {
 	require.Error(err)
 }
 
+func TestBinaryStringComparison(t *testing.T) {
+	require := require.New(t)
+
+	text := expression.NewGetField(0, sql.CreateLongText(sql.Collation_utf8mb4_0900_ai_ci), "text", true)
+	binary := expression.NewGetField(1, sql.LongBlob, "binary", true)
+
+	eq := expression.NewEquals(text, binary)
+	require.Equal(true, eval(t, eq, sql.NewRow("abc", []byte("abc"))))
+	require.Equal(false, eval(t, eq, sql.NewRow("ABC", []byte("abc"))))
+	require.Equal(true, eval(t, eq, sql.NewRow("\x00\xff", []byte{0, 0xff})))
+
+	lt := expression.NewLessThan(text, binary)
+	require.Equal(true, eval(t, lt, sql.NewRow("Z", "a")))
+
+	like := expression.NewLike(text, binary, nil)
+	require.Equal(true, eval(t, like, sql.NewRow("abc", "a%")))
+	require.Equal(false, eval(t, like, sql.NewRow("ABC", "a%")))
+}
+
 func eval(t *testing.T, e sql.Expression, row sql.Row) interface{} {
 	t.Helper()
 	v, err := e.Eval(sql.NewEmptyContext(), row)
@@ -108,6 +108,11 @@ func (c *Convert) Type() sql.Type {
 	}
 }
 
+// CastToType returns the name of the type the expression is converted to.
+func (c *Convert) CastToType() string {
+	return c.castToType
+}
+
 // Name implements the Expression interface.
 func (c *Convert) String() string {
 	return fmt.Sprintf("convert(%v, %v)", c.Child, c.castToType)
@@ -132,6 +137,22 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	if c.castToType == ConvertToJSON {
+		if ok, err := ctx.FunctionLimits.CheckJSONDocument(ctx, "CAST", val); !ok {
+			return nil, err
+		}
+	}
+
+	switch strings.ToLower(c.castToType) {
+	case ConvertToChar, ConvertToNChar:
+		// Values are cast to text as they're shown to clients
+		s, err := sql.RenderValue(c.Child.Type(), val)
+		if err != nil {
+			return nil, nil
+		}
+		return s, nil
+	}
+
 	casted, err := convertValue(val, c.castToType)
 	if err != nil {
 		return nil, ErrConvertExpression.Wrap(err, c.String(), c.castToType)
@@ -0,0 +1,175 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/mitchellh/hashstructure"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// hllPrecision is the number of bits of each hash used to pick a HyperLogLog register. 2^14 registers give a
+// standard error of about 0.8% using 16KB of memory per group.
+const hllPrecision = 14
+
+const hllRegisters = 1 << hllPrecision
+
+// ApproxCountDistinct estimates the number of distinct non-NULL values of an expression using a HyperLogLog sketch.
+// Unlike COUNT(DISTINCT), its memory usage is constant no matter how many distinct values there are.
+type ApproxCountDistinct struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*ApproxCountDistinct)(nil)
+var _ sql.Aggregation = (*ApproxCountDistinct)(nil)
+
+// NewApproxCountDistinct creates a new ApproxCountDistinct node.
+func NewApproxCountDistinct(e sql.Expression) *ApproxCountDistinct {
+	return &ApproxCountDistinct{expression.UnaryExpression{Child: e}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (a *ApproxCountDistinct) FunctionName() string {
+	return "approx_count_distinct"
+}
+
+// NewBuffer creates a new buffer for the aggregation.
+func (a *ApproxCountDistinct) NewBuffer() (sql.AggregationBuffer, error) {
+	bufferChild, err := expression.Clone(a.Child)
+	if err != nil {
+		return nil, err
+	}
+	return &approxCountDistinctBuffer{sketch: newHyperLogLog(), expr: bufferChild}, nil
+}
+
+// Type returns the type of the result.
+func (a *ApproxCountDistinct) Type() sql.Type {
+	return sql.Int64
+}
+
+// IsNullable returns whether the return value can be null.
+func (a *ApproxCountDistinct) IsNullable() bool {
+	return false
+}
+
+func (a *ApproxCountDistinct) String() string {
+	return fmt.Sprintf("APPROX_COUNT_DISTINCT(%s)", a.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (a *ApproxCountDistinct) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 1)
+	}
+	return NewApproxCountDistinct(children[0]), nil
+}
+
+// Eval implements the Expression interface.
+func (a *ApproxCountDistinct) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("ApproxCountDistinct")
+}
+
+type approxCountDistinctBuffer struct {
+	sketch *hyperLogLog
+	expr   sql.Expression
+}
+
+// Update implements the AggregationBuffer interface.
+func (a *approxCountDistinctBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	v, err := a.expr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	hash, err := hashstructure.Hash(v, nil)
+	if err != nil {
+		return fmt.Errorf("approx_count_distinct unable to hash value: %s", err)
+	}
+
+	a.sketch.add(hash)
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (a *approxCountDistinctBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return a.sketch.estimate(), nil
+}
+
+// Dispose implements the Disposable interface.
+func (a *approxCountDistinctBuffer) Dispose() {
+	expression.Dispose(a.expr)
+}
+
+// hyperLogLog is a HyperLogLog cardinality sketch, as described in "HyperLogLog: the analysis of a near-optimal
+// cardinality estimation algorithm" (Flajolet et al.), with the small range correction. Since 64 bit hashes are used,
+// no large range correction is needed.
+type hyperLogLog struct {
+	registers [hllRegisters]uint8
+}
+
+func newHyperLogLog() *hyperLogLog {
+	return &hyperLogLog{}
+}
+
+func (h *hyperLogLog) add(hash uint64) {
+	// The FNV hashes from hashstructure don't distribute well enough on their high bits, so mix them first
+	hash = mix64(hash)
+
+	idx := hash >> (64 - hllPrecision)
+	rank := uint8(bits.LeadingZeros64(hash<<hllPrecision|1<<(hllPrecision-1))) + 1
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+func (h *hyperLogLog) estimate() int64 {
+	m := float64(hllRegisters)
+	alpha := 0.7213 / (1 + 1.079/m)
+
+	var sum float64
+	var zeros int
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return int64(math.Round(estimate))
+}
+
+// mix64 is the finalizer of the SplitMix64 generator, used to spread the bits of a hash.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
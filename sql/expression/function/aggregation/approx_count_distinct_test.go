@@ -0,0 +1,50 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestApproxCountDistinct(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	c := NewApproxCountDistinct(expression.NewGetField(0, sql.Int64, "", true))
+	b, err := c.NewBuffer()
+	require.NoError(err)
+	require.Equal(int64(0), evalBuffer(t, b))
+
+	require.NoError(b.Update(ctx, sql.NewRow(nil)))
+	require.NoError(b.Update(ctx, sql.NewRow(int64(1))))
+	require.NoError(b.Update(ctx, sql.NewRow(int64(1))))
+	require.NoError(b.Update(ctx, sql.NewRow(int64(2))))
+	require.Equal(int64(2), evalBuffer(t, b))
+
+	const distinct = 100000
+	b, err = c.NewBuffer()
+	require.NoError(err)
+	for i := 0; i < 2*distinct; i++ {
+		require.NoError(b.Update(ctx, sql.NewRow(int64(i%distinct))))
+	}
+
+	estimate := evalBuffer(t, b).(int64)
+	require.InEpsilon(distinct, estimate, 0.03)
+}
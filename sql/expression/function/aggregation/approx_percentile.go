@@ -0,0 +1,259 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrInvalidPercentile is returned when a percentile is not between 0 and 1.
+var ErrInvalidPercentile = errors.NewKind("percentile must be a number between 0 and 1, got %v")
+
+// maxQuantileCentroids is the number of centroids kept by a quantile sketch after compression. The sketch never holds
+// more than twice this number, which bounds the memory used per group.
+const maxQuantileCentroids = 1000
+
+// ApproxPercentile estimates a percentile of the non-NULL values of an expression, e.g.
+// APPROX_PERCENTILE(latency, 0.99). Values are summarized in a sketch of bounded size, so the result is exact for small
+// groups and approximate for large ones.
+type ApproxPercentile struct {
+	expr       sql.Expression
+	percentile sql.Expression
+}
+
+var _ sql.FunctionExpression = (*ApproxPercentile)(nil)
+var _ sql.Aggregation = (*ApproxPercentile)(nil)
+
+// NewApproxPercentile creates a new ApproxPercentile node.
+func NewApproxPercentile(expr, percentile sql.Expression) sql.Expression {
+	return &ApproxPercentile{expr: expr, percentile: percentile}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (a *ApproxPercentile) FunctionName() string {
+	return "approx_percentile"
+}
+
+// Resolved implements the Expression interface.
+func (a *ApproxPercentile) Resolved() bool {
+	return a.expr.Resolved() && a.percentile.Resolved()
+}
+
+func (a *ApproxPercentile) String() string {
+	return fmt.Sprintf("APPROX_PERCENTILE(%s, %s)", a.expr, a.percentile)
+}
+
+// Type implements the Expression interface.
+func (a *ApproxPercentile) Type() sql.Type {
+	return sql.Float64
+}
+
+// IsNullable implements the Expression interface.
+func (a *ApproxPercentile) IsNullable() bool {
+	return true
+}
+
+// Children implements the Expression interface.
+func (a *ApproxPercentile) Children() []sql.Expression {
+	return []sql.Expression{a.expr, a.percentile}
+}
+
+// WithChildren implements the Expression interface.
+func (a *ApproxPercentile) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 2)
+	}
+	return NewApproxPercentile(children[0], children[1]), nil
+}
+
+// NewBuffer implements the Aggregation interface.
+func (a *ApproxPercentile) NewBuffer() (sql.AggregationBuffer, error) {
+	bufferChild, err := expression.Clone(a.expr)
+	if err != nil {
+		return nil, err
+	}
+	return &approxPercentileBuffer{expr: bufferChild, percentile: a.percentile}, nil
+}
+
+// Eval implements the Expression interface.
+func (a *ApproxPercentile) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("ApproxPercentile")
+}
+
+type approxPercentileBuffer struct {
+	expr       sql.Expression
+	percentile sql.Expression
+	sketch     quantileSketch
+}
+
+// Update implements the AggregationBuffer interface.
+func (a *approxPercentileBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	v, err := a.expr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	v, err = sql.Float64.Convert(v)
+	if err != nil {
+		return err
+	}
+
+	a.sketch.add(v.(float64))
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (a *approxPercentileBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	p, err := evalPercentile(ctx, a.percentile)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.sketch.count == 0 {
+		return nil, nil
+	}
+
+	return a.sketch.quantile(p), nil
+}
+
+// Dispose implements the Disposable interface.
+func (a *approxPercentileBuffer) Dispose() {
+	expression.Dispose(a.expr)
+}
+
+// evalPercentile evaluates a constant percentile argument and checks that it's between 0 and 1.
+func evalPercentile(ctx *sql.Context, e sql.Expression) (float64, error) {
+	v, err := e.Eval(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if v == nil {
+		return 0, ErrInvalidPercentile.New(v)
+	}
+
+	f, err := sql.Float64.Convert(v)
+	if err != nil {
+		return 0, ErrInvalidPercentile.New(v)
+	}
+
+	p := f.(float64)
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		return 0, ErrInvalidPercentile.New(v)
+	}
+
+	return p, nil
+}
+
+// centroid is a set of values summarized by their mean.
+type centroid struct {
+	mean  float64
+	count int64
+}
+
+// quantileSketch summarizes a stream of values as a bounded list of centroids. Until 2*maxQuantileCentroids values
+// have been seen every value is its own centroid and quantiles are exact. After that, adjacent centroids are merged
+// whenever the list grows too long, trading accuracy for memory.
+type quantileSketch struct {
+	centroids []centroid
+	count     int64
+	sorted    bool
+}
+
+func (q *quantileSketch) add(v float64) {
+	q.centroids = append(q.centroids, centroid{mean: v, count: 1})
+	q.count++
+	q.sorted = false
+
+	if len(q.centroids) > 2*maxQuantileCentroids {
+		q.compress()
+	}
+}
+
+func (q *quantileSketch) sort() {
+	if !q.sorted {
+		sort.Slice(q.centroids, func(i, j int) bool {
+			return q.centroids[i].mean < q.centroids[j].mean
+		})
+		q.sorted = true
+	}
+}
+
+// compress merges adjacent centroids, never letting one grow past twice its fair share of the values seen. Any two
+// adjacent centroids in the result hold more than that share together, so at most maxQuantileCentroids+1 remain.
+func (q *quantileSketch) compress() {
+	q.sort()
+
+	limit := 2 * q.count / maxQuantileCentroids
+	if limit < 1 {
+		limit = 1
+	}
+
+	merged := make([]centroid, 0, maxQuantileCentroids+1)
+	current := q.centroids[0]
+	for _, c := range q.centroids[1:] {
+		if current.count+c.count > limit {
+			merged = append(merged, current)
+			current = c
+			continue
+		}
+
+		count := current.count + c.count
+		current = centroid{
+			mean:  (current.mean*float64(current.count) + c.mean*float64(c.count)) / float64(count),
+			count: count,
+		}
+	}
+
+	q.centroids = append(merged, current)
+}
+
+// quantile returns the value at quantile |p| of the values seen, interpolating linearly between the two closest
+// ranks. The sketch must not be empty.
+func (q *quantileSketch) quantile(p float64) float64 {
+	q.sort()
+
+	rank := p * float64(q.count-1)
+	lower := int64(math.Floor(rank))
+	upper := int64(math.Ceil(rank))
+
+	lowerValue := q.valueAtRank(lower)
+	upperValue := q.valueAtRank(upper)
+
+	return lowerValue + (rank-float64(lower))*(upperValue-lowerValue)
+}
+
+// valueAtRank returns the mean of the centroid that holds the value with the rank given.
+func (q *quantileSketch) valueAtRank(rank int64) float64 {
+	var seen int64
+	for _, c := range q.centroids {
+		seen += c.count
+		if rank < seen {
+			return c.mean
+		}
+	}
+	return q.centroids[len(q.centroids)-1].mean
+}
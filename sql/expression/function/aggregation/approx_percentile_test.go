@@ -0,0 +1,62 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestApproxPercentile(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	newPercentile := func(p interface{}) sql.Aggregation {
+		return NewApproxPercentile(
+			expression.NewGetField(0, sql.Int64, "", true),
+			expression.NewLiteral(p, sql.Float64),
+		).(sql.Aggregation)
+	}
+
+	require.Nil(aggregate(t, newPercentile(0.5)))
+	require.Nil(aggregate(t, newPercentile(0.5), sql.NewRow(nil)))
+
+	rows := []sql.Row{{int64(4)}, {nil}, {int64(1)}, {int64(3)}, {int64(2)}}
+	require.Equal(float64(1), aggregate(t, newPercentile(0.0), rows...))
+	require.Equal(2.5, aggregate(t, newPercentile(0.5), rows...))
+	require.Equal(float64(4), aggregate(t, newPercentile(1.0), rows...))
+	require.Equal(3.25, aggregate(t, newPercentile(0.75), rows...))
+
+	b, err := newPercentile(1.5).NewBuffer()
+	require.NoError(err)
+	_, err = b.Eval(ctx)
+	require.True(ErrInvalidPercentile.Is(err))
+
+	const n = 100000
+	b, err = newPercentile(0.9).NewBuffer()
+	require.NoError(err)
+	for i := 0; i < n; i++ {
+		// Insert the values out of order so compression sees a mix of them
+		require.NoError(b.Update(ctx, sql.NewRow(int64((i*7919)%n))))
+	}
+
+	v, err := b.Eval(ctx)
+	require.NoError(err)
+	require.InEpsilon(0.9*n, v, 0.01)
+}
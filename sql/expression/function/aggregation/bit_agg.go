@@ -0,0 +1,223 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// BitAnd node to calculate the bitwise AND of all the values of a column, as unsigned 64 bit integers.
+type BitAnd struct {
+	expression.UnaryExpression
+}
+
+// BitOr node to calculate the bitwise OR of all the values of a column, as unsigned 64 bit integers.
+type BitOr struct {
+	expression.UnaryExpression
+}
+
+// BitXor node to calculate the bitwise XOR of all the values of a column, as unsigned 64 bit integers.
+type BitXor struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*BitAnd)(nil)
+var _ sql.Aggregation = (*BitAnd)(nil)
+var _ sql.FunctionExpression = (*BitOr)(nil)
+var _ sql.Aggregation = (*BitOr)(nil)
+var _ sql.FunctionExpression = (*BitXor)(nil)
+var _ sql.Aggregation = (*BitXor)(nil)
+
+// NewBitAnd creates a new BitAnd node.
+func NewBitAnd(e sql.Expression) *BitAnd {
+	return &BitAnd{expression.UnaryExpression{Child: e}}
+}
+
+// NewBitOr creates a new BitOr node.
+func NewBitOr(e sql.Expression) *BitOr {
+	return &BitOr{expression.UnaryExpression{Child: e}}
+}
+
+// NewBitXor creates a new BitXor node.
+func NewBitXor(e sql.Expression) *BitXor {
+	return &BitXor{expression.UnaryExpression{Child: e}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *BitAnd) FunctionName() string {
+	return "bit_and"
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *BitOr) FunctionName() string {
+	return "bit_or"
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *BitXor) FunctionName() string {
+	return "bit_xor"
+}
+
+func (b *BitAnd) String() string {
+	return fmt.Sprintf("BIT_AND(%s)", b.Child)
+}
+
+func (b *BitOr) String() string {
+	return fmt.Sprintf("BIT_OR(%s)", b.Child)
+}
+
+func (b *BitXor) String() string {
+	return fmt.Sprintf("BIT_XOR(%s)", b.Child)
+}
+
+// Type implements Expression interface.
+func (b *BitAnd) Type() sql.Type {
+	return sql.Uint64
+}
+
+// Type implements Expression interface.
+func (b *BitOr) Type() sql.Type {
+	return sql.Uint64
+}
+
+// Type implements Expression interface.
+func (b *BitXor) Type() sql.Type {
+	return sql.Uint64
+}
+
+// IsNullable implements Expression interface.
+func (b *BitAnd) IsNullable() bool {
+	return false
+}
+
+// IsNullable implements Expression interface.
+func (b *BitOr) IsNullable() bool {
+	return false
+}
+
+// IsNullable implements Expression interface.
+func (b *BitXor) IsNullable() bool {
+	return false
+}
+
+// Eval implements Expression interface.
+func (b *BitAnd) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("BitAnd")
+}
+
+// Eval implements Expression interface.
+func (b *BitOr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("BitOr")
+}
+
+// Eval implements Expression interface.
+func (b *BitXor) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("BitXor")
+}
+
+// WithChildren implements the Expression interface.
+func (b *BitAnd) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 1)
+	}
+	return NewBitAnd(children[0]), nil
+}
+
+// WithChildren implements the Expression interface.
+func (b *BitOr) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 1)
+	}
+	return NewBitOr(children[0]), nil
+}
+
+// WithChildren implements the Expression interface.
+func (b *BitXor) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 1)
+	}
+	return NewBitXor(children[0]), nil
+}
+
+// NewBuffer implements Aggregation interface. The result without any non NULL values has all its bits set.
+func (b *BitAnd) NewBuffer() (sql.AggregationBuffer, error) {
+	return newBitBuffer(b.Child, math.MaxUint64, func(a, b uint64) uint64 { return a & b })
+}
+
+// NewBuffer implements Aggregation interface. The result without any non NULL values is zero.
+func (b *BitOr) NewBuffer() (sql.AggregationBuffer, error) {
+	return newBitBuffer(b.Child, 0, func(a, b uint64) uint64 { return a | b })
+}
+
+// NewBuffer implements Aggregation interface. The result without any non NULL values is zero.
+func (b *BitXor) NewBuffer() (sql.AggregationBuffer, error) {
+	return newBitBuffer(b.Child, 0, func(a, b uint64) uint64 { return a ^ b })
+}
+
+// bitBuffer combines the non NULL values of its expression with a bitwise operation.
+type bitBuffer struct {
+	value uint64
+	op    func(a, b uint64) uint64
+	expr  sql.Expression
+}
+
+func newBitBuffer(child sql.Expression, initial uint64, op func(a, b uint64) uint64) (*bitBuffer, error) {
+	bufferChild, err := expression.Clone(child)
+	if err != nil {
+		return nil, err
+	}
+	return &bitBuffer{value: initial, op: op, expr: bufferChild}, nil
+}
+
+// Update implements the AggregationBuffer interface.
+func (b *bitBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	v, err := b.expr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	b.value = b.op(b.value, bitValue(v))
+	return nil
+}
+
+// bitValue returns the value given as an unsigned 64 bit integer. Negative values are taken in two's complement, and
+// values that aren't numbers are 0.
+func bitValue(v interface{}) uint64 {
+	if u, err := sql.Uint64.Convert(v); err == nil {
+		return u.(uint64)
+	}
+	if i, err := sql.Int64.Convert(v); err == nil {
+		return uint64(i.(int64))
+	}
+	return 0
+}
+
+// Eval implements the AggregationBuffer interface.
+func (b *bitBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return b.value, nil
+}
+
+// Dispose implements the Disposable interface.
+func (b *bitBuffer) Dispose() {
+	expression.Dispose(b.expr)
+}
@@ -0,0 +1,52 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestBitAggregations(t *testing.T) {
+	field := expression.NewGetField(0, sql.Int64, "field", true)
+	rows := []sql.Row{{int64(12)}, {nil}, {int64(10)}, {int64(6)}}
+
+	testCases := []struct {
+		name     string
+		agg      sql.Aggregation
+		rows     []sql.Row
+		expected uint64
+	}{
+		{"bit_and", NewBitAnd(field), rows, 0},
+		{"bit_and of two rows", NewBitAnd(field), rows[:3], 8},
+		{"bit_or", NewBitOr(field), rows, 14},
+		{"bit_xor", NewBitXor(field), rows, 0},
+		{"bit_xor of two rows", NewBitXor(field), rows[:3], 6},
+		{"bit_and of a negative number", NewBitAnd(field), []sql.Row{{int64(-1)}}, 18446744073709551615},
+		{"bit_and without rows", NewBitAnd(field), nil, 18446744073709551615},
+		{"bit_or without rows", NewBitOr(field), []sql.Row{{nil}}, 0},
+		{"bit_xor without rows", NewBitXor(field), nil, 0},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, aggregate(t, tt.agg, tt.rows...))
+		})
+	}
+}
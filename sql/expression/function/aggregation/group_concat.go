@@ -133,7 +133,7 @@ func (g *GroupConcat) Type() sql.Type {
 
 // IsNullable implements the Expression interface.
 func (g *GroupConcat) IsNullable() bool {
-	return false
+	return true
 }
 
 // Children implements the Expression interface.
@@ -174,27 +174,28 @@ func (g *groupConcatBuffer) Update(ctx *sql.Context, originalRow sql.Row) error
 
 	g.gc.returnType = retType
 
-	// Skip if this is a null row
-	if evalRow == nil {
-		return nil
-	}
+	// Rows where any of the expressions is NULL are skipped, the values of the rest are concatenated.
+	sb := strings.Builder{}
+	for _, val := range evalRow {
+		if val == nil {
+			return nil
+		}
 
-	var v interface{}
-	if retType == sql.Blob {
-		v, err = sql.Blob.Convert(evalRow[0])
-	} else {
-		v, err = sql.LongText.Convert(evalRow[0])
-	}
+		var v interface{}
+		if retType == sql.Blob {
+			v, err = sql.Blob.Convert(val)
+		} else {
+			v, err = sql.LongText.Convert(val)
+		}
 
-	if err != nil {
-		return err
-	}
+		if err != nil {
+			return err
+		}
 
-	if v == nil {
-		return nil
+		sb.WriteString(v.(string))
 	}
 
-	vs := v.(string)
+	vs := sb.String()
 
 	// Get the current array of rows and the map
 	// Check if distinct is active if so look at and update our map
@@ -209,7 +210,9 @@ func (g *groupConcatBuffer) Update(ctx *sql.Context, originalRow sql.Row) error
 
 	// Append the current value to the end of the row. We want to preserve the row's original structure for
 	// for sort ordering in the final step.
-	g.rows = append(g.rows, append(originalRow, nil, vs))
+	row := make(sql.Row, len(originalRow), len(originalRow)+2)
+	copy(row, originalRow)
+	g.rows = append(g.rows, append(row, nil, vs))
 
 	return nil
 }
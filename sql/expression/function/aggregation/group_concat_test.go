@@ -107,3 +107,24 @@ func TestGroupConcat_ReturnType(t *testing.T) {
 		require.Equal(t, tt.returnType, gc.Type())
 	}
 }
+
+// Validates that all the expressions of GROUP_CONCAT are concatenated, and that rows where any of them is NULL are
+// skipped.
+func TestGroupConcat_MultipleExpressionsAndNulls(t *testing.T) {
+	require := require.New(t)
+
+	gc, err := NewGroupConcat("", nil, ",", []sql.Expression{
+		expression.NewGetField(0, sql.LongText, "a", true),
+		expression.NewGetField(1, sql.Int64, "b", true),
+	}, 1024)
+	require.NoError(err)
+	require.True(gc.IsNullable())
+
+	require.Equal("x1,z3", aggregate(t, gc,
+		sql.Row{"x", int64(1)},
+		sql.Row{nil, int64(2)},
+		sql.Row{"z", int64(3)},
+		sql.Row{"y", nil},
+	))
+	require.Nil(aggregate(t, gc, sql.Row{nil, nil}))
+}
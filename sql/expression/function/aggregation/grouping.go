@@ -0,0 +1,110 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrGroupingWithoutGroupingSets is returned when GROUPING is used in a query that isn't grouped by ROLLUP, CUBE or
+// GROUPING SETS.
+var ErrGroupingWithoutGroupingSets = errors.NewKind("GROUPING function can only be used with ROLLUP, CUBE or GROUPING SETS")
+
+// Grouping returns whether each of its arguments is grouped out of the row of a grouping set, as a bit mask with the
+// bit of the first argument as the most significant one. Its value is computed by the GroupBy node for each grouping
+// set, so it can't be evaluated on its own.
+type Grouping struct {
+	Args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Grouping)(nil)
+var _ sql.Aggregation = (*Grouping)(nil)
+
+// NewGrouping creates a new Grouping expression.
+func NewGrouping(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) == 0 {
+		return nil, sql.ErrInvalidArgumentNumber.New("GROUPING", "1 or more", 0)
+	}
+	return &Grouping{Args: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (g *Grouping) FunctionName() string {
+	return "grouping"
+}
+
+// Type implements the Expression interface.
+func (g *Grouping) Type() sql.Type {
+	return sql.Int64
+}
+
+// IsNullable implements the Expression interface.
+func (g *Grouping) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the Expression interface.
+func (g *Grouping) Resolved() bool {
+	return expression.ExpressionsResolved(g.Args...)
+}
+
+// Children implements the Expression interface.
+func (g *Grouping) Children() []sql.Expression {
+	return g.Args
+}
+
+func (g *Grouping) String() string {
+	args := make([]string, len(g.Args))
+	for i, arg := range g.Args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("GROUPING(%s)", strings.Join(args, ", "))
+}
+
+// WithChildren implements the Expression interface.
+func (g *Grouping) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) == 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 1)
+	}
+	return NewGrouping(children...)
+}
+
+// NewBuffer implements the Aggregation interface.
+func (g *Grouping) NewBuffer() (sql.AggregationBuffer, error) {
+	return nil, ErrGroupingWithoutGroupingSets.New()
+}
+
+// Eval implements the Expression interface.
+func (g *Grouping) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrGroupingWithoutGroupingSets.New()
+}
+
+// Mask returns the value of the expression for a grouping set, given whether each of its arguments is grouped out.
+func (g *Grouping) Mask(groupedOut []bool) int64 {
+	var mask int64
+	for _, out := range groupedOut {
+		mask <<= 1
+		if out {
+			mask |= 1
+		}
+	}
+	return mask
+}
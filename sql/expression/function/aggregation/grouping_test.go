@@ -0,0 +1,49 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestGrouping(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewGrouping()
+	require.True(sql.ErrInvalidArgumentNumber.Is(err))
+
+	e, err := NewGrouping(
+		expression.NewGetField(0, sql.Int64, "a", false),
+		expression.NewGetField(1, sql.Int64, "b", false),
+	)
+	require.NoError(err)
+	require.Equal("GROUPING(a, b)", e.String())
+
+	g := e.(*Grouping)
+	require.Equal(int64(0), g.Mask([]bool{false, false}))
+	require.Equal(int64(1), g.Mask([]bool{false, true}))
+	require.Equal(int64(2), g.Mask([]bool{true, false}))
+	require.Equal(int64(3), g.Mask([]bool{true, true}))
+
+	_, err = g.Eval(sql.NewEmptyContext(), sql.Row{int64(1), int64(2)})
+	require.True(ErrGroupingWithoutGroupingSets.Is(err))
+	_, err = g.NewBuffer()
+	require.True(ErrGroupingWithoutGroupingSets.Is(err))
+}
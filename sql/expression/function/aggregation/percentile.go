@@ -0,0 +1,299 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// PercentileCont computes a percentile of the non-NULL values of an expression, interpolating linearly between the
+// two closest values when the percentile falls between them, e.g. PERCENTILE_CONT(price, 0.5).
+type PercentileCont struct {
+	expr       sql.Expression
+	percentile sql.Expression
+}
+
+var _ sql.FunctionExpression = (*PercentileCont)(nil)
+var _ sql.Aggregation = (*PercentileCont)(nil)
+
+// NewPercentileCont creates a new PercentileCont node.
+func NewPercentileCont(expr, percentile sql.Expression) sql.Expression {
+	return &PercentileCont{expr: expr, percentile: percentile}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (p *PercentileCont) FunctionName() string {
+	return "percentile_cont"
+}
+
+// Resolved implements the Expression interface.
+func (p *PercentileCont) Resolved() bool {
+	return p.expr.Resolved() && p.percentile.Resolved()
+}
+
+func (p *PercentileCont) String() string {
+	return fmt.Sprintf("PERCENTILE_CONT(%s, %s)", p.expr, p.percentile)
+}
+
+// Type implements the Expression interface.
+func (p *PercentileCont) Type() sql.Type {
+	return sql.Float64
+}
+
+// IsNullable implements the Expression interface.
+func (p *PercentileCont) IsNullable() bool {
+	return true
+}
+
+// Children implements the Expression interface.
+func (p *PercentileCont) Children() []sql.Expression {
+	return []sql.Expression{p.expr, p.percentile}
+}
+
+// WithChildren implements the Expression interface.
+func (p *PercentileCont) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 2)
+	}
+	return NewPercentileCont(children[0], children[1]), nil
+}
+
+// NewBuffer implements the Aggregation interface.
+func (p *PercentileCont) NewBuffer() (sql.AggregationBuffer, error) {
+	return newPercentileBuffer(p.expr, p.percentile, false)
+}
+
+// Eval implements the Expression interface.
+func (p *PercentileCont) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("PercentileCont")
+}
+
+// PercentileDisc computes a percentile of the non-NULL values of an expression, returning the first value whose
+// cumulative distribution is greater than or equal to the percentile, e.g. PERCENTILE_DISC(price, 0.5). Unlike
+// PERCENTILE_CONT, the result is always one of the values of the group.
+type PercentileDisc struct {
+	expr       sql.Expression
+	percentile sql.Expression
+}
+
+var _ sql.FunctionExpression = (*PercentileDisc)(nil)
+var _ sql.Aggregation = (*PercentileDisc)(nil)
+
+// NewPercentileDisc creates a new PercentileDisc node.
+func NewPercentileDisc(expr, percentile sql.Expression) sql.Expression {
+	return &PercentileDisc{expr: expr, percentile: percentile}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (p *PercentileDisc) FunctionName() string {
+	return "percentile_disc"
+}
+
+// Resolved implements the Expression interface.
+func (p *PercentileDisc) Resolved() bool {
+	return p.expr.Resolved() && p.percentile.Resolved()
+}
+
+func (p *PercentileDisc) String() string {
+	return fmt.Sprintf("PERCENTILE_DISC(%s, %s)", p.expr, p.percentile)
+}
+
+// Type implements the Expression interface.
+func (p *PercentileDisc) Type() sql.Type {
+	return p.expr.Type()
+}
+
+// IsNullable implements the Expression interface.
+func (p *PercentileDisc) IsNullable() bool {
+	return true
+}
+
+// Children implements the Expression interface.
+func (p *PercentileDisc) Children() []sql.Expression {
+	return []sql.Expression{p.expr, p.percentile}
+}
+
+// WithChildren implements the Expression interface.
+func (p *PercentileDisc) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 2)
+	}
+	return NewPercentileDisc(children[0], children[1]), nil
+}
+
+// NewBuffer implements the Aggregation interface.
+func (p *PercentileDisc) NewBuffer() (sql.AggregationBuffer, error) {
+	return newPercentileBuffer(p.expr, p.percentile, true)
+}
+
+// Eval implements the Expression interface.
+func (p *PercentileDisc) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("PercentileDisc")
+}
+
+// Median computes the median of the non-NULL values of an expression. It's equivalent to PERCENTILE_CONT(expr, 0.5).
+type Median struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*Median)(nil)
+var _ sql.Aggregation = (*Median)(nil)
+
+// NewMedian creates a new Median node.
+func NewMedian(e sql.Expression) *Median {
+	return &Median{expression.UnaryExpression{Child: e}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *Median) FunctionName() string {
+	return "median"
+}
+
+func (m *Median) String() string {
+	return fmt.Sprintf("MEDIAN(%s)", m.Child)
+}
+
+// Type implements the Expression interface.
+func (m *Median) Type() sql.Type {
+	return sql.Float64
+}
+
+// IsNullable implements the Expression interface.
+func (m *Median) IsNullable() bool {
+	return true
+}
+
+// WithChildren implements the Expression interface.
+func (m *Median) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 1)
+	}
+	return NewMedian(children[0]), nil
+}
+
+// NewBuffer implements the Aggregation interface.
+func (m *Median) NewBuffer() (sql.AggregationBuffer, error) {
+	return newPercentileBuffer(m.Child, expression.NewLiteral(0.5, sql.Float64), false)
+}
+
+// Eval implements the Expression interface.
+func (m *Median) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("Median")
+}
+
+// percentileBuffer holds every non-NULL value of a group, so that exact percentiles can be computed once the group is
+// complete.
+type percentileBuffer struct {
+	expr       sql.Expression
+	percentile sql.Expression
+	discrete   bool
+	values     []interface{}
+}
+
+func newPercentileBuffer(expr, percentile sql.Expression, discrete bool) (*percentileBuffer, error) {
+	bufferChild, err := expression.Clone(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &percentileBuffer{expr: bufferChild, percentile: percentile, discrete: discrete}, nil
+}
+
+// Update implements the AggregationBuffer interface.
+func (p *percentileBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	v, err := p.expr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	if !p.discrete {
+		v, err = sql.Float64.Convert(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	p.values = append(p.values, v)
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (p *percentileBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	percentile, err := evalPercentile(ctx, p.percentile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(p.values) == 0 {
+		return nil, nil
+	}
+
+	if p.discrete {
+		return p.discretePercentile(percentile)
+	}
+
+	return p.continuousPercentile(percentile), nil
+}
+
+func (p *percentileBuffer) discretePercentile(percentile float64) (interface{}, error) {
+	typ := p.expr.Type()
+
+	var sortErr error
+	sort.SliceStable(p.values, func(i, j int) bool {
+		cmp, err := typ.Compare(p.values[i], p.values[j])
+		if err != nil {
+			sortErr = err
+		}
+		return cmp < 0
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	idx := int(math.Ceil(percentile*float64(len(p.values)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+
+	return p.values[idx], nil
+}
+
+func (p *percentileBuffer) continuousPercentile(percentile float64) float64 {
+	sort.Slice(p.values, func(i, j int) bool {
+		return p.values[i].(float64) < p.values[j].(float64)
+	})
+
+	rank := percentile * float64(len(p.values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	lowerValue := p.values[lower].(float64)
+	upperValue := p.values[upper].(float64)
+
+	return lowerValue + (rank-float64(lower))*(upperValue-lowerValue)
+}
+
+// Dispose implements the Disposable interface.
+func (p *percentileBuffer) Dispose() {
+	expression.Dispose(p.expr)
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestPercentileCont(t *testing.T) {
+	require := require.New(t)
+
+	newPercentile := func(p interface{}) sql.Aggregation {
+		return NewPercentileCont(
+			expression.NewGetField(0, sql.Int64, "", true),
+			expression.NewLiteral(p, sql.Float64),
+		).(sql.Aggregation)
+	}
+
+	require.Nil(aggregate(t, newPercentile(0.5)))
+	require.Nil(aggregate(t, newPercentile(0.5), sql.NewRow(nil)))
+
+	rows := []sql.Row{{int64(4)}, {nil}, {int64(1)}, {int64(3)}, {int64(2)}}
+	require.Equal(float64(1), aggregate(t, newPercentile(0.0), rows...))
+	require.Equal(2.5, aggregate(t, newPercentile(0.5), rows...))
+	require.Equal(3.25, aggregate(t, newPercentile(0.75), rows...))
+	require.Equal(float64(4), aggregate(t, newPercentile(1.0), rows...))
+
+	b, err := newPercentile(-0.1).NewBuffer()
+	require.NoError(err)
+	_, err = b.Eval(sql.NewEmptyContext())
+	require.True(ErrInvalidPercentile.Is(err))
+}
+
+func TestPercentileDisc(t *testing.T) {
+	require := require.New(t)
+
+	newPercentile := func(p interface{}) sql.Aggregation {
+		return NewPercentileDisc(
+			expression.NewGetField(0, sql.LongText, "", true),
+			expression.NewLiteral(p, sql.Float64),
+		).(sql.Aggregation)
+	}
+
+	require.Nil(aggregate(t, newPercentile(0.5)))
+
+	rows := []sql.Row{{"d"}, {nil}, {"a"}, {"c"}, {"b"}}
+	require.Equal("a", aggregate(t, newPercentile(0.0), rows...))
+	require.Equal("a", aggregate(t, newPercentile(0.25), rows...))
+	require.Equal("b", aggregate(t, newPercentile(0.5), rows...))
+	require.Equal("c", aggregate(t, newPercentile(0.6), rows...))
+	require.Equal("d", aggregate(t, newPercentile(1.0), rows...))
+}
+
+func TestMedian(t *testing.T) {
+	require := require.New(t)
+
+	median := NewMedian(expression.NewGetField(0, sql.Float64, "", true))
+
+	require.Nil(aggregate(t, median))
+	require.Equal(float64(3), aggregate(t, median, sql.NewRow(5.0), sql.NewRow(1.0), sql.NewRow(3.0)))
+	require.Equal(2.5, aggregate(t, median, sql.NewRow(4.0), sql.NewRow(1.0), sql.NewRow(3.0), sql.NewRow(2.0)))
+}
@@ -0,0 +1,286 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// VarPop node to calculate the population variance of a numeric column. It's also VARIANCE.
+type VarPop struct {
+	expression.UnaryExpression
+}
+
+// VarSamp node to calculate the sample variance of a numeric column.
+type VarSamp struct {
+	expression.UnaryExpression
+}
+
+// StdDevPop node to calculate the population standard deviation of a numeric column. It's also STD and STDDEV.
+type StdDevPop struct {
+	expression.UnaryExpression
+}
+
+// StdDevSamp node to calculate the sample standard deviation of a numeric column.
+type StdDevSamp struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*VarPop)(nil)
+var _ sql.Aggregation = (*VarPop)(nil)
+var _ sql.FunctionExpression = (*VarSamp)(nil)
+var _ sql.Aggregation = (*VarSamp)(nil)
+var _ sql.FunctionExpression = (*StdDevPop)(nil)
+var _ sql.Aggregation = (*StdDevPop)(nil)
+var _ sql.FunctionExpression = (*StdDevSamp)(nil)
+var _ sql.Aggregation = (*StdDevSamp)(nil)
+
+// NewVarPop creates a new VarPop node.
+func NewVarPop(e sql.Expression) *VarPop {
+	return &VarPop{expression.UnaryExpression{Child: e}}
+}
+
+// NewVarSamp creates a new VarSamp node.
+func NewVarSamp(e sql.Expression) *VarSamp {
+	return &VarSamp{expression.UnaryExpression{Child: e}}
+}
+
+// NewStdDevPop creates a new StdDevPop node.
+func NewStdDevPop(e sql.Expression) *StdDevPop {
+	return &StdDevPop{expression.UnaryExpression{Child: e}}
+}
+
+// NewStdDevSamp creates a new StdDevSamp node.
+func NewStdDevSamp(e sql.Expression) *StdDevSamp {
+	return &StdDevSamp{expression.UnaryExpression{Child: e}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (v *VarPop) FunctionName() string {
+	return "var_pop"
+}
+
+// FunctionName implements sql.FunctionExpression
+func (v *VarSamp) FunctionName() string {
+	return "var_samp"
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *StdDevPop) FunctionName() string {
+	return "stddev_pop"
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *StdDevSamp) FunctionName() string {
+	return "stddev_samp"
+}
+
+func (v *VarPop) String() string {
+	return fmt.Sprintf("VAR_POP(%s)", v.Child)
+}
+
+func (v *VarSamp) String() string {
+	return fmt.Sprintf("VAR_SAMP(%s)", v.Child)
+}
+
+func (s *StdDevPop) String() string {
+	return fmt.Sprintf("STDDEV_POP(%s)", s.Child)
+}
+
+func (s *StdDevSamp) String() string {
+	return fmt.Sprintf("STDDEV_SAMP(%s)", s.Child)
+}
+
+// Type implements Expression interface.
+func (v *VarPop) Type() sql.Type {
+	return sql.Float64
+}
+
+// Type implements Expression interface.
+func (v *VarSamp) Type() sql.Type {
+	return sql.Float64
+}
+
+// Type implements Expression interface.
+func (s *StdDevPop) Type() sql.Type {
+	return sql.Float64
+}
+
+// Type implements Expression interface.
+func (s *StdDevSamp) Type() sql.Type {
+	return sql.Float64
+}
+
+// IsNullable implements Expression interface.
+func (v *VarPop) IsNullable() bool {
+	return true
+}
+
+// IsNullable implements Expression interface.
+func (v *VarSamp) IsNullable() bool {
+	return true
+}
+
+// IsNullable implements Expression interface.
+func (s *StdDevPop) IsNullable() bool {
+	return true
+}
+
+// IsNullable implements Expression interface.
+func (s *StdDevSamp) IsNullable() bool {
+	return true
+}
+
+// Eval implements Expression interface.
+func (v *VarPop) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("VarPop")
+}
+
+// Eval implements Expression interface.
+func (v *VarSamp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("VarSamp")
+}
+
+// Eval implements Expression interface.
+func (s *StdDevPop) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("StdDevPop")
+}
+
+// Eval implements Expression interface.
+func (s *StdDevSamp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("StdDevSamp")
+}
+
+// WithChildren implements the Expression interface.
+func (v *VarPop) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(v, len(children), 1)
+	}
+	return NewVarPop(children[0]), nil
+}
+
+// WithChildren implements the Expression interface.
+func (v *VarSamp) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(v, len(children), 1)
+	}
+	return NewVarSamp(children[0]), nil
+}
+
+// WithChildren implements the Expression interface.
+func (s *StdDevPop) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	return NewStdDevPop(children[0]), nil
+}
+
+// WithChildren implements the Expression interface.
+func (s *StdDevSamp) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	return NewStdDevSamp(children[0]), nil
+}
+
+// NewBuffer implements Aggregation interface.
+func (v *VarPop) NewBuffer() (sql.AggregationBuffer, error) {
+	return newVarianceBuffer(v.Child, false, false)
+}
+
+// NewBuffer implements Aggregation interface.
+func (v *VarSamp) NewBuffer() (sql.AggregationBuffer, error) {
+	return newVarianceBuffer(v.Child, true, false)
+}
+
+// NewBuffer implements Aggregation interface.
+func (s *StdDevPop) NewBuffer() (sql.AggregationBuffer, error) {
+	return newVarianceBuffer(s.Child, false, true)
+}
+
+// NewBuffer implements Aggregation interface.
+func (s *StdDevSamp) NewBuffer() (sql.AggregationBuffer, error) {
+	return newVarianceBuffer(s.Child, true, true)
+}
+
+// varianceBuffer computes the variance of the non NULL values of its expression with Welford's online algorithm,
+// which doesn't lose precision when the values are large compared to their differences.
+type varianceBuffer struct {
+	rows   int64
+	mean   float64
+	m2     float64
+	sample bool
+	stddev bool
+	expr   sql.Expression
+}
+
+func newVarianceBuffer(child sql.Expression, sample, stddev bool) (*varianceBuffer, error) {
+	bufferChild, err := expression.Clone(child)
+	if err != nil {
+		return nil, err
+	}
+	return &varianceBuffer{sample: sample, stddev: stddev, expr: bufferChild}, nil
+}
+
+// Update implements the AggregationBuffer interface.
+func (v *varianceBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	val, err := v.expr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if val == nil {
+		return nil
+	}
+
+	val, err = sql.Float64.Convert(val)
+	if err != nil {
+		val = float64(0)
+	}
+
+	x := val.(float64)
+	v.rows++
+	delta := x - v.mean
+	v.mean += delta / float64(v.rows)
+	v.m2 += delta * (x - v.mean)
+
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (v *varianceBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	n := v.rows
+	if v.sample {
+		n--
+	}
+	if n <= 0 {
+		return nil, nil
+	}
+
+	variance := v.m2 / float64(n)
+	if v.stddev {
+		return math.Sqrt(variance), nil
+	}
+	return variance, nil
+}
+
+// Dispose implements the Disposable interface.
+func (v *varianceBuffer) Dispose() {
+	expression.Dispose(v.expr)
+}
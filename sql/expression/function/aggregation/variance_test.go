@@ -0,0 +1,57 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestVariance(t *testing.T) {
+	field := expression.NewGetField(0, sql.Float64, "field", true)
+	rows := []sql.Row{{float64(2)}, {float64(4)}, {nil}, {float64(4)}, {float64(4)}, {float64(5)}, {float64(5)}, {float64(7)}, {float64(9)}}
+
+	testCases := []struct {
+		name     string
+		agg      sql.Aggregation
+		rows     []sql.Row
+		expected interface{}
+	}{
+		{"var_pop", NewVarPop(field), rows, float64(4)},
+		{"var_samp", NewVarSamp(field), rows, float64(32) / 7},
+		{"stddev_pop", NewStdDevPop(field), rows, float64(2)},
+		{"stddev_samp", NewStdDevSamp(field), rows[:2], math.Sqrt2},
+		{"var_pop of a single row", NewVarPop(field), rows[:1], float64(0)},
+		{"var_samp of a single row", NewVarSamp(field), rows[:1], nil},
+		{"stddev_pop of only nulls", NewStdDevPop(field), []sql.Row{{nil}}, nil},
+		{"var_pop without rows", NewVarPop(field), nil, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result := aggregate(t, tt.agg, tt.rows...)
+			if tt.expected == nil {
+				require.Nil(t, result)
+				return
+			}
+			require.InDelta(t, tt.expected, result, 1e-9)
+		})
+	}
+}
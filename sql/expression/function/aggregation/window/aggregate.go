@@ -0,0 +1,258 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Aggregate is an aggregate function, such as SUM or COUNT, used as a window function: for every row, the aggregation
+// is evaluated over the rows of the frame of the row in its partition.
+type Aggregate struct {
+	agg    sql.Aggregation
+	window *sql.Window
+}
+
+var _ sql.FunctionExpression = (*Aggregate)(nil)
+var _ sql.WindowAggregation = (*Aggregate)(nil)
+
+// NewAggregate returns the aggregation given evaluated over the window given.
+func NewAggregate(agg sql.Aggregation, window *sql.Window) *Aggregate {
+	return &Aggregate{agg: agg, window: window}
+}
+
+// Aggregation returns the aggregation evaluated over the window.
+func (a *Aggregate) Aggregation() sql.Aggregation {
+	return a.agg
+}
+
+// FunctionName implements sql.FunctionExpression
+func (a *Aggregate) FunctionName() string {
+	if f, ok := a.agg.(sql.FunctionExpression); ok {
+		return f.FunctionName()
+	}
+	return strings.ToLower(a.agg.String())
+}
+
+// Window implements sql.WindowAggregation
+func (a *Aggregate) Window() *sql.Window {
+	return a.window
+}
+
+// Resolved implements sql.Expression
+func (a *Aggregate) Resolved() bool {
+	return a.agg.Resolved() && windowResolved(a.window)
+}
+
+func (a *Aggregate) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(a.agg.String())
+	sb.WriteString(" ")
+	sb.WriteString(a.window.String())
+	return sb.String()
+}
+
+func (a *Aggregate) DebugString() string {
+	sb := strings.Builder{}
+	sb.WriteString(sql.DebugString(a.agg))
+	sb.WriteString(" ")
+	sb.WriteString(sql.DebugString(a.window))
+	return sb.String()
+}
+
+// Type implements sql.Expression
+func (a *Aggregate) Type() sql.Type {
+	return a.agg.Type()
+}
+
+// IsNullable implements sql.Expression. The frame of a row may be empty, in which case most aggregations are NULL.
+func (a *Aggregate) IsNullable() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (a *Aggregate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	panic("eval called on window function")
+}
+
+// Children implements sql.Expression
+func (a *Aggregate) Children() []sql.Expression {
+	return append(a.agg.Children(), a.window.ToExpressions()...)
+}
+
+// WithChildren implements sql.Expression
+func (a *Aggregate) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	n := len(a.agg.Children())
+	if len(children) < n {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), n+len(a.window.ToExpressions()))
+	}
+
+	agg, err := a.agg.WithChildren(children[:n]...)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := a.window.FromExpressions(children[n:])
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAggregate(agg.(sql.Aggregation), window), nil
+}
+
+// WithWindow implements sql.WindowAggregation
+func (a *Aggregate) WithWindow(window *sql.Window) (sql.WindowAggregation, error) {
+	return NewAggregate(a.agg, window), nil
+}
+
+// NewBuffer implements sql.WindowAggregation
+func (a *Aggregate) NewBuffer() sql.Row {
+	return sql.NewRow(make([]sql.Row, 0))
+}
+
+// Add implements sql.WindowAggregation
+func (a *Aggregate) Add(ctx *sql.Context, buffer, row sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	r := make(sql.Row, len(row), len(row)+2)
+	copy(r, row)
+	buffer[0] = append(rows, append(r, nil, len(rows)))
+	return nil
+}
+
+// Finish implements sql.WindowAggregation. The rows are sorted by partition and by the ORDER BY of the window, the
+// aggregation is evaluated over the frame of every row, and the rows are put back in their original order.
+func (a *Aggregate) Finish(ctx *sql.Context, buffer sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	resultIdx := len(rows[0]) - 2
+	originalOrderIdx := len(rows[0]) - 1
+
+	sortFields := append(partitionsToSortFields(a.window.PartitionBy), a.window.OrderBy...)
+	if len(sortFields) > 0 {
+		sorter := &expression.Sorter{
+			SortFields: sortFields,
+			Rows:       rows,
+			Ctx:        ctx,
+		}
+		sort.Stable(sorter)
+		if sorter.LastError != nil {
+			return sorter.LastError
+		}
+	}
+
+	start := 0
+	for start < len(rows) {
+		end := start + 1
+		for ; end < len(rows); end++ {
+			isNew, err := isNewPartition(ctx, a.window.PartitionBy, rows[end-1], rows[end])
+			if err != nil {
+				return err
+			}
+			if isNew {
+				break
+			}
+		}
+
+		if err := a.evalPartition(ctx, rows[start:end], resultIdx); err != nil {
+			return err
+		}
+		start = end
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i][originalOrderIdx].(int) < rows[j][originalOrderIdx].(int)
+	})
+	return nil
+}
+
+// evalPartition evaluates the aggregation over the frame of every row of the sorted partition given, and stores the
+// result in the row at the index given.
+func (a *Aggregate) evalPartition(ctx *sql.Context, partition []sql.Row, resultIdx int) error {
+	frames, err := newFrameBounds(ctx, a.window, partition)
+	if err != nil {
+		return err
+	}
+
+	// Frames that always start at the beginning of the partition only grow, so a single buffer is updated with the
+	// rows added to the frame of each row.
+	var running sql.AggregationBuffer
+	var added int
+	defer func() {
+		if running != nil {
+			running.Dispose()
+		}
+	}()
+
+	for i, row := range partition {
+		start, end := frames.bounds(i)
+
+		if start == 0 && end >= added {
+			if running == nil {
+				running, err = a.agg.NewBuffer()
+				if err != nil {
+					return err
+				}
+			}
+			for ; added < end; added++ {
+				if err := running.Update(ctx, partition[added][:resultIdx]); err != nil {
+					return err
+				}
+			}
+			row[resultIdx], err = running.Eval(ctx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		buf, err := a.agg.NewBuffer()
+		if err != nil {
+			return err
+		}
+		for _, r := range partition[start:max(start, end)] {
+			if err := buf.Update(ctx, r[:resultIdx]); err != nil {
+				buf.Dispose()
+				return err
+			}
+		}
+		row[resultIdx], err = buf.Eval(ctx)
+		buf.Dispose()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EvalRow implements sql.WindowAggregation
+func (a *Aggregate) EvalRow(i int, buffer sql.Row) (interface{}, error) {
+	rows := buffer[0].([]sql.Row)
+	return rows[i][len(rows[i])-2], nil
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// frameBounds are the bounds of the frames of the rows of a sorted partition.
+type frameBounds struct {
+	starts []int
+	ends   []int
+}
+
+// bounds returns the start and the end, exclusive, of the frame of the row of the partition at the index given. The
+// frame is empty when the end is not after the start.
+func (f *frameBounds) bounds(i int) (int, int) {
+	return f.starts[i], f.ends[i]
+}
+
+// newFrameBounds returns the bounds of the frames of the rows of the partition given, sorted by the ORDER BY of the
+// window given.
+func newFrameBounds(ctx *sql.Context, window *sql.Window, partition []sql.Row) (*frameBounds, error) {
+	frame := window.Frame
+	if frame == nil {
+		frame = &sql.WindowFrame{
+			Unit:  sql.RangeFrame,
+			Start: sql.WindowFrameBound{Type: sql.UnboundedPreceding},
+			End:   sql.WindowFrameBound{Type: sql.CurrentRow},
+		}
+		if len(window.OrderBy) == 0 {
+			frame.End = sql.WindowFrameBound{Type: sql.UnboundedFollowing}
+		}
+	}
+
+	f := &frameBounds{
+		starts: make([]int, len(partition)),
+		ends:   make([]int, len(partition)),
+	}
+
+	if frame.Unit == sql.RowsFrame {
+		for i := range partition {
+			f.starts[i] = rowsBound(frame.Start, i, len(partition), false)
+			f.ends[i] = rowsBound(frame.End, i, len(partition), true)
+		}
+		return f, nil
+	}
+
+	r, err := newRangeFrame(ctx, window, partition)
+	if err != nil {
+		return nil, err
+	}
+	for i := range partition {
+		if f.starts[i], err = r.bound(frame.Start, i, false); err != nil {
+			return nil, err
+		}
+		if f.ends[i], err = r.bound(frame.End, i, true); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// rowsBound returns the index in a partition of n rows of the ROWS bound given of the row at index i. End bounds are
+// exclusive.
+func rowsBound(b sql.WindowFrameBound, i, n int, end bool) int {
+	var idx int
+	switch b.Type {
+	case sql.UnboundedPreceding:
+		return 0
+	case sql.UnboundedFollowing:
+		return n
+	case sql.Preceding:
+		idx = i - int(b.Offset)
+	case sql.Following:
+		idx = i + int(b.Offset)
+	default:
+		idx = i
+	}
+	if end {
+		idx++
+	}
+	if idx < 0 {
+		return 0
+	}
+	if idx > n {
+		return n
+	}
+	return idx
+}
+
+// rangeFrame computes the RANGE bounds of the rows of a sorted partition.
+type rangeFrame struct {
+	// peerStarts and peerEnds are the bounds of the peers of every row, the rows with the same ORDER BY values.
+	peerStarts []int
+	peerEnds   []int
+	// keys are the values of the single ORDER BY expression of the window, for bounds with an offset, or nil for
+	// NULL values.
+	keys       []*float64
+	descending bool
+	// nonNullStart and nonNullEnd are the bounds of the rows with a non NULL key, which are all together in the
+	// partition.
+	nonNullStart int
+	nonNullEnd   int
+}
+
+func newRangeFrame(ctx *sql.Context, window *sql.Window, partition []sql.Row) (*rangeFrame, error) {
+	n := len(partition)
+	r := &rangeFrame{
+		peerStarts: make([]int, n),
+		peerEnds:   make([]int, n),
+	}
+
+	orderBy := window.OrderBy.ToExpressions()
+	start := 0
+	for i := 1; i <= n; i++ {
+		if i < n {
+			isNew, err := isNewOrderValue(ctx, orderBy, partition[i-1], partition[i])
+			if err != nil {
+				return nil, err
+			}
+			if !isNew {
+				continue
+			}
+		}
+		for j := start; j < i; j++ {
+			r.peerStarts[j] = start
+			r.peerEnds[j] = i
+		}
+		start = i
+	}
+
+	frame := window.Frame
+	if frame == nil || (!hasOffset(frame.Start) && !hasOffset(frame.End)) {
+		return r, nil
+	}
+
+	if len(window.OrderBy) != 1 || !sql.IsNumber(window.OrderBy[0].Column.Type()) {
+		return nil, sql.ErrWindowFrameRangeOrderBy.New()
+	}
+
+	r.descending = window.OrderBy[0].Order == sql.Descending
+	r.keys = make([]*float64, n)
+	r.nonNullStart, r.nonNullEnd = n, 0
+	for i, row := range partition {
+		v, err := window.OrderBy[0].Column.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			continue
+		}
+		v, err = sql.Float64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		key := v.(float64)
+		r.keys[i] = &key
+		if i < r.nonNullStart {
+			r.nonNullStart = i
+		}
+		r.nonNullEnd = i + 1
+	}
+	if r.nonNullStart > r.nonNullEnd {
+		r.nonNullStart = r.nonNullEnd
+	}
+	return r, nil
+}
+
+func hasOffset(b sql.WindowFrameBound) bool {
+	return b.Type == sql.Preceding || b.Type == sql.Following
+}
+
+// bound returns the index of the RANGE bound given of the row at index i. End bounds are exclusive.
+func (r *rangeFrame) bound(b sql.WindowFrameBound, i int, end bool) (int, error) {
+	switch b.Type {
+	case sql.UnboundedPreceding:
+		return 0, nil
+	case sql.UnboundedFollowing:
+		return len(r.peerStarts), nil
+	case sql.CurrentRow:
+		if end {
+			return r.peerEnds[i], nil
+		}
+		return r.peerStarts[i], nil
+	}
+
+	// The offset bounds of rows with a NULL key are their NULL peers.
+	if r.keys[i] == nil {
+		if end {
+			return r.peerEnds[i], nil
+		}
+		return r.peerStarts[i], nil
+	}
+
+	// The value of the bound, in the direction of the ORDER BY. Rows are after the bound when their value is greater,
+	// or smaller when descending.
+	offset := float64(b.Offset)
+	if b.Type == sql.Preceding {
+		offset = -offset
+	}
+	if r.descending {
+		offset = -offset
+	}
+	value := *r.keys[i] + offset
+
+	after := func(key float64) bool {
+		if r.descending {
+			return key < value
+		}
+		return key > value
+	}
+
+	for j := r.nonNullStart; j < r.nonNullEnd; j++ {
+		key := *r.keys[j]
+		// Starts are the first row at or after the bound, ends the first row after it.
+		if after(key) || (!end && key == value) {
+			return j, nil
+		}
+	}
+	return r.nonNullEnd, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrBenchmarkInvalidCount is returned when BENCHMARK is given a negative count.
+var ErrBenchmarkInvalidCount = errors.NewKind("Incorrect count value: '%d' for function benchmark")
+
+// Benchmark implements the BENCHMARK function, which evaluates an expression the given number of times and returns 0.
+// It's used to time how long the engine takes to evaluate the expression, and is bounded by the function limits of
+// the context.
+// https://dev.mysql.com/doc/refman/8.0/en/information-functions.html#function_benchmark
+type Benchmark struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*Benchmark)(nil)
+var _ sql.NonDeterministicExpression = (*Benchmark)(nil)
+
+// NewBenchmark returns a new BENCHMARK function expression
+func NewBenchmark(count, expr sql.Expression) sql.Expression {
+	return &Benchmark{expression.BinaryExpression{Left: count, Right: expr}}
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (f *Benchmark) IsNonDeterministic() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (f *Benchmark) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	count, ok, err := evalIntArg(ctx, f.Left, 0, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if count < 0 {
+		return nil, ErrBenchmarkInvalidCount.New(count)
+	}
+
+	start := time.Now()
+	for i := int64(0); i < count; i++ {
+		if _, err := f.Right.Eval(ctx, row); err != nil {
+			return nil, err
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if ok, err := ctx.FunctionLimits.CheckBenchmarkDuration(ctx, time.Since(start)); !ok {
+			return nil, err
+		}
+	}
+
+	return int32(0), nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *Benchmark) FunctionName() string {
+	return "benchmark"
+}
+
+// String implements sql.Expression
+func (f *Benchmark) String() string {
+	return fmt.Sprintf("BENCHMARK(%s, %s)", f.Left, f.Right)
+}
+
+// Type implements sql.Expression
+func (f *Benchmark) Type() sql.Type {
+	return sql.Int32
+}
+
+// WithChildren implements sql.Expression
+func (f *Benchmark) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
+	}
+	return NewBenchmark(children[0], children[1]), nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestBenchmark(t *testing.T) {
+	testCases := []struct {
+		name     string
+		count    interface{}
+		expected interface{}
+		err      bool
+	}{
+		{"zero", int64(0), int32(0), false},
+		{"some", int64(10), int32(0), false},
+		{"null count", nil, nil, false},
+		{"negative count", int64(-1), nil, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			f := NewBenchmark(
+				expression.NewLiteral(tt.count, sql.Int64),
+				NewUpper(expression.NewLiteral("a", sql.LongText)),
+			)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			if tt.err {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+}
+
+func TestBenchmarkLimits(t *testing.T) {
+	require := require.New(t)
+
+	f := NewBenchmark(
+		expression.NewLiteral(int64(1<<40), sql.Int64),
+		NewUpper(expression.NewLiteral("a", sql.LongText)),
+	)
+
+	ctx := sql.NewContext(context.Background(), sql.WithFunctionLimits(&sql.FunctionLimits{
+		MaxBenchmarkDuration: 10 * time.Millisecond,
+	}))
+	_, err := f.Eval(ctx, nil)
+	require.True(sql.ErrFunctionLimitExceeded.Is(err))
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = f.Eval(sql.NewContext(cancelled), nil)
+	require.Error(err)
+}
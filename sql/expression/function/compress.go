@@ -0,0 +1,184 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// compressedLengthSize is the size of the length of the uncompressed string that prefixes the strings returned by
+// COMPRESS.
+const compressedLengthSize = 4
+
+// zlibDataErrorCode is the code of the warning of the functions given corrupted compressed strings.
+const zlibDataErrorCode = 1259
+
+// Compress implements the COMPRESS function, which compresses a string with zlib. The compressed string is prefixed
+// with the length of the uncompressed string, as a 4 bytes little endian integer, like MySQL does.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_compress
+type Compress struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Compress)(nil)
+
+// NewCompress returns a new COMPRESS function expression
+func NewCompress(arg sql.Expression) sql.Expression {
+	return &Compress{NewUnaryFunc(arg, "COMPRESS", sql.LongBlob)}
+}
+
+// Eval implements sql.Expression
+func (f *Compress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	data, ok, err := evalBytesArg(ctx, f.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return []byte{}, nil
+	}
+
+	var buf bytes.Buffer
+	var length [compressedLengthSize]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(data)))
+	buf.Write(length[:])
+
+	w := zlib.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	// A string ending with a space would lose it if stored in a CHAR column.
+	compressed := buf.Bytes()
+	if compressed[len(compressed)-1] == ' ' {
+		compressed = append(compressed, '.')
+	}
+	return compressed, nil
+}
+
+// WithChildren implements sql.Expression
+func (f *Compress) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewCompress(children[0]), nil
+}
+
+// Uncompress implements the UNCOMPRESS function, which uncompresses a string compressed by COMPRESS. The result is
+// NULL, with a warning, if the string isn't a valid compressed string.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_uncompress
+type Uncompress struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Uncompress)(nil)
+
+// NewUncompress returns a new UNCOMPRESS function expression
+func NewUncompress(arg sql.Expression) sql.Expression {
+	return &Uncompress{NewUnaryFunc(arg, "UNCOMPRESS", sql.LongBlob)}
+}
+
+// Eval implements sql.Expression
+func (f *Uncompress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	data, ok, err := evalBytesArg(ctx, f.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return []byte{}, nil
+	}
+
+	uncompressed, ok := uncompress(data)
+	if !ok {
+		ctx.Warn(zlibDataErrorCode, "ZLIB: Input data corrupted")
+		return nil, nil
+	}
+	return uncompressed, nil
+}
+
+// uncompress returns the string compressed given uncompressed, or false if it isn't a valid compressed string.
+func uncompress(data []byte) ([]byte, bool) {
+	if len(data) <= compressedLengthSize {
+		return nil, false
+	}
+	length := binary.LittleEndian.Uint32(data[:compressedLengthSize])
+
+	r, err := zlib.NewReader(bytes.NewReader(data[compressedLengthSize:]))
+	if err != nil {
+		return nil, false
+	}
+	defer r.Close()
+
+	// Reading past the length given would only find corrupted data.
+	uncompressed, err := ioutil.ReadAll(io.LimitReader(r, int64(length)+1))
+	if err != nil || uint32(len(uncompressed)) != length {
+		return nil, false
+	}
+	return uncompressed, true
+}
+
+// WithChildren implements sql.Expression
+func (f *Uncompress) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewUncompress(children[0]), nil
+}
+
+// UncompressedLength implements the UNCOMPRESSED_LENGTH function, which returns the length of the string compressed
+// by COMPRESS given.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_uncompressed-length
+type UncompressedLength struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*UncompressedLength)(nil)
+
+// NewUncompressedLength returns a new UNCOMPRESSED_LENGTH function expression
+func NewUncompressedLength(arg sql.Expression) sql.Expression {
+	return &UncompressedLength{NewUnaryFunc(arg, "UNCOMPRESSED_LENGTH", sql.Uint32)}
+}
+
+// Eval implements sql.Expression
+func (f *UncompressedLength) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	data, ok, err := evalBytesArg(ctx, f.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return uint32(0), nil
+	}
+	if len(data) <= compressedLengthSize {
+		ctx.Warn(zlibDataErrorCode, "ZLIB: Input data corrupted")
+		return uint32(0), nil
+	}
+	return binary.LittleEndian.Uint32(data[:compressedLengthSize]), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *UncompressedLength) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewUncompressedLength(children[0]), nil
+}
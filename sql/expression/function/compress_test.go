@@ -0,0 +1,82 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestCompressUncompress(t *testing.T) {
+	for _, str := range []string{"a", "some text", strings.Repeat("a", 1000), "日本語"} {
+		t.Run(fmt.Sprint(len(str)), func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			compressed, err := NewCompress(expression.NewLiteral(str, sql.LongText)).Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal([]byte{byte(len(str)), byte(len(str) >> 8), 0, 0}, compressed.([]byte)[:4])
+
+			length, err := NewUncompressedLength(expression.NewLiteral(compressed, sql.LongBlob)).Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(uint32(len(str)), length)
+
+			uncompressed, err := NewUncompress(expression.NewLiteral(compressed, sql.LongBlob)).Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal([]byte(str), uncompressed)
+		})
+	}
+}
+
+func TestUncompressEdgeCases(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	tests := []struct {
+		arg          interface{}
+		compressed   interface{}
+		uncompressed interface{}
+		length       interface{}
+	}{
+		{nil, nil, nil, nil},
+		{"", []byte{}, []byte{}, uint32(0)},
+	}
+	for _, tt := range tests {
+		v, err := NewCompress(expression.NewLiteral(tt.arg, sql.LongText)).Eval(ctx, nil)
+		require.NoError(err)
+		require.Equal(tt.compressed, v)
+
+		v, err = NewUncompress(expression.NewLiteral(tt.arg, sql.LongBlob)).Eval(ctx, nil)
+		require.NoError(err)
+		require.Equal(tt.uncompressed, v)
+
+		v, err = NewUncompressedLength(expression.NewLiteral(tt.arg, sql.LongBlob)).Eval(ctx, nil)
+		require.NoError(err)
+		require.Equal(tt.length, v)
+	}
+	require.Len(ctx.Warnings(), 0)
+
+	// Strings that weren't compressed are NULL with a warning.
+	v, err := NewUncompress(expression.NewLiteral("not compressed", sql.LongBlob)).Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(v)
+	require.Len(ctx.Warnings(), 1)
+}
@@ -15,16 +15,12 @@
 package function
 
 import (
-	"errors"
 	"fmt"
-	"regexp"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
-var offsetRegex = regexp.MustCompile(`(?m)^(\+|\-)(\d{2}):(\d{2})$`) // (?m)^\+|\-(\d{2}):(\d{2})$
-
 type ConvertTz struct {
 	dt     sql.Expression
 	fromTz sql.Expression
@@ -95,72 +91,23 @@ func (c *ConvertTz) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	converted, success := convertTimeZone(datetime, fromStr, toStr)
-	if success {
-		return sql.Datetime.ConvertWithoutRangeCheck(converted)
-	}
-
-	// If we weren't successful converting by timezone try converting via offsets.
-	converted, success = convertOffsets(datetime, fromStr, toStr)
-	if !success {
-		return nil, nil
-	}
-
-	return sql.Datetime.ConvertWithoutRangeCheck(converted)
-}
-
-// convertTimeZone returns the conversion of t from timezone fromLocation to toLocation.
-func convertTimeZone(datetime time.Time, fromLocation string, toLocation string) (time.Time, bool) {
-	fLoc, err := time.LoadLocation(fromLocation)
-	if err != nil {
-		return time.Time{}, false
-	}
-
-	tLoc, err := time.LoadLocation(toLocation)
+	fromLoc, err := sql.ParseTimeZone(fromStr)
 	if err != nil {
-		return time.Time{}, false
-	}
-
-	delta := getCopy(datetime, fLoc).Sub(getCopy(datetime, tLoc))
-
-	return datetime.Add(delta), true
-}
-
-// getCopy recreates the time t in the wanted timezone.
-func getCopy(t time.Time, loc *time.Location) time.Time {
-	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc).UTC()
-}
-
-// convertOffsets returns the conversion of t to t + (endDuration - startDuration) and a boolean indicating success.
-func convertOffsets(t time.Time, startDuration string, endDuration string) (time.Time, bool) {
-	fromDuration, err := getDeltaAsDuration(startDuration)
-	if err != nil {
-		return time.Time{}, false
+		return nil, nil
 	}
 
-	toDuration, err := getDeltaAsDuration(endDuration)
+	toLoc, err := sql.ParseTimeZone(toStr)
 	if err != nil {
-		return time.Time{}, false
+		return nil, nil
 	}
 
-	return t.Add(toDuration - fromDuration), true
+	return sql.Datetime.ConvertWithoutRangeCheck(convertTimeZone(datetime, fromLoc, toLoc))
 }
 
-// getDeltaAsDuration takes in a MySQL offset in the format (ex +01:00) and returns it as a time Duration.
-func getDeltaAsDuration(d string) (time.Duration, error) {
-	var hours string
-	var mins string
-	var symbol string
-	matches := offsetRegex.FindStringSubmatch(d)
-	if len(matches) == 4 {
-		symbol = matches[1]
-		hours = matches[2]
-		mins = matches[3]
-	} else {
-		return -1, errors.New("error: unable to process time")
-	}
-
-	return time.ParseDuration(symbol + hours + "h" + mins + "m")
+// convertTimeZone returns the wall clock time in the location toLoc of the wall clock time datetime in the location
+// fromLoc. The result is in UTC, like all the datetime values.
+func convertTimeZone(datetime time.Time, fromLoc, toLoc *time.Location) time.Time {
+	return sql.TimestampToTimeZone(sql.TimestampFromTimeZone(datetime, fromLoc), toLoc)
 }
 
 // Children implements the sql.Expression interface.
@@ -117,6 +117,34 @@ func TestConvertTz(t *testing.T) {
 			toTimeZone:     "+10:00",
 			expectedResult: time.Date(2010, 6, 3, 23, 12, 12, 0, time.UTC),
 		},
+		{
+			name:           "Daylight saving time of the location",
+			datetime:       "2004-07-01 12:00:00",
+			fromTimeZone:   "UTC",
+			toTimeZone:     "US/Eastern",
+			expectedResult: time.Date(2004, 7, 1, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "Offset to location",
+			datetime:       "2004-01-01 12:00:00",
+			fromTimeZone:   "+05:30",
+			toTimeZone:     "Europe/Paris",
+			expectedResult: time.Date(2004, 1, 1, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name:           "Offset with a single digit hour",
+			datetime:       "2004-01-01 12:00:00",
+			fromTimeZone:   "-5:00",
+			toTimeZone:     "+00:00",
+			expectedResult: time.Date(2004, 1, 1, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "Offset out of range returns nil",
+			datetime:       "2004-01-01 12:00:00",
+			fromTimeZone:   "+00:00",
+			toTimeZone:     "+14:01",
+			expectedResult: nil,
+		},
 		{
 			name:           "No symbol on toTimeZone errors",
 			datetime:       time.Date(2010, 6, 3, 12, 12, 12, 0, time.UTC),
@@ -365,7 +365,8 @@ func (ut *UnixTimestamp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error
 		return nil, err
 	}
 
-	return toUnixTimestamp(date.(time.Time))
+	// Dates are wall clock times in the time zone of the session.
+	return toUnixTimestamp(sql.TimestampFromTimeZone(date.(time.Time), sql.SessionTimeZone(ctx)))
 }
 
 func toUnixTimestamp(t time.Time) (interface{}, error) {
@@ -406,7 +407,7 @@ func (r *FromUnixtime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, err
 	}
 
-	return time.Unix(n.(int64), 0), nil
+	return sql.TimestampToTimeZone(time.Unix(n.(int64), 0), sql.SessionTimeZone(ctx)), nil
 }
 
 func (r *FromUnixtime) WithChildren(children ...sql.Expression) (sql.Expression, error) {
@@ -435,7 +436,7 @@ func NewCurrentDate() sql.Expression {
 }
 
 func currDateLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	t := ctx.QueryTime()
+	t := sessionNow(ctx)
 	return fmt.Sprintf("%d-%02d-%02d", t.Year(), t.Month(), t.Day()), nil
 }
 
@@ -17,6 +17,7 @@ package function
 import (
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/lestrrat-go/strftime"
@@ -93,10 +94,6 @@ func twentyFourHourNoPadding(t time.Time) string {
 	return fmt.Sprintf("%d", t.Hour())
 }
 
-func fullMonthName(t time.Time) string {
-	return t.Month().String()
-}
-
 func ampmClockStr(t time.Time) string {
 	hour, ampm := twelveHour(t)
 	return fmt.Sprintf("%02d:%02d:%02d %s", hour, t.Minute(), t.Second(), ampm)
@@ -159,12 +156,8 @@ func yearMode1(t time.Time) string {
 	return strconv.FormatInt(int64(yr), 10)
 }
 
-func dayName(t time.Time) string {
-	return t.Weekday().String()
-}
-
 func yearTwoDigit(t time.Time) string {
-	return strconv.FormatInt(int64(t.Year())%100, 10)
+	return fmt.Sprintf("%02d", t.Year()%100)
 }
 
 type AppendFuncWrapper struct {
@@ -180,7 +173,6 @@ func (af AppendFuncWrapper) Append(bytes []byte, t time.Time) []byte {
 	return append(bytes, []byte(s)...)
 }
 
-var mysqlDateFormatSpec = strftime.NewSpecificationSet()
 var specifierToFunc = map[byte]func(time.Time) string{
 	'a': nil,
 	'b': nil,
@@ -196,7 +188,7 @@ var specifierToFunc = map[byte]func(time.Time) string{
 	'j': nil,
 	'k': twentyFourHourNoPadding,
 	'l': twelveHourNoPadding,
-	'M': fullMonthName,
+	'M': nil,
 	'm': nil,
 	'p': nil,
 	'r': ampmClockStr,
@@ -207,7 +199,7 @@ var specifierToFunc = map[byte]func(time.Time) string{
 	'u': weekMode1,
 	'V': weekMode2,
 	'v': weekMode3,
-	'W': dayName,
+	'W': nil,
 	'w': nil,
 	'X': yearMode0,
 	'x': yearMode1,
@@ -215,17 +207,37 @@ var specifierToFunc = map[byte]func(time.Time) string{
 	'y': yearTwoDigit,
 }
 
-func init() {
+// dateFormatSpecs are the specification sets of DATE_FORMAT by locale name, which are made the first time a locale is
+// used.
+var dateFormatSpecs sync.Map
+
+// dateFormatSpec returns the specification set of DATE_FORMAT with the names of days and months of the locale given.
+func dateFormatSpec(locale *sql.Locale) strftime.SpecificationSet {
+	if spec, ok := dateFormatSpecs.Load(locale.Name); ok {
+		return spec.(strftime.SpecificationSet)
+	}
+
+	spec := strftime.NewSpecificationSet()
 	for specifier, fn := range specifierToFunc {
 		if fn != nil {
-			panicIfErr(mysqlDateFormatSpec.Set(specifier, wrap(fn)))
+			panicIfErr(spec.Set(specifier, wrap(fn)))
 		}
 	}
 
+	localized := map[byte]func(time.Time) string{
+		'a': locale.AbbrevDayName,
+		'b': locale.AbbrevMonthName,
+		'M': locale.MonthName,
+		'W': locale.DayName,
+	}
+	for specifier, fn := range localized {
+		panicIfErr(spec.Set(specifier, wrap(fn)))
+	}
+
 	// replace any strftime specifiers that aren't supported
 	fn := func(b byte) {
 		if _, ok := specifierToFunc[b]; !ok {
-			panicIfErr(mysqlDateFormatSpec.Set(b, wrap(func(time.Time) string {
+			panicIfErr(spec.Set(b, wrap(func(time.Time) string {
 				return string(b)
 			})))
 		}
@@ -236,10 +248,18 @@ func init() {
 		fn(i)
 		fn(i + capToLower)
 	}
+
+	actual, _ := dateFormatSpecs.LoadOrStore(locale.Name, spec)
+	return actual.(strftime.SpecificationSet)
 }
 
 func formatDate(format string, t time.Time) (string, error) {
-	formatter, err := strftime.New(format, strftime.WithSpecificationSet(mysqlDateFormatSpec))
+	return formatDateInLocale(format, t, sql.DefaultLocale)
+}
+
+// formatDateInLocale formats the date given like DATE_FORMAT, with the names of days and months of the locale given.
+func formatDateInLocale(format string, t time.Time, locale *sql.Locale) (string, error) {
+	formatter, err := strftime.New(format, strftime.WithSpecificationSet(dateFormatSpec(locale)))
 
 	if err != nil {
 		return "", err
@@ -286,9 +306,12 @@ func (f *DateFormat) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	timeVal, err := sql.Datetime.Convert(left)
-
 	if err != nil {
-		return nil, err
+		// Like MySQL, values that aren't valid dates are formatted to NULL, with a warning.
+		if ctx != nil {
+			ctx.Warn(1292, "Incorrect datetime value: '%v'", left)
+		}
+		return nil, nil
 	}
 
 	t := timeVal.(time.Time)
@@ -302,13 +325,12 @@ func (f *DateFormat) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	formatStr, ok := right.(string)
-
-	if !ok {
+	formatStr, err := sql.LongText.Convert(right)
+	if err != nil {
 		return nil, ErrInvalidArgument.New("DATE_FORMAT", "format must be a string")
 	}
 
-	return formatDate(formatStr, t)
+	return formatDateInLocale(formatStr.(string), t, sql.TimeLocale(ctx))
 }
 
 // Type implements the Expression interface.
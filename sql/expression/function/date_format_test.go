@@ -66,6 +66,27 @@ func TestDateFormatting(t *testing.T) {
 		{"%x", "2020", false},        // Year for the week where Monday is the first day of the week. Used with %V
 	}
 
+	pm := time.Date(2005, 11, 12, 15, 4, 5, 0, time.UTC)
+	pmTests := []struct {
+		formatStr string
+		expected  string
+	}{
+		{"%y", "05"},
+		{"%h:%i %p", "03:04 PM"},
+		{"%l %k", "3 15"},
+		{"%D %M", "12th November"},
+		{"100%% on %a", "100% on Sat"},
+		{"%Y%m%d%H%i%s", "20051112150405"},
+	}
+
+	for _, test := range pmTests {
+		t.Run(pm.String()+test.formatStr, func(t *testing.T) {
+			result, err := formatDate(test.formatStr, pm)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+
 	for _, test := range tests {
 		t.Run(dt.String()+test.formatStr, func(t *testing.T) {
 			result, err := formatDate(test.formatStr, dt)
@@ -188,4 +209,11 @@ func TestDateFormatEval(t *testing.T) {
 	res, err = dateFormat.Eval(nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, nil, nil)
+	ctx := sql.NewEmptyContext()
+	dateFormat = NewDateFormat(expression.NewLiteral("not a date", sql.Text), format)
+	res, err = dateFormat.Eval(ctx, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+	require.Len(t, ctx.Warnings(), 1)
+	assert.Equal(t, 1292, ctx.Warnings()[0].Code)
 }
@@ -0,0 +1,194 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Elt implements the ELT function, which returns the Nth of its string arguments.
+// https://dev.mysql.com/doc/refman/8.0/en/string-functions.html#function_elt
+type Elt struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Elt)(nil)
+
+// NewElt creates a new Elt expression.
+func NewElt(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ELT", "2 or more", len(args))
+	}
+	return &Elt{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (e *Elt) FunctionName() string {
+	return "elt"
+}
+
+// Type implements the sql.Expression interface.
+func (e *Elt) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the sql.Expression interface.
+func (e *Elt) IsNullable() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (e *Elt) Children() []sql.Expression { return e.args }
+
+// Resolved implements the sql.Expression interface.
+func (e *Elt) Resolved() bool { return expression.ExpressionsResolved(e.args...) }
+
+// WithChildren implements the sql.Expression interface.
+func (e *Elt) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewElt(children...)
+}
+
+func (e *Elt) String() string {
+	return fmt.Sprintf("elt(%s)", joinExpressions(e.args))
+}
+
+// Eval implements the sql.Expression interface. The result is NULL if N is NULL, or not the position of one of the
+// strings.
+func (e *Elt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	n, err := e.args[0].Eval(ctx, row)
+	if err != nil || n == nil {
+		return nil, err
+	}
+
+	n, err = sql.Int64.Convert(n)
+	if err != nil {
+		return nil, err
+	}
+
+	i := n.(int64)
+	if i < 1 || i >= int64(len(e.args)) {
+		return nil, nil
+	}
+
+	val, err := e.args[i].Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	return sql.LongText.Convert(val)
+}
+
+// Field implements the FIELD function, which returns the position of its first argument among the others.
+// https://dev.mysql.com/doc/refman/8.0/en/string-functions.html#function_field
+type Field struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Field)(nil)
+
+// NewField creates a new Field expression.
+func NewField(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("FIELD", "2 or more", len(args))
+	}
+	return &Field{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *Field) FunctionName() string {
+	return "field"
+}
+
+// Type implements the sql.Expression interface.
+func (f *Field) Type() sql.Type { return sql.Int64 }
+
+// IsNullable implements the sql.Expression interface.
+func (f *Field) IsNullable() bool { return false }
+
+// Children implements the sql.Expression interface.
+func (f *Field) Children() []sql.Expression { return f.args }
+
+// Resolved implements the sql.Expression interface.
+func (f *Field) Resolved() bool { return expression.ExpressionsResolved(f.args...) }
+
+// WithChildren implements the sql.Expression interface.
+func (f *Field) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewField(children...)
+}
+
+func (f *Field) String() string {
+	return fmt.Sprintf("field(%s)", joinExpressions(f.args))
+}
+
+// Eval implements the sql.Expression interface. The arguments are compared as strings if they are all strings or NULL, and
+// as numbers otherwise. The result is 0 if the first argument is NULL or isn't equal to any other.
+func (f *Field) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	var cmpType sql.Type = sql.LongText
+	for _, arg := range f.args {
+		if arg.Type() == sql.Null {
+			continue
+		}
+		if !sql.IsText(arg.Type()) {
+			cmpType = sql.Float64
+			break
+		}
+	}
+
+	val, err := f.args[0].Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return int64(0), nil
+	}
+
+	// Strings that aren't numbers aren't equal to any number.
+	val, err = cmpType.Convert(val)
+	if err != nil {
+		return int64(0), nil
+	}
+
+	for i, arg := range f.args[1:] {
+		v, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			continue
+		}
+
+		v, err = cmpType.Convert(v)
+		if err != nil {
+			continue
+		}
+
+		cmp, err := cmpType.Compare(val, v)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == 0 {
+			return int64(i + 1), nil
+		}
+	}
+
+	return int64(0), nil
+}
+
+func joinExpressions(exprs []sql.Expression) string {
+	args := make([]string, len(exprs))
+	for i, e := range exprs {
+		args[i] = e.String()
+	}
+	return strings.Join(args, ", ")
+}
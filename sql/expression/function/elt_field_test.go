@@ -0,0 +1,87 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestElt(t *testing.T) {
+	testCases := []struct {
+		args     []interface{}
+		expected interface{}
+	}{
+		{[]interface{}{int64(1), "a", "b", "c"}, "a"},
+		{[]interface{}{int64(3), "a", "b", "c"}, "c"},
+		{[]interface{}{"2", "a", "b", "c"}, "b"},
+		{[]interface{}{int64(0), "a", "b", "c"}, nil},
+		{[]interface{}{int64(4), "a", "b", "c"}, nil},
+		{[]interface{}{nil, "a", "b", "c"}, nil},
+		{[]interface{}{int64(2), "a", nil, "c"}, nil},
+		{[]interface{}{int64(2), "a", int64(5)}, "5"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprint(tt.args), func(t *testing.T) {
+			require := require.New(t)
+			f, err := NewElt(literals(tt.args...)...)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	_, err := NewElt(literals(int64(1))...)
+	require.Error(t, err)
+}
+
+func TestField(t *testing.T) {
+	testCases := []struct {
+		args     []interface{}
+		expected interface{}
+	}{
+		{[]interface{}{"b", "a", "b", "c"}, int64(2)},
+		{[]interface{}{"d", "a", "b", "c"}, int64(0)},
+		{[]interface{}{"日本", "日本語", "日本"}, int64(2)},
+		{[]interface{}{nil, "a", nil}, int64(0)},
+		{[]interface{}{"b", nil, "b"}, int64(2)},
+		{[]interface{}{int64(2), int64(1), int64(2)}, int64(2)},
+		{[]interface{}{int64(2), "a", "2.0"}, int64(2)},
+		{[]interface{}{"a", int64(1), "a"}, int64(0)},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprint(tt.args), func(t *testing.T) {
+			require := require.New(t)
+			f, err := NewField(literals(tt.args...)...)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	_, err := NewField(expression.NewLiteral("a", sql.LongText))
+	require.Error(t, err)
+}
@@ -0,0 +1,387 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+var (
+	// ErrInvalidBlockEncryptionMode is returned when block_encryption_mode isn't a supported AES mode.
+	ErrInvalidBlockEncryptionMode = errors.NewKind("unsupported block_encryption_mode '%s'")
+
+	// ErrAESInitVectorTooShort is returned when the initialization vector given to AES_ENCRYPT or AES_DECRYPT is
+	// shorter than a block.
+	ErrAESInitVectorTooShort = errors.NewKind("The initialization vector supplied to %s is too short. Must be at least 16 bytes long")
+
+	// ErrRandomBytesOutOfRange is returned when RANDOM_BYTES is given a length that isn't between 1 and 1024.
+	ErrRandomBytesOutOfRange = errors.NewKind("length value is out of range in 'random_bytes'")
+)
+
+// maxRandomBytes is the maximum number of bytes returned by RANDOM_BYTES.
+const maxRandomBytes = 1024
+
+// aesMode is an AES mode of block_encryption_mode, such as aes-128-ecb.
+type aesMode struct {
+	keyLen int
+	mode   string
+}
+
+// blockEncryptionMode returns the AES mode of the block_encryption_mode of the session.
+func blockEncryptionMode(ctx *sql.Context) (aesMode, error) {
+	val, err := ctx.GetSessionVariable(ctx, "block_encryption_mode")
+	if err != nil {
+		return aesMode{}, err
+	}
+
+	name, _ := val.(string)
+	parts := strings.Split(strings.ToLower(name), "-")
+	if len(parts) != 3 || parts[0] != "aes" {
+		return aesMode{}, ErrInvalidBlockEncryptionMode.New(name)
+	}
+
+	var mode aesMode
+	switch parts[1] {
+	case "128":
+		mode.keyLen = 16
+	case "192":
+		mode.keyLen = 24
+	case "256":
+		mode.keyLen = 32
+	default:
+		return aesMode{}, ErrInvalidBlockEncryptionMode.New(name)
+	}
+
+	switch parts[2] {
+	case "ecb", "cbc", "cfb128", "ofb":
+		mode.mode = parts[2]
+	default:
+		return aesMode{}, ErrInvalidBlockEncryptionMode.New(name)
+	}
+	return mode, nil
+}
+
+// block returns the cipher of the key given, which is folded into a key of the length of the mode by XORing the bytes
+// past its length into its start, like MySQL does.
+func (m aesMode) block(key []byte) (cipher.Block, error) {
+	folded := make([]byte, m.keyLen)
+	for i, b := range key {
+		folded[i%m.keyLen] ^= b
+	}
+	return aes.NewCipher(folded)
+}
+
+// padded returns whether the mode encrypts whole blocks, with PKCS#7 padding.
+func (m aesMode) padded() bool {
+	return m.mode == "ecb" || m.mode == "cbc"
+}
+
+// usesIV returns whether the mode requires an initialization vector.
+func (m aesMode) usesIV() bool {
+	return m.mode != "ecb"
+}
+
+func (m aesMode) encrypt(data, key, iv []byte) ([]byte, error) {
+	block, err := m.block(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.padded() {
+		padLen := aes.BlockSize - len(data)%aes.BlockSize
+		padded := make([]byte, len(data)+padLen)
+		copy(padded, data)
+		for i := len(data); i < len(padded); i++ {
+			padded[i] = byte(padLen)
+		}
+		data = padded
+	}
+
+	out := make([]byte, len(data))
+	switch m.mode {
+	case "ecb":
+		for i := 0; i < len(data); i += aes.BlockSize {
+			block.Encrypt(out[i:i+aes.BlockSize], data[i:i+aes.BlockSize])
+		}
+	case "cbc":
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(out, data)
+	case "cfb128":
+		cipher.NewCFBEncrypter(block, iv).XORKeyStream(out, data)
+	case "ofb":
+		cipher.NewOFB(block, iv).XORKeyStream(out, data)
+	}
+	return out, nil
+}
+
+// decrypt returns the data given decrypted, or false if it isn't valid encrypted data for the key given.
+func (m aesMode) decrypt(data, key, iv []byte) ([]byte, bool, error) {
+	block, err := m.block(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if m.padded() && (len(data) == 0 || len(data)%aes.BlockSize != 0) {
+		return nil, false, nil
+	}
+
+	out := make([]byte, len(data))
+	switch m.mode {
+	case "ecb":
+		for i := 0; i < len(data); i += aes.BlockSize {
+			block.Decrypt(out[i:i+aes.BlockSize], data[i:i+aes.BlockSize])
+		}
+	case "cbc":
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(out, data)
+	case "cfb128":
+		cipher.NewCFBDecrypter(block, iv).XORKeyStream(out, data)
+	case "ofb":
+		cipher.NewOFB(block, iv).XORKeyStream(out, data)
+	}
+
+	if !m.padded() {
+		return out, true, nil
+	}
+
+	padLen := int(out[len(out)-1])
+	if padLen == 0 || padLen > aes.BlockSize {
+		return nil, false, nil
+	}
+	for _, b := range out[len(out)-padLen:] {
+		if int(b) != padLen {
+			return nil, false, nil
+		}
+	}
+	return out[:len(out)-padLen], true, nil
+}
+
+// aesFunc holds the arguments of AES_ENCRYPT and AES_DECRYPT.
+type aesFunc struct {
+	name string
+	str  sql.Expression
+	key  sql.Expression
+	iv   sql.Expression
+}
+
+func newAESFunc(name string, args []sql.Expression) (aesFunc, error) {
+	switch len(args) {
+	case 2:
+		return aesFunc{name: name, str: args[0], key: args[1]}, nil
+	case 3:
+		return aesFunc{name: name, str: args[0], key: args[1], iv: args[2]}, nil
+	default:
+		return aesFunc{}, sql.ErrInvalidArgumentNumber.New(strings.ToUpper(name), "2 or 3", len(args))
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *aesFunc) FunctionName() string {
+	return f.name
+}
+
+// Type implements sql.Expression
+func (f *aesFunc) Type() sql.Type {
+	return sql.LongBlob
+}
+
+// IsNullable implements sql.Expression
+func (f *aesFunc) IsNullable() bool {
+	return true
+}
+
+// Children implements sql.Expression
+func (f *aesFunc) Children() []sql.Expression {
+	if f.iv == nil {
+		return []sql.Expression{f.str, f.key}
+	}
+	return []sql.Expression{f.str, f.key, f.iv}
+}
+
+// Resolved implements sql.Expression
+func (f *aesFunc) Resolved() bool {
+	return expression.ExpressionsResolved(f.Children()...)
+}
+
+// String implements sql.Expression
+func (f *aesFunc) String() string {
+	return fmt.Sprintf("%s(%s)", strings.ToUpper(f.name), joinExpressions(f.Children()))
+}
+
+// evalArgs returns the mode, the string, the key and the initialization vector to encrypt or decrypt with, or false
+// if any of them is NULL.
+func (f *aesFunc) evalArgs(ctx *sql.Context, row sql.Row) (aesMode, []byte, []byte, []byte, bool, error) {
+	mode, err := blockEncryptionMode(ctx)
+	if err != nil {
+		return aesMode{}, nil, nil, nil, false, err
+	}
+
+	str, ok, err := evalBytesArg(ctx, f.str, row)
+	if err != nil || !ok {
+		return aesMode{}, nil, nil, nil, false, err
+	}
+
+	key, ok, err := evalBytesArg(ctx, f.key, row)
+	if err != nil || !ok {
+		return aesMode{}, nil, nil, nil, false, err
+	}
+
+	if !mode.usesIV() {
+		if f.iv != nil {
+			ctx.Warn(1618, "<IV> option ignored")
+		}
+		return mode, str, key, nil, true, nil
+	}
+
+	if f.iv == nil {
+		return aesMode{}, nil, nil, nil, false, sql.ErrInvalidArgumentNumber.New(strings.ToUpper(f.name), 3, 2)
+	}
+
+	iv, ok, err := evalBytesArg(ctx, f.iv, row)
+	if err != nil || !ok {
+		return aesMode{}, nil, nil, nil, false, err
+	}
+	if len(iv) < aes.BlockSize {
+		return aesMode{}, nil, nil, nil, false, ErrAESInitVectorTooShort.New(f.name)
+	}
+	return mode, str, key, iv[:aes.BlockSize], true, nil
+}
+
+// AESEncrypt implements the AES_ENCRYPT function, which encrypts a string with a key in the mode of the
+// block_encryption_mode of the session.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_aes-encrypt
+type AESEncrypt struct {
+	aesFunc
+}
+
+var _ sql.FunctionExpression = (*AESEncrypt)(nil)
+
+// NewAESEncrypt returns a new AES_ENCRYPT function expression
+func NewAESEncrypt(args ...sql.Expression) (sql.Expression, error) {
+	f, err := newAESFunc("aes_encrypt", args)
+	if err != nil {
+		return nil, err
+	}
+	return &AESEncrypt{f}, nil
+}
+
+// Eval implements sql.Expression
+func (f *AESEncrypt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	mode, str, key, iv, ok, err := f.evalArgs(ctx, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return mode.encrypt(str, key, iv)
+}
+
+// WithChildren implements sql.Expression
+func (f *AESEncrypt) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(f.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), len(f.Children()))
+	}
+	return NewAESEncrypt(children...)
+}
+
+// AESDecrypt implements the AES_DECRYPT function, which decrypts a string encrypted by AES_ENCRYPT. The result is NULL
+// if the string isn't valid encrypted data for the key.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_aes-decrypt
+type AESDecrypt struct {
+	aesFunc
+}
+
+var _ sql.FunctionExpression = (*AESDecrypt)(nil)
+
+// NewAESDecrypt returns a new AES_DECRYPT function expression
+func NewAESDecrypt(args ...sql.Expression) (sql.Expression, error) {
+	f, err := newAESFunc("aes_decrypt", args)
+	if err != nil {
+		return nil, err
+	}
+	return &AESDecrypt{f}, nil
+}
+
+// Eval implements sql.Expression
+func (f *AESDecrypt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	mode, str, key, iv, ok, err := f.evalArgs(ctx, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	decrypted, ok, err := mode.decrypt(str, key, iv)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return decrypted, nil
+}
+
+// WithChildren implements sql.Expression
+func (f *AESDecrypt) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(f.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), len(f.Children()))
+	}
+	return NewAESDecrypt(children...)
+}
+
+// RandomBytes implements the RANDOM_BYTES function, which returns a string of random bytes generated by a
+// cryptographically secure random number generator.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_random-bytes
+type RandomBytes struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*RandomBytes)(nil)
+var _ sql.NonDeterministicExpression = (*RandomBytes)(nil)
+
+// NewRandomBytes returns a new RANDOM_BYTES function expression
+func NewRandomBytes(arg sql.Expression) sql.Expression {
+	return &RandomBytes{NewUnaryFunc(arg, "RANDOM_BYTES", sql.LongBlob)}
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (f *RandomBytes) IsNonDeterministic() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (f *RandomBytes) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	n, ok, err := evalIntArg(ctx, f.Child, 0, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if n < 1 || n > maxRandomBytes {
+		return nil, ErrRandomBytesOutOfRange.New()
+	}
+
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// WithChildren implements sql.Expression
+func (f *RandomBytes) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewRandomBytes(children[0]), nil
+}
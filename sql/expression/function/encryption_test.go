@@ -0,0 +1,145 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestAESEncryptDecrypt(t *testing.T) {
+	tests := []struct {
+		mode      string
+		str       string
+		key       string
+		iv        string
+		encrypted string
+	}{
+		{"aes-128-ecb", "text", "key", "", "15e36637363712fc2e699b9c95b75393"},
+		// Keys longer than the key length of the mode are folded into it.
+		{"aes-128-ecb", "text", "0123456789abcdefXY", "", aesEncryptHex(t, "aes-128-ecb", "text", "hh23456789abcdef")},
+		{"aes-256-cbc", "text", "key", "1234567890123456", "53c2a3389aca49dc6d54a64ff0d653e4"},
+		{"aes-192-cfb128", "some longer text", "key", "1234567890123456", ""},
+		{"aes-256-ofb", "some longer text", "key", "1234567890123456", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			require.NoError(ctx.SetSessionVariable(ctx, "block_encryption_mode", tt.mode))
+
+			args := literals(tt.str, tt.key)
+			if tt.iv != "" {
+				args = append(args, expression.NewLiteral(tt.iv, sql.LongText))
+			}
+
+			f, err := NewAESEncrypt(args...)
+			require.NoError(err)
+			encrypted, err := f.Eval(ctx, nil)
+			require.NoError(err)
+			if tt.encrypted != "" {
+				require.Equal(tt.encrypted, hex.EncodeToString(encrypted.([]byte)))
+			}
+
+			args[0] = expression.NewLiteral(encrypted, sql.LongBlob)
+			f, err = NewAESDecrypt(args...)
+			require.NoError(err)
+			decrypted, err := f.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal([]byte(tt.str), decrypted)
+
+			args[1] = expression.NewLiteral("wrong key", sql.LongText)
+			f, err = NewAESDecrypt(args...)
+			require.NoError(err)
+			decrypted, err = f.Eval(ctx, nil)
+			require.NoError(err)
+			require.NotEqual([]byte(tt.str), decrypted)
+		})
+	}
+}
+
+func TestAESEncryptErrors(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	f, err := NewAESEncrypt(literals(nil, "key")...)
+	require.NoError(err)
+	v, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(v)
+
+	f, err = NewAESEncrypt(literals("text", "key", "1234567890123456")...)
+	require.NoError(err)
+	_, err = f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Len(ctx.Warnings(), 1)
+
+	require.NoError(ctx.SetSessionVariable(ctx, "block_encryption_mode", "aes-128-cbc"))
+	f, err = NewAESEncrypt(literals("text", "key")...)
+	require.NoError(err)
+	_, err = f.Eval(ctx, nil)
+	require.Error(err)
+
+	f, err = NewAESEncrypt(literals("text", "key", "short")...)
+	require.NoError(err)
+	_, err = f.Eval(ctx, nil)
+	require.True(ErrAESInitVectorTooShort.Is(err))
+
+	require.NoError(ctx.SetSessionVariable(ctx, "block_encryption_mode", "des-128-ecb"))
+	f, err = NewAESEncrypt(literals("text", "key")...)
+	require.NoError(err)
+	_, err = f.Eval(ctx, nil)
+	require.True(ErrInvalidBlockEncryptionMode.Is(err))
+}
+
+// aesEncryptHex returns the hex of the string given encrypted by AES_ENCRYPT with the mode and key given.
+func aesEncryptHex(t *testing.T, mode, str, key string) string {
+	ctx := sql.NewEmptyContext()
+	require.NoError(t, ctx.SetSessionVariable(ctx, "block_encryption_mode", mode))
+
+	f, err := NewAESEncrypt(literals(str, key)...)
+	require.NoError(t, err)
+	encrypted, err := f.Eval(ctx, nil)
+	require.NoError(t, err)
+	return hex.EncodeToString(encrypted.([]byte))
+}
+
+func TestRandomBytes(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	f := NewRandomBytes(expression.NewLiteral(int64(16), sql.Int64))
+	first, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Len(first, 16)
+	second, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.NotEqual(first, second)
+
+	v, err := NewRandomBytes(expression.NewLiteral(nil, sql.Null)).Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(v)
+
+	for _, n := range []int64{0, 1025} {
+		_, err = NewRandomBytes(expression.NewLiteral(n, sql.Int64)).Eval(ctx, nil)
+		require.True(ErrRandomBytesOutOfRange.Is(err))
+	}
+}
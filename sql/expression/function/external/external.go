@@ -0,0 +1,303 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package external bridges functions implemented outside of the engine, such as by another process or a gRPC service,
+// into functions the engine calls like its own. The engine keeps control of the calls: arguments and results are
+// converted to the types of the function, calls time out, and the calls made while a call is running are sent
+// together as a batch.
+package external
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var (
+	// ErrTimeout is returned when a call of an external function doesn't return in time.
+	ErrTimeout = errors.NewKind("external function %s timed out after %s")
+
+	// ErrBadResult is returned when the handler of an external function doesn't return a result for each row.
+	ErrBadResult = errors.NewKind("external function %s returned %d results for %d rows")
+)
+
+// Handler evaluates external functions.
+type Handler interface {
+	// Call evaluates the function with the name given on each row of arguments of the batch given, returning a result
+	// for each row, in the same order. The context is canceled when the call times out.
+	Call(ctx context.Context, function string, batch [][]interface{}) ([]interface{}, error)
+}
+
+// HandlerFunc is a function that implements the Handler interface.
+type HandlerFunc func(ctx context.Context, function string, batch [][]interface{}) ([]interface{}, error)
+
+var _ Handler = HandlerFunc(nil)
+
+// Call implements the Handler interface.
+func (f HandlerFunc) Call(ctx context.Context, function string, batch [][]interface{}) ([]interface{}, error) {
+	return f(ctx, function, batch)
+}
+
+// DefaultMaxBatchSize is the maximum number of rows of the calls of functions without one.
+const DefaultMaxBatchSize = 100
+
+// Definition defines an external function.
+type Definition struct {
+	// Name is the name the function is called with, and the name sent to its handler.
+	Name string
+	// ArgTypes are the types of the arguments of the function, which arguments are converted to before they're sent.
+	ArgTypes []sql.Type
+	// ReturnType is the type of the function, which results are converted to.
+	ReturnType sql.Type
+	// Handler evaluates the function.
+	Handler Handler
+	// Timeout is the maximum time a call of the handler can take. If zero, calls don't time out.
+	Timeout time.Duration
+	// MaxBatchSize is the maximum number of rows sent in a call of the handler. If zero, it's DefaultMaxBatchSize.
+	MaxBatchSize int
+}
+
+// NewFunction returns the external function defined, to be registered with the catalog of an engine or with a
+// database. All the calls of the function are batched together, even those of different queries.
+func NewFunction(def Definition) sql.Function {
+	if def.MaxBatchSize <= 0 {
+		def.MaxBatchSize = DefaultMaxBatchSize
+	}
+	b := &batcher{def: def}
+	return sql.FunctionN{
+		Name: def.Name,
+		Fn: func(args ...sql.Expression) (sql.Expression, error) {
+			if len(args) != len(def.ArgTypes) {
+				return nil, sql.ErrInvalidArgumentNumber.New(def.Name, len(def.ArgTypes), len(args))
+			}
+			return &Call{batcher: b, args: args}, nil
+		},
+	}
+}
+
+// Call is a call of an external function.
+type Call struct {
+	batcher *batcher
+	args    []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Call)(nil)
+var _ sql.NonDeterministicExpression = (*Call)(nil)
+
+// FunctionName implements sql.FunctionExpression
+func (c *Call) FunctionName() string {
+	return c.batcher.def.Name
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression. The engine doesn't know what external functions do, so
+// their calls are never evaluated only once or cached.
+func (c *Call) IsNonDeterministic() bool {
+	return true
+}
+
+// Resolved implements the sql.Expression interface.
+func (c *Call) Resolved() bool {
+	for _, arg := range c.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNullable implements the sql.Expression interface.
+func (c *Call) IsNullable() bool {
+	return true
+}
+
+// Type implements the sql.Expression interface.
+func (c *Call) Type() sql.Type {
+	return c.batcher.def.ReturnType
+}
+
+// Children implements the sql.Expression interface.
+func (c *Call) Children() []sql.Expression {
+	return c.args
+}
+
+// WithChildren implements the sql.Expression interface.
+func (c *Call) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(c.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), len(c.args))
+	}
+	return &Call{batcher: c.batcher, args: children}, nil
+}
+
+func (c *Call) String() string {
+	args := make([]string, len(c.args))
+	for i, arg := range c.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", c.batcher.def.Name, strings.Join(args, ", "))
+}
+
+// Eval implements the sql.Expression interface.
+func (c *Call) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	args := make([]interface{}, len(c.args))
+	for i, arg := range c.args {
+		v, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		args[i], err = c.batcher.def.ArgTypes[i].Convert(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := c.batcher.call(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return c.batcher.def.ReturnType.Convert(result)
+}
+
+// batcher sends the calls of an external function to its handler. One call at a time sends batches of the pending
+// calls until its own result is known, and then hands over to the next pending call, so that the calls made while the
+// handler evaluates a batch are sent together in the next one.
+type batcher struct {
+	def     Definition
+	mu      sync.Mutex
+	pending []*pendingCall
+	running bool
+}
+
+// pendingCall is a call waiting for its result, or for its turn to send the pending calls.
+type pendingCall struct {
+	args   []interface{}
+	result interface{}
+	err    error
+	done   chan struct{}
+	lead   chan struct{}
+}
+
+// call returns the result of the function for the arguments given.
+func (b *batcher) call(ctx *sql.Context, args []interface{}) (interface{}, error) {
+	c := &pendingCall{args: args, done: make(chan struct{}), lead: make(chan struct{})}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, c)
+	if b.running {
+		b.mu.Unlock()
+		select {
+		case <-c.done:
+			return c.result, c.err
+		case <-c.lead:
+		case <-ctx.Done():
+			return b.cancel(ctx, c)
+		}
+		b.mu.Lock()
+	}
+	b.running = true
+
+	for !isClosed(c.done) {
+		n := len(b.pending)
+		if n > b.def.MaxBatchSize {
+			n = b.def.MaxBatchSize
+		}
+		batch := b.pending[:n]
+		b.pending = b.pending[n:]
+		b.mu.Unlock()
+
+		b.send(ctx, batch)
+
+		b.mu.Lock()
+	}
+	b.handOver()
+	b.mu.Unlock()
+	return c.result, c.err
+}
+
+// cancel removes the call given, whose context is done, from the pending calls. It must be called without holding
+// the lock of the batcher.
+func (b *batcher) cancel(ctx *sql.Context, c *pendingCall) (interface{}, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if isClosed(c.done) {
+		return c.result, c.err
+	}
+	for i, p := range b.pending {
+		if p == c {
+			b.pending = append(b.pending[:i], b.pending[i+1:]...)
+			break
+		}
+	}
+	if isClosed(c.lead) {
+		b.handOver()
+	}
+	return nil, ctx.Err()
+}
+
+// handOver makes the first pending call send the pending calls, if there are any. It must be called holding the lock
+// of the batcher.
+func (b *batcher) handOver() {
+	if len(b.pending) == 0 {
+		b.running = false
+		return
+	}
+	close(b.pending[0].lead)
+}
+
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+// send calls the handler with the calls given, and sets their results.
+func (b *batcher) send(ctx *sql.Context, batch []*pendingCall) {
+	rows := make([][]interface{}, len(batch))
+	for i, c := range batch {
+		rows[i] = c.args
+	}
+
+	callCtx := context.Context(ctx)
+	if b.def.Timeout > 0 {
+		var cancel context.CancelFunc
+		callCtx, cancel = context.WithTimeout(ctx, b.def.Timeout)
+		defer cancel()
+	}
+
+	results, err := b.def.Handler.Call(callCtx, b.def.Name, rows)
+	if err == nil && len(results) != len(rows) {
+		err = ErrBadResult.New(b.def.Name, len(results), len(rows))
+	}
+	if err != nil && callCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+		err = ErrTimeout.New(b.def.Name, b.def.Timeout)
+	}
+
+	for i, c := range batch {
+		if err != nil {
+			c.err = err
+		} else {
+			c.result = results[i]
+		}
+		close(c.done)
+	}
+}
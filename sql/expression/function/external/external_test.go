@@ -0,0 +1,255 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func newCall(t *testing.T, def Definition, args ...sql.Expression) sql.Expression {
+	f := NewFunction(def)
+	e, err := f.NewInstance(args)
+	require.NoError(t, err)
+	return e
+}
+
+func TestCall(t *testing.T) {
+	require := require.New(t)
+
+	var batches [][][]interface{}
+	handler := HandlerFunc(func(ctx context.Context, function string, batch [][]interface{}) ([]interface{}, error) {
+		require.Equal("concat_id", function)
+		batches = append(batches, batch)
+		results := make([]interface{}, len(batch))
+		for i, row := range batch {
+			results[i] = fmt.Sprintf("%v-%v", row[0], row[1])
+		}
+		return results, nil
+	})
+
+	def := Definition{
+		Name:       "concat_id",
+		ArgTypes:   []sql.Type{sql.LongText, sql.Int64},
+		ReturnType: sql.LongText,
+		Handler:    handler,
+	}
+	e := newCall(t, def, expression.NewGetField(0, sql.LongText, "name", true), expression.NewLiteral("12", sql.LongText))
+	require.Equal("concat_id(name, \"12\")", e.String())
+	require.Equal(sql.LongText, e.Type())
+
+	// Arguments are converted to the types of the function before they're sent
+	v, err := e.Eval(sql.NewEmptyContext(), sql.Row{"a"})
+	require.NoError(err)
+	require.Equal("a-12", v)
+	require.Equal([][][]interface{}{{{"a", int64(12)}}}, batches)
+
+	_, err = NewFunction(def).NewInstance([]sql.Expression{expression.NewLiteral("a", sql.LongText)})
+	require.True(sql.ErrInvalidArgumentNumber.Is(err))
+}
+
+func TestCallResultConversion(t *testing.T) {
+	require := require.New(t)
+
+	results := []interface{}{float64(3)}
+	e := newCall(t, Definition{
+		Name:       "f",
+		ReturnType: sql.Int32,
+		Handler: HandlerFunc(func(ctx context.Context, function string, batch [][]interface{}) ([]interface{}, error) {
+			return results, nil
+		}),
+	})
+
+	v, err := e.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(err)
+	require.Equal(int32(3), v)
+
+	results = []interface{}{"x"}
+	_, err = e.Eval(sql.NewEmptyContext(), nil)
+	require.Error(err)
+
+	results = nil
+	_, err = e.Eval(sql.NewEmptyContext(), nil)
+	require.True(ErrBadResult.Is(err))
+}
+
+func TestCallBatching(t *testing.T) {
+	require := require.New(t)
+
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var sizes []int
+	handler := HandlerFunc(func(ctx context.Context, function string, batch [][]interface{}) ([]interface{}, error) {
+		<-release
+		mu.Lock()
+		sizes = append(sizes, len(batch))
+		mu.Unlock()
+		results := make([]interface{}, len(batch))
+		for i, row := range batch {
+			results[i] = row[0].(int64) * 2
+		}
+		return results, nil
+	})
+	e := newCall(t, Definition{
+		Name:         "double",
+		ArgTypes:     []sql.Type{sql.Int64},
+		ReturnType:   sql.Int64,
+		Handler:      handler,
+		MaxBatchSize: 3,
+	}, expression.NewGetField(0, sql.Int64, "x", false))
+
+	const calls = 7
+	var wg sync.WaitGroup
+	results := make([]interface{}, calls)
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, err := e.Eval(sql.NewEmptyContext(), sql.Row{int64(i)})
+			require.NoError(err)
+			results[i] = v
+		}(i)
+		if i == 0 {
+			// Let the first call reach the handler, so that the others are batched
+			time.Sleep(20 * time.Millisecond)
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i, v := range results {
+		require.Equal(int64(i*2), v)
+	}
+	require.Equal([]int{1, 3, 3}, sizes)
+}
+
+func TestCallTimeout(t *testing.T) {
+	require := require.New(t)
+
+	e := newCall(t, Definition{
+		Name:       "slow",
+		ReturnType: sql.Int64,
+		Timeout:    10 * time.Millisecond,
+		Handler: HandlerFunc(func(ctx context.Context, function string, batch [][]interface{}) ([]interface{}, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}),
+	})
+
+	_, err := e.Eval(sql.NewEmptyContext(), nil)
+	require.True(ErrTimeout.Is(err))
+
+	// Canceled queries are not reported as timeouts
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = e.Eval(sql.NewContext(ctx), nil)
+	require.Equal(context.Canceled, err)
+}
+
+// TestHelperProcess is the process of the process handler tests. It evaluates upper(x) and fails other functions.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+
+	in := bufio.NewScanner(os.Stdin)
+	out := json.NewEncoder(os.Stdout)
+	for in.Scan() {
+		var req ProcessRequest
+		if err := json.Unmarshal(in.Bytes(), &req); err != nil {
+			os.Exit(1)
+		}
+		resp := ProcessResponse{ID: req.ID}
+		switch req.Function {
+		case "upper":
+			for _, row := range req.Rows {
+				resp.Results = append(resp.Results, strings.ToUpper(row[0].(string)))
+			}
+		case "hang":
+			select {}
+		default:
+			resp.Error = "unknown function " + req.Function
+		}
+		_ = out.Encode(resp)
+	}
+	os.Exit(0)
+}
+
+func newHelperProcess(t *testing.T) *ProcessHandler {
+	cmd := exec.Command(os.Args[0], "-test.run=TestHelperProcess")
+	cmd.Env = append(os.Environ(), "GO_WANT_HELPER_PROCESS=1")
+	h, err := NewProcessHandler(cmd)
+	require.NoError(t, err)
+	return h
+}
+
+func TestProcessHandler(t *testing.T) {
+	require := require.New(t)
+
+	h := newHelperProcess(t)
+	results, err := h.Call(context.Background(), "upper", [][]interface{}{{"a"}, {"bc"}})
+	require.NoError(err)
+	require.Equal([]interface{}{"A", "BC"}, results)
+
+	_, err = h.Call(context.Background(), "lower", [][]interface{}{{"a"}})
+	require.EqualError(err, "unknown function lower")
+
+	e := newCall(t, Definition{
+		Name:       "upper",
+		ArgTypes:   []sql.Type{sql.LongText},
+		ReturnType: sql.LongText,
+		Handler:    h,
+	}, expression.NewLiteral(int64(1), sql.Int64))
+	v, err := e.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(err)
+	require.Equal("1", v)
+
+	require.NoError(h.Close())
+	_, err = h.Call(context.Background(), "upper", [][]interface{}{{"a"}})
+	require.True(ErrProcess.Is(err))
+}
+
+func TestProcessHandlerTimeout(t *testing.T) {
+	require := require.New(t)
+
+	h := newHelperProcess(t)
+	e := newCall(t, Definition{
+		Name:       "hang",
+		ReturnType: sql.Int64,
+		Handler:    h,
+		Timeout:    50 * time.Millisecond,
+	})
+	_, err := e.Eval(sql.NewEmptyContext(), nil)
+	require.True(ErrTimeout.Is(err))
+
+	// The process is killed, since its answer would be mistaken for the answer to the next call
+	_, err = h.Call(context.Background(), "upper", [][]interface{}{{"a"}})
+	require.True(ErrProcess.Is(err))
+	require.Error(h.Close())
+}
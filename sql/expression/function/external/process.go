@@ -0,0 +1,148 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrProcess is returned when the process of a process handler fails or breaks its protocol.
+var ErrProcess = errors.NewKind("external function process: %s")
+
+// ProcessRequest is a line the process of a process handler reads from its standard input.
+type ProcessRequest struct {
+	ID       uint64          `json:"id"`
+	Function string          `json:"function"`
+	Rows     [][]interface{} `json:"rows"`
+}
+
+// ProcessResponse is the line the process of a process handler writes to its standard output for each request.
+type ProcessResponse struct {
+	ID      uint64        `json:"id"`
+	Results []interface{} `json:"results"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// ProcessHandler is a Handler that evaluates functions with a process, talking to it over its standard input and
+// output. Each batch is written to the process as a JSON ProcessRequest on a line, and the process answers with a
+// JSON ProcessResponse on a line. Requests are sent one at a time. A process that doesn't answer in time is killed,
+// and the handler fails from then on.
+type ProcessHandler struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+
+	mu     sync.Mutex
+	nextID uint64
+	err    error
+}
+
+var _ Handler = (*ProcessHandler)(nil)
+
+// NewProcessHandler starts the command given, which must not be started yet, and returns a handler evaluating
+// functions with it.
+func NewProcessHandler(cmd *exec.Cmd) (*ProcessHandler, error) {
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &ProcessHandler{cmd: cmd, stdin: stdin, stdout: bufio.NewReader(stdout)}, nil
+}
+
+// Call implements the Handler interface.
+func (h *ProcessHandler) Call(ctx context.Context, function string, batch [][]interface{}) ([]interface{}, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	h.nextID++
+	req, err := json.Marshal(ProcessRequest{ID: h.nextID, Function: function, Rows: batch})
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	var resp ProcessResponse
+	var protocolErr error
+	go func() {
+		defer close(done)
+		if _, err := h.stdin.Write(append(req, '\n')); err != nil {
+			protocolErr = err
+			return
+		}
+		line, err := h.stdout.ReadBytes('\n')
+		if err != nil {
+			protocolErr = err
+			return
+		}
+		protocolErr = json.Unmarshal(line, &resp)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		// The answer of the process can't be told apart from the answer to the next request anymore, so the
+		// process is stopped.
+		h.err = ErrProcess.New("killed after an abandoned call")
+		_ = h.cmd.Process.Kill()
+		<-done
+		return nil, ctx.Err()
+	}
+
+	if protocolErr != nil {
+		h.err = ErrProcess.New(protocolErr)
+		return nil, h.err
+	}
+	if resp.ID != h.nextID {
+		h.err = ErrProcess.New(fmt.Sprintf("response %d to request %d", resp.ID, h.nextID))
+		return nil, h.err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("%s", resp.Error)
+	}
+	return resp.Results, nil
+}
+
+// Close stops the process, closing its standard input and waiting for it to exit.
+func (h *ProcessHandler) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.err == nil {
+		h.err = ErrProcess.New("closed")
+	}
+	if err := h.stdin.Close(); err != nil {
+		return err
+	}
+	return h.cmd.Wait()
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Extract implements the EXTRACT function, which returns a part of a date or datetime, such as its YEAR or its
+// DAY_HOUR, as an integer.
+type Extract struct {
+	unit sql.Expression
+	date sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Extract)(nil)
+
+// NewExtract creates a new EXTRACT function, returning the part of the date given named by the unit given.
+func NewExtract(unit, date sql.Expression) sql.Expression {
+	return &Extract{unit: unit, date: date}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (e *Extract) FunctionName() string {
+	return "extract"
+}
+
+// Children implements the sql.Expression interface.
+func (e *Extract) Children() []sql.Expression {
+	return []sql.Expression{e.unit, e.date}
+}
+
+// Resolved implements the sql.Expression interface.
+func (e *Extract) Resolved() bool {
+	return e.unit.Resolved() && e.date.Resolved()
+}
+
+// IsNullable implements the sql.Expression interface.
+func (e *Extract) IsNullable() bool {
+	return e.date.IsNullable()
+}
+
+// Type implements the sql.Expression interface.
+func (e *Extract) Type() sql.Type { return sql.Int64 }
+
+// WithChildren implements the sql.Expression interface.
+func (e *Extract) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 2)
+	}
+	return NewExtract(children[0], children[1]), nil
+}
+
+func (e *Extract) String() string {
+	return fmt.Sprintf("EXTRACT(%s FROM %s)", e.unit, e.date)
+}
+
+// Eval implements the sql.Expression interface.
+func (e *Extract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	unit, err := evalTimeUnit(ctx, "EXTRACT", e.unit, row)
+	if err != nil {
+		return nil, err
+	}
+
+	date, err := evalDatetime(ctx, e.date, row)
+	if err != nil || date == nil {
+		return nil, err
+	}
+
+	return extractPart(unit, date.(time.Time))
+}
+
+// extractPart returns the part of the time given named by the unit given. The parts of compound units, such as
+// HOUR_MINUTE, are concatenated as decimal digits: two for each part, and six for microseconds.
+func extractPart(unit string, t time.Time) (int64, error) {
+	year, month, day := int64(t.Year()), int64(t.Month()), int64(t.Day())
+	hour, minute, second := int64(t.Hour()), int64(t.Minute()), int64(t.Second())
+	micros := int64(t.Nanosecond() / int(time.Microsecond))
+
+	switch unit {
+	case "MICROSECOND":
+		return micros, nil
+	case "SECOND":
+		return second, nil
+	case "MINUTE":
+		return minute, nil
+	case "HOUR":
+		return hour, nil
+	case "DAY":
+		return day, nil
+	case "WEEK":
+		return int64(weekOfYear(t)), nil
+	case "MONTH":
+		return month, nil
+	case "QUARTER":
+		return (month-1)/3 + 1, nil
+	case "YEAR":
+		return year, nil
+	case "SECOND_MICROSECOND":
+		return second*1000000 + micros, nil
+	case "MINUTE_MICROSECOND":
+		return (minute*100+second)*1000000 + micros, nil
+	case "MINUTE_SECOND":
+		return minute*100 + second, nil
+	case "HOUR_MICROSECOND":
+		return (hour*10000+minute*100+second)*1000000 + micros, nil
+	case "HOUR_SECOND":
+		return hour*10000 + minute*100 + second, nil
+	case "HOUR_MINUTE":
+		return hour*100 + minute, nil
+	case "DAY_MICROSECOND":
+		return (day*1000000+hour*10000+minute*100+second)*1000000 + micros, nil
+	case "DAY_SECOND":
+		return day*1000000 + hour*10000 + minute*100 + second, nil
+	case "DAY_MINUTE":
+		return day*10000 + hour*100 + minute, nil
+	case "DAY_HOUR":
+		return day*100 + hour, nil
+	case "YEAR_MONTH":
+		return year*100 + month, nil
+	default:
+		return 0, ErrInvalidArgument.New("EXTRACT", fmt.Sprintf("invalid unit %s", unit))
+	}
+}
+
+// weekOfYear returns the week of the time given like WEEK() in mode 0: weeks start on Sunday, and the days before the
+// first Sunday of the year are in week 0.
+func weekOfYear(t time.Time) int32 {
+	yyyy := int32(t.Year())
+	yearForWeek, week := calcWeek(yyyy, int32(t.Month()), int32(t.Day()), weekMode(0)|weekBehaviourYear)
+	if yearForWeek < yyyy {
+		return 0
+	} else if yearForWeek > yyyy {
+		return 53
+	}
+	return week
+}
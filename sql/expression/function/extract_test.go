@@ -0,0 +1,69 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestExtract(t *testing.T) {
+	date := "2019-07-02 01:02:03.000004"
+	testCases := []struct {
+		unit     string
+		date     interface{}
+		expected interface{}
+	}{
+		{"YEAR", date, int64(2019)},
+		{"QUARTER", date, int64(3)},
+		{"MONTH", date, int64(7)},
+		{"WEEK", date, int64(26)},
+		{"WEEK", "2005-01-01", int64(0)},
+		{"DAY", date, int64(2)},
+		{"HOUR", date, int64(1)},
+		{"MINUTE", date, int64(2)},
+		{"SECOND", date, int64(3)},
+		{"MICROSECOND", date, int64(4)},
+		{"YEAR_MONTH", date, int64(201907)},
+		{"DAY_HOUR", date, int64(201)},
+		{"DAY_MINUTE", date, int64(20102)},
+		{"DAY_SECOND", date, int64(2010203)},
+		{"DAY_MICROSECOND", date, int64(2010203000004)},
+		{"HOUR_MINUTE", date, int64(102)},
+		{"HOUR_SECOND", date, int64(10203)},
+		{"HOUR_MICROSECOND", date, int64(10203000004)},
+		{"MINUTE_SECOND", date, int64(203)},
+		{"MINUTE_MICROSECOND", date, int64(203000004)},
+		{"SECOND_MICROSECOND", date, int64(3000004)},
+		{"day", nil, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.unit, func(t *testing.T) {
+			f := NewExtract(expression.NewLiteral(tt.unit, sql.LongText), expression.NewLiteral(tt.date, sql.LongText))
+			result, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+
+	f := NewExtract(expression.NewLiteral("CENTURY", sql.LongText), expression.NewLiteral(date, sql.LongText))
+	_, err := f.Eval(sql.NewEmptyContext(), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,170 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// maxFormatDecimals is the maximum number of decimals of the numbers formatted by FORMAT.
+const maxFormatDecimals = 30
+
+// Format implements the FORMAT function, which formats a number with thousands separators and the number of decimals
+// given.
+// https://dev.mysql.com/doc/refman/8.0/en/string-functions.html#function_format
+type Format struct {
+	number   sql.Expression
+	decimals sql.Expression
+	locale   sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Format)(nil)
+
+// NewFormat creates a new Format expression.
+func NewFormat(args ...sql.Expression) (sql.Expression, error) {
+	switch len(args) {
+	case 2:
+		return &Format{number: args[0], decimals: args[1]}, nil
+	case 3:
+		return &Format{number: args[0], decimals: args[1], locale: args[2]}, nil
+	default:
+		return nil, sql.ErrInvalidArgumentNumber.New("FORMAT", "2 or 3", len(args))
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *Format) FunctionName() string {
+	return "format"
+}
+
+// Type implements the sql.Expression interface.
+func (f *Format) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the sql.Expression interface.
+func (f *Format) IsNullable() bool {
+	return f.number.IsNullable() || f.decimals.IsNullable()
+}
+
+// Children implements the sql.Expression interface.
+func (f *Format) Children() []sql.Expression {
+	if f.locale == nil {
+		return []sql.Expression{f.number, f.decimals}
+	}
+	return []sql.Expression{f.number, f.decimals, f.locale}
+}
+
+// Resolved implements the sql.Expression interface.
+func (f *Format) Resolved() bool {
+	return expression.ExpressionsResolved(f.Children()...)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *Format) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(f.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), len(f.Children()))
+	}
+	return NewFormat(children...)
+}
+
+func (f *Format) String() string {
+	return fmt.Sprintf("format(%s)", joinExpressions(f.Children()))
+}
+
+// Eval implements the sql.Expression interface.
+func (f *Format) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := f.number.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	number, ok := val.(decimal.Decimal)
+	if !ok {
+		val, err = sql.Float64.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		number = decimal.NewFromFloat(val.(float64))
+	}
+
+	decimals, ok, err := evalIntArg(ctx, f.decimals, 0, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if decimals < 0 {
+		decimals = 0
+	} else if decimals > maxFormatDecimals {
+		decimals = maxFormatDecimals
+	}
+
+	locale, err := f.evalLocale(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	formatted := number.Round(int32(decimals)).StringFixed(int32(decimals))
+
+	var sign string
+	if strings.HasPrefix(formatted, "-") {
+		sign, formatted = "-", formatted[1:]
+	}
+
+	integer, fraction := formatted, ""
+	if i := strings.IndexByte(formatted, '.'); i >= 0 {
+		integer, fraction = formatted[:i], formatted[i+1:]
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteString(locale.ThousandsSep)
+		}
+		b.WriteRune(digit)
+	}
+	if fraction != "" {
+		b.WriteString(locale.DecimalPoint)
+		b.WriteString(fraction)
+	}
+	return b.String(), nil
+}
+
+// evalLocale returns the locale the number is formatted with: the one given, or en_US if it's missing or NULL.
+// Unknown locales are formatted with en_US too, with a warning.
+func (f *Format) evalLocale(ctx *sql.Context, row sql.Row) (*sql.Locale, error) {
+	if f.locale == nil {
+		return sql.DefaultLocale, nil
+	}
+
+	name, err := evalStringArg(ctx, f.locale, row)
+	if err != nil {
+		return nil, err
+	}
+	if name == nil {
+		return sql.DefaultLocale, nil
+	}
+
+	if locale, ok := sql.LookupLocale(name.(string)); ok {
+		return locale, nil
+	}
+
+	ctx.Warn(1649, "Unknown locale: '%v'", name)
+	return sql.DefaultLocale, nil
+}
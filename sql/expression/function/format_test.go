@@ -0,0 +1,74 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		args     []sql.Expression
+		expected interface{}
+		warnings int
+	}{
+		{literals("12332.123456", int64(4)), "12,332.1235", 0},
+		{literals("12332.1", int64(4)), "12,332.1000", 0},
+		{literals("12332.2", int64(0)), "12,332", 0},
+		{literals("12332.2", int64(-2)), "12,332", 0},
+		{literals("-1234567.5", int64(0)), "-1,234,568", 0},
+		{literals("123", int64(2)), "123.00", 0},
+		{literals("12332.2", int64(2), "de_DE"), "12.332,20", 0},
+		{literals("12332.2", int64(2), "DE_de"), "12.332,20", 0},
+		{literals("1234567.891", int64(2), "de_CH"), "1'234'567.89", 0},
+		{literals("1234567.891", int64(1), "fr_FR"), "1234567,9", 0},
+		{literals("12332.2", int64(2), "xx_XX"), "12,332.20", 1},
+		{literals("12332.2", int64(2), nil), "12,332.20", 0},
+		{literals(nil, int64(2)), nil, 0},
+		{literals("12332.2", nil), nil, 0},
+		{[]sql.Expression{
+			expression.NewLiteral(decimal.New(1234005, -3), sql.MustCreateDecimalType(10, 3)),
+			expression.NewLiteral(int64(2), sql.Int64),
+		}, "1,234.01", 0},
+		{[]sql.Expression{
+			expression.NewLiteral(float64(0.5), sql.Float64),
+			expression.NewLiteral(int64(0), sql.Int64),
+		}, "1", 0},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprint(tt.args), func(t *testing.T) {
+			require := require.New(t)
+			f, err := NewFormat(tt.args...)
+			require.NoError(err)
+
+			ctx := sql.NewEmptyContext()
+			v, err := f.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+			require.Len(ctx.Warnings(), tt.warnings)
+		})
+	}
+
+	_, err := NewFormat(literals("1")...)
+	require.Error(t, err)
+}
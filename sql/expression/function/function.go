@@ -61,3 +61,49 @@ func (uf *UnaryFunc) String() string {
 func (uf *UnaryFunc) Type() sql.Type {
 	return uf.RetType
 }
+
+// evalStringArg evaluates the argument given as a string.
+func evalStringArg(ctx *sql.Context, e sql.Expression, row sql.Row) (interface{}, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	return sql.LongText.Convert(val)
+}
+
+// evalIntArg evaluates the optional integer argument given, which is the default given if it's nil. It returns false if
+// the argument is NULL.
+func evalIntArg(ctx *sql.Context, e sql.Expression, def int64, row sql.Row) (int64, bool, error) {
+	if e == nil {
+		return def, true, nil
+	}
+
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return 0, false, err
+	}
+
+	val, err = sql.Int64.Convert(val)
+	if err != nil {
+		return 0, false, err
+	}
+	return val.(int64), true, nil
+}
+
+// evalBytesArg evaluates the argument given as a binary string. It returns false if the argument is NULL.
+func evalBytesArg(ctx *sql.Context, e sql.Expression, row sql.Row) ([]byte, bool, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, false, err
+	}
+
+	if b, ok := val.([]byte); ok {
+		return b, true, nil
+	}
+
+	val, err = sql.LongText.Convert(val)
+	if err != nil {
+		return nil, false, err
+	}
+	return []byte(val.(string)), true, nil
+}
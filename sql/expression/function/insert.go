@@ -0,0 +1,113 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Insert implements the INSERT function, which replaces a substring of a string with another string.
+// https://dev.mysql.com/doc/refman/8.0/en/string-functions.html#function_insert
+type Insert struct {
+	str    sql.Expression
+	pos    sql.Expression
+	length sql.Expression
+	newStr sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Insert)(nil)
+
+// NewInsert creates a new Insert expression.
+func NewInsert(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 4 {
+		return nil, sql.ErrInvalidArgumentNumber.New("INSERT", "4", len(args))
+	}
+	return &Insert{args[0], args[1], args[2], args[3]}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (i *Insert) FunctionName() string {
+	return "insert"
+}
+
+// Type implements the sql.Expression interface.
+func (i *Insert) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the sql.Expression interface.
+func (i *Insert) IsNullable() bool {
+	return i.str.IsNullable() || i.pos.IsNullable() || i.length.IsNullable() || i.newStr.IsNullable()
+}
+
+// Children implements the sql.Expression interface.
+func (i *Insert) Children() []sql.Expression {
+	return []sql.Expression{i.str, i.pos, i.length, i.newStr}
+}
+
+// Resolved implements the sql.Expression interface.
+func (i *Insert) Resolved() bool {
+	return expression.ExpressionsResolved(i.Children()...)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (i *Insert) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 4 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 4)
+	}
+	return NewInsert(children...)
+}
+
+func (i *Insert) String() string {
+	return fmt.Sprintf("insert(%s, %s, %s, %s)", i.str, i.pos, i.length, i.newStr)
+}
+
+// Eval implements the sql.Expression interface. The string is returned unchanged if the position isn't within it, and
+// the rest of the string is replaced if the length isn't within the rest of the string. Positions and lengths are
+// counted in characters.
+func (i *Insert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	str, err := evalStringArg(ctx, i.str, row)
+	if err != nil || str == nil {
+		return nil, err
+	}
+
+	pos, ok, err := evalIntArg(ctx, i.pos, 0, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	length, ok, err := evalIntArg(ctx, i.length, 0, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	newStr, err := evalStringArg(ctx, i.newStr, row)
+	if err != nil || newStr == nil {
+		return nil, err
+	}
+
+	chars := []rune(str.(string))
+	if pos < 1 || pos > int64(len(chars)) {
+		return str, nil
+	}
+
+	start := pos - 1
+	if length < 0 || length > int64(len(chars))-start {
+		length = int64(len(chars)) - start
+	}
+
+	return string(chars[:start]) + newStr.(string) + string(chars[start+length:]), nil
+}
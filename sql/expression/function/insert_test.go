@@ -0,0 +1,58 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestInsert(t *testing.T) {
+	testCases := []struct {
+		args     []interface{}
+		expected interface{}
+	}{
+		{[]interface{}{"Quadratic", int64(3), int64(4), "What"}, "QuWhattic"},
+		{[]interface{}{"Quadratic", int64(-1), int64(4), "What"}, "Quadratic"},
+		{[]interface{}{"Quadratic", int64(10), int64(4), "What"}, "Quadratic"},
+		{[]interface{}{"Quadratic", int64(3), int64(100), "What"}, "QuWhat"},
+		{[]interface{}{"Quadratic", int64(3), int64(-1), "What"}, "QuWhat"},
+		{[]interface{}{"Quadratic", int64(3), int64(0), "What"}, "QuWhatadratic"},
+		{[]interface{}{"日本語です", int64(2), int64(2), "X"}, "日Xです"},
+		{[]interface{}{nil, int64(3), int64(4), "What"}, nil},
+		{[]interface{}{"Quadratic", nil, int64(4), "What"}, nil},
+		{[]interface{}{"Quadratic", int64(3), nil, "What"}, nil},
+		{[]interface{}{"Quadratic", int64(3), int64(4), nil}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprint(tt.args), func(t *testing.T) {
+			require := require.New(t)
+			f, err := NewInsert(literals(tt.args...)...)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	_, err := NewInsert(literals("Quadratic", int64(3), int64(4))...)
+	require.Error(t, err)
+}
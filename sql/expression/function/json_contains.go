@@ -102,12 +102,12 @@ func (j *JSONContains) IsNullable() bool {
 
 func (j *JSONContains) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	target, err := getSearchableJSONVal(ctx, row, j.JSONTarget)
-	if err != nil {
+	if err != nil || target == nil {
 		return nil, err
 	}
 
 	candidate, err := getSearchableJSONVal(ctx, row, j.JSONCandidate)
-	if err != nil {
+	if err != nil || candidate == nil {
 		return nil, err
 	}
 
@@ -144,6 +144,9 @@ func getSearchableJSONVal(ctx *sql.Context, row sql.Row, json sql.Expression) (s
 	if err != nil {
 		return nil, err
 	}
+	if ok, err := ctx.FunctionLimits.CheckJSONDocument(ctx, "JSON_CONTAINS", js); !ok {
+		return nil, err
+	}
 
 	converted, err := sql.JSON.Convert(js)
 	if err != nil {
@@ -68,6 +68,9 @@ func (j *JSONExtract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	if ok, err := ctx.FunctionLimits.CheckJSONDocument(ctx, "JSON_EXTRACT", js); !ok {
+		return nil, err
+	}
 
 	js, err = j.Type().Convert(js)
 	if err != nil {
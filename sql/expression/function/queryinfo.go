@@ -148,3 +148,53 @@ func (r FoundRows) WithChildren(children ...sql.Expression) (sql.Expression, err
 func (r FoundRows) FunctionName() string {
 	return "found_rows"
 }
+
+// LastCommitSequence implements the LAST_COMMIT_SEQUENCE() function, which returns the commit sequence number of the
+// last write of the session, or zero if it didn't write or the engine doesn't number commits.
+type LastCommitSequence struct{}
+
+func NewLastCommitSequence() sql.Expression {
+	return LastCommitSequence{}
+}
+
+var _ sql.FunctionExpression = LastCommitSequence{}
+
+// Resolved implements sql.Expression
+func (r LastCommitSequence) Resolved() bool {
+	return true
+}
+
+// String implements sql.Expression
+func (r LastCommitSequence) String() string {
+	return "LAST_COMMIT_SEQUENCE()"
+}
+
+// Type implements sql.Expression
+func (r LastCommitSequence) Type() sql.Type {
+	return sql.Uint64
+}
+
+// IsNullable implements sql.Expression
+func (r LastCommitSequence) IsNullable() bool {
+	return false
+}
+
+// Eval implements sql.Expression
+func (r LastCommitSequence) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return uint64(ctx.GetLastQueryInfo(sql.LastCommitSequence)), nil
+}
+
+// Children implements sql.Expression
+func (r LastCommitSequence) Children() []sql.Expression {
+	return nil
+}
+
+// WithChildren implements sql.Expression
+func (r LastCommitSequence) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return sql.NillaryWithChildren(r, children...)
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r LastCommitSequence) FunctionName() string {
+	return "last_commit_sequence"
+}
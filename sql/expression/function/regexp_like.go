@@ -141,6 +141,9 @@ func (r *RegexpLike) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	if ok, err := ctx.FunctionLimits.CheckRegexpInput(ctx, "REGEXP_LIKE", text.(string)); !ok {
+		return nil, err
+	}
 
 	var outVal int8
 	if r.re.MatchString(text.(string)) {
@@ -15,6 +15,7 @@
 package function
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -352,3 +353,22 @@ func TestRegexpLikeNilAndErrors(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, nil, res)
 }
+
+func TestRegexpLikeFunctionLimits(t *testing.T) {
+	f, err := NewRegexpLike(
+		expression.NewLiteral("foofoo", sql.LongText),
+		expression.NewLiteral("foo", sql.LongText),
+	)
+	require.NoError(t, err)
+
+	limits := &sql.FunctionLimits{MaxRegexpInputLength: 4}
+	ctx := sql.NewContext(context.Background(), sql.WithFunctionLimits(limits))
+	_, err = f.Eval(ctx, nil)
+	require.True(t, sql.ErrFunctionLimitExceeded.Is(err))
+
+	limits.Policy = sql.FunctionLimitWarn
+	res, err := f.Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, uint16(1), ctx.WarningCount())
+}
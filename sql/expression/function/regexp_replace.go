@@ -0,0 +1,180 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrRegexpIndexOutOfBounds is returned when the position given to a regexp function is outside of the string.
+var ErrRegexpIndexOutOfBounds = errors.NewKind("Index out of bounds in regular expression search.")
+
+// RegexpReplace implements the REGEXP_REPLACE function.
+// https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-replace
+type RegexpReplace struct {
+	Text       sql.Expression
+	Pattern    sql.Expression
+	Replace    sql.Expression
+	Position   sql.Expression
+	Occurrence sql.Expression
+	Flags      sql.Expression
+}
+
+var _ sql.FunctionExpression = (*RegexpReplace)(nil)
+
+// NewRegexpReplace creates a new RegexpReplace expression.
+func NewRegexpReplace(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 3 || len(args) > 6 {
+		return nil, sql.ErrInvalidArgumentNumber.New("regexp_replace", "3 to 6", len(args))
+	}
+
+	r := &RegexpReplace{Text: args[0], Pattern: args[1], Replace: args[2]}
+	optional := []*sql.Expression{&r.Position, &r.Occurrence, &r.Flags}
+	for i, arg := range args[3:] {
+		*optional[i] = arg
+	}
+	return r, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *RegexpReplace) FunctionName() string {
+	return "regexp_replace"
+}
+
+// Type implements the sql.Expression interface.
+func (r *RegexpReplace) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the sql.Expression interface.
+func (r *RegexpReplace) IsNullable() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (r *RegexpReplace) Children() []sql.Expression {
+	return regexpArgs(r.Text, r.Pattern, r.Replace, r.Position, r.Occurrence, r.Flags)
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RegexpReplace) Resolved() bool {
+	return expression.ExpressionsResolved(r.Children()...)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RegexpReplace) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(r.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.Children()))
+	}
+	return NewRegexpReplace(children...)
+}
+
+func (r *RegexpReplace) String() string {
+	var args []string
+	for _, e := range r.Children() {
+		args = append(args, e.String())
+	}
+	return fmt.Sprintf("regexp_replace(%s)", strings.Join(args, ", "))
+}
+
+// Eval implements the sql.Expression interface.
+func (r *RegexpReplace) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.RegexpReplace")
+	defer span.Finish()
+
+	text, err := evalStringArg(ctx, r.Text, row)
+	if err != nil || text == nil {
+		return nil, err
+	}
+	if ok, err := ctx.FunctionLimits.CheckRegexpInput(ctx, "REGEXP_REPLACE", text.(string)); !ok {
+		return nil, err
+	}
+
+	re, err := compileRegex(ctx, r.Pattern, r.Flags, r.FunctionName(), row)
+	if err != nil || re == nil {
+		return nil, err
+	}
+
+	replace, err := evalStringArg(ctx, r.Replace, row)
+	if err != nil || replace == nil {
+		return nil, err
+	}
+
+	prefix, rest, ok, err := splitAtRegexpPosition(ctx, r.Position, text.(string), row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	occurrence, ok, err := evalIntArg(ctx, r.Occurrence, 0, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	// An occurrence of 0 replaces all the matches.
+	matches := re.FindAllStringSubmatchIndex(rest, -1)
+	if occurrence > 0 {
+		if occurrence > int64(len(matches)) {
+			return text, nil
+		}
+		matches = matches[occurrence-1 : occurrence]
+	}
+
+	var b strings.Builder
+	b.WriteString(prefix)
+	var last int
+	for _, match := range matches {
+		b.WriteString(rest[last:match[0]])
+		b.Write(re.ExpandString(nil, replace.(string), rest, match))
+		last = match[1]
+	}
+	b.WriteString(rest[last:])
+	return b.String(), nil
+}
+
+// regexpArgs returns the arguments given up to the first nil one, which is an optional argument that wasn't given.
+func regexpArgs(args ...sql.Expression) []sql.Expression {
+	for i, arg := range args {
+		if arg == nil {
+			return args[:i]
+		}
+	}
+	return args
+}
+
+// splitAtRegexpPosition splits the text given at the position, in characters and starting at 1, of the optional
+// argument given, from which the text is searched. It returns false if the position is NULL.
+func splitAtRegexpPosition(ctx *sql.Context, position sql.Expression, text string, row sql.Row) (string, string, bool, error) {
+	pos, ok, err := evalIntArg(ctx, position, 1, row)
+	if err != nil || !ok {
+		return "", "", false, err
+	}
+
+	if pos < 1 {
+		return "", "", false, ErrRegexpIndexOutOfBounds.New()
+	}
+
+	var offset int
+	for i := int64(1); i < pos; i++ {
+		if offset == len(text) {
+			return "", "", false, ErrRegexpIndexOutOfBounds.New()
+		}
+		_, size := utf8.DecodeRuneInString(text[offset:])
+		offset += size
+	}
+	return text[:offset], text[offset:], true, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// literals returns literals of the values given, which are NULL, strings or int64s.
+func literals(values ...interface{}) []sql.Expression {
+	exprs := make([]sql.Expression, len(values))
+	for i, v := range values {
+		switch v.(type) {
+		case nil:
+			exprs[i] = expression.NewLiteral(nil, sql.Null)
+		case string:
+			exprs[i] = expression.NewLiteral(v, sql.LongText)
+		default:
+			exprs[i] = expression.NewLiteral(v, sql.Int64)
+		}
+	}
+	return exprs
+}
+
+func TestRegexpReplace(t *testing.T) {
+	testCases := []struct {
+		args     []interface{}
+		expected interface{}
+		err      bool
+	}{
+		{[]interface{}{"a b c", "b", "X"}, "a X c", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X"}, "X X X", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X", int64(1), int64(0)}, "X X X", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X", int64(1), int64(3)}, "abc def X", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X", int64(1), int64(4)}, "abc def ghi", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X", int64(5)}, "abc X X", false},
+		{[]interface{}{"abc", "b", "X", int64(4)}, "abc", false},
+		{[]interface{}{"abc", "(b)", "[$1]"}, "a[b]c", false},
+		{[]interface{}{"ABC", "b", "x"}, "AxC", false},
+		{[]interface{}{"ABC", "b", "x", int64(1), int64(0), "c"}, "ABC", false},
+		{[]interface{}{"日本語 日本", "日本", "X"}, "X語 X", false},
+		{[]interface{}{"日本語日本", "日本", "X", int64(2)}, "日本語X", false},
+		{[]interface{}{nil, "b", "X"}, nil, false},
+		{[]interface{}{"abc", nil, "X"}, nil, false},
+		{[]interface{}{"abc", "b", nil}, nil, false},
+		{[]interface{}{"abc", "b", "X", nil}, nil, false},
+		{[]interface{}{"abc", "b", "X", int64(0)}, nil, true},
+		{[]interface{}{"abc", "b", "X", int64(5)}, nil, true},
+		{[]interface{}{"abc", "b", "X", int64(1), int64(0), "x"}, nil, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprint(tt.args), func(t *testing.T) {
+			require := require.New(t)
+			f, err := NewRegexpReplace(literals(tt.args...)...)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			if tt.err {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	_, err := NewRegexpReplace(literals("abc", "b")...)
+	require.Error(t, err)
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// RegexpSubstr implements the REGEXP_SUBSTR function.
+// https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-substr
+type RegexpSubstr struct {
+	Text       sql.Expression
+	Pattern    sql.Expression
+	Position   sql.Expression
+	Occurrence sql.Expression
+	Flags      sql.Expression
+}
+
+var _ sql.FunctionExpression = (*RegexpSubstr)(nil)
+
+// NewRegexpSubstr creates a new RegexpSubstr expression.
+func NewRegexpSubstr(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 5 {
+		return nil, sql.ErrInvalidArgumentNumber.New("regexp_substr", "2 to 5", len(args))
+	}
+
+	r := &RegexpSubstr{Text: args[0], Pattern: args[1]}
+	optional := []*sql.Expression{&r.Position, &r.Occurrence, &r.Flags}
+	for i, arg := range args[2:] {
+		*optional[i] = arg
+	}
+	return r, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *RegexpSubstr) FunctionName() string {
+	return "regexp_substr"
+}
+
+// Type implements the sql.Expression interface.
+func (r *RegexpSubstr) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the sql.Expression interface.
+func (r *RegexpSubstr) IsNullable() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (r *RegexpSubstr) Children() []sql.Expression {
+	return regexpArgs(r.Text, r.Pattern, r.Position, r.Occurrence, r.Flags)
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RegexpSubstr) Resolved() bool {
+	return expression.ExpressionsResolved(r.Children()...)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RegexpSubstr) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(r.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.Children()))
+	}
+	return NewRegexpSubstr(children...)
+}
+
+func (r *RegexpSubstr) String() string {
+	var args []string
+	for _, e := range r.Children() {
+		args = append(args, e.String())
+	}
+	return fmt.Sprintf("regexp_substr(%s)", strings.Join(args, ", "))
+}
+
+// Eval implements the sql.Expression interface.
+func (r *RegexpSubstr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.RegexpSubstr")
+	defer span.Finish()
+
+	text, err := evalStringArg(ctx, r.Text, row)
+	if err != nil || text == nil {
+		return nil, err
+	}
+	if ok, err := ctx.FunctionLimits.CheckRegexpInput(ctx, "REGEXP_SUBSTR", text.(string)); !ok {
+		return nil, err
+	}
+
+	re, err := compileRegex(ctx, r.Pattern, r.Flags, r.FunctionName(), row)
+	if err != nil || re == nil {
+		return nil, err
+	}
+
+	_, rest, ok, err := splitAtRegexpPosition(ctx, r.Position, text.(string), row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	occurrence, ok, err := evalIntArg(ctx, r.Occurrence, 1, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if occurrence < 1 {
+		occurrence = 1
+	}
+
+	matches := re.FindAllString(rest, int(occurrence))
+	if int64(len(matches)) < occurrence {
+		return nil, nil
+	}
+	return matches[occurrence-1], nil
+}
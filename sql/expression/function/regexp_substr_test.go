@@ -0,0 +1,62 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRegexpSubstr(t *testing.T) {
+	testCases := []struct {
+		args     []interface{}
+		expected interface{}
+		err      bool
+	}{
+		{[]interface{}{"abc def ghi", "[a-z]+"}, "abc", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", int64(1), int64(3)}, "ghi", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", int64(1), int64(4)}, nil, false},
+		{[]interface{}{"abc def ghi", "[a-z]+", int64(6)}, "ef", false},
+		{[]interface{}{"abc def ghi", "x"}, nil, false},
+		{[]interface{}{"ABC", "b"}, "B", false},
+		{[]interface{}{"ABC", "b", int64(1), int64(1), "c"}, nil, false},
+		{[]interface{}{"日本語 日本", "日.", int64(2)}, "日本", false},
+		{[]interface{}{nil, "b"}, nil, false},
+		{[]interface{}{"abc", nil}, nil, false},
+		{[]interface{}{"abc", "b", int64(1), nil}, nil, false},
+		{[]interface{}{"abc", "b", int64(0)}, nil, true},
+		{[]interface{}{"abc", "b", int64(5)}, nil, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprint(tt.args), func(t *testing.T) {
+			require := require.New(t)
+			f, err := NewRegexpSubstr(literals(tt.args...)...)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			if tt.err {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+}
@@ -30,22 +30,31 @@ var ErrFunctionAlreadyRegistered = errors.NewKind("function '%s' is already regi
 
 // BuiltIns is the set of built-in functions any integrator can use
 var BuiltIns = []sql.Function{
-	// elt, find_in_set, insert, load_file, locate
+	// find_in_set, locate
 	sql.Function1{Name: "abs", Fn: NewAbsVal},
 	sql.Function1{Name: "acos", Fn: NewAcos},
+	sql.FunctionN{Name: "aes_decrypt", Fn: NewAESDecrypt},
+	sql.FunctionN{Name: "aes_encrypt", Fn: NewAESEncrypt},
 	sql.Function1{Name: "array_length", Fn: NewArrayLength},
+	sql.Function1{Name: "approx_count_distinct", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewApproxCountDistinct(e) }},
+	sql.Function2{Name: "approx_percentile", Fn: aggregation.NewApproxPercentile},
 	sql.Function1{Name: "ascii", Fn: NewAscii},
 	sql.Function1{Name: "asin", Fn: NewAsin},
 	sql.Function1{Name: "atan", Fn: NewAtan},
 	sql.Function1{Name: "avg", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewAvg(e) }},
+	sql.Function2{Name: "benchmark", Fn: NewBenchmark},
 	sql.Function1{Name: "bin", Fn: NewBin},
 	sql.FunctionN{Name: "bin_to_uuid", Fn: NewBinToUUID},
+	sql.Function1{Name: "bit_and", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewBitAnd(e) }},
 	sql.Function1{Name: "bit_length", Fn: NewBitlength},
+	sql.Function1{Name: "bit_or", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewBitOr(e) }},
+	sql.Function1{Name: "bit_xor", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewBitXor(e) }},
 	sql.Function1{Name: "ceil", Fn: NewCeil},
 	sql.Function1{Name: "ceiling", Fn: NewCeil},
 	sql.Function1{Name: "char_length", Fn: NewCharLength},
 	sql.Function1{Name: "character_length", Fn: NewCharLength},
 	sql.FunctionN{Name: "coalesce", Fn: NewCoalesce},
+	sql.Function1{Name: "compress", Fn: NewCompress},
 	sql.FunctionN{Name: "concat", Fn: NewConcat},
 	sql.FunctionN{Name: "concat_ws", Fn: NewConcatWithSeparator},
 	sql.NewFunction0("connection_id", NewConnectionID),
@@ -73,13 +82,18 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "dayofweek", Fn: NewDayOfWeek},
 	sql.Function1{Name: "dayofyear", Fn: NewDayOfYear},
 	sql.Function1{Name: "degrees", Fn: NewDegrees},
+	sql.FunctionN{Name: "elt", Fn: NewElt},
 	sql.Function1{Name: "explode", Fn: NewExplode},
+	sql.Function2{Name: "extract", Fn: NewExtract},
+	sql.FunctionN{Name: "field", Fn: NewField},
 	sql.Function1{Name: "first", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewFirst(e) }},
 	sql.Function1{Name: "floor", Fn: NewFloor},
+	sql.FunctionN{Name: "format", Fn: NewFormat},
 	sql.Function0{Name: "found_rows", Fn: NewFoundRows},
 	sql.Function1{Name: "from_base64", Fn: NewFromBase64},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
 	sql.Function0{Name: "group_concat", Fn: aggregation.NewEmptyGroupConcat},
+	sql.FunctionN{Name: "grouping", Fn: aggregation.NewGrouping},
 	sql.Function1{Name: "hex", Fn: NewHex},
 	sql.Function1{Name: "hour", Fn: NewHour},
 	sql.Function3{Name: "if", Fn: NewIf},
@@ -88,6 +102,7 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "inet6_aton", Fn: NewInet6Aton},
 	sql.Function1{Name: "inet_ntoa", Fn: NewInetNtoa},
 	sql.Function1{Name: "inet6_ntoa", Fn: NewInet6Ntoa},
+	sql.FunctionN{Name: "insert", Fn: NewInsert},
 	sql.Function2{Name: "instr", Fn: NewInstr},
 	sql.Function1{Name: "is_binary", Fn: NewIsBinary},
 	sql.Function1{Name: "is_ipv4", Fn: NewIsIPv4},
@@ -128,6 +143,8 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "json_valid", Fn: NewJSONValid},
 	sql.FunctionN{Name: "json_value", Fn: NewJSONValue},
 	sql.Function1{Name: "last", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewLast(e) }},
+	sql.Function1{Name: "last_day", Fn: NewLastDay},
+	sql.Function0{Name: "last_commit_sequence", Fn: NewLastCommitSequence},
 	sql.Function0{Name: "last_insert_id", Fn: NewLastInsertId},
 	sql.Function1{Name: "lcase", Fn: NewLower},
 	sql.FunctionN{Name: "least", Fn: NewLeast},
@@ -143,6 +160,7 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "ltrim", Fn: NewLeftTrim},
 	sql.Function1{Name: "max", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewMax(e) }},
 	sql.Function1{Name: "md5", Fn: NewMD5},
+	sql.Function1{Name: "median", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewMedian(e) }},
 	sql.Function1{Name: "microsecond", Fn: NewMicrosecond},
 	sql.FunctionN{Name: "mid", Fn: NewSubstring},
 	sql.Function1{Name: "min", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewMin(e) }},
@@ -151,17 +169,25 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "monthname", Fn: NewMonthName},
 	sql.FunctionN{Name: "now", Fn: NewNow},
 	sql.Function2{Name: "nullif", Fn: NewNullIf},
+	sql.Function2{Name: "percentile_cont", Fn: aggregation.NewPercentileCont},
+	sql.Function2{Name: "percentile_disc", Fn: aggregation.NewPercentileDisc},
 	sql.Function2{Name: "pow", Fn: NewPower},
 	sql.Function2{Name: "power", Fn: NewPower},
+	sql.Function1{Name: "quote", Fn: NewQuote},
 	sql.Function1{Name: "radians", Fn: NewRadians},
 	sql.FunctionN{Name: "rand", Fn: NewRand},
+	sql.Function1{Name: "random_bytes", Fn: NewRandomBytes},
 	sql.FunctionN{Name: "regexp_like", Fn: NewRegexpLike},
+	sql.FunctionN{Name: "regexp_replace", Fn: NewRegexpReplace},
+	sql.FunctionN{Name: "regexp_substr", Fn: NewRegexpSubstr},
 	sql.Function2{Name: "repeat", Fn: NewRepeat},
 	sql.Function3{Name: "replace", Fn: NewReplace},
 	sql.Function1{Name: "reverse", Fn: NewReverse},
 	sql.Function2{Name: "right", Fn: NewRight},
 	sql.FunctionN{Name: "round", Fn: NewRound},
 	sql.Function0{Name: "row_count", Fn: NewRowCount},
+	sql.FunctionN{Name: "row_fingerprint", Fn: NewRowFingerprint},
+	sql.FunctionN{Name: "row_hash", Fn: NewRowHash},
 	sql.Function0{Name: "row_number", Fn: window.NewRowNumber},
 	sql.Function0{Name: "percent_rank", Fn: window.NewPercentRank},
 	sql.Function1{Name: "first_value", Fn: window.NewFirstValue},
@@ -178,24 +204,36 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "soundex", Fn: NewSoundex},
 	sql.Function2{Name: "split", Fn: NewSplit},
 	sql.Function1{Name: "sqrt", Fn: NewSqrt},
+	sql.Function1{Name: "std", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewStdDevPop(e) }},
+	sql.Function1{Name: "stddev", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewStdDevPop(e) }},
+	sql.Function1{Name: "stddev_pop", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewStdDevPop(e) }},
+	sql.Function1{Name: "stddev_samp", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewStdDevSamp(e) }},
 	sql.FunctionN{Name: "substr", Fn: NewSubstring},
 	sql.FunctionN{Name: "substring", Fn: NewSubstring},
 	sql.Function3{Name: "substring_index", Fn: NewSubstringIndex},
 	sql.Function1{Name: "sum", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewSum(e) }},
 	sql.Function1{Name: "tan", Fn: NewTan},
+	sql.FunctionN{Name: "time_bucket", Fn: NewTimeBucket},
 	sql.Function1{Name: "time_to_sec", Fn: NewTimeToSec},
 	sql.Function2{Name: "timediff", Fn: NewTimeDiff},
 	sql.FunctionN{Name: "timestamp", Fn: NewTimestamp},
+	sql.Function3{Name: "timestampdiff", Fn: NewTimestampDiff},
 	sql.Function1{Name: "to_base64", Fn: NewToBase64},
 	sql.Function1{Name: "ucase", Fn: NewUpper},
 	sql.Function1{Name: "unhex", Fn: NewUnhex},
+	sql.Function1{Name: "uncompress", Fn: NewUncompress},
+	sql.Function1{Name: "uncompressed_length", Fn: NewUncompressedLength},
 	sql.FunctionN{Name: "unix_timestamp", Fn: NewUnixTimestamp},
 	sql.Function1{Name: "from_unixtime", Fn: NewFromUnixtime},
 	sql.Function1{Name: "upper", Fn: NewUpper},
 	sql.NewFunction0("user", NewUser),
 	sql.FunctionN{Name: "utc_timestamp", Fn: NewUTCTimestamp},
 	sql.Function0{Name: "uuid", Fn: NewUUIDFunc},
+	sql.Function0{Name: "uuid_short", Fn: NewUUIDShort},
 	sql.FunctionN{Name: "uuid_to_bin", Fn: NewUUIDToBin},
+	sql.Function1{Name: "var_pop", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewVarPop(e) }},
+	sql.Function1{Name: "var_samp", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewVarSamp(e) }},
+	sql.Function1{Name: "variance", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewVarPop(e) }},
 	sql.FunctionN{Name: "week", Fn: NewWeek},
 	sql.Function1{Name: "values", Fn: NewValues},
 	sql.Function1{Name: "weekday", Fn: NewWeekday},
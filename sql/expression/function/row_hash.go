@@ -0,0 +1,125 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RowHash is ROW_HASH and ROW_FINGERPRINT, which hash the values of their arguments together, for deduplicating and
+// diffing rows. ROW_HASH returns the 64-bit sql.CanonicalRowHash of the values, and ROW_FINGERPRINT the hex of their
+// SHA-256 sql.CanonicalRowFingerprint. Equal values hash the same whatever their representation, NULLs included, so
+// ROW_HASH(*) of two rows is the same if they have the same values, even if their tables have different column types.
+// Stars stand for all the columns of the row, or of a table with t.*.
+type RowHash struct {
+	args        []sql.Expression
+	fingerprint bool
+}
+
+var _ sql.FunctionExpression = (*RowHash)(nil)
+
+// NewRowHash returns a new ROW_HASH function.
+func NewRowHash(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) == 0 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ROW_HASH", "1 or more", 0)
+	}
+	return &RowHash{args: args}, nil
+}
+
+// NewRowFingerprint returns a new ROW_FINGERPRINT function.
+func NewRowFingerprint(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) == 0 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ROW_FINGERPRINT", "1 or more", 0)
+	}
+	return &RowHash{args: args, fingerprint: true}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *RowHash) FunctionName() string {
+	if f.fingerprint {
+		return "row_fingerprint"
+	}
+	return "row_hash"
+}
+
+// Type implements the Expression interface.
+func (f *RowHash) Type() sql.Type {
+	if f.fingerprint {
+		return sql.LongText
+	}
+	return sql.Uint64
+}
+
+// IsNullable implements the Expression interface.
+func (f *RowHash) IsNullable() bool {
+	return false
+}
+
+func (f *RowHash) String() string {
+	var args = make([]string, len(f.args))
+	for i, arg := range f.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", f.FunctionName(), strings.Join(args, ", "))
+}
+
+// WithChildren implements the Expression interface.
+func (f *RowHash) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if f.fingerprint {
+		return NewRowFingerprint(children...)
+	}
+	return NewRowHash(children...)
+}
+
+// Resolved implements the Expression interface.
+func (f *RowHash) Resolved() bool {
+	for _, arg := range f.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Children implements the Expression interface.
+func (f *RowHash) Children() []sql.Expression { return f.args }
+
+// Eval implements the Expression interface.
+func (f *RowHash) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	values := make(sql.Row, len(f.args))
+	for i, arg := range f.args {
+		v, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		values[i], err = sql.CanonicalValue(arg.Type(), v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.fingerprint {
+		fp, err := sql.CanonicalRowFingerprint(ctx, values)
+		if err != nil {
+			return nil, err
+		}
+		return hex.EncodeToString(fp), nil
+	}
+	return sql.CanonicalRowHash(ctx, values)
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestRowHash(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	_, err := NewRowHash()
+	require.True(sql.ErrInvalidArgumentNumber.Is(err))
+	_, err = NewRowFingerprint()
+	require.True(sql.ErrInvalidArgumentNumber.Is(err))
+
+	args := []sql.Expression{
+		expression.NewGetField(0, sql.Int64, "a", true),
+		expression.NewGetField(1, sql.LongText, "b", true),
+	}
+	hash, err := NewRowHash(args...)
+	require.NoError(err)
+	fingerprint, err := NewRowFingerprint(args...)
+	require.NoError(err)
+	require.Equal(sql.Uint64, hash.Type())
+	require.Equal("row_fingerprint(a, b)", fingerprint.String())
+
+	expected, err := sql.CanonicalRowHash(ctx, sql.NewRow(int64(1), "x"))
+	require.NoError(err)
+	v, err := hash.Eval(ctx, sql.NewRow(int8(1), []byte("x")))
+	require.NoError(err)
+	require.Equal(expected, v)
+
+	v, err = fingerprint.Eval(ctx, sql.NewRow(nil, "x"))
+	require.NoError(err)
+	require.Len(v, 64)
+	other, err := fingerprint.Eval(ctx, sql.NewRow("", "x"))
+	require.NoError(err)
+	require.NotEqual(v, other)
+}
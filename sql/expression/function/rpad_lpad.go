@@ -84,7 +84,7 @@ func (p *Pad) Resolved() bool {
 
 // IsNullable implements the Expression interface.
 func (p *Pad) IsNullable() bool {
-	return p.str.IsNullable() || p.length.IsNullable() || p.padStr.IsNullable()
+	return true
 }
 
 // Type implements the Expression interface.
@@ -152,29 +152,34 @@ func (p *Pad) Eval(
 	return padString(str.(string), length.(int64), padStr.(string), p.padType)
 }
 
-func padString(str string, length int64, padStr string, padType padType) (string, error) {
-	if length <= 0 {
-		return "", nil
+// padString pads the string given with padStr up to length characters, or truncates it to length characters if it's
+// longer. Lengths are counted in characters, not bytes. The result is NULL if the length is negative or if the string
+// needs padding but padStr is empty.
+func padString(str string, length int64, padStr string, padType padType) (interface{}, error) {
+	if length < 0 {
+		return nil, nil
 	}
-	if int64(len(str)) >= length {
-		return str[:length], nil
+
+	chars := []rune(str)
+	if int64(len(chars)) >= length {
+		return string(chars[:length]), nil
 	}
-	if len(padStr) == 0 {
-		return "", nil
+
+	pad := []rune(padStr)
+	if len(pad) == 0 {
+		return nil, nil
 	}
 
-	padLen := int(length - int64(len(str)))
-	quo, rem, err := divmod(int64(padLen), int64(len(padStr)))
+	quo, rem, err := divmod(length-int64(len(chars)), int64(len(pad)))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
+	padding := strings.Repeat(padStr, int(quo)) + string(pad[:rem])
 	if padType == lPadType {
-		result := strings.Repeat(padStr, int(quo)) + padStr[:rem] + str
-		return result[:length], nil
+		return padding + str, nil
 	}
-	result := str + strings.Repeat(padStr, int(quo)) + padStr[:rem]
-	return result[(int64(len(result)) - length):], nil
+	return str + padding, nil
 }
 
 func divmod(a, b int64) (quotient, remainder int64, err error) {
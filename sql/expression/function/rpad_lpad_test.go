@@ -41,12 +41,12 @@ func TestLPad(t *testing.T) {
 		{"null len", sql.NewRow("foo", nil, "bar"), nil, false},
 		{"null padStr", sql.NewRow("foo", 1, nil), nil, false},
 
-		{"negative length", sql.NewRow("foo", -1, "bar"), "", false},
+		{"negative length", sql.NewRow("foo", -1, "bar"), nil, false},
 		{"length 0", sql.NewRow("foo", 0, "bar"), "", false},
 		{"invalid length", sql.NewRow("foo", "a", "bar"), "", true},
 
 		{"empty padStr and len < len(str)", sql.NewRow("foo", 1, ""), "f", false},
-		{"empty padStr and len > len(str)", sql.NewRow("foo", 4, ""), "", false},
+		{"empty padStr and len > len(str)", sql.NewRow("foo", 4, ""), nil, false},
 		{"empty padStr and len == len(str)", sql.NewRow("foo", 3, ""), "foo", false},
 
 		{"non empty padStr and len < len(str)", sql.NewRow("foo", 1, "abcd"), "f", false},
@@ -55,6 +55,9 @@ func TestLPad(t *testing.T) {
 		{"padStr repeats exactly once", sql.NewRow("foo", 6, "abc"), "abcfoo", false},
 		{"padStr does not repeat once", sql.NewRow("foo", 5, "abc"), "abfoo", false},
 		{"padStr repeats many times", sql.NewRow("foo", 10, "abc"), "abcabcafoo", false},
+
+		{"multibyte string truncated", sql.NewRow("日本語", 2, "abc"), "日本", false},
+		{"multibyte padStr", sql.NewRow("foo", 6, "日本"), "日本日foo", false},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -91,12 +94,12 @@ func TestRPad(t *testing.T) {
 		{"null len", sql.NewRow("foo", nil, "bar"), nil, false},
 		{"null padStr", sql.NewRow("foo", 1, nil), nil, false},
 
-		{"negative length", sql.NewRow("foo", -1, "bar"), "", false},
+		{"negative length", sql.NewRow("foo", -1, "bar"), nil, false},
 		{"length 0", sql.NewRow("foo", 0, "bar"), "", false},
 		{"invalid length", sql.NewRow("foo", "a", "bar"), "", true},
 
 		{"empty padStr and len < len(str)", sql.NewRow("foo", 1, ""), "f", false},
-		{"empty padStr and len > len(str)", sql.NewRow("foo", 4, ""), "", false},
+		{"empty padStr and len > len(str)", sql.NewRow("foo", 4, ""), nil, false},
 		{"empty padStr and len == len(str)", sql.NewRow("foo", 3, ""), "foo", false},
 
 		{"non empty padStr and len < len(str)", sql.NewRow("foo", 1, "abcd"), "f", false},
@@ -105,6 +108,9 @@ func TestRPad(t *testing.T) {
 		{"padStr repeats exactly once", sql.NewRow("foo", 6, "abc"), "fooabc", false},
 		{"padStr does not repeat once", sql.NewRow("foo", 5, "abc"), "fooab", false},
 		{"padStr repeats many times", sql.NewRow("foo", 10, "abc"), "fooabcabca", false},
+
+		{"multibyte string truncated", sql.NewRow("日本語", 2, "abc"), "日本", false},
+		{"multibyte padStr", sql.NewRow("foo", 6, "日本"), "foo日本日", false},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
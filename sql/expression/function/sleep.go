@@ -15,7 +15,6 @@
 package function
 
 import (
-	"context"
 	"fmt"
 	"time"
 
@@ -31,12 +30,19 @@ type Sleep struct {
 }
 
 var _ sql.FunctionExpression = (*Sleep)(nil)
+var _ sql.NonDeterministicExpression = (*Sleep)(nil)
 
 // NewSleep creates a new Sleep expression.
 func NewSleep(e sql.Expression) sql.Expression {
 	return &Sleep{expression.UnaryExpression{Child: e}}
 }
 
+// IsNonDeterministic implements sql.NonDeterministicExpression. Calls must not be evaluated only once or cached,
+// since waiting is the point of the function.
+func (s *Sleep) IsNonDeterministic() bool {
+	return true
+}
+
 // FunctionName implements sql.FunctionExpression
 func (s *Sleep) FunctionName() string {
 	return "sleep"
@@ -64,7 +70,8 @@ func (s *Sleep) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	select {
 	case <-ctx.Done():
-		return 0, context.Canceled
+		// Killed queries and queries exceeding their execution time are interrupted
+		return 0, ctx.Err()
 	case <-t.C:
 		return 0, nil
 	}
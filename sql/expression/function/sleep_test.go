@@ -15,6 +15,7 @@
 package function
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -63,3 +64,16 @@ func TestSleep(t *testing.T) {
 		})
 	}
 }
+
+func TestSleepCanceled(t *testing.T) {
+	require := require.New(t)
+	f := NewSleep(expression.NewLiteral(int64(100), sql.Int64))
+
+	c, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	t1 := time.Now()
+	_, err := f.Eval(sql.NewContext(c), nil)
+	require.Equal(context.Canceled, err)
+	require.Less(time.Since(t1).Seconds(), 1.0)
+}
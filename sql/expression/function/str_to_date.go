@@ -57,17 +57,18 @@ func (s StringToDatetime) Eval(ctx *sql.Context, row sql.Row) (interface{}, erro
 	if err != nil {
 		return nil, err
 	}
-	dateStr, ok := date.(string)
-	if !ok {
-		// TODO: improve this error
+	if date == nil || format == nil {
+		return nil, nil
+	}
+	dateStr, err := sql.LongText.Convert(date)
+	if err != nil {
 		return nil, sql.ErrInvalidType.New(fmt.Sprintf("%T", date))
 	}
-	formatStr, ok := format.(string)
-	if !ok {
-		// TODO: improve this error
-		return nil, sql.ErrInvalidType.New(fmt.Sprintf("%T", formatStr))
+	formatStr, err := sql.LongText.Convert(format)
+	if err != nil {
+		return nil, sql.ErrInvalidType.New(fmt.Sprintf("%T", format))
 	}
-	goTime, err := dateparse.ParseDateWithFormat(dateStr, formatStr)
+	goTime, err := dateparse.ParseDateWithFormat(dateStr.(string), formatStr.(string))
 	if err != nil {
 		return sql.Null, nil
 	}
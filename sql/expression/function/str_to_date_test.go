@@ -15,11 +15,13 @@ func TestStrToDate(t *testing.T) {
 
 	testCases := [...]struct {
 		name     string
-		dateStr  string
+		dateStr  interface{}
 		fmtStr   string
 		expected string
 	}{
 		{"standard", "Dec 26, 2000 2:13:15", "%b %e, %Y %T", "2000-12-26 02:13:15 -0600 CST"},
+		{"week", "200442 Monday", "%X%V %W", "2004-10-18 00:00:00 -0500 CDT"},
+		{"numeric", 20211016, "%Y%m%d", "2021-10-16 00:00:00 -0500 CDT"},
 	}
 
 	for _, tt := range testCases {
@@ -67,6 +69,17 @@ func TestStrToDateFailure(t *testing.T) {
 	}
 }
 
+func TestStrToDateNull(t *testing.T) {
+	f, err := NewStrToDate(
+		expression.NewGetField(0, sql.Text, "", true),
+		expression.NewGetField(1, sql.Text, "", true),
+	)
+	require.NoError(t, err)
+
+	require.Nil(t, eval(t, f, sql.NewRow(nil, "%Y")))
+	require.Nil(t, eval(t, f, sql.NewRow("2021", nil)))
+}
+
 func setupTimezone(t *testing.T) {
 	loc, err := time.LoadLocation("America/Chicago")
 	if err != nil {
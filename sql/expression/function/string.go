@@ -386,3 +386,63 @@ func (h *Bitlength) WithChildren(children ...sql.Expression) (sql.Expression, er
 	}
 	return NewBitlength(children[0]), nil
 }
+
+// Quote implements the sql function "quote" which returns a string quoted to be used as a string literal
+type Quote struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Quote)(nil)
+
+func NewQuote(arg sql.Expression) sql.Expression {
+	return &Quote{NewUnaryFunc(arg, "QUOTE", sql.LongText)}
+}
+
+// IsNullable implements the sql.Expression interface
+func (q *Quote) IsNullable() bool {
+	return false
+}
+
+// Eval implements the sql.Expression interface. The result is the word NULL, without quotes, if the argument is NULL.
+func (q *Quote) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := q.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if arg == nil {
+		return "NULL", nil
+	}
+
+	val, err := sql.LongText.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	str := val.(string)
+	var b strings.Builder
+	b.WriteByte('\'')
+	for i := 0; i < len(str); i++ {
+		switch c := str[i]; c {
+		case '\\', '\'':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case 0:
+			b.WriteString(`\0`)
+		case 26:
+			b.WriteString(`\Z`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('\'')
+	return b.String(), nil
+}
+
+// WithChildren implements the sql.Expression interface
+func (q *Quote) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(q, len(children), 1)
+	}
+	return NewQuote(children[0]), nil
+}
@@ -145,3 +145,15 @@ func TestBitLength(t *testing.T) {
 	tf.AddSucceeding(128, time.Now())
 	tf.Test(t, nil, nil)
 }
+
+func TestQuoteFunc(t *testing.T) {
+	f := sql.Function1{Name: "quote", Fn: NewQuote}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding("NULL", nil)
+	tf.AddSucceeding("'Don\\'t!'", "Don't!")
+	tf.AddSucceeding("'a\\\\b'", "a\\b")
+	tf.AddSucceeding("'\\0\\Z'", "\x00\x1a")
+	tf.AddSucceeding("'日本語'", "日本語")
+	tf.AddSignedVariations("'5'", 5)
+	tf.Test(t, nil, nil)
+}
@@ -791,7 +791,7 @@ func (n *Now) Children() []sql.Expression { return nil }
 
 // Eval implements the sql.Expression interface.
 func (n *Now) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	t := ctx.QueryTime()
+	t := sessionNow(ctx)
 	// TODO: Now should return a string formatted depending on context.  This code handles string formatting
 	// and should be enabled at the time we fix the return type
 	/*s, err := formatDate("%Y-%m-%d %H:%i:%s", t)
@@ -988,7 +988,7 @@ func (d *DayName) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	t := val.(time.Time)
-	return t.Weekday().String(), nil
+	return sql.TimeLocale(ctx).DayName(t), nil
 }
 
 func (d *DayName) WithChildren(children ...sql.Expression) (sql.Expression, error) {
@@ -1044,7 +1044,7 @@ func (d *MonthName) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	t := val.(time.Time)
-	return t.Month().String(), nil
+	return sql.TimeLocale(ctx).MonthName(t), nil
 }
 
 func (d *MonthName) WithChildren(children ...sql.Expression) (sql.Expression, error) {
@@ -1111,6 +1111,39 @@ func (m *WeekOfYear) WithChildren(children ...sql.Expression) (sql.Expression, e
 	return NewWeekOfYear(children[0]), nil
 }
 
+// LastDay implements the LAST_DAY function, which returns the date of the last day of the month of a date.
+type LastDay struct {
+	*UnaryDatetimeFunc
+}
+
+var _ sql.FunctionExpression = (*LastDay)(nil)
+
+func NewLastDay(arg sql.Expression) sql.Expression {
+	return &LastDay{NewUnaryDatetimeFunc(arg, "LAST_DAY", sql.Date)}
+}
+
+func (l *LastDay) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := l.Child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	// Like MySQL, values that aren't valid dates have no last day.
+	t, err := sql.Datetime.ConvertWithoutRangeCheck(val)
+	if err != nil {
+		return nil, nil
+	}
+
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC), nil
+}
+
+func (l *LastDay) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), 1)
+	}
+	return NewLastDay(children[0]), nil
+}
+
 // TimeDiff subtracts the second argument from the first expressed as a time value.
 type TimeDiff struct {
 	expression.BinaryExpression
@@ -1208,7 +1241,7 @@ func NewCurrentTime() sql.Expression {
 }
 
 func currTimeLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	t := ctx.QueryTime()
+	t := sessionNow(ctx)
 	return fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second()), nil
 }
 
@@ -1235,7 +1268,13 @@ func NewCurrTimestamp() sql.Expression {
 }
 
 func currDatetimeLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	return ctx.QueryTime(), nil
+	return sessionNow(ctx), nil
+}
+
+// sessionNow returns the time of the query as the wall clock time in the time zone of the session, in UTC like all
+// datetime values.
+func sessionNow(ctx *sql.Context) time.Time {
+	return sql.TimestampToTimeZone(ctx.QueryTime(), sql.SessionTimeZone(ctx))
 }
 
 // Eval implements sql.Expression
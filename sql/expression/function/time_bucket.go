@@ -0,0 +1,225 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// defaultBucketOrigin is the origin used to align buckets when none is given. Like TimescaleDB, it is a Monday so
+// that weekly buckets start on Mondays.
+var defaultBucketOrigin = time.Date(2000, time.January, 3, 0, 0, 0, 0, time.UTC)
+
+// calendarBucketOrigin is the default origin for buckets measured in months or years.
+var calendarBucketOrigin = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// TimeBucket rounds a datetime down to the start of the fixed-width bucket that contains it, e.g.
+// TIME_BUCKET(INTERVAL 15 MINUTE, ts). It's meant to be used as a grouping key for time series queries.
+type TimeBucket struct {
+	Width  *expression.Interval
+	Date   sql.Expression
+	Origin sql.Expression
+}
+
+var _ sql.FunctionExpression = (*TimeBucket)(nil)
+var _ sql.MonotonicExpression = (*TimeBucket)(nil)
+
+// NewTimeBucket creates a new TIME_BUCKET function. It takes a bucket width as an interval, the datetime to bucket
+// and, optionally, an origin datetime the buckets are aligned to.
+func NewTimeBucket(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New("TIME_BUCKET", "2 or 3", len(args))
+	}
+
+	width, ok := args[0].(*expression.Interval)
+	if !ok {
+		return nil, fmt.Errorf("TIME_BUCKET expects an interval as first parameter")
+	}
+
+	tb := &TimeBucket{Width: width, Date: args[1]}
+	if len(args) == 3 {
+		tb.Origin = args[2]
+	}
+
+	return tb, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (t *TimeBucket) FunctionName() string {
+	return "time_bucket"
+}
+
+// Children implements the sql.Expression interface.
+func (t *TimeBucket) Children() []sql.Expression {
+	if t.Origin != nil {
+		return []sql.Expression{t.Width, t.Date, t.Origin}
+	}
+	return []sql.Expression{t.Width, t.Date}
+}
+
+// Resolved implements the sql.Expression interface.
+func (t *TimeBucket) Resolved() bool {
+	for _, child := range t.Children() {
+		if !child.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNullable implements the sql.Expression interface.
+func (t *TimeBucket) IsNullable() bool {
+	return true
+}
+
+// Type implements the sql.Expression interface.
+func (t *TimeBucket) Type() sql.Type { return sql.Datetime }
+
+// MonotonicArgument implements the sql.MonotonicExpression interface. Buckets of a constant width and origin are
+// monotonic in the datetime they bucket, so rows sorted on it come sorted on their buckets.
+func (t *TimeBucket) MonotonicArgument() sql.Expression {
+	if _, ok := t.Width.Child.(*expression.Literal); !ok {
+		return nil
+	}
+	if t.Origin != nil {
+		if _, ok := t.Origin.(*expression.Literal); !ok {
+			return nil
+		}
+	}
+	if !sql.IsTime(t.Date.Type()) {
+		return nil
+	}
+	return t.Date
+}
+
+// WithChildren implements the Expression interface.
+func (t *TimeBucket) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewTimeBucket(children...)
+}
+
+// Eval implements the sql.Expression interface.
+func (t *TimeBucket) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	delta, err := t.Width.EvalDelta(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if delta == nil {
+		return nil, nil
+	}
+
+	val, err := t.Date.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+
+	date, err := sql.Datetime.ConvertWithoutRangeCheck(val)
+	if err != nil {
+		return nil, err
+	}
+
+	months := delta.Years*12 + delta.Months
+	fixed := time.Duration(delta.Days)*24*time.Hour +
+		time.Duration(delta.Hours)*time.Hour +
+		time.Duration(delta.Minutes)*time.Minute +
+		time.Duration(delta.Seconds)*time.Second +
+		time.Duration(delta.Microseconds)*time.Microsecond
+
+	if months != 0 && fixed != 0 {
+		return nil, ErrInvalidArgument.New("time_bucket", "bucket width cannot mix months or years with smaller units")
+	}
+	if months < 0 || fixed < 0 || (months == 0 && fixed == 0) {
+		return nil, ErrInvalidArgument.New("time_bucket", "bucket width must be positive")
+	}
+
+	origin := defaultBucketOrigin
+	if months != 0 {
+		origin = calendarBucketOrigin
+	}
+
+	if t.Origin != nil {
+		val, err := t.Origin.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+
+		origin, err = sql.Datetime.ConvertWithoutRangeCheck(val)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if months != 0 {
+		return sql.ValidateTime(monthBucket(date, origin, months)), nil
+	}
+
+	return sql.ValidateTime(fixedBucket(date, origin, fixed)), nil
+}
+
+// monthBucket returns the start of the bucket of |width| months containing |date|, with buckets aligned to |origin|.
+func monthBucket(date, origin time.Time, width int64) time.Time {
+	elapsed := int64(date.Year()-origin.Year())*12 + int64(date.Month()-origin.Month())
+	start := origin.AddDate(0, int(floorDiv(elapsed, width)*width), 0)
+
+	// Dates earlier in the month than the origin belong to the previous bucket
+	if start.After(date) {
+		start = origin.AddDate(0, int((floorDiv(elapsed, width)-1)*width), 0)
+	}
+
+	return start
+}
+
+// fixedBucket returns the start of the bucket of |width| containing |date|, with buckets aligned to |origin|. The
+// arithmetic is done in seconds and nanoseconds so that dates far from the origin don't overflow a time.Duration.
+func fixedBucket(date, origin time.Time, width time.Duration) time.Time {
+	if width%time.Second == 0 {
+		secs := int64(width / time.Second)
+		elapsed := date.Unix() - origin.Unix()
+		if date.Nanosecond() < origin.Nanosecond() {
+			elapsed--
+		}
+		return time.Unix(origin.Unix()+floorDiv(elapsed, secs)*secs, int64(origin.Nanosecond())).In(origin.Location())
+	}
+
+	elapsed := date.Sub(origin)
+	return origin.Add(time.Duration(floorDiv(int64(elapsed), int64(width)) * int64(width)))
+}
+
+// floorDiv divides a by b, rounding towards negative infinity. b must be positive.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
+
+func (t *TimeBucket) String() string {
+	args := make([]string, len(t.Children()))
+	for i, child := range t.Children() {
+		args[i] = child.String()
+	}
+	return fmt.Sprintf("TIME_BUCKET(%s)", strings.Join(args, ", "))
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestTimeBucket(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	_, err := NewTimeBucket(expression.NewLiteral("2021-05-02", sql.LongText))
+	require.Error(t, err)
+
+	_, err = NewTimeBucket(
+		expression.NewLiteral(int64(1), sql.Int64),
+		expression.NewLiteral("2021-05-02", sql.LongText),
+	)
+	require.Error(t, err)
+
+	testCases := []struct {
+		name     string
+		width    int64
+		unit     string
+		date     interface{}
+		origin   interface{}
+		expected interface{}
+		err      bool
+	}{
+		{"15 minutes", 15, "MINUTE", "2021-05-02 10:37:12", nil, time.Date(2021, time.May, 2, 10, 30, 0, 0, time.UTC), false},
+		{"on the boundary", 15, "MINUTE", "2021-05-02 10:45:00", nil, time.Date(2021, time.May, 2, 10, 45, 0, 0, time.UTC), false},
+		{"1 hour", 1, "HOUR", "2021-05-02 10:37:12", nil, time.Date(2021, time.May, 2, 10, 0, 0, 0, time.UTC), false},
+		{"1 week starts on monday", 1, "WEEK", "2021-05-02 10:37:12", nil, time.Date(2021, time.April, 26, 0, 0, 0, 0, time.UTC), false},
+		{"before the origin", 1, "DAY", "1999-12-31 23:00:00", nil, time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC), false},
+		{"3 months", 3, "MONTH", "2021-05-02 10:37:12", nil, time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC), false},
+		{"1 year", 1, "YEAR", "2021-05-02 10:37:12", nil, time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), false},
+		{"with origin", 1, "HOUR", "2021-05-02 10:37:12", "2021-01-01 00:30:00", time.Date(2021, time.May, 2, 10, 30, 0, 0, time.UTC), false},
+		{"month with origin", 1, "MONTH", "2021-05-02 10:37:12", "2021-01-15 00:00:00", time.Date(2021, time.April, 15, 0, 0, 0, 0, time.UTC), false},
+		{"null date", 1, "HOUR", nil, nil, nil, false},
+		{"zero width", 0, "HOUR", "2021-05-02 10:37:12", nil, nil, true},
+		{"negative width", -1, "HOUR", "2021-05-02 10:37:12", nil, nil, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			args := []sql.Expression{
+				expression.NewInterval(expression.NewLiteral(tt.width, sql.Int64), tt.unit),
+				expression.NewLiteral(tt.date, sql.LongText),
+			}
+			if tt.origin != nil {
+				args = append(args, expression.NewLiteral(tt.origin, sql.LongText))
+			}
+
+			f, err := NewTimeBucket(args...)
+			require.NoError(err)
+
+			result, err := f.Eval(ctx, nil)
+			if tt.err {
+				require.Error(err)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
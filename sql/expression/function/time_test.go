@@ -363,6 +363,9 @@ func TestNow(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// The default time zone of the session is the one of the server, which date is in.
+	wallClock := time.Date(2018, time.December, 2, 16, 25, 0, 0, time.UTC)
+
 	tests := []struct {
 		args      []sql.Expression
 		result    time.Time
@@ -370,22 +373,22 @@ func TestNow(t *testing.T) {
 	}{
 		{
 			args:      nil,
-			result:    date,
+			result:    wallClock,
 			expectErr: false,
 		},
 		{
 			args:      []sql.Expression{expression.NewLiteral(0, sql.Int8)},
-			result:    date,
+			result:    wallClock,
 			expectErr: false,
 		},
 		{
 			args:      []sql.Expression{expression.NewLiteral(0, sql.Int64)},
-			result:    date,
+			result:    wallClock,
 			expectErr: false,
 		},
 		{
 			args:      []sql.Expression{expression.NewLiteral(6, sql.Uint8)},
-			result:    date,
+			result:    wallClock,
 			expectErr: false,
 		},
 		{
@@ -520,6 +523,71 @@ func TestDate(t *testing.T) {
 	}
 }
 
+func TestLastDay(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	f := NewLastDay(expression.NewGetField(0, sql.LongText, "foo", true))
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"null date", sql.NewRow(nil), nil},
+		{"invalid date", sql.NewRow("2003-03-32"), nil},
+		{"leap year", sql.NewRow("2004-02-05"), time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"datetime", sql.NewRow("2003-02-05 12:30:00"), time.Date(2003, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"end of year", sql.NewRow(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)), time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			val, err := f.Eval(ctx, tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
+
+func TestLocalizedDateNames(t *testing.T) {
+	date := expression.NewGetField(0, sql.LongText, "foo", true)
+	row := sql.NewRow("2021-03-07 10:00:00")
+
+	testCases := []struct {
+		locale     string
+		dayName    string
+		monthName  string
+		dateFormat string
+	}{
+		{"en_US", "Sunday", "March", "Sun 7 Mar"},
+		{"de_DE", "Sonntag", "März", "So 7 Mär"},
+		{"es_es", "domingo", "marzo", "dom 7 mar"},
+		{"ja_JP", "日曜日", "3月", "日 7 3月"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.locale, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			require.NoError(ctx.SetSessionVariable(ctx, "lc_time_names", tt.locale))
+
+			val, err := NewDayName(date).Eval(ctx, row)
+			require.NoError(err)
+			require.Equal(tt.dayName, val)
+
+			val, err = NewMonthName(date).Eval(ctx, row)
+			require.NoError(err)
+			require.Equal(tt.monthName, val)
+
+			val, err = NewDateFormat(date, expression.NewLiteral("%a %e %b", sql.LongText)).Eval(ctx, row)
+			require.NoError(err)
+			require.Equal(tt.dateFormat, val)
+		})
+	}
+
+	ctx := sql.NewEmptyContext()
+	require.Error(t, ctx.SetSessionVariable(ctx, "lc_time_names", "xx_XX"))
+}
+
 func TestTimeDiff(t *testing.T) {
 	ctx := sql.NewEmptyContext()
 	testCases := []struct {
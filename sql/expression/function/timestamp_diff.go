@@ -0,0 +1,166 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// TimestampDiff implements the TIMESTAMPDIFF function, which returns the number of whole units between two dates or
+// datetimes.
+type TimestampDiff struct {
+	unit  sql.Expression
+	start sql.Expression
+	end   sql.Expression
+}
+
+var _ sql.FunctionExpression = (*TimestampDiff)(nil)
+
+// NewTimestampDiff creates a new TIMESTAMPDIFF function, which returns end - start in the unit given.
+func NewTimestampDiff(unit, start, end sql.Expression) sql.Expression {
+	return &TimestampDiff{unit: unit, start: start, end: end}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (t *TimestampDiff) FunctionName() string {
+	return "timestampdiff"
+}
+
+// Children implements the sql.Expression interface.
+func (t *TimestampDiff) Children() []sql.Expression {
+	return []sql.Expression{t.unit, t.start, t.end}
+}
+
+// Resolved implements the sql.Expression interface.
+func (t *TimestampDiff) Resolved() bool {
+	return t.unit.Resolved() && t.start.Resolved() && t.end.Resolved()
+}
+
+// IsNullable implements the sql.Expression interface.
+func (t *TimestampDiff) IsNullable() bool {
+	return t.start.IsNullable() || t.end.IsNullable()
+}
+
+// Type implements the sql.Expression interface.
+func (t *TimestampDiff) Type() sql.Type { return sql.Int64 }
+
+// WithChildren implements the sql.Expression interface.
+func (t *TimestampDiff) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 3 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 3)
+	}
+	return NewTimestampDiff(children[0], children[1], children[2]), nil
+}
+
+func (t *TimestampDiff) String() string {
+	return fmt.Sprintf("TIMESTAMPDIFF(%s, %s, %s)", t.unit, t.start, t.end)
+}
+
+// Eval implements the sql.Expression interface.
+func (t *TimestampDiff) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	unit, err := evalTimeUnit(ctx, "TIMESTAMPDIFF", t.unit, row)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := evalDatetime(ctx, t.start, row)
+	if err != nil || start == nil {
+		return nil, err
+	}
+
+	end, err := evalDatetime(ctx, t.end, row)
+	if err != nil || end == nil {
+		return nil, err
+	}
+
+	return timestampDiff(unit, start.(time.Time), end.(time.Time))
+}
+
+// timestampDiff returns the number of whole units of the unit given between start and end, which is negative when
+// end is before start.
+func timestampDiff(unit string, start, end time.Time) (int64, error) {
+	diff := end.Sub(start)
+	switch unit {
+	case "MICROSECOND":
+		return int64(diff / time.Microsecond), nil
+	case "SECOND":
+		return int64(diff / time.Second), nil
+	case "MINUTE":
+		return int64(diff / time.Minute), nil
+	case "HOUR":
+		return int64(diff / time.Hour), nil
+	case "DAY":
+		return int64(diff / (24 * time.Hour)), nil
+	case "WEEK":
+		return int64(diff / (7 * 24 * time.Hour)), nil
+	case "MONTH":
+		return monthsBetween(start, end), nil
+	case "QUARTER":
+		return monthsBetween(start, end) / 3, nil
+	case "YEAR":
+		return monthsBetween(start, end) / 12, nil
+	default:
+		return 0, ErrInvalidArgument.New("TIMESTAMPDIFF", fmt.Sprintf("invalid unit %s", unit))
+	}
+}
+
+// monthsBetween returns the number of whole months between start and end. A month is only complete once the day of
+// the month and the time of start are reached.
+func monthsBetween(start, end time.Time) int64 {
+	months := int64(end.Year()-start.Year())*12 + int64(end.Month()-start.Month())
+
+	startRest := timeInMonth(start)
+	endRest := timeInMonth(end)
+	if months > 0 && endRest < startRest {
+		months--
+	} else if months < 0 && endRest > startRest {
+		months++
+	}
+	return months
+}
+
+// timeInMonth returns the time elapsed since the beginning of the month of the time given.
+func timeInMonth(t time.Time) time.Duration {
+	return time.Duration(t.Day()-1)*24*time.Hour + time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second +
+		time.Duration(t.Nanosecond())
+}
+
+// evalTimeUnit evaluates the unit argument of the function given, such as DAY or MONTH, returning it in upper case.
+func evalTimeUnit(ctx *sql.Context, name string, e sql.Expression, row sql.Row) (string, error) {
+	unit, err := e.Eval(ctx, row)
+	if err != nil {
+		return "", err
+	}
+
+	unitStr, ok := unit.(string)
+	if !ok {
+		return "", ErrInvalidArgument.New(name, fmt.Sprintf("invalid unit %v", unit))
+	}
+	return strings.ToUpper(unitStr), nil
+}
+
+// evalDatetime evaluates the expression given as a datetime, returning nil for NULL values.
+func evalDatetime(ctx *sql.Context, e sql.Expression, row sql.Row) (interface{}, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	return sql.Datetime.ConvertWithoutRangeCheck(val)
+}
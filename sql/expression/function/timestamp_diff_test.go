@@ -0,0 +1,67 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestTimestampDiff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		unit     string
+		start    interface{}
+		end      interface{}
+		expected interface{}
+		err      bool
+	}{
+		{"months", "MONTH", "2003-02-01", "2003-05-01", int64(3), false},
+		{"incomplete month", "month", "2003-01-31 12:00:00", "2003-02-28 12:00:00", int64(0), false},
+		{"months backwards", "MONTH", "2021-03-15", "2021-01-20", int64(-1), false},
+		{"years", "YEAR", "2002-05-01", "2001-01-01", int64(-1), false},
+		{"quarters", "QUARTER", "2020-01-01", "2020-12-31", int64(3), false},
+		{"minutes", "MINUTE", "2003-02-01", "2003-05-01 12:05:55", int64(128885), false},
+		{"weeks", "WEEK", "2021-10-01", "2021-10-16", int64(2), false},
+		{"days", "DAY", "2021-10-16 23:00:00", "2021-10-15 00:00:00", int64(-1), false},
+		{"seconds", "SECOND", time.Date(2021, 10, 16, 0, 0, 0, 0, time.UTC), "2021-10-16 00:01:01", int64(61), false},
+		{"microseconds", "MICROSECOND", "2021-10-16 00:00:00", "2021-10-16 00:00:01.5", int64(1500000), false},
+		{"null", "DAY", nil, "2021-10-16", nil, false},
+		{"invalid unit", "FORTNIGHT", "2021-10-16", "2021-10-16", nil, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewTimestampDiff(
+				expression.NewLiteral(tt.unit, sql.LongText),
+				expression.NewLiteral(tt.start, sql.LongText),
+				expression.NewLiteral(tt.end, sql.LongText),
+			)
+
+			result, err := f.Eval(sql.NewEmptyContext(), nil)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
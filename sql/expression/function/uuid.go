@@ -16,6 +16,8 @@ package function
 
 import (
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -54,6 +56,7 @@ import (
 type UUIDFunc struct{}
 
 var _ sql.FunctionExpression = &UUIDFunc{}
+var _ sql.NonDeterministicExpression = UUIDFunc{}
 
 func NewUUIDFunc() sql.Expression {
 	return UUIDFunc{}
@@ -63,6 +66,11 @@ func (u UUIDFunc) String() string {
 	return "UUID()"
 }
 
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (u UUIDFunc) IsNonDeterministic() bool {
+	return true
+}
+
 func (u UUIDFunc) Type() sql.Type {
 	return sql.MustCreateStringWithDefaults(sqltypes.VarChar, 36)
 }
@@ -431,7 +439,7 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// If the swap flag is 0 we can return uuid's string format as is.
-	if sf.(int8) == 0 {
+	if sf == nil || sf.(int8) == 0 {
 		return parsed.String(), nil
 	} else if sf.(int8) == 1 {
 		encoding := unswapUUIDBytes(parsed)
@@ -484,3 +492,74 @@ func (bu BinToUUID) Children() []sql.Expression {
 func (bu BinToUUID) IsNullable() bool {
 	return false
 }
+
+// UUID_SHORT()
+//
+// Returns a “short” universal identifier as a 64-bit unsigned integer. Values returned by UUID_SHORT() differ from the
+// string-format 128-bit identifiers returned by the UUID() function and have different uniqueness properties. The
+// value of UUID_SHORT() is guaranteed to be unique if the server is not restarted more than 256 times per second and
+// fewer than 16 million values are generated between restarts.
+//
+// The UUID_SHORT() return value is constructed this way:
+//
+//   (server_id & 255) << 56
+// + (server_startup_time_in_seconds << 24)
+// + incremented_variable_by_one;
+//
+// The engine has no server ID, so its part of the value is always 0.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_uuid-short
+
+type UUIDShort struct{}
+
+var _ sql.FunctionExpression = UUIDShort{}
+var _ sql.NonDeterministicExpression = UUIDShort{}
+
+// uuidShortCounter is the last value returned by UUID_SHORT, which starts at the startup time of the process.
+var uuidShortCounter = uint64(time.Now().Unix()) << 24
+
+func NewUUIDShort() sql.Expression {
+	return UUIDShort{}
+}
+
+func (u UUIDShort) String() string {
+	return "UUID_SHORT()"
+}
+
+func (u UUIDShort) Type() sql.Type {
+	return sql.Uint64
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (u UUIDShort) IsNonDeterministic() bool {
+	return true
+}
+
+func (u UUIDShort) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return atomic.AddUint64(&uuidShortCounter, 1) & (1<<56 - 1), nil
+}
+
+func (u UUIDShort) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 0)
+	}
+
+	return UUIDShort{}, nil
+}
+
+func (u UUIDShort) FunctionName() string {
+	return "uuid_short"
+}
+
+func (u UUIDShort) Resolved() bool {
+	return true
+}
+
+// Children returns the children expressions of this expression.
+func (u UUIDShort) Children() []sql.Expression {
+	return nil
+}
+
+// IsNullable returns whether the expression can be null.
+func (u UUIDShort) IsNullable() bool {
+	return false
+}
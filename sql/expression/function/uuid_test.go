@@ -167,6 +167,7 @@ func TestBinToUUID(t *testing.T) {
 		{"valid uuid; swap=0", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), true, sql.Int8, int8(0), "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
 		{"valid uuid; swap=1", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("&ººlÍxd[e`$Û"), true, sql.Int8, int8(1), "ba6cc38d-bac2-26c2-7864-5b656024c39b"},
 		{"valid uuid; no swap", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), false, nil, nil, "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
+		{"valid uuid; swap=NULL", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), true, sql.Null, nil, "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
 		{"null input", sql.Null, nil, false, nil, nil, nil},
 	}
 
@@ -214,3 +215,16 @@ func TestBinToUUIDFailing(t *testing.T) {
 		})
 	}
 }
+
+func TestUUIDShort(t *testing.T) {
+	require := require.New(t)
+
+	f := NewUUIDShort()
+	require.True(f.(sql.NonDeterministicExpression).IsNonDeterministic())
+
+	first := eval(t, f, sql.Row{nil}).(uint64)
+	second := eval(t, f, sql.Row{nil}).(uint64)
+	require.Equal(first+1, second)
+	// The server ID part of the value is always 0.
+	require.Zero(second >> 56)
+}
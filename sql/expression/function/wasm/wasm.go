@@ -0,0 +1,436 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wasm implements user-defined functions compiled to WebAssembly, which end users create with CREATE FUNCTION
+// ... LANGUAGE WASM. Modules run sandboxed: they can't import anything, so they can only compute their results from
+// their arguments, each call runs in a new instance, and the instructions and memory of calls are bounded by the
+// function limits of the engine.
+//
+// Arguments and results are passed by type:
+//
+//	integers           i32 or i64, sign or zero extended as the SQL type is signed or not
+//	FLOAT and DOUBLE   f32 or f64
+//	strings and BLOBs  a pointer and a length, both i32, to a copy of the string in the memory of the module
+//
+// To receive strings, modules export their memory as "memory" and a function "alloc" taking a size and returning a
+// pointer to that many bytes. Strings are returned as an i64 with the pointer in its upper 32 bits and the length in its
+// lower 32 bits. NULL arguments make the result NULL without calling the module.
+package wasm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/internal/wasmvm"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var (
+	// ErrUnsupportedType is returned when a WebAssembly function takes or returns a type that can't be passed to it.
+	ErrUnsupportedType = errors.NewKind("WebAssembly function %s can't take or return values of type %s")
+
+	// ErrSignatureMismatch is returned when the function of a module doesn't match the parameters and return type of
+	// the WebAssembly function it implements.
+	ErrSignatureMismatch = errors.NewKind("WebAssembly function %s doesn't match the export %s of its module, of type %s")
+
+	// ErrBadString is returned when a WebAssembly function returns a string outside of the memory of its module.
+	ErrBadString = errors.NewKind("WebAssembly function %s returned a string out of the bounds of its memory")
+)
+
+const (
+	// memoryExport is the name of the memory modules taking or returning strings export.
+	memoryExport = "memory"
+	// allocExport is the name of the function modules taking strings export to allocate them.
+	allocExport = "alloc"
+	// maxCachedModules is the number of decoded modules kept, after which the cache is cleared.
+	maxCachedModules = 64
+)
+
+// Definition defines a WebAssembly function.
+type Definition struct {
+	// Name is the name the function is called with.
+	Name string
+	// ArgTypes are the types of the arguments of the function.
+	ArgTypes []sql.Type
+	// ReturnType is the type of the function.
+	ReturnType sql.Type
+	// Module is the binary WebAssembly module implementing the function.
+	Module []byte
+	// Export is the name of the function of the module implementing the function. If empty, it's the name of the
+	// function.
+	Export string
+}
+
+// kind is how values of a SQL type are passed to and from WebAssembly.
+type kind byte
+
+const (
+	kindSigned kind = iota
+	kindUnsigned
+	kindFloat
+	kindString
+)
+
+func kindOf(t sql.Type) (kind, bool) {
+	switch {
+	case sql.IsSigned(t):
+		return kindSigned, true
+	case sql.IsUnsigned(t):
+		return kindUnsigned, true
+	case sql.IsFloat(t):
+		return kindFloat, true
+	case sql.IsText(t):
+		return kindString, true
+	default:
+		return 0, false
+	}
+}
+
+// NewFunction returns the WebAssembly function defined, or an error if its module is invalid or doesn't match its
+// types.
+func NewFunction(def Definition) (sql.Function, error) {
+	if def.Export == "" {
+		def.Export = def.Name
+	}
+	m, err := decode(def.Module)
+	if err != nil {
+		return nil, err
+	}
+
+	typ, ok := m.Func(def.Export)
+	if !ok {
+		return nil, wasmvm.ErrNoFunction.New(def.Export)
+	}
+	f := &function{def: def, module: m, typ: typ}
+	if err := f.check(); err != nil {
+		return nil, err
+	}
+
+	return sql.FunctionN{
+		Name: def.Name,
+		Fn: func(args ...sql.Expression) (sql.Expression, error) {
+			if len(args) != len(def.ArgTypes) {
+				return nil, sql.ErrInvalidArgumentNumber.New(def.Name, len(def.ArgTypes), len(args))
+			}
+			return &Call{function: f, args: args}, nil
+		},
+	}, nil
+}
+
+var (
+	modulesMu sync.Mutex
+	modules   = make(map[[sha256.Size]byte]*wasmvm.Module)
+)
+
+// decode decodes the module given, or returns it from the cache of decoded modules. Functions are built from their
+// CREATE FUNCTION statements each time a query calls them, so this saves decoding their modules each time.
+func decode(bin []byte) (*wasmvm.Module, error) {
+	key := sha256.Sum256(bin)
+	modulesMu.Lock()
+	m, ok := modules[key]
+	modulesMu.Unlock()
+	if ok {
+		return m, nil
+	}
+
+	m, err := wasmvm.Decode(bin)
+	if err != nil {
+		return nil, err
+	}
+
+	modulesMu.Lock()
+	defer modulesMu.Unlock()
+	if len(modules) >= maxCachedModules {
+		modules = make(map[[sha256.Size]byte]*wasmvm.Module)
+	}
+	modules[key] = m
+	return m, nil
+}
+
+// function is a WebAssembly function, with the decoded module implementing it.
+type function struct {
+	def    Definition
+	module *wasmvm.Module
+	typ    wasmvm.FuncType
+	// ret is the kind of the return type, and args those of the arguments
+	ret  kind
+	args []kind
+	// wide holds whether each argument is passed as an i64 or f64 rather than an i32 or f32, and wideRet the same for
+	// the result
+	wide    []bool
+	wideRet bool
+}
+
+// check checks that the function of the module matches the types of the function, and works out how values are
+// passed to it.
+func (f *function) check() error {
+	mismatch := ErrSignatureMismatch.New(f.def.Name, f.def.Export, f.typ)
+	params := f.typ.Params
+	strings := false
+	for _, t := range f.def.ArgTypes {
+		k, ok := kindOf(t)
+		if !ok {
+			return ErrUnsupportedType.New(f.def.Name, t)
+		}
+		f.args = append(f.args, k)
+
+		if k == kindString {
+			if len(params) < 2 || params[0] != wasmvm.I32 || params[1] != wasmvm.I32 {
+				return mismatch
+			}
+			params = params[2:]
+			f.wide = append(f.wide, false)
+			strings = true
+			continue
+		}
+		if len(params) == 0 || !matches(k, params[0]) {
+			return mismatch
+		}
+		f.wide = append(f.wide, params[0] == wasmvm.I64 || params[0] == wasmvm.F64)
+		params = params[1:]
+	}
+	if len(params) > 0 {
+		return mismatch
+	}
+
+	k, ok := kindOf(f.def.ReturnType)
+	if !ok {
+		return ErrUnsupportedType.New(f.def.Name, f.def.ReturnType)
+	}
+	f.ret = k
+	if len(f.typ.Results) != 1 {
+		return mismatch
+	}
+	result := f.typ.Results[0]
+	if k == kindString && result != wasmvm.I64 || k != kindString && !matches(k, result) {
+		return mismatch
+	}
+	f.wideRet = result == wasmvm.I64 || result == wasmvm.F64
+
+	if k == kindString || strings {
+		if !f.module.HasMemory(memoryExport) {
+			return ErrSignatureMismatch.New(f.def.Name, memoryExport, "memory")
+		}
+	}
+	if strings {
+		alloc, ok := f.module.Func(allocExport)
+		if !ok || len(alloc.Params) != 1 || alloc.Params[0] != wasmvm.I32 || len(alloc.Results) != 1 || alloc.Results[0] != wasmvm.I32 {
+			return ErrSignatureMismatch.New(f.def.Name, allocExport, alloc)
+		}
+	}
+	return nil
+}
+
+// matches returns whether values of the kind given can be passed as values of the WebAssembly type given.
+func matches(k kind, t wasmvm.ValueType) bool {
+	if k == kindFloat {
+		return t == wasmvm.F32 || t == wasmvm.F64
+	}
+	return t == wasmvm.I32 || t == wasmvm.I64
+}
+
+// Call is a call of a WebAssembly function.
+type Call struct {
+	function *function
+	args     []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Call)(nil)
+
+// FunctionName implements sql.FunctionExpression
+func (c *Call) FunctionName() string {
+	return c.function.def.Name
+}
+
+// Resolved implements the sql.Expression interface.
+func (c *Call) Resolved() bool {
+	for _, arg := range c.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNullable implements the sql.Expression interface.
+func (c *Call) IsNullable() bool {
+	return true
+}
+
+// Type implements the sql.Expression interface.
+func (c *Call) Type() sql.Type {
+	return c.function.def.ReturnType
+}
+
+// Children implements the sql.Expression interface.
+func (c *Call) Children() []sql.Expression {
+	return c.args
+}
+
+// WithChildren implements the sql.Expression interface.
+func (c *Call) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(c.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), len(c.args))
+	}
+	return &Call{function: c.function, args: children}, nil
+}
+
+func (c *Call) String() string {
+	args := make([]string, len(c.args))
+	for i, arg := range c.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", c.function.def.Name, strings.Join(args, ", "))
+}
+
+// Eval implements the sql.Expression interface.
+func (c *Call) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	f := c.function
+	args := make([]interface{}, len(c.args))
+	for i, arg := range c.args {
+		v, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			return nil, nil
+		}
+		args[i], err = f.def.ArgTypes[i].Convert(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	limits := ctx.FunctionLimits
+	var vmLimits wasmvm.Limits
+	if limits != nil {
+		vmLimits.MaxInstructions = limits.MaxWASMInstructions
+		vmLimits.MaxMemoryPages = uint32(limits.MaxWASMMemory / wasmvm.PageSize)
+		if limits.MaxWASMMemory > 0 && vmLimits.MaxMemoryPages == 0 {
+			vmLimits.MaxMemoryPages = 1
+		}
+	}
+
+	result, err := f.call(vmLimits, args)
+	switch {
+	case wasmvm.ErrInstructionLimit.Is(err):
+		return nil, limits.WASMInstructionsExceeded(ctx, f.def.Name)
+	case wasmvm.ErrMemoryLimit.Is(err):
+		return nil, limits.WASMMemoryExceeded(ctx, f.def.Name)
+	case err != nil:
+		return nil, err
+	}
+	return f.def.ReturnType.Convert(result)
+}
+
+// bits returns the bits of the numeric argument given as the WebAssembly type of its parameter.
+func (f *function) bits(i int, v interface{}) uint64 {
+	switch v := v.(type) {
+	case int64:
+		if f.wide[i] {
+			return uint64(v)
+		}
+		return uint64(uint32(v))
+	case uint64:
+		if f.wide[i] {
+			return v
+		}
+		return uint64(uint32(v))
+	default:
+		if f.wide[i] {
+			return math.Float64bits(v.(float64))
+		}
+		return uint64(math.Float32bits(float32(v.(float64))))
+	}
+}
+
+// call calls the function in a new instance of its module, so that calls don't see what others left in the memory.
+func (f *function) call(limits wasmvm.Limits, args []interface{}) (interface{}, error) {
+	in, err := f.module.Instantiate(limits)
+	if err != nil {
+		return nil, err
+	}
+
+	var params []uint64
+	for i, arg := range args {
+		switch f.args[i] {
+		case kindSigned, kindUnsigned:
+			v, err := sql.Int64.Convert(arg)
+			if f.args[i] == kindUnsigned {
+				v, err = sql.Uint64.Convert(arg)
+			}
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, f.bits(i, v))
+		case kindFloat:
+			v, err := sql.Float64.Convert(arg)
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, f.bits(i, v))
+		case kindString:
+			s := arg.(string)
+			if uint64(len(s)) > math.MaxUint32 {
+				return nil, wasmvm.ErrTrap.New("string too long")
+			}
+			ptr, err := in.Call(allocExport, uint64(len(s)))
+			if err != nil {
+				return nil, err
+			}
+			mem := in.Memory()
+			if uint64(uint32(ptr[0]))+uint64(len(s)) > uint64(len(mem)) {
+				return nil, wasmvm.ErrTrap.New("alloc returned a pointer out of bounds")
+			}
+			copy(mem[uint32(ptr[0]):], s)
+			params = append(params, uint64(uint32(ptr[0])), uint64(len(s)))
+		}
+	}
+
+	results, err := in.Call(f.def.Export, params...)
+	if err != nil {
+		return nil, err
+	}
+	r := results[0]
+
+	switch f.ret {
+	case kindSigned:
+		if f.wideRet {
+			return int64(r), nil
+		}
+		return int64(int32(r)), nil
+	case kindUnsigned:
+		if f.wideRet {
+			return r, nil
+		}
+		return uint64(uint32(r)), nil
+	case kindFloat:
+		if f.wideRet {
+			return math.Float64frombits(r), nil
+		}
+		return float64(math.Float32frombits(uint32(r))), nil
+	default:
+		ptr, length := r>>32, r&math.MaxUint32
+		mem := in.Memory()
+		if ptr+length > uint64(len(mem)) {
+			return nil, ErrBadString.New(f.def.Name)
+		}
+		return string(mem[ptr : ptr+length]), nil
+	}
+}
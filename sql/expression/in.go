@@ -153,28 +153,27 @@ func NewNotInTuple(left sql.Expression, right sql.Expression) sql.Expression {
 // HashInTuple is an expression that checks an expression is inside a list of expressions using a hashmap.
 type HashInTuple struct {
 	InTuple
-	cmp     map[uint64]sql.Expression
+	cmp map[uint64]sql.Expression
+	// hasNull is whether the list has a NULL element, which makes the result NULL instead of false when there's no match.
 	hasNull bool
+	// nullTuples are the tuples of the list with NULL elements, which can't be hashed and are compared one by one.
+	nullTuples []Tuple
 }
 
 var _ Comparer = (*InTuple)(nil)
 
 // NewHashInTuple creates an InTuple expression.
 func NewHashInTuple(left, right sql.Expression) (*HashInTuple, error) {
-	cmp, hasNull, err := newInMap(right, left.Type())
+	cmp, hasNull, nullTuples, err := newInMap(right, left.Type())
 	if err != nil {
 		return nil, err
 	}
 
-	return &HashInTuple{InTuple: *NewInTuple(left, right), cmp: cmp, hasNull: hasNull}, nil
+	return &HashInTuple{InTuple: *NewInTuple(left, right), cmp: cmp, hasNull: hasNull, nullTuples: nullTuples}, nil
 }
 
 // Eval implements the Expression interface.
 func (hit *HashInTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	if hit.hasNull {
-		return nil, nil
-	}
-
 	// convert GetField to Literal, necessary for hashing
 	left, err := normalizeLeft(ctx, hit.Left(), row)
 	if err != nil {
@@ -193,6 +192,11 @@ func (hit *HashInTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, nil
 	}
 
+	// A tuple with NULL elements can't match any element of the list, but it might be unknown whether it does
+	if vals, ok := leftVal.([]interface{}); ok && hasNullValue(vals) {
+		return hit.evalTupleWithNulls(ctx, vals)
+	}
+
 	key, err := hashOf(left, hit.Left().Type())
 	if err != nil {
 		return nil, err
@@ -200,7 +204,7 @@ func (hit *HashInTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 
 	right, ok := hit.cmp[key]
 	if !ok {
-		return false, nil
+		return hit.evalNoMatch(ctx, leftVal)
 	}
 
 	if sql.NumColumns(right.Type().Promote()) != leftElems {
@@ -210,6 +214,116 @@ func (hit *HashInTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	return true, nil
 }
 
+// evalNoMatch returns the result for a value that doesn't match any hashed element of the list: false, unless the list
+// has NULLs that make the result unknown.
+func (hit *HashInTuple) evalNoMatch(ctx *sql.Context, leftVal interface{}) (interface{}, error) {
+	var result interface{} = false
+	if hit.hasNull {
+		result = nil
+	}
+
+	vals, ok := leftVal.([]interface{})
+	if !ok {
+		return result, nil
+	}
+
+	for _, tup := range hit.nullTuples {
+		cmp, err := compareTupleWithNulls(ctx, hit.Left().Type(), vals, tup)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == true {
+			return true, nil
+		} else if cmp == nil {
+			result = nil
+		}
+	}
+
+	return result, nil
+}
+
+// evalTupleWithNulls compares the tuple given, which has NULL elements, with all the elements of the list.
+func (hit *HashInTuple) evalTupleWithNulls(ctx *sql.Context, vals []interface{}) (interface{}, error) {
+	var result interface{} = false
+	if hit.hasNull {
+		result = nil
+	}
+
+	tuples := append([]Tuple{}, hit.nullTuples...)
+	for _, e := range hit.cmp {
+		if tup, ok := e.(Tuple); ok {
+			tuples = append(tuples, tup)
+		}
+	}
+
+	for _, tup := range tuples {
+		cmp, err := compareTupleWithNulls(ctx, hit.Left().Type(), vals, tup)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == true {
+			return true, nil
+		} else if cmp == nil {
+			result = nil
+		}
+	}
+
+	return result, nil
+}
+
+// compareTupleWithNulls compares the values of a tuple of the type given with a tuple of the list, where any of them
+// might be NULL. It returns true if they're equal, false if they're not and nil if it's unknown because of the NULLs.
+func compareTupleWithNulls(ctx *sql.Context, typ sql.Type, left []interface{}, tup Tuple) (interface{}, error) {
+	tupType, ok := typ.(sql.TupleType)
+	if !ok || len(tupType) != len(tup) || len(left) != len(tup) {
+		return false, nil
+	}
+
+	right, err := tup.Eval(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	vals, ok := right.([]interface{})
+	if !ok {
+		return false, nil
+	}
+
+	unknown := false
+	for i := range left {
+		if left[i] == nil || vals[i] == nil {
+			unknown = true
+			continue
+		}
+
+		typ := tupType[i].Promote()
+		r, err := typ.Convert(vals[i])
+		if err != nil {
+			return false, nil
+		}
+		cmp, err := typ.Compare(left[i], r)
+		if err != nil {
+			return nil, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+
+	if unknown {
+		return nil, nil
+	}
+	return true, nil
+}
+
+func hasNullValue(vals []interface{}) bool {
+	for _, v := range vals {
+		if v == nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (hit *HashInTuple) String() string {
 	return fmt.Sprintf("(%s HASH IN %s)", hit.Left(), hit.Right())
 }
@@ -218,36 +332,61 @@ func (hit *HashInTuple) DebugString() string {
 	return fmt.Sprintf("(%s HASH IN %s)", sql.DebugString(hit.Left()), sql.DebugString(hit.Right()))
 }
 
-// newInMap will hash Literal and Tuple expressions, and return a map of the hash to original expression
-func newInMap(expr sql.Expression, lType sql.Type) (map[uint64]sql.Expression, bool, error) {
+// newInMap will hash Literal and Tuple expressions, and return a map of the hash to original expression. NULL literals
+// aren't hashed, since they never match: the list having them is returned instead, along with the tuples that have
+// NULL elements.
+func newInMap(expr sql.Expression, lType sql.Type) (map[uint64]sql.Expression, bool, []Tuple, error) {
 	if lType == sql.Null {
-		return nil, true, nil
+		return nil, true, nil, nil
 	}
 
 	elements := make(map[uint64]sql.Expression)
 	hasNull := false
+	var nullTuples []Tuple
 	switch right := expr.(type) {
 	case Tuple:
 		for _, el := range right {
 			switch l := el.(type) {
 			case *Literal, Tuple:
+				if lit, ok := l.(*Literal); ok && lit.Value() == nil {
+					hasNull = true
+					continue
+				}
+				if tup, ok := l.(Tuple); ok && tupleHasNullLiteral(tup) {
+					if len(tup) == 1 {
+						hasNull = true
+					} else if _, ok := lType.(sql.TupleType); ok {
+						nullTuples = append(nullTuples, tup)
+					}
+					continue
+				}
 				key, err := hashOf(l, lType)
 				if sql.ErrInvalidType.Is(err) {
 					// TODO: can't convert a tuple in right expr to left literal type, and vice versa, echo warning?
 					continue
 				}
 				if err != nil {
-					return nil, hasNull, err
+					return nil, hasNull, nil, err
 				}
 				elements[key] = el
 			default:
-				return nil, hasNull, ErrUnsupportedHashInSubexpression.New(el)
+				return nil, hasNull, nil, ErrUnsupportedHashInSubexpression.New(el)
 			}
 		}
 	default:
-		return nil, hasNull, ErrUnsupportedHashInOperand.New(right)
+		return nil, hasNull, nil, ErrUnsupportedHashInOperand.New(right)
+	}
+	return elements, hasNull, nullTuples, nil
+}
+
+// tupleHasNullLiteral returns whether the tuple given has a NULL literal element.
+func tupleHasNullLiteral(tup Tuple) bool {
+	for _, e := range tup {
+		if lit, ok := e.(*Literal); ok && lit.Value() == nil {
+			return true
+		}
 	}
-	return elements, hasNull, nil
+	return false
 }
 
 func hashOf(e sql.Expression, t sql.Type) (uint64, error) {
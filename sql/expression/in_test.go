@@ -373,6 +373,89 @@ func TestHashInTuple(t *testing.T) {
 			expression.ErrCantHashNestedExpression,
 			nil,
 		},
+		{
+			"left is in right with nulls",
+			expression.NewGetField(0, sql.Int64, "foo", false),
+			expression.NewTuple(
+				expression.NewLiteral(nil, sql.Null),
+				expression.NewLiteral(int64(1), sql.Int64),
+			),
+			sql.NewRow(int64(1)),
+			true,
+			nil,
+			nil,
+		},
+		{
+			"left is not in right with nulls",
+			expression.NewGetField(0, sql.Int64, "foo", false),
+			expression.NewTuple(
+				expression.NewLiteral(int64(0), sql.Int64),
+				expression.NewLiteral(nil, sql.Null),
+			),
+			sql.NewRow(int64(1)),
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"left tuple might be in right tuple with nulls",
+			expression.NewTuple(
+				expression.NewLiteral(int64(2), sql.Int64),
+				expression.NewLiteral(int64(1), sql.Int64),
+			),
+			expression.NewTuple(
+				expression.NewTuple(
+					expression.NewLiteral(int64(2), sql.Int64),
+					expression.NewLiteral(nil, sql.Null),
+				),
+				expression.NewTuple(
+					expression.NewLiteral(int64(1), sql.Int64),
+					expression.NewLiteral(int64(0), sql.Int64),
+				),
+			),
+			nil,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"left tuple is not in right tuple with nulls",
+			expression.NewTuple(
+				expression.NewLiteral(int64(3), sql.Int64),
+				expression.NewLiteral(int64(1), sql.Int64),
+			),
+			expression.NewTuple(
+				expression.NewTuple(
+					expression.NewLiteral(int64(2), sql.Int64),
+					expression.NewLiteral(nil, sql.Null),
+				),
+				expression.NewTuple(
+					expression.NewLiteral(int64(1), sql.Int64),
+					expression.NewLiteral(int64(0), sql.Int64),
+				),
+			),
+			nil,
+			false,
+			nil,
+			nil,
+		},
+		{
+			"left tuple with nulls might be in right",
+			expression.NewTuple(
+				expression.NewGetField(0, sql.Int64, "foo", true),
+				expression.NewGetField(1, sql.Int64, "foo", false),
+			),
+			expression.NewTuple(
+				expression.NewTuple(
+					expression.NewLiteral(int64(2), sql.Int64),
+					expression.NewLiteral(int64(1), sql.Int64),
+				),
+			),
+			sql.NewRow(nil, int64(1)),
+			nil,
+			nil,
+			nil,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -72,6 +72,11 @@ func (e *IsTrue) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return boolVal, nil
 }
 
+// IsFalse returns whether the expression checks if its child is false instead.
+func (e *IsTrue) IsFalse() bool {
+	return e.invert
+}
+
 func (e *IsTrue) String() string {
 	isStr := IsTrueStr
 	if e.invert {
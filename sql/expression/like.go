@@ -77,7 +77,8 @@ func (l *Like) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	createMatcher := newDefaultLikeMatcher
 	lType := l.Left.Type()
 	lm, likeOK := lType.(sql.LikeMatcher)
-	if likeOK {
+	// Matching against a binary string is always done byte by byte, regardless of the collation of the other operand.
+	if likeOK && !sql.IsBlob(l.Right.Type()) {
 		createMatcher = lm.CreateMatcher
 	}
 
@@ -163,6 +164,11 @@ func (l *Like) evalRight(ctx *sql.Context, row sql.Row) (*string, error) {
 	return &s, nil
 }
 
+// Escape returns the expression of the escape character of the pattern, or nil if it uses the default one.
+func (l *Like) Escape() sql.Expression {
+	return l.escape
+}
+
 func (l *Like) String() string {
 	return fmt.Sprintf("%s LIKE %s", l.Left, l.Right)
 }
@@ -15,6 +15,9 @@
 package expression
 
 import (
+	"bytes"
+	"sort"
+
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
@@ -82,3 +85,93 @@ func (s *Sorter) Less(i, j int) bool {
 
 	return false
 }
+
+// SortRows sorts the rows given in place, keeping the original order of rows that compare equal. Unlike Sorter, which
+// evaluates the sort fields on every comparison, the sort fields are evaluated once per row, and values of types with
+// sort keys (see sql.EncodeSortKey) are encoded once and compared byte by byte.
+func SortRows(ctx *sql.Context, sortFields []sql.SortField, rows []sql.Row) error {
+	encoded := make([]bool, len(sortFields))
+	for i, sf := range sortFields {
+		encoded[i] = sql.HasSortKey(sf.Column.Type())
+	}
+
+	keys := make([][]interface{}, len(rows))
+	for i, row := range rows {
+		keys[i] = make([]interface{}, len(sortFields))
+		for j, sf := range sortFields {
+			v, err := sf.Column.Eval(ctx, row)
+			if err != nil {
+				return sql.ErrUnableSort.Wrap(err)
+			}
+
+			if v != nil && encoded[j] {
+				v, err = sql.EncodeSortKey(nil, sf.Column.Type(), v)
+				if err != nil {
+					return sql.ErrUnableSort.Wrap(err)
+				}
+			}
+
+			keys[i][j] = v
+		}
+	}
+
+	sorter := &keyedSorter{sortFields: sortFields, encoded: encoded, keys: keys, rows: rows}
+	sort.Stable(sorter)
+	return sorter.lastError
+}
+
+type keyedSorter struct {
+	sortFields []sql.SortField
+	encoded    []bool
+	keys       [][]interface{}
+	rows       []sql.Row
+	lastError  error
+}
+
+func (s *keyedSorter) Len() int {
+	return len(s.rows)
+}
+
+func (s *keyedSorter) Swap(i, j int) {
+	s.rows[i], s.rows[j] = s.rows[j], s.rows[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+func (s *keyedSorter) Less(i, j int) bool {
+	if s.lastError != nil {
+		return false
+	}
+
+	for k, sf := range s.sortFields {
+		av, bv := s.keys[i][k], s.keys[j][k]
+		if sf.Order == sql.Descending {
+			av, bv = bv, av
+		}
+
+		if av == nil && bv == nil {
+			continue
+		} else if av == nil {
+			return sf.NullOrdering == sql.NullsFirst
+		} else if bv == nil {
+			return sf.NullOrdering != sql.NullsFirst
+		}
+
+		var cmp int
+		if s.encoded[k] {
+			cmp = bytes.Compare(av.([]byte), bv.([]byte))
+		} else {
+			var err error
+			cmp, err = sf.Column.Type().Compare(av, bv)
+			if err != nil {
+				s.lastError = err
+				return false
+			}
+		}
+
+		if cmp != 0 {
+			return cmp < 0
+		}
+	}
+
+	return false
+}
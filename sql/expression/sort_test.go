@@ -0,0 +1,52 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestSortRows(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	rows := []sql.Row{
+		{"b", int64(1)},
+		{nil, int64(2)},
+		{"a", int64(3)},
+		{"b", int64(4)},
+		{"B", int64(5)},
+		{"a", nil},
+	}
+
+	err := SortRows(ctx, []sql.SortField{
+		{Column: NewGetField(0, sql.LongText, "s", true), Order: sql.Ascending, NullOrdering: sql.NullsFirst},
+		{Column: NewGetField(1, sql.Int64, "i", true), Order: sql.Descending, NullOrdering: sql.NullsFirst},
+	}, rows)
+	require.NoError(err)
+
+	require.Equal([]sql.Row{
+		{nil, int64(2)},
+		{"B", int64(5)},
+		{"a", int64(3)},
+		{"a", nil},
+		{"b", int64(4)},
+		{"b", int64(1)},
+	}, rows)
+}
@@ -91,6 +91,8 @@ func (uc *UnresolvedColumn) WithChildren(children ...sql.Expression) (sql.Expres
 // supposed to be called.
 type UnresolvedFunction struct {
 	name string
+	// Database is the database qualifying the function, if any, whose functions it's resolved with.
+	Database string
 	// IsAggregate or not.
 	IsAggregate bool
 	// Window is the window for this function, if present
@@ -148,7 +150,14 @@ func (uf *UnresolvedFunction) String() string {
 		over = fmt.Sprintf(" %s", uf.Window)
 	}
 
-	return fmt.Sprintf("%s(%s)%s", uf.name, strings.Join(exprs, ", "), over)
+	return fmt.Sprintf("%s(%s)%s", uf.qualifiedName(), strings.Join(exprs, ", "), over)
+}
+
+func (uf *UnresolvedFunction) qualifiedName() string {
+	if uf.Database == "" {
+		return uf.name
+	}
+	return uf.Database + "." + uf.name
 }
 
 func (uf *UnresolvedFunction) DebugString() string {
@@ -162,7 +171,7 @@ func (uf *UnresolvedFunction) DebugString() string {
 		over = fmt.Sprintf(" %s", sql.DebugString(uf.Window))
 	}
 
-	return fmt.Sprintf("%s(%s)%s", uf.name, strings.Join(exprs, ", "), over)
+	return fmt.Sprintf("%s(%s)%s", uf.qualifiedName(), strings.Join(exprs, ", "), over)
 }
 
 // Eval implements the Expression interface.
@@ -181,5 +190,7 @@ func (uf *UnresolvedFunction) WithChildren(children ...sql.Expression) (sql.Expr
 		return nil, err
 	}
 
-	return NewUnresolvedFunction(uf.name, uf.IsAggregate, window, children[:len(uf.Arguments)]...), nil
+	nf := NewUnresolvedFunction(uf.name, uf.IsAggregate, window, children[:len(uf.Arguments)]...)
+	nf.Database = uf.Database
+	return nf, nil
 }
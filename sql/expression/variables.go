@@ -132,3 +132,49 @@ func (v *UserVar) WithChildren(children ...sql.Expression) (sql.Expression, erro
 	}
 	return v, nil
 }
+
+// UserVarAssignment is an expression that assigns the value of its child to a user variable, with the := operator, and
+// returns it. Assignments are evaluated once for every row, in the order of the expressions, so that a query like
+// `SELECT @rownum := @rownum + 1, name FROM t` numbers its rows.
+type UserVarAssignment struct {
+	UnaryExpression
+	Name string
+}
+
+var _ sql.Expression = (*UserVarAssignment)(nil)
+var _ sql.NonDeterministicExpression = (*UserVarAssignment)(nil)
+
+// NewUserVarAssignment creates a new UserVarAssignment expression.
+func NewUserVarAssignment(name string, expr sql.Expression) *UserVarAssignment {
+	return &UserVarAssignment{UnaryExpression{Child: expr}, name}
+}
+
+// Eval implements the sql.Expression interface.
+func (a *UserVarAssignment) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := a.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.SetUserVariable(ctx, a.Name, val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// IsNonDeterministic implements the sql.NonDeterministicExpression interface. Assignments must be evaluated for every
+// row, and never be moved or cached by the analyzer.
+func (a *UserVarAssignment) IsNonDeterministic() bool { return true }
+
+// Type implements the sql.Expression interface.
+func (a *UserVarAssignment) Type() sql.Type { return a.Child.Type() }
+
+// String implements the sql.Expression interface.
+func (a *UserVarAssignment) String() string { return fmt.Sprintf("@%s := %s", a.Name, a.Child) }
+
+// WithChildren implements the Expression interface.
+func (a *UserVarAssignment) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 1)
+	}
+	return NewUserVarAssignment(a.Name, children[0]), nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+)
+
+// FunctionLimitPolicy is what happens to the calls of functions that exceed one of their FunctionLimits.
+type FunctionLimitPolicy byte
+
+const (
+	// FunctionLimitError fails the query with ErrFunctionLimitExceeded.
+	FunctionLimitError FunctionLimitPolicy = iota
+	// FunctionLimitWarn makes the call return NULL, with a warning.
+	FunctionLimitWarn
+)
+
+// FunctionLimits bound the work done by single calls of the built-in functions that can take a long time or a lot of
+// memory on pathological inputs, such as regular expressions matched against huge strings, JSON documents of many
+// megabytes or BENCHMARK, and of the user-defined functions compiled to WebAssembly. Limits of 0 don't bound anything, and neither do nil FunctionLimits.
+type FunctionLimits struct {
+	// MaxRegexpInputLength is the maximum length, in bytes, of the strings regular expressions are matched against.
+	MaxRegexpInputLength int64
+	// MaxJSONDocumentLength is the maximum length, in bytes, of the JSON documents parsed from strings.
+	MaxJSONDocumentLength int64
+	// MaxBenchmarkDuration is the maximum time a call of BENCHMARK can take.
+	MaxBenchmarkDuration time.Duration
+	// MaxWASMInstructions is the maximum number of instructions a call of a WebAssembly function can execute.
+	MaxWASMInstructions int64
+	// MaxWASMMemory is the maximum memory, in bytes, of a call of a WebAssembly function. It's rounded down to a
+	// whole number of the 64KiB pages of WebAssembly memory, of at least one.
+	MaxWASMMemory int64
+	// Policy is what happens to the calls that exceed one of the limits.
+	Policy FunctionLimitPolicy
+}
+
+// DefaultFunctionLimits returns the function limits of new engines.
+func DefaultFunctionLimits() *FunctionLimits {
+	return &FunctionLimits{
+		MaxRegexpInputLength:  16 << 20,
+		MaxJSONDocumentLength: 64 << 20,
+		MaxBenchmarkDuration:  time.Minute,
+		MaxWASMInstructions:   1 << 30,
+		MaxWASMMemory:         64 << 20,
+	}
+}
+
+// CheckRegexpInput returns whether the function given can match a regular expression against the text given. If it
+// can't, an error is returned, or a warning is added to the ctx if the policy is FunctionLimitWarn.
+func (l *FunctionLimits) CheckRegexpInput(ctx *Context, fn string, text string) (bool, error) {
+	if l == nil || l.MaxRegexpInputLength <= 0 || int64(len(text)) <= l.MaxRegexpInputLength {
+		return true, nil
+	}
+	return l.exceeded(ctx, fn, l.MaxRegexpInputLength, "the length of regular expression inputs")
+}
+
+// CheckJSONDocument returns whether the function given can parse the JSON document given, if it's a string that
+// still needs to be parsed. If it can't, an error is returned, or a warning is added to the ctx if the policy is
+// FunctionLimitWarn.
+func (l *FunctionLimits) CheckJSONDocument(ctx *Context, fn string, doc interface{}) (bool, error) {
+	if l == nil || l.MaxJSONDocumentLength <= 0 {
+		return true, nil
+	}
+
+	var length int
+	switch doc := doc.(type) {
+	case string:
+		length = len(doc)
+	case []byte:
+		length = len(doc)
+	default:
+		return true, nil
+	}
+
+	if int64(length) <= l.MaxJSONDocumentLength {
+		return true, nil
+	}
+	return l.exceeded(ctx, fn, l.MaxJSONDocumentLength, "the length of JSON documents")
+}
+
+// CheckBenchmarkDuration returns whether a call of BENCHMARK that has been running for the duration given can go on.
+// If it can't, an error is returned, or a warning is added to the ctx if the policy is FunctionLimitWarn.
+func (l *FunctionLimits) CheckBenchmarkDuration(ctx *Context, elapsed time.Duration) (bool, error) {
+	if l == nil || l.MaxBenchmarkDuration <= 0 || elapsed <= l.MaxBenchmarkDuration {
+		return true, nil
+	}
+	return l.exceeded(ctx, "BENCHMARK", l.MaxBenchmarkDuration, "its duration")
+}
+
+// WASMInstructionsExceeded returns the error of a call of the WebAssembly function given that executed more
+// instructions than the limit, or nil if the policy is FunctionLimitWarn, when a warning is added to the ctx instead.
+func (l *FunctionLimits) WASMInstructionsExceeded(ctx *Context, fn string) error {
+	_, err := l.exceeded(ctx, fn, l.MaxWASMInstructions, "the number of instructions it executes")
+	return err
+}
+
+// WASMMemoryExceeded returns the error of a call of the WebAssembly function given that needed more memory than the
+// limit, or nil if the policy is FunctionLimitWarn, when a warning is added to the ctx instead.
+func (l *FunctionLimits) WASMMemoryExceeded(ctx *Context, fn string) error {
+	_, err := l.exceeded(ctx, fn, l.MaxWASMMemory, "its memory")
+	return err
+}
+
+func (l *FunctionLimits) exceeded(ctx *Context, fn string, limit interface{}, what string) (bool, error) {
+	err := ErrFunctionLimitExceeded.New(fn, limit, what)
+	if l.Policy == FunctionLimitWarn {
+		ctx.Warn(mysql.ERUnknownError, "%s", err.Error())
+		return false, nil
+	}
+	return false, err
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunctionLimits(t *testing.T) {
+	require := require.New(t)
+
+	limits := &FunctionLimits{
+		MaxRegexpInputLength:  4,
+		MaxJSONDocumentLength: 8,
+		MaxBenchmarkDuration:  time.Second,
+	}
+
+	ctx := NewEmptyContext()
+	ok, err := limits.CheckRegexpInput(ctx, "REGEXP_LIKE", "abcd")
+	require.True(ok)
+	require.NoError(err)
+
+	ok, err = limits.CheckRegexpInput(ctx, "REGEXP_LIKE", "abcde")
+	require.False(ok)
+	require.True(ErrFunctionLimitExceeded.Is(err))
+
+	ok, err = limits.CheckJSONDocument(ctx, "JSON_EXTRACT", `{"a": 12345}`)
+	require.False(ok)
+	require.True(ErrFunctionLimitExceeded.Is(err))
+
+	ok, err = limits.CheckJSONDocument(ctx, "JSON_EXTRACT", []byte(`[1, 2]`))
+	require.True(ok)
+	require.NoError(err)
+
+	ok, err = limits.CheckJSONDocument(ctx, "JSON_EXTRACT", map[string]interface{}{"a": strings.Repeat("a", 16)})
+	require.True(ok)
+	require.NoError(err)
+
+	ok, err = limits.CheckBenchmarkDuration(ctx, 2*time.Second)
+	require.False(ok)
+	require.True(ErrFunctionLimitExceeded.Is(err))
+
+	limits.Policy = FunctionLimitWarn
+	ok, err = limits.CheckRegexpInput(ctx, "REGEXP_LIKE", "abcde")
+	require.False(ok)
+	require.NoError(err)
+	require.Equal(uint16(1), ctx.WarningCount())
+
+	var nilLimits *FunctionLimits
+	ok, err = nilLimits.CheckRegexpInput(ctx, "REGEXP_LIKE", strings.Repeat("a", 1<<10))
+	require.True(ok)
+	require.NoError(err)
+
+	ok, err = (&FunctionLimits{}).CheckBenchmarkDuration(ctx, time.Hour)
+	require.True(ok)
+	require.NoError(err)
+}
@@ -63,6 +63,38 @@ type IndexLookup interface {
 	Ranges() RangeCollection
 }
 
+// MergeableIndexLookup is an IndexLookup that can be combined with lookups on other indexes of the same table, so
+// that predicates on different indexes joined by OR or AND can still be answered with index lookups. Lookups on the
+// same index are combined through their ranges instead, so only lookups on different indexes are given to these
+// methods.
+type MergeableIndexLookup interface {
+	IndexLookup
+	// IsMergeable returns whether this lookup can be merged with the lookup given.
+	IsMergeable(IndexLookup) bool
+	// Union returns a lookup for the rows matched by this lookup or any of the lookups given.
+	Union(...IndexLookup) (IndexLookup, error)
+	// Intersection returns a lookup for the rows matched by this lookup and all of the lookups given.
+	Intersection(...IndexLookup) (IndexLookup, error)
+}
+
+// IndexOrder is the order in which an index returns the rows of its lookups.
+type IndexOrder byte
+
+const (
+	// IndexOrderNone means that rows are returned in no particular order.
+	IndexOrderNone IndexOrder = iota
+	// IndexOrderAsc means that rows are returned sorted by the index expressions, in ascending order.
+	IndexOrderAsc
+)
+
+// OrderedIndex is an Index whose lookups return rows sorted by its expressions. Joins between two such indexes can be
+// answered by merging both sides in a single pass, without holding either side in memory.
+type OrderedIndex interface {
+	Index
+	// Order returns the order of the rows returned by lookups on this index.
+	Order() IndexOrder
+}
+
 // ColumnExpressionType returns a column expression along with its Type.
 type ColumnExpressionType struct {
 	Expression string
@@ -15,6 +15,8 @@
 package sql
 
 import (
+	"time"
+
 	"gopkg.in/src-d/go-errors.v1"
 )
 
@@ -61,6 +63,7 @@ func (b *IndexBuilder) Equals(ctx *Context, colExpr string, key interface{}) *In
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	key = storedKey(ctx, typ, key)
 	b.updateCol(ctx, colExpr, ClosedRangeColumnExpr(key, key, typ))
 	return b
 }
@@ -76,6 +79,7 @@ func (b *IndexBuilder) NotEquals(ctx *Context, colExpr string, key interface{})
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	key = storedKey(ctx, typ, key)
 	b.updateCol(ctx, colExpr, GreaterThanRangeColumnExpr(key, typ), LessThanRangeColumnExpr(key, typ))
 	if !b.isInvalid {
 		ranges, err := SimplifyRangeColumn(b.ranges[colExpr]...)
@@ -105,6 +109,7 @@ func (b *IndexBuilder) GreaterThan(ctx *Context, colExpr string, key interface{}
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	key = storedKey(ctx, typ, key)
 	b.updateCol(ctx, colExpr, GreaterThanRangeColumnExpr(key, typ))
 	return b
 }
@@ -120,6 +125,7 @@ func (b *IndexBuilder) GreaterOrEqual(ctx *Context, colExpr string, key interfac
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	key = storedKey(ctx, typ, key)
 	b.updateCol(ctx, colExpr, GreaterOrEqualRangeColumnExpr(key, typ))
 	return b
 }
@@ -135,6 +141,7 @@ func (b *IndexBuilder) LessThan(ctx *Context, colExpr string, key interface{}) *
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	key = storedKey(ctx, typ, key)
 	b.updateCol(ctx, colExpr, LessThanRangeColumnExpr(key, typ))
 	return b
 }
@@ -150,10 +157,24 @@ func (b *IndexBuilder) LessOrEqual(ctx *Context, colExpr string, key interface{}
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	key = storedKey(ctx, typ, key)
 	b.updateCol(ctx, colExpr, LessOrEqualRangeColumnExpr(key, typ))
 	return b
 }
 
+// storedKey returns the key given as it's stored in the index, for a column of the type given. TIMESTAMP values are
+// stored in UTC, while the keys are wall clock times in the time zone of the session like all the other datetime values.
+func storedKey(ctx *Context, typ Type, key interface{}) interface{} {
+	if typ != Timestamp || key == nil {
+		return key
+	}
+	t, err := Timestamp.Convert(key)
+	if err != nil {
+		return key
+	}
+	return TimestampFromTimeZone(t.(time.Time), SessionTimeZone(ctx))
+}
+
 // Range returns the range for this index builder. If the builder is invalid for any reason then this returns nil.
 func (b *IndexBuilder) Range() Range {
 	if b.err != nil || b.isInvalid {
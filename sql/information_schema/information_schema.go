@@ -22,11 +22,11 @@ import (
 	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 
 	. "github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -73,6 +73,14 @@ const (
 	PartitionsTableName = "partitions"
 	// InnoDBTempTableName is the name of the INNODB_TEMP_TABLE_INFO table
 	InnoDBTempTableName = "innodb_temp_table_info"
+	// ProcessListTableName is the name of the processlist table
+	ProcessListTableName = "processlist"
+	// EngineStatusTableName is the name of the engine_status table
+	EngineStatusTableName = "engine_status"
+	// CachedPlansTableName is the name of the cached_plans table
+	CachedPlansTableName = "cached_plans"
+	// BackgroundJobsTableName is the name of the background_jobs table
+	BackgroundJobsTableName = "background_jobs"
 )
 
 var _ Database = (*informationSchemaDatabase)(nil)
@@ -443,6 +451,39 @@ var innoDBTempTableSchema = Schema{
 	{Name: "space", Type: Uint64, Default: nil, Nullable: false, Source: InnoDBTempTableName},
 }
 
+var processListSchema = Schema{
+	{Name: "id", Type: Uint64, Default: nil, Nullable: false, Source: ProcessListTableName},
+	{Name: "user", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 32), Default: nil, Nullable: false, Source: ProcessListTableName},
+	{Name: "host", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 261), Default: nil, Nullable: false, Source: ProcessListTableName},
+	{Name: "db", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: true, Source: ProcessListTableName},
+	{Name: "command", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 16), Default: nil, Nullable: false, Source: ProcessListTableName},
+	{Name: "time", Type: Int32, Default: nil, Nullable: false, Source: ProcessListTableName},
+	{Name: "state", Type: LongText, Default: nil, Nullable: true, Source: ProcessListTableName},
+	{Name: "info", Type: LongText, Default: nil, Nullable: true, Source: ProcessListTableName},
+}
+
+var engineStatusSchema = Schema{
+	{Name: "variable_name", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: false, Source: EngineStatusTableName},
+	{Name: "variable_value", Type: Uint64, Default: nil, Nullable: false, Source: EngineStatusTableName},
+}
+
+var cachedPlansSchema = Schema{
+	{Name: "session_id", Type: Uint64, Default: nil, Nullable: true, Source: CachedPlansTableName},
+	{Name: "db", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: true, Source: CachedPlansTableName},
+	{Name: "query", Type: LongText, Default: nil, Nullable: false, Source: CachedPlansTableName},
+	{Name: "analyzed", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 3), Default: nil, Nullable: false, Source: CachedPlansTableName},
+}
+
+var backgroundJobsSchema = Schema{
+	{Name: "id", Type: Uint64, Default: nil, Nullable: false, Source: BackgroundJobsTableName},
+	{Name: "name", Type: LongText, Default: nil, Nullable: false, Source: BackgroundJobsTableName},
+	{Name: "state", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 16), Default: nil, Nullable: false, Source: BackgroundJobsTableName},
+	{Name: "interval_seconds", Type: Float64, Default: nil, Nullable: true, Source: BackgroundJobsTableName},
+	{Name: "runs", Type: Uint64, Default: nil, Nullable: false, Source: BackgroundJobsTableName},
+	{Name: "last_run", Type: Datetime, Default: nil, Nullable: true, Source: BackgroundJobsTableName},
+	{Name: "error", Type: LongText, Default: nil, Nullable: true, Source: BackgroundJobsTableName},
+}
+
 func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 	var rows []Row
 	for _, db := range cat.AllDatabases() {
@@ -946,6 +987,105 @@ func innoDBTempTableIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// processListRowIter returns a row for each of the queries running in the engine's process list.
+func processListRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	if ctx.ProcessList == nil {
+		return RowsToRowIter(), nil
+	}
+
+	processes := ctx.ProcessList.Processes()
+	rows := make([]Row, len(processes))
+	for i, proc := range processes {
+		var db interface{}
+		if proc.Database != "" {
+			db = proc.Database
+		}
+
+		rows[i] = Row{
+			uint64(proc.Connection),
+			proc.User,
+			proc.Host,
+			db,
+			"Query",
+			int32(proc.Seconds()),
+			proc.State(),
+			proc.Query,
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// engineStatusRowIter returns a row for each of the status variables of the engine running the query.
+func engineStatusRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	if ctx.EngineStatus == nil {
+		return RowsToRowIter(), nil
+	}
+
+	vars := ctx.EngineStatus.EngineStatus()
+	rows := make([]Row, len(vars))
+	for i, v := range vars {
+		rows[i] = Row{v.Name, v.Value}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// cachedPlansRowIter returns a row for each of the statements in the plan cache of the engine running the query.
+// Plans shared by every session have a NULL session_id.
+func cachedPlansRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	if ctx.EngineStatus == nil {
+		return RowsToRowIter(), nil
+	}
+
+	plans := ctx.EngineStatus.CachedPlans()
+	rows := make([]Row, len(plans))
+	for i, p := range plans {
+		var session, db interface{}
+		if p.Session != 0 {
+			session = uint64(p.Session)
+		}
+		if p.Database != "" {
+			db = p.Database
+		}
+
+		analyzed := "NO"
+		if p.Analyzed {
+			analyzed = "YES"
+		}
+
+		rows[i] = Row{session, db, p.Query, analyzed}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// backgroundJobsRowIter returns a row for each of the background jobs of the engine running the query.
+func backgroundJobsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	if ctx.BackgroundJobs == nil {
+		return RowsToRowIter(), nil
+	}
+
+	jobs := ctx.BackgroundJobs.Jobs()
+	rows := make([]Row, len(jobs))
+	for i, job := range jobs {
+		var interval, lastRun, jobErr interface{}
+		if job.Interval > 0 {
+			interval = job.Interval.Seconds()
+		}
+		if !job.LastRun.IsZero() {
+			lastRun = job.LastRun
+		}
+		if job.Error != "" {
+			jobErr = job.Error
+		}
+
+		rows[i] = Row{job.ID, job.Name, string(job.State), interval, job.Runs, lastRun, jobErr}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
 func emptyRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(), nil
 }
@@ -1053,6 +1193,26 @@ func NewInformationSchemaDatabase() Database {
 				schema:  innoDBTempTableSchema,
 				rowIter: innoDBTempTableIter,
 			},
+			ProcessListTableName: &informationSchemaTable{
+				name:    ProcessListTableName,
+				schema:  processListSchema,
+				rowIter: processListRowIter,
+			},
+			EngineStatusTableName: &informationSchemaTable{
+				name:    EngineStatusTableName,
+				schema:  engineStatusSchema,
+				rowIter: engineStatusRowIter,
+			},
+			CachedPlansTableName: &informationSchemaTable{
+				name:    CachedPlansTableName,
+				schema:  cachedPlansSchema,
+				rowIter: cachedPlansRowIter,
+			},
+			BackgroundJobsTableName: &informationSchemaTable{
+				name:    BackgroundJobsTableName,
+				schema:  backgroundJobsSchema,
+				rowIter: backgroundJobsRowIter,
+			},
 		},
 	}
 }
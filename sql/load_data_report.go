@@ -0,0 +1,52 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// LoadDataRejectedRow is a line of the file of a LOAD DATA IGNORE statement that wasn't loaded into its table.
+type LoadDataRejectedRow struct {
+	// Line is the number of the line in the file, starting at 1.
+	Line int64
+	// Reason is the error the line was rejected with.
+	Reason string
+}
+
+// LoadDataReport is the outcome of a LOAD DATA statement that read its whole file.
+type LoadDataReport struct {
+	// File is the file given to the statement.
+	File string
+	// Table is the table the rows are loaded into.
+	Table string
+	// Loaded is the number of rows read from the file and passed on to be inserted.
+	Loaded int64
+	// Rejected are the lines that weren't loaded, in order. Lines are only rejected with IGNORE, since without it the
+	// statement fails on the first invalid line instead.
+	Rejected []LoadDataRejectedRow
+}
+
+// LoadDataReporter receives the reports of the LOAD DATA statements run through an engine, so that integrators can
+// show which lines of the files of bulk loads were rejected, and why.
+type LoadDataReporter interface {
+	ReportLoadData(ctx *Context, report LoadDataReport)
+}
+
+// LoadDataReporterFunc is a function that implements the LoadDataReporter interface.
+type LoadDataReporterFunc func(ctx *Context, report LoadDataReport)
+
+var _ LoadDataReporter = LoadDataReporterFunc(nil)
+
+// ReportLoadData implements the LoadDataReporter interface.
+func (f LoadDataReporterFunc) ReportLoadData(ctx *Context, report LoadDataReport) {
+	f(ctx, report)
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// LoadDataSource opens the files of LOAD DATA statements that aren't on the disk of the server, such as objects in
+// object storage or HTTP(S) URLs, for embedded deployments where the data doesn't live on the local disk.
+type LoadDataSource interface {
+	// OpenLoadData returns the contents of the file given, or false if the source doesn't handle it, in which case
+	// the next source is tried.
+	OpenLoadData(ctx *Context, file string) (io.ReadCloser, bool, error)
+}
+
+// LoadDataSourceFunc is a function that implements the LoadDataSource interface.
+type LoadDataSourceFunc func(ctx *Context, file string) (io.ReadCloser, bool, error)
+
+var _ LoadDataSource = LoadDataSourceFunc(nil)
+
+// OpenLoadData implements the LoadDataSource interface.
+func (f LoadDataSourceFunc) OpenLoadData(ctx *Context, file string) (io.ReadCloser, bool, error) {
+	return f(ctx, file)
+}
+
+// LoadDataSources are the sources the files of server-side LOAD DATA statements are opened from. The sources are
+// tried in the order they were added, and files that none of them handles are read from the local disk.
+type LoadDataSources struct {
+	mu      sync.RWMutex
+	sources []LoadDataSource
+}
+
+// NewLoadDataSources creates new LoadDataSources without any source.
+func NewLoadDataSources() *LoadDataSources {
+	return &LoadDataSources{}
+}
+
+// AddSource adds a source that the files of the LOAD DATA statements run from now on are opened from.
+func (s *LoadDataSources) AddSource(source LoadDataSource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sources = append(s.sources, source)
+}
+
+// Open returns the contents of the file given from the first source that handles it, or false if none does.
+func (s *LoadDataSources) Open(ctx *Context, file string) (io.ReadCloser, bool, error) {
+	s.mu.RLock()
+	sources := s.sources
+	s.mu.RUnlock()
+
+	for _, source := range sources {
+		r, ok, err := source.OpenLoadData(ctx, file)
+		if err != nil {
+			return nil, false, ErrLoadDataCannotOpen.New(err.Error())
+		}
+		if ok {
+			return r, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// NewURLLoadDataSource returns a LoadDataSource for the files that are URLs with one of the schemes given, such as
+// s3, which are opened by the function given. Other files are left to the next sources.
+func NewURLLoadDataSource(open func(ctx *Context, u *url.URL) (io.ReadCloser, error), schemes ...string) LoadDataSource {
+	return LoadDataSourceFunc(func(ctx *Context, file string) (io.ReadCloser, bool, error) {
+		u, err := url.Parse(file)
+		if err != nil {
+			return nil, false, nil
+		}
+
+		for _, scheme := range schemes {
+			if strings.EqualFold(u.Scheme, scheme) {
+				r, err := open(ctx, u)
+				if err != nil {
+					return nil, false, err
+				}
+				return r, true, nil
+			}
+		}
+		return nil, false, nil
+	})
+}
+
+// NewHTTPLoadDataSource returns a LoadDataSource for the files that are http and https URLs, which are downloaded
+// with the client given, or http.DefaultClient if it's nil. It isn't added to engines by default, since it lets
+// anyone who can run LOAD DATA make requests from the server.
+func NewHTTPLoadDataSource(client *http.Client) LoadDataSource {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return NewURLLoadDataSource(func(ctx *Context, u *url.URL) (io.ReadCloser, error) {
+		req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("GET %s: %s", u.Redacted(), resp.Status)
+		}
+		return resp.Body, nil
+	}, "http", "https")
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPLoadDataSource(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/data.csv" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, "1,one\n")
+	}))
+	defer server.Close()
+
+	sources := NewLoadDataSources()
+	sources.AddSource(NewHTTPLoadDataSource(server.Client()))
+	ctx := NewContext(context.Background())
+
+	r, ok, err := sources.Open(ctx, server.URL+"/data.csv")
+	require.NoError(err)
+	require.True(ok)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(err)
+	require.NoError(r.Close())
+	require.Equal("1,one\n", string(data))
+
+	_, _, err = sources.Open(ctx, server.URL+"/missing.csv")
+	require.Error(err)
+	require.True(ErrLoadDataCannotOpen.Is(err))
+
+	// Files that aren't URLs are left to the local disk.
+	_, ok, err = sources.Open(ctx, "/tmp/data.csv")
+	require.NoError(err)
+	require.False(ok)
+}
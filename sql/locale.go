@@ -0,0 +1,226 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Locale is a locale of the server, which localizes the names of days and months of DAYNAME, MONTHNAME and
+// DATE_FORMAT through the lc_time_names variable, and the separators of the numbers formatted by FORMAT.
+type Locale struct {
+	Name string
+	// DayNames and AbbrevDayNames are indexed by time.Weekday, starting on Sunday.
+	DayNames       [7]string
+	AbbrevDayNames [7]string
+	// MonthNames and AbbrevMonthNames start on January.
+	MonthNames       [12]string
+	AbbrevMonthNames [12]string
+	DecimalPoint     string
+	// ThousandsSep is empty for the locales that don't group digits.
+	ThousandsSep string
+}
+
+// DayName returns the name of the weekday of the time given.
+func (l *Locale) DayName(t time.Time) string {
+	return l.DayNames[t.Weekday()]
+}
+
+// AbbrevDayName returns the abbreviated name of the weekday of the time given.
+func (l *Locale) AbbrevDayName(t time.Time) string {
+	return l.AbbrevDayNames[t.Weekday()]
+}
+
+// MonthName returns the name of the month of the time given.
+func (l *Locale) MonthName(t time.Time) string {
+	return l.MonthNames[t.Month()-1]
+}
+
+// AbbrevMonthName returns the abbreviated name of the month of the time given.
+func (l *Locale) AbbrevMonthName(t time.Time) string {
+	return l.AbbrevMonthNames[t.Month()-1]
+}
+
+var (
+	englishDays         = [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	englishAbbrevDays   = [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+	englishMonths       = [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+	englishAbbrevMonths = [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+
+	germanDays         = [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"}
+	germanAbbrevDays   = [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"}
+	germanMonths       = [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"}
+	germanAbbrevMonths = [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"}
+
+	numberedMonths = [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"}
+)
+
+// DefaultLocale is en_US, the locale of lc_time_names by default and of FORMAT without a locale.
+var DefaultLocale = &Locale{
+	Name:             "en_US",
+	DayNames:         englishDays,
+	AbbrevDayNames:   englishAbbrevDays,
+	MonthNames:       englishMonths,
+	AbbrevMonthNames: englishAbbrevMonths,
+	DecimalPoint:     ".",
+	ThousandsSep:     ",",
+}
+
+// locales are the locales supported, by lower case name.
+var locales = newLocales(
+	DefaultLocale,
+	englishLocale("en_GB"),
+	englishLocale("en_AU"),
+	englishLocale("en_CA"),
+	&Locale{
+		Name:             "de_DE",
+		DayNames:         germanDays,
+		AbbrevDayNames:   germanAbbrevDays,
+		MonthNames:       germanMonths,
+		AbbrevMonthNames: germanAbbrevMonths,
+		DecimalPoint:     ",",
+		ThousandsSep:     ".",
+	},
+	&Locale{
+		Name:             "de_AT",
+		DayNames:         germanDays,
+		AbbrevDayNames:   germanAbbrevDays,
+		MonthNames:       [12]string{"Jänner", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		AbbrevMonthNames: [12]string{"Jän", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		DecimalPoint:     ",",
+		ThousandsSep:     ".",
+	},
+	&Locale{
+		Name:             "de_CH",
+		DayNames:         germanDays,
+		AbbrevDayNames:   germanAbbrevDays,
+		MonthNames:       germanMonths,
+		AbbrevMonthNames: germanAbbrevMonths,
+		DecimalPoint:     ".",
+		ThousandsSep:     "'",
+	},
+	&Locale{
+		Name:             "es_ES",
+		DayNames:         [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		AbbrevDayNames:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		MonthNames:       [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		AbbrevMonthNames: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+		DecimalPoint:     ",",
+		ThousandsSep:     ".",
+	},
+	&Locale{
+		Name:             "fr_FR",
+		DayNames:         [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		AbbrevDayNames:   [7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+		MonthNames:       [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		AbbrevMonthNames: [12]string{"jan", "fév", "mar", "avr", "mai", "jun", "jui", "aoû", "sep", "oct", "nov", "déc"},
+		DecimalPoint:     ",",
+	},
+	&Locale{
+		Name:             "it_IT",
+		DayNames:         [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		AbbrevDayNames:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		MonthNames:       [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		AbbrevMonthNames: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		DecimalPoint:     ",",
+		ThousandsSep:     ".",
+	},
+	&Locale{
+		Name:             "nl_NL",
+		DayNames:         [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		AbbrevDayNames:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		MonthNames:       [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		AbbrevMonthNames: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		DecimalPoint:     ",",
+		ThousandsSep:     ".",
+	},
+	&Locale{
+		Name:             "pt_BR",
+		DayNames:         [7]string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"},
+		AbbrevDayNames:   [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+		MonthNames:       [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		AbbrevMonthNames: [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		DecimalPoint:     ",",
+		ThousandsSep:     ".",
+	},
+	&Locale{
+		Name:             "ja_JP",
+		DayNames:         [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		AbbrevDayNames:   [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		MonthNames:       numberedMonths,
+		AbbrevMonthNames: numberedMonths,
+		DecimalPoint:     ".",
+		ThousandsSep:     ",",
+	},
+	&Locale{
+		Name:             "zh_CN",
+		DayNames:         [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		AbbrevDayNames:   [7]string{"日", "一", "二", "三", "四", "五", "六"},
+		MonthNames:       [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+		AbbrevMonthNames: numberedMonths,
+		DecimalPoint:     ".",
+		ThousandsSep:     ",",
+	},
+)
+
+func englishLocale(name string) *Locale {
+	l := *DefaultLocale
+	l.Name = name
+	return &l
+}
+
+func newLocales(ls ...*Locale) map[string]*Locale {
+	m := make(map[string]*Locale, len(ls))
+	for _, l := range ls {
+		m[strings.ToLower(l.Name)] = l
+	}
+	return m
+}
+
+// LookupLocale returns the locale with the name given, case-insensitively, and whether it exists.
+func LookupLocale(name string) (*Locale, bool) {
+	l, ok := locales[strings.ToLower(name)]
+	return l, ok
+}
+
+// LocaleNames returns the names of the locales supported, sorted.
+func LocaleNames() []string {
+	names := make([]string, 0, len(locales))
+	for _, l := range locales {
+		names = append(names, l.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TimeLocale returns the locale of lc_time_names of the session of the context given, which names the days and
+// months of dates. It's DefaultLocale without a context.
+func TimeLocale(ctx *Context) *Locale {
+	if ctx == nil || ctx.Session == nil {
+		return DefaultLocale
+	}
+
+	val, err := ctx.GetSessionVariable(ctx, "lc_time_names")
+	if err != nil {
+		return DefaultLocale
+	}
+	name, _ := val.(string)
+	if l, ok := LookupLocale(name); ok {
+		return l
+	}
+	return DefaultLocale
+}
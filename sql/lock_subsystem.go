@@ -71,7 +71,8 @@ func (ls *LockSubsystem) createLock(name string) **ownedLock {
 }
 
 // Lock attempts to acquire a lock with a given name for the Id associated with the given ctx.Session within the given
-// timeout
+// timeout. Waiting for the lock stops when the ctx is canceled, such as when the query is killed or its connection is
+// closed.
 func (ls *LockSubsystem) Lock(ctx *Context, name string, timeout time.Duration) error {
 	nl := ls.getNamedLock(name)
 
@@ -97,6 +98,9 @@ func (ls *LockSubsystem) Lock(ctx *Context, name string, timeout time.Duration)
 			}
 		}
 
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		time.Sleep(100 * time.Microsecond)
 	}
 
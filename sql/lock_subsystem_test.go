@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"context"
 	"math/rand"
 	"sync"
 	"testing"
@@ -187,3 +188,20 @@ func TestGetLockState(t *testing.T) {
 	assert.Equal(t, LockFree, state)
 	assert.Equal(t, uint32(0), owner)
 }
+
+func TestLockCanceled(t *testing.T) {
+	ls := NewLockSubsystem()
+	user1 := NewEmptyContext()
+
+	err := ls.Lock(user1, testLockName, 0)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	user2 := NewContext(ctx)
+	time.AfterFunc(time.Millisecond, cancel)
+
+	// Waiting forever stops when the query is killed
+	err = ls.Lock(user2, testLockName, -1)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, getLockDiffs(user2))
+}
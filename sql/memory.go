@@ -67,6 +67,10 @@ type RowsCache interface {
 // ErrNoMemoryAvailable is returned when there is no more available memory.
 var ErrNoMemoryAvailable = errors.NewKind("no memory available")
 
+// ErrOutOfMemory is returned when an operator would take the memory accounted by a query or by the whole server over
+// its limit.
+var ErrOutOfMemory = errors.NewKind("out of memory: %s memory limit of %d bytes exceeded")
+
 const maxMemoryKey = "MAX_MEMORY"
 
 const (
@@ -131,6 +135,12 @@ type MemoryManager struct {
 	reporter Reporter
 	caches   map[uint64]Disposable
 	token    uint64
+
+	// Memory explicitly accounted by the operators of all queries through their MemoryAccounts. It's kept apart from
+	// the memory seen by the reporter so that limits can be enforced before the process runs out of memory.
+	accountMu sync.Mutex
+	limit     uint64
+	used      uint64
 }
 
 // NewMemoryManager creates a new manager with the given memory reporter. If nil is given,
@@ -222,3 +232,116 @@ func (m *MemoryManager) NumCaches() int {
 	defer m.mu.RUnlock()
 	return len(m.caches)
 }
+
+// SetLimit sets the number of bytes that the operators of all queries can account for together. Zero means no limit.
+func (m *MemoryManager) SetLimit(limit uint64) {
+	m.accountMu.Lock()
+	defer m.accountMu.Unlock()
+	m.limit = limit
+}
+
+// Limit returns the number of bytes that the operators of all queries can account for together, or zero if there's
+// no limit.
+func (m *MemoryManager) Limit() uint64 {
+	m.accountMu.Lock()
+	defer m.accountMu.Unlock()
+	return m.limit
+}
+
+// Used returns the number of bytes currently accounted for by the operators of all queries.
+func (m *MemoryManager) Used() uint64 {
+	m.accountMu.Lock()
+	defer m.accountMu.Unlock()
+	return m.used
+}
+
+// NewAccount returns a new, empty memory account for a query.
+func (m *MemoryManager) NewAccount() *MemoryAccount {
+	return &MemoryAccount{manager: m}
+}
+
+func (m *MemoryManager) grow(n uint64) error {
+	m.accountMu.Lock()
+	defer m.accountMu.Unlock()
+
+	if m.limit > 0 && m.used+n > m.limit {
+		return ErrOutOfMemory.New("server", m.limit)
+	}
+
+	m.used += n
+	return nil
+}
+
+func (m *MemoryManager) shrink(n uint64) {
+	m.accountMu.Lock()
+	defer m.accountMu.Unlock()
+
+	if n > m.used {
+		n = m.used
+	}
+	m.used -= n
+}
+
+// MemoryAccount keeps track of the memory used by the operators of a single query, such as the rows buffered by
+// joins, sorts, aggregations and subquery caches. Operators grow the account before holding on to more memory and
+// shrink it once they release it, and get an ErrOutOfMemory error instead of growing it over the limit of the query
+// or of the server.
+type MemoryAccount struct {
+	manager *MemoryManager
+	mu      sync.Mutex
+	limit   uint64
+	used    uint64
+}
+
+// SetLimit sets the number of bytes the account can hold. Zero means no limit.
+func (a *MemoryAccount) SetLimit(limit uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.limit = limit
+}
+
+// Grow accounts for n more bytes, or returns an ErrOutOfMemory error if that would take the account or the server
+// over its limit.
+func (a *MemoryAccount) Grow(n uint64) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.limit > 0 && a.used+n > a.limit {
+		return ErrOutOfMemory.New("query", a.limit)
+	}
+
+	if err := a.manager.grow(n); err != nil {
+		return err
+	}
+
+	a.used += n
+	return nil
+}
+
+// Shrink releases n bytes previously accounted for.
+func (a *MemoryAccount) Shrink(n uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if n > a.used {
+		n = a.used
+	}
+	a.used -= n
+	a.manager.shrink(n)
+}
+
+// Used returns the number of bytes accounted for.
+func (a *MemoryAccount) Used() uint64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.used
+}
+
+// Close releases all the memory accounted for.
+func (a *MemoryAccount) Close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.manager.shrink(a.used)
+	a.used = 0
+}
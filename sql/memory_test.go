@@ -52,6 +52,36 @@ func TestManager(t *testing.T) {
 	require.True(f.freed)
 }
 
+func TestMemoryAccount(t *testing.T) {
+	require := require.New(t)
+	m := NewMemoryManager(nil)
+	m.SetLimit(100)
+
+	a1 := m.NewAccount()
+	a1.SetLimit(50)
+	a2 := m.NewAccount()
+
+	require.NoError(a1.Grow(40))
+	require.True(ErrOutOfMemory.Is(a1.Grow(20)))
+	require.Equal(uint64(40), a1.Used())
+
+	require.NoError(a2.Grow(60))
+	require.True(ErrOutOfMemory.Is(a2.Grow(1)))
+	require.Equal(uint64(100), m.Used())
+
+	a1.Shrink(30)
+	require.NoError(a2.Grow(30))
+	require.Equal(uint64(100), m.Used())
+
+	a2.Close()
+	require.Equal(uint64(0), a2.Used())
+	require.Equal(uint64(10), m.Used())
+
+	a1.Shrink(20)
+	require.Equal(uint64(0), a1.Used())
+	require.Equal(uint64(0), m.Used())
+}
+
 type disposableCache struct{}
 
 func (d disposableCache) Dispose() {}
@@ -367,9 +367,9 @@ func (t numberTypeImpl) SQL(v interface{}) (sqltypes.Value, error) {
 	case sqltypes.Uint64:
 		return sqltypes.MakeTrusted(sqltypes.Uint64, []byte(fmt.Sprintf("%d", v))), nil
 	case sqltypes.Float32:
-		return sqltypes.MakeTrusted(sqltypes.Float32, []byte(strconv.FormatFloat(float64(v.(float32)), 'f', -1, 32))), nil
+		return sqltypes.MakeTrusted(sqltypes.Float32, []byte(renderFloat(float64(v.(float32)), 32))), nil
 	case sqltypes.Float64:
-		return sqltypes.MakeTrusted(sqltypes.Float64, []byte(strconv.FormatFloat(v.(float64), 'f', -1, 64))), nil
+		return sqltypes.MakeTrusted(sqltypes.Float64, []byte(renderFloat(v.(float64), 64))), nil
 	default:
 		panic(ErrInvalidBaseType.New(t.baseType.String(), "number"))
 	}
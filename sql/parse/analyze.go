@@ -0,0 +1,60 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// analyzeTableRegex matches ANALYZE TABLE statements, which the parser doesn't know, capturing their list of tables.
+var analyzeTableRegex = regexp.MustCompile(`(?is)^analyze\s+(?:(?:no_write_to_binlog|local)\s+)?tables?\s+(.+)$`)
+
+func parseAnalyzeTable(s string) (sql.Node, error) {
+	matches := analyzeTableRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+
+	// The list of tables is parsed as the FROM clause of a query, which takes care of quoted and qualified names.
+	stmt, err := sqlparser.Parse("SELECT * FROM " + matches[1])
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where != nil || sel.Limit != nil || len(sel.OrderBy) > 0 || len(sel.GroupBy) > 0 {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+
+	tables := make([]sql.Node, len(sel.From))
+	for i, te := range sel.From {
+		ate, ok := te.(*sqlparser.AliasedTableExpr)
+		if !ok || !ate.As.IsEmpty() || ate.AsOf != nil {
+			return nil, sql.ErrSyntaxError.New(s)
+		}
+
+		name, ok := ate.Expr.(sqlparser.TableName)
+		if !ok {
+			return nil, sql.ErrSyntaxError.New(s)
+		}
+		tables[i] = tableNameToUnresolvedTable(name)
+	}
+
+	return plan.NewAnalyzeTable(tables), nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var (
+	// showMasterStatusRegex matches SHOW MASTER STATUS and its newer name SHOW BINARY LOG STATUS.
+	showMasterStatusRegex = regexp.MustCompile(`(?is)^show\s+(?:master|binary\s+log)\s+status$`)
+	// showBinaryLogsRegex matches SHOW BINARY LOGS and its synonym SHOW MASTER LOGS.
+	showBinaryLogsRegex = regexp.MustCompile(`(?is)^show\s+(?:binary|master)\s+logs$`)
+	// showReplicasRegex matches SHOW REPLICAS and its older name SHOW SLAVE HOSTS.
+	showReplicasRegex = regexp.MustCompile(`(?is)^show\s+(?:replicas|slave\s+hosts)$`)
+	// showBinlogEventsRegex matches SHOW BINLOG EVENTS, capturing its file, its position and the offset and count of
+	// its LIMIT clause.
+	showBinlogEventsRegex = regexp.MustCompile(`(?is)^show\s+binlog\s+events(?:\s+in\s+['"]([^'"]*)['"])?(?:\s+from\s+(\d+))?(?:\s+limit\s+(?:(\d+)\s*,\s*)?(\d+))?$`)
+	// purgeBinaryLogsRegex matches PURGE BINARY LOGS, capturing its TO or BEFORE clause.
+	purgeBinaryLogsRegex = regexp.MustCompile(`(?is)^purge\s+(?:binary|master)\s+logs\s+((?:to|before)\s+.+)$`)
+)
+
+// isBinlogStatement returns whether the query given is one of the binary log administration statements, which the
+// parser doesn't know.
+func isBinlogStatement(s string) bool {
+	return showMasterStatusRegex.MatchString(s) || showBinaryLogsRegex.MatchString(s) ||
+		showReplicasRegex.MatchString(s) || showBinlogEventsRegex.MatchString(s) || purgeBinaryLogsRegex.MatchString(s)
+}
+
+func parseBinlogStatement(s string) (sql.Node, error) {
+	switch {
+	case showMasterStatusRegex.MatchString(s):
+		return plan.NewBinlogStatement(plan.BinlogStatement_ShowMasterStatus, ""), nil
+	case showBinaryLogsRegex.MatchString(s):
+		return plan.NewBinlogStatement(plan.BinlogStatement_ShowBinaryLogs, ""), nil
+	case showReplicasRegex.MatchString(s):
+		return plan.NewBinlogStatement(plan.BinlogStatement_ShowReplicas, ""), nil
+	}
+
+	if matches := showBinlogEventsRegex.FindStringSubmatch(s); matches != nil {
+		return parseShowBinlogEvents(matches)
+	}
+
+	matches := purgeBinaryLogsRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+	return plan.NewBinlogStatement(plan.BinlogStatement_PurgeBinaryLogs, matches[1]), nil
+}
+
+func parseShowBinlogEvents(matches []string) (sql.Node, error) {
+	n := plan.NewShowBinlogEvents(matches[1])
+	var err error
+	if matches[2] != "" {
+		if n.Pos, err = strconv.ParseUint(matches[2], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if matches[3] != "" {
+		if n.Offset, err = strconv.ParseInt(matches[3], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if matches[4] != "" {
+		if n.Limit, err = strconv.ParseInt(matches[4], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// createTemporaryDatabaseRegex matches the CREATE TEMPORARY DATABASE statements, which create databases that only exist
+// in the current session.
+var createTemporaryDatabaseRegex = regexp.MustCompile("(?i)^create\\s+temporary\\s+(?:database|schema)\\s+(if\\s+not\\s+exists\\s+)?(`[^`]+`|[\\w$]+)$")
+
+// parseCreateTemporaryDatabase parses a CREATE TEMPORARY DATABASE statement, which the parser doesn't know.
+func parseCreateTemporaryDatabase(s string) (sql.Node, error) {
+	matches := createTemporaryDatabaseRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+
+	return plan.NewCreateTemporaryDatabase(unquoteIdentifier(matches[2]), matches[1] != ""), nil
+}
+
+func unquoteIdentifier(s string) string {
+	return strings.Trim(s, "`")
+}
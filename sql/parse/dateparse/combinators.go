@@ -42,15 +42,6 @@ func takeAllSpaces(str string) (rest string) {
 	return rest
 }
 
-func takeNumber(chars string) (num uint, rest string, err error) {
-	numChars, rest := takeAll(chars, isNumeral)
-	parsedNum, err := strconv.ParseUint(numChars, 10, 32)
-	if err != nil {
-		return 0, "", err
-	}
-	return uint(parsedNum), rest, nil
-}
-
 func isNumeral(r rune) bool {
 	switch r {
 	case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
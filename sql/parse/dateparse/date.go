@@ -117,8 +117,12 @@ type datetime struct {
 
 	dayOfYear  *uint
 	weekOfYear *uint
+	// weekYear is the year of weekOfYear, when it's given apart from the year of the date
+	weekYear *uint
+	// true => weeks start on Sunday, false => weeks start on Monday
+	sundayFirst bool
 
-	// this is completely ignored, but we still parse it for correctness
+	// this is only used to find the date of a day of a week, and ignored otherwise
 	weekday *time.Weekday
 
 	// true => AM, false => PM, nil => unspecified
@@ -207,14 +211,22 @@ var formatSpecifiers = map[byte]parser{
 	's': parseSecondsNumeric,
 	// %T	Time, 24-hour (hh:mm:ss)
 	'T': parse24HourTimestamp,
-	'U': nil,
-	'u': nil,
-	'V': nil,
-	'v': nil,
-	'W': nil,
-	'w': nil,
-	'X': nil,
-	'x': nil,
+	// %U	Week (00..53), where Sunday is the first day of the week; WEEK() mode 0
+	'U': parseWeekStartingSunday,
+	// %u	Week (00..53), where Monday is the first day of the week; WEEK() mode 1
+	'u': parseWeekStartingMonday,
+	// %V	Week (01..53), where Sunday is the first day of the week; WEEK() mode 2; used with %X
+	'V': parseWeekStartingSunday,
+	// %v	Week (01..53), where Monday is the first day of the week; WEEK() mode 3; used with %x
+	'v': parseWeekStartingMonday,
+	// %W	Weekday name (Sunday..Saturday)
+	'W': parseWeekdayName,
+	// %w	Day of the week (0=Sunday..6=Saturday)
+	'w': parseWeekdayNumeric,
+	// %X	Year for the week where Sunday is the first day of the week, numeric, four digits; used with %V
+	'X': parseWeekYear,
+	// %x	Year for the week, where Monday is the first day of the week, numeric, four digits; used with %v
+	'x': parseWeekYear,
 	// %Y	Year, numeric, four digits
 	'Y': parseYear4DigitNumeric,
 	// %y	Year, numeric (two digits)
@@ -57,6 +57,13 @@ func TestParseDate(t *testing.T) {
 
 		{"date_by_year_offset", "100 20", "%j %y", "2020-04-09 00:00:00 -0500 CDT"},
 		{"date_by_year_offset_singledigit_year", "100 5", "%j %y", "2005-04-10 00:00:00 -0500 CDT"},
+
+		{"midnight", "01/02/99 12:05:00 AM", "%m/%e/%y %r", "1999-01-02 00:05:00 -0600 CST"},
+		{"noon", "01/02/99 12:05:00 PM", "%m/%e/%y %r", "1999-01-02 12:05:00 -0600 CST"},
+
+		{"week_starting_sunday", "200442 Monday", "%X%V %W", "2004-10-18 00:00:00 -0500 CDT"},
+		{"week_starting_monday", "2021 01 1", "%x %v %w", "2021-01-04 00:00:00 -0600 CST"},
+		{"week_zero", "2005 00 Saturday", "%Y %U %W", "2005-01-01 00:00:00 -0600 CST"},
 	}
 
 	for _, tt := range tests {
@@ -8,6 +8,9 @@ import (
 // Validate that the combination of fields in datetime
 // can be evaluated unambiguously to a time.Time.
 func validate(dt datetime) error {
+	if dt.weekOfYear != nil {
+		return validateWeek(dt)
+	}
 	if dt.year == nil && dt.day == nil && dt.month == nil && dt.dayOfYear == nil {
 		return nil
 	}
@@ -28,6 +31,21 @@ func validate(dt datetime) error {
 	return nil
 }
 
+// Validate that a date given by its week also has the day
+// of the week and the year of the week.
+func validateWeek(dt datetime) error {
+	if dt.day != nil || dt.dayOfYear != nil {
+		return fmt.Errorf("day is ambiguous")
+	}
+	if dt.weekday == nil {
+		return fmt.Errorf("day of the week is missing")
+	}
+	if dt.year == nil && dt.weekYear == nil {
+		return fmt.Errorf("year is ambiguous")
+	}
+	return nil
+}
+
 // Evaluate the parsed datetime params to a time.Time.
 func evaluate(dt datetime) (time.Time, error) {
 	err := validate(dt)
@@ -37,8 +55,13 @@ func evaluate(dt datetime) (time.Time, error) {
 
 	var hour, minute, second, miliseconds, microseconds, nanoseconds int
 	if dt.hours != nil {
-		if *dt.hours < 13 && dt.am != nil && !*dt.am {
-			*dt.hours += 12
+		if dt.am != nil {
+			// 12 AM is midnight and 12 PM is noon
+			if !*dt.am && *dt.hours < 12 {
+				*dt.hours += 12
+			} else if *dt.am && *dt.hours == 12 {
+				*dt.hours = 0
+			}
 		}
 		hour = int(*dt.hours)
 	}
@@ -75,6 +98,9 @@ func evaluate(dt datetime) (time.Time, error) {
 	} else if dt.day != nil {
 		month = *dt.month
 		day = int(*dt.day)
+	} else if dt.weekOfYear != nil {
+		date := dateOfWeek(dt)
+		year, month, day = date.Year(), date.Month(), date.Day()
 	}
 
 	// if timestamp only, add the duration to the 0 date
@@ -87,3 +113,30 @@ func evaluate(dt datetime) (time.Time, error) {
 
 	return time.Date(year, month, day, hour, minute, second, int(nanosecondDuration), time.Local), nil
 }
+
+// dateOfWeek returns the date of the day of the week in the week of the
+// year of the datetime given. Weeks starting on Sunday are counted from
+// the first Sunday of the year, and weeks starting on Monday from the
+// week of January 4th, like ISO 8601 weeks.
+func dateOfWeek(dt datetime) time.Time {
+	var year int
+	if dt.weekYear != nil {
+		year = int(*dt.weekYear)
+	} else {
+		year = int(*dt.year)
+	}
+
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var firstWeek time.Time
+	var offset int
+	if dt.sundayFirst {
+		firstWeek = jan1.AddDate(0, 0, (7-int(jan1.Weekday()))%7)
+		offset = int(*dt.weekday)
+	} else {
+		jan4 := jan1.AddDate(0, 0, 3)
+		firstWeek = jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+		offset = (int(*dt.weekday) + 6) % 7
+	}
+
+	return firstWeek.AddDate(0, 0, (int(*dt.weekOfYear)-1)*7+offset)
+}
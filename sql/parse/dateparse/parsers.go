@@ -2,6 +2,7 @@ package dateparse
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -75,7 +76,7 @@ func parseMonthAbbreviation(result *datetime, chars string) (rest string, _ erro
 }
 
 func parseMonthNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -85,7 +86,7 @@ func parseMonthNumeric(result *datetime, chars string) (rest string, _ error) {
 }
 
 func parseDayOfMonthNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -94,7 +95,7 @@ func parseDayOfMonthNumeric(result *datetime, chars string) (rest string, _ erro
 }
 
 func parseMicrosecondsNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(6, chars)
 	if err != nil {
 		return "", err
 	}
@@ -103,7 +104,7 @@ func parseMicrosecondsNumeric(result *datetime, chars string) (rest string, _ er
 }
 
 func parse24HourNumeric(result *datetime, chars string) (rest string, _ error) {
-	hour, rest, err := takeNumber(chars)
+	hour, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -112,7 +113,7 @@ func parse24HourNumeric(result *datetime, chars string) (rest string, _ error) {
 }
 
 func parse12HourNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -121,7 +122,7 @@ func parse12HourNumeric(result *datetime, chars string) (rest string, _ error) {
 }
 
 func parseMinuteNumeric(result *datetime, chars string) (rest string, _ error) {
-	min, rest, err := takeNumber(chars)
+	min, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -171,7 +172,7 @@ func parse12HourTimestamp(result *datetime, chars string) (rest string, _ error)
 }
 
 func parseSecondsNumeric(result *datetime, chars string) (rest string, _ error) {
-	sec, rest, err := takeNumber(chars)
+	sec, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -224,7 +225,7 @@ func parseYear4DigitNumeric(result *datetime, chars string) (rest string, _ erro
 	if len(chars) < 4 {
 		return "", fmt.Errorf("expected at least 4 chars, got %d", len(chars))
 	}
-	year, rest, err := takeNumber(chars)
+	year, rest, err := takeNumberAtMostNChars(4, chars)
 	if err != nil {
 		return "", err
 	}
@@ -233,7 +234,7 @@ func parseYear4DigitNumeric(result *datetime, chars string) (rest string, _ erro
 }
 
 func parseDayNumericWithEnglishSuffix(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(2, chars)
 	if err != nil {
 		return "", err
 	}
@@ -242,10 +243,71 @@ func parseDayNumericWithEnglishSuffix(result *datetime, chars string) (rest stri
 }
 
 func parseDayOfYearNumeric(result *datetime, chars string) (rest string, _ error) {
-	num, rest, err := takeNumber(chars)
+	num, rest, err := takeNumberAtMostNChars(3, chars)
 	if err != nil {
 		return "", err
 	}
 	result.dayOfYear = &num
 	return rest, nil
 }
+
+func parseWeekdayName(result *datetime, chars string) (rest string, _ error) {
+	for i := 0; i < 7; i++ {
+		weekday := time.Weekday(i)
+		name := strings.ToLower(weekday.String())
+		if strings.HasPrefix(chars, name) {
+			result.weekday = &weekday
+			return trimPrefix(len(name), chars), nil
+		}
+	}
+	return "", fmt.Errorf("unknown weekday name, got \"%s\"", chars)
+}
+
+func parseWeekdayNumeric(result *datetime, chars string) (rest string, _ error) {
+	num, rest, err := takeNumberAtMostNChars(1, chars)
+	if err != nil {
+		return "", err
+	}
+	if num > 6 {
+		return "", fmt.Errorf("expected day of the week between 0 and 6, got %d", num)
+	}
+	weekday := time.Weekday(num)
+	result.weekday = &weekday
+	return rest, nil
+}
+
+func parseWeekStartingSunday(result *datetime, chars string) (rest string, _ error) {
+	rest, err := parseWeekNumeric(result, chars)
+	result.sundayFirst = true
+	return rest, err
+}
+
+func parseWeekStartingMonday(result *datetime, chars string) (rest string, _ error) {
+	rest, err := parseWeekNumeric(result, chars)
+	result.sundayFirst = false
+	return rest, err
+}
+
+func parseWeekNumeric(result *datetime, chars string) (rest string, _ error) {
+	num, rest, err := takeNumberAtMostNChars(2, chars)
+	if err != nil {
+		return "", err
+	}
+	if num > 53 {
+		return "", fmt.Errorf("expected week between 0 and 53, got %d", num)
+	}
+	result.weekOfYear = &num
+	return rest, nil
+}
+
+func parseWeekYear(result *datetime, chars string) (rest string, _ error) {
+	if len(chars) < 4 {
+		return "", fmt.Errorf("expected at least 4 chars, got %d", len(chars))
+	}
+	year, rest, err := takeNumberAtMostNChars(4, chars)
+	if err != nil {
+		return "", err
+	}
+	result.weekYear = &year
+	return rest, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"encoding/hex"
+	"regexp"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/wasm"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// functionNamePattern matches the name of a function, optionally qualified with the name of its database.
+const functionNamePattern = "(?:`[^`]+`|\\w+)(?:\\s*\\.\\s*(?:`[^`]+`|\\w+))?"
+
+var (
+	// createWASMFunctionRegex matches CREATE FUNCTION ... LANGUAGE WASM, capturing its IF NOT EXISTS, its name, its
+	// parameters, its return type, the export of the module implementing it, and its module as a hex literal.
+	createWASMFunctionRegex = regexp.MustCompile(`(?is)^create\s+function\s+(if\s+not\s+exists\s+)?(` + functionNamePattern + `)\s*\((.*)\)\s*returns\s+(.+?)\s+language\s+wasm(?:\s+export\s+'([^']*)')?\s+as\s+(?:x'([0-9a-f]*)'|0x([0-9a-f]+))$`)
+	// dropFunctionRegex matches DROP FUNCTION, capturing its IF EXISTS and its name.
+	dropFunctionRegex = regexp.MustCompile(`(?is)^drop\s+function\s+(if\s+exists\s+)?(` + functionNamePattern + `)$`)
+)
+
+// isFunctionStatement returns whether the query given creates or drops a user-defined function, which the parser
+// doesn't know.
+func isFunctionStatement(s string) bool {
+	return createWASMFunctionRegex.MatchString(s) || dropFunctionRegex.MatchString(s)
+}
+
+func parseFunctionStatement(s string) (sql.Node, error) {
+	if matches := dropFunctionRegex.FindStringSubmatch(s); matches != nil {
+		name, err := parseFunctionName(matches[2])
+		if err != nil {
+			return nil, err
+		}
+		return plan.NewDropFunction(sql.UnresolvedDatabase(name.Qualifier.String()), name.Name.String(), matches[1] != ""), nil
+	}
+
+	matches := createWASMFunctionRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+	name, err := parseFunctionName(matches[2])
+	if err != nil {
+		return nil, err
+	}
+
+	// The parameters are parsed as those of a procedure, and the return type as the type of a column
+	stmt, err := sqlparser.ParseStrictDDL("CREATE PROCEDURE p(" + matches[3] + ") SELECT 1")
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	var argTypes []sql.Type
+	for _, param := range stmt.(*sqlparser.DDL).ProcedureSpec.Params {
+		if param.Direction != sqlparser.ProcedureParamDirection_In {
+			return nil, sql.ErrSyntaxError.New(s)
+		}
+		typ, err := sql.ColumnTypeToType(&param.Type)
+		if err != nil {
+			return nil, err
+		}
+		argTypes = append(argTypes, typ)
+	}
+
+	stmt, err = sqlparser.ParseStrictDDL("CREATE TABLE t (r " + matches[4] + ")")
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	columns := stmt.(*sqlparser.DDL).TableSpec.Columns
+	if len(columns) != 1 {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+	returnType, err := sql.ColumnTypeToType(&columns[0].Type)
+	if err != nil {
+		return nil, err
+	}
+
+	literal := matches[6]
+	if matches[7] != "" {
+		literal = matches[7]
+	}
+	module, err := hex.DecodeString(literal)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+
+	fn, err := wasm.NewFunction(wasm.Definition{
+		Name:       name.Name.String(),
+		ArgTypes:   argTypes,
+		ReturnType: returnType,
+		Module:     module,
+		Export:     matches[5],
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewCreateFunction(sql.UnresolvedDatabase(name.Qualifier.String()), name.Name.String(), matches[1] != "", fn, s), nil
+}
+
+// parseFunctionName parses the name of a function as the name of a table, which takes care of quoted and qualified
+// names.
+func parseFunctionName(s string) (sqlparser.TableName, error) {
+	stmt, err := sqlparser.Parse("SELECT * FROM " + s)
+	if err != nil {
+		return sqlparser.TableName{}, sql.ErrSyntaxError.New(err.Error())
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.From) != 1 {
+		return sqlparser.TableName{}, sql.ErrSyntaxError.New(s)
+	}
+	ate, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return sqlparser.TableName{}, sql.ErrSyntaxError.New(s)
+	}
+	name, ok := ate.Expr.(sqlparser.TableName)
+	if !ok {
+		return sqlparser.TableName{}, sql.ErrSyntaxError.New(s)
+	}
+	return name, nil
+}
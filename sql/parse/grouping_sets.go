@@ -0,0 +1,152 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
+)
+
+// hasGroupingSets returns whether the GROUP BY clause given groups rows by grouping sets, with ROLLUP, CUBE, GROUPING
+// SETS or WITH ROLLUP.
+func hasGroupingSets(g sqlparser.GroupBy) bool {
+	for _, e := range g {
+		if _, ok := e.(*sqlparser.GroupingSetExpr); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// groupingSetsToExpressions returns the distinct expressions of a GROUP BY clause with grouping sets, and the sets of
+// the indexes of the expressions it groups rows by. Rows are grouped by every combination of the sets of each of the
+// elements of the clause.
+func groupingSetsToExpressions(ctx *sql.Context, g sqlparser.GroupBy) ([]sql.Expression, [][]int, error) {
+	var exprs []sqlparser.Expr
+	index := func(e sqlparser.Expr) int {
+		for i, expr := range exprs {
+			if sqlparser.String(expr) == sqlparser.String(e) {
+				return i
+			}
+		}
+		exprs = append(exprs, e)
+		return len(exprs) - 1
+	}
+
+	// group returns the indexes of the expressions of a grouping set, which is either an expression or a tuple of
+	// expressions
+	group := func(e sqlparser.Expr) []int {
+		tuple, ok := e.(sqlparser.ValTuple)
+		if !ok {
+			return []int{index(e)}
+		}
+		set := []int{}
+		for _, e := range tuple {
+			set = append(set, index(e))
+		}
+		return set
+	}
+
+	groups := func(list sqlparser.Exprs) [][]int {
+		var gs [][]int
+		for _, e := range list {
+			gs = append(gs, group(e))
+		}
+		return gs
+	}
+
+	sets := [][]int{{}}
+	for _, element := range g {
+		var elementSets [][]int
+		if gs, ok := element.(*sqlparser.GroupingSetExpr); ok {
+			switch gs.Type {
+			case sqlparser.RollupStr, sqlparser.WithRollupStr:
+				elementSets = rollupSets(groups(gs.Exprs))
+			case sqlparser.CubeStr:
+				elementSets = cubeSets(groups(gs.Exprs))
+			default:
+				elementSets = groups(gs.Exprs)
+			}
+		} else {
+			elementSets = [][]int{group(element)}
+		}
+
+		var combined [][]int
+		for _, set := range sets {
+			for _, elementSet := range elementSets {
+				combined = append(combined, unionSet(set, elementSet))
+			}
+		}
+		sets = combined
+	}
+
+	es := make([]sql.Expression, len(exprs))
+	for i, e := range exprs {
+		var err error
+		es[i], err = ExprToExpression(ctx, e)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return es, sets, nil
+}
+
+// rollupSets returns the grouping sets of ROLLUP on the groups given: all of them, and then every prefix of them from
+// the longest one to the empty one.
+func rollupSets(groups [][]int) [][]int {
+	sets := make([][]int, 0, len(groups)+1)
+	for n := len(groups); n >= 0; n-- {
+		set := []int{}
+		for _, g := range groups[:n] {
+			set = unionSet(set, g)
+		}
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+// cubeSets returns the grouping sets of CUBE on the groups given, which are all of their combinations.
+func cubeSets(groups [][]int) [][]int {
+	sets := make([][]int, 0, 1<<len(groups))
+	for mask := (1 << len(groups)) - 1; mask >= 0; mask-- {
+		set := []int{}
+		for i, g := range groups {
+			if mask&(1<<(len(groups)-1-i)) != 0 {
+				set = unionSet(set, g)
+			}
+		}
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+// unionSet returns the indexes of both sets given, without duplicates.
+func unionSet(a, b []int) []int {
+	set := append([]int{}, a...)
+	for _, i := range b {
+		found := false
+		for _, j := range set {
+			if i == j {
+				found = true
+				break
+			}
+		}
+		if !found {
+			set = append(set, i)
+		}
+	}
+	return set
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// maxExecutionTimeHintRegex matches a MAX_EXECUTION_TIME(n) optimizer hint, which MySQL only accepts in the first
+// comment after the SELECT keyword.
+var maxExecutionTimeHintRegex = regexp.MustCompile(`(?is)^\s*select\s*/\*\+[^*]*?\bmax_execution_time\s*\(\s*(\d+)\s*\)`)
+
+// MaxExecutionTimeHint returns the number of milliseconds given in the MAX_EXECUTION_TIME optimizer hint of the query
+// given, if it has one.
+func MaxExecutionTimeHint(query string) (int64, bool) {
+	matches := maxExecutionTimeHintRegex.FindStringSubmatch(query)
+	if matches == nil {
+		return 0, false
+	}
+
+	ms, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return ms, true
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxExecutionTimeHint(t *testing.T) {
+	testCases := []struct {
+		query string
+		ms    int64
+		ok    bool
+	}{
+		{"SELECT /*+ MAX_EXECUTION_TIME(1000) */ * FROM t", 1000, true},
+		{"select /*+ JOIN_ORDER(a, b) max_execution_time( 5 ) */ * from a, b", 5, true},
+		{"SELECT * FROM t", 0, false},
+		{"SELECT /* MAX_EXECUTION_TIME(1000) */ * FROM t", 0, false},
+		{"SELECT * FROM t WHERE a = '/*+ MAX_EXECUTION_TIME(1000) */'", 0, false},
+		{"INSERT /*+ MAX_EXECUTION_TIME(1000) */ INTO t VALUES (1)", 0, false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			ms, ok := MaxExecutionTimeHint(tt.query)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.ms, ms)
+		})
+	}
+}
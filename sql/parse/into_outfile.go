@@ -0,0 +1,55 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// intoOutfileRegex matches a query ending with an INTO OUTFILE clause, which the parser doesn't know, capturing the
+// query, the file, and the FIELDS and LINES options of the clause.
+var intoOutfileRegex = regexp.MustCompile(`(?is)^((?:select|with|\()\b.*?)\s+into\s+outfile\s+('(?:[^'\\]|\\.|'')*'|"(?:[^"\\]|\\.|"")*")(.*)$`)
+
+// isSelectIntoOutfile returns whether the query given is SELECT ... INTO OUTFILE.
+func isSelectIntoOutfile(s string) bool {
+	return intoOutfileRegex.MatchString(s)
+}
+
+func parseSelectIntoOutfile(ctx *sql.Context, s string) (sql.Node, error) {
+	matches := intoOutfileRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+
+	// The file and its options are the same as those of LOAD DATA, which the parser knows
+	stmt, err := sqlparser.Parse("LOAD DATA INFILE " + matches[2] + " INTO TABLE t " + matches[3])
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	load, ok := stmt.(*sqlparser.Load)
+	if !ok {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+
+	query, err := Parse(ctx, matches[1])
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewIntoOutfile(query, load.Infile, load.Fields, load.Lines), nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func parseKill(s string) (sql.Node, error) {
+	matches := killRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+
+	connID, err := strconv.ParseUint(matches[2], 10, 32)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+
+	kt := plan.KillType_Connection
+	if matches[1] == "query" {
+		kt = plan.KillType_Query
+	}
+
+	return plan.NewKill(kt, uint32(connID)), nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// loadDataModifierRegex matches the IGNORE or REPLACE keyword of a LOAD DATA statement, which the parser doesn't know.
+var loadDataModifierRegex = regexp.MustCompile(`(?is)^load\s+data\s+(?:local\s+)?infile\s+(?:'(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*")\s+(ignore|replace)\s+into\s+table\s`)
+
+// parseLoadDataModifier parses a LOAD DATA statement with the IGNORE or REPLACE keyword matched by
+// loadDataModifierRegex. The statement is parsed without the keyword, which is then set on the resulting insert.
+func parseLoadDataModifier(ctx *sql.Context, query string, match []int) (sql.Node, error) {
+	modifier := strings.ToLower(query[match[2]:match[3]])
+	node, err := Parse(ctx, query[:match[2]]+query[match[3]:])
+	if err != nil {
+		return nil, err
+	}
+
+	insert, ok := node.(*plan.InsertInto)
+	if !ok {
+		return nil, ErrUnsupportedSyntax.New(query)
+	}
+	load, ok := insert.Source.(*plan.LoadData)
+	if !ok {
+		return nil, ErrUnsupportedSyntax.New(query)
+	}
+
+	insert = insert.WithSource(load).(*plan.InsertInto)
+	if modifier == "ignore" {
+		load.Ignore = true
+		insert.Ignore = true
+	} else {
+		insert.IsReplace = true
+	}
+	return insert, nil
+}
+
+// loadDataCharsetRegex matches the CHARACTER SET clause of a LOAD DATA statement, which the parser doesn't support,
+// after the table and partitions the rows are loaded into.
+var loadDataCharsetRegex = regexp.MustCompile(`(?is)^load\s+data\s.*?\sinto\s+table\s+(?:` + "`[^`]*`" + `|[\w$]+)(?:\.(?:` + "`[^`]*`" + `|[\w$]+))?(?:\s+partition\s*\([^)]*\))?(\s+(?:character\s+set|charset)\s+('[^']*'|"[^"]*"|[\w$]+))`)
+
+// parseLoadDataCharset parses a LOAD DATA statement with the CHARACTER SET clause matched by loadDataCharsetRegex.
+// The statement is parsed without the clause, and the character set is then set on the resulting LOAD DATA.
+func parseLoadDataCharset(ctx *sql.Context, query string, match []int) (sql.Node, error) {
+	charset := strings.ToLower(strings.Trim(query[match[4]:match[5]], "'\""))
+	if charset != "default" {
+		if _, err := sql.ParseCharacterSet(charset); err != nil {
+			return nil, err
+		}
+	}
+
+	node, err := Parse(ctx, query[:match[2]]+query[match[3]:])
+	if err != nil {
+		return nil, err
+	}
+
+	insert, ok := node.(*plan.InsertInto)
+	if !ok {
+		return nil, ErrUnsupportedSyntax.New(query)
+	}
+	load, ok := insert.Source.(*plan.LoadData)
+	if !ok {
+		return nil, ErrUnsupportedSyntax.New(query)
+	}
+
+	load.Charset = charset
+	return insert, nil
+}
@@ -22,7 +22,6 @@ import (
 	"time"
 	"unicode"
 
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/opentracing/opentracing-go"
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -30,6 +29,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -62,9 +62,10 @@ var (
 
 var (
 	showVariablesRegex   = regexp.MustCompile(`^show\s+(.*)?variables\s*`)
-	showWarningsRegex    = regexp.MustCompile(`^show\s+warnings\s*`)
+	showWarningsRegex    = regexp.MustCompile(`^show\s+(count\s*\(\s*\*\s*\)\s*)?(warnings|errors)\b`)
 	fullProcessListRegex = regexp.MustCompile(`^show\s+(full\s+)?processlist$`)
 	setRegex             = regexp.MustCompile(`^set\s+`)
+	killRegex            = regexp.MustCompile(`^kill\s+(?:(query|connection)\s+)?(\d+)$`)
 )
 
 var describeSupportedFormats = []string{"tree"}
@@ -127,9 +128,32 @@ func Parse(ctx *sql.Context, query string) (sql.Node, error) {
 		return plan.NewShowProcessList(), nil
 	case setRegex.MatchString(lowerQuery):
 		s = fixSetQuery(s)
+	case killRegex.MatchString(lowerQuery):
+		return parseKill(lowerQuery)
+	case createTemporaryDatabaseRegex.MatchString(lowerQuery):
+		return parseCreateTemporaryDatabase(s)
+	case analyzeTableRegex.MatchString(lowerQuery):
+		return parseAnalyzeTable(s)
+	case isBinlogStatement(lowerQuery):
+		return parseBinlogStatement(s)
+	case isReplicationStatement(lowerQuery):
+		return parseReplicationStatement(s)
+	case isFunctionStatement(lowerQuery):
+		return parseFunctionStatement(s)
+	case isSelectIntoOutfile(lowerQuery):
+		return parseSelectIntoOutfile(ctx, s)
 	}
 
-	stmt, err := sqlparser.Parse(s)
+	if strings.HasPrefix(lowerQuery, "load") {
+		if match := loadDataModifierRegex.FindStringSubmatchIndex(s); match != nil {
+			return parseLoadDataModifier(ctx, s, match)
+		}
+		if match := loadDataCharsetRegex.FindStringSubmatchIndex(s); match != nil {
+			return parseLoadDataCharset(ctx, s, match)
+		}
+	}
+
+	stmt, err := sqlparser.ParseWithOptions(s, parserOptions(ctx))
 	if err != nil {
 		if err.Error() == "empty statement" {
 			ctx.Warn(0, "query was empty after trimming comments, so it will be ignored")
@@ -234,6 +258,8 @@ func convert(ctx *sql.Context, stmt sqlparser.Statement, query string) (sql.Node
 		return convertDeclare(ctx, n)
 	case *sqlparser.Signal:
 		return convertSignal(ctx, n)
+	case *sqlparser.Resignal:
+		return convertResignal(ctx, n)
 	case *sqlparser.LockTables:
 		return convertLockTables(ctx, n)
 	case *sqlparser.UnlockTables:
@@ -423,6 +449,11 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 			sql.UnresolvedDatabase(s.Table.Qualifier.String()),
 			s.Table.Name.String(),
 		), nil
+	case sqlparser.DiffStr:
+		return plan.NewShowDiff(
+			tableNameToUnresolvedTable(s.OnTable),
+			tableNameToUnresolvedTable(s.Table),
+		), nil
 	case "grants":
 		return plan.NewShowGrants(), nil
 	case "triggers":
@@ -642,15 +673,64 @@ func convertUnion(ctx *sql.Context, u *sqlparser.Union) (sql.Node, error) {
 		return nil, err
 	}
 
-	if u.Type == sqlparser.UnionAllStr {
-		return plan.NewUnion(left, right), nil
-	} else { // default is DISTINCT (either explicit or implicit)
+	var node sql.Node
+	switch u.Type {
+	case sqlparser.IntersectStr, sqlparser.IntersectDistinctStr:
+		node = plan.NewSetOp(plan.SetOp_Intersect, true, left, right)
+	case sqlparser.IntersectAllStr:
+		node = plan.NewSetOp(plan.SetOp_Intersect, false, left, right)
+	case sqlparser.ExceptStr, sqlparser.ExceptDistinctStr:
+		node = plan.NewSetOp(plan.SetOp_Except, true, left, right)
+	case sqlparser.ExceptAllStr:
+		node = plan.NewSetOp(plan.SetOp_Except, false, left, right)
+	case sqlparser.UnionAllStr:
+		node = plan.NewUnion(left, right)
+	default: // default is DISTINCT (either explicit or implicit)
 		// TODO: this creates redundant Distinct nodes that we can't easily remove after the fact. With this construct,
 		//  we can't in all cases tell the difference between `union distinct (select ...)` and
 		//  `union (select distinct ...)`. We need something like a Distinct property on Union nodes to be able to prune
 		//  redundant Distinct nodes and thereby avoid doing extra work.
-		return plan.NewDistinct(plan.NewUnion(left, right)), nil
+		node = plan.NewDistinct(plan.NewUnion(left, right))
+	}
+
+	return orderByAndLimitToNodes(ctx, u.OrderBy, u.Limit, node)
+}
+
+// setOperationAlias is the name of the derived table holding the result of a set operation with an ORDER BY clause.
+const setOperationAlias = "__set_operation"
+
+// orderByAndLimitToNodes applies the ORDER BY and LIMIT clauses given, which may be empty, to the combined result of a
+// set operation.
+func orderByAndLimitToNodes(ctx *sql.Context, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, node sql.Node) (sql.Node, error) {
+	var err error
+	if len(orderBy) != 0 {
+		// The ORDER BY names the columns of the combined result, which is selected from like a derived table so the
+		// sort can be resolved and pushed down as usual.
+		node = plan.NewProject(
+			[]sql.Expression{expression.NewStar()},
+			plan.NewSubqueryAlias(setOperationAlias, "", node),
+		)
+		node, err = orderByToSort(ctx, orderBy, node)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if limit != nil && limit.Offset != nil {
+		node, err = offsetToOffset(ctx, limit.Offset, node)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if limit != nil {
+		node, err = limitToLimit(ctx, limit.Rowcount, node)
+		if err != nil {
+			return nil, err
+		}
 	}
+
+	return node, nil
 }
 
 func convertSelect(ctx *sql.Context, s *sqlparser.Select) (sql.Node, error) {
@@ -940,6 +1020,9 @@ func convertDeclare(ctx *sql.Context, d *sqlparser.Declare) (sql.Node, error) {
 	if d.Condition != nil {
 		return convertDeclareCondition(ctx, d)
 	}
+	if d.Handler != nil {
+		return convertDeclareHandler(ctx, d)
+	}
 	return nil, ErrUnsupportedSyntax.New(sqlparser.String(d))
 }
 
@@ -964,11 +1047,102 @@ func convertDeclareCondition(ctx *sql.Context, d *sqlparser.Declare) (sql.Node,
 	return plan.NewDeclareCondition(strings.ToLower(dc.Name), 0, dc.SqlStateValue), nil
 }
 
+func convertDeclareHandler(ctx *sql.Context, d *sqlparser.Declare) (sql.Node, error) {
+	dh := d.Handler
+
+	var action plan.DeclareHandlerAction
+	switch dh.Action {
+	case sqlparser.DeclareHandlerAction_Continue:
+		action = plan.DeclareHandlerAction_Continue
+	case sqlparser.DeclareHandlerAction_Exit:
+		action = plan.DeclareHandlerAction_Exit
+	default:
+		return nil, ErrUnsupportedFeature.New("UNDO handlers")
+	}
+
+	conditions := make([]plan.HandlerCondition, len(dh.ConditionValues))
+	for i, cv := range dh.ConditionValues {
+		switch cv.ValueType {
+		case sqlparser.DeclareHandlerCondition_MysqlErrorCode:
+			code, err := strconv.ParseInt(string(cv.MysqlErrorCode.Val), 10, 64)
+			if err != nil || code == 0 {
+				// We use our own error instead
+				return nil, fmt.Errorf("invalid value '%s' for MySQL error code", string(cv.MysqlErrorCode.Val))
+			}
+			conditions[i] = plan.HandlerCondition{Type: plan.HandlerConditionType_MysqlErrCode, MysqlErrCode: code}
+		case sqlparser.DeclareHandlerCondition_SqlState:
+			if err := validateSqlState(cv.String); err != nil {
+				return nil, err
+			}
+			conditions[i] = plan.HandlerCondition{Type: plan.HandlerConditionType_SqlState, SqlStateValue: cv.String}
+		case sqlparser.DeclareHandlerCondition_ConditionName:
+			conditions[i] = plan.HandlerCondition{Type: plan.HandlerConditionType_ConditionName, Name: strings.ToLower(cv.String)}
+		case sqlparser.DeclareHandlerCondition_SqlWarning:
+			conditions[i] = plan.HandlerCondition{Type: plan.HandlerConditionType_SqlWarning}
+		case sqlparser.DeclareHandlerCondition_NotFound:
+			conditions[i] = plan.HandlerCondition{Type: plan.HandlerConditionType_NotFound}
+		case sqlparser.DeclareHandlerCondition_SqlException:
+			conditions[i] = plan.HandlerCondition{Type: plan.HandlerConditionType_SqlException}
+		default:
+			return nil, ErrUnsupportedSyntax.New(sqlparser.String(d))
+		}
+	}
+
+	statement, err := convert(ctx, dh.Statement, sqlparser.String(dh.Statement))
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.NewDeclareHandler(action, conditions, statement), nil
+}
+
+// validateSqlState returns an error if the SQLSTATE value given isn't one that conditions can be raised with.
+func validateSqlState(state string) error {
+	if len(state) != 5 {
+		return fmt.Errorf("SQLSTATE VALUE must be a string with length 5 consisting of only integers")
+	}
+	if state[0:2] == "00" {
+		return fmt.Errorf("invalid SQLSTATE VALUE: '%s'", state)
+	}
+	return nil
+}
+
 func convertSignal(ctx *sql.Context, s *sqlparser.Signal) (sql.Node, error) {
+	signalInfo, err := convertSignalInfo(s.Info)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.ConditionName != "" {
+		return plan.NewSignalName(strings.ToLower(s.ConditionName), signalInfo), nil
+	} else {
+		if err := validateSqlState(s.SqlStateValue); err != nil {
+			return nil, err
+		}
+		return plan.NewSignal(s.SqlStateValue, signalInfo), nil
+	}
+}
+
+func convertResignal(ctx *sql.Context, s *sqlparser.Resignal) (sql.Node, error) {
+	signalInfo, err := convertSignalInfo(s.Info)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a condition value, the handled condition is raised again
+	if s.SqlStateValue != "" {
+		if err := validateSqlState(s.SqlStateValue); err != nil {
+			return nil, err
+		}
+	}
+	return plan.NewResignal(s.SqlStateValue, strings.ToLower(s.ConditionName), signalInfo), nil
+}
+
+func convertSignalInfo(items []sqlparser.SignalInfo) (map[plan.SignalConditionItemName]plan.SignalInfo, error) {
 	// https://dev.mysql.com/doc/refman/8.0/en/signal.html#signal-condition-information-items
 	var err error
 	signalInfo := make(map[plan.SignalConditionItemName]plan.SignalInfo)
-	for _, info := range s.Info {
+	for _, info := range items {
 		si := plan.SignalInfo{}
 		si.ConditionItemName, err = convertSignalConditionItemName(info.ConditionItemName)
 		if err != nil {
@@ -1000,18 +1174,7 @@ func convertSignal(ctx *sql.Context, s *sqlparser.Signal) (sql.Node, error) {
 		}
 		signalInfo[si.ConditionItemName] = si
 	}
-
-	if s.ConditionName != "" {
-		return plan.NewSignalName(strings.ToLower(s.ConditionName), signalInfo), nil
-	} else {
-		if len(s.SqlStateValue) != 5 {
-			return nil, fmt.Errorf("SQLSTATE VALUE must be a string with length 5 consisting of only integers")
-		}
-		if s.SqlStateValue[0:2] == "00" {
-			return nil, fmt.Errorf("invalid SQLSTATE VALUE: '%s'", s.SqlStateValue)
-		}
-		return plan.NewSignal(s.SqlStateValue, signalInfo), nil
-	}
+	return signalInfo, nil
 }
 
 func convertLockTables(ctx *sql.Context, s *sqlparser.LockTables) (sql.Node, error) {
@@ -1073,15 +1236,28 @@ func convertRenameTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error)
 		panic("Expected from tables and to tables of equal length")
 	}
 
+	// Tables can only be renamed within a single database, which is the current one unless the tables are qualified.
+	// New names without a qualifier are in the database of the renamed tables.
+	var db string
+	if len(ddl.FromTables) > 0 {
+		db = ddl.FromTables[0].Qualifier.String()
+	}
+
 	var fromTables, toTables []string
 	for _, table := range ddl.FromTables {
+		if !strings.EqualFold(table.Qualifier.String(), db) {
+			return nil, ErrUnsupportedFeature.New("RENAME TABLE across databases")
+		}
 		fromTables = append(fromTables, table.Name.String())
 	}
 	for _, table := range ddl.ToTables {
+		if !table.Qualifier.IsEmpty() && !strings.EqualFold(table.Qualifier.String(), db) {
+			return nil, ErrUnsupportedFeature.New("RENAME TABLE across databases")
+		}
 		toTables = append(toTables, table.Name.String())
 	}
 
-	return plan.NewRenameTable(sql.UnresolvedDatabase(""), fromTables, toTables), nil
+	return plan.NewRenameTable(sql.UnresolvedDatabase(db), fromTables, toTables), nil
 }
 
 func convertAlterTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error) {
@@ -1148,6 +1324,47 @@ func convertAlterTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error) {
 	return nil, ErrUnsupportedFeature.New(sqlparser.String(ddl))
 }
 
+// pivotToPivot converts the PIVOT or UNPIVOT clause of the table given.
+func pivotToPivot(ctx *sql.Context, p *sqlparser.Pivot, child sql.Node) (sql.Node, error) {
+	values := make([]sql.Expression, len(p.In))
+	for i, se := range p.In {
+		ae, ok := se.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, ErrUnsupportedSyntax.New(sqlparser.String(p))
+		}
+
+		expr, err := ExprToExpression(ctx, ae.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		// The values aren't the columns of a select, so they're only aliased when they have an explicit alias
+		if !ae.As.IsEmpty() {
+			expr = expression.NewAlias(ae.As.String(), expr)
+		}
+		values[i] = expr
+	}
+
+	if p.Type == sqlparser.UnpivotStr {
+		valueColumn, ok := p.Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, ErrUnsupportedSyntax.New(sqlparser.String(p))
+		}
+		return plan.NewUnpivot(valueColumn.Name.String(), p.For.Name.String(), values, child), nil
+	}
+
+	aggregate, err := ExprToExpression(ctx, p.Expr)
+	if err != nil {
+		return nil, err
+	}
+	column, err := ExprToExpression(ctx, p.For)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.NewPivot(aggregate, column, values, child), nil
+}
+
 func tableNameToUnresolvedTable(tableName sqlparser.TableName) *plan.UnresolvedTable {
 	return plan.NewUnresolvedTable(tableName.Name.String(), tableName.Qualifier.String())
 }
@@ -1440,8 +1657,18 @@ func convertCreateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 		return plan.NewCreateTableSelect(sql.UnresolvedDatabase(qualifier), c.Table.Name.String(), selectNode, tableSpec, plan.IfNotExistsOption(c.IfNotExists), plan.TempTableOption(c.Temporary)), nil
 	}
 
-	return plan.NewCreateTable(
-		sql.UnresolvedDatabase(qualifier), c.Table.Name.String(), plan.IfNotExistsOption(c.IfNotExists), plan.TempTableOption(c.Temporary), tableSpec), nil
+	ct := plan.NewCreateTable(
+		sql.UnresolvedDatabase(qualifier), c.Table.Name.String(), plan.IfNotExistsOption(c.IfNotExists), plan.TempTableOption(c.Temporary), tableSpec)
+
+	if c.TableSpec.PartitionOpt != nil {
+		scheme, err := partitionOptionToScheme(ctx, c.TableSpec.PartitionOpt, ct.Name(), ct.Schema())
+		if err != nil {
+			return nil, err
+		}
+		ct = ct.WithPartitionScheme(scheme)
+	}
+
+	return ct, nil
 }
 
 type namedConstraint struct {
@@ -1686,7 +1913,11 @@ func convertLoad(ctx *sql.Context, d *sqlparser.Load) (sql.Node, error) {
 
 	ld := plan.NewLoadData(bool(d.Local), d.Infile, unresolvedTable, columnsToStrings(d.Columns), d.Fields, d.Lines, ignoreNumVal)
 
-	return plan.NewInsertInto(sql.UnresolvedDatabase(d.Table.Qualifier.String()), tableNameToUnresolvedTable(d.Table), ld, false, ld.ColumnNames, nil, false), nil
+	// The file of LOAD DATA LOCAL is sent by the client, which can't stop sending it when a line fails, so duplicate
+	// keys and invalid lines are skipped with a warning, as if IGNORE was given.
+	ld.Ignore = ld.Local
+
+	return plan.NewInsertInto(sql.UnresolvedDatabase(d.Table.Qualifier.String()), tableNameToUnresolvedTable(d.Table), ld, false, ld.ColumnNames, nil, ld.Ignore), nil
 }
 
 // TableSpecToSchema creates a sql.Schema from a parsed TableSpec
@@ -1878,18 +2109,31 @@ func tableExprsToTable(
 		nodes = append(nodes, n)
 	}
 
-	if len(nodes) == 1 {
-		return nodes[0], nil
-	}
-
-	join := plan.NewCrossJoin(nodes[0], nodes[1])
-	for i := 2; i < len(nodes); i++ {
-		join = plan.NewCrossJoin(join, nodes[i])
+	join := nodes[0]
+	for i := 1; i < len(nodes); i++ {
+		if isLateralTableExpr(te[i]) {
+			join = plan.NewLateralJoin(join, nodes[i], false)
+		} else {
+			join = plan.NewCrossJoin(join, nodes[i])
+		}
 	}
 
 	return join, nil
 }
 
+// isLateralTableExpr returns whether the table given may reference the columns of the tables that precede it, which
+// makes it the right side of a lateral join. JSON_TABLEs always may.
+func isLateralTableExpr(te sqlparser.TableExpr) bool {
+	switch te := te.(type) {
+	case *sqlparser.AliasedTableExpr:
+		return te.Lateral
+	case *sqlparser.JSONTableExpr:
+		return true
+	default:
+		return false
+	}
+}
+
 func tableExprToTable(
 	ctx *sql.Context,
 	te sqlparser.TableExpr,
@@ -1912,6 +2156,17 @@ func tableExprToTable(
 				node = tableNameToUnresolvedTable(e)
 			}
 
+			if t.Pivot != nil {
+				pivot, err := pivotToPivot(ctx, t.Pivot, node)
+				if err != nil {
+					return nil, err
+				}
+				if !t.As.IsEmpty() {
+					return plan.NewTableAlias(t.As.String(), pivot), nil
+				}
+				return pivot, nil
+			}
+
 			if !t.As.IsEmpty() {
 				return plan.NewTableAlias(t.As.String(), node), nil
 			}
@@ -1957,6 +2212,8 @@ func tableExprToTable(
 		default:
 			return nil, ErrUnsupportedSyntax.New(sqlparser.String(te))
 		}
+	case *sqlparser.JSONTableExpr:
+		return jsonTableToTable(ctx, t)
 	case *sqlparser.JoinTableExpr:
 		// TODO: add support for using, once we have proper table
 		// qualification of fields
@@ -1979,6 +2236,9 @@ func tableExprToTable(
 		}
 
 		if t.Condition.On == nil {
+			if isLateralTableExpr(t.RightExpr) {
+				return plan.NewLateralJoin(left, right, false), nil
+			}
 			return plan.NewCrossJoin(left, right), nil
 		}
 
@@ -1987,6 +2247,10 @@ func tableExprToTable(
 			return nil, err
 		}
 
+		if isLateralTableExpr(t.RightExpr) {
+			return lateralJoinToJoin(t.Join, left, right, cond)
+		}
+
 		switch strings.ToLower(t.Join) {
 		case sqlparser.JoinStr:
 			return plan.NewInnerJoin(left, right, cond), nil
@@ -2000,6 +2264,105 @@ func tableExprToTable(
 	}
 }
 
+// lateralJoinToJoin converts a join with a lateral table on its right side, which is evaluated once for each row of
+// its left side.
+func lateralJoinToJoin(joinType string, left, right sql.Node, cond sql.Expression) (sql.Node, error) {
+	switch strings.ToLower(joinType) {
+	case sqlparser.JoinStr:
+		join := plan.NewLateralJoin(left, right, false)
+		if isTrueLiteral(cond) {
+			return join, nil
+		}
+		return plan.NewFilter(cond, join), nil
+	case sqlparser.LeftJoinStr:
+		if !isTrueLiteral(cond) {
+			return nil, ErrUnsupportedFeature.New("LEFT JOIN with a lateral table and a condition other than ON TRUE")
+		}
+		return plan.NewLateralJoin(left, right, true), nil
+	case sqlparser.RightJoinStr:
+		return nil, ErrUnsupportedFeature.New("RIGHT JOIN with a lateral table")
+	default:
+		return nil, ErrUnsupportedFeature.New("Join type " + joinType)
+	}
+}
+
+func isTrueLiteral(e sql.Expression) bool {
+	lit, ok := e.(*expression.Literal)
+	if !ok {
+		return false
+	}
+	b, err := sql.ConvertToBool(lit.Value())
+	return err == nil && b
+}
+
+// jsonTableToTable converts a JSON_TABLE.
+func jsonTableToTable(ctx *sql.Context, t *sqlparser.JSONTableExpr) (sql.Node, error) {
+	expr, err := ExprToExpression(ctx, t.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := jsonTableColumnsToColumns(t.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.NewJSONTable(expr, string(t.Path.Val), columns, t.Alias.String()), nil
+}
+
+func jsonTableColumnsToColumns(cols []*sqlparser.JSONTableColumn) ([]plan.JSONTableColumn, error) {
+	columns := make([]plan.JSONTableColumn, len(cols))
+	for i, c := range cols {
+		if c.Nested != nil {
+			nested, err := jsonTableColumnsToColumns(c.Nested)
+			if err != nil {
+				return nil, err
+			}
+			columns[i] = plan.JSONTableColumn{Path: string(c.Path.Val), Nested: nested}
+			continue
+		}
+
+		if c.Ordinality {
+			columns[i] = plan.JSONTableColumn{Name: c.Name.String(), Type: sql.Uint32, Ordinality: true}
+			continue
+		}
+
+		typ, err := sql.ColumnTypeToType(&c.Type)
+		if err != nil {
+			return nil, err
+		}
+		columns[i] = plan.JSONTableColumn{
+			Name:    c.Name.String(),
+			Type:    typ,
+			Path:    string(c.Path.Val),
+			Exists:  c.Exists,
+			OnEmpty: jsonTableFallbackToFallback(c.OnEmpty),
+			OnError: jsonTableFallbackToFallback(c.OnError),
+		}
+	}
+	return columns, nil
+}
+
+// jsonTableFallbackToFallback converts the NULL, ERROR or DEFAULT value of an ON EMPTY or ON ERROR clause.
+func jsonTableFallbackToFallback(f *sqlparser.JSONTableFallback) plan.JSONTableFallback {
+	switch {
+	case f == nil:
+		return plan.JSONTableFallback{}
+	case f.Error:
+		return plan.JSONTableFallback{Error: true}
+	case f.Default != nil:
+		value := string(f.Default.Val)
+		// The default is a JSON value, or a string if it isn't valid JSON
+		doc, err := sql.JSON.Convert(value)
+		if err != nil {
+			return plan.JSONTableFallback{Default: value}
+		}
+		return plan.JSONTableFallback{Default: doc.(sql.JSONDocument).Val}
+	default:
+		return plan.JSONTableFallback{}
+	}
+}
+
 func whereToFilter(ctx *sql.Context, w *sqlparser.Where, child sql.Node) (*plan.Filter, error) {
 	c, err := ExprToExpression(ctx, w.Expr)
 	if err != nil {
@@ -2162,11 +2525,9 @@ func selectToSelectionNode(
 		for _, e := range selectExprs {
 			if isAggregateExpr(e) {
 				sql.Inspect(e, func(e sql.Expression) bool {
-					if uf, ok := e.(*expression.UnresolvedFunction); ok {
-						if uf.Window == nil || len(uf.Window.PartitionBy) > 0 || len(uf.Window.OrderBy) > 0 {
-							err = ErrUnsupportedFeature.New("aggregate functions appearing alongside window functions must have an empty OVER () clause")
-							return false
-						}
+					if uf, ok := e.(*expression.UnresolvedFunction); ok && uf.IsAggregate && uf.Window == nil {
+						err = ErrUnsupportedFeature.New("aggregate functions appearing alongside window functions must have an OVER clause")
+						return false
 					}
 					return true
 				})
@@ -2189,7 +2550,7 @@ func selectToSelectionNode(
 	}
 
 	if isAgg {
-		groupingExprs, err := groupByToExpressions(ctx, g)
+		groupingExprs, groupingSets, err := groupByToExpressions(ctx, g)
 		if err != nil {
 			return nil, err
 		}
@@ -2200,9 +2561,11 @@ func selectToSelectionNode(
 			if l, ok := ge.(*expression.Literal); ok && sql.IsNumber(l.Type()) {
 				if i64, err := sql.Int64.Convert(l.Value()); err == nil {
 					if idx, ok := i64.(int64); ok && idx > 0 && idx <= agglen {
+						// Group by the selected expression itself rather than its alias, whose name may be
+						// shadowed by a column of the tables in the FROM clause.
 						aggexpr := selectExprs[idx-1]
 						if alias, ok := aggexpr.(*expression.Alias); ok {
-							aggexpr = expression.NewUnresolvedColumn(alias.Name())
+							aggexpr = alias.Child
 						}
 						groupingExprs[i] = aggexpr
 					}
@@ -2210,7 +2573,11 @@ func selectToSelectionNode(
 			}
 		}
 
-		return plan.NewGroupBy(selectExprs, groupingExprs, child), nil
+		groupBy := plan.NewGroupBy(selectExprs, groupingExprs, child)
+		if groupingSets != nil {
+			return groupBy.WithGroupingSets(groupingSets), nil
+		}
+		return groupBy, nil
 	}
 
 	return plan.NewProject(selectExprs, child), nil
@@ -2384,8 +2751,20 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 			exprs[0] = expression.NewDistinctExpression(exprs[0])
 		}
 
+		window, err := overToWindow(ctx, v.Over)
+		if err != nil {
+			return nil, err
+		}
+
+		if !v.Qualifier.IsEmpty() {
+			// Functions qualified with a database are the functions of that database, which aren't aggregations
+			uf := expression.NewUnresolvedFunction(v.Name.Lowered(), false, window, exprs...)
+			uf.Database = v.Qualifier.String()
+			return uf, nil
+		}
+
 		return expression.NewUnresolvedFunction(v.Name.Lowered(),
-			isAggregateFunc(v), overToWindow(ctx, v.Over), exprs...), nil
+			isAggregateFunc(v), window, exprs...), nil
 	case *sqlparser.GroupConcatExpr:
 		exprs, err := selectExprsToExpressions(ctx, v.Exprs)
 		if err != nil {
@@ -2495,6 +2874,20 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 		return caseExprToExpression(ctx, v)
 	case *sqlparser.IntervalExpr:
 		return intervalExprToExpression(ctx, v)
+	case *sqlparser.TimestampFuncExpr:
+		return timestampFuncExprToExpression(ctx, v)
+	case *sqlparser.VarAssignmentExpr:
+		expr, err := ExprToExpression(ctx, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewUserVarAssignment(v.Name, expr), nil
+	case *sqlparser.ExtractFuncExpr:
+		expr, err := ExprToExpression(ctx, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewUnresolvedFunction("extract", false, nil, expression.NewLiteral(v.Unit, sql.LongText), expr), nil
 	case *sqlparser.CollateExpr:
 		// TODO: handle collation
 		return ExprToExpression(ctx, v.Expr)
@@ -2514,14 +2907,14 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 	}
 }
 
-func overToWindow(ctx *sql.Context, over *sqlparser.Over) *sql.Window {
+func overToWindow(ctx *sql.Context, over *sqlparser.Over) (*sql.Window, error) {
 	if over == nil {
-		return nil
+		return nil, nil
 	}
 
 	sortFields, err := orderByToSortFields(ctx, over.OrderBy)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
 	partitions := make([]sql.Expression, len(over.PartitionBy))
@@ -2529,16 +2922,77 @@ func overToWindow(ctx *sql.Context, over *sqlparser.Over) *sql.Window {
 		var err error
 		partitions[i], err = ExprToExpression(ctx, expr)
 		if err != nil {
-			return nil
+			return nil, err
 		}
 	}
 
-	return sql.NewWindow(partitions, sortFields)
+	window := sql.NewWindow(partitions, sortFields)
+	if over.Frame == nil {
+		return window, nil
+	}
+
+	frame, err := windowFrameToFrame(over.Frame)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Unit == sql.RangeFrame && (hasFrameOffset(frame.Start) || hasFrameOffset(frame.End)) &&
+		len(sortFields) != 1 {
+		return nil, sql.ErrWindowFrameRangeOrderBy.New()
+	}
+
+	return window.WithFrame(frame), nil
+}
+
+// windowFrameToFrame converts the frame of a window specification. A frame with a single bound ends at the current
+// row.
+func windowFrameToFrame(f *sqlparser.WindowFrame) (*sql.WindowFrame, error) {
+	unit := sql.RowsFrame
+	if f.Unit == sqlparser.RangeStr {
+		unit = sql.RangeFrame
+	}
+
+	start, err := frameBoundToBound(f.Start)
+	if err != nil {
+		return nil, err
+	}
+	end := sql.WindowFrameBound{Type: sql.CurrentRow}
+	if f.End != nil {
+		end, err = frameBoundToBound(f.End)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.NewWindowFrame(unit, start, end)
+}
+
+func frameBoundToBound(b *sqlparser.FrameBound) (sql.WindowFrameBound, error) {
+	switch b.Type {
+	case sqlparser.UnboundedPrecedingStr:
+		return sql.WindowFrameBound{Type: sql.UnboundedPreceding}, nil
+	case sqlparser.CurrentRowStr:
+		return sql.WindowFrameBound{Type: sql.CurrentRow}, nil
+	case sqlparser.UnboundedFollowingStr:
+		return sql.WindowFrameBound{Type: sql.UnboundedFollowing}, nil
+	}
+
+	offset, err := strconv.ParseInt(string(b.Offset.(*sqlparser.SQLVal).Val), 10, 64)
+	if err != nil {
+		return sql.WindowFrameBound{}, ErrUnsupportedSyntax.New(sqlparser.String(b))
+	}
+	if b.Type == sqlparser.FollowingStr {
+		return sql.WindowFrameBound{Type: sql.Following, Offset: offset}, nil
+	}
+	return sql.WindowFrameBound{Type: sql.Preceding, Offset: offset}, nil
+}
+
+func hasFrameOffset(b sql.WindowFrameBound) bool {
+	return b.Type == sql.Preceding || b.Type == sql.Following
 }
 
 func isAggregateFunc(v *sqlparser.FuncExpr) bool {
 	switch v.Name.Lowered() {
-	case "first", "last":
+	case "first", "last", "approx_count_distinct", "approx_percentile", "median", "percentile_cont", "percentile_disc":
 		return true
 	}
 
@@ -2715,18 +3169,24 @@ func comparisonExprToExpression(ctx *sql.Context, c *sqlparser.ComparisonExpr) (
 	}
 }
 
-func groupByToExpressions(ctx *sql.Context, g sqlparser.GroupBy) ([]sql.Expression, error) {
+// groupByToExpressions returns the expressions of the GROUP BY clause given, and its grouping sets, which are nil
+// unless it has ROLLUP, CUBE, GROUPING SETS or WITH ROLLUP.
+func groupByToExpressions(ctx *sql.Context, g sqlparser.GroupBy) ([]sql.Expression, [][]int, error) {
+	if hasGroupingSets(g) {
+		return groupingSetsToExpressions(ctx, g)
+	}
+
 	es := make([]sql.Expression, len(g))
 	for i, ve := range g {
 		e, err := ExprToExpression(ctx, ve)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		es[i] = e
 	}
 
-	return es, nil
+	return es, nil, nil
 }
 
 func selectExprToExpression(ctx *sql.Context, se sqlparser.SelectExpr) (sql.Expression, error) {
@@ -2748,6 +3208,14 @@ func selectExprToExpression(ctx *sql.Context, se sqlparser.SelectExpr) (sql.Expr
 			return expression.NewAlias(e.As.String(), expr), nil
 		}
 
+		// Only the expressions of select lists have an input expression, the arguments of functions don't
+		if name, ok := literalColumnName(e.Expr); ok && len(e.InputExpression) > 0 {
+			if name == expr.String() {
+				return expr, nil
+			}
+			return expression.NewAlias(name, expr), nil
+		}
+
 		if selectExprNeedsAlias(e, expr) {
 			return expression.NewAlias(e.InputExpression, expr), nil
 		}
@@ -2756,6 +3224,21 @@ func selectExprToExpression(ctx *sql.Context, se sqlparser.SelectExpr) (sql.Expr
 	}
 }
 
+// literalColumnName returns the name MySQL gives to the column of a select expression that's a literal, if it's
+// different from the way it was written: string literals are named after their value, without quotes, and NULL is
+// always upper case.
+func literalColumnName(e sqlparser.Expr) (string, bool) {
+	switch e := e.(type) {
+	case *sqlparser.SQLVal:
+		if e.Type == sqlparser.StrVal {
+			return string(e.Val), true
+		}
+	case *sqlparser.NullVal:
+		return "NULL", true
+	}
+	return "", false
+}
+
 func selectExprNeedsAlias(e *sqlparser.AliasedExpr, expr sql.Expression) bool {
 	if len(e.InputExpression) == 0 {
 		return false
@@ -2847,6 +3330,18 @@ func binaryExprToExpression(ctx *sql.Context, be *sqlparser.BinaryExpr) (sql.Exp
 		}
 
 		return expression.NewArithmetic(l, r, be.Operator), nil
+	case sqlparser.ConcatStr:
+		l, err := ExprToExpression(ctx, be.Left)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := ExprToExpression(ctx, be.Right)
+		if err != nil {
+			return nil, err
+		}
+
+		return expression.NewUnresolvedFunction("concat", false, nil, l, r), nil
 	case
 		sqlparser.JSONExtractOp,
 		sqlparser.JSONUnquoteExtractOp:
@@ -2908,6 +3403,29 @@ func intervalExprToExpression(ctx *sql.Context, e *sqlparser.IntervalExpr) (sql.
 	return expression.NewInterval(expr, e.Unit), nil
 }
 
+// timestampFuncExprToExpression converts the TIMESTAMPADD and TIMESTAMPDIFF functions, whose first argument is a
+// unit keyword rather than an expression.
+func timestampFuncExprToExpression(ctx *sql.Context, e *sqlparser.TimestampFuncExpr) (sql.Expression, error) {
+	expr1, err := ExprToExpression(ctx, e.Expr1)
+	if err != nil {
+		return nil, err
+	}
+
+	expr2, err := ExprToExpression(ctx, e.Expr2)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(e.Name) {
+	case "timestampadd":
+		return function.NewDateAdd(expr2, expression.NewInterval(expr1, e.Unit))
+	case "timestampdiff":
+		return function.NewTimestampDiff(expression.NewLiteral(strings.ToUpper(e.Unit), sql.LongText), expr1, expr2), nil
+	default:
+		return nil, ErrUnsupportedSyntax.New(sqlparser.String(e))
+	}
+}
+
 func setExprsToExpressions(ctx *sql.Context, e sqlparser.SetVarExprs) ([]sql.Expression, error) {
 	res := make([]sql.Expression, len(e))
 	for i, setExpr := range e {
@@ -21,7 +21,6 @@ import (
 	"testing"
 
 	"github.com/dolthub/vitess/go/sqltypes"
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/pmezard/go-difflib/difflib"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,7 +28,9 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -864,6 +865,9 @@ CREATE TABLE t2
 	`RENAME TABLE foo TO bar, baz TO qux`: plan.NewRenameTable(
 		sql.UnresolvedDatabase(""), []string{"foo", "baz"}, []string{"bar", "qux"},
 	),
+	`RENAME TABLE mydb.foo TO mydb.bar, mydb.baz TO qux`: plan.NewRenameTable(
+		sql.UnresolvedDatabase("mydb"), []string{"foo", "baz"}, []string{"bar", "qux"},
+	),
 	`ALTER TABLE foo RENAME bar`: plan.NewRenameTable(
 		sql.UnresolvedDatabase(""), []string{"foo"}, []string{"bar"},
 	),
@@ -1752,6 +1756,23 @@ CREATE TABLE t2
 		},
 		plan.NewUnresolvedTable("dual", ""),
 	),
+	`SELECT 'abc', "it's", null, NULL`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("abc", expression.NewLiteral("abc", sql.LongText)),
+			expression.NewAlias("it's", expression.NewLiteral("it's", sql.LongText)),
+			expression.NewLiteral(nil, sql.Null),
+			expression.NewLiteral(nil, sql.Null),
+		},
+		plan.NewUnresolvedTable("dual", ""),
+	),
+	`SELECT _binary 'abc'`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("_binary 'abc'",
+				expression.NewLiteral("abc", sql.LongBlob),
+			),
+		},
+		plan.NewUnresolvedTable("dual", ""),
+	),
 	`SELECT * FROM b WHERE SOMEFUNC((1, 2), (3, 4))`: plan.NewProject(
 		[]sql.Expression{expression.NewStar()},
 		plan.NewFilter(
@@ -1771,6 +1792,17 @@ CREATE TABLE t2
 			plan.NewUnresolvedTable("b", ""),
 		),
 	),
+	`SELECT * FROM b WHERE mydb.SOMEFUNC(1)`: plan.NewProject(
+		[]sql.Expression{expression.NewStar()},
+		plan.NewFilter(
+			func() sql.Expression {
+				uf := expression.NewUnresolvedFunction("somefunc", false, nil, expression.NewLiteral(int8(1), sql.Int8))
+				uf.Database = "mydb"
+				return uf
+			}(),
+			plan.NewUnresolvedTable("b", ""),
+		),
+	),
 	`SELECT * FROM foo WHERE :foo_id = 2`: plan.NewProject(
 		[]sql.Expression{expression.NewStar()},
 		plan.NewFilter(
@@ -1828,6 +1860,69 @@ CREATE TABLE t2
 		},
 		plan.NewUnresolvedTable("foo", ""),
 	),
+	`SELECT EXTRACT(YEAR_MONTH FROM a), extract(day from b) FROM foo`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("EXTRACT(YEAR_MONTH FROM a)",
+				expression.NewUnresolvedFunction("extract", false, nil,
+					expression.NewLiteral("YEAR_MONTH", sql.LongText), expression.NewUnresolvedColumn("a")),
+			),
+			expression.NewAlias("extract(day from b)",
+				expression.NewUnresolvedFunction("extract", false, nil,
+					expression.NewLiteral("day", sql.LongText), expression.NewUnresolvedColumn("b")),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	`SELECT a FROM foo INTERSECT SELECT EXTRACT(YEAR FROM b) FROM bar`: plan.NewSetOp(plan.SetOp_Intersect, true,
+		plan.NewProject(
+			[]sql.Expression{expression.NewUnresolvedColumn("a")},
+			plan.NewUnresolvedTable("foo", ""),
+		),
+		plan.NewProject(
+			[]sql.Expression{
+				expression.NewAlias("EXTRACT(YEAR FROM b)",
+					expression.NewUnresolvedFunction("extract", false, nil,
+						expression.NewLiteral("YEAR", sql.LongText), expression.NewUnresolvedColumn("b")),
+				),
+			},
+			plan.NewUnresolvedTable("bar", ""),
+		),
+	),
+	`SELECT FORMAT(a, 2, 'de_DE'), date_format(b, '%M') FROM foo`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("FORMAT(a, 2, 'de_DE')",
+				expression.NewUnresolvedFunction("format", false, nil,
+					expression.NewUnresolvedColumn("a"), expression.NewLiteral(int8(2), sql.Int8), expression.NewLiteral("de_DE", sql.LongText)),
+			),
+			expression.NewAlias("date_format(b, '%M')",
+				expression.NewUnresolvedFunction("date_format", false, nil,
+					expression.NewUnresolvedColumn("b"), expression.NewLiteral("%M", sql.LongText)),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	`SELECT INSERT(a, 3, 4, 'What') FROM foo`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("INSERT(a, 3, 4, 'What')",
+				expression.NewUnresolvedFunction("insert", false, nil,
+					expression.NewUnresolvedColumn("a"), expression.NewLiteral(int8(3), sql.Int8),
+					expression.NewLiteral(int8(4), sql.Int8), expression.NewLiteral("What", sql.LongText)),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	`SELECT TIMESTAMPDIFF(MONTH, a, b) FROM foo`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("TIMESTAMPDIFF(MONTH, a, b)",
+				function.NewTimestampDiff(
+					expression.NewLiteral("MONTH", sql.LongText),
+					expression.NewUnresolvedColumn("a"),
+					expression.NewUnresolvedColumn("b"),
+				),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
 	`SELECT 2 = 2 FROM foo`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewAlias("2 = 2",
@@ -2152,9 +2247,257 @@ CREATE TABLE t2
 	`SHOW KEYS IN foo`:      plan.NewShowIndexes(plan.NewUnresolvedTable("foo", "")),
 	`SHOW FULL PROCESSLIST`: plan.NewShowProcessList(),
 	`SHOW PROCESSLIST`:      plan.NewShowProcessList(),
+	`KILL 5`:                plan.NewKill(plan.KillType_Connection, 5),
+	`KILL QUERY 5`:          plan.NewKill(plan.KillType_Query, 5),
+	`kill connection 5;`:    plan.NewKill(plan.KillType_Connection, 5),
+	`ANALYZE TABLE t1, mydb.t2`: plan.NewAnalyzeTable([]sql.Node{
+		plan.NewUnresolvedTable("t1", ""),
+		plan.NewUnresolvedTable("t2", "mydb"),
+	}),
+	"analyze local table `t1`;":                               plan.NewAnalyzeTable([]sql.Node{plan.NewUnresolvedTable("t1", "")}),
+	`SHOW MASTER STATUS`:                                      plan.NewBinlogStatement(plan.BinlogStatement_ShowMasterStatus, ""),
+	`show binary log status;`:                                 plan.NewBinlogStatement(plan.BinlogStatement_ShowMasterStatus, ""),
+	`SHOW BINARY LOGS`:                                        plan.NewBinlogStatement(plan.BinlogStatement_ShowBinaryLogs, ""),
+	`SHOW MASTER LOGS`:                                        plan.NewBinlogStatement(plan.BinlogStatement_ShowBinaryLogs, ""),
+	`SHOW REPLICAS`:                                           plan.NewBinlogStatement(plan.BinlogStatement_ShowReplicas, ""),
+	`SHOW SLAVE HOSTS`:                                        plan.NewBinlogStatement(plan.BinlogStatement_ShowReplicas, ""),
+	`PURGE BINARY LOGS TO 'mysql-bin.000010'`:                 plan.NewBinlogStatement(plan.BinlogStatement_PurgeBinaryLogs, "TO 'mysql-bin.000010'"),
+	`PURGE MASTER LOGS BEFORE NOW()`:                          plan.NewBinlogStatement(plan.BinlogStatement_PurgeBinaryLogs, "BEFORE NOW()"),
+	`SHOW BINLOG EVENTS`:                                      plan.NewShowBinlogEvents(""),
+	`show binlog events in 'binlog.000002' from 4 limit 1, 2`: &plan.ShowBinlogEvents{LogName: "binlog.000002", Pos: 4, Offset: 1, Limit: 2},
+	`START REPLICA`:                                           plan.NewStartReplica(),
+	`stop slave;`:                                             plan.NewStopReplica(),
+	`CHANGE REPLICATION SOURCE TO SOURCE_HOST = 'db1', SOURCE_PORT = 3307, SOURCE_PASSWORD = 'it''s'`: plan.NewChangeReplicationSource([]plan.ReplicationOption{
+		{Name: plan.ReplicationOption_SourceHost, Value: "db1"},
+		{Name: plan.ReplicationOption_SourcePort, Value: uint64(3307)},
+		{Name: plan.ReplicationOption_SourcePassword, Value: "it's"},
+	}),
+	`change master to master_user="repl", master_auto_position=1`: plan.NewChangeReplicationSource([]plan.ReplicationOption{
+		{Name: plan.ReplicationOption_SourceUser, Value: "repl"},
+		{Name: plan.ReplicationOption_SourceAutoPosition, Value: uint64(1)},
+	}),
+	"CHANGE REPLICATION FILTER REPLICATE_DO_DB = (db1, `db2`), REPLICATE_IGNORE_TABLE = (db1.t1), REPLICATE_IGNORE_DB = ()": plan.NewChangeReplicationFilter([]plan.ReplicationOption{
+		{Name: plan.ReplicationOption_ReplicateDoDB, Value: []string{"db1", "db2"}},
+		{Name: plan.ReplicationOption_ReplicateIgnoreTable, Value: []string{"db1.t1"}},
+		{Name: plan.ReplicationOption_ReplicateIgnoreDB, Value: []string(nil)},
+	}),
+	`SELECT * FROM sales PIVOT (SUM(amount) FOR quarter IN ('Q1', 'Q2' AS second)) WHERE region = 'EU'`: plan.NewProject(
+		[]sql.Expression{expression.NewStar()},
+		plan.NewFilter(
+			expression.NewEquals(
+				expression.NewUnresolvedColumn("region"),
+				expression.NewLiteral("EU", sql.LongText),
+			),
+			plan.NewPivot(
+				expression.NewUnresolvedFunction("sum", true, nil, expression.NewUnresolvedColumn("amount")),
+				expression.NewUnresolvedColumn("quarter"),
+				[]sql.Expression{
+					expression.NewLiteral("Q1", sql.LongText),
+					expression.NewAlias("second", expression.NewLiteral("Q2", sql.LongText)),
+				},
+				plan.NewUnresolvedTable("sales", ""),
+			),
+		),
+	),
+	"SELECT region, amount FROM `quarterly` unpivot (amount for quarter in (q1, q2))": plan.NewProject(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("region"),
+			expression.NewUnresolvedColumn("amount"),
+		},
+		plan.NewUnpivot(
+			"amount",
+			"quarter",
+			[]sql.Expression{
+				expression.NewUnresolvedColumn("q1"),
+				expression.NewUnresolvedColumn("q2"),
+			},
+			plan.NewUnresolvedTable("quarterly", ""),
+		),
+	),
+	`SELECT * FROM t1, LATERAL (SELECT a FROM t2 WHERE t2.b = t1.b) AS d`: plan.NewProject(
+		[]sql.Expression{expression.NewStar()},
+		plan.NewLateralJoin(
+			plan.NewUnresolvedTable("t1", ""),
+			plan.NewSubqueryAlias("d", "select a from t2 where t2.b = t1.b",
+				plan.NewProject(
+					[]sql.Expression{expression.NewUnresolvedColumn("a")},
+					plan.NewFilter(
+						expression.NewEquals(
+							expression.NewUnresolvedQualifiedColumn("t2", "b"),
+							expression.NewUnresolvedQualifiedColumn("t1", "b"),
+						),
+						plan.NewUnresolvedTable("t2", ""),
+					),
+				),
+			),
+			false,
+		),
+	),
+	`SELECT * FROM t1 LEFT JOIN lateral (SELECT a FROM t2 WHERE t2.b = t1.b) d ON TRUE`: plan.NewProject(
+		[]sql.Expression{expression.NewStar()},
+		plan.NewLateralJoin(
+			plan.NewUnresolvedTable("t1", ""),
+			plan.NewSubqueryAlias("d", "select a from t2 where t2.b = t1.b",
+				plan.NewProject(
+					[]sql.Expression{expression.NewUnresolvedColumn("a")},
+					plan.NewFilter(
+						expression.NewEquals(
+							expression.NewUnresolvedQualifiedColumn("t2", "b"),
+							expression.NewUnresolvedQualifiedColumn("t1", "b"),
+						),
+						plan.NewUnresolvedTable("t2", ""),
+					),
+				),
+			),
+			true,
+		),
+	),
+	`SELECT jt.* FROM t1 JOIN JSON_TABLE(t1.doc, '$[*]' COLUMNS (id FOR ORDINALITY, name VARCHAR(20) PATH '$.name' DEFAULT '"none"' ON EMPTY ERROR ON ERROR, has_tags INT EXISTS PATH '$.tags', NESTED PATH '$.tags[*]' COLUMNS (tag TEXT PATH '$'))) AS jt ON jt.id > 1`: plan.NewProject(
+		[]sql.Expression{expression.NewQualifiedStar("jt")},
+		plan.NewFilter(
+			expression.NewGreaterThan(
+				expression.NewUnresolvedQualifiedColumn("jt", "id"),
+				expression.NewLiteral(int8(1), sql.Int8),
+			),
+			plan.NewLateralJoin(
+				plan.NewUnresolvedTable("t1", ""),
+				plan.NewJSONTable(
+					expression.NewUnresolvedQualifiedColumn("t1", "doc"),
+					"$[*]",
+					[]plan.JSONTableColumn{
+						{Name: "id", Type: sql.Uint32, Ordinality: true},
+						{
+							Name:    "name",
+							Type:    sql.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
+							Path:    "$.name",
+							OnEmpty: plan.JSONTableFallback{Default: "none"},
+							OnError: plan.JSONTableFallback{Error: true},
+						},
+						{Name: "has_tags", Type: sql.Int32, Path: "$.tags", Exists: true},
+						{Path: "$.tags[*]", Nested: []plan.JSONTableColumn{{Name: "tag", Type: sql.Text, Path: "$"}}},
+					},
+					"jt",
+				),
+				false,
+			),
+		),
+	),
+	`SELECT * FROM JSON_TABLE('[1, 2]', "$[*]" COLUMNS (a INT PATH '$')) jt WHERE a = 'json_table(x)'`: plan.NewProject(
+		[]sql.Expression{expression.NewStar()},
+		plan.NewFilter(
+			expression.NewEquals(
+				expression.NewUnresolvedColumn("a"),
+				expression.NewLiteral("json_table(x)", sql.LongText),
+			),
+			plan.NewJSONTable(
+				expression.NewLiteral("[1, 2]", sql.LongText),
+				"$[*]",
+				[]plan.JSONTableColumn{{Name: "a", Type: sql.Int32, Path: "$"}},
+				"jt",
+			),
+		),
+	),
+	`CREATE TABLE t1(a INTEGER, b TEXT) PARTITION BY RANGE (a) (PARTITION p0 VALUES LESS THAN (10), PARTITION p1 VALUES LESS THAN MAXVALUE)`: plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t1",
+		plan.IfNotExistsAbsent,
+		plan.IsTempTableAbsent,
+		&plan.TableSpec{
+			Schema: sql.Schema{{
+				Name:     "a",
+				Type:     sql.Int32,
+				Nullable: true,
+			}, {
+				Name:     "b",
+				Type:     sql.Text,
+				Nullable: true,
+			}},
+			PartitionScheme: &sql.PartitionScheme{
+				Method:     sql.PartitionByRange,
+				Expression: expression.NewGetFieldWithTable(0, sql.Int32, "t1", "a", true),
+				Partitions: []sql.PartitionDefinition{
+					{Name: "p0", LessThan: int32(10)},
+					{Name: "p1"},
+				},
+			},
+		},
+	),
+	"CREATE TABLE t1(a INTEGER, b TEXT) partition by list (`a`) (partition odd values in (1, 3), partition even values in (2, 4, NULL))": plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t1",
+		plan.IfNotExistsAbsent,
+		plan.IsTempTableAbsent,
+		&plan.TableSpec{
+			Schema: sql.Schema{{
+				Name:     "a",
+				Type:     sql.Int32,
+				Nullable: true,
+			}, {
+				Name:     "b",
+				Type:     sql.Text,
+				Nullable: true,
+			}},
+			PartitionScheme: &sql.PartitionScheme{
+				Method:     sql.PartitionByList,
+				Expression: expression.NewGetFieldWithTable(0, sql.Int32, "t1", "a", true),
+				Partitions: []sql.PartitionDefinition{
+					{Name: "odd", Values: []interface{}{int32(1), int32(3)}},
+					{Name: "even", Values: []interface{}{int32(2), int32(4), nil}},
+				},
+			},
+		},
+	),
+	`CREATE TABLE t1(a INTEGER PRIMARY KEY, b TEXT) PARTITION BY HASH (a) PARTITIONS 2`: plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t1",
+		plan.IfNotExistsAbsent,
+		plan.IsTempTableAbsent,
+		&plan.TableSpec{
+			Schema: sql.Schema{{
+				Name:       "a",
+				Type:       sql.Int32,
+				Nullable:   false,
+				PrimaryKey: true,
+			}, {
+				Name:     "b",
+				Type:     sql.Text,
+				Nullable: true,
+			}},
+			PartitionScheme: &sql.PartitionScheme{
+				Method:     sql.PartitionByHash,
+				Expression: expression.NewGetFieldWithTable(0, sql.Int32, "t1", "a", false),
+				Partitions: []sql.PartitionDefinition{{Name: "p0"}, {Name: "p1"}},
+			},
+		},
+	),
 	`SELECT @@allowed_max_packet`: plan.NewProject([]sql.Expression{
 		expression.NewUnresolvedColumn("@@allowed_max_packet"),
 	}, plan.NewUnresolvedTable("dual", "")),
+	`SELECT @rownum := @rownum + 1 AS n, name FROM foo`: plan.NewProject([]sql.Expression{
+		expression.NewAlias("n", expression.NewUserVarAssignment("rownum",
+			expression.NewPlus(expression.NewUnresolvedColumn("@rownum"), expression.NewLiteral(int8(1), sql.Int8)),
+		)),
+		expression.NewUnresolvedColumn("name"),
+	}, plan.NewUnresolvedTable("foo", "")),
+	`SELECT @a := 1, @b:=@a + 1, @c := (@d := 2) * 2`: plan.NewProject([]sql.Expression{
+		expression.NewUserVarAssignment("a", expression.NewLiteral(int8(1), sql.Int8)),
+		expression.NewAlias("@b:=@a + 1", expression.NewUserVarAssignment("b",
+			expression.NewPlus(expression.NewUnresolvedColumn("@a"), expression.NewLiteral(int8(1), sql.Int8)),
+		)),
+		expression.NewAlias("@c := (@d := 2) * 2", expression.NewUserVarAssignment("c",
+			expression.NewMult(
+				expression.NewUserVarAssignment("d", expression.NewLiteral(int8(2), sql.Int8)),
+				expression.NewLiteral(int8(2), sql.Int8),
+			),
+		)),
+	}, plan.NewUnresolvedTable("dual", "")),
+	`SET @foo := 1, SESSION autocommit := ON, @bar := (@baz := 2)`: plan.NewSet(
+		[]sql.Expression{
+			expression.NewSetField(expression.NewUserVar("foo"), expression.NewLiteral(int8(1), sql.Int8)),
+			expression.NewSetField(expression.NewSystemVar("autocommit", sql.SystemVariableScope_Session), expression.NewLiteral("ON", sql.LongText)),
+			expression.NewSetField(expression.NewUserVar("bar"), expression.NewUserVarAssignment("baz", expression.NewLiteral(int8(2), sql.Int8))),
+		},
+	),
 	`SET autocommit=1, foo="bar", baz=ON, qux=bareword`: plan.NewSet(
 		[]sql.Expression{
 			expression.NewSetField(expression.NewUnresolvedColumn("autocommit"), expression.NewLiteral(int8(1), sql.Int8)),
@@ -2300,7 +2643,8 @@ CREATE TABLE t2
 		plan.NewUnresolvedTable("bar", "foo"),
 	),
 	`SHOW VARIABLES`:                           plan.NewShowVariables(""),
-	`SHOW GLOBAL VARIABLES`:                    plan.NewShowVariables(""),
+	`SHOW GLOBAL VARIABLES`:                    plan.NewShowGlobalVariables(""),
+	`SHOW GLOBAL VARIABLES LIKE 'gtid_mode'`:   plan.NewShowGlobalVariables("gtid_mode"),
 	`SHOW SESSION VARIABLES`:                   plan.NewShowVariables(""),
 	`SHOW VARIABLES LIKE 'gtid_mode'`:          plan.NewShowVariables("gtid_mode"),
 	`SHOW SESSION VARIABLES LIKE 'autocommit'`: plan.NewShowVariables("autocommit"),
@@ -2343,6 +2687,10 @@ CREATE TABLE t2
 	`SHOW WARNINGS`:                            plan.NewOffset(expression.NewLiteral(0, sql.Int64), plan.ShowWarnings(sql.NewEmptyContext().Warnings())),
 	`SHOW WARNINGS LIMIT 10`:                   plan.NewLimit(expression.NewLiteral(10, sql.Int64), plan.NewOffset(expression.NewLiteral(0, sql.Int64), plan.ShowWarnings(sql.NewEmptyContext().Warnings()))),
 	`SHOW WARNINGS LIMIT 5,10`:                 plan.NewLimit(expression.NewLiteral(10, sql.Int64), plan.NewOffset(expression.NewLiteral(5, sql.Int64), plan.ShowWarnings(sql.NewEmptyContext().Warnings()))),
+	`SHOW ERRORS`:                              plan.NewOffset(expression.NewLiteral(0, sql.Int64), plan.ShowWarnings(sql.ErrorWarnings(sql.NewEmptyContext().Warnings()))),
+	`SHOW ERRORS LIMIT 10`:                     plan.NewLimit(expression.NewLiteral(10, sql.Int64), plan.NewOffset(expression.NewLiteral(0, sql.Int64), plan.ShowWarnings(sql.ErrorWarnings(sql.NewEmptyContext().Warnings())))),
+	`SHOW COUNT(*) WARNINGS`:                   plan.NewShowWarningsCount(sql.NewEmptyContext().Warnings(), false),
+	`show count( * ) errors`:                   plan.NewShowWarningsCount(sql.NewEmptyContext().Warnings(), true),
 	"SHOW CREATE DATABASE `foo`":               plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false),
 	"SHOW CREATE SCHEMA `foo`":                 plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false),
 	"SHOW CREATE DATABASE IF NOT EXISTS `foo`": plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), true),
@@ -2446,6 +2794,7 @@ CREATE TABLE t2
 	"SHOW CREATE TABLE `mydb`.`mytable`":     plan.NewShowCreateTable(plan.NewUnresolvedTable("mytable", "mydb"), false),
 	"SHOW CREATE TABLE `my.table`":           plan.NewShowCreateTable(plan.NewUnresolvedTable("my.table", ""), false),
 	"SHOW CREATE TABLE `my.db`.`my.table`":   plan.NewShowCreateTable(plan.NewUnresolvedTable("my.table", "my.db"), false),
+	"SHOW DIFF FROM mytable TO mydb.other":   plan.NewShowDiff(plan.NewUnresolvedTable("mytable", ""), plan.NewUnresolvedTable("other", "mydb")),
 	"SHOW CREATE TABLE `my``table`":          plan.NewShowCreateTable(plan.NewUnresolvedTable("my`table", ""), false),
 	"SHOW CREATE TABLE `my``db`.`my``table`": plan.NewShowCreateTable(plan.NewUnresolvedTable("my`table", "my`db"), false),
 	"SHOW CREATE TABLE ````":                 plan.NewShowCreateTable(plan.NewUnresolvedTable("`", ""), false),
@@ -2555,6 +2904,60 @@ CREATE TABLE t2
 			plan.NewUnresolvedTable("t", ""),
 		),
 	),
+	`SELECT foo, bar FROM t1 GROUP BY foo, bar WITH ROLLUP`: plan.NewGroupBy(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("foo"),
+			expression.NewUnresolvedColumn("bar"),
+		},
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("foo"),
+			expression.NewUnresolvedColumn("bar"),
+		},
+		plan.NewUnresolvedTable("t1", ""),
+	).WithGroupingSets([][]int{{0, 1}, {0}, {}}),
+	`SELECT foo, bar FROM t1 GROUP BY CUBE(foo, bar)`: plan.NewGroupBy(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("foo"),
+			expression.NewUnresolvedColumn("bar"),
+		},
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("foo"),
+			expression.NewUnresolvedColumn("bar"),
+		},
+		plan.NewUnresolvedTable("t1", ""),
+	).WithGroupingSets([][]int{{0, 1}, {0}, {1}, {}}),
+	`SELECT foo, bar FROM t1 GROUP BY foo, ROLLUP(bar, (baz, foo))`: plan.NewGroupBy(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("foo"),
+			expression.NewUnresolvedColumn("bar"),
+		},
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("foo"),
+			expression.NewUnresolvedColumn("bar"),
+			expression.NewUnresolvedColumn("baz"),
+		},
+		plan.NewUnresolvedTable("t1", ""),
+	).WithGroupingSets([][]int{{0, 1, 2}, {0, 1}, {0}}),
+	`SELECT foo FROM t1 WHERE bar = 'with rollup' GROUP BY GROUPING SETS ((foo, bar), foo, ()) HAVING GROUPING(foo) = 0`: plan.NewHaving(
+		expression.NewEquals(
+			expression.NewUnresolvedFunction("grouping", false, nil, expression.NewUnresolvedColumn("foo")),
+			expression.NewLiteral(int8(0), sql.Int8),
+		),
+		plan.NewGroupBy(
+			[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+			[]sql.Expression{
+				expression.NewUnresolvedColumn("foo"),
+				expression.NewUnresolvedColumn("bar"),
+			},
+			plan.NewFilter(
+				expression.NewEquals(
+					expression.NewUnresolvedColumn("bar"),
+					expression.NewLiteral("with rollup", sql.LongText),
+				),
+				plan.NewUnresolvedTable("t1", ""),
+			),
+		).WithGroupingSets([][]int{{0, 1}, {0}, {}}),
+	),
 	`SELECT COUNT(*) FROM foo GROUP BY a HAVING COUNT(*) > 5`: plan.NewHaving(
 		expression.NewGreaterThan(
 			expression.NewUnresolvedFunction("count", true, nil, expression.NewStar()),
@@ -2778,6 +3181,66 @@ CREATE TABLE t2
 		},
 		plan.NewUnresolvedTable("foo", ""),
 	),
+	`SELECT a, count(i) over (order by x) FROM foo`: plan.NewWindow(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("a"),
+			expression.NewAlias("count(i) over (order by x)",
+				expression.NewUnresolvedFunction("count", true, sql.NewWindow(
+					[]sql.Expression{},
+					sql.SortFields{
+						{
+							Column:       expression.NewUnresolvedColumn("x"),
+							Order:        sql.Ascending,
+							NullOrdering: sql.NullsFirst,
+						},
+					},
+				), expression.NewUnresolvedColumn("i")),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	`SELECT a, sum(i) over (partition by y order by x rows between 2 preceding and current row) FROM foo`: plan.NewWindow(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("a"),
+			expression.NewAlias("sum(i) over (partition by y order by x rows between 2 preceding and current row)",
+				expression.NewUnresolvedFunction("sum", true, sql.NewWindow(
+					[]sql.Expression{
+						expression.NewUnresolvedColumn("y"),
+					},
+					sql.SortFields{
+						{
+							Column:       expression.NewUnresolvedColumn("x"),
+							Order:        sql.Ascending,
+							NullOrdering: sql.NullsFirst,
+						},
+					},
+				).WithFrame(&sql.WindowFrame{
+					Unit:  sql.RowsFrame,
+					Start: sql.WindowFrameBound{Type: sql.Preceding, Offset: 2},
+					End:   sql.WindowFrameBound{Type: sql.CurrentRow},
+				}), expression.NewUnresolvedColumn("i")),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	`SELECT a, avg(i) over (partition by y RANGE UNBOUNDED PRECEDING) FROM foo`: plan.NewWindow(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("a"),
+			expression.NewAlias("avg(i) over (partition by y RANGE UNBOUNDED PRECEDING)",
+				expression.NewUnresolvedFunction("avg", true, sql.NewWindow(
+					[]sql.Expression{
+						expression.NewUnresolvedColumn("y"),
+					},
+					nil,
+				).WithFrame(&sql.WindowFrame{
+					Unit:  sql.RangeFrame,
+					Start: sql.WindowFrameBound{Type: sql.UnboundedPreceding},
+					End:   sql.WindowFrameBound{Type: sql.CurrentRow},
+				}), expression.NewUnresolvedColumn("i")),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
 	`with cte1 as (select a from b) select * from cte1`: plan.NewWith(
 		plan.NewProject(
 			[]sql.Expression{
@@ -3151,10 +3614,89 @@ CREATE TABLE t2
 			),
 		),
 	),
+	`SELECT foo FROM a UNION ALL SELECT foo FROM b ORDER BY foo DESC LIMIT 1`: plan.NewLimit(expression.NewLiteral(int8(1), sql.Int8),
+		plan.NewSort(
+			[]sql.SortField{{Column: expression.NewUnresolvedColumn("foo"), Order: sql.Descending, NullOrdering: sql.NullsFirst}},
+			plan.NewProject(
+				[]sql.Expression{expression.NewStar()},
+				plan.NewSubqueryAlias("__set_operation", "",
+					plan.NewUnion(
+						plan.NewProject(
+							[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+							plan.NewUnresolvedTable("a", ""),
+						),
+						plan.NewProject(
+							[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+							plan.NewUnresolvedTable("b", ""),
+						),
+					),
+				),
+			),
+		),
+	),
+	`SELECT foo FROM a INTERSECT SELECT foo FROM b EXCEPT ALL SELECT foo FROM c INTERSECT ALL SELECT foo FROM d ORDER BY foo DESC`: plan.NewSort(
+		[]sql.SortField{{Column: expression.NewUnresolvedColumn("foo"), Order: sql.Descending, NullOrdering: sql.NullsFirst}},
+		plan.NewProject(
+			[]sql.Expression{expression.NewStar()},
+			plan.NewSubqueryAlias("__set_operation", "",
+				plan.NewSetOp(plan.SetOp_Except, false,
+					plan.NewSetOp(plan.SetOp_Intersect, true,
+						plan.NewProject(
+							[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+							plan.NewUnresolvedTable("a", ""),
+						),
+						plan.NewProject(
+							[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+							plan.NewUnresolvedTable("b", ""),
+						),
+					),
+					plan.NewSetOp(plan.SetOp_Intersect, false,
+						plan.NewProject(
+							[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+							plan.NewUnresolvedTable("c", ""),
+						),
+						plan.NewProject(
+							[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+							plan.NewUnresolvedTable("d", ""),
+						),
+					),
+				),
+			),
+		),
+	),
+	`SELECT foo FROM a UNION ALL SELECT foo FROM b INTERSECT SELECT foo FROM c`: plan.NewUnion(
+		plan.NewProject(
+			[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+			plan.NewUnresolvedTable("a", ""),
+		),
+		plan.NewSetOp(plan.SetOp_Intersect, true,
+			plan.NewProject(
+				[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+				plan.NewUnresolvedTable("b", ""),
+			),
+			plan.NewProject(
+				[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+				plan.NewUnresolvedTable("c", ""),
+			),
+		),
+	),
+	`(SELECT foo FROM a) EXCEPT DISTINCT SELECT foo FROM b`: plan.NewSetOp(plan.SetOp_Except, true,
+		plan.NewProject(
+			[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+			plan.NewUnresolvedTable("a", ""),
+		),
+		plan.NewProject(
+			[]sql.Expression{expression.NewUnresolvedColumn("foo")},
+			plan.NewUnresolvedTable("b", ""),
+		),
+	),
 	`CREATE DATABASE test`:               plan.NewCreateDatabase("test", false),
 	`CREATE DATABASE IF NOT EXISTS test`: plan.NewCreateDatabase("test", true),
 	`DROP DATABASE test`:                 plan.NewDropDatabase("test", false),
 	`DROP DATABASE IF EXISTS test`:       plan.NewDropDatabase("test", true),
+
+	`CREATE TEMPORARY DATABASE test`:               plan.NewCreateTemporaryDatabase("test", false),
+	"CREATE TEMPORARY SCHEMA IF NOT EXISTS `Test`": plan.NewCreateTemporaryDatabase("Test", true),
 }
 
 func TestParse(t *testing.T) {
@@ -3229,10 +3771,30 @@ var fixturesErrors = map[string]*errors.Kind{
 	`SELECT '2018-05-01' + (INTERVAL 1 DAY + INTERVAL 1 DAY)`: ErrUnsupportedSyntax,
 	"DESCRIBE FORMAT=pretty SELECT * FROM foo":                errInvalidDescribeFormat,
 	`CREATE TABLE test (pk int, primary key(pk, noexist))`:    ErrUnknownIndexColumn,
-	`SELECT a, count(i) over (order by x) FROM foo`:           ErrUnsupportedFeature,
-	`SELECT a, count(i) over (partition by y) FROM foo`:       ErrUnsupportedFeature,
 	`SELECT i, row_number() over (order by a) group by 1`:     ErrUnsupportedFeature,
 	`SELECT i, row_number() over (order by a), max(b)`:        ErrUnsupportedFeature,
+	`RENAME TABLE mydb.foo TO otherdb.foo`:                    ErrUnsupportedFeature,
+	`ANALYZE TABLE foo WHERE a = 1`:                           sql.ErrSyntaxError,
+	`ANALYZE TABLE foo AS f`:                                  sql.ErrSyntaxError,
+	`CREATE TABLE t (a int) PARTITION BY RANGE (a) (PARTITION p0 VALUES LESS THAN (10), PARTITION p1 VALUES LESS THAN (5))`: sql.ErrInvalidPartitionScheme,
+	`CREATE TABLE t (a int) PARTITION BY LIST (a) (PARTITION p0 VALUES IN (1, 2), PARTITION p1 VALUES IN (2))`:              sql.ErrInvalidPartitionScheme,
+	`CREATE TABLE t (a int, b int, primary key (a)) PARTITION BY HASH (b) PARTITIONS 2`:                                     sql.ErrInvalidPartitionScheme,
+	`CREATE TABLE t (a int) PARTITION BY HASH (c) PARTITIONS 2`:                                                             sql.ErrTableColumnNotFound,
+	`CREATE TABLE t (a int) PARTITION BY KEY (a) PARTITIONS 2`:                                                              ErrUnsupportedFeature,
+	`CREATE TABLE t (a int) PARTITION BY RANGE (a) (PARTITION p0)`:                                                          sql.ErrInvalidPartitionScheme,
+	`CREATE TABLE t (a int) PARTITION BY LIST (a)`:                                                                          sql.ErrInvalidPartitionScheme,
+	`CHANGE REPLICATION SOURCE TO SOURCE_HOST 'db1'`:                                                                        sql.ErrSyntaxError,
+	`SELECT foo FROM t1 GROUP BY ROLLUP(foo, )`:                                                                             sql.ErrSyntaxError,
+	`SELECT foo FROM t1 GROUP BY CUBE(foo) WITH ROLLUP`:                                                                     sql.ErrSyntaxError,
+	`SELECT * FROM t1 RIGHT JOIN LATERAL (SELECT a FROM t2 WHERE t2.b = t1.b) d ON TRUE`:                                    ErrUnsupportedFeature,
+	`SELECT * FROM t1 LEFT JOIN LATERAL (SELECT a FROM t2) d ON d.a = t1.a`:                                                 ErrUnsupportedFeature,
+	`SELECT * FROM JSON_TABLE('[1, 2]', '$[*]' COLUMNS (a INT)) AS jt`:                                                      sql.ErrSyntaxError,
+	`SELECT * FROM JSON_TABLE('[1, 2]' COLUMNS (a INT PATH '$')) AS jt`:                                                     sql.ErrSyntaxError,
+	`SELECT a, sum(i) over (order by x rows between current row and 1 preceding) FROM foo`:                                  sql.ErrInvalidWindowFrame,
+	`SELECT a, sum(i) over (order by x rows unbounded following) FROM foo`:                                                  sql.ErrInvalidWindowFrame,
+	`SELECT a, sum(i) over (order by x, y range between 1 preceding and current row) FROM foo`:                              sql.ErrWindowFrameRangeOrderBy,
+	`SELECT a, sum(i) over (rows between 1 squiggles and current row) FROM foo`:                                             sql.ErrSyntaxError,
+	`CREATE PROCEDURE p() BEGIN DECLARE UNDO HANDLER FOR SQLEXCEPTION SET @a = 1; END`:                                      ErrUnsupportedFeature,
 }
 
 func TestParseErrors(t *testing.T) {
@@ -0,0 +1,172 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
+)
+
+// partitionOptionToScheme converts the PARTITION BY clause of a CREATE TABLE statement for the table with the schema
+// given.
+func partitionOptionToScheme(ctx *sql.Context, p *sqlparser.PartitionOption, table string, schema sql.Schema) (*sql.PartitionScheme, error) {
+	var method sql.PartitionMethod
+	switch p.Type {
+	case sqlparser.RangeStr:
+		method = sql.PartitionByRange
+	case sqlparser.ListStr:
+		method = sql.PartitionByList
+	case sqlparser.HashStr:
+		method = sql.PartitionByHash
+	default:
+		return nil, ErrUnsupportedFeature.New(fmt.Sprintf("PARTITION BY %s", strings.ToUpper(p.Type)))
+	}
+
+	var count int
+	if p.Count != nil {
+		n, err := strconv.Atoi(string(p.Count.Val))
+		if err != nil || n < 1 {
+			return nil, sql.ErrInvalidPartitionScheme.New(fmt.Sprintf("invalid number of partitions %s", p.Count.Val))
+		}
+		count = n
+	}
+
+	if len(p.Definitions) == 0 && method != sql.PartitionByHash {
+		return nil, sql.ErrInvalidPartitionScheme.New(fmt.Sprintf("partitions must be defined for %s partitioning", method))
+	}
+	if count > 0 && len(p.Definitions) > 0 && count != len(p.Definitions) {
+		return nil, sql.ErrInvalidPartitionScheme.New("wrong number of partitions defined")
+	}
+
+	expr, err := resolvePartitionExpression(ctx, p.Expr, table, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := make([]sql.PartitionDefinition, len(p.Definitions))
+	for i, def := range p.Definitions {
+		partitions[i] = sql.PartitionDefinition{Name: def.Name.String()}
+		if def.Limit != nil {
+			values, err := evalPartitionValues(ctx, sqlparser.Exprs{def.Limit})
+			if err != nil {
+				return nil, err
+			}
+			partitions[i].LessThan = values[0]
+		}
+		if def.In != nil {
+			if partitions[i].Values, err = evalPartitionValues(ctx, def.In); err != nil {
+				return nil, err
+			}
+		}
+		if method == sql.PartitionByRange && def.Limit == nil && !def.Maxvalue {
+			return nil, sql.ErrInvalidPartitionScheme.New("RANGE partitions must be defined with VALUES LESS THAN")
+		}
+	}
+
+	if method == sql.PartitionByHash && len(partitions) == 0 {
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			partitions = append(partitions, sql.PartitionDefinition{Name: fmt.Sprintf("p%d", i)})
+		}
+	}
+
+	return sql.NewPartitionScheme(method, expr, partitions)
+}
+
+// resolvePartitionExpression converts the partitioning expression of a table and resolves it against the table's
+// schema. A primary key must include all the columns used in the expression, as in MySQL, so that a row can't change
+// partitions without changing its key.
+func resolvePartitionExpression(ctx *sql.Context, expr sqlparser.Expr, table string, schema sql.Schema) (sql.Expression, error) {
+	e, err := ExprToExpression(ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var hasPk bool
+	for _, col := range schema {
+		hasPk = hasPk || col.PrimaryKey
+	}
+
+	registry := function.NewRegistry()
+	resolved, err := expression.TransformUp(e, func(e sql.Expression) (sql.Expression, error) {
+		switch e := e.(type) {
+		case *expression.UnresolvedColumn:
+			if e.Table() != "" && !strings.EqualFold(e.Table(), table) {
+				return nil, sql.ErrTableColumnNotFound.New(e.Table(), e.Name())
+			}
+			idx := schema.IndexOf(e.Name(), table)
+			if idx < 0 {
+				return nil, sql.ErrTableColumnNotFound.New(table, e.Name())
+			}
+			col := schema[idx]
+			if hasPk && !col.PrimaryKey {
+				return nil, sql.ErrInvalidPartitionScheme.New("a PRIMARY KEY must include all columns in the table's partitioning function")
+			}
+			return expression.NewGetFieldWithTable(idx, col.Type, table, col.Name, col.Nullable), nil
+		case *expression.UnresolvedFunction:
+			fn, err := registry.Function(strings.ToLower(e.Name()))
+			if err != nil {
+				return nil, err
+			}
+			return fn.NewInstance(e.Children())
+		default:
+			return e, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resolved.Resolved() {
+		return nil, sql.ErrInvalidPartitionScheme.New(fmt.Sprintf("expression %s is not allowed in the partitioning function", sqlparser.String(expr)))
+	}
+	return resolved, nil
+}
+
+// evalPartitionValues evaluates the constant values of a partition.
+func evalPartitionValues(ctx *sql.Context, exprs sqlparser.Exprs) ([]interface{}, error) {
+	values := make([]interface{}, len(exprs))
+	for i, expr := range exprs {
+		e, err := ExprToExpression(ctx, expr)
+		if err != nil {
+			return nil, err
+		}
+
+		constant := true
+		sql.Inspect(e, func(e sql.Expression) bool {
+			switch e.(type) {
+			case *expression.UnresolvedColumn, *expression.UnresolvedFunction:
+				constant = false
+			}
+			return constant
+		})
+		if !constant || !e.Resolved() {
+			return nil, sql.ErrInvalidPartitionScheme.New(fmt.Sprintf("partition values must be constants, got %s", e))
+		}
+
+		if values[i], err = e.Eval(ctx, nil); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var (
+	// startReplicaRegex matches START REPLICA and its older name START SLAVE.
+	startReplicaRegex = regexp.MustCompile(`(?is)^start\s+(?:replica|slave)$`)
+	// stopReplicaRegex matches STOP REPLICA and its older name STOP SLAVE.
+	stopReplicaRegex = regexp.MustCompile(`(?is)^stop\s+(?:replica|slave)$`)
+	// changeReplicationSourceRegex matches CHANGE REPLICATION SOURCE TO and its older name CHANGE MASTER TO,
+	// capturing their options.
+	changeReplicationSourceRegex = regexp.MustCompile(`(?is)^change\s+(?:replication\s+source|master)\s+to\s+(.+)$`)
+	// changeReplicationFilterRegex matches CHANGE REPLICATION FILTER, capturing its options.
+	changeReplicationFilterRegex = regexp.MustCompile(`(?is)^change\s+replication\s+filter\s+(.+)$`)
+	// replicationOptionRegex matches the first of a list of replication options, capturing its name and its value,
+	// which is a quoted string, a number or a parenthesized list.
+	replicationOptionRegex = regexp.MustCompile(`(?s)^\s*(\w+)\s*=\s*('(?:[^'\\]|''|\\.)*'|"(?:[^"\\]|""|\\.)*"|\d+|\([^)]*\))\s*(?:,|$)`)
+)
+
+// isReplicationStatement returns whether the query given is one of the statements controlling replication, which the
+// parser doesn't know.
+func isReplicationStatement(s string) bool {
+	return startReplicaRegex.MatchString(s) || stopReplicaRegex.MatchString(s) ||
+		changeReplicationSourceRegex.MatchString(s) || changeReplicationFilterRegex.MatchString(s)
+}
+
+func parseReplicationStatement(s string) (sql.Node, error) {
+	switch {
+	case startReplicaRegex.MatchString(s):
+		return plan.NewStartReplica(), nil
+	case stopReplicaRegex.MatchString(s):
+		return plan.NewStopReplica(), nil
+	}
+
+	if matches := changeReplicationSourceRegex.FindStringSubmatch(s); matches != nil {
+		options, err := parseReplicationOptions(matches[1])
+		if err != nil {
+			return nil, err
+		}
+		for i, o := range options {
+			if strings.HasPrefix(o.Name, "MASTER_") {
+				options[i].Name = "SOURCE_" + strings.TrimPrefix(o.Name, "MASTER_")
+			}
+		}
+		return plan.NewChangeReplicationSource(options), nil
+	}
+
+	matches := changeReplicationFilterRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+	options, err := parseReplicationOptions(matches[1])
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewChangeReplicationFilter(options), nil
+}
+
+// parseReplicationOptions parses a comma separated list of options of the form NAME = value. Names are returned in
+// upper case, and values as strings, uint64s or lists of names without their quotes.
+func parseReplicationOptions(s string) ([]plan.ReplicationOption, error) {
+	var options []plan.ReplicationOption
+	for rest := s; strings.TrimSpace(rest) != ""; {
+		matches := replicationOptionRegex.FindStringSubmatch(rest)
+		if matches == nil {
+			return nil, sql.ErrSyntaxError.New(s)
+		}
+		rest = rest[len(matches[0]):]
+
+		var value interface{}
+		switch v := matches[2]; v[0] {
+		case '\'', '"':
+			value = unquoteString(v)
+		case '(':
+			value = splitReplicationList(v[1 : len(v)-1])
+		default:
+			n, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, sql.ErrSyntaxError.New(s)
+			}
+			value = n
+		}
+
+		options = append(options, plan.ReplicationOption{Name: strings.ToUpper(matches[1]), Value: value})
+	}
+
+	if len(options) == 0 {
+		return nil, sql.ErrSyntaxError.New(s)
+	}
+	return options, nil
+}
+
+// unquoteString removes the quotes of a quoted string, along with the escaping of the characters in it.
+func unquoteString(s string) string {
+	quote := s[0]
+	s = s[1 : len(s)-1]
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+		case s[i] == quote && i+1 < len(s) && s[i+1] == quote:
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// splitReplicationList splits a comma separated list of databases or qualified tables, removing the backticks
+// quoting their names.
+func splitReplicationList(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+
+	parts := strings.Split(s, ",")
+	list := make([]string, len(parts))
+	for i, part := range parts {
+		names := strings.Split(strings.TrimSpace(part), ".")
+		for j, name := range names {
+			names[j] = strings.Trim(strings.TrimSpace(name), "`")
+		}
+		list[i] = strings.Join(names, ".")
+	}
+	return list
+}
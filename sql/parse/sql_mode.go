@@ -0,0 +1,32 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
+)
+
+// parserOptions returns the options of the parser for the SQL mode of the session:
+//   - with ANSI_QUOTES, double quoted text is an identifier rather than a string;
+//   - with PIPES_AS_CONCAT, || concatenates strings instead of being a synonym of OR. The operator binds tighter than
+//     any other binary operator.
+func parserOptions(ctx *sql.Context) sqlparser.ParserOptions {
+	mode := sql.LoadSqlMode(ctx)
+	return sqlparser.ParserOptions{
+		AnsiQuotes:    mode.ModeEnabled(sql.SqlModeAnsiQuotes),
+		PipesAsConcat: mode.ModeEnabled(sql.SqlModePipesAsConcat),
+	}
+}
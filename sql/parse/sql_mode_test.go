@@ -0,0 +1,136 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestParseWithSqlMode(t *testing.T) {
+	testCases := []struct {
+		mode     string
+		query    string
+		expected sql.Node
+	}{
+		{
+			mode:  "",
+			query: `SELECT "a" || 'b' FROM t`,
+			expected: plan.NewProject(
+				[]sql.Expression{
+					expression.NewAlias(`"a" || 'b'`,
+						expression.NewOr(expression.NewLiteral("a", sql.LongText), expression.NewLiteral("b", sql.LongText)),
+					),
+				},
+				plan.NewUnresolvedTable("t", ""),
+			),
+		},
+		{
+			mode:  "PIPES_AS_CONCAT",
+			query: `SELECT 'a' || 'b'`,
+			expected: plan.NewProject(
+				[]sql.Expression{
+					expression.NewAlias(`'a' || 'b'`,
+						expression.NewUnresolvedFunction("concat", false, nil,
+							expression.NewLiteral("a", sql.LongText), expression.NewLiteral("b", sql.LongText)),
+					),
+				},
+				plan.NewUnresolvedTable("dual", ""),
+			),
+		},
+		{
+			mode:  "PIPES_AS_CONCAT",
+			query: `SELECT a || b || c FROM t WHERE i + 1 || 'x' = '2x'`,
+			expected: plan.NewProject(
+				[]sql.Expression{
+					expression.NewAlias("a || b || c",
+						expression.NewUnresolvedFunction("concat", false, nil,
+							expression.NewUnresolvedFunction("concat", false, nil,
+								expression.NewUnresolvedColumn("a"), expression.NewUnresolvedColumn("b")),
+							expression.NewUnresolvedColumn("c")),
+					),
+				},
+				plan.NewFilter(
+					expression.NewEquals(
+						expression.NewArithmetic(
+							expression.NewUnresolvedColumn("i"),
+							expression.NewUnresolvedFunction("concat", false, nil,
+								expression.NewLiteral(int8(1), sql.Int8), expression.NewLiteral("x", sql.LongText)),
+							"+",
+						),
+						expression.NewLiteral("2x", sql.LongText),
+					),
+					plan.NewUnresolvedTable("t", ""),
+				),
+			),
+		},
+		{
+			mode:  "PIPES_AS_CONCAT",
+			query: `SELECT x'41' || b'1000010' || 0x43 || _binary'd'`,
+			expected: plan.NewProject(
+				[]sql.Expression{
+					expression.NewAlias(`x'41' || b'1000010' || 0x43 || _binary'd'`,
+						expression.NewUnresolvedFunction("concat", false, nil,
+							expression.NewUnresolvedFunction("concat", false, nil,
+								expression.NewUnresolvedFunction("concat", false, nil,
+									expression.NewLiteral([]byte("A"), sql.LongBlob), expression.NewLiteral(uint64(0x42), sql.Uint64)),
+								expression.NewLiteral(int8(67), sql.Int8)),
+							expression.NewLiteral("d", sql.LongBlob)),
+					),
+				},
+				plan.NewUnresolvedTable("dual", ""),
+			),
+		},
+		{
+			mode:  "ANSI_QUOTES",
+			query: `SELECT "a""b" FROM "t" WHERE "s" = 'x'`,
+			expected: plan.NewProject(
+				[]sql.Expression{expression.NewUnresolvedColumn(`a"b`)},
+				plan.NewFilter(
+					expression.NewEquals(expression.NewUnresolvedColumn("s"), expression.NewLiteral("x", sql.LongText)),
+					plan.NewUnresolvedTable("t", ""),
+				),
+			),
+		},
+		{
+			mode:  "ANSI_QUOTES,PIPES_AS_CONCAT",
+			query: `SELECT "s" || x'41' FROM t`,
+			expected: plan.NewProject(
+				[]sql.Expression{
+					expression.NewAlias(`"s" || x'41'`,
+						expression.NewUnresolvedFunction("concat", false, nil,
+							expression.NewUnresolvedColumn("s"), expression.NewLiteral([]byte("A"), sql.LongBlob)),
+					),
+				},
+				plan.NewUnresolvedTable("t", ""),
+			),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.mode+" "+tt.query, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			require.NoError(t, ctx.SetSessionVariable(ctx, "sql_mode", tt.mode))
+			node, err := Parse(ctx, tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, node)
+		})
+	}
+}
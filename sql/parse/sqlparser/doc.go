@@ -0,0 +1,23 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlparser is the SQL parser of the engine. It's a copy of the go/vt/sqlparser package of
+// github.com/dolthub/vitess at v0.0.0-20211108230711-9b4006809d3b, extended with the syntax the engine supports
+// beyond it, so that the grammar can change along with the engine. The other packages of vitess are still used from
+// that module.
+//
+// The parser is generated from the grammar in sql.y. After changing it, regenerate sql.go with:
+//
+//	make -C sql/parse/sqlparser
+package sqlparser
@@ -0,0 +1,12108 @@
+// Code generated by goyacc -o sql.go sql.y. DO NOT EDIT.
+
+//line sql.y:18
+package sqlparser
+
+import __yyfmt__ "fmt"
+
+//line sql.y:18
+
+import "fmt"
+
+func setParseTree(yylex interface{}, stmt Statement) {
+	yylex.(*Tokenizer).ParseTree = stmt
+}
+
+func setAllowComments(yylex interface{}, allow bool) {
+	yylex.(*Tokenizer).AllowComments = allow
+}
+
+func setDDL(yylex interface{}, ddl *DDL) {
+	yylex.(*Tokenizer).partialDDL = ddl
+}
+
+func incNesting(yylex interface{}) bool {
+	yylex.(*Tokenizer).nesting++
+	if yylex.(*Tokenizer).nesting == 200 {
+		return true
+	}
+	return false
+}
+
+func decNesting(yylex interface{}) {
+	yylex.(*Tokenizer).nesting--
+}
+
+func yyPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).Position
+}
+
+func yyOldPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).OldPosition
+}
+
+// skipToEnd forces the lexer to end prematurely. Not all SQL statements
+// are supported by the Parser, thus calling skipToEnd will make the lexer
+// return EOF early.
+func skipToEnd(yylex interface{}) {
+	yylex.(*Tokenizer).SkipToEnd = true
+}
+
+//line sql.y:63
+type yySymType struct {
+	yys                      int
+	empty                    struct{}
+	statement                Statement
+	selStmt                  SelectStatement
+	ddl                      *DDL
+	ddls                     []*DDL
+	ins                      *Insert
+	byt                      byte
+	bytes                    []byte
+	bytes2                   [][]byte
+	str                      string
+	int                      int
+	strs                     []string
+	selectExprs              SelectExprs
+	selectExpr               SelectExpr
+	columns                  Columns
+	statements               Statements
+	partitions               Partitions
+	colName                  *ColName
+	tableExprs               TableExprs
+	tableExpr                TableExpr
+	subquery                 *Subquery
+	simpleTableExpr          SimpleTableExpr
+	joinCondition            JoinCondition
+	tableName                TableName
+	tableNames               TableNames
+	indexHints               *IndexHints
+	asOf                     *AsOf
+	expr                     Expr
+	exprs                    Exprs
+	boolVal                  BoolVal
+	boolean                  bool
+	sqlVal                   *SQLVal
+	colTuple                 ColTuple
+	values                   Values
+	valTuple                 ValTuple
+	whens                    []*When
+	when                     *When
+	orderBy                  OrderBy
+	order                    *Order
+	limit                    *Limit
+	assignExprs              AssignmentExprs
+	assignExpr               *AssignmentExpr
+	setVarExprs              SetVarExprs
+	setVarExpr               *SetVarExpr
+	setScope                 SetScope
+	colIdent                 ColIdent
+	colIdents                []ColIdent
+	tableIdent               TableIdent
+	convertType              *ConvertType
+	aliasedTableName         *AliasedTableExpr
+	TableSpec                *TableSpec
+	columnType               ColumnType
+	columnOrder              *ColumnOrder
+	triggerOrder             *TriggerOrder
+	colKeyOpt                ColumnKeyOption
+	optVal                   Expr
+	LengthScaleOption        LengthScaleOption
+	columnDefinition         *ColumnDefinition
+	indexDefinition          *IndexDefinition
+	indexInfo                *IndexInfo
+	indexOption              *IndexOption
+	indexOptions             []*IndexOption
+	indexColumn              *IndexColumn
+	indexColumns             []*IndexColumn
+	constraintDefinition     *ConstraintDefinition
+	constraintInfo           ConstraintInfo
+	ReferenceAction          ReferenceAction
+	partDefs                 []*PartitionDefinition
+	partDef                  *PartitionDefinition
+	partOption               *PartitionOption
+	partSpec                 *PartitionSpec
+	showFilter               *ShowFilter
+	over                     *Over
+	pivot                    *Pivot
+	jsonTableColumn          *JSONTableColumn
+	jsonTableColumns         []*JSONTableColumn
+	jsonTableFallback        *JSONTableFallback
+	windowFrame              *WindowFrame
+	frameBound               *FrameBound
+	caseStatementCases       []CaseStatementCase
+	caseStatementCase        CaseStatementCase
+	ifStatementConditions    []IfStatementCondition
+	ifStatementCondition     IfStatementCondition
+	signalInfo               SignalInfo
+	signalInfos              []SignalInfo
+	signalConditionItemName  SignalConditionItemName
+	declareHandlerAction     DeclareHandlerAction
+	declareHandlerCondition  DeclareHandlerCondition
+	declareHandlerConditions []DeclareHandlerCondition
+	procedureParam           ProcedureParam
+	procedureParams          []ProcedureParam
+	characteristic           Characteristic
+	characteristics          []Characteristic
+	Fields                   *Fields
+	Lines                    *Lines
+	EnclosedBy               *EnclosedBy
+	tableAndLockType         *TableAndLockType
+	tableAndLockTypes        TableAndLockTypes
+	lockType                 LockType
+}
+
+const LEX_ERROR = 57346
+const UNION = 57347
+const EXCEPT = 57348
+const INTERSECT = 57349
+const SELECT = 57350
+const STREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const CALL = 57364
+const ALL = 57365
+const DISTINCT = 57366
+const AS = 57367
+const EXISTS = 57368
+const ASC = 57369
+const DESC = 57370
+const INTO = 57371
+const DUPLICATE = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const UNLOCK = 57376
+const KEYS = 57377
+const OF = 57378
+const OUTFILE = 57379
+const DATA = 57380
+const LOAD = 57381
+const LINES = 57382
+const TERMINATED = 57383
+const ESCAPED = 57384
+const ENCLOSED = 57385
+const OPTIONALLY = 57386
+const STARTING = 57387
+const UNIQUE = 57388
+const KEY = 57389
+const SYSTEM_TIME = 57390
+const VALUES = 57391
+const LAST_INSERT_ID = 57392
+const SQL_CALC_FOUND_ROWS = 57393
+const NEXT = 57394
+const VALUE = 57395
+const SHARE = 57396
+const MODE = 57397
+const SQL_NO_CACHE = 57398
+const SQL_CACHE = 57399
+const PIVOT = 57400
+const UNPIVOT = 57401
+const LATERAL = 57402
+const JSON_TABLE = 57403
+const NESTED = 57404
+const ORDINALITY = 57405
+const PATH = 57406
+const EMPTY = 57407
+const ERROR = 57408
+const JOIN = 57409
+const STRAIGHT_JOIN = 57410
+const LEFT = 57411
+const RIGHT = 57412
+const INNER = 57413
+const OUTER = 57414
+const CROSS = 57415
+const NATURAL = 57416
+const USE = 57417
+const FORCE = 57418
+const ON = 57419
+const USING = 57420
+const ID = 57421
+const HEX = 57422
+const STRING = 57423
+const INTEGRAL = 57424
+const FLOAT = 57425
+const HEXNUM = 57426
+const VALUE_ARG = 57427
+const LIST_ARG = 57428
+const COMMENT = 57429
+const COMMENT_KEYWORD = 57430
+const BIT_LITERAL = 57431
+const NULL = 57432
+const TRUE = 57433
+const FALSE = 57434
+const OFF = 57435
+const ASSIGNMENT_OP = 57436
+const OR = 57437
+const AND = 57438
+const NOT = 57439
+const BETWEEN = 57440
+const CASE = 57441
+const WHEN = 57442
+const THEN = 57443
+const ELSE = 57444
+const ELSEIF = 57445
+const END = 57446
+const LE = 57447
+const GE = 57448
+const NE = 57449
+const NULL_SAFE_EQUAL = 57450
+const IS = 57451
+const LIKE = 57452
+const REGEXP = 57453
+const IN = 57454
+const SHIFT_LEFT = 57455
+const SHIFT_RIGHT = 57456
+const DIV = 57457
+const MOD = 57458
+const PIPE_CONCAT = 57459
+const UNARY = 57460
+const COLLATE = 57461
+const BINARY = 57462
+const UNDERSCORE_BINARY = 57463
+const UNDERSCORE_UTF8MB4 = 57464
+const INTERVAL = 57465
+const JSON_EXTRACT_OP = 57466
+const JSON_UNQUOTE_EXTRACT_OP = 57467
+const CREATE = 57468
+const ALTER = 57469
+const DROP = 57470
+const RENAME = 57471
+const ANALYZE = 57472
+const ADD = 57473
+const FLUSH = 57474
+const MODIFY = 57475
+const CHANGE = 57476
+const SCHEMA = 57477
+const TABLE = 57478
+const INDEX = 57479
+const INDEXES = 57480
+const VIEW = 57481
+const TO = 57482
+const IGNORE = 57483
+const IF = 57484
+const PRIMARY = 57485
+const COLUMN = 57486
+const SPATIAL = 57487
+const FULLTEXT = 57488
+const KEY_BLOCK_SIZE = 57489
+const CHECK = 57490
+const ACTION = 57491
+const CASCADE = 57492
+const CONSTRAINT = 57493
+const FOREIGN = 57494
+const NO = 57495
+const REFERENCES = 57496
+const RESTRICT = 57497
+const FIRST = 57498
+const AFTER = 57499
+const SHOW = 57500
+const DESCRIBE = 57501
+const EXPLAIN = 57502
+const DATE = 57503
+const ESCAPE = 57504
+const REPAIR = 57505
+const OPTIMIZE = 57506
+const TRUNCATE = 57507
+const FORMAT = 57508
+const DIFF = 57509
+const MAXVALUE = 57510
+const PARTITION = 57511
+const REORGANIZE = 57512
+const LESS = 57513
+const THAN = 57514
+const PROCEDURE = 57515
+const TRIGGER = 57516
+const TRIGGERS = 57517
+const FUNCTION = 57518
+const PARTITIONS = 57519
+const LINEAR = 57520
+const HASH = 57521
+const LIST = 57522
+const STATUS = 57523
+const VARIABLES = 57524
+const WARNINGS = 57525
+const SEQUENCE = 57526
+const EACH = 57527
+const ROW = 57528
+const BEFORE = 57529
+const FOLLOWS = 57530
+const PRECEDES = 57531
+const DEFINER = 57532
+const INVOKER = 57533
+const INOUT = 57534
+const OUT = 57535
+const DETERMINISTIC = 57536
+const CONTAINS = 57537
+const READS = 57538
+const MODIFIES = 57539
+const SQL = 57540
+const SECURITY = 57541
+const TEMPORARY = 57542
+const CLASS_ORIGIN = 57543
+const SUBCLASS_ORIGIN = 57544
+const MESSAGE_TEXT = 57545
+const MYSQL_ERRNO = 57546
+const CONSTRAINT_CATALOG = 57547
+const CONSTRAINT_SCHEMA = 57548
+const CONSTRAINT_NAME = 57549
+const CATALOG_NAME = 57550
+const SCHEMA_NAME = 57551
+const TABLE_NAME = 57552
+const COLUMN_NAME = 57553
+const CURSOR_NAME = 57554
+const SIGNAL = 57555
+const RESIGNAL = 57556
+const SQLSTATE = 57557
+const DECLARE = 57558
+const CONDITION = 57559
+const CURSOR = 57560
+const CONTINUE = 57561
+const EXIT = 57562
+const UNDO = 57563
+const HANDLER = 57564
+const FOUND = 57565
+const SQLWARNING = 57566
+const SQLEXCEPTION = 57567
+const BEGIN = 57568
+const START = 57569
+const TRANSACTION = 57570
+const COMMIT = 57571
+const ROLLBACK = 57572
+const SAVEPOINT = 57573
+const WORK = 57574
+const RELEASE = 57575
+const BIT = 57576
+const TINYINT = 57577
+const SMALLINT = 57578
+const MEDIUMINT = 57579
+const INT = 57580
+const INTEGER = 57581
+const BIGINT = 57582
+const INTNUM = 57583
+const REAL = 57584
+const DOUBLE = 57585
+const FLOAT_TYPE = 57586
+const DECIMAL = 57587
+const NUMERIC = 57588
+const DEC = 57589
+const FIXED = 57590
+const PRECISION = 57591
+const TIME = 57592
+const TIMESTAMP = 57593
+const DATETIME = 57594
+const YEAR = 57595
+const CHAR = 57596
+const VARCHAR = 57597
+const BOOL = 57598
+const CHARACTER = 57599
+const VARBINARY = 57600
+const NCHAR = 57601
+const NVARCHAR = 57602
+const NATIONAL = 57603
+const VARYING = 57604
+const TEXT = 57605
+const TINYTEXT = 57606
+const MEDIUMTEXT = 57607
+const LONGTEXT = 57608
+const LONG = 57609
+const BLOB = 57610
+const TINYBLOB = 57611
+const MEDIUMBLOB = 57612
+const LONGBLOB = 57613
+const JSON = 57614
+const ENUM = 57615
+const GEOMETRY = 57616
+const POINT = 57617
+const LINESTRING = 57618
+const POLYGON = 57619
+const GEOMETRYCOLLECTION = 57620
+const MULTIPOINT = 57621
+const MULTILINESTRING = 57622
+const MULTIPOLYGON = 57623
+const LOCAL = 57624
+const LOW_PRIORITY = 57625
+const NULLX = 57626
+const AUTO_INCREMENT = 57627
+const APPROXNUM = 57628
+const SIGNED = 57629
+const UNSIGNED = 57630
+const ZEROFILL = 57631
+const COLLATION = 57632
+const DATABASES = 57633
+const SCHEMAS = 57634
+const TABLES = 57635
+const FULL = 57636
+const PROCESSLIST = 57637
+const COLUMNS = 57638
+const FIELDS = 57639
+const ENGINES = 57640
+const PLUGINS = 57641
+const NAMES = 57642
+const CHARSET = 57643
+const GLOBAL = 57644
+const SESSION = 57645
+const ISOLATION = 57646
+const LEVEL = 57647
+const READ = 57648
+const WRITE = 57649
+const ONLY = 57650
+const REPEATABLE = 57651
+const COMMITTED = 57652
+const UNCOMMITTED = 57653
+const SERIALIZABLE = 57654
+const CURRENT_TIMESTAMP = 57655
+const DATABASE = 57656
+const CURRENT_DATE = 57657
+const CURRENT_USER = 57658
+const CURRENT_TIME = 57659
+const LOCALTIME = 57660
+const LOCALTIMESTAMP = 57661
+const UTC_DATE = 57662
+const UTC_TIME = 57663
+const UTC_TIMESTAMP = 57664
+const REPLACE = 57665
+const CONVERT = 57666
+const CAST = 57667
+const SUBSTR = 57668
+const SUBSTRING = 57669
+const TRIM = 57670
+const LEADING = 57671
+const TRAILING = 57672
+const BOTH = 57673
+const GROUP_CONCAT = 57674
+const SEPARATOR = 57675
+const TIMESTAMPADD = 57676
+const TIMESTAMPDIFF = 57677
+const EXTRACT = 57678
+const DAY_HOUR = 57679
+const DAY_MICROSECOND = 57680
+const DAY_MINUTE = 57681
+const DAY_SECOND = 57682
+const HOUR_MICROSECOND = 57683
+const HOUR_MINUTE = 57684
+const HOUR_SECOND = 57685
+const MINUTE_MICROSECOND = 57686
+const MINUTE_SECOND = 57687
+const SECOND_MICROSECOND = 57688
+const YEAR_MONTH = 57689
+const OVER = 57690
+const WINDOW = 57691
+const GROUPING = 57692
+const GROUPS = 57693
+const ROLLUP = 57694
+const CUBE = 57695
+const SETS = 57696
+const ROWS = 57697
+const RANGE = 57698
+const CURRENT = 57699
+const AVG = 57700
+const BIT_AND = 57701
+const BIT_OR = 57702
+const BIT_XOR = 57703
+const COUNT = 57704
+const JSON_ARRAYAGG = 57705
+const JSON_OBJECTAGG = 57706
+const MAX = 57707
+const MIN = 57708
+const STDDEV_POP = 57709
+const STDDEV = 57710
+const STD = 57711
+const STDDEV_SAMP = 57712
+const SUM = 57713
+const VAR_POP = 57714
+const VARIANCE = 57715
+const VAR_SAMP = 57716
+const CUME_DIST = 57717
+const DENSE_RANK = 57718
+const FIRST_VALUE = 57719
+const LAG = 57720
+const LAST_VALUE = 57721
+const LEAD = 57722
+const NTH_VALUE = 57723
+const NTILE = 57724
+const ROW_NUMBER = 57725
+const PERCENT_RANK = 57726
+const RANK = 57727
+const MATCH = 57728
+const AGAINST = 57729
+const BOOLEAN = 57730
+const LANGUAGE = 57731
+const WITH = 57732
+const QUERY = 57733
+const EXPANSION = 57734
+const UNUSED = 57735
+const ARRAY = 57736
+const DESCRIPTION = 57737
+const MEMBER = 57738
+const RECURSIVE = 57739
+const ACTIVE = 57740
+const ADMIN = 57741
+const BUCKETS = 57742
+const CLONE = 57743
+const COMPONENT = 57744
+const DEFINITION = 57745
+const ENFORCED = 57746
+const EXCLUDE = 57747
+const FOLLOWING = 57748
+const GEOMCOLLECTION = 57749
+const GET_MASTER_PUBLIC_KEY = 57750
+const HISTOGRAM = 57751
+const HISTORY = 57752
+const INACTIVE = 57753
+const INVISIBLE = 57754
+const LOCKED = 57755
+const MASTER_COMPRESSION_ALGORITHMS = 57756
+const MASTER_PUBLIC_KEY_PATH = 57757
+const MASTER_TLS_CIPHERSUITES = 57758
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57759
+const NETWORK_NAMESPACE = 57760
+const NOWAIT = 57761
+const NULLS = 57762
+const OJ = 57763
+const OLD = 57764
+const OPTIONAL = 57765
+const ORGANIZATION = 57766
+const OTHERS = 57767
+const PERSIST = 57768
+const PERSIST_ONLY = 57769
+const PRECEDING = 57770
+const PRIVILEGE_CHECKS_USER = 57771
+const PROCESS = 57772
+const RANDOM = 57773
+const REFERENCE = 57774
+const REQUIRE_ROW_FORMAT = 57775
+const RESOURCE = 57776
+const RESPECT = 57777
+const RESTART = 57778
+const RETAIN = 57779
+const REUSE = 57780
+const ROLE = 57781
+const SECONDARY = 57782
+const SECONDARY_ENGINE = 57783
+const SECONDARY_LOAD = 57784
+const SECONDARY_UNLOAD = 57785
+const SKIP = 57786
+const SRID = 57787
+const THREAD_PRIORITY = 57788
+const TIES = 57789
+const UNBOUNDED = 57790
+const VCPU = 57791
+const VISIBLE = 57792
+const SYSTEM = 57793
+const INFILE = 57794
+
+var yyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
+	"LEX_ERROR",
+	"UNION",
+	"EXCEPT",
+	"INTERSECT",
+	"SELECT",
+	"STREAM",
+	"INSERT",
+	"UPDATE",
+	"DELETE",
+	"FROM",
+	"WHERE",
+	"GROUP",
+	"HAVING",
+	"ORDER",
+	"BY",
+	"LIMIT",
+	"OFFSET",
+	"FOR",
+	"CALL",
+	"ALL",
+	"DISTINCT",
+	"AS",
+	"EXISTS",
+	"ASC",
+	"DESC",
+	"INTO",
+	"DUPLICATE",
+	"DEFAULT",
+	"SET",
+	"LOCK",
+	"UNLOCK",
+	"KEYS",
+	"OF",
+	"OUTFILE",
+	"DATA",
+	"LOAD",
+	"LINES",
+	"TERMINATED",
+	"ESCAPED",
+	"ENCLOSED",
+	"OPTIONALLY",
+	"STARTING",
+	"UNIQUE",
+	"KEY",
+	"SYSTEM_TIME",
+	"VALUES",
+	"LAST_INSERT_ID",
+	"SQL_CALC_FOUND_ROWS",
+	"NEXT",
+	"VALUE",
+	"SHARE",
+	"MODE",
+	"SQL_NO_CACHE",
+	"SQL_CACHE",
+	"PIVOT",
+	"UNPIVOT",
+	"LATERAL",
+	"JSON_TABLE",
+	"NESTED",
+	"ORDINALITY",
+	"PATH",
+	"EMPTY",
+	"ERROR",
+	"JOIN",
+	"STRAIGHT_JOIN",
+	"LEFT",
+	"RIGHT",
+	"INNER",
+	"OUTER",
+	"CROSS",
+	"NATURAL",
+	"USE",
+	"FORCE",
+	"ON",
+	"USING",
+	"'('",
+	"','",
+	"')'",
+	"ID",
+	"HEX",
+	"STRING",
+	"INTEGRAL",
+	"FLOAT",
+	"HEXNUM",
+	"VALUE_ARG",
+	"LIST_ARG",
+	"COMMENT",
+	"COMMENT_KEYWORD",
+	"BIT_LITERAL",
+	"NULL",
+	"TRUE",
+	"FALSE",
+	"OFF",
+	"ASSIGNMENT_OP",
+	"OR",
+	"AND",
+	"NOT",
+	"'!'",
+	"BETWEEN",
+	"CASE",
+	"WHEN",
+	"THEN",
+	"ELSE",
+	"ELSEIF",
+	"END",
+	"'='",
+	"'<'",
+	"'>'",
+	"LE",
+	"GE",
+	"NE",
+	"NULL_SAFE_EQUAL",
+	"IS",
+	"LIKE",
+	"REGEXP",
+	"IN",
+	"'|'",
+	"'&'",
+	"SHIFT_LEFT",
+	"SHIFT_RIGHT",
+	"'+'",
+	"'-'",
+	"'*'",
+	"'/'",
+	"DIV",
+	"'%'",
+	"MOD",
+	"'^'",
+	"PIPE_CONCAT",
+	"'~'",
+	"UNARY",
+	"COLLATE",
+	"BINARY",
+	"UNDERSCORE_BINARY",
+	"UNDERSCORE_UTF8MB4",
+	"INTERVAL",
+	"'.'",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
+	"CREATE",
+	"ALTER",
+	"DROP",
+	"RENAME",
+	"ANALYZE",
+	"ADD",
+	"FLUSH",
+	"MODIFY",
+	"CHANGE",
+	"SCHEMA",
+	"TABLE",
+	"INDEX",
+	"INDEXES",
+	"VIEW",
+	"TO",
+	"IGNORE",
+	"IF",
+	"PRIMARY",
+	"COLUMN",
+	"SPATIAL",
+	"FULLTEXT",
+	"KEY_BLOCK_SIZE",
+	"CHECK",
+	"ACTION",
+	"CASCADE",
+	"CONSTRAINT",
+	"FOREIGN",
+	"NO",
+	"REFERENCES",
+	"RESTRICT",
+	"FIRST",
+	"AFTER",
+	"SHOW",
+	"DESCRIBE",
+	"EXPLAIN",
+	"DATE",
+	"ESCAPE",
+	"REPAIR",
+	"OPTIMIZE",
+	"TRUNCATE",
+	"FORMAT",
+	"DIFF",
+	"MAXVALUE",
+	"PARTITION",
+	"REORGANIZE",
+	"LESS",
+	"THAN",
+	"PROCEDURE",
+	"TRIGGER",
+	"TRIGGERS",
+	"FUNCTION",
+	"PARTITIONS",
+	"LINEAR",
+	"HASH",
+	"LIST",
+	"STATUS",
+	"VARIABLES",
+	"WARNINGS",
+	"SEQUENCE",
+	"EACH",
+	"ROW",
+	"BEFORE",
+	"FOLLOWS",
+	"PRECEDES",
+	"DEFINER",
+	"INVOKER",
+	"INOUT",
+	"OUT",
+	"DETERMINISTIC",
+	"CONTAINS",
+	"READS",
+	"MODIFIES",
+	"SQL",
+	"SECURITY",
+	"TEMPORARY",
+	"CLASS_ORIGIN",
+	"SUBCLASS_ORIGIN",
+	"MESSAGE_TEXT",
+	"MYSQL_ERRNO",
+	"CONSTRAINT_CATALOG",
+	"CONSTRAINT_SCHEMA",
+	"CONSTRAINT_NAME",
+	"CATALOG_NAME",
+	"SCHEMA_NAME",
+	"TABLE_NAME",
+	"COLUMN_NAME",
+	"CURSOR_NAME",
+	"SIGNAL",
+	"RESIGNAL",
+	"SQLSTATE",
+	"DECLARE",
+	"CONDITION",
+	"CURSOR",
+	"CONTINUE",
+	"EXIT",
+	"UNDO",
+	"HANDLER",
+	"FOUND",
+	"SQLWARNING",
+	"SQLEXCEPTION",
+	"BEGIN",
+	"START",
+	"TRANSACTION",
+	"COMMIT",
+	"ROLLBACK",
+	"SAVEPOINT",
+	"WORK",
+	"RELEASE",
+	"BIT",
+	"TINYINT",
+	"SMALLINT",
+	"MEDIUMINT",
+	"INT",
+	"INTEGER",
+	"BIGINT",
+	"INTNUM",
+	"REAL",
+	"DOUBLE",
+	"FLOAT_TYPE",
+	"DECIMAL",
+	"NUMERIC",
+	"DEC",
+	"FIXED",
+	"PRECISION",
+	"TIME",
+	"TIMESTAMP",
+	"DATETIME",
+	"YEAR",
+	"CHAR",
+	"VARCHAR",
+	"BOOL",
+	"CHARACTER",
+	"VARBINARY",
+	"NCHAR",
+	"NVARCHAR",
+	"NATIONAL",
+	"VARYING",
+	"TEXT",
+	"TINYTEXT",
+	"MEDIUMTEXT",
+	"LONGTEXT",
+	"LONG",
+	"BLOB",
+	"TINYBLOB",
+	"MEDIUMBLOB",
+	"LONGBLOB",
+	"JSON",
+	"ENUM",
+	"GEOMETRY",
+	"POINT",
+	"LINESTRING",
+	"POLYGON",
+	"GEOMETRYCOLLECTION",
+	"MULTIPOINT",
+	"MULTILINESTRING",
+	"MULTIPOLYGON",
+	"LOCAL",
+	"LOW_PRIORITY",
+	"NULLX",
+	"AUTO_INCREMENT",
+	"APPROXNUM",
+	"SIGNED",
+	"UNSIGNED",
+	"ZEROFILL",
+	"COLLATION",
+	"DATABASES",
+	"SCHEMAS",
+	"TABLES",
+	"FULL",
+	"PROCESSLIST",
+	"COLUMNS",
+	"FIELDS",
+	"ENGINES",
+	"PLUGINS",
+	"NAMES",
+	"CHARSET",
+	"GLOBAL",
+	"SESSION",
+	"ISOLATION",
+	"LEVEL",
+	"READ",
+	"WRITE",
+	"ONLY",
+	"REPEATABLE",
+	"COMMITTED",
+	"UNCOMMITTED",
+	"SERIALIZABLE",
+	"CURRENT_TIMESTAMP",
+	"DATABASE",
+	"CURRENT_DATE",
+	"CURRENT_USER",
+	"CURRENT_TIME",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"UTC_DATE",
+	"UTC_TIME",
+	"UTC_TIMESTAMP",
+	"REPLACE",
+	"CONVERT",
+	"CAST",
+	"SUBSTR",
+	"SUBSTRING",
+	"TRIM",
+	"LEADING",
+	"TRAILING",
+	"BOTH",
+	"GROUP_CONCAT",
+	"SEPARATOR",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
+	"EXTRACT",
+	"DAY_HOUR",
+	"DAY_MICROSECOND",
+	"DAY_MINUTE",
+	"DAY_SECOND",
+	"HOUR_MICROSECOND",
+	"HOUR_MINUTE",
+	"HOUR_SECOND",
+	"MINUTE_MICROSECOND",
+	"MINUTE_SECOND",
+	"SECOND_MICROSECOND",
+	"YEAR_MONTH",
+	"OVER",
+	"WINDOW",
+	"GROUPING",
+	"GROUPS",
+	"ROLLUP",
+	"CUBE",
+	"SETS",
+	"ROWS",
+	"RANGE",
+	"CURRENT",
+	"AVG",
+	"BIT_AND",
+	"BIT_OR",
+	"BIT_XOR",
+	"COUNT",
+	"JSON_ARRAYAGG",
+	"JSON_OBJECTAGG",
+	"MAX",
+	"MIN",
+	"STDDEV_POP",
+	"STDDEV",
+	"STD",
+	"STDDEV_SAMP",
+	"SUM",
+	"VAR_POP",
+	"VARIANCE",
+	"VAR_SAMP",
+	"CUME_DIST",
+	"DENSE_RANK",
+	"FIRST_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"NTH_VALUE",
+	"NTILE",
+	"ROW_NUMBER",
+	"PERCENT_RANK",
+	"RANK",
+	"MATCH",
+	"AGAINST",
+	"BOOLEAN",
+	"LANGUAGE",
+	"WITH",
+	"QUERY",
+	"EXPANSION",
+	"UNUSED",
+	"ARRAY",
+	"DESCRIPTION",
+	"MEMBER",
+	"RECURSIVE",
+	"ACTIVE",
+	"ADMIN",
+	"BUCKETS",
+	"CLONE",
+	"COMPONENT",
+	"DEFINITION",
+	"ENFORCED",
+	"EXCLUDE",
+	"FOLLOWING",
+	"GEOMCOLLECTION",
+	"GET_MASTER_PUBLIC_KEY",
+	"HISTOGRAM",
+	"HISTORY",
+	"INACTIVE",
+	"INVISIBLE",
+	"LOCKED",
+	"MASTER_COMPRESSION_ALGORITHMS",
+	"MASTER_PUBLIC_KEY_PATH",
+	"MASTER_TLS_CIPHERSUITES",
+	"MASTER_ZSTD_COMPRESSION_LEVEL",
+	"NETWORK_NAMESPACE",
+	"NOWAIT",
+	"NULLS",
+	"OJ",
+	"OLD",
+	"OPTIONAL",
+	"ORGANIZATION",
+	"OTHERS",
+	"PERSIST",
+	"PERSIST_ONLY",
+	"PRECEDING",
+	"PRIVILEGE_CHECKS_USER",
+	"PROCESS",
+	"RANDOM",
+	"REFERENCE",
+	"REQUIRE_ROW_FORMAT",
+	"RESOURCE",
+	"RESPECT",
+	"RESTART",
+	"RETAIN",
+	"REUSE",
+	"ROLE",
+	"SECONDARY",
+	"SECONDARY_ENGINE",
+	"SECONDARY_LOAD",
+	"SECONDARY_UNLOAD",
+	"SKIP",
+	"SRID",
+	"THREAD_PRIORITY",
+	"TIES",
+	"UNBOUNDED",
+	"VCPU",
+	"VISIBLE",
+	"SYSTEM",
+	"INFILE",
+	"';'",
+}
+
+var yyStatenames = [...]string{}
+
+const yyEofCode = 1
+const yyErrCode = 2
+const yyInitialStackSize = 16
+
+//line yacctab:1
+var yyExca = [...]int{
+	-1, 1,
+	1, -1,
+	-2, 0,
+	-1, 33,
+	5, 50,
+	6, 50,
+	7, 50,
+	-2, 937,
+	-1, 41,
+	153, 1001,
+	154, 1027,
+	-2, 123,
+	-1, 48,
+	198, 526,
+	199, 526,
+	-2, 516,
+	-1, 55,
+	1, 1465,
+	470, 1465,
+	-2, 554,
+	-1, 450,
+	140, 1037,
+	-2, 1031,
+	-1, 451,
+	140, 1038,
+	-2, 1032,
+	-1, 558,
+	97, 1282,
+	109, 1282,
+	140, 1282,
+	-2, 982,
+	-1, 559,
+	97, 1386,
+	109, 1386,
+	140, 1386,
+	-2, 983,
+	-1, 564,
+	97, 1304,
+	109, 1304,
+	140, 1304,
+	-2, 984,
+	-1, 565,
+	97, 1344,
+	109, 1344,
+	140, 1344,
+	-2, 985,
+	-1, 566,
+	97, 1345,
+	109, 1345,
+	140, 1345,
+	-2, 986,
+	-1, 567,
+	97, 1233,
+	109, 1233,
+	140, 1233,
+	-2, 993,
+	-1, 569,
+	97, 1323,
+	109, 1323,
+	140, 1323,
+	-2, 995,
+	-1, 572,
+	140, 1037,
+	-2, 1031,
+	-1, 1005,
+	1, 622,
+	5, 622,
+	6, 622,
+	7, 622,
+	14, 622,
+	15, 622,
+	16, 622,
+	17, 622,
+	19, 622,
+	21, 622,
+	32, 622,
+	33, 622,
+	67, 622,
+	68, 622,
+	69, 622,
+	70, 622,
+	71, 622,
+	73, 622,
+	74, 622,
+	77, 622,
+	78, 622,
+	80, 622,
+	81, 622,
+	470, 622,
+	-2, 674,
+	-1, 1011,
+	78, 69,
+	80, 69,
+	-2, 73,
+	-1, 1213,
+	140, 1040,
+	-2, 1036,
+	-1, 1407,
+	79, 363,
+	-2, 1196,
+	-1, 1410,
+	79, 359,
+	82, 359,
+	-2, 1125,
+	-1, 1411,
+	79, 360,
+	82, 360,
+	-2, 1136,
+	-1, 1501,
+	46, 406,
+	160, 408,
+	162, 406,
+	163, 406,
+	-2, 446,
+	-1, 1578,
+	5, 51,
+	6, 51,
+	7, 51,
+	-2, 741,
+	-1, 1869,
+	80, 1175,
+	81, 1175,
+	140, 1175,
+	-2, 561,
+	-1, 1894,
+	1, 695,
+	5, 695,
+	6, 695,
+	7, 695,
+	14, 695,
+	15, 695,
+	16, 695,
+	17, 695,
+	19, 695,
+	21, 695,
+	32, 695,
+	33, 695,
+	67, 695,
+	68, 695,
+	69, 695,
+	70, 695,
+	71, 695,
+	73, 695,
+	74, 695,
+	77, 695,
+	78, 695,
+	80, 695,
+	81, 695,
+	470, 695,
+	-2, 674,
+	-1, 1970,
+	160, 409,
+	-2, 407,
+	-1, 2034,
+	5, 51,
+	6, 51,
+	7, 51,
+	-2, 956,
+	-1, 2202,
+	43, 1047,
+	-2, 1045,
+	-1, 2339,
+	5, 51,
+	6, 51,
+	7, 51,
+	-2, 959,
+}
+
+const yyPrivate = 57344
+
+const yyLast = 30813
+
+var yyAct = [...]int{
+	514, 78, 2523, 2507, 2575, 2540, 2530, 2340, 954, 2531,
+	2368, 1455, 2436, 2509, 2355, 2403, 399, 2269, 7, 2268,
+	6, 2218, 2414, 2267, 5, 2270, 8, 869, 92, 2224,
+	454, 2435, 2045, 2341, 2315, 2122, 1043, 2320, 2175, 2402,
+	2128, 2202, 2314, 1453, 1619, 1863, 1886, 2369, 1910, 442,
+	1357, 1412, 1785, 772, 1682, 82, 1653, 435, 513, 1795,
+	456, 2102, 2266, 3, 584, 1348, 1911, 2084, 1191, 1864,
+	1794, 1963, 2356, 375, 378, 1736, 1009, 1620, 1860, 78,
+	1354, 1428, 469, 1404, 1499, 782, 1005, 1393, 103, 1394,
+	1871, 1530, 1878, 1184, 1239, 1481, 371, 1832, 1327, 1444,
+	1199, 1408, 1124, 1249, 1331, 448, 1761, 1169, 401, 1400,
+	1380, 579, 1440, 759, 1760, 1719, 853, 1144, 1022, 582,
+	860, 1317, 1338, 118, 1002, 1215, 560, 856, 453, 831,
+	810, 578, 370, 556, 438, 557, 1001, 1021, 835, 552,
+	873, 434, 389, 737, 809, 1013, 549, 2599, 580, 2594,
+	2583, 563, 2561, 372, 373, 374, 2559, 398, 2535, 84,
+	2502, 2422, 81, 1167, 2078, 67, 551, 2331, 2329, 974,
+	576, 2227, 2227, 2548, 735, 34, 2395, 975, 2520, 2337,
+	34, 34, 2482, 2394, 864, 1853, 745, 2386, 2225, 2330,
+	2328, 1944, 2130, 2131, 754, 86, 87, 88, 89, 90,
+	2433, 1552, 2026, 736, 1614, 1322, 1662, 1905, 1906, 1661,
+	34, 1023, 1663, 1024, 1173, 1494, 2149, 1904, 2336, 1377,
+	1378, 1615, 1350, 2235, 888, 887, 898, 899, 891, 892,
+	893, 894, 895, 896, 897, 889, 890, 1171, 1172, 900,
+	847, 2085, 1179, 1180, 739, 1376, 79, 458, 785, 786,
+	2087, 79, 79, 1702, 784, 2487, 2449, 2158, 574, 430,
+	386, 79, 114, 110, 111, 385, 112, 1414, 1493, 828,
+	531, 2134, 537, 539, 538, 535, 536, 534, 533, 532,
+	1416, 79, 34, 1429, 70, 37, 38, 1441, 1970, 2064,
+	1804, 540, 541, 770, 1420, 1422, 1434, 1421, 1429, 2013,
+	116, 115, 2011, 365, 1154, 384, 794, 2513, 396, 764,
+	2508, 2544, 2419, 2417, 2418, 376, 2199, 70, 37, 38,
+	2090, 2504, 1462, 2198, 2197, 2511, 2196, 2195, 2193, 2194,
+	2357, 430, 2299, 2300, 2264, 1170, 2411, 2412, 2332, 39,
+	2047, 1640, 2258, 779, 780, 771, 771, 781, 2167, 1805,
+	778, 1737, 1461, 79, 777, 741, 740, 1962, 771, 2516,
+	1964, 2088, 2089, 2091, 2092, 2093, 2476, 1669, 78, 78,
+	2316, 1042, 787, 1416, 788, 785, 786, 1788, 379, 763,
+	767, 1332, 2262, 769, 2590, 799, 368, 801, 1738, 837,
+	837, 800, 83, 2103, 2104, 366, 1042, 1914, 1969, 1042,
+	850, 1767, 1042, 1916, 424, 2601, 1916, 2597, 2172, 1042,
+	2584, 2562, 1041, 738, 747, 2302, 756, 765, 768, 394,
+	766, 395, 369, 395, 2115, 1741, 1755, 1483, 380, 1114,
+	798, 802, 796, 1105, 1652, 2475, 1651, 2063, 2062, 2061,
+	1650, 113, 734, 845, 742, 339, 109, 2118, 1997, 1989,
+	909, 1666, 1658, 912, 913, 914, 2259, 377, 79, 1547,
+	2228, 2228, 832, 1429, 1535, 377, 2510, 2512, 377, 1520,
+	1155, 846, 795, 1670, 1443, 877, 424, 1194, 1739, 1740,
+	1709, 2579, 923, 924, 925, 926, 927, 928, 929, 930,
+	931, 932, 933, 934, 935, 936, 937, 938, 939, 940,
+	941, 942, 943, 944, 945, 946, 947, 948, 949, 950,
+	951, 2236, 1943, 952, 2385, 956, 957, 958, 959, 960,
+	961, 962, 963, 964, 965, 966, 967, 968, 838, 971,
+	972, 973, 976, 976, 976, 982, 976, 976, 982, 976,
+	982, 991, 992, 993, 994, 995, 996, 833, 1006, 2335,
+	2498, 2226, 2226, 2389, 836, 836, 2086, 2171, 762, 410,
+	2119, 2000, 2176, 1035, 2546, 915, 916, 917, 918, 919,
+	920, 921, 922, 1419, 77, 2178, 106, 953, 377, 77,
+	77, 403, 404, 405, 406, 407, 412, 413, 417, 418,
+	427, 426, 425, 428, 429, 432, 431, 433, 408, 409,
+	411, 414, 415, 416, 419, 420, 423, 421, 422, 77,
+	1173, 1680, 1100, 1000, 71, 2060, 2577, 563, 1019, 2578,
+	1680, 2576, 563, 999, 1381, 1011, 106, 879, 98, 2114,
+	1036, 410, 755, 1171, 1172, 890, 1362, 1364, 900, 773,
+	851, 900, 1588, 1833, 360, 1933, 1372, 71, 2177, 1786,
+	2247, 793, 2246, 403, 404, 405, 406, 407, 412, 413,
+	417, 418, 427, 426, 425, 428, 429, 432, 431, 433,
+	408, 409, 411, 414, 415, 416, 419, 420, 423, 421,
+	422, 77, 100, 1026, 1145, 1835, 97, 1680, 1027, 1585,
+	1040, 357, 108, 107, 1187, 1012, 1017, 95, 1782, 1934,
+	911, 1101, 977, 979, 981, 983, 985, 987, 988, 990,
+	978, 980, 872, 984, 986, 1472, 989, 1876, 1679, 1363,
+	1680, 1875, 1683, 1162, 1777, 1767, 1512, 1679, 803, 1774,
+	889, 890, 1773, 1776, 900, 1037, 775, 104, 1222, 743,
+	1511, 1694, 108, 107, 2582, 340, 1855, 105, 1033, 761,
+	1769, 94, 343, 1220, 1221, 1219, 1699, 1698, 771, 1680,
+	1032, 2113, 352, 358, 359, 771, 771, 771, 789, 1837,
+	913, 914, 1318, 1318, 1841, 1601, 1836, 1583, 1834, 1582,
+	771, 771, 2499, 1839, 1107, 2391, 1892, 1695, 910, 93,
+	1146, 1516, 2371, 356, 1679, 1010, 1838, 871, 870, 349,
+	1510, 1700, 350, 1692, 871, 870, 871, 870, 1921, 355,
+	1693, 1840, 1842, 2586, 2591, 872, 870, 913, 914, 79,
+	1126, 1473, 872, 551, 872, 2550, 1119, 1679, 867, 1218,
+	1781, 871, 870, 872, 1778, 1165, 857, 2415, 78, 858,
+	871, 870, 776, 1196, 1136, 1137, 1138, 771, 2353, 872,
+	1183, 1139, 760, 1508, 1502, 1503, 2250, 1501, 872, 1504,
+	1505, 99, 2401, 1192, 1193, 2077, 1679, 871, 870, 1152,
+	1697, 791, 1128, 1767, 2501, 1197, 2592, 1042, 1198, 401,
+	1770, 1768, 2400, 341, 2526, 872, 1111, 2565, 2541, 2564,
+	2076, 1140, 1141, 1115, 1724, 1514, 1517, 1151, 1769, 1722,
+	1176, 1148, 1149, 1703, 1185, 877, 1131, 1132, 871, 870,
+	1532, 1533, 1534, 78, 746, 2416, 354, 344, 345, 2525,
+	363, 1725, 1177, 807, 346, 348, 872, 342, 362, 361,
+	871, 870, 2527, 1212, 871, 870, 1182, 1857, 871, 870,
+	1240, 1216, 1241, 2484, 1213, 1201, 2524, 1584, 872, 1157,
+	1158, 2472, 872, 1160, 806, 2471, 872, 956, 2450, 891,
+	892, 893, 894, 895, 896, 897, 889, 890, 1174, 1163,
+	900, 1664, 2415, 1665, 2460, 953, 2459, 1175, 1205, 1207,
+	1208, 2424, 1211, 1509, 2377, 1206, 2261, 1214, 393, 2192,
+	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
+	1233, 1234, 1235, 1236, 1237, 1238, 1181, 1351, 912, 1353,
+	2144, 1507, 1696, 2074, 1006, 1209, 2049, 1926, 1006, 1296,
+	871, 870, 1720, 1490, 1303, 1306, 1359, 749, 750, 751,
+	752, 753, 1319, 1159, 1347, 1271, 1129, 2458, 872, 1275,
+	401, 2457, 1244, 1245, 474, 473, 476, 477, 478, 479,
+	1513, 1127, 2212, 475, 480, 2397, 852, 852, 1133, 1134,
+	1135, 893, 894, 895, 896, 897, 889, 890, 1683, 953,
+	900, 1309, 1100, 1142, 1143, 1333, 546, 547, 2206, 1367,
+	2111, 563, 1323, 1369, 1126, 1294, 852, 1454, 1984, 1388,
+	1980, 1368, 1395, 580, 1390, 2478, 852, 1010, 2156, 2491,
+	1213, 2066, 2474, 2452, 852, 2156, 852, 771, 1247, 771,
+	2383, 852, 1255, 2066, 2381, 2205, 1365, 2066, 2379, 1515,
+	2066, 2263, 1277, 1278, 2156, 2242, 2156, 2182, 2156, 2155,
+	1217, 2066, 2065, 1288, 1256, 1257, 1971, 1292, 1389, 1954,
+	1178, 1430, 1431, 1432, 1433, 1953, 1280, 1281, 1282, 1283,
+	1952, 1401, 1748, 1315, 1374, 1747, 1373, 1484, 1370, 1293,
+	1295, 1101, 1470, 1379, 2037, 852, 1300, 1519, 852, 1941,
+	1940, 1398, 1391, 1991, 1654, 1469, 837, 1937, 1938, 1937,
+	1936, 1654, 1456, 1242, 78, 1545, 852, 1492, 1464, 1156,
+	1465, 1466, 1335, 852, 1467, 1446, 1447, 1448, 1449, 1294,
+	1487, 401, 1153, 1123, 1122, 1121, 1442, 1120, 1112, 1110,
+	1109, 1108, 1106, 1536, 1039, 1038, 83, 829, 757, 1450,
+	1451, 383, 381, 2186, 1477, 812, 813, 814, 815, 816,
+	817, 818, 819, 820, 821, 822, 823, 2185, 1861, 1949,
+	1992, 1874, 1874, 2204, 1927, 832, 953, 1335, 1212, 1654,
+	83, 2032, 1950, 1015, 1010, 1352, 1015, 79, 1474, 1213,
+	1366, 1010, 1014, 1480, 1189, 1010, 887, 898, 899, 891,
+	892, 893, 894, 895, 896, 897, 889, 890, 1216, 1294,
+	900, 1334, 1939, 1340, 1343, 1344, 1345, 1341, 1888, 1342,
+	1346, 1486, 1485, 1879, 1880, 1758, 2409, 1389, 1491, 898,
+	899, 891, 892, 893, 894, 895, 896, 897, 889, 890,
+	1992, 1524, 900, 1545, 1496, 1874, 1522, 1523, 1016, 1541,
+	1018, 1016, 1375, 1014, 1188, 1545, 1538, 1539, 1540, 1335,
+	1608, 1607, 1617, 1618, 575, 1161, 1006, 1006, 1006, 1006,
+	1006, 836, 1468, 1014, 1190, 1537, 1168, 1340, 1343, 1344,
+	1345, 1341, 1351, 1342, 1346, 1113, 1643, 1020, 849, 1495,
+	2380, 1648, 1649, 848, 1636, 1887, 2211, 1006, 2209, 2079,
+	1416, 2043, 1445, 1359, 1920, 1646, 1879, 1880, 401, 1441,
+	1674, 1641, 1497, 1463, 1436, 1435, 1102, 401, 826, 401,
+	1518, 1576, 2596, 2556, 1616, 1621, 2554, 2549, 2532, 1622,
+	1457, 1948, 1459, 1531, 1882, 79, 1861, 1726, 1117, 1885,
+	1647, 1884, 79, 1629, 1296, 1632, 1593, 1594, 1595, 1628,
+	1633, 2446, 1630, 2600, 1656, 2443, 1657, 1631, 1634, 2485,
+	1344, 1345, 1655, 2393, 1676, 563, 2448, 1644, 1600, 2572,
+	2573, 439, 440, 1792, 1521, 1200, 2432, 1395, 1543, 1529,
+	1528, 2147, 2110, 1685, 1546, 2069, 78, 865, 866, 1548,
+	1549, 1624, 1625, 1684, 1627, 1979, 1978, 1217, 771, 1635,
+	771, 771, 1623, 1925, 2447, 1626, 1924, 1555, 1556, 1557,
+	1558, 1559, 1560, 1671, 1677, 1563, 863, 2304, 2307, 2376,
+	1568, 1569, 1570, 1571, 1659, 1573, 1574, 1575, 2375, 1704,
+	1705, 1026, 1578, 1579, 1580, 1581, 1711, 1667, 1678, 1681,
+	1673, 1587, 2203, 2423, 1590, 1591, 1718, 2201, 1728, 1596,
+	1597, 2298, 2297, 1101, 382, 1603, 1604, 1605, 1746, 1606,
+	1751, 1713, 1609, 1610, 854, 1611, 1612, 1034, 824, 1712,
+	808, 1714, 1715, 1716, 1717, 1743, 855, 805, 804, 758,
+	2467, 2216, 1721, 1812, 1637, 1638, 1723, 2215, 2030, 2120,
+	1753, 1192, 1193, 1458, 1489, 1116, 95, 1789, 1729, 865,
+	866, 436, 1479, 1790, 1791, 1104, 843, 844, 841, 842,
+	839, 840, 1527, 1010, 1010, 1010, 1010, 1010, 1752, 1797,
+	1526, 2466, 1750, 1754, 2465, 1749, 1757, 1759, 2464, 1010,
+	1756, 1212, 1793, 1762, 1775, 1780, 1551, 1553, 2189, 1772,
+	1415, 1764, 1213, 1765, 1010, 2054, 1866, 1771, 78, 1783,
+	1784, 2426, 2425, 1787, 2373, 2308, 2220, 2132, 1908, 437,
+	1564, 1565, 1566, 1567, 1799, 1798, 83, 2219, 2123, 1654,
+	1589, 1890, 1731, 1732, 1733, 2558, 2557, 2557, 1801, 1803,
+	1807, 1898, 1899, 1900, 1854, 1586, 1554, 1147, 1891, 1862,
+	1813, 868, 792, 2558, 2239, 1923, 1186, 390, 391, 392,
+	392, 1185, 401, 575, 1865, 1621, 1873, 387, 1843, 1622,
+	1867, 85, 1844, 2280, 51, 2282, 19, 2281, 18, 2283,
+	20, 1845, 1846, 54, 1847, 1848, 80, 1895, 1849, 1889,
+	2284, 21, 2279, 15, 1, 1913, 2278, 14, 830, 1901,
+	1897, 1868, 1903, 1858, 1859, 2272, 10, 2291, 30, 1883,
+	1797, 2290, 29, 1395, 2289, 28, 1395, 2287, 25, 2286,
+	24, 2288, 26, 1918, 1893, 2374, 1919, 2277, 13, 2303,
+	1946, 1947, 2274, 12, 2273, 11, 2271, 9, 2305, 2200,
+	1802, 1894, 2098, 2083, 1896, 2082, 1810, 1907, 1735, 1915,
+	1917, 1742, 1909, 1744, 1745, 1734, 825, 1820, 1821, 1166,
+	1763, 1328, 1766, 1506, 1951, 2166, 2248, 2059, 1827, 1668,
+	1402, 1392, 1831, 577, 91, 1471, 774, 2108, 1930, 347,
+	1183, 1965, 1399, 1690, 2306, 1450, 1451, 827, 1689, 1686,
+	1922, 1676, 1701, 1945, 1974, 1976, 1413, 1688, 1687, 2301,
+	1691, 1047, 1045, 1046, 1965, 1928, 1929, 1967, 1955, 1044,
+	1049, 1048, 1932, 351, 451, 1028, 2363, 101, 55, 1935,
+	2112, 1779, 1959, 1500, 96, 102, 1958, 783, 353, 1808,
+	1809, 908, 1525, 1961, 1660, 1993, 561, 1815, 1816, 1960,
+	562, 1990, 1968, 554, 2024, 1983, 1981, 1957, 1966, 1823,
+	1824, 1825, 1826, 1956, 1828, 1829, 1830, 2129, 1999, 1972,
+	2410, 121, 859, 2317, 121, 1599, 1988, 969, 1316, 457,
+	121, 1639, 2319, 1204, 472, 471, 470, 467, 468, 1478,
+	1101, 1195, 1613, 881, 1942, 455, 446, 2522, 1356, 1004,
+	997, 401, 121, 1488, 1339, 1814, 1995, 1337, 1817, 1818,
+	1819, 1336, 1118, 1822, 121, 550, 1881, 1877, 121, 587,
+	1349, 1003, 121, 2052, 397, 68, 790, 1258, 367, 2038,
+	2025, 2055, 2056, 2057, 121, 1621, 587, 2009, 2048, 1622,
+	2234, 2031, 121, 36, 388, 2051, 441, 27, 78, 17,
+	797, 22, 2039, 2070, 16, 1498, 2021, 2022, 2023, 2058,
+	744, 1395, 888, 887, 898, 899, 891, 892, 893, 894,
+	895, 896, 897, 889, 890, 40, 43, 900, 42, 1730,
+	1460, 2362, 2506, 811, 2539, 2413, 32, 31, 2285, 2095,
+	2096, 2097, 1006, 1965, 2292, 2276, 2275, 1671, 2493, 23,
+	2492, 2105, 2106, 4, 1994, 834, 2071, 69, 33, 573,
+	2080, 2107, 2, 0, 0, 1965, 0, 0, 2073, 2001,
+	2075, 1482, 0, 0, 0, 2081, 2094, 0, 0, 0,
+	0, 2100, 0, 1866, 2101, 0, 2151, 1010, 2005, 0,
+	0, 2072, 0, 2121, 2099, 2116, 0, 0, 1890, 2014,
+	2015, 1797, 2125, 2126, 1915, 2020, 2117, 2124, 0, 0,
+	0, 2168, 2169, 2170, 0, 2173, 0, 1359, 0, 2160,
+	2109, 0, 2033, 2034, 2035, 0, 2154, 2036, 0, 0,
+	0, 401, 0, 401, 0, 0, 0, 0, 0, 2133,
+	1450, 1865, 2188, 1996, 2190, 0, 2153, 1913, 2150, 2050,
+	0, 1998, 2148, 0, 0, 0, 2157, 0, 0, 0,
+	1913, 2002, 2003, 0, 2161, 2162, 0, 0, 2004, 0,
+	0, 2217, 2135, 2136, 2137, 2138, 0, 0, 2187, 0,
+	2142, 2159, 0, 2179, 2145, 2146, 2180, 2181, 2174, 0,
+	0, 2229, 0, 0, 0, 0, 0, 0, 0, 0,
+	1866, 2191, 78, 0, 0, 0, 0, 2207, 2208, 2244,
+	0, 0, 0, 2210, 121, 0, 0, 2249, 0, 587,
+	587, 0, 2254, 2255, 0, 2222, 0, 0, 0, 2214,
+	0, 2221, 587, 0, 2183, 0, 2184, 0, 0, 0,
+	0, 78, 0, 0, 0, 2240, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1006, 2256, 0, 1865, 0,
+	121, 2245, 0, 2257, 2241, 0, 0, 0, 0, 121,
+	0, 0, 0, 512, 0, 0, 0, 2139, 2140, 2141,
+	2260, 2143, 0, 0, 0, 0, 0, 0, 2265, 1010,
+	1913, 0, 1913, 0, 0, 0, 0, 2344, 2345, 0,
+	0, 0, 0, 0, 2311, 2327, 0, 2325, 2310, 0,
+	2312, 2163, 2164, 2165, 0, 0, 0, 0, 0, 876,
+	2309, 0, 0, 0, 2251, 2252, 2253, 2358, 0, 0,
+	0, 0, 2326, 0, 2338, 2333, 0, 0, 2351, 0,
+	0, 0, 2346, 0, 0, 0, 0, 78, 0, 0,
+	1621, 0, 0, 0, 1622, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 570, 0, 0, 0, 583, 0,
+	0, 2313, 0, 2387, 2388, 958, 0, 0, 0, 0,
+	0, 0, 0, 0, 2223, 748, 0, 0, 0, 2354,
+	0, 2230, 2231, 2232, 2233, 2372, 0, 2390, 2370, 2367,
+	0, 2237, 2238, 0, 0, 0, 0, 0, 0, 0,
+	2350, 0, 0, 0, 0, 0, 2378, 0, 0, 0,
+	0, 121, 121, 121, 2392, 0, 0, 0, 0, 2399,
+	0, 0, 0, 0, 0, 2405, 0, 587, 2438, 2438,
+	2440, 0, 0, 0, 2428, 2257, 2430, 0, 0, 0,
+	2420, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1913, 0, 0, 2427, 0, 0, 78, 2431, 0,
+	2439, 2441, 2456, 78, 2434, 0, 0, 2463, 0, 2429,
+	0, 2445, 2453, 0, 0, 877, 2461, 0, 0, 956,
+	2334, 2438, 2455, 0, 0, 0, 0, 2339, 0, 2473,
+	2486, 0, 1010, 0, 0, 0, 0, 2347, 2348, 2349,
+	0, 78, 2352, 0, 0, 0, 78, 0, 2489, 0,
+	2470, 2500, 2481, 2483, 2497, 2468, 2496, 2517, 2503, 2438,
+	2495, 0, 2494, 0, 0, 0, 0, 0, 2529, 2515,
+	0, 2518, 0, 0, 2528, 0, 0, 0, 0, 78,
+	2521, 953, 78, 78, 2533, 0, 0, 78, 0, 2534,
+	0, 1592, 2536, 0, 401, 0, 0, 2545, 0, 0,
+	2382, 0, 0, 0, 0, 0, 2547, 0, 2505, 0,
+	0, 0, 0, 0, 0, 0, 78, 2552, 2555, 78,
+	2553, 0, 0, 0, 0, 2566, 2396, 2568, 0, 2563,
+	2470, 0, 2406, 0, 2542, 0, 2407, 2408, 0, 587,
+	78, 0, 78, 2580, 0, 0, 78, 0, 583, 583,
+	0, 121, 0, 2514, 121, 2589, 2585, 0, 0, 852,
+	121, 583, 587, 2470, 78, 0, 0, 78, 0, 587,
+	587, 587, 121, 121, 121, 78, 0, 2595, 2442, 121,
+	78, 0, 2444, 0, 587, 587, 0, 2470, 2451, 2470,
+	0, 2454, 0, 0, 0, 0, 0, 121, 888, 887,
+	898, 899, 891, 892, 893, 894, 895, 896, 897, 889,
+	890, 2470, 0, 900, 0, 0, 0, 0, 0, 0,
+	0, 2477, 2470, 0, 2479, 2480, 0, 2470, 0, 0,
+	0, 2569, 1069, 0, 0, 0, 0, 2488, 0, 0,
+	2490, 1417, 1418, 0, 1423, 1424, 1425, 1426, 1427, 0,
+	121, 587, 0, 121, 0, 587, 0, 0, 0, 0,
+	0, 0, 1437, 1438, 1439, 0, 0, 2519, 0, 0,
+	0, 0, 0, 121, 0, 0, 1248, 1253, 1254, 876,
+	2421, 0, 0, 0, 1272, 1273, 1274, 0, 1276, 0,
+	0, 1279, 0, 0, 0, 0, 1284, 1285, 1286, 1287,
+	0, 1289, 1290, 1291, 0, 0, 0, 0, 0, 1297,
+	1298, 1299, 0, 0, 2551, 1305, 1308, 0, 1313, 1314,
+	0, 0, 0, 0, 1320, 1321, 2053, 587, 0, 0,
+	0, 0, 0, 2571, 0, 587, 1056, 0, 2574, 0,
+	852, 0, 0, 0, 0, 1326, 0, 1329, 1330, 0,
+	0, 0, 0, 0, 0, 2587, 2588, 0, 0, 0,
+	570, 0, 0, 0, 0, 570, 1029, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1070, 888,
+	887, 898, 899, 891, 892, 893, 894, 895, 896, 897,
+	889, 890, 0, 0, 900, 0, 587, 587, 0, 0,
+	0, 0, 0, 121, 0, 0, 0, 0, 0, 0,
+	0, 121, 0, 0, 121, 0, 0, 1852, 121, 121,
+	0, 0, 121, 121, 121, 888, 887, 898, 899, 891,
+	892, 893, 894, 895, 896, 897, 889, 890, 2398, 0,
+	900, 0, 0, 587, 587, 0, 0, 0, 0, 1069,
+	0, 1083, 1086, 1087, 1088, 1089, 1090, 1091, 0, 1092,
+	1093, 1094, 1095, 1096, 1097, 1098, 0, 1071, 1072, 1073,
+	1074, 1050, 1054, 1084, 1051, 1057, 1053, 1055, 1052, 0,
+	1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067,
+	1068, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	121, 587, 0, 587, 0, 0, 121, 0, 121, 121,
+	0, 0, 121, 0, 888, 887, 898, 899, 891, 892,
+	893, 894, 895, 896, 897, 889, 890, 0, 1103, 900,
+	0, 0, 0, 0, 0, 0, 0, 0, 1251, 0,
+	121, 121, 121, 1056, 0, 0, 0, 0, 0, 0,
+	0, 583, 0, 0, 0, 0, 0, 0, 583, 583,
+	583, 0, 0, 121, 0, 121, 2029, 0, 0, 0,
+	0, 0, 0, 583, 583, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1070, 0, 0, 0, 1544,
+	0, 0, 0, 0, 0, 1085, 0, 1550, 0, 0,
+	1706, 1707, 1708, 1710, 0, 0, 888, 887, 898, 899,
+	891, 892, 893, 894, 895, 896, 897, 889, 890, 1561,
+	1562, 900, 0, 0, 0, 444, 0, 0, 0, 0,
+	1572, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	583, 0, 0, 0, 583, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1598, 1083, 1086,
+	1087, 1088, 1089, 1090, 1091, 0, 1092, 1093, 1094, 1095,
+	1096, 1097, 1098, 583, 1071, 1072, 1073, 1074, 1050, 1054,
+	1084, 1051, 1057, 1053, 1055, 1052, 0, 1058, 1059, 1060,
+	1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1075, 1076,
+	1077, 1078, 1079, 1080, 1081, 1082, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1246, 0, 0, 0,
+	95, 0, 0, 0, 1259, 0, 0, 0, 0, 0,
+	121, 121, 121, 121, 121, 0, 2019, 0, 0, 0,
+	0, 0, 121, 0, 0, 0, 121, 0, 0, 2028,
+	0, 121, 0, 121, 0, 0, 0, 0, 0, 0,
+	34, 121, 70, 37, 38, 570, 0, 0, 0, 0,
+	1362, 1364, 0, 0, 61, 0, 0, 0, 0, 0,
+	76, 0, 0, 0, 39, 1324, 1325, 0, 587, 888,
+	887, 898, 899, 891, 892, 893, 894, 895, 896, 897,
+	889, 890, 0, 0, 900, 0, 0, 0, 0, 0,
+	0, 0, 1085, 0, 570, 888, 887, 898, 899, 891,
+	892, 893, 894, 895, 896, 897, 889, 890, 0, 583,
+	900, 79, 583, 583, 0, 888, 887, 898, 899, 891,
+	892, 893, 894, 895, 896, 897, 889, 890, 0, 587,
+	900, 0, 0, 1363, 0, 2293, 0, 0, 2538, 2541,
+	2537, 0, 587, 121, 587, 587, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 121, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1931, 0, 0, 0, 0, 41, 72, 45, 44, 47,
+	583, 0, 583, 0, 0, 0, 0, 0, 0, 0,
+	0, 2294, 0, 0, 587, 587, 0, 0, 0, 0,
+	121, 0, 0, 0, 0, 0, 0, 48, 75, 74,
+	587, 883, 1851, 886, 46, 0, 0, 0, 0, 0,
+	901, 902, 903, 904, 905, 906, 907, 587, 884, 885,
+	882, 888, 887, 898, 899, 891, 892, 893, 894, 895,
+	896, 897, 889, 890, 0, 0, 900, 0, 0, 1069,
+	0, 0, 0, 0, 0, 0, 861, 0, 0, 0,
+	0, 862, 59, 60, 0, 2295, 880, 0, 583, 0,
+	0, 0, 0, 0, 0, 2296, 73, 0, 52, 53,
+	63, 0, 64, 2018, 0, 0, 0, 0, 0, 0,
+	0, 0, 587, 587, 2006, 2007, 0, 2008, 0, 0,
+	2010, 0, 2012, 0, 0, 0, 0, 0, 119, 0,
+	1850, 364, 0, 0, 0, 0, 121, 119, 587, 888,
+	887, 898, 899, 891, 892, 893, 894, 895, 896, 897,
+	889, 890, 0, 955, 900, 0, 0, 0, 0, 400,
+	0, 587, 0, 0, 587, 0, 970, 587, 445, 587,
+	0, 553, 571, 1056, 0, 119, 0, 0, 0, 119,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 71, 2067, 2068, 0, 0, 0, 0, 119,
+	0, 0, 888, 887, 898, 899, 891, 892, 893, 894,
+	895, 896, 897, 889, 890, 1070, 0, 900, 0, 0,
+	0, 121, 0, 0, 0, 0, 0, 0, 570, 0,
+	0, 0, 0, 0, 0, 0, 121, 888, 887, 898,
+	899, 891, 892, 893, 894, 895, 896, 897, 889, 890,
+	121, 0, 900, 0, 0, 0, 0, 0, 0, 77,
+	0, 0, 0, 0, 0, 0, 0, 2017, 570, 0,
+	587, 0, 0, 0, 121, 587, 0, 0, 0, 0,
+	0, 0, 587, 587, 0, 0, 0, 583, 1083, 1086,
+	1087, 1088, 1089, 1090, 1091, 0, 1092, 1093, 1094, 1095,
+	1096, 1097, 1098, 0, 1071, 1072, 1073, 1074, 1050, 1054,
+	1084, 1051, 1057, 1053, 1055, 1052, 0, 1058, 1059, 1060,
+	1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1075, 1076,
+	1077, 1078, 1079, 1080, 1081, 1082, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1727, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 583, 0, 583, 583, 121, 888, 887, 898, 899,
+	891, 892, 893, 894, 895, 896, 897, 889, 890, 0,
+	0, 900, 587, 0, 0, 34, 35, 70, 37, 38,
+	0, 0, 0, 587, 587, 587, 0, 0, 0, 61,
+	1130, 0, 587, 0, 0, 76, 0, 0, 0, 39,
+	65, 66, 0, 583, 583, 587, 62, 0, 0, 0,
+	0, 119, 0, 0, 0, 0, 0, 0, 1150, 583,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 583, 1085, 121, 0, 0, 1811, 0, 0, 0,
+	0, 0, 49, 0, 0, 0, 79, 0, 0, 0,
+	0, 0, 0, 0, 0, 955, 0, 119, 0, 0,
+	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
+	0, 0, 0, 0, 587, 0, 121, 0, 0, 0,
+	0, 0, 587, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 570, 1202,
+	1203, 583, 1872, 0, 0, 0, 0, 0, 0, 0,
+	41, 72, 45, 44, 47, 0, 58, 0, 0, 0,
+	0, 0, 587, 0, 0, 0, 0, 1872, 587, 0,
+	0, 0, 0, 570, 0, 121, 0, 121, 0, 1243,
+	0, 0, 48, 75, 74, 0, 0, 56, 57, 46,
+	583, 587, 0, 583, 0, 0, 583, 0, 1912, 0,
+	0, 0, 0, 0, 587, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 34, 0, 70, 37, 38,
+	0, 0, 0, 0, 0, 955, 0, 0, 0, 61,
+	1301, 1302, 0, 0, 0, 76, 0, 59, 60, 39,
+	587, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	50, 73, 0, 52, 53, 63, 0, 64, 119, 119,
+	119, 0, 0, 0, 0, 0, 0, 0, 571, 0,
+	0, 0, 0, 571, 0, 0, 0, 0, 0, 0,
+	0, 587, 0, 0, 0, 0, 79, 0, 0, 0,
+	0, 0, 0, 0, 0, 1800, 0, 0, 587, 1977,
+	0, 1384, 1387, 0, 1982, 0, 0, 0, 0, 0,
+	2293, 1986, 1987, 0, 0, 2598, 888, 887, 898, 899,
+	891, 892, 893, 894, 895, 896, 897, 889, 890, 121,
+	0, 900, 0, 0, 587, 0, 587, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 71, 0, 0,
+	41, 72, 45, 44, 47, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2294, 0, 0, 587,
+	0, 1452, 0, 0, 0, 0, 0, 0, 0, 0,
+	587, 0, 48, 75, 74, 0, 0, 121, 2016, 46,
+	0, 0, 0, 0, 0, 0, 0, 0, 570, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2046, 0, 0, 77, 0, 0, 0, 0, 0,
+	587, 0, 2046, 2046, 2046, 0, 0, 0, 0, 0,
+	0, 583, 0, 0, 0, 0, 0, 59, 60, 0,
+	2295, 587, 0, 0, 2046, 0, 0, 0, 0, 0,
+	2296, 73, 0, 52, 53, 63, 0, 64, 119, 861,
+	0, 119, 0, 0, 0, 0, 0, 1125, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	119, 119, 0, 0, 0, 0, 119, 888, 887, 898,
+	899, 891, 892, 893, 894, 895, 896, 897, 889, 890,
+	0, 0, 900, 0, 119, 587, 0, 0, 0, 0,
+	0, 0, 0, 583, 0, 0, 0, 0, 0, 0,
+	0, 583, 587, 0, 0, 0, 0, 0, 0, 876,
+	0, 0, 0, 0, 587, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 587, 71, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
+	400, 2152, 0, 1577, 0, 0, 0, 2046, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 570, 570, 0,
+	119, 0, 0, 0, 0, 0, 0, 1602, 0, 0,
+	1912, 1125, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1912, 0, 0, 0, 0, 121, 0,
+	0, 587, 0, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 1642, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1252, 1252, 1252, 2213,
+	0, 0, 0, 1252, 1252, 1252, 1252, 1252, 1252, 0,
+	0, 1252, 0, 0, 587, 0, 1252, 1252, 1252, 1252,
+	0, 1252, 1252, 1252, 0, 0, 0, 0, 0, 1252,
+	1252, 1252, 0, 0, 0, 1252, 1252, 0, 1252, 1252,
+	2243, 0, 0, 571, 1252, 1252, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2046, 0, 0,
+	0, 0, 0, 0, 0, 1252, 1252, 1252, 1252, 0,
+	119, 0, 0, 0, 0, 0, 0, 0, 119, 0,
+	0, 400, 0, 0, 0, 119, 119, 0, 1542, 119,
+	1371, 1125, 571, 1912, 0, 1912, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1125, 0, 888,
+	887, 898, 899, 891, 892, 893, 894, 895, 896, 897,
+	889, 890, 0, 570, 900, 0, 0, 0, 2342, 0,
+	0, 0, 0, 34, 0, 70, 37, 38, 0, 2046,
+	0, 0, 0, 0, 0, 0, 0, 61, 0, 0,
+	0, 0, 0, 76, 0, 0, 0, 39, 888, 887,
+	898, 899, 891, 892, 893, 894, 895, 896, 897, 889,
+	890, 0, 0, 900, 0, 0, 0, 119, 0, 583,
+	0, 0, 0, 119, 0, 119, 119, 0, 0, 119,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2384, 0, 0, 0, 79, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1475, 1476, 119,
+	0, 0, 0, 0, 0, 0, 0, 0, 2293, 0,
+	1856, 0, 0, 2593, 0, 0, 0, 0, 0, 0,
+	119, 0, 400, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1912, 0, 1125, 0, 41, 72,
+	45, 44, 47, 0, 0, 0, 0, 0, 0, 0,
+	0, 2342, 0, 1902, 2294, 0, 0, 0, 0, 0,
+	0, 0, 0, 2046, 0, 0, 0, 0, 0, 0,
+	48, 75, 74, 0, 0, 583, 0, 46, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1252,
+	0, 0, 0, 0, 0, 0, 0, 1252, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1252,
+	1252, 0, 0, 0, 0, 59, 60, 0, 2295, 0,
+	1252, 0, 0, 0, 1252, 0, 0, 0, 2296, 73,
+	2342, 52, 53, 63, 0, 64, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1252, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 34, 0,
+	70, 37, 38, 0, 0, 0, 571, 119, 119, 119,
+	119, 119, 61, 2342, 1985, 0, 0, 0, 76, 400,
+	0, 0, 39, 119, 444, 0, 0, 0, 400, 0,
+	400, 0, 0, 0, 0, 0, 0, 0, 119, 0,
+	0, 0, 0, 0, 0, 0, 571, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 71, 0, 0, 0, 79,
+	0, 0, 0, 0, 0, 0, 34, 0, 70, 37,
+	38, 0, 0, 0, 0, 0, 2027, 0, 0, 0,
+	61, 0, 0, 2293, 0, 955, 76, 0, 2581, 0,
+	39, 0, 0, 0, 2040, 2041, 0, 0, 2042, 0,
+	0, 2044, 0, 0, 0, 0, 0, 0, 0, 955,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 77, 41, 72, 45, 44, 47, 0, 0,
+	119, 0, 0, 0, 0, 0, 0, 79, 0, 2294,
+	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 48, 75, 74, 0, 0,
+	0, 2293, 46, 0, 0, 0, 2560, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1252, 0,
+	0, 41, 72, 45, 44, 47, 0, 1252, 0, 1125,
+	59, 60, 0, 2295, 0, 0, 0, 2294, 0, 0,
+	0, 0, 0, 2296, 73, 0, 52, 53, 63, 0,
+	64, 0, 0, 48, 75, 74, 0, 0, 0, 0,
+	46, 34, 0, 70, 37, 38, 0, 0, 0, 0,
+	0, 0, 430, 0, 0, 61, 0, 0, 0, 0,
+	0, 76, 0, 0, 0, 39, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 571, 0, 0, 0,
+	0, 1675, 0, 0, 0, 0, 0, 0, 59, 60,
+	0, 2295, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2296, 73, 400, 52, 53, 63, 0, 64, 0,
+	0, 571, 79, 2543, 0, 0, 0, 0, 0, 0,
+	71, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2293, 0, 0, 0,
+	0, 0, 0, 0, 444, 0, 0, 0, 0, 0,
+	0, 955, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1042, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 41, 72, 45, 44,
+	47, 0, 0, 0, 0, 0, 0, 77, 119, 0,
+	0, 0, 2294, 0, 0, 0, 0, 424, 71, 0,
+	0, 0, 0, 119, 0, 0, 0, 0, 48, 75,
+	74, 0, 0, 0, 0, 46, 0, 119, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2318, 2321,
+	0, 119, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 0, 59, 60, 77, 2295, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2296, 73, 0, 52,
+	53, 63, 0, 64, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2359, 2360, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 400, 0, 0, 0, 571, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 410, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2404, 2404, 0, 0, 0, 0, 0,
+	0, 0, 0, 71, 403, 404, 405, 406, 407, 412,
+	413, 417, 418, 427, 426, 425, 428, 429, 432, 431,
+	433, 408, 409, 411, 414, 415, 416, 419, 420, 423,
+	421, 422, 0, 0, 0, 2321, 2437, 2437, 0, 0,
+	119, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2462, 0, 0, 0, 0, 0,
+	77, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 0, 0, 0, 955, 0, 2437,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2404, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2437, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 400, 0, 400, 571, 571, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 955, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2567, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 714, 627, 647, 694, 305, 646, 718, 616, 635,
+	730, 636, 639, 679, 601, 659, 239, 633, 602, 0,
+	620, 592, 628, 593, 617, 649, 168, 615, 696, 663,
+	717, 201, 675, 0, 158, 210, 207, 0, 0, 0,
+	245, 304, 715, 655, 0, 724, 204, 0, 672, 725,
+	295, 224, 0, 0, 683, 716, 656, 654, 233, 246,
+	250, 170, 174, 651, 703, 657, 690, 645, 681, 608,
+	671, 719, 634, 677, 720, 0, 119, 0, 2361, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 674,
+	713, 631, 676, 0, 678, 590, 673, 0, 596, 603,
+	729, 709, 623, 624, 625, 0, 0, 0, 0, 0,
+	0, 571, 650, 658, 687, 642, 0, 0, 0, 0,
+	0, 0, 0, 0, 621, 0, 669, 0, 0, 0,
+	0, 604, 597, 0, 119, 648, 0, 0, 0, 607,
+	126, 622, 688, 0, 588, 180, 225, 137, 693, 708,
+	644, 194, 332, 712, 641, 640, 259, 0, 300, 183,
+	202, 141, 123, 135, 151, 182, 235, 268, 278, 632,
+	589, 697, 618, 629, 159, 626, 271, 243, 322, 0,
+	166, 666, 0, 270, 205, 311, 261, 320, 321, 184,
+	249, 660, 190, 209, 733, 329, 334, 291, 169, 0,
+	127, 0, 256, 163, 198, 643, 680, 619, 155, 685,
+	670, 702, 290, 309, 142, 306, 223, 229, 152, 154,
+	153, 136, 285, 308, 146, 157, 296, 274, 301, 162,
+	0, 0, 2364, 2365, 2366, 0, 0, 0, 0, 128,
+	303, 319, 148, 282, 283, 335, 269, 130, 317, 299,
+	221, 195, 196, 129, 0, 266, 167, 179, 161, 238,
+	0, 178, 258, 314, 315, 160, 337, 138, 328, 132,
+	139, 327, 232, 0, 231, 330, 310, 318, 222, 214,
+	0, 131, 316, 220, 213, 200, 173, 186, 254, 208,
+	255, 187, 227, 226, 228, 211, 215, 0, 594, 0,
+	297, 325, 338, 144, 614, 284, 307, 0, 0, 145,
+	177, 172, 253, 230, 140, 189, 293, 199, 206, 265,
+	336, 242, 272, 149, 324, 292, 612, 613, 610, 0,
+	611, 661, 662, 721, 722, 723, 689, 605, 0, 704,
+	705, 0, 0, 0, 0, 0, 695, 710, 711, 630,
+	0, 0, 0, 0, 0, 400, 0, 0, 0, 0,
+	0, 682, 731, 637, 638, 691, 609, 294, 692, 684,
+	156, 595, 598, 599, 600, 606, 652, 653, 665, 668,
+	700, 699, 698, 701, 706, 727, 726, 728, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 664,
+	122, 133, 203, 732, 263, 176, 326, 591, 165, 667,
+	686, 124, 125, 134, 143, 150, 164, 171, 175, 181,
+	185, 188, 191, 192, 193, 197, 212, 216, 217, 218,
+	219, 234, 236, 237, 240, 241, 244, 247, 248, 251,
+	252, 257, 260, 262, 264, 267, 273, 275, 276, 277,
+	279, 280, 281, 286, 287, 288, 289, 298, 302, 312,
+	313, 323, 331, 333, 707, 714, 627, 647, 694, 305,
+	646, 718, 616, 635, 730, 636, 639, 679, 601, 659,
+	239, 633, 602, 0, 620, 592, 628, 593, 617, 649,
+	168, 615, 696, 663, 717, 201, 675, 0, 158, 210,
+	207, 0, 0, 0, 245, 304, 715, 655, 0, 724,
+	204, 0, 672, 725, 295, 224, 0, 0, 683, 716,
+	656, 654, 233, 246, 250, 170, 174, 651, 703, 657,
+	690, 645, 681, 608, 671, 719, 634, 677, 720, 0,
+	0, 0, 586, 0, 1396, 1397, 0, 0, 0, 0,
+	0, 147, 0, 674, 713, 631, 676, 0, 678, 590,
+	673, 0, 596, 603, 729, 709, 623, 624, 625, 1672,
+	0, 0, 0, 0, 0, 0, 650, 658, 687, 642,
+	0, 0, 0, 0, 0, 0, 0, 0, 621, 0,
+	669, 0, 0, 0, 0, 604, 597, 0, 0, 648,
+	0, 0, 0, 607, 126, 622, 688, 0, 588, 180,
+	225, 137, 693, 708, 644, 194, 332, 712, 641, 640,
+	259, 0, 300, 183, 202, 141, 123, 135, 151, 182,
+	235, 268, 278, 632, 589, 697, 618, 629, 159, 626,
+	271, 243, 322, 0, 166, 666, 0, 270, 205, 311,
+	261, 320, 321, 184, 249, 660, 190, 209, 733, 329,
+	334, 291, 169, 0, 127, 0, 256, 163, 198, 643,
+	680, 619, 155, 685, 670, 702, 290, 309, 142, 306,
+	223, 229, 152, 154, 153, 136, 285, 308, 146, 157,
+	296, 274, 301, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 303, 319, 148, 282, 283, 335,
+	269, 130, 317, 299, 221, 195, 196, 129, 0, 266,
+	167, 179, 161, 238, 0, 178, 258, 314, 315, 160,
+	337, 138, 328, 132, 139, 327, 232, 0, 231, 330,
+	310, 318, 222, 214, 0, 131, 316, 220, 213, 200,
+	173, 186, 254, 208, 255, 187, 227, 226, 228, 211,
+	215, 0, 594, 0, 297, 325, 338, 144, 614, 284,
+	307, 0, 0, 145, 177, 172, 253, 230, 140, 189,
+	293, 199, 206, 265, 336, 242, 272, 149, 324, 292,
+	612, 613, 610, 0, 611, 661, 662, 721, 722, 723,
+	689, 605, 0, 704, 705, 0, 0, 0, 0, 0,
+	695, 710, 711, 630, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 682, 731, 637, 638, 691,
+	609, 294, 692, 684, 156, 595, 598, 599, 600, 606,
+	652, 653, 665, 668, 700, 699, 698, 701, 706, 727,
+	726, 728, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 664, 122, 133, 203, 732, 263, 176,
+	326, 591, 165, 667, 686, 124, 125, 134, 143, 150,
+	164, 171, 175, 181, 185, 188, 191, 192, 193, 197,
+	212, 216, 217, 218, 219, 234, 236, 237, 240, 241,
+	244, 247, 248, 251, 252, 257, 260, 262, 264, 267,
+	273, 275, 276, 277, 279, 280, 281, 286, 287, 288,
+	289, 298, 302, 312, 313, 323, 331, 333, 707, 714,
+	627, 647, 694, 305, 646, 718, 616, 635, 730, 636,
+	639, 679, 601, 659, 239, 633, 602, 0, 620, 592,
+	628, 593, 617, 649, 168, 615, 696, 663, 717, 201,
+	675, 0, 158, 210, 207, 0, 0, 0, 245, 304,
+	715, 655, 0, 724, 204, 0, 672, 725, 295, 224,
+	0, 0, 683, 716, 656, 654, 233, 246, 250, 170,
+	174, 651, 703, 657, 690, 645, 681, 608, 671, 719,
+	634, 677, 720, 0, 0, 0, 586, 0, 1396, 1397,
+	0, 0, 0, 0, 0, 147, 0, 674, 713, 631,
+	676, 0, 678, 590, 673, 0, 596, 603, 729, 709,
+	623, 624, 625, 0, 0, 0, 0, 0, 0, 0,
+	650, 658, 687, 642, 0, 0, 0, 0, 0, 0,
+	0, 0, 621, 0, 669, 0, 0, 0, 0, 604,
+	597, 0, 0, 648, 0, 0, 0, 607, 126, 622,
+	688, 0, 588, 180, 225, 137, 693, 708, 644, 194,
+	332, 712, 641, 640, 259, 0, 300, 183, 202, 141,
+	123, 135, 151, 182, 235, 268, 278, 632, 589, 697,
+	618, 629, 159, 626, 271, 243, 322, 0, 166, 666,
+	0, 270, 205, 311, 261, 320, 321, 184, 249, 660,
+	190, 209, 733, 329, 334, 291, 169, 0, 127, 0,
+	256, 163, 198, 643, 680, 619, 155, 685, 670, 702,
+	290, 309, 142, 306, 223, 229, 152, 154, 153, 136,
+	285, 308, 146, 157, 296, 274, 301, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 303, 319,
+	148, 282, 283, 335, 269, 130, 317, 299, 221, 195,
+	196, 129, 0, 266, 167, 179, 161, 238, 0, 178,
+	258, 314, 315, 160, 337, 138, 328, 132, 139, 327,
+	232, 0, 231, 330, 310, 318, 222, 214, 0, 131,
+	316, 220, 213, 200, 173, 186, 254, 208, 255, 187,
+	227, 226, 228, 211, 215, 0, 594, 0, 297, 325,
+	338, 144, 614, 284, 307, 0, 0, 145, 177, 172,
+	253, 230, 140, 189, 293, 199, 206, 265, 336, 242,
+	272, 149, 324, 292, 612, 613, 610, 0, 611, 661,
+	662, 721, 722, 723, 689, 605, 0, 704, 705, 0,
+	0, 0, 0, 0, 695, 710, 711, 630, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 682,
+	731, 637, 638, 691, 609, 294, 692, 684, 156, 595,
+	598, 599, 600, 606, 652, 653, 665, 668, 700, 699,
+	698, 701, 706, 727, 726, 728, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 664, 122, 133,
+	203, 732, 263, 176, 326, 591, 165, 667, 686, 124,
+	125, 134, 143, 150, 164, 171, 175, 181, 185, 188,
+	191, 192, 193, 197, 212, 216, 217, 218, 219, 234,
+	236, 237, 240, 241, 244, 247, 248, 251, 252, 257,
+	260, 262, 264, 267, 273, 275, 276, 277, 279, 280,
+	281, 286, 287, 288, 289, 298, 302, 312, 313, 323,
+	331, 333, 707, 714, 627, 647, 694, 305, 646, 718,
+	616, 635, 730, 636, 639, 679, 601, 659, 239, 633,
+	602, 0, 620, 592, 628, 593, 617, 649, 168, 615,
+	696, 663, 717, 201, 675, 0, 158, 210, 207, 0,
+	0, 0, 245, 304, 715, 655, 0, 724, 204, 0,
+	672, 725, 295, 224, 0, 0, 683, 716, 656, 654,
+	233, 246, 250, 170, 174, 651, 703, 657, 690, 645,
+	681, 608, 671, 719, 634, 677, 720, 0, 0, 0,
+	586, 0, 0, 0, 0, 0, 0, 0, 0, 147,
+	0, 674, 713, 631, 676, 0, 678, 590, 673, 0,
+	596, 603, 729, 709, 623, 624, 625, 0, 0, 0,
+	0, 0, 0, 0, 650, 658, 687, 642, 0, 0,
+	0, 0, 0, 0, 2127, 0, 621, 0, 669, 0,
+	0, 0, 0, 604, 597, 0, 0, 648, 0, 0,
+	0, 607, 126, 622, 688, 0, 588, 180, 225, 137,
+	693, 708, 644, 194, 332, 712, 641, 640, 259, 0,
+	300, 183, 202, 141, 123, 135, 151, 182, 235, 268,
+	278, 632, 589, 697, 618, 629, 159, 626, 271, 243,
+	322, 0, 166, 666, 0, 270, 205, 311, 261, 320,
+	321, 184, 249, 660, 190, 209, 733, 329, 334, 291,
+	169, 0, 127, 0, 256, 163, 198, 643, 680, 619,
+	155, 685, 670, 702, 290, 309, 142, 306, 223, 229,
+	152, 154, 153, 136, 285, 308, 146, 157, 296, 274,
+	301, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 303, 319, 148, 282, 283, 335, 269, 130,
+	317, 299, 221, 195, 196, 129, 0, 266, 167, 179,
+	161, 238, 0, 178, 258, 314, 315, 160, 337, 138,
+	328, 132, 139, 327, 232, 0, 231, 330, 310, 318,
+	222, 214, 0, 131, 316, 220, 213, 200, 173, 186,
+	254, 208, 255, 187, 227, 226, 228, 211, 215, 0,
+	594, 0, 297, 325, 338, 144, 614, 284, 307, 0,
+	0, 145, 177, 172, 253, 230, 140, 189, 293, 199,
+	206, 265, 336, 242, 272, 149, 324, 292, 612, 613,
+	610, 0, 611, 661, 662, 721, 722, 723, 689, 605,
+	0, 704, 705, 0, 0, 0, 0, 0, 695, 710,
+	711, 630, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 682, 731, 637, 638, 691, 609, 294,
+	692, 684, 156, 595, 598, 599, 600, 606, 652, 653,
+	665, 668, 700, 699, 698, 701, 706, 727, 726, 728,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 664, 122, 133, 203, 732, 263, 176, 326, 591,
+	165, 667, 686, 124, 125, 134, 143, 150, 164, 171,
+	175, 181, 185, 188, 191, 192, 193, 197, 212, 216,
+	217, 218, 219, 234, 236, 237, 240, 241, 244, 247,
+	248, 251, 252, 257, 260, 262, 264, 267, 273, 275,
+	276, 277, 279, 280, 281, 286, 287, 288, 289, 298,
+	302, 312, 313, 323, 331, 333, 707, 714, 627, 647,
+	1869, 305, 646, 718, 616, 635, 730, 636, 639, 679,
+	601, 659, 239, 633, 602, 0, 620, 592, 628, 593,
+	617, 649, 168, 615, 696, 663, 717, 201, 675, 0,
+	158, 210, 207, 0, 0, 0, 245, 304, 715, 655,
+	0, 724, 204, 0, 672, 725, 295, 224, 0, 0,
+	683, 716, 656, 654, 233, 246, 250, 170, 174, 651,
+	703, 657, 690, 645, 681, 608, 671, 719, 634, 677,
+	720, 79, 0, 0, 586, 0, 0, 0, 0, 0,
+	0, 0, 0, 147, 0, 674, 713, 631, 676, 0,
+	678, 590, 673, 0, 596, 603, 729, 709, 623, 624,
+	625, 0, 0, 0, 0, 0, 0, 0, 650, 658,
+	687, 642, 0, 0, 0, 0, 0, 0, 0, 0,
+	621, 0, 669, 0, 0, 0, 0, 604, 597, 0,
+	0, 648, 0, 0, 0, 607, 126, 622, 688, 0,
+	588, 180, 225, 137, 693, 708, 644, 194, 332, 712,
+	641, 640, 259, 0, 300, 183, 202, 141, 123, 135,
+	151, 182, 235, 268, 278, 632, 589, 697, 618, 629,
+	159, 626, 271, 243, 322, 0, 166, 666, 0, 270,
+	205, 311, 261, 320, 321, 184, 249, 660, 190, 209,
+	733, 329, 334, 291, 169, 0, 127, 0, 256, 163,
+	198, 643, 680, 619, 155, 685, 670, 702, 290, 309,
+	142, 306, 223, 229, 152, 154, 153, 136, 285, 308,
+	146, 157, 296, 274, 301, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 303, 319, 148, 282,
+	283, 335, 269, 130, 317, 299, 221, 195, 196, 129,
+	0, 266, 167, 179, 161, 238, 0, 178, 258, 314,
+	315, 160, 337, 138, 328, 132, 139, 327, 232, 0,
+	231, 330, 310, 318, 222, 214, 0, 131, 316, 220,
+	213, 200, 173, 186, 254, 208, 255, 187, 227, 226,
+	228, 211, 215, 0, 594, 0, 297, 325, 338, 144,
+	614, 284, 307, 0, 0, 145, 177, 172, 253, 230,
+	140, 189, 293, 199, 206, 265, 336, 242, 272, 149,
+	324, 292, 612, 613, 610, 0, 611, 661, 662, 721,
+	722, 723, 689, 605, 0, 704, 705, 0, 0, 0,
+	0, 0, 695, 710, 711, 630, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 682, 731, 637,
+	638, 691, 609, 294, 692, 684, 156, 595, 598, 599,
+	600, 606, 652, 653, 665, 668, 700, 699, 698, 701,
+	706, 727, 726, 728, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 664, 122, 133, 203, 1870,
+	263, 176, 326, 591, 165, 667, 686, 124, 125, 134,
+	143, 150, 164, 171, 175, 181, 185, 188, 191, 192,
+	193, 197, 212, 216, 217, 218, 219, 234, 236, 237,
+	240, 241, 244, 247, 248, 251, 252, 257, 260, 262,
+	264, 267, 273, 275, 276, 277, 279, 280, 281, 286,
+	287, 288, 289, 298, 302, 312, 313, 323, 331, 333,
+	707, 714, 627, 647, 694, 305, 646, 718, 616, 635,
+	730, 636, 639, 679, 601, 659, 239, 633, 602, 0,
+	620, 592, 628, 593, 617, 649, 168, 615, 696, 663,
+	717, 201, 675, 0, 158, 210, 207, 0, 0, 0,
+	245, 304, 715, 655, 0, 724, 204, 0, 672, 725,
+	295, 224, 0, 0, 683, 716, 656, 654, 233, 246,
+	250, 170, 174, 651, 703, 657, 690, 645, 681, 608,
+	671, 719, 634, 677, 720, 0, 0, 0, 572, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 674,
+	713, 631, 676, 0, 678, 590, 673, 0, 596, 603,
+	729, 709, 623, 624, 625, 0, 0, 0, 0, 0,
+	0, 0, 650, 658, 687, 642, 0, 0, 0, 0,
+	0, 0, 1806, 0, 621, 0, 669, 0, 0, 0,
+	0, 604, 597, 0, 0, 648, 0, 0, 0, 607,
+	126, 622, 688, 0, 588, 180, 225, 137, 693, 708,
+	644, 194, 332, 712, 641, 640, 259, 0, 300, 183,
+	202, 141, 123, 135, 151, 182, 235, 268, 278, 632,
+	589, 697, 618, 629, 159, 626, 271, 243, 322, 0,
+	166, 666, 0, 270, 205, 311, 261, 320, 321, 184,
+	249, 660, 190, 209, 733, 329, 334, 291, 169, 0,
+	127, 0, 256, 163, 198, 643, 680, 619, 155, 685,
+	670, 702, 290, 309, 142, 306, 223, 229, 152, 154,
+	153, 136, 285, 308, 146, 157, 296, 274, 301, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	303, 319, 148, 282, 283, 335, 269, 130, 317, 299,
+	221, 195, 196, 129, 0, 266, 167, 179, 161, 238,
+	0, 178, 258, 314, 315, 160, 337, 138, 328, 132,
+	139, 327, 232, 0, 231, 330, 310, 318, 222, 214,
+	0, 131, 316, 220, 213, 200, 173, 186, 254, 208,
+	255, 187, 227, 226, 228, 211, 215, 0, 594, 0,
+	297, 325, 338, 144, 614, 284, 307, 0, 0, 145,
+	177, 172, 253, 230, 140, 189, 293, 199, 206, 265,
+	336, 242, 272, 149, 324, 292, 612, 613, 610, 0,
+	611, 661, 662, 721, 722, 723, 689, 605, 0, 704,
+	705, 0, 0, 0, 0, 0, 695, 710, 711, 630,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 682, 731, 637, 638, 691, 609, 294, 692, 684,
+	156, 595, 598, 599, 600, 606, 652, 653, 665, 668,
+	700, 699, 698, 701, 706, 727, 726, 728, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 664,
+	122, 133, 203, 732, 263, 176, 326, 591, 165, 667,
+	686, 124, 125, 134, 143, 150, 164, 171, 175, 181,
+	185, 188, 191, 192, 193, 197, 212, 216, 217, 218,
+	219, 234, 236, 237, 240, 241, 244, 247, 248, 251,
+	252, 257, 260, 262, 264, 267, 273, 275, 276, 277,
+	279, 280, 281, 286, 287, 288, 289, 298, 302, 312,
+	313, 323, 331, 333, 707, 714, 627, 647, 694, 305,
+	646, 718, 616, 635, 730, 636, 639, 679, 601, 659,
+	239, 633, 602, 0, 620, 592, 628, 593, 617, 649,
+	168, 615, 696, 663, 717, 201, 675, 0, 158, 210,
+	207, 0, 0, 0, 245, 304, 715, 655, 0, 724,
+	204, 0, 672, 725, 295, 224, 0, 0, 683, 716,
+	656, 654, 233, 246, 250, 170, 174, 651, 703, 657,
+	690, 645, 681, 608, 671, 719, 634, 677, 720, 0,
+	0, 0, 586, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 0, 674, 713, 631, 676, 0, 678, 590,
+	673, 0, 596, 603, 729, 709, 623, 624, 625, 0,
+	0, 0, 0, 0, 0, 0, 650, 658, 687, 642,
+	0, 0, 0, 0, 0, 0, 1796, 0, 621, 0,
+	669, 0, 0, 0, 0, 604, 597, 0, 0, 648,
+	0, 0, 0, 607, 126, 622, 688, 0, 588, 180,
+	225, 137, 693, 708, 644, 194, 332, 712, 641, 640,
+	259, 0, 300, 183, 202, 141, 123, 135, 151, 182,
+	235, 268, 278, 632, 589, 697, 618, 629, 159, 626,
+	271, 243, 322, 0, 166, 666, 0, 270, 205, 311,
+	261, 320, 321, 184, 249, 660, 190, 209, 733, 329,
+	334, 291, 169, 0, 127, 0, 256, 163, 198, 643,
+	680, 619, 155, 685, 670, 702, 290, 309, 142, 306,
+	223, 229, 152, 154, 153, 136, 285, 308, 146, 157,
+	296, 274, 301, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 303, 319, 148, 282, 283, 335,
+	269, 130, 317, 299, 221, 195, 196, 129, 0, 266,
+	167, 179, 161, 238, 0, 178, 258, 314, 315, 160,
+	337, 138, 328, 132, 139, 327, 232, 0, 231, 330,
+	310, 318, 222, 214, 0, 131, 316, 220, 213, 200,
+	173, 186, 254, 208, 255, 187, 227, 226, 228, 211,
+	215, 0, 594, 0, 297, 325, 338, 144, 614, 284,
+	307, 0, 0, 145, 177, 172, 253, 230, 140, 189,
+	293, 199, 206, 265, 336, 242, 272, 149, 324, 292,
+	612, 613, 610, 0, 611, 661, 662, 721, 722, 723,
+	689, 605, 0, 704, 705, 0, 0, 0, 0, 0,
+	695, 710, 711, 630, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 682, 731, 637, 638, 691,
+	609, 294, 692, 684, 156, 595, 598, 599, 600, 606,
+	652, 653, 665, 668, 700, 699, 698, 701, 706, 727,
+	726, 728, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 664, 122, 133, 203, 732, 263, 176,
+	326, 591, 165, 667, 686, 124, 125, 134, 143, 150,
+	164, 171, 175, 181, 185, 188, 191, 192, 193, 197,
+	212, 216, 217, 218, 219, 234, 236, 237, 240, 241,
+	244, 247, 248, 251, 252, 257, 260, 262, 264, 267,
+	273, 275, 276, 277, 279, 280, 281, 286, 287, 288,
+	289, 298, 302, 312, 313, 323, 331, 333, 707, 714,
+	627, 647, 694, 305, 646, 718, 616, 635, 730, 636,
+	639, 679, 601, 659, 239, 633, 602, 0, 620, 592,
+	628, 593, 617, 649, 168, 615, 696, 663, 717, 201,
+	675, 0, 158, 210, 207, 0, 0, 0, 245, 304,
+	715, 655, 0, 724, 204, 0, 672, 725, 295, 224,
+	0, 0, 683, 716, 656, 654, 233, 246, 250, 170,
+	174, 651, 703, 657, 690, 645, 681, 608, 671, 719,
+	634, 677, 720, 79, 0, 0, 586, 0, 0, 0,
+	0, 0, 0, 0, 0, 147, 0, 674, 713, 631,
+	676, 0, 678, 590, 673, 0, 596, 603, 729, 709,
+	623, 624, 625, 0, 0, 0, 0, 0, 0, 0,
+	650, 658, 687, 642, 0, 0, 0, 0, 0, 0,
+	0, 0, 621, 0, 669, 0, 0, 0, 0, 604,
+	597, 0, 0, 648, 0, 0, 0, 607, 126, 622,
+	688, 0, 588, 180, 225, 137, 693, 708, 644, 194,
+	332, 712, 641, 640, 259, 0, 300, 183, 202, 141,
+	123, 135, 151, 182, 235, 268, 278, 632, 589, 697,
+	618, 629, 159, 626, 271, 243, 322, 0, 166, 666,
+	0, 270, 205, 311, 261, 320, 321, 184, 249, 660,
+	190, 209, 733, 329, 334, 291, 169, 0, 127, 0,
+	256, 163, 198, 643, 680, 619, 155, 685, 670, 702,
+	290, 309, 142, 306, 223, 229, 152, 154, 153, 136,
+	285, 308, 146, 157, 296, 274, 301, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 303, 319,
+	148, 282, 283, 335, 269, 130, 317, 299, 221, 195,
+	196, 129, 0, 266, 167, 179, 161, 238, 0, 178,
+	258, 314, 315, 160, 337, 138, 328, 132, 139, 327,
+	232, 0, 231, 330, 310, 318, 222, 214, 0, 131,
+	316, 220, 213, 200, 173, 186, 254, 208, 255, 187,
+	227, 226, 228, 211, 215, 0, 594, 0, 297, 325,
+	338, 144, 614, 284, 307, 0, 0, 145, 177, 172,
+	253, 230, 140, 189, 293, 199, 206, 265, 336, 242,
+	272, 149, 324, 292, 612, 613, 610, 0, 611, 661,
+	662, 721, 722, 723, 689, 605, 0, 704, 705, 0,
+	0, 0, 0, 0, 695, 710, 711, 630, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 682,
+	731, 637, 638, 691, 609, 294, 692, 684, 156, 595,
+	598, 599, 600, 606, 652, 653, 665, 668, 700, 699,
+	698, 701, 706, 727, 726, 728, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 664, 122, 133,
+	203, 732, 263, 176, 326, 591, 165, 667, 686, 124,
+	125, 134, 143, 150, 164, 171, 175, 181, 185, 188,
+	191, 192, 193, 197, 212, 216, 217, 218, 219, 234,
+	236, 237, 240, 241, 244, 247, 248, 251, 252, 257,
+	260, 262, 264, 267, 273, 275, 276, 277, 279, 280,
+	281, 286, 287, 288, 289, 298, 302, 312, 313, 323,
+	331, 333, 707, 714, 627, 647, 694, 305, 646, 718,
+	616, 635, 730, 636, 639, 679, 601, 659, 239, 633,
+	602, 0, 620, 592, 628, 593, 617, 649, 168, 615,
+	696, 663, 717, 201, 675, 0, 158, 210, 207, 0,
+	0, 0, 245, 304, 715, 655, 0, 724, 204, 0,
+	672, 725, 295, 224, 0, 0, 683, 716, 656, 654,
+	233, 246, 250, 170, 174, 651, 703, 657, 690, 645,
+	681, 608, 671, 719, 634, 677, 720, 0, 0, 0,
+	120, 0, 0, 0, 0, 0, 0, 0, 0, 147,
+	0, 674, 713, 631, 676, 0, 678, 590, 673, 0,
+	596, 603, 729, 709, 623, 624, 625, 0, 0, 0,
+	0, 0, 0, 0, 650, 658, 687, 642, 0, 0,
+	0, 0, 0, 0, 1372, 0, 621, 0, 669, 0,
+	0, 0, 0, 604, 597, 0, 0, 648, 0, 0,
+	0, 607, 126, 622, 688, 0, 588, 180, 225, 137,
+	693, 708, 644, 194, 332, 712, 641, 640, 259, 0,
+	300, 183, 202, 141, 123, 135, 151, 182, 235, 268,
+	278, 632, 589, 697, 618, 629, 159, 626, 271, 243,
+	322, 0, 166, 666, 0, 270, 205, 311, 261, 320,
+	321, 184, 249, 660, 190, 209, 733, 329, 334, 291,
+	169, 0, 127, 0, 256, 163, 198, 643, 680, 619,
+	155, 685, 670, 702, 290, 309, 142, 306, 223, 229,
+	152, 154, 153, 136, 285, 308, 146, 157, 296, 274,
+	301, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 303, 319, 148, 282, 283, 335, 269, 130,
+	317, 299, 221, 195, 196, 129, 0, 266, 167, 179,
+	161, 238, 0, 178, 258, 314, 315, 160, 337, 138,
+	328, 132, 139, 327, 232, 0, 231, 330, 310, 318,
+	222, 214, 0, 131, 316, 220, 213, 200, 173, 186,
+	254, 208, 255, 187, 227, 226, 228, 211, 215, 0,
+	594, 0, 297, 325, 338, 144, 614, 284, 307, 0,
+	0, 145, 177, 172, 253, 230, 140, 189, 293, 199,
+	206, 265, 336, 242, 272, 149, 324, 292, 612, 613,
+	610, 0, 611, 661, 662, 721, 722, 723, 689, 605,
+	0, 704, 705, 0, 0, 0, 0, 0, 695, 710,
+	711, 630, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 682, 731, 637, 638, 691, 609, 294,
+	692, 684, 156, 595, 598, 599, 600, 606, 652, 653,
+	665, 668, 700, 699, 698, 701, 706, 727, 726, 728,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 664, 122, 133, 203, 732, 263, 176, 326, 591,
+	165, 667, 686, 124, 125, 134, 143, 150, 164, 171,
+	175, 181, 185, 188, 191, 192, 193, 197, 212, 216,
+	217, 218, 219, 234, 236, 237, 240, 241, 244, 247,
+	248, 251, 252, 257, 260, 262, 264, 267, 273, 275,
+	276, 277, 279, 280, 281, 286, 287, 288, 289, 298,
+	302, 312, 313, 323, 331, 333, 707, 714, 627, 647,
+	694, 305, 646, 718, 616, 635, 730, 636, 639, 679,
+	601, 659, 239, 633, 602, 0, 620, 592, 628, 593,
+	617, 649, 168, 615, 696, 663, 717, 201, 675, 0,
+	158, 210, 207, 0, 0, 0, 245, 304, 715, 655,
+	0, 724, 204, 0, 672, 725, 295, 224, 0, 0,
+	683, 716, 656, 654, 233, 246, 250, 170, 174, 651,
+	703, 657, 690, 645, 681, 608, 671, 719, 634, 677,
+	720, 0, 0, 0, 572, 0, 0, 0, 0, 0,
+	0, 0, 0, 147, 0, 674, 713, 631, 676, 0,
+	678, 590, 673, 0, 596, 603, 729, 709, 623, 624,
+	625, 0, 0, 0, 0, 0, 0, 0, 650, 658,
+	687, 642, 0, 0, 0, 0, 0, 0, 1210, 0,
+	621, 0, 669, 0, 0, 0, 0, 604, 597, 0,
+	0, 648, 0, 0, 0, 607, 126, 622, 688, 0,
+	588, 180, 225, 137, 693, 708, 644, 194, 332, 712,
+	641, 640, 259, 0, 300, 183, 202, 141, 123, 135,
+	151, 182, 235, 268, 278, 632, 589, 697, 618, 629,
+	159, 626, 271, 243, 322, 0, 166, 666, 0, 270,
+	205, 311, 261, 320, 321, 184, 249, 660, 190, 209,
+	733, 329, 334, 291, 169, 0, 127, 0, 256, 163,
+	198, 643, 680, 619, 155, 685, 670, 702, 290, 309,
+	142, 306, 223, 229, 152, 154, 153, 136, 285, 308,
+	146, 157, 296, 274, 301, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 303, 319, 148, 282,
+	283, 335, 269, 130, 317, 299, 221, 195, 196, 129,
+	0, 266, 167, 179, 161, 238, 0, 178, 258, 314,
+	315, 160, 337, 138, 328, 132, 139, 327, 232, 0,
+	231, 330, 310, 318, 222, 214, 0, 131, 316, 220,
+	213, 200, 173, 186, 254, 208, 255, 187, 227, 226,
+	228, 211, 215, 0, 594, 0, 297, 325, 338, 144,
+	614, 284, 307, 0, 0, 145, 177, 172, 253, 230,
+	140, 189, 293, 199, 206, 265, 336, 242, 272, 149,
+	324, 292, 612, 613, 610, 0, 611, 661, 662, 721,
+	722, 723, 689, 605, 0, 704, 705, 0, 0, 0,
+	0, 0, 695, 710, 711, 630, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 682, 731, 637,
+	638, 691, 609, 294, 692, 684, 156, 595, 598, 599,
+	600, 606, 652, 653, 665, 668, 700, 699, 698, 701,
+	706, 727, 726, 728, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 664, 122, 133, 203, 732,
+	263, 176, 326, 591, 165, 667, 686, 124, 125, 134,
+	143, 150, 164, 171, 175, 181, 185, 188, 191, 192,
+	193, 197, 212, 216, 217, 218, 219, 234, 236, 237,
+	240, 241, 244, 247, 248, 251, 252, 257, 260, 262,
+	264, 267, 273, 275, 276, 277, 279, 280, 281, 286,
+	287, 288, 289, 298, 302, 312, 313, 323, 331, 333,
+	707, 714, 627, 647, 694, 305, 646, 718, 616, 635,
+	730, 636, 639, 679, 601, 659, 239, 633, 602, 0,
+	620, 592, 628, 593, 617, 649, 168, 615, 696, 663,
+	717, 201, 675, 0, 158, 210, 207, 0, 0, 0,
+	245, 304, 715, 655, 0, 724, 204, 0, 672, 725,
+	295, 224, 0, 0, 683, 716, 656, 654, 233, 246,
+	250, 170, 174, 651, 703, 657, 690, 645, 681, 608,
+	671, 719, 634, 677, 720, 0, 0, 0, 586, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 674,
+	713, 631, 676, 0, 678, 590, 673, 0, 596, 603,
+	729, 709, 623, 624, 625, 0, 0, 0, 0, 0,
+	0, 0, 650, 658, 687, 642, 0, 0, 0, 0,
+	0, 0, 0, 0, 621, 0, 669, 0, 0, 0,
+	0, 604, 597, 0, 0, 648, 0, 0, 0, 607,
+	126, 622, 688, 0, 588, 180, 225, 137, 693, 708,
+	644, 194, 332, 712, 641, 640, 259, 0, 300, 183,
+	202, 141, 123, 135, 151, 182, 235, 268, 278, 632,
+	589, 697, 618, 629, 159, 626, 271, 243, 322, 0,
+	166, 666, 0, 270, 205, 311, 261, 320, 321, 184,
+	249, 660, 190, 209, 733, 329, 334, 291, 169, 0,
+	127, 0, 256, 163, 198, 643, 680, 619, 155, 685,
+	670, 702, 290, 309, 142, 306, 223, 229, 152, 154,
+	153, 136, 285, 308, 146, 157, 296, 274, 301, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	303, 319, 148, 282, 283, 335, 269, 130, 317, 299,
+	221, 195, 196, 129, 0, 266, 167, 179, 161, 238,
+	0, 178, 258, 314, 315, 160, 337, 138, 328, 132,
+	139, 327, 232, 0, 231, 330, 310, 318, 222, 214,
+	0, 131, 316, 220, 213, 200, 173, 186, 254, 208,
+	255, 187, 227, 226, 228, 211, 215, 0, 594, 0,
+	297, 325, 338, 144, 614, 284, 307, 0, 0, 145,
+	177, 172, 253, 230, 140, 189, 293, 199, 206, 265,
+	336, 242, 272, 149, 324, 292, 612, 613, 610, 0,
+	611, 661, 662, 721, 722, 723, 689, 605, 0, 704,
+	705, 0, 0, 0, 0, 0, 695, 710, 711, 630,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 682, 731, 637, 638, 691, 609, 294, 692, 684,
+	156, 595, 598, 599, 600, 606, 652, 653, 665, 668,
+	700, 699, 698, 701, 706, 727, 726, 728, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 664,
+	122, 133, 203, 732, 263, 176, 326, 591, 165, 667,
+	686, 124, 125, 134, 143, 150, 164, 171, 175, 181,
+	185, 188, 191, 192, 193, 197, 212, 216, 217, 218,
+	219, 234, 236, 237, 240, 241, 244, 247, 248, 251,
+	252, 257, 260, 262, 264, 267, 273, 275, 276, 277,
+	279, 280, 281, 286, 287, 288, 289, 298, 302, 312,
+	313, 323, 331, 333, 707, 714, 627, 647, 694, 305,
+	646, 718, 616, 635, 730, 636, 639, 679, 601, 659,
+	239, 633, 602, 0, 620, 592, 628, 593, 617, 649,
+	168, 615, 696, 663, 717, 201, 675, 0, 158, 210,
+	207, 0, 0, 0, 245, 304, 715, 655, 0, 724,
+	204, 0, 672, 725, 295, 224, 0, 0, 683, 716,
+	656, 654, 233, 246, 250, 170, 174, 651, 703, 657,
+	690, 645, 681, 608, 671, 719, 634, 677, 720, 0,
+	0, 0, 572, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 0, 674, 713, 631, 676, 0, 678, 590,
+	673, 0, 596, 603, 729, 709, 623, 624, 625, 0,
+	0, 0, 0, 0, 0, 0, 650, 658, 687, 642,
+	0, 0, 0, 0, 0, 0, 0, 0, 621, 0,
+	669, 0, 0, 0, 0, 604, 597, 0, 0, 648,
+	0, 0, 0, 607, 126, 622, 688, 0, 588, 180,
+	225, 137, 693, 708, 644, 194, 332, 712, 641, 640,
+	259, 0, 300, 183, 202, 141, 123, 135, 151, 182,
+	235, 268, 278, 632, 589, 697, 618, 629, 159, 626,
+	271, 243, 322, 0, 166, 666, 0, 270, 205, 311,
+	261, 320, 321, 184, 249, 660, 190, 209, 733, 329,
+	334, 291, 169, 0, 127, 0, 256, 163, 198, 643,
+	680, 619, 155, 685, 670, 702, 290, 309, 142, 306,
+	223, 229, 152, 154, 153, 136, 285, 308, 146, 157,
+	296, 274, 301, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 303, 319, 148, 282, 283, 335,
+	269, 130, 317, 299, 221, 195, 196, 129, 0, 266,
+	167, 179, 161, 238, 0, 178, 258, 314, 315, 160,
+	337, 138, 328, 132, 139, 327, 232, 0, 231, 330,
+	310, 318, 222, 214, 0, 131, 316, 220, 213, 200,
+	173, 186, 254, 208, 255, 187, 227, 226, 228, 211,
+	215, 0, 594, 0, 297, 325, 338, 144, 614, 284,
+	307, 0, 0, 145, 177, 172, 253, 230, 140, 189,
+	293, 199, 206, 265, 336, 242, 272, 149, 324, 292,
+	612, 613, 610, 0, 611, 661, 662, 721, 722, 723,
+	689, 605, 0, 704, 705, 0, 0, 0, 0, 0,
+	695, 710, 711, 630, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 682, 731, 637, 638, 691,
+	609, 294, 692, 684, 156, 595, 598, 599, 600, 606,
+	652, 653, 665, 668, 700, 699, 698, 701, 706, 727,
+	726, 728, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 664, 122, 133, 203, 732, 263, 176,
+	326, 591, 165, 667, 686, 124, 125, 134, 143, 150,
+	164, 171, 175, 181, 185, 188, 191, 192, 193, 197,
+	212, 216, 217, 218, 219, 234, 236, 237, 240, 241,
+	244, 247, 248, 251, 252, 257, 260, 262, 264, 267,
+	273, 275, 276, 277, 279, 280, 281, 286, 287, 288,
+	289, 298, 302, 312, 313, 323, 331, 333, 707, 714,
+	627, 647, 694, 305, 646, 718, 616, 635, 730, 636,
+	639, 679, 601, 659, 239, 633, 602, 0, 620, 592,
+	628, 593, 617, 649, 168, 615, 696, 663, 717, 201,
+	675, 0, 158, 210, 207, 0, 0, 0, 245, 304,
+	1407, 1411, 0, 724, 204, 0, 672, 725, 295, 224,
+	0, 0, 683, 716, 656, 654, 233, 246, 250, 170,
+	174, 651, 703, 657, 690, 645, 681, 608, 671, 719,
+	634, 677, 720, 0, 0, 0, 586, 0, 0, 0,
+	0, 0, 0, 0, 0, 147, 0, 674, 713, 631,
+	676, 0, 678, 590, 673, 0, 596, 603, 729, 709,
+	623, 624, 625, 0, 0, 0, 0, 0, 0, 0,
+	650, 658, 687, 642, 0, 0, 0, 0, 0, 0,
+	0, 0, 621, 0, 669, 0, 0, 0, 0, 604,
+	597, 0, 0, 648, 0, 0, 0, 607, 126, 622,
+	688, 0, 588, 180, 225, 137, 693, 708, 1410, 194,
+	332, 712, 641, 640, 1405, 0, 1406, 183, 202, 585,
+	123, 135, 1403, 1409, 235, 268, 278, 632, 589, 697,
+	618, 629, 159, 626, 271, 243, 322, 0, 166, 666,
+	0, 270, 205, 311, 261, 320, 321, 184, 249, 660,
+	190, 209, 733, 329, 334, 291, 169, 0, 127, 0,
+	256, 163, 198, 643, 680, 619, 155, 685, 670, 702,
+	290, 309, 142, 306, 223, 229, 152, 154, 153, 136,
+	285, 308, 146, 157, 296, 274, 301, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 303, 319,
+	148, 282, 283, 335, 269, 130, 317, 299, 221, 195,
+	196, 129, 0, 266, 167, 179, 161, 238, 0, 178,
+	258, 314, 315, 160, 337, 138, 328, 132, 139, 327,
+	232, 0, 231, 330, 310, 318, 222, 214, 0, 131,
+	316, 220, 213, 200, 173, 186, 254, 208, 255, 187,
+	227, 226, 228, 211, 215, 0, 594, 0, 297, 325,
+	338, 144, 614, 284, 307, 0, 0, 145, 177, 172,
+	253, 230, 140, 189, 293, 199, 206, 265, 336, 242,
+	272, 149, 324, 292, 612, 613, 610, 0, 611, 661,
+	662, 721, 722, 723, 689, 605, 0, 704, 705, 0,
+	0, 0, 0, 0, 695, 710, 711, 630, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 682,
+	731, 637, 638, 691, 609, 294, 692, 684, 156, 595,
+	598, 599, 600, 606, 652, 653, 665, 668, 700, 699,
+	698, 701, 706, 727, 726, 728, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 664, 122, 133,
+	203, 732, 263, 176, 326, 591, 165, 667, 686, 124,
+	125, 134, 143, 150, 164, 171, 175, 181, 185, 188,
+	191, 192, 193, 197, 212, 216, 217, 218, 219, 234,
+	236, 237, 240, 241, 244, 247, 248, 251, 252, 257,
+	260, 262, 264, 267, 273, 275, 276, 277, 279, 280,
+	281, 286, 287, 288, 289, 298, 302, 312, 313, 323,
+	331, 333, 707, 714, 627, 647, 694, 305, 646, 718,
+	616, 635, 730, 636, 639, 679, 601, 659, 239, 633,
+	602, 0, 620, 592, 628, 593, 617, 649, 168, 615,
+	696, 663, 717, 201, 675, 0, 158, 210, 207, 0,
+	0, 0, 245, 304, 715, 655, 0, 724, 204, 0,
+	672, 725, 295, 224, 0, 0, 683, 716, 656, 654,
+	233, 246, 250, 170, 174, 651, 703, 657, 690, 645,
+	681, 608, 671, 719, 634, 677, 720, 0, 0, 0,
+	120, 0, 0, 0, 0, 0, 0, 0, 0, 147,
+	0, 674, 713, 631, 676, 0, 678, 590, 673, 0,
+	596, 603, 729, 709, 623, 624, 625, 0, 0, 0,
+	0, 0, 0, 0, 650, 658, 687, 642, 0, 0,
+	0, 0, 0, 0, 0, 0, 621, 0, 669, 0,
+	0, 0, 0, 604, 597, 0, 0, 648, 0, 0,
+	0, 607, 126, 622, 688, 0, 588, 180, 225, 137,
+	693, 708, 644, 194, 332, 712, 641, 640, 259, 0,
+	300, 183, 202, 141, 123, 135, 151, 182, 235, 268,
+	278, 632, 589, 697, 618, 629, 159, 626, 271, 243,
+	322, 0, 166, 666, 0, 270, 205, 311, 261, 320,
+	321, 184, 249, 660, 190, 209, 733, 329, 334, 291,
+	169, 0, 127, 0, 256, 163, 198, 643, 680, 619,
+	155, 685, 670, 702, 290, 309, 142, 306, 223, 229,
+	152, 154, 153, 136, 285, 308, 146, 157, 296, 274,
+	301, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 303, 319, 148, 282, 283, 335, 269, 130,
+	317, 299, 221, 195, 196, 129, 0, 266, 167, 179,
+	161, 238, 0, 178, 258, 314, 315, 160, 337, 138,
+	328, 132, 139, 327, 232, 0, 231, 330, 310, 318,
+	222, 214, 0, 131, 316, 220, 213, 200, 173, 186,
+	254, 208, 255, 187, 227, 226, 228, 211, 215, 0,
+	594, 0, 297, 325, 338, 144, 614, 284, 307, 0,
+	0, 145, 177, 172, 253, 230, 140, 189, 293, 199,
+	206, 265, 336, 242, 272, 149, 324, 292, 612, 613,
+	610, 0, 611, 661, 662, 721, 722, 723, 689, 605,
+	0, 704, 705, 0, 0, 0, 0, 0, 695, 710,
+	711, 630, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 682, 731, 637, 638, 691, 609, 294,
+	692, 684, 156, 595, 598, 599, 600, 606, 652, 653,
+	665, 668, 700, 699, 698, 701, 706, 727, 726, 728,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 664, 122, 133, 203, 732, 263, 176, 326, 591,
+	165, 667, 686, 124, 125, 134, 143, 150, 164, 171,
+	175, 181, 185, 188, 191, 192, 193, 197, 212, 216,
+	217, 218, 219, 234, 236, 237, 240, 241, 244, 247,
+	248, 251, 252, 257, 260, 262, 264, 267, 273, 275,
+	276, 277, 279, 280, 281, 286, 287, 288, 289, 298,
+	302, 312, 313, 323, 331, 333, 707, 714, 627, 647,
+	694, 305, 646, 718, 616, 635, 730, 636, 639, 679,
+	601, 659, 239, 633, 602, 0, 620, 592, 628, 593,
+	617, 649, 168, 615, 696, 663, 717, 201, 675, 0,
+	158, 210, 207, 0, 0, 0, 245, 304, 715, 655,
+	0, 724, 204, 0, 672, 725, 295, 224, 0, 0,
+	683, 716, 656, 654, 233, 246, 250, 170, 174, 651,
+	703, 657, 690, 645, 681, 608, 671, 719, 634, 677,
+	720, 0, 0, 0, 586, 0, 0, 0, 0, 0,
+	0, 0, 0, 147, 0, 674, 713, 631, 676, 0,
+	678, 590, 673, 0, 596, 603, 729, 709, 623, 624,
+	625, 0, 0, 0, 0, 0, 0, 0, 650, 658,
+	687, 642, 0, 0, 0, 0, 0, 0, 0, 0,
+	621, 0, 669, 0, 0, 0, 0, 604, 597, 0,
+	0, 648, 0, 0, 0, 607, 126, 622, 688, 0,
+	588, 180, 225, 137, 693, 708, 644, 194, 332, 712,
+	641, 640, 259, 0, 300, 183, 202, 585, 123, 135,
+	581, 182, 235, 268, 278, 632, 589, 697, 618, 629,
+	159, 626, 271, 243, 322, 0, 166, 666, 0, 270,
+	205, 311, 261, 320, 321, 184, 249, 660, 190, 209,
+	733, 329, 334, 291, 169, 0, 127, 0, 256, 163,
+	198, 643, 680, 619, 155, 685, 670, 702, 290, 309,
+	142, 306, 223, 229, 152, 154, 153, 136, 285, 308,
+	146, 157, 296, 274, 301, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 303, 319, 148, 282,
+	283, 335, 269, 130, 317, 299, 221, 195, 196, 129,
+	0, 266, 167, 179, 161, 238, 0, 178, 258, 314,
+	315, 160, 337, 138, 328, 132, 139, 327, 232, 0,
+	231, 330, 310, 318, 222, 214, 0, 131, 316, 220,
+	213, 200, 173, 186, 254, 208, 255, 187, 227, 226,
+	228, 211, 215, 0, 594, 0, 297, 325, 338, 144,
+	614, 284, 307, 0, 0, 145, 177, 172, 253, 230,
+	140, 189, 293, 199, 206, 265, 336, 242, 272, 149,
+	324, 292, 612, 613, 610, 0, 611, 661, 662, 721,
+	722, 723, 689, 605, 0, 704, 705, 0, 0, 0,
+	0, 0, 695, 710, 711, 630, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 682, 731, 637,
+	638, 691, 609, 294, 692, 684, 156, 595, 598, 599,
+	600, 606, 652, 653, 665, 668, 700, 699, 698, 701,
+	706, 727, 726, 728, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 664, 122, 133, 203, 732,
+	263, 176, 326, 591, 165, 667, 686, 124, 125, 134,
+	143, 150, 164, 171, 175, 181, 185, 188, 191, 192,
+	193, 197, 212, 216, 217, 218, 219, 234, 236, 237,
+	240, 241, 244, 247, 248, 251, 252, 257, 260, 262,
+	264, 267, 273, 275, 276, 277, 279, 280, 281, 286,
+	287, 288, 289, 298, 302, 312, 313, 323, 331, 333,
+	707, 34, 305, 530, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 239, 0, 0, 0, 0, 0, 452,
+	0, 0, 0, 168, 449, 0, 0, 0, 201, 0,
+	0, 158, 210, 207, 0, 0, 0, 245, 304, 0,
+	0, 0, 528, 204, 0, 0, 430, 295, 224, 0,
+	0, 0, 0, 0, 0, 233, 246, 250, 170, 174,
+	0, 0, 515, 517, 0, 0, 0, 0, 0, 0,
+	0, 0, 79, 0, 852, 450, 474, 473, 476, 477,
+	478, 479, 0, 0, 147, 475, 480, 510, 511, 0,
+	0, 0, 0, 447, 465, 0, 527, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 462, 463, 0,
+	0, 0, 0, 544, 0, 0, 464, 0, 0, 459,
+	460, 461, 466, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 180, 225, 137, 518, 0, 0, 194, 332,
+	0, 0, 542, 259, 0, 300, 183, 202, 141, 123,
+	135, 151, 182, 235, 268, 278, 525, 0, 0, 0,
+	0, 159, 0, 271, 243, 322, 529, 166, 0, 0,
+	270, 205, 311, 261, 320, 321, 184, 249, 0, 190,
+	209, 424, 329, 334, 291, 169, 0, 127, 0, 256,
+	163, 198, 0, 0, 0, 155, 0, 0, 0, 290,
+	309, 142, 306, 223, 229, 152, 154, 153, 136, 285,
+	308, 146, 157, 296, 274, 301, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 128, 303, 319, 148,
+	282, 283, 335, 269, 130, 317, 299, 221, 195, 196,
+	129, 0, 266, 167, 179, 161, 238, 0, 178, 258,
+	314, 315, 160, 337, 138, 328, 132, 139, 327, 232,
+	0, 231, 330, 310, 318, 222, 214, 0, 131, 316,
+	220, 213, 200, 173, 186, 254, 208, 255, 187, 227,
+	226, 228, 211, 215, 0, 0, 0, 297, 325, 338,
+	144, 0, 284, 307, 0, 0, 145, 177, 172, 253,
+	230, 140, 189, 293, 199, 206, 265, 336, 242, 272,
+	149, 324, 292, 531, 543, 537, 539, 538, 535, 536,
+	534, 533, 532, 545, 519, 520, 521, 522, 523, 0,
+	0, 0, 526, 0, 540, 541, 488, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	516, 0, 0, 0, 294, 0, 0, 156, 481, 482,
+	483, 484, 485, 490, 491, 495, 496, 504, 503, 502,
+	505, 506, 508, 507, 509, 486, 487, 489, 492, 493,
+	494, 497, 498, 501, 499, 500, 524, 122, 133, 203,
+	77, 263, 176, 326, 0, 165, 0, 0, 124, 125,
+	134, 143, 150, 164, 171, 175, 181, 185, 188, 191,
+	192, 193, 197, 212, 216, 217, 218, 219, 234, 236,
+	237, 240, 241, 244, 247, 248, 251, 252, 257, 260,
+	262, 264, 267, 273, 275, 276, 277, 279, 280, 281,
+	286, 287, 288, 289, 298, 302, 312, 313, 323, 331,
+	333, 305, 530, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 0, 452, 0,
+	0, 0, 168, 449, 0, 0, 0, 201, 0, 0,
+	158, 210, 207, 0, 0, 0, 245, 304, 0, 0,
+	0, 528, 204, 0, 0, 430, 295, 224, 0, 0,
+	0, 0, 0, 0, 233, 246, 250, 170, 174, 0,
+	0, 515, 517, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 0, 0, 450, 474, 473, 476, 477, 478,
+	479, 0, 0, 147, 475, 480, 510, 511, 0, 0,
+	0, 0, 447, 465, 0, 527, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 462, 463, 0, 0,
+	0, 0, 544, 0, 0, 464, 0, 0, 459, 460,
+	461, 466, 0, 0, 0, 0, 126, 0, 0, 0,
+	0, 180, 225, 137, 518, 0, 0, 194, 332, 0,
+	0, 542, 259, 0, 300, 183, 202, 141, 123, 135,
+	151, 182, 235, 268, 278, 525, 0, 0, 0, 0,
+	159, 0, 271, 243, 322, 529, 166, 0, 0, 270,
+	205, 311, 261, 320, 321, 184, 249, 0, 190, 209,
+	424, 329, 334, 291, 169, 0, 127, 0, 256, 163,
+	198, 0, 0, 0, 155, 0, 0, 0, 290, 309,
+	142, 306, 223, 229, 152, 154, 153, 136, 285, 308,
+	146, 157, 296, 274, 301, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 303, 319, 148, 282,
+	283, 335, 269, 130, 317, 299, 221, 195, 196, 129,
+	0, 266, 167, 179, 161, 238, 0, 178, 258, 314,
+	315, 160, 337, 138, 328, 132, 139, 327, 232, 0,
+	231, 330, 310, 318, 222, 214, 0, 131, 316, 220,
+	213, 200, 173, 186, 254, 208, 255, 187, 227, 226,
+	228, 211, 215, 0, 0, 0, 297, 325, 338, 144,
+	0, 284, 307, 0, 0, 145, 177, 172, 253, 230,
+	140, 189, 293, 199, 206, 265, 336, 242, 272, 149,
+	324, 292, 531, 543, 537, 539, 538, 535, 536, 534,
+	533, 532, 545, 519, 520, 521, 522, 523, 0, 0,
+	0, 526, 0, 540, 541, 488, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2324,
+	0, 2322, 2323, 294, 0, 0, 156, 481, 482, 483,
+	484, 485, 490, 491, 495, 496, 504, 503, 502, 505,
+	506, 508, 507, 509, 486, 487, 489, 492, 493, 494,
+	497, 498, 501, 499, 500, 524, 122, 133, 203, 0,
+	263, 176, 326, 0, 165, 0, 0, 124, 125, 134,
+	143, 150, 164, 171, 175, 181, 185, 188, 191, 192,
+	193, 197, 212, 216, 217, 218, 219, 234, 236, 237,
+	240, 241, 244, 247, 248, 251, 252, 257, 260, 262,
+	264, 267, 273, 275, 276, 277, 279, 280, 281, 286,
+	287, 288, 289, 298, 302, 312, 313, 323, 331, 333,
+	305, 530, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 239, 0, 0, 0, 0, 0, 452, 0, 0,
+	0, 168, 449, 0, 0, 0, 201, 0, 0, 158,
+	210, 207, 0, 0, 0, 245, 304, 0, 0, 0,
+	528, 204, 0, 0, 430, 295, 224, 0, 0, 0,
+	0, 0, 0, 233, 246, 250, 170, 174, 0, 0,
+	515, 517, 0, 0, 0, 0, 0, 0, 1385, 0,
+	79, 0, 0, 450, 474, 473, 476, 477, 478, 479,
+	0, 0, 147, 475, 480, 510, 511, 1386, 0, 0,
+	0, 447, 465, 0, 527, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 462, 463, 0, 0, 0,
+	0, 544, 0, 0, 464, 0, 0, 459, 460, 461,
+	466, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	180, 225, 137, 518, 0, 0, 194, 332, 0, 0,
+	542, 259, 0, 300, 183, 202, 141, 123, 135, 151,
+	182, 235, 268, 278, 525, 0, 0, 0, 0, 159,
+	0, 271, 243, 322, 529, 166, 0, 0, 270, 205,
+	311, 261, 320, 321, 184, 249, 0, 190, 209, 424,
+	329, 334, 291, 169, 0, 127, 0, 256, 163, 198,
+	0, 0, 0, 155, 0, 0, 0, 290, 309, 142,
+	306, 223, 229, 152, 154, 153, 136, 285, 308, 146,
+	157, 296, 274, 301, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 303, 319, 148, 282, 283,
+	335, 269, 130, 317, 299, 221, 195, 196, 129, 0,
+	266, 167, 179, 161, 238, 0, 178, 258, 314, 315,
+	160, 337, 138, 328, 132, 139, 327, 232, 0, 231,
+	330, 310, 318, 222, 214, 0, 131, 316, 220, 213,
+	200, 173, 186, 254, 208, 255, 187, 227, 226, 228,
+	211, 215, 0, 0, 0, 297, 325, 338, 144, 0,
+	284, 307, 0, 0, 145, 177, 172, 253, 230, 140,
+	189, 293, 199, 206, 265, 336, 242, 272, 149, 324,
+	292, 531, 543, 537, 539, 538, 535, 536, 534, 533,
+	532, 545, 519, 520, 521, 522, 523, 0, 0, 0,
+	526, 0, 540, 541, 488, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 516, 0,
+	0, 0, 294, 0, 0, 156, 481, 482, 483, 484,
+	485, 490, 491, 495, 496, 504, 503, 502, 505, 506,
+	508, 507, 509, 486, 487, 489, 492, 493, 494, 497,
+	498, 501, 499, 500, 524, 122, 133, 203, 0, 263,
+	176, 326, 0, 165, 0, 0, 124, 125, 134, 143,
+	150, 164, 171, 175, 181, 185, 188, 191, 192, 193,
+	197, 212, 216, 217, 218, 219, 234, 236, 237, 240,
+	241, 244, 247, 248, 251, 252, 257, 260, 262, 264,
+	267, 273, 275, 276, 277, 279, 280, 281, 286, 287,
+	288, 289, 298, 302, 312, 313, 323, 331, 333, 305,
+	530, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 0, 452, 0, 0, 0,
+	168, 449, 0, 0, 0, 201, 0, 0, 158, 210,
+	207, 0, 0, 0, 245, 304, 0, 0, 0, 528,
+	204, 0, 0, 430, 295, 224, 0, 0, 0, 0,
+	0, 0, 233, 246, 250, 170, 174, 0, 0, 515,
+	517, 0, 0, 0, 0, 0, 0, 1382, 0, 79,
+	0, 0, 450, 474, 473, 476, 477, 478, 479, 0,
+	0, 147, 475, 480, 510, 511, 1383, 0, 0, 0,
+	447, 465, 0, 527, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 462, 463, 0, 0, 0, 0,
+	544, 0, 0, 464, 0, 0, 459, 460, 461, 466,
+	0, 0, 0, 0, 126, 0, 0, 0, 0, 180,
+	225, 137, 518, 0, 0, 194, 332, 0, 0, 542,
+	259, 0, 300, 183, 202, 141, 123, 135, 151, 182,
+	235, 268, 278, 525, 0, 0, 0, 0, 159, 0,
+	271, 243, 322, 529, 166, 0, 0, 270, 205, 311,
+	261, 320, 321, 184, 249, 0, 190, 209, 424, 329,
+	334, 291, 169, 0, 127, 0, 256, 163, 198, 0,
+	0, 0, 155, 0, 0, 0, 290, 309, 142, 306,
+	223, 229, 152, 154, 153, 136, 285, 308, 146, 157,
+	296, 274, 301, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 303, 319, 148, 282, 283, 335,
+	269, 130, 317, 299, 221, 195, 196, 129, 0, 266,
+	167, 179, 161, 238, 0, 178, 258, 314, 315, 160,
+	337, 138, 328, 132, 139, 327, 232, 0, 231, 330,
+	310, 318, 222, 214, 0, 131, 316, 220, 213, 200,
+	173, 186, 254, 208, 255, 187, 227, 226, 228, 211,
+	215, 0, 0, 0, 297, 325, 338, 144, 0, 284,
+	307, 0, 0, 145, 177, 172, 253, 230, 140, 189,
+	293, 199, 206, 265, 336, 242, 272, 149, 324, 292,
+	531, 543, 537, 539, 538, 535, 536, 534, 533, 532,
+	545, 519, 520, 521, 522, 523, 0, 0, 0, 526,
+	0, 540, 541, 488, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 0, 0,
+	0, 294, 0, 0, 156, 481, 482, 483, 484, 485,
+	490, 491, 495, 496, 504, 503, 502, 505, 506, 508,
+	507, 509, 486, 487, 489, 492, 493, 494, 497, 498,
+	501, 499, 500, 524, 122, 133, 203, 0, 263, 176,
+	326, 0, 165, 0, 0, 124, 125, 134, 143, 150,
+	164, 171, 175, 181, 185, 188, 191, 192, 193, 197,
+	212, 216, 217, 218, 219, 234, 236, 237, 240, 241,
+	244, 247, 248, 251, 252, 257, 260, 262, 264, 267,
+	273, 275, 276, 277, 279, 280, 281, 286, 287, 288,
+	289, 298, 302, 312, 313, 323, 331, 333, 34, 305,
+	530, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 0, 452, 0, 0, 0,
+	168, 449, 0, 0, 0, 201, 0, 0, 158, 210,
+	207, 0, 0, 0, 245, 304, 0, 0, 0, 528,
+	204, 0, 0, 430, 295, 224, 0, 0, 0, 0,
+	0, 0, 233, 246, 250, 170, 174, 0, 0, 515,
+	517, 0, 0, 0, 0, 0, 0, 0, 0, 79,
+	0, 0, 450, 474, 473, 476, 477, 478, 479, 0,
+	0, 147, 475, 480, 510, 511, 0, 0, 0, 0,
+	447, 465, 0, 527, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 462, 463, 0, 0, 0, 0,
+	544, 0, 0, 464, 0, 0, 459, 460, 461, 466,
+	0, 0, 0, 0, 126, 0, 0, 0, 0, 180,
+	225, 137, 518, 0, 0, 194, 332, 0, 0, 542,
+	259, 0, 300, 183, 202, 141, 123, 135, 151, 182,
+	235, 268, 278, 525, 0, 0, 0, 0, 159, 0,
+	271, 243, 322, 529, 166, 0, 0, 270, 205, 311,
+	261, 320, 321, 184, 249, 0, 190, 209, 424, 329,
+	334, 291, 169, 0, 127, 0, 256, 163, 198, 0,
+	0, 0, 155, 0, 0, 0, 290, 309, 142, 306,
+	223, 229, 152, 154, 153, 136, 285, 308, 146, 157,
+	296, 274, 301, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 303, 319, 148, 282, 283, 335,
+	269, 130, 317, 299, 221, 195, 196, 129, 0, 266,
+	167, 179, 161, 238, 0, 178, 258, 314, 315, 160,
+	337, 138, 328, 132, 139, 327, 232, 0, 231, 330,
+	310, 318, 222, 214, 0, 131, 316, 220, 213, 200,
+	173, 186, 254, 208, 255, 187, 227, 226, 228, 211,
+	215, 0, 0, 0, 297, 325, 338, 144, 0, 284,
+	307, 0, 0, 145, 177, 172, 253, 230, 140, 189,
+	293, 199, 206, 265, 336, 242, 272, 149, 324, 292,
+	531, 543, 537, 539, 538, 535, 536, 534, 533, 532,
+	545, 519, 520, 521, 522, 523, 0, 0, 0, 526,
+	0, 540, 541, 488, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 0, 0,
+	0, 294, 0, 0, 156, 481, 482, 483, 484, 485,
+	490, 491, 495, 496, 504, 503, 502, 505, 506, 508,
+	507, 509, 486, 487, 489, 492, 493, 494, 497, 498,
+	501, 499, 500, 524, 122, 133, 203, 77, 263, 176,
+	326, 0, 165, 0, 0, 124, 125, 134, 143, 150,
+	164, 171, 175, 181, 185, 188, 191, 192, 193, 197,
+	212, 216, 217, 218, 219, 234, 236, 237, 240, 241,
+	244, 247, 248, 251, 252, 257, 260, 262, 264, 267,
+	273, 275, 276, 277, 279, 280, 281, 286, 287, 288,
+	289, 298, 302, 312, 313, 323, 331, 333, 305, 530,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 239,
+	0, 0, 0, 0, 0, 452, 0, 0, 0, 168,
+	449, 0, 0, 0, 201, 0, 0, 158, 210, 207,
+	0, 0, 0, 245, 304, 0, 0, 0, 528, 204,
+	0, 0, 430, 295, 224, 0, 0, 0, 0, 0,
+	0, 233, 246, 250, 170, 174, 0, 0, 515, 517,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
+	0, 450, 474, 473, 476, 477, 478, 479, 0, 0,
+	147, 475, 480, 510, 511, 0, 0, 0, 0, 447,
+	465, 0, 527, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 462, 463, 443, 0, 0, 0, 544,
+	0, 0, 464, 0, 0, 459, 460, 461, 466, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 180, 225,
+	137, 518, 0, 0, 194, 332, 0, 0, 542, 259,
+	0, 300, 183, 202, 141, 123, 135, 151, 182, 235,
+	268, 278, 525, 0, 0, 0, 0, 159, 0, 271,
+	243, 322, 529, 166, 0, 0, 270, 205, 311, 261,
+	320, 321, 184, 249, 0, 190, 209, 424, 329, 334,
+	291, 169, 0, 127, 0, 256, 163, 198, 0, 0,
+	0, 155, 0, 0, 0, 290, 309, 142, 306, 223,
+	229, 152, 154, 153, 136, 285, 308, 146, 157, 296,
+	274, 301, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 303, 319, 148, 282, 283, 335, 269,
+	130, 317, 299, 221, 195, 196, 129, 0, 266, 167,
+	179, 161, 238, 0, 178, 258, 314, 315, 160, 337,
+	138, 328, 132, 139, 327, 232, 0, 231, 330, 310,
+	318, 222, 214, 0, 131, 316, 220, 213, 200, 173,
+	186, 254, 208, 255, 187, 227, 226, 228, 211, 215,
+	0, 0, 0, 297, 325, 338, 144, 0, 284, 307,
+	0, 0, 145, 177, 172, 253, 230, 140, 189, 293,
+	199, 206, 265, 336, 242, 272, 149, 324, 292, 531,
+	543, 537, 539, 538, 535, 536, 534, 533, 532, 545,
+	519, 520, 521, 522, 523, 0, 0, 0, 526, 0,
+	540, 541, 488, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 516, 0, 0, 0,
+	294, 0, 0, 156, 481, 482, 483, 484, 485, 490,
+	491, 495, 496, 504, 503, 502, 505, 506, 508, 507,
+	509, 486, 487, 489, 492, 493, 494, 497, 498, 501,
+	499, 500, 524, 122, 133, 203, 0, 263, 176, 326,
+	0, 165, 0, 0, 124, 125, 134, 143, 150, 164,
+	171, 175, 181, 185, 188, 191, 192, 193, 197, 212,
+	216, 217, 218, 219, 234, 236, 237, 240, 241, 244,
+	247, 248, 251, 252, 257, 260, 262, 264, 267, 273,
+	275, 276, 277, 279, 280, 281, 286, 287, 288, 289,
+	298, 302, 312, 313, 323, 331, 333, 305, 530, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
+	0, 0, 0, 0, 452, 0, 0, 0, 168, 449,
+	0, 0, 0, 201, 0, 0, 158, 210, 207, 0,
+	0, 0, 245, 304, 0, 0, 0, 528, 204, 0,
+	0, 430, 295, 224, 0, 0, 0, 0, 0, 0,
+	233, 246, 250, 170, 174, 0, 0, 515, 517, 0,
+	0, 0, 0, 0, 0, 0, 0, 79, 0, 852,
+	450, 474, 473, 476, 477, 478, 479, 0, 0, 147,
+	475, 480, 510, 511, 0, 0, 0, 0, 447, 465,
+	0, 527, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 462, 463, 0, 0, 0, 0, 544, 0,
+	0, 464, 0, 0, 459, 460, 461, 466, 0, 0,
+	0, 0, 126, 0, 0, 0, 0, 180, 225, 137,
+	518, 0, 0, 194, 332, 0, 0, 542, 259, 0,
+	300, 183, 202, 141, 123, 135, 151, 182, 235, 268,
+	278, 525, 0, 0, 0, 0, 159, 0, 271, 243,
+	322, 529, 166, 0, 0, 270, 205, 311, 261, 320,
+	321, 184, 249, 0, 190, 209, 424, 329, 334, 291,
+	169, 0, 127, 0, 256, 163, 198, 0, 0, 0,
+	155, 0, 0, 0, 290, 309, 142, 306, 223, 229,
+	152, 154, 153, 136, 285, 308, 146, 157, 296, 274,
+	301, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 303, 319, 148, 282, 283, 335, 269, 130,
+	317, 299, 221, 195, 196, 129, 0, 266, 167, 179,
+	161, 238, 0, 178, 258, 314, 315, 160, 337, 138,
+	328, 132, 139, 327, 232, 0, 231, 330, 310, 318,
+	222, 214, 0, 131, 316, 220, 213, 200, 173, 186,
+	254, 208, 255, 187, 227, 226, 228, 211, 215, 0,
+	0, 0, 297, 325, 338, 144, 0, 284, 307, 0,
+	0, 145, 177, 172, 253, 230, 140, 189, 293, 199,
+	206, 265, 336, 242, 272, 149, 324, 292, 531, 543,
+	537, 539, 538, 535, 536, 534, 533, 532, 545, 519,
+	520, 521, 522, 523, 0, 0, 0, 526, 0, 540,
+	541, 488, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 0, 0, 0, 294,
+	0, 0, 156, 481, 482, 483, 484, 485, 490, 491,
+	495, 496, 504, 503, 502, 505, 506, 508, 507, 509,
+	486, 487, 489, 492, 493, 494, 497, 498, 501, 499,
+	500, 524, 122, 133, 203, 0, 263, 176, 326, 0,
+	165, 0, 0, 124, 125, 134, 143, 150, 164, 171,
+	175, 181, 185, 188, 191, 192, 193, 197, 212, 216,
+	217, 218, 219, 234, 236, 237, 240, 241, 244, 247,
+	248, 251, 252, 257, 260, 262, 264, 267, 273, 275,
+	276, 277, 279, 280, 281, 286, 287, 288, 289, 298,
+	302, 312, 313, 323, 331, 333, 305, 530, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 239, 0, 0,
+	0, 0, 0, 452, 0, 0, 0, 168, 449, 0,
+	0, 0, 201, 0, 0, 158, 210, 207, 0, 0,
+	0, 245, 304, 0, 0, 0, 528, 204, 0, 0,
+	430, 295, 224, 0, 0, 0, 0, 0, 0, 233,
+	246, 250, 170, 174, 0, 0, 515, 517, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 450,
+	474, 473, 476, 477, 478, 479, 0, 0, 147, 475,
+	480, 510, 511, 0, 0, 0, 0, 447, 465, 0,
+	527, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 462, 463, 1250, 0, 0, 0, 544, 0, 0,
+	464, 0, 0, 459, 460, 461, 466, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 180, 225, 137, 518,
+	0, 0, 194, 332, 0, 0, 542, 259, 0, 300,
+	183, 202, 141, 123, 135, 151, 182, 235, 268, 278,
+	525, 0, 0, 0, 0, 159, 0, 271, 243, 322,
+	529, 166, 0, 0, 270, 205, 311, 261, 320, 321,
+	184, 249, 0, 190, 209, 424, 329, 334, 291, 169,
+	0, 127, 0, 256, 163, 198, 0, 0, 0, 155,
+	0, 0, 0, 290, 309, 142, 306, 223, 229, 152,
+	154, 153, 136, 285, 308, 146, 157, 296, 274, 301,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 303, 319, 148, 282, 283, 335, 269, 130, 317,
+	299, 221, 195, 196, 129, 0, 266, 167, 179, 161,
+	238, 0, 178, 258, 314, 315, 160, 337, 138, 328,
+	132, 139, 327, 232, 0, 231, 330, 310, 318, 222,
+	214, 0, 131, 316, 220, 213, 200, 173, 186, 254,
+	208, 255, 187, 227, 226, 228, 211, 215, 0, 0,
+	0, 297, 325, 338, 144, 0, 284, 307, 0, 0,
+	145, 177, 172, 253, 230, 140, 189, 293, 199, 206,
+	265, 336, 242, 272, 149, 324, 292, 531, 543, 537,
+	539, 538, 535, 536, 534, 533, 532, 545, 519, 520,
+	521, 522, 523, 0, 0, 0, 526, 0, 540, 541,
+	488, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 516, 0, 0, 0, 294, 0,
+	0, 156, 481, 482, 483, 484, 485, 490, 491, 495,
+	496, 504, 503, 502, 505, 506, 508, 507, 509, 486,
+	487, 489, 492, 493, 494, 497, 498, 501, 499, 500,
+	524, 122, 133, 203, 0, 263, 176, 326, 0, 165,
+	0, 0, 124, 125, 134, 143, 150, 164, 171, 175,
+	181, 185, 188, 191, 192, 193, 197, 212, 216, 217,
+	218, 219, 234, 236, 237, 240, 241, 244, 247, 248,
+	251, 252, 257, 260, 262, 264, 267, 273, 275, 276,
+	277, 279, 280, 281, 286, 287, 288, 289, 298, 302,
+	312, 313, 323, 331, 333, 305, 530, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
+	0, 0, 452, 0, 0, 0, 168, 449, 0, 0,
+	0, 201, 0, 0, 158, 210, 207, 0, 0, 0,
+	245, 304, 0, 0, 0, 528, 204, 0, 0, 430,
+	295, 224, 0, 0, 0, 0, 0, 0, 233, 246,
+	250, 170, 174, 0, 0, 515, 517, 0, 0, 0,
+	0, 0, 0, 0, 0, 79, 0, 0, 450, 474,
+	1307, 476, 477, 478, 479, 0, 0, 147, 475, 480,
+	510, 511, 0, 0, 0, 0, 447, 465, 0, 527,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	462, 463, 1250, 0, 0, 0, 544, 0, 0, 464,
+	0, 0, 459, 460, 461, 466, 0, 0, 0, 0,
+	126, 0, 0, 0, 0, 180, 225, 137, 518, 0,
+	0, 194, 332, 0, 0, 542, 259, 0, 300, 183,
+	202, 141, 123, 135, 151, 182, 235, 268, 278, 525,
+	0, 0, 0, 0, 159, 0, 271, 243, 322, 529,
+	166, 0, 0, 270, 205, 311, 261, 320, 321, 184,
+	249, 0, 190, 209, 424, 329, 334, 291, 169, 0,
+	127, 0, 256, 163, 198, 0, 0, 0, 155, 0,
+	0, 0, 290, 309, 142, 306, 223, 229, 152, 154,
+	153, 136, 285, 308, 146, 157, 296, 274, 301, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	303, 319, 148, 282, 283, 335, 269, 130, 317, 299,
+	221, 195, 196, 129, 0, 266, 167, 179, 161, 238,
+	0, 178, 258, 314, 315, 160, 337, 138, 328, 132,
+	139, 327, 232, 0, 231, 330, 310, 318, 222, 214,
+	0, 131, 316, 220, 213, 200, 173, 186, 254, 208,
+	255, 187, 227, 226, 228, 211, 215, 0, 0, 0,
+	297, 325, 338, 144, 0, 284, 307, 0, 0, 145,
+	177, 172, 253, 230, 140, 189, 293, 199, 206, 265,
+	336, 242, 272, 149, 324, 292, 531, 543, 537, 539,
+	538, 535, 536, 534, 533, 532, 545, 519, 520, 521,
+	522, 523, 0, 0, 0, 526, 0, 540, 541, 488,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 516, 0, 0, 0, 294, 0, 0,
+	156, 481, 482, 483, 484, 485, 490, 491, 495, 496,
+	504, 503, 502, 505, 506, 508, 507, 509, 486, 487,
+	489, 492, 493, 494, 497, 498, 501, 499, 500, 524,
+	122, 133, 203, 0, 263, 176, 326, 0, 165, 0,
+	0, 124, 125, 134, 143, 150, 164, 171, 175, 181,
+	185, 188, 191, 192, 193, 197, 212, 216, 217, 218,
+	219, 234, 236, 237, 240, 241, 244, 247, 248, 251,
+	252, 257, 260, 262, 264, 267, 273, 275, 276, 277,
+	279, 280, 281, 286, 287, 288, 289, 298, 302, 312,
+	313, 323, 331, 333, 305, 530, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
+	0, 452, 0, 0, 0, 168, 449, 0, 0, 0,
+	201, 0, 0, 158, 210, 207, 0, 0, 0, 245,
+	304, 0, 0, 0, 528, 204, 0, 0, 430, 295,
+	224, 0, 0, 0, 0, 0, 0, 233, 246, 250,
+	170, 174, 0, 0, 515, 517, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 0, 0, 450, 474, 1304,
+	476, 477, 478, 479, 0, 0, 147, 475, 480, 510,
+	511, 0, 0, 0, 0, 447, 465, 0, 527, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 462,
+	463, 1250, 0, 0, 0, 544, 0, 0, 464, 0,
+	0, 459, 460, 461, 466, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 180, 225, 137, 518, 0, 0,
+	194, 332, 0, 0, 542, 259, 0, 300, 183, 202,
+	141, 123, 135, 151, 182, 235, 268, 278, 525, 0,
+	0, 0, 0, 159, 0, 271, 243, 322, 529, 166,
+	0, 0, 270, 205, 311, 261, 320, 321, 184, 249,
+	0, 190, 209, 424, 329, 334, 291, 169, 0, 127,
+	0, 256, 163, 198, 0, 0, 0, 155, 0, 0,
+	0, 290, 309, 142, 306, 223, 229, 152, 154, 153,
+	136, 285, 308, 146, 157, 296, 274, 301, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 128, 303,
+	319, 148, 282, 283, 335, 269, 130, 317, 299, 221,
+	195, 196, 129, 0, 266, 167, 179, 161, 238, 0,
+	178, 258, 314, 315, 160, 337, 138, 328, 132, 139,
+	327, 232, 0, 231, 330, 310, 318, 222, 214, 0,
+	131, 316, 220, 213, 200, 173, 186, 254, 208, 255,
+	187, 227, 226, 228, 211, 215, 0, 0, 0, 297,
+	325, 338, 144, 0, 284, 307, 0, 0, 145, 177,
+	172, 253, 230, 140, 189, 293, 199, 206, 265, 336,
+	242, 272, 149, 324, 292, 531, 543, 537, 539, 538,
+	535, 536, 534, 533, 532, 545, 519, 520, 521, 522,
+	523, 0, 0, 0, 526, 0, 540, 541, 488, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 516, 0, 0, 0, 294, 0, 0, 156,
+	481, 482, 483, 484, 485, 490, 491, 495, 496, 504,
+	503, 502, 505, 506, 508, 507, 509, 486, 487, 489,
+	492, 493, 494, 497, 498, 501, 499, 500, 524, 122,
+	133, 203, 0, 263, 176, 326, 0, 165, 0, 0,
+	124, 125, 134, 143, 150, 164, 171, 175, 181, 185,
+	188, 191, 192, 193, 197, 212, 216, 217, 218, 219,
+	234, 236, 237, 240, 241, 244, 247, 248, 251, 252,
+	257, 260, 262, 264, 267, 273, 275, 276, 277, 279,
+	280, 281, 286, 287, 288, 289, 298, 302, 312, 313,
+	323, 331, 333, 305, 530, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 239, 0, 0, 0, 0, 0,
+	452, 0, 0, 0, 168, 449, 0, 0, 0, 201,
+	0, 0, 158, 210, 207, 0, 0, 0, 245, 304,
+	0, 0, 0, 528, 204, 0, 0, 430, 295, 224,
+	0, 0, 0, 0, 0, 0, 233, 246, 250, 170,
+	174, 0, 0, 515, 517, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 1164, 450, 474, 473, 476,
+	477, 478, 479, 0, 0, 147, 475, 480, 510, 511,
+	0, 0, 0, 0, 447, 465, 0, 527, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 462, 463,
+	0, 0, 0, 0, 544, 0, 0, 464, 0, 0,
+	459, 460, 461, 466, 0, 0, 0, 0, 126, 0,
+	0, 0, 0, 180, 225, 137, 518, 0, 0, 194,
+	332, 0, 0, 542, 259, 0, 300, 183, 202, 141,
+	123, 135, 151, 182, 235, 268, 278, 525, 0, 0,
+	0, 0, 159, 0, 271, 243, 322, 529, 166, 0,
+	0, 270, 205, 311, 261, 320, 321, 184, 249, 0,
+	190, 209, 424, 329, 334, 291, 169, 0, 127, 0,
+	256, 163, 198, 0, 0, 0, 155, 0, 0, 0,
+	290, 309, 142, 306, 223, 229, 152, 154, 153, 136,
+	285, 308, 146, 157, 296, 274, 301, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 303, 319,
+	148, 282, 283, 335, 269, 130, 317, 299, 221, 195,
+	196, 129, 0, 266, 167, 179, 161, 238, 0, 178,
+	258, 314, 315, 160, 337, 138, 328, 132, 139, 327,
+	232, 0, 231, 330, 310, 318, 222, 214, 0, 131,
+	316, 220, 213, 200, 173, 186, 254, 208, 255, 187,
+	227, 226, 228, 211, 215, 0, 0, 0, 297, 325,
+	338, 144, 0, 284, 307, 0, 0, 145, 177, 172,
+	253, 230, 140, 189, 293, 199, 206, 265, 336, 242,
+	272, 149, 324, 292, 531, 543, 537, 539, 538, 535,
+	536, 534, 533, 532, 545, 519, 520, 521, 522, 523,
+	0, 0, 0, 526, 0, 540, 541, 488, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 516, 0, 0, 0, 294, 0, 0, 156, 481,
+	482, 483, 484, 485, 490, 491, 495, 496, 504, 503,
+	502, 505, 506, 508, 507, 509, 486, 487, 489, 492,
+	493, 494, 497, 498, 501, 499, 500, 524, 122, 133,
+	203, 0, 263, 176, 326, 0, 165, 0, 0, 124,
+	125, 134, 143, 150, 164, 171, 175, 181, 185, 188,
+	191, 192, 193, 197, 212, 216, 217, 218, 219, 234,
+	236, 237, 240, 241, 244, 247, 248, 251, 252, 257,
+	260, 262, 264, 267, 273, 275, 276, 277, 279, 280,
+	281, 286, 287, 288, 289, 298, 302, 312, 313, 323,
+	331, 333, 305, 530, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 239, 0, 0, 0, 0, 0, 452,
+	0, 0, 0, 168, 449, 0, 0, 0, 201, 0,
+	0, 158, 210, 207, 0, 0, 0, 245, 304, 0,
+	0, 0, 528, 204, 0, 0, 430, 295, 224, 0,
+	0, 0, 0, 0, 0, 233, 246, 250, 170, 174,
+	0, 0, 515, 517, 0, 0, 0, 0, 0, 0,
+	0, 0, 79, 0, 0, 450, 474, 473, 476, 477,
+	478, 479, 0, 0, 147, 475, 480, 510, 511, 0,
+	0, 0, 0, 447, 465, 0, 527, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 462, 463, 0,
+	0, 0, 0, 544, 0, 0, 464, 0, 0, 459,
+	460, 461, 466, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 180, 225, 137, 518, 0, 0, 194, 332,
+	0, 0, 542, 259, 0, 300, 183, 202, 141, 123,
+	135, 151, 182, 235, 268, 278, 525, 0, 0, 0,
+	0, 159, 0, 271, 243, 322, 529, 166, 0, 0,
+	270, 205, 311, 261, 320, 321, 184, 249, 0, 190,
+	209, 424, 329, 334, 291, 169, 0, 127, 0, 256,
+	163, 198, 0, 0, 0, 155, 0, 0, 0, 290,
+	309, 142, 306, 223, 229, 152, 154, 153, 136, 285,
+	308, 146, 157, 296, 274, 301, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 128, 303, 319, 148,
+	282, 283, 335, 269, 130, 317, 299, 221, 195, 196,
+	129, 0, 266, 167, 179, 161, 238, 0, 178, 258,
+	314, 315, 160, 337, 138, 328, 132, 139, 327, 232,
+	0, 231, 330, 310, 318, 222, 214, 0, 131, 316,
+	220, 213, 200, 173, 186, 254, 208, 255, 187, 227,
+	226, 228, 211, 215, 0, 0, 0, 297, 325, 338,
+	144, 0, 284, 307, 0, 0, 145, 177, 172, 253,
+	230, 140, 189, 293, 199, 206, 265, 336, 242, 272,
+	149, 324, 292, 531, 543, 537, 539, 538, 535, 536,
+	534, 533, 532, 545, 519, 520, 521, 522, 523, 0,
+	0, 0, 526, 0, 540, 541, 488, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	516, 0, 0, 0, 294, 0, 0, 156, 481, 482,
+	483, 484, 485, 490, 491, 495, 496, 504, 503, 502,
+	505, 506, 508, 507, 509, 486, 487, 489, 492, 493,
+	494, 497, 498, 501, 499, 500, 524, 122, 133, 203,
+	0, 263, 176, 326, 0, 165, 0, 0, 124, 125,
+	134, 143, 150, 164, 171, 175, 181, 185, 188, 191,
+	192, 193, 197, 212, 216, 217, 218, 219, 234, 236,
+	237, 240, 241, 244, 247, 248, 251, 252, 257, 260,
+	262, 264, 267, 273, 275, 276, 277, 279, 280, 281,
+	286, 287, 288, 289, 298, 302, 312, 313, 323, 331,
+	333, 305, 530, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 168, 0, 0, 0, 0, 201, 0, 0,
+	158, 210, 207, 0, 0, 0, 245, 304, 0, 0,
+	0, 528, 204, 0, 0, 430, 295, 224, 0, 0,
+	0, 0, 0, 0, 233, 246, 250, 170, 174, 0,
+	0, 515, 517, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 0, 0, 572, 474, 473, 476, 477, 478,
+	479, 0, 0, 147, 475, 480, 510, 511, 0, 0,
+	0, 0, 0, 465, 0, 527, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 462, 463, 0, 0,
+	0, 0, 544, 0, 0, 464, 0, 0, 459, 460,
+	461, 466, 0, 0, 0, 0, 126, 0, 0, 0,
+	0, 180, 225, 137, 518, 0, 0, 194, 332, 0,
+	0, 542, 259, 0, 300, 183, 202, 141, 123, 135,
+	151, 182, 235, 268, 278, 525, 0, 0, 0, 0,
+	159, 0, 271, 243, 322, 529, 166, 0, 0, 270,
+	205, 311, 261, 320, 321, 184, 249, 0, 190, 209,
+	424, 329, 334, 291, 169, 0, 127, 0, 256, 163,
+	198, 0, 0, 0, 155, 0, 0, 0, 290, 309,
+	142, 306, 223, 229, 152, 154, 153, 136, 285, 308,
+	146, 157, 296, 274, 301, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 303, 319, 148, 282,
+	283, 335, 269, 130, 317, 299, 221, 195, 196, 129,
+	0, 266, 167, 179, 161, 238, 0, 178, 258, 314,
+	315, 160, 337, 138, 328, 132, 139, 327, 232, 0,
+	231, 330, 310, 318, 222, 214, 0, 131, 316, 220,
+	213, 200, 173, 186, 254, 208, 255, 187, 227, 226,
+	228, 211, 215, 0, 0, 0, 297, 325, 338, 144,
+	0, 284, 307, 0, 0, 145, 177, 172, 253, 230,
+	140, 189, 293, 199, 206, 265, 336, 242, 272, 149,
+	324, 292, 531, 543, 537, 539, 538, 535, 536, 534,
+	533, 532, 545, 519, 520, 521, 522, 523, 1310, 1311,
+	1312, 526, 0, 540, 541, 488, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 516,
+	0, 0, 0, 294, 0, 0, 156, 481, 482, 483,
+	484, 485, 490, 491, 495, 496, 504, 503, 502, 505,
+	506, 508, 507, 509, 486, 487, 489, 492, 493, 494,
+	497, 498, 501, 499, 500, 524, 122, 133, 203, 0,
+	263, 176, 326, 0, 165, 0, 0, 124, 125, 134,
+	143, 150, 164, 171, 175, 181, 185, 188, 191, 192,
+	193, 197, 212, 216, 217, 218, 219, 234, 236, 237,
+	240, 241, 244, 247, 248, 251, 252, 257, 260, 262,
+	264, 267, 273, 275, 276, 277, 279, 280, 281, 286,
+	287, 288, 289, 298, 302, 312, 313, 323, 331, 333,
+	305, 530, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 239, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 168, 0, 0, 0, 0, 201, 0, 0, 158,
+	210, 207, 0, 0, 0, 245, 304, 0, 0, 0,
+	528, 204, 0, 0, 430, 295, 224, 0, 0, 0,
+	0, 0, 0, 233, 246, 250, 170, 174, 0, 0,
+	515, 517, 0, 0, 0, 0, 0, 0, 0, 0,
+	79, 0, 0, 572, 474, 473, 476, 477, 478, 479,
+	0, 0, 147, 475, 480, 510, 511, 0, 0, 0,
+	0, 0, 465, 0, 527, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 462, 463, 0, 0, 0,
+	0, 544, 0, 0, 464, 0, 0, 459, 460, 461,
+	466, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	180, 225, 137, 518, 0, 0, 194, 332, 0, 0,
+	542, 259, 0, 300, 183, 202, 141, 123, 135, 151,
+	182, 235, 268, 278, 525, 0, 0, 0, 0, 159,
+	0, 271, 243, 322, 529, 166, 2570, 0, 270, 205,
+	311, 261, 320, 321, 184, 249, 0, 190, 209, 424,
+	329, 334, 291, 169, 0, 127, 0, 256, 163, 198,
+	0, 0, 0, 155, 0, 0, 0, 290, 309, 142,
+	306, 223, 229, 152, 154, 153, 136, 285, 308, 146,
+	157, 296, 274, 301, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 303, 319, 148, 282, 283,
+	335, 269, 130, 317, 299, 221, 195, 196, 129, 0,
+	266, 167, 179, 161, 238, 0, 178, 258, 314, 315,
+	160, 337, 138, 328, 132, 139, 327, 232, 0, 231,
+	330, 310, 318, 222, 214, 0, 131, 316, 220, 213,
+	200, 173, 186, 254, 208, 255, 187, 227, 226, 228,
+	211, 215, 0, 0, 0, 297, 325, 338, 144, 0,
+	284, 307, 0, 0, 145, 177, 172, 253, 230, 140,
+	189, 293, 199, 206, 265, 336, 242, 272, 149, 324,
+	292, 531, 543, 537, 539, 538, 535, 536, 534, 533,
+	532, 545, 519, 520, 521, 522, 523, 0, 0, 0,
+	526, 0, 540, 541, 488, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 516, 0,
+	0, 0, 294, 0, 0, 156, 481, 482, 483, 484,
+	485, 490, 491, 495, 496, 504, 503, 502, 505, 506,
+	508, 507, 509, 486, 487, 489, 492, 493, 494, 497,
+	498, 501, 499, 500, 524, 122, 133, 203, 0, 263,
+	176, 326, 0, 165, 0, 0, 124, 125, 134, 143,
+	150, 164, 171, 175, 181, 185, 188, 191, 192, 193,
+	197, 212, 216, 217, 218, 219, 234, 236, 237, 240,
+	241, 244, 247, 248, 251, 252, 257, 260, 262, 264,
+	267, 273, 275, 276, 277, 279, 280, 281, 286, 287,
+	288, 289, 298, 302, 312, 313, 323, 331, 333, 305,
+	530, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	168, 0, 0, 0, 0, 201, 0, 0, 158, 210,
+	207, 0, 0, 0, 245, 304, 0, 0, 0, 528,
+	204, 0, 0, 430, 295, 224, 0, 0, 0, 0,
+	0, 0, 233, 246, 250, 170, 174, 0, 0, 515,
+	517, 0, 0, 0, 0, 0, 0, 0, 0, 79,
+	0, 852, 572, 474, 473, 476, 477, 478, 479, 0,
+	0, 147, 475, 480, 510, 511, 0, 0, 0, 0,
+	0, 465, 0, 527, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 462, 463, 0, 0, 0, 0,
+	544, 0, 0, 464, 0, 0, 459, 460, 461, 466,
+	0, 0, 0, 0, 126, 0, 0, 0, 0, 180,
+	225, 137, 518, 0, 0, 194, 332, 0, 0, 542,
+	259, 0, 300, 183, 202, 141, 123, 135, 151, 182,
+	235, 268, 278, 525, 0, 0, 0, 0, 159, 0,
+	271, 243, 322, 529, 166, 0, 0, 270, 205, 311,
+	261, 320, 321, 184, 249, 0, 190, 209, 424, 329,
+	334, 291, 169, 0, 127, 0, 256, 163, 198, 0,
+	0, 0, 155, 0, 0, 0, 290, 309, 142, 306,
+	223, 229, 152, 154, 153, 136, 285, 308, 146, 157,
+	296, 274, 301, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 303, 319, 148, 282, 283, 335,
+	269, 130, 317, 299, 221, 195, 196, 129, 0, 266,
+	167, 179, 161, 238, 0, 178, 258, 314, 315, 160,
+	337, 138, 328, 132, 139, 327, 232, 0, 231, 330,
+	310, 318, 222, 214, 0, 131, 316, 220, 213, 200,
+	173, 186, 254, 208, 255, 187, 227, 226, 228, 211,
+	215, 0, 0, 0, 297, 325, 338, 144, 0, 284,
+	307, 0, 0, 145, 177, 172, 253, 230, 140, 189,
+	293, 199, 206, 265, 336, 242, 272, 149, 324, 292,
+	531, 543, 537, 539, 538, 535, 536, 534, 533, 532,
+	545, 519, 520, 521, 522, 523, 0, 0, 0, 526,
+	0, 540, 541, 488, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 0, 0,
+	0, 294, 0, 0, 156, 481, 482, 483, 484, 485,
+	490, 491, 495, 496, 504, 503, 502, 505, 506, 508,
+	507, 509, 486, 487, 489, 492, 493, 494, 497, 498,
+	501, 499, 500, 524, 122, 133, 203, 0, 263, 176,
+	326, 0, 165, 0, 0, 124, 125, 134, 143, 150,
+	164, 171, 175, 181, 185, 188, 191, 192, 193, 197,
+	212, 216, 217, 218, 219, 234, 236, 237, 240, 241,
+	244, 247, 248, 251, 252, 257, 260, 262, 264, 267,
+	273, 275, 276, 277, 279, 280, 281, 286, 287, 288,
+	289, 298, 302, 312, 313, 323, 331, 333, 305, 530,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 239,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 168,
+	0, 0, 0, 0, 201, 0, 0, 158, 210, 207,
+	0, 0, 0, 245, 304, 0, 0, 0, 528, 204,
+	0, 0, 430, 295, 224, 0, 0, 0, 0, 0,
+	0, 233, 246, 250, 170, 174, 0, 0, 515, 517,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
+	0, 572, 474, 473, 476, 477, 478, 479, 0, 0,
+	147, 475, 480, 510, 511, 0, 0, 0, 0, 0,
+	465, 0, 527, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 462, 463, 0, 0, 0, 0, 544,
+	0, 0, 464, 0, 0, 459, 460, 461, 466, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 180, 225,
+	137, 518, 0, 0, 194, 332, 0, 0, 542, 259,
+	0, 300, 183, 202, 141, 123, 135, 151, 182, 235,
+	268, 278, 525, 0, 0, 0, 0, 159, 0, 271,
+	243, 322, 529, 166, 0, 0, 270, 205, 311, 261,
+	320, 321, 184, 249, 0, 190, 209, 424, 329, 334,
+	291, 169, 0, 127, 0, 256, 163, 198, 0, 0,
+	0, 155, 0, 0, 0, 290, 309, 142, 306, 223,
+	229, 152, 154, 153, 136, 285, 308, 146, 157, 296,
+	274, 301, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 303, 319, 148, 282, 283, 335, 269,
+	130, 317, 299, 221, 195, 196, 129, 0, 266, 167,
+	179, 161, 238, 0, 178, 258, 314, 315, 160, 337,
+	138, 328, 132, 139, 327, 232, 0, 231, 330, 310,
+	318, 222, 214, 0, 131, 316, 220, 213, 200, 173,
+	186, 254, 208, 255, 187, 227, 226, 228, 211, 215,
+	0, 0, 0, 297, 325, 338, 144, 0, 284, 307,
+	0, 0, 145, 177, 172, 253, 230, 140, 189, 293,
+	199, 206, 265, 336, 242, 272, 149, 324, 292, 531,
+	543, 537, 539, 538, 535, 536, 534, 533, 532, 545,
+	519, 520, 521, 522, 523, 0, 0, 0, 526, 0,
+	540, 541, 488, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 516, 0, 0, 0,
+	294, 0, 0, 156, 481, 482, 483, 484, 485, 490,
+	491, 495, 496, 504, 503, 502, 505, 506, 508, 507,
+	509, 486, 487, 489, 492, 493, 494, 497, 498, 501,
+	499, 500, 524, 122, 133, 203, 0, 263, 176, 326,
+	0, 165, 0, 0, 124, 125, 134, 143, 150, 164,
+	171, 175, 181, 185, 188, 191, 192, 193, 197, 212,
+	216, 217, 218, 219, 234, 236, 237, 240, 241, 244,
+	247, 248, 251, 252, 257, 260, 262, 264, 267, 273,
+	275, 276, 277, 279, 280, 281, 286, 287, 288, 289,
+	298, 302, 312, 313, 323, 331, 333, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
+	0, 0, 0, 1358, 0, 0, 0, 0, 168, 0,
+	0, 0, 0, 201, 0, 0, 158, 210, 207, 0,
+	0, 0, 245, 304, 0, 0, 0, 0, 204, 0,
+	0, 430, 295, 224, 0, 0, 1360, 1361, 0, 0,
+	233, 246, 250, 170, 174, 0, 0, 0, 0, 0,
+	0, 0, 0, 1362, 1364, 0, 0, 0, 0, 0,
+	120, 0, 402, 0, 0, 0, 0, 0, 0, 147,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 126, 0, 0, 0, 0, 180, 225, 137,
+	0, 0, 0, 194, 332, 0, 1363, 0, 259, 0,
+	300, 183, 202, 141, 123, 135, 151, 182, 235, 268,
+	278, 0, 0, 0, 0, 0, 159, 0, 271, 243,
+	322, 0, 166, 0, 1355, 270, 205, 311, 261, 320,
+	321, 184, 249, 0, 190, 209, 424, 329, 334, 291,
+	169, 0, 127, 0, 256, 163, 198, 0, 0, 0,
+	155, 0, 0, 0, 290, 309, 142, 306, 223, 229,
+	152, 154, 153, 136, 285, 308, 146, 157, 296, 274,
+	301, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 303, 319, 148, 282, 283, 335, 269, 130,
+	317, 299, 221, 195, 196, 129, 0, 266, 167, 179,
+	161, 238, 0, 178, 258, 314, 315, 160, 337, 138,
+	328, 132, 139, 327, 232, 0, 231, 330, 310, 318,
+	222, 214, 0, 131, 316, 220, 213, 200, 173, 186,
+	254, 208, 255, 187, 227, 226, 228, 211, 215, 0,
+	0, 0, 297, 325, 338, 144, 0, 284, 307, 0,
+	0, 145, 177, 172, 253, 230, 140, 189, 293, 199,
+	206, 265, 336, 242, 272, 149, 324, 292, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 410, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 294,
+	0, 0, 156, 403, 404, 405, 406, 407, 412, 413,
+	417, 418, 427, 426, 425, 428, 429, 432, 431, 433,
+	408, 409, 411, 414, 415, 416, 419, 420, 423, 421,
+	422, 0, 122, 133, 203, 0, 263, 176, 326, 0,
+	165, 0, 0, 124, 125, 134, 143, 150, 164, 171,
+	175, 181, 185, 188, 191, 192, 193, 197, 212, 216,
+	217, 218, 219, 234, 236, 237, 240, 241, 244, 247,
+	248, 251, 252, 257, 260, 262, 264, 267, 273, 275,
+	276, 277, 279, 280, 281, 286, 287, 288, 289, 298,
+	302, 312, 313, 323, 331, 333, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 239, 0, 0,
+	0, 0, 874, 0, 0, 0, 0, 168, 0, 0,
+	0, 0, 201, 0, 0, 158, 210, 207, 0, 0,
+	0, 245, 304, 0, 0, 0, 0, 204, 0, 0,
+	430, 295, 224, 0, 0, 0, 0, 0, 0, 233,
+	246, 250, 170, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 875,
+	0, 878, 0, 0, 0, 0, 0, 0, 147, 0,
+	0, 0, 0, 0, 0, 871, 870, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 872, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 180, 225, 137, 0,
+	0, 0, 194, 332, 0, 0, 0, 259, 0, 300,
+	183, 202, 141, 123, 135, 151, 182, 235, 268, 278,
+	0, 0, 0, 0, 0, 159, 0, 271, 243, 322,
+	0, 166, 0, 0, 270, 205, 311, 261, 320, 321,
+	184, 249, 0, 190, 209, 424, 329, 334, 291, 169,
+	0, 127, 0, 256, 163, 198, 0, 0, 0, 155,
+	0, 0, 0, 290, 309, 142, 306, 223, 229, 152,
+	154, 153, 136, 285, 308, 146, 157, 296, 274, 301,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 303, 319, 148, 282, 283, 335, 269, 130, 317,
+	299, 221, 195, 196, 129, 0, 266, 167, 179, 161,
+	238, 0, 178, 258, 314, 315, 160, 337, 138, 328,
+	132, 139, 327, 232, 0, 231, 330, 310, 318, 222,
+	214, 0, 131, 316, 220, 213, 200, 173, 186, 254,
+	208, 255, 187, 227, 226, 228, 211, 215, 0, 0,
+	0, 297, 325, 338, 144, 0, 284, 307, 0, 0,
+	145, 177, 172, 253, 230, 140, 189, 293, 199, 206,
+	265, 336, 242, 272, 149, 324, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	410, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 294, 0,
+	0, 156, 403, 404, 405, 406, 407, 412, 413, 417,
+	418, 427, 426, 425, 428, 429, 432, 431, 433, 408,
+	409, 411, 414, 415, 416, 419, 420, 423, 421, 422,
+	0, 122, 133, 203, 0, 263, 176, 326, 0, 165,
+	0, 0, 124, 125, 134, 143, 150, 164, 171, 175,
+	181, 185, 188, 191, 192, 193, 197, 212, 216, 217,
+	218, 219, 234, 236, 237, 240, 241, 244, 247, 248,
+	251, 252, 257, 260, 262, 264, 267, 273, 275, 276,
+	277, 279, 280, 281, 286, 287, 288, 289, 298, 302,
+	312, 313, 323, 331, 333, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
+	0, 1358, 0, 0, 0, 0, 168, 0, 0, 0,
+	0, 201, 0, 0, 158, 210, 207, 0, 0, 0,
+	245, 304, 0, 0, 0, 0, 204, 0, 0, 430,
+	295, 224, 0, 0, 0, 0, 0, 0, 233, 246,
+	250, 170, 174, 0, 0, 0, 0, 0, 0, 0,
+	0, 1362, 1364, 0, 0, 0, 0, 0, 120, 0,
+	402, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	126, 0, 0, 0, 0, 180, 225, 137, 0, 0,
+	0, 194, 332, 0, 1363, 0, 259, 0, 300, 183,
+	202, 141, 123, 135, 151, 182, 235, 268, 278, 0,
+	0, 0, 0, 0, 159, 0, 271, 243, 322, 0,
+	166, 0, 0, 270, 205, 311, 261, 320, 321, 184,
+	249, 0, 190, 209, 424, 329, 334, 291, 169, 0,
+	127, 0, 256, 163, 198, 0, 0, 0, 155, 0,
+	0, 0, 290, 309, 142, 306, 223, 229, 152, 154,
+	153, 136, 285, 308, 146, 157, 296, 274, 301, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	303, 319, 148, 282, 283, 335, 269, 130, 317, 299,
+	221, 195, 196, 129, 0, 266, 167, 179, 161, 238,
+	0, 178, 258, 314, 315, 160, 337, 138, 328, 132,
+	139, 327, 232, 0, 231, 330, 310, 318, 222, 214,
+	0, 131, 316, 220, 213, 200, 173, 186, 254, 208,
+	255, 187, 227, 226, 228, 211, 215, 0, 0, 0,
+	297, 325, 338, 144, 0, 284, 307, 0, 0, 145,
+	177, 172, 253, 230, 140, 189, 293, 199, 206, 265,
+	336, 242, 272, 149, 324, 292, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 410,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 294, 0, 0,
+	156, 403, 404, 405, 406, 407, 412, 413, 417, 418,
+	427, 426, 425, 428, 429, 432, 431, 433, 408, 409,
+	411, 414, 415, 416, 419, 420, 423, 421, 422, 0,
+	122, 133, 203, 0, 263, 176, 326, 0, 165, 0,
+	0, 124, 125, 134, 143, 150, 164, 171, 175, 181,
+	185, 188, 191, 192, 193, 197, 212, 216, 217, 218,
+	219, 234, 236, 237, 240, 241, 244, 247, 248, 251,
+	252, 257, 260, 262, 264, 267, 273, 275, 276, 277,
+	279, 280, 281, 286, 287, 288, 289, 298, 302, 312,
+	313, 323, 331, 333, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 168, 0, 0, 0, 0,
+	201, 1645, 0, 158, 210, 207, 0, 0, 0, 245,
+	304, 0, 0, 0, 0, 204, 0, 0, 430, 295,
+	224, 0, 0, 0, 0, 0, 0, 233, 246, 250,
+	170, 174, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 120, 0, 402,
+	0, 0, 0, 0, 0, 0, 147, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 180, 225, 137, 0, 0, 0,
+	194, 332, 0, 0, 0, 259, 0, 300, 183, 202,
+	141, 123, 135, 151, 182, 235, 268, 278, 0, 0,
+	0, 0, 0, 159, 0, 271, 243, 322, 0, 166,
+	0, 0, 270, 205, 311, 261, 320, 321, 184, 249,
+	0, 190, 209, 424, 329, 334, 291, 169, 0, 127,
+	0, 256, 163, 198, 0, 0, 0, 155, 0, 0,
+	0, 290, 309, 142, 306, 223, 229, 152, 154, 153,
+	136, 285, 308, 146, 157, 296, 274, 301, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 128, 303,
+	319, 148, 282, 283, 335, 269, 130, 317, 299, 221,
+	195, 196, 129, 0, 266, 167, 179, 161, 238, 0,
+	178, 258, 314, 315, 160, 337, 138, 328, 132, 139,
+	327, 232, 0, 231, 330, 310, 318, 222, 214, 0,
+	131, 316, 220, 213, 200, 173, 186, 254, 208, 255,
+	187, 227, 226, 228, 211, 215, 0, 0, 0, 297,
+	325, 338, 144, 0, 284, 307, 0, 0, 145, 177,
+	172, 253, 230, 140, 189, 293, 199, 206, 265, 336,
+	242, 272, 149, 324, 292, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 410, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 294, 0, 0, 156,
+	403, 404, 405, 406, 407, 412, 413, 417, 418, 427,
+	426, 425, 428, 429, 432, 431, 433, 408, 409, 411,
+	414, 415, 416, 419, 420, 423, 421, 422, 0, 122,
+	133, 203, 0, 263, 176, 326, 0, 165, 0, 0,
+	124, 125, 134, 143, 150, 164, 171, 175, 181, 185,
+	188, 191, 192, 193, 197, 212, 216, 217, 218, 219,
+	234, 236, 237, 240, 241, 244, 247, 248, 251, 252,
+	257, 260, 262, 264, 267, 273, 275, 276, 277, 279,
+	280, 281, 286, 287, 288, 289, 298, 302, 312, 313,
+	323, 331, 333, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 239, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 168, 0, 0, 0, 0, 201,
+	0, 0, 158, 210, 207, 0, 0, 0, 245, 304,
+	0, 0, 0, 0, 204, 0, 0, 430, 295, 224,
+	0, 0, 0, 0, 0, 0, 233, 246, 250, 170,
+	174, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 120, 0, 402, 0,
+	0, 0, 0, 0, 0, 147, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 126, 0,
+	0, 0, 0, 180, 225, 137, 0, 0, 0, 194,
+	332, 0, 0, 0, 259, 0, 300, 183, 202, 141,
+	123, 135, 151, 182, 235, 268, 278, 0, 0, 0,
+	0, 0, 159, 0, 271, 243, 322, 0, 166, 0,
+	0, 270, 205, 311, 261, 320, 321, 184, 249, 0,
+	190, 209, 424, 329, 334, 291, 169, 0, 127, 0,
+	256, 163, 198, 0, 0, 0, 155, 0, 0, 0,
+	290, 309, 142, 306, 223, 229, 152, 154, 153, 136,
+	285, 308, 146, 157, 296, 274, 301, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 303, 319,
+	148, 282, 283, 335, 269, 130, 317, 299, 221, 195,
+	196, 129, 0, 266, 167, 179, 161, 238, 0, 178,
+	258, 314, 315, 160, 337, 138, 328, 132, 139, 327,
+	232, 0, 231, 330, 310, 318, 222, 214, 0, 131,
+	316, 220, 213, 200, 173, 186, 254, 208, 255, 187,
+	227, 226, 228, 211, 215, 0, 0, 0, 297, 325,
+	338, 144, 0, 284, 307, 0, 0, 145, 177, 172,
+	253, 230, 140, 189, 293, 199, 206, 265, 336, 242,
+	272, 149, 324, 292, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 410, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 294, 0, 0, 156, 403,
+	404, 405, 406, 407, 412, 413, 417, 418, 427, 426,
+	425, 428, 429, 432, 431, 433, 408, 409, 411, 414,
+	415, 416, 419, 420, 423, 421, 422, 0, 122, 133,
+	203, 0, 263, 176, 326, 0, 165, 0, 0, 124,
+	125, 134, 143, 150, 164, 171, 175, 181, 185, 188,
+	191, 192, 193, 197, 212, 216, 217, 218, 219, 234,
+	236, 237, 240, 241, 244, 247, 248, 251, 252, 257,
+	260, 262, 264, 267, 273, 275, 276, 277, 279, 280,
+	281, 286, 287, 288, 289, 298, 302, 312, 313, 323,
+	331, 333, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 239, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 168, 0, 0, 0, 0, 201, 0,
+	0, 158, 210, 207, 0, 0, 0, 245, 304, 0,
+	0, 0, 0, 204, 0, 0, 430, 295, 224, 0,
+	0, 0, 0, 0, 0, 233, 246, 250, 170, 174,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 875, 0, 878, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 180, 225, 137, 0, 0, 0, 194, 332,
+	0, 0, 0, 259, 0, 300, 183, 202, 141, 123,
+	135, 151, 182, 235, 268, 278, 0, 0, 0, 0,
+	0, 159, 0, 271, 243, 322, 0, 166, 0, 0,
+	270, 205, 311, 261, 320, 321, 184, 249, 0, 190,
+	209, 424, 329, 334, 291, 169, 0, 127, 0, 256,
+	163, 198, 0, 0, 0, 155, 0, 0, 0, 290,
+	309, 142, 306, 223, 229, 152, 154, 153, 136, 285,
+	308, 146, 157, 296, 274, 301, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 128, 303, 319, 148,
+	282, 283, 335, 269, 130, 317, 299, 221, 195, 196,
+	129, 0, 266, 167, 179, 161, 238, 0, 178, 258,
+	314, 315, 160, 337, 138, 328, 132, 139, 327, 232,
+	0, 231, 330, 310, 318, 222, 214, 0, 131, 316,
+	220, 213, 200, 173, 186, 254, 208, 255, 187, 227,
+	226, 228, 211, 215, 0, 0, 0, 297, 325, 338,
+	144, 0, 284, 307, 0, 0, 145, 177, 172, 253,
+	230, 140, 189, 293, 199, 206, 265, 336, 242, 272,
+	149, 324, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 410, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 294, 0, 0, 156, 403, 404,
+	405, 406, 407, 412, 413, 417, 418, 427, 426, 425,
+	428, 429, 432, 431, 433, 408, 409, 411, 414, 415,
+	416, 419, 420, 423, 421, 422, 0, 122, 133, 203,
+	0, 263, 176, 326, 0, 165, 0, 0, 124, 125,
+	134, 143, 150, 164, 171, 175, 181, 185, 188, 191,
+	192, 193, 197, 212, 216, 217, 218, 219, 234, 236,
+	237, 240, 241, 244, 247, 248, 251, 252, 257, 260,
+	262, 264, 267, 273, 275, 276, 277, 279, 280, 281,
+	286, 287, 288, 289, 298, 302, 312, 313, 323, 331,
+	333, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 168, 0, 0, 0, 0, 201, 0, 0,
+	158, 210, 207, 0, 0, 0, 245, 304, 0, 0,
+	0, 0, 204, 0, 0, 430, 295, 224, 0, 0,
+	0, 0, 0, 0, 233, 246, 250, 170, 174, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 586, 0, 0, 0, 0, 0,
+	0, 0, 0, 147, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 126, 0, 0, 0,
+	0, 180, 225, 137, 0, 0, 0, 194, 332, 0,
+	0, 0, 259, 0, 300, 183, 202, 141, 123, 135,
+	151, 182, 235, 268, 278, 0, 0, 0, 0, 0,
+	159, 0, 271, 243, 322, 0, 166, 0, 0, 270,
+	205, 311, 261, 320, 321, 184, 249, 0, 190, 209,
+	424, 329, 334, 291, 169, 0, 127, 0, 256, 163,
+	198, 0, 0, 0, 155, 0, 0, 0, 290, 309,
+	142, 306, 223, 229, 152, 154, 153, 136, 285, 308,
+	146, 157, 296, 274, 301, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 303, 319, 148, 282,
+	283, 335, 269, 130, 317, 299, 221, 195, 196, 129,
+	0, 266, 167, 179, 161, 238, 0, 178, 258, 314,
+	315, 160, 337, 138, 328, 132, 139, 327, 232, 0,
+	231, 330, 310, 318, 222, 214, 0, 131, 316, 220,
+	213, 200, 173, 186, 254, 208, 255, 187, 227, 226,
+	228, 211, 215, 0, 0, 0, 297, 325, 338, 144,
+	0, 284, 307, 0, 0, 145, 177, 172, 253, 230,
+	140, 189, 293, 199, 206, 265, 336, 242, 272, 149,
+	324, 292, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 410, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 294, 0, 0, 156, 403, 404, 405,
+	406, 407, 412, 413, 417, 418, 427, 426, 425, 428,
+	429, 432, 431, 433, 408, 409, 411, 414, 415, 416,
+	419, 420, 423, 421, 422, 0, 122, 133, 203, 0,
+	263, 176, 326, 0, 165, 0, 0, 124, 125, 134,
+	143, 150, 164, 171, 175, 181, 185, 188, 191, 192,
+	193, 197, 212, 216, 217, 218, 219, 234, 236, 237,
+	240, 241, 244, 247, 248, 251, 252, 257, 260, 262,
+	264, 267, 273, 275, 276, 277, 279, 280, 281, 286,
+	287, 288, 289, 298, 302, 312, 313, 323, 331, 333,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 239, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 168, 0, 0, 0, 0, 201, 0, 0, 158,
+	210, 207, 0, 0, 0, 245, 304, 0, 0, 0,
+	0, 204, 0, 0, 0, 295, 224, 0, 0, 0,
+	0, 0, 0, 233, 246, 250, 170, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 586, 0, 0, 0, 0, 0, 0,
+	0, 0, 147, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 888, 887, 898, 899, 891, 892, 893, 894, 895,
+	896, 897, 889, 890, 0, 0, 900, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	180, 225, 137, 0, 0, 0, 194, 332, 0, 0,
+	0, 259, 0, 300, 183, 202, 141, 123, 135, 151,
+	182, 235, 268, 278, 0, 0, 0, 0, 0, 159,
+	0, 271, 243, 322, 0, 166, 0, 0, 270, 205,
+	311, 261, 320, 321, 184, 249, 0, 190, 209, 0,
+	329, 334, 291, 169, 0, 127, 0, 256, 163, 198,
+	0, 0, 0, 155, 0, 0, 0, 290, 309, 142,
+	306, 223, 229, 152, 154, 153, 136, 285, 308, 146,
+	157, 296, 274, 301, 162, 0, 430, 0, 0, 0,
+	0, 0, 0, 0, 128, 303, 319, 148, 282, 283,
+	335, 269, 130, 317, 299, 221, 195, 196, 129, 0,
+	266, 167, 179, 161, 238, 1099, 178, 258, 314, 315,
+	160, 337, 138, 328, 132, 139, 327, 232, 0, 231,
+	330, 310, 318, 222, 214, 0, 131, 316, 220, 213,
+	200, 173, 186, 254, 208, 255, 187, 227, 226, 228,
+	211, 215, 0, 0, 0, 297, 325, 338, 144, 0,
+	284, 307, 0, 0, 145, 177, 172, 253, 230, 140,
+	189, 293, 199, 206, 265, 336, 242, 272, 149, 324,
+	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1042, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 294, 0, 0, 156, 0, 0, 0, 0,
+	0, 424, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 122, 133, 203, 0, 263,
+	176, 326, 0, 165, 0, 0, 124, 125, 134, 143,
+	150, 164, 171, 175, 181, 185, 188, 191, 192, 193,
+	197, 212, 216, 217, 218, 219, 234, 236, 237, 240,
+	241, 244, 247, 248, 251, 252, 257, 260, 262, 264,
+	267, 273, 275, 276, 277, 279, 280, 281, 286, 287,
+	288, 289, 298, 302, 312, 313, 323, 331, 333, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	168, 0, 0, 0, 0, 201, 0, 0, 158, 210,
+	207, 0, 0, 0, 245, 304, 0, 0, 0, 0,
+	204, 0, 0, 0, 295, 224, 0, 0, 0, 0,
+	0, 0, 233, 246, 250, 170, 174, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 410, 0, 0, 0,
+	0, 0, 586, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 0, 0, 0, 0, 0, 0, 403, 404,
+	405, 406, 407, 412, 413, 417, 418, 427, 426, 425,
+	428, 429, 432, 431, 433, 408, 409, 411, 414, 415,
+	416, 419, 420, 423, 421, 422, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 126, 0, 0, 0, 0, 180,
+	225, 137, 0, 0, 0, 194, 332, 0, 0, 0,
+	259, 0, 300, 183, 202, 141, 123, 135, 151, 182,
+	235, 268, 278, 0, 0, 0, 0, 0, 159, 0,
+	271, 243, 322, 0, 166, 0, 0, 270, 205, 311,
+	261, 320, 321, 184, 249, 0, 190, 209, 0, 329,
+	334, 291, 169, 0, 127, 0, 256, 163, 198, 0,
+	0, 0, 155, 0, 0, 0, 290, 309, 142, 306,
+	223, 229, 152, 154, 153, 136, 285, 308, 146, 157,
+	296, 274, 301, 162, 0, 430, 0, 0, 0, 0,
+	0, 0, 0, 128, 303, 319, 148, 282, 283, 335,
+	269, 130, 317, 299, 221, 195, 196, 129, 0, 266,
+	167, 179, 161, 238, 1964, 178, 258, 314, 315, 160,
+	337, 138, 328, 132, 139, 327, 232, 0, 231, 330,
+	310, 318, 222, 214, 0, 131, 316, 220, 213, 200,
+	173, 186, 254, 208, 255, 187, 227, 226, 228, 211,
+	215, 0, 0, 0, 297, 325, 338, 144, 0, 284,
+	307, 0, 0, 145, 177, 172, 253, 230, 140, 189,
+	293, 199, 206, 265, 336, 242, 272, 149, 324, 292,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1260, 1261, 1262, 1263, 1264, 1265,
+	1266, 1267, 1268, 1269, 1270, 0, 0, 0, 0, 0,
+	0, 294, 0, 0, 156, 0, 0, 0, 0, 0,
+	424, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 133, 203, 0, 263, 176,
+	326, 0, 165, 0, 0, 124, 125, 134, 143, 150,
+	164, 171, 175, 181, 185, 188, 191, 192, 193, 197,
+	212, 216, 217, 218, 219, 234, 236, 237, 240, 241,
+	244, 247, 248, 251, 252, 257, 260, 262, 264, 267,
+	273, 275, 276, 277, 279, 280, 281, 286, 287, 288,
+	289, 298, 302, 312, 313, 323, 331, 333, 34, 305,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	168, 0, 0, 0, 0, 201, 0, 0, 158, 210,
+	207, 1975, 0, 0, 245, 304, 0, 0, 0, 1350,
+	204, 0, 0, 0, 295, 224, 0, 0, 0, 0,
+	1007, 1008, 233, 246, 250, 170, 174, 0, 0, 0,
+	0, 0, 0, 0, 0, 410, 0, 0, 0, 79,
+	0, 0, 120, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 0, 0, 0, 0, 0, 403, 404, 405,
+	406, 407, 412, 413, 417, 418, 427, 426, 425, 428,
+	429, 432, 431, 433, 408, 409, 411, 414, 415, 416,
+	419, 420, 423, 421, 422, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 126, 0, 0, 0, 0, 180,
+	225, 137, 0, 0, 0, 194, 332, 424, 0, 0,
+	259, 0, 300, 183, 202, 141, 123, 135, 151, 182,
+	235, 268, 278, 0, 0, 0, 0, 0, 159, 0,
+	271, 243, 322, 0, 166, 0, 0, 270, 205, 311,
+	261, 320, 321, 184, 249, 0, 190, 209, 0, 329,
+	334, 291, 169, 0, 127, 0, 256, 163, 198, 0,
+	0, 0, 155, 0, 0, 0, 290, 309, 142, 306,
+	223, 229, 152, 154, 153, 136, 285, 308, 146, 157,
+	296, 274, 301, 162, 0, 430, 0, 0, 0, 0,
+	0, 0, 0, 128, 303, 319, 148, 282, 283, 335,
+	269, 130, 317, 299, 221, 195, 196, 129, 0, 266,
+	167, 179, 161, 238, 1973, 178, 258, 314, 315, 160,
+	337, 138, 328, 132, 139, 327, 232, 0, 231, 330,
+	310, 318, 222, 214, 0, 131, 316, 220, 213, 200,
+	173, 186, 254, 208, 255, 187, 227, 226, 228, 211,
+	215, 0, 0, 0, 297, 325, 338, 144, 0, 284,
+	307, 0, 410, 145, 177, 172, 253, 230, 140, 189,
+	293, 199, 206, 265, 336, 242, 272, 149, 324, 292,
+	0, 0, 0, 0, 403, 404, 405, 406, 407, 412,
+	413, 417, 418, 427, 426, 425, 428, 429, 432, 431,
+	433, 408, 409, 411, 414, 415, 416, 419, 420, 423,
+	421, 422, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 294, 0, 0, 156, 0, 0, 0, 0, 0,
+	424, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 133, 203, 77, 263, 176,
+	326, 0, 165, 0, 0, 124, 125, 134, 143, 150,
+	164, 171, 175, 181, 185, 188, 191, 192, 193, 197,
+	212, 216, 217, 218, 219, 234, 236, 237, 240, 241,
+	244, 247, 248, 251, 252, 257, 260, 262, 264, 267,
+	273, 275, 276, 277, 279, 280, 281, 286, 287, 288,
+	289, 298, 302, 312, 313, 323, 331, 333, 305, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 239,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 168,
+	0, 0, 0, 0, 201, 0, 0, 158, 210, 207,
+	0, 0, 0, 245, 304, 0, 0, 0, 0, 204,
+	0, 0, 0, 295, 224, 0, 0, 0, 0, 1007,
+	1008, 233, 246, 250, 170, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 410, 0, 0, 79, 0,
+	0, 120, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 0, 0, 0, 0, 0, 0, 403, 404, 405,
+	406, 407, 412, 413, 417, 418, 427, 426, 425, 428,
+	429, 432, 431, 433, 408, 409, 411, 414, 415, 416,
+	419, 420, 423, 421, 422, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 180, 225,
+	137, 0, 0, 0, 194, 332, 0, 0, 0, 259,
+	0, 300, 183, 202, 141, 123, 135, 151, 182, 235,
+	268, 278, 0, 0, 0, 0, 0, 159, 0, 271,
+	243, 322, 0, 166, 0, 0, 270, 205, 311, 261,
+	320, 321, 184, 249, 0, 190, 209, 0, 329, 334,
+	291, 169, 0, 127, 0, 256, 163, 198, 0, 0,
+	0, 155, 0, 0, 0, 290, 309, 142, 306, 223,
+	229, 152, 154, 153, 136, 285, 308, 146, 157, 296,
+	274, 301, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 303, 319, 148, 282, 283, 335, 269,
+	130, 317, 299, 221, 195, 196, 129, 0, 266, 167,
+	179, 161, 238, 0, 178, 258, 314, 315, 160, 337,
+	138, 328, 132, 139, 327, 232, 0, 231, 330, 310,
+	318, 222, 214, 0, 131, 316, 220, 213, 200, 173,
+	186, 254, 208, 255, 187, 227, 226, 228, 211, 215,
+	0, 0, 0, 297, 325, 338, 144, 0, 284, 307,
+	0, 0, 145, 177, 172, 253, 230, 140, 189, 293,
+	199, 206, 265, 336, 242, 272, 149, 324, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	294, 0, 0, 156, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 133, 203, 0, 263, 176, 326,
+	0, 165, 0, 0, 124, 125, 134, 143, 150, 164,
+	171, 175, 181, 185, 188, 191, 192, 193, 197, 212,
+	216, 217, 218, 219, 234, 236, 237, 240, 241, 244,
+	247, 248, 251, 252, 257, 260, 262, 264, 267, 273,
+	275, 276, 277, 279, 280, 281, 286, 287, 288, 289,
+	298, 302, 312, 313, 323, 331, 333, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 168, 1031,
+	0, 0, 0, 201, 0, 0, 158, 210, 207, 0,
+	0, 0, 245, 304, 0, 0, 0, 0, 204, 0,
+	0, 0, 295, 224, 0, 0, 0, 0, 0, 0,
+	233, 246, 250, 170, 174, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	586, 0, 1030, 0, 0, 0, 0, 0, 0, 147,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 126, 0, 0, 0, 0, 180, 225, 137,
+	0, 0, 0, 194, 332, 0, 0, 0, 259, 0,
+	300, 183, 202, 141, 123, 135, 151, 182, 235, 268,
+	278, 0, 0, 0, 0, 0, 159, 0, 271, 243,
+	322, 0, 166, 0, 0, 270, 205, 311, 261, 320,
+	321, 184, 249, 0, 190, 209, 0, 329, 334, 291,
+	169, 0, 127, 0, 256, 163, 198, 0, 0, 0,
+	155, 0, 0, 0, 290, 309, 142, 306, 223, 229,
+	152, 154, 153, 136, 285, 308, 146, 157, 296, 274,
+	301, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 303, 319, 148, 282, 283, 335, 269, 130,
+	317, 299, 221, 195, 196, 129, 0, 266, 167, 179,
+	161, 238, 0, 178, 258, 314, 315, 160, 337, 138,
+	328, 132, 139, 327, 232, 0, 231, 330, 310, 318,
+	222, 214, 0, 131, 316, 220, 213, 200, 173, 186,
+	254, 208, 255, 187, 227, 226, 228, 211, 215, 0,
+	0, 0, 297, 325, 338, 144, 0, 284, 307, 0,
+	0, 145, 177, 172, 253, 230, 140, 189, 293, 199,
+	206, 265, 336, 242, 272, 149, 324, 292, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 294,
+	0, 0, 156, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 133, 203, 0, 263, 176, 326, 0,
+	165, 0, 0, 124, 125, 134, 143, 150, 164, 171,
+	175, 181, 185, 188, 191, 192, 193, 197, 212, 216,
+	217, 218, 219, 234, 236, 237, 240, 241, 244, 247,
+	248, 251, 252, 257, 260, 262, 264, 267, 273, 275,
+	276, 277, 279, 280, 281, 286, 287, 288, 289, 298,
+	302, 312, 313, 323, 331, 333, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 239, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 168, 0, 0,
+	0, 0, 201, 0, 0, 158, 210, 207, 0, 0,
+	0, 245, 304, 0, 0, 0, 0, 204, 0, 0,
+	0, 295, 224, 0, 0, 0, 0, 0, 0, 233,
+	246, 250, 170, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 586,
+	0, 0, 0, 0, 0, 0, 0, 0, 147, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 180, 225, 137, 0,
+	0, 0, 194, 332, 0, 0, 0, 259, 0, 300,
+	183, 202, 141, 123, 135, 151, 182, 235, 268, 278,
+	0, 0, 0, 0, 0, 159, 0, 271, 243, 322,
+	0, 166, 0, 0, 270, 205, 311, 261, 320, 321,
+	184, 249, 0, 190, 209, 0, 329, 334, 291, 169,
+	0, 127, 0, 256, 163, 198, 0, 0, 0, 155,
+	0, 0, 0, 290, 309, 142, 306, 223, 229, 152,
+	154, 153, 136, 285, 308, 146, 157, 296, 274, 301,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 303, 319, 148, 282, 283, 335, 269, 130, 317,
+	299, 221, 195, 196, 129, 0, 266, 167, 179, 161,
+	238, 0, 178, 258, 314, 315, 160, 337, 138, 328,
+	132, 139, 327, 232, 0, 231, 330, 310, 318, 222,
+	214, 0, 131, 316, 220, 213, 200, 173, 186, 254,
+	208, 255, 187, 227, 226, 228, 211, 215, 0, 0,
+	0, 297, 325, 338, 144, 0, 284, 307, 0, 0,
+	145, 177, 172, 253, 230, 140, 189, 293, 199, 206,
+	265, 336, 242, 272, 149, 324, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 294, 0,
+	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 122, 133, 203, 0, 263, 176, 326, 0, 165,
+	0, 0, 124, 125, 134, 143, 150, 164, 171, 175,
+	181, 185, 188, 191, 192, 193, 197, 212, 216, 217,
+	218, 219, 234, 236, 237, 240, 241, 244, 247, 248,
+	251, 252, 257, 260, 262, 264, 267, 273, 275, 276,
+	277, 279, 280, 281, 286, 287, 288, 289, 298, 302,
+	312, 313, 323, 331, 333, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
+	0, 0, 0, 0, 0, 998, 168, 0, 0, 0,
+	0, 201, 0, 0, 158, 210, 207, 0, 0, 0,
+	245, 304, 0, 0, 0, 0, 204, 0, 0, 0,
+	295, 224, 0, 0, 0, 0, 0, 0, 233, 246,
+	250, 170, 174, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 120, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	126, 0, 0, 0, 0, 180, 225, 137, 0, 0,
+	0, 194, 332, 0, 0, 0, 259, 0, 300, 183,
+	202, 141, 123, 135, 151, 182, 235, 268, 278, 0,
+	0, 0, 0, 0, 159, 0, 271, 243, 322, 0,
+	166, 0, 0, 270, 205, 311, 261, 320, 321, 184,
+	249, 0, 190, 209, 0, 329, 334, 291, 169, 0,
+	127, 0, 256, 163, 198, 0, 0, 0, 155, 0,
+	0, 0, 290, 309, 142, 306, 223, 229, 152, 154,
+	153, 136, 285, 308, 146, 157, 296, 274, 301, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	303, 319, 148, 282, 283, 335, 269, 130, 317, 299,
+	221, 195, 196, 129, 0, 266, 167, 179, 161, 238,
+	0, 178, 258, 314, 315, 160, 337, 138, 328, 132,
+	139, 327, 232, 0, 231, 330, 310, 318, 222, 214,
+	0, 131, 316, 220, 213, 200, 173, 186, 254, 208,
+	255, 187, 227, 226, 228, 211, 215, 0, 0, 0,
+	297, 325, 338, 144, 0, 284, 307, 0, 0, 145,
+	177, 172, 253, 230, 140, 189, 293, 199, 206, 265,
+	336, 242, 272, 149, 324, 292, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 294, 0, 0,
+	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 133, 203, 0, 263, 176, 326, 0, 165, 0,
+	0, 124, 125, 134, 143, 150, 164, 171, 175, 181,
+	185, 188, 191, 192, 193, 197, 212, 216, 217, 218,
+	219, 234, 236, 237, 240, 241, 244, 247, 248, 251,
+	252, 257, 260, 262, 264, 267, 273, 275, 276, 277,
+	279, 280, 281, 286, 287, 288, 289, 298, 302, 312,
+	313, 323, 331, 333, 305, 0, 0, 0, 548, 0,
+	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 168, 0, 0, 0, 0,
+	201, 0, 0, 158, 210, 207, 0, 0, 0, 245,
+	304, 0, 0, 0, 0, 204, 0, 0, 0, 295,
+	224, 0, 0, 0, 0, 0, 0, 233, 246, 250,
+	170, 174, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 120, 0, 0,
+	0, 0, 0, 0, 0, 0, 147, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 180, 225, 137, 0, 0, 0,
+	194, 332, 0, 0, 0, 259, 0, 300, 183, 202,
+	141, 123, 135, 151, 182, 235, 268, 278, 0, 0,
+	0, 0, 0, 159, 0, 271, 243, 322, 0, 166,
+	0, 0, 270, 205, 311, 261, 320, 321, 184, 249,
+	0, 190, 209, 0, 329, 334, 291, 169, 0, 127,
+	0, 256, 163, 198, 0, 0, 0, 155, 0, 0,
+	0, 290, 309, 142, 306, 223, 229, 152, 154, 153,
+	136, 285, 308, 146, 157, 296, 274, 301, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 128, 303,
+	319, 148, 282, 283, 335, 269, 130, 317, 299, 221,
+	195, 196, 129, 0, 266, 167, 179, 161, 238, 0,
+	178, 258, 314, 315, 160, 337, 138, 328, 132, 139,
+	327, 232, 0, 231, 330, 310, 318, 222, 214, 0,
+	131, 316, 220, 213, 200, 173, 186, 254, 208, 255,
+	187, 227, 226, 228, 211, 215, 0, 0, 0, 297,
+	325, 338, 144, 0, 284, 307, 0, 0, 145, 177,
+	172, 253, 230, 140, 189, 293, 199, 206, 265, 336,
+	242, 272, 149, 324, 292, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 294, 0, 0, 156,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 122,
+	133, 203, 0, 263, 176, 326, 0, 165, 0, 0,
+	124, 125, 134, 143, 150, 164, 171, 175, 181, 185,
+	188, 191, 192, 193, 197, 212, 216, 217, 218, 219,
+	234, 236, 237, 240, 241, 244, 247, 248, 251, 252,
+	257, 260, 262, 264, 267, 273, 275, 276, 277, 279,
+	280, 281, 286, 287, 288, 289, 298, 302, 312, 313,
+	323, 331, 333, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 239, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 168, 0, 0, 0, 0, 201,
+	0, 0, 158, 210, 207, 0, 0, 0, 245, 304,
+	0, 0, 0, 0, 204, 0, 0, 0, 295, 224,
+	0, 0, 0, 0, 0, 0, 233, 246, 250, 170,
+	174, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 120, 0, 0, 0,
+	0, 0, 0, 0, 0, 147, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 126, 0,
+	0, 0, 0, 180, 225, 137, 0, 117, 0, 194,
+	332, 0, 0, 0, 259, 0, 300, 183, 202, 141,
+	123, 135, 151, 182, 235, 268, 278, 0, 0, 0,
+	0, 0, 159, 0, 271, 243, 322, 0, 166, 0,
+	0, 270, 205, 311, 261, 320, 321, 184, 249, 0,
+	190, 209, 0, 329, 334, 291, 169, 0, 127, 0,
+	256, 163, 198, 0, 0, 0, 155, 0, 0, 0,
+	290, 309, 142, 306, 223, 229, 152, 154, 153, 136,
+	285, 308, 146, 157, 296, 274, 301, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 303, 319,
+	148, 282, 283, 335, 269, 130, 317, 299, 221, 195,
+	196, 129, 0, 266, 167, 179, 161, 238, 0, 178,
+	258, 314, 315, 160, 337, 138, 328, 132, 139, 327,
+	232, 0, 231, 330, 310, 318, 222, 214, 0, 131,
+	316, 220, 213, 200, 173, 186, 254, 208, 255, 187,
+	227, 226, 228, 211, 215, 0, 0, 0, 297, 325,
+	338, 144, 0, 284, 307, 0, 0, 145, 177, 172,
+	253, 230, 140, 189, 293, 199, 206, 265, 336, 242,
+	272, 149, 324, 292, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 294, 0, 0, 156, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 122, 133,
+	203, 0, 263, 176, 326, 0, 165, 0, 0, 124,
+	125, 134, 143, 150, 164, 171, 175, 181, 185, 188,
+	191, 192, 193, 197, 212, 216, 217, 218, 219, 234,
+	236, 237, 240, 241, 244, 247, 248, 251, 252, 257,
+	260, 262, 264, 267, 273, 275, 276, 277, 279, 280,
+	281, 286, 287, 288, 289, 298, 302, 312, 313, 323,
+	331, 333, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 239, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 168, 0, 0, 0, 0, 201, 0,
+	0, 158, 210, 207, 0, 0, 0, 245, 304, 0,
+	0, 0, 0, 204, 0, 0, 0, 295, 224, 0,
+	0, 0, 0, 0, 0, 2343, 246, 250, 170, 174,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 586, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 180, 225, 137, 0, 0, 0, 194, 332,
+	0, 0, 0, 259, 0, 300, 183, 202, 141, 123,
+	135, 151, 182, 235, 268, 278, 0, 0, 0, 0,
+	0, 159, 0, 271, 243, 322, 0, 166, 0, 0,
+	270, 205, 311, 261, 320, 321, 184, 249, 0, 190,
+	209, 0, 329, 334, 291, 169, 0, 127, 0, 256,
+	163, 198, 0, 0, 0, 155, 0, 0, 0, 290,
+	309, 142, 306, 223, 229, 152, 154, 153, 136, 285,
+	308, 146, 157, 296, 274, 301, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 128, 303, 319, 148,
+	282, 283, 335, 269, 130, 317, 299, 221, 195, 196,
+	129, 0, 266, 167, 179, 161, 238, 0, 178, 258,
+	314, 315, 160, 337, 138, 328, 132, 139, 327, 232,
+	0, 231, 330, 310, 318, 222, 214, 0, 131, 316,
+	220, 213, 200, 173, 186, 254, 208, 255, 187, 227,
+	226, 228, 211, 215, 0, 0, 0, 297, 325, 338,
+	144, 0, 284, 307, 0, 0, 145, 177, 172, 253,
+	230, 140, 189, 293, 199, 206, 265, 336, 242, 272,
+	149, 324, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 294, 0, 0, 156, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 122, 133, 203,
+	0, 263, 176, 326, 0, 165, 0, 0, 124, 125,
+	134, 143, 150, 164, 171, 175, 181, 185, 188, 191,
+	192, 193, 197, 212, 216, 217, 218, 219, 234, 236,
+	237, 240, 241, 244, 247, 248, 251, 252, 257, 260,
+	262, 264, 267, 273, 275, 276, 277, 279, 280, 281,
+	286, 287, 288, 289, 298, 302, 312, 313, 323, 331,
+	333, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 168, 0, 0, 0, 0, 201, 0, 0,
+	158, 210, 207, 0, 0, 0, 245, 304, 0, 0,
+	0, 0, 204, 0, 0, 0, 295, 224, 0, 0,
+	0, 0, 0, 0, 233, 246, 250, 170, 174, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 586, 0, 0, 0, 0, 0,
+	0, 0, 0, 147, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 126, 0, 0, 0,
+	0, 180, 225, 137, 0, 0, 0, 194, 332, 0,
+	0, 0, 259, 0, 300, 183, 202, 141, 123, 135,
+	151, 182, 235, 268, 278, 0, 0, 0, 0, 0,
+	159, 0, 271, 243, 322, 0, 166, 0, 0, 270,
+	205, 311, 261, 320, 321, 184, 249, 0, 190, 209,
+	0, 329, 334, 291, 169, 0, 127, 0, 256, 163,
+	198, 0, 0, 0, 155, 0, 0, 0, 290, 309,
+	142, 306, 223, 229, 152, 154, 153, 136, 285, 308,
+	146, 157, 296, 274, 301, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 303, 319, 148, 282,
+	283, 335, 269, 130, 317, 299, 221, 195, 196, 129,
+	0, 266, 167, 179, 161, 238, 0, 178, 258, 314,
+	315, 160, 337, 138, 328, 132, 139, 327, 232, 0,
+	231, 330, 310, 318, 222, 214, 0, 131, 316, 220,
+	213, 200, 173, 186, 254, 208, 255, 187, 227, 226,
+	228, 211, 215, 0, 0, 0, 297, 325, 338, 144,
+	0, 284, 307, 0, 0, 145, 177, 172, 253, 230,
+	140, 189, 293, 199, 206, 265, 336, 242, 272, 149,
+	324, 292, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 294, 0, 0, 156, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 133, 203, 0,
+	263, 176, 326, 0, 165, 0, 0, 124, 125, 134,
+	143, 150, 164, 171, 175, 181, 185, 188, 191, 192,
+	193, 197, 212, 216, 217, 218, 219, 234, 236, 237,
+	240, 241, 244, 247, 248, 251, 252, 257, 260, 262,
+	264, 267, 273, 275, 276, 277, 279, 280, 281, 286,
+	287, 288, 289, 298, 302, 312, 313, 323, 331, 333,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 239, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 168, 0, 0, 0, 0, 201, 0, 0, 158,
+	210, 207, 0, 0, 0, 245, 304, 0, 0, 0,
+	0, 204, 0, 0, 0, 295, 224, 0, 0, 0,
+	0, 0, 0, 233, 246, 250, 170, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 0, 0, 0, 0, 0, 0,
+	0, 0, 147, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	180, 225, 137, 0, 0, 0, 194, 332, 0, 0,
+	0, 259, 0, 300, 183, 202, 141, 123, 135, 151,
+	182, 235, 268, 278, 0, 0, 0, 0, 0, 159,
+	0, 271, 243, 322, 0, 166, 0, 0, 270, 205,
+	311, 261, 320, 321, 184, 249, 0, 190, 209, 0,
+	329, 334, 291, 169, 0, 127, 0, 256, 163, 198,
+	0, 0, 0, 155, 0, 0, 0, 290, 309, 142,
+	306, 223, 229, 152, 154, 153, 136, 285, 308, 146,
+	157, 296, 274, 301, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 303, 319, 148, 282, 283,
+	335, 269, 130, 317, 299, 221, 195, 196, 129, 0,
+	266, 167, 179, 161, 238, 0, 178, 258, 314, 315,
+	160, 337, 138, 328, 132, 139, 327, 232, 0, 231,
+	330, 310, 318, 222, 214, 0, 131, 316, 220, 213,
+	200, 173, 186, 254, 208, 255, 187, 227, 226, 228,
+	211, 215, 0, 0, 0, 297, 325, 338, 144, 0,
+	284, 307, 0, 0, 145, 177, 172, 253, 230, 140,
+	189, 293, 199, 206, 265, 336, 242, 272, 149, 324,
+	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 294, 0, 0, 156, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 122, 133, 203, 0, 263,
+	176, 326, 0, 165, 0, 0, 124, 125, 134, 143,
+	150, 164, 171, 175, 181, 185, 188, 191, 192, 193,
+	197, 212, 216, 217, 218, 219, 234, 236, 237, 240,
+	241, 244, 247, 248, 251, 252, 257, 260, 262, 264,
+	267, 273, 275, 276, 277, 279, 280, 281, 286, 287,
+	288, 289, 298, 302, 312, 313, 323, 331, 333, 305,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	168, 0, 0, 0, 0, 201, 0, 0, 158, 210,
+	207, 0, 0, 0, 245, 304, 0, 0, 0, 0,
+	204, 0, 0, 0, 295, 224, 0, 0, 0, 0,
+	0, 0, 233, 246, 250, 170, 174, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 572, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 126, 0, 0, 0, 0, 180,
+	225, 137, 0, 0, 0, 194, 332, 0, 0, 0,
+	259, 0, 300, 183, 202, 141, 123, 135, 151, 182,
+	235, 268, 278, 0, 0, 0, 0, 0, 159, 0,
+	271, 243, 322, 0, 166, 0, 0, 270, 205, 311,
+	261, 320, 321, 184, 249, 0, 190, 209, 0, 329,
+	334, 291, 169, 0, 127, 0, 256, 163, 198, 0,
+	0, 0, 155, 0, 0, 0, 290, 309, 142, 306,
+	223, 229, 152, 154, 153, 136, 285, 308, 146, 157,
+	296, 274, 301, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 303, 319, 148, 282, 283, 335,
+	269, 130, 317, 299, 221, 195, 196, 129, 0, 266,
+	167, 179, 161, 238, 0, 178, 258, 314, 315, 160,
+	337, 138, 328, 132, 139, 327, 232, 0, 231, 330,
+	310, 318, 222, 214, 0, 131, 316, 220, 213, 200,
+	173, 186, 254, 208, 255, 187, 227, 226, 228, 211,
+	215, 0, 0, 0, 297, 325, 338, 144, 0, 284,
+	307, 0, 0, 145, 177, 172, 253, 230, 140, 189,
+	293, 199, 206, 265, 336, 242, 272, 149, 324, 292,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 294, 0, 0, 156, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 133, 203, 0, 263, 176,
+	326, 0, 165, 0, 0, 124, 125, 134, 143, 150,
+	164, 171, 175, 181, 185, 188, 191, 192, 193, 197,
+	212, 216, 217, 218, 219, 234, 236, 237, 240, 241,
+	244, 247, 248, 251, 252, 257, 260, 262, 264, 267,
+	273, 275, 276, 277, 279, 280, 281, 286, 287, 288,
+	289, 298, 302, 312, 313, 323, 331, 333, 305, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 239,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 168,
+	0, 0, 0, 0, 201, 0, 0, 158, 210, 207,
+	0, 0, 0, 245, 304, 0, 0, 0, 0, 204,
+	0, 0, 0, 295, 224, 0, 0, 0, 0, 0,
+	0, 233, 246, 250, 170, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 572, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 180, 225,
+	137, 0, 0, 0, 194, 332, 0, 0, 0, 259,
+	0, 300, 183, 202, 141, 123, 135, 151, 182, 235,
+	268, 278, 0, 0, 0, 0, 0, 159, 0, 271,
+	243, 322, 0, 166, 0, 0, 270, 205, 311, 261,
+	320, 321, 184, 249, 0, 190, 209, 0, 329, 334,
+	291, 169, 0, 127, 0, 256, 163, 198, 0, 0,
+	0, 155, 0, 0, 0, 290, 309, 142, 306, 223,
+	229, 152, 154, 153, 136, 285, 308, 146, 157, 296,
+	274, 301, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 303, 319, 148, 282, 283, 335, 269,
+	130, 317, 299, 221, 195, 196, 129, 0, 266, 167,
+	179, 161, 238, 0, 178, 258, 314, 315, 160, 337,
+	138, 328, 132, 568, 327, 232, 0, 231, 330, 310,
+	318, 222, 214, 0, 131, 316, 220, 213, 200, 173,
+	186, 254, 208, 255, 187, 227, 226, 228, 564, 215,
+	0, 0, 0, 297, 325, 338, 144, 0, 284, 307,
+	0, 0, 145, 177, 172, 253, 569, 567, 558, 559,
+	199, 206, 265, 336, 242, 272, 149, 324, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	294, 0, 0, 156, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 133, 203, 0, 263, 176, 326,
+	0, 165, 0, 0, 124, 125, 134, 143, 150, 164,
+	171, 175, 181, 185, 188, 191, 192, 193, 197, 212,
+	216, 217, 218, 219, 234, 236, 237, 240, 241, 244,
+	247, 248, 565, 566, 257, 260, 262, 264, 267, 273,
+	275, 276, 277, 279, 280, 281, 286, 287, 288, 289,
+	298, 302, 312, 313, 323, 331, 333, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 168, 0,
+	0, 0, 0, 201, 0, 0, 158, 210, 207, 0,
+	0, 0, 245, 304, 0, 0, 0, 0, 204, 0,
+	0, 0, 295, 224, 0, 0, 0, 0, 0, 0,
+	233, 246, 250, 170, 174, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	572, 0, 0, 0, 0, 0, 0, 0, 0, 147,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 126, 0, 0, 0, 0, 180, 225, 137,
+	0, 0, 0, 194, 332, 0, 0, 0, 259, 0,
+	300, 183, 202, 141, 123, 135, 151, 182, 235, 268,
+	278, 0, 0, 0, 0, 0, 159, 0, 271, 243,
+	322, 0, 166, 0, 0, 270, 205, 311, 261, 320,
+	321, 184, 249, 0, 190, 209, 0, 329, 334, 291,
+	169, 0, 127, 0, 256, 163, 198, 0, 0, 0,
+	155, 0, 0, 0, 290, 309, 142, 306, 223, 229,
+	152, 154, 153, 136, 285, 308, 146, 157, 296, 274,
+	301, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 303, 1025, 148, 282, 283, 335, 269, 130,
+	317, 299, 221, 195, 196, 129, 0, 266, 167, 179,
+	161, 238, 0, 178, 258, 314, 315, 160, 337, 138,
+	328, 132, 139, 327, 232, 0, 231, 330, 310, 318,
+	222, 214, 0, 131, 316, 220, 213, 200, 173, 186,
+	254, 208, 255, 187, 227, 226, 228, 211, 215, 0,
+	0, 0, 297, 325, 338, 144, 0, 284, 307, 0,
+	0, 145, 177, 172, 253, 230, 140, 189, 293, 199,
+	206, 265, 336, 242, 272, 149, 324, 292, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 294,
+	0, 0, 156, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 133, 203, 0, 263, 176, 326, 0,
+	165, 0, 0, 124, 125, 134, 143, 150, 164, 171,
+	175, 181, 185, 188, 191, 192, 193, 197, 212, 216,
+	217, 218, 219, 234, 236, 237, 240, 241, 244, 247,
+	248, 251, 252, 257, 260, 262, 264, 267, 273, 275,
+	276, 277, 279, 280, 281, 286, 287, 288, 289, 298,
+	302, 312, 313, 323, 331, 333, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 239, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 168, 0, 0,
+	0, 0, 201, 0, 0, 158, 210, 207, 0, 0,
+	0, 245, 304, 0, 0, 0, 0, 204, 0, 0,
+	0, 295, 224, 0, 0, 0, 0, 0, 0, 233,
+	246, 250, 170, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 572,
+	0, 0, 0, 0, 0, 0, 0, 0, 147, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 180, 225, 137, 0,
+	0, 0, 194, 332, 0, 0, 0, 259, 0, 300,
+	183, 202, 141, 123, 135, 151, 182, 235, 268, 278,
+	0, 0, 0, 0, 0, 159, 0, 271, 243, 322,
+	0, 166, 0, 0, 270, 205, 311, 261, 320, 321,
+	184, 249, 0, 190, 209, 0, 329, 334, 291, 169,
+	0, 127, 0, 256, 163, 198, 0, 0, 0, 155,
+	0, 0, 0, 290, 309, 142, 306, 223, 229, 152,
+	154, 153, 136, 285, 308, 146, 157, 296, 274, 301,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 303, 555, 148, 282, 283, 335, 269, 130, 317,
+	299, 221, 195, 196, 129, 0, 266, 167, 179, 161,
+	238, 0, 178, 258, 314, 315, 160, 337, 138, 328,
+	132, 568, 327, 232, 0, 231, 330, 310, 318, 222,
+	214, 0, 131, 316, 220, 213, 200, 173, 186, 254,
+	208, 255, 187, 227, 226, 228, 564, 215, 0, 0,
+	0, 297, 325, 338, 144, 0, 284, 307, 0, 0,
+	145, 177, 172, 253, 569, 567, 558, 559, 199, 206,
+	265, 336, 242, 272, 149, 324, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 294, 0,
+	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 122, 133, 203, 0, 263, 176, 326, 0, 165,
+	0, 0, 124, 125, 134, 143, 150, 164, 171, 175,
+	181, 185, 188, 191, 192, 193, 197, 212, 216, 217,
+	218, 219, 234, 236, 237, 240, 241, 244, 247, 248,
+	565, 566, 257, 260, 262, 264, 267, 273, 275, 276,
+	277, 279, 280, 281, 286, 287, 288, 289, 298, 302,
+	312, 313, 323, 331, 333, 34, 0, 70, 37, 38,
+	0, 0, 0, 34, 0, 70, 37, 38, 0, 61,
+	0, 0, 0, 0, 0, 76, 0, 61, 0, 39,
+	0, 0, 0, 76, 0, 0, 0, 39, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 0,
+	0, 0, 0, 0, 79, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2293, 0, 0, 0, 0, 2469, 0, 0, 2293, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	41, 72, 45, 44, 47, 0, 0, 0, 41, 72,
+	45, 44, 47, 0, 0, 0, 2294, 0, 0, 0,
+	0, 0, 0, 0, 2294, 0, 0, 0, 0, 0,
+	0, 0, 48, 75, 74, 0, 0, 0, 0, 46,
+	48, 75, 74, 0, 0, 0, 0, 46, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 59, 60, 0,
+	2295, 0, 0, 0, 0, 59, 60, 0, 2295, 0,
+	2296, 73, 0, 52, 53, 63, 0, 64, 2296, 73,
+	0, 52, 53, 63, 0, 64, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 71, 0, 0,
+	0, 0, 0, 0, 0, 71, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 77,
+}
+
+var yyPact = [...]int{
+	3707, -1000, -308, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1619, -1000, -1000, -1000, -1000, -1000, -1000,
+	672, 530, -1000, -1000, 293, 110, 26724, 292, 609, 28101,
+	-1000, -1000, -1000, 146, 239, 28101, -1000, -1000, -1000, 233,
+	346, 1140, 1486, 1139, 57, -45, -50, -1000, 1669, 1662,
+	-1000, -1000, 263, 63, -1000, -1000, -1000, 21674, 202, -1000,
+	-1000, -1000, 1552, 1611, 1385, -1000, 14789, 265, 265, 26265,
+	29937, -1000, 1665, 28101, 12032, -1000, 288, 28101, -137, 254,
+	254, 165, 291, -1000, 630, -1000, -1000, -1000, -1000, 28101,
+	255, 27642, 255, 255, 255, 255, 255, 28101, -1000, 492,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 28101,
+	1136, 1517, 735, 227, 8784, 8784, -1000, 723, -1000, 156,
+	152, 145, 149, 62, 754, -1000, 1649, 8784, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 224, 275, 274, 202, 619,
+	-1000, -1000, -1000, -1000, -1000, 1516, 1515, 870, 1508, 1007,
+	1506, 1309, -30, -1000, 1135, 28101, -1000, -1000, 1326, 1326,
+	1557, 1555, 1553, 290, 28101, -1000, -83, 1283, -1000, 1333,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 976, 1513, 751, 17543, 1434, -1000,
+	-1000, 738, 1648, -1000, 20297, 487, -1000, 17543, 3261, 1178,
+	691, -1000, 1178, -1000, -1000, -1000, 313, -1000, -1000, 19379,
+	19379, 19379, 19379, 19379, 19379, 19379, 19379, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178,
+	1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178,
+	1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178,
+	-1000, -1000, 1178, -1000, 14330, 1178, 1178, 1178, 1178, 1178,
+	1178, 1178, 1178, 1178, 1178, 1178, 1178, 17543, 1178, 1178,
+	1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178, 1178,
+	1178, 1178, 1178, 1178, 1178, 1178, 25806, 24429, 28101, 1243,
+	1240, -1000, -1000, 478, 1277, -110, 29478, -1000, -1000, -1000,
+	-1000, 28560, 24888, 651, -1000, -1000, -1000, -1000, 1505, -1000,
+	-1000, 423, -1000, -1000, 1619, -1000, -1000, 1134, 244, -1000,
+	3367, 23233, -1000, -1000, -1000, 1307, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 27642, 1550, 277, 1130, 684, 1129,
+	1128, 1127, 254, 1126, 1275, 272, 28101, 1539, 1331, 28101,
+	1125, 1123, 1122, 1121, -1000, 11568, -1000, 8784, 735, -1000,
+	952, 17543, 254, 254, 8784, 8784, 8784, 28101, 28101, 28101,
+	-1000, -1000, -1000, -1000, 28101, -1000, -1000, 735, 735, 8784,
+	8784, 671, 1644, 671, 671, -1000, -1000, -1000, -1000, 17543,
+	-1000, 19379, 28101, -1000, -1000, 1120, 222, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1107, 1007, 1007, -1000, 949, 1007, 1255,
+	-1000, 614, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1007, -1000, 17084, -306, -1000, -1000,
+	1266, -1000, 310, 1385, 1663, -1000, -1000, 202, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 28101, 8784, -82, 21674, 1178,
+	10176, -1000, -1000, -1000, 1655, 575, 1244, -1000, -1000, 1264,
+	-1000, 836, 337, 790, 1394, -1000, -1000, -1000, 28101, -1000,
+	17543, 17543, 885, -1000, 22133, -1000, -1000, -1000, -1000, 9712,
+	596, 19379, 740, 636, 19379, 19379, 19379, 19379, 19379, 19379,
+	19379, 19379, 19379, 19379, 19379, 19379, 19379, 19379, 19379, 19379,
+	858, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1101,
+	17543, -1000, 202, 961, 961, 506, 506, 506, 506, 506,
+	506, 506, 23051, 1546, 15707, 15707, 15707, 1546, 976, 976,
+	23510, 15707, 15707, 15707, 15707, 15707, 15707, 1546, 1546, 15707,
+	976, 976, 976, 976, 15707, 15707, 15707, 15707, 1546, 15707,
+	15707, 15707, 1546, 976, 1005, 742, 14330, 15707, 15707, 15707,
+	976, 17543, 17543, 16625, 16166, 18002, 15707, 15707, 1546, 668,
+	742, 28560, 15707, 15707, -1000, -1000, 18920, -1000, -1000, -1000,
+	-1000, -1000, 976, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 27642, 27642, 15707, 15707, 15707, 15707, 195, 28101, -1000,
+	1249, 1280, -1000, -1000, -1000, 1541, 23970, 1178, 1178, 19838,
+	-1000, 195, 1182, 24429, 28101, -1000, -1000, 24429, 28101, 9248,
+	29019, 1242, -1000, -77, -105, -110, -1000, -1000, 489, -1000,
+	-1000, -1000, 13870, 13411, -1000, 10640, 1552, 1385, 6464, 11104,
+	-1000, 23233, 1307, -1000, -38, -1000, -1000, -1000, 1291, -1000,
+	1291, 294, 23, 1291, 1291, 1291, 1291, 1291, 9, 9,
+	9, 9, 24, -1000, -1000, -1000, -1000, -1000, 1306, 1305,
+	-1000, 1291, 1291, 1291, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1300, 208, 1293, 1293, 1293, 1293, 1293, 231,
+	231, -1000, 17543, 1009, -1000, 28101, 8784, 1537, 8784, 148,
+	1304, 28101, -1000, 28101, 28101, 1263, -1000, 28101, 1262, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	742, 1093, 1080, -1000, -1000, -1000, -1000, -1000, -1000, 702,
+	-1000, -1000, -1000, -1000, 735, 28101, 28101, 28101, 1547, 735,
+	742, 1842, 270, -1000, -1000, 1075, -1000, 1255, 1255, -1000,
+	1255, 1007, 961, 1255, -1000, 1119, 1535, 939, 28101, -1000,
+	21674, -31, -1000, -109, 1546, 1326, 976, 709, -1000, -1000,
+	-1000, -1000, -1000, 173, 1087, 329, -1000, 1390, 751, 751,
+	17543, -1000, -1000, -1000, 10640, 1569, -1000, 1401, 1400, 1335,
+	-1000, -1000, 596, 717, -1000, -1000, 817, -1000, -1000, -1000,
+	-1000, 324, 1178, -1000, 4378, -1000, -1000, -1000, -1000, 740,
+	19379, 19379, 19379, 1842, 4378, 4329, 1177, 1145, 503, 506,
+	935, 935, 599, 599, 599, 599, 599, 835, 835, -1000,
+	-1000, -1000, 976, 742, -1000, -1000, -1000, 15707, 1105, -1000,
+	-1000, 742, 319, 1105, 1105, 15707, -164, -164, 1643, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 976, 1105, 1105, 1105, 976, 1105, 15707, 15707, 1105,
+	-164, -164, -164, -164, 1105, 1105, 1105, 1105, 15707, 1105,
+	1105, 1105, 15707, -1000, 17543, -1000, 976, 1105, 1105, 1105,
+	-1000, 699, 922, 676, 1642, 1105, 629, 1627, 1105, 2498,
+	19379, 19379, 19379, 1105, 1105, 15707, 669, -1000, 17543, 976,
+	1105, 1105, -1000, 2679, 1251, 1250, 1105, 976, 1245, 1105,
+	1105, 172, 1178, -1000, 10640, 24429, 24429, 24429, 24429, 24429,
+	-1000, 1352, 1346, -1000, 1355, 1348, 1361, 21674, 1112, 976,
+	138, 23970, 1541, 17543, -1000, 1178, 20756, -1000, 21215, 561,
+	1178, 1178, 286, 282, 280, 1625, 24429, 1167, -1000, 1167,
+	-1000, 312, -1000, -1000, 28560, -110, -117, -1000, -1000, 1242,
+	-1000, 889, -1000, -1000, 742, -1000, -1000, 742, -1000, 311,
+	1513, 1546, 287, 6000, -1000, -1000, -1000, -1000, 244, -1000,
+	-1000, -1000, 1301, 4949, -1000, 1437, 573, 640, 986, 1406,
+	-1000, -1000, 710, -53, -1000, -1000, 818, 9, 9, 1291,
+	1291, 201, 1291, -1000, 9, -1000, -1000, -1000, 489, 1499,
+	489, 489, 489, 489, 9, 938, 938, -1000, -1000, -1000,
+	-1000, 814, -1000, 1300, -1000, 809, -1000, -1000, -1000, -1000,
+	-1000, -1000, 840, 1330, 27642, 202, 1543, -1000, -1000, -1000,
+	1632, -1000, -1000, 269, -1000, 268, -1000, 8784, 28101, 8784,
+	8784, 1625, 1073, 1070, -1000, -1000, -1000, 671, 735, 1494,
+	-1000, -1000, 19379, 28101, -1000, -1000, -1000, -1000, 195, 273,
+	-1000, -1000, -86, -1000, -1000, 1394, -1000, -1000, 1215, -1000,
+	-1000, 712, 564, 673, 240, 240, -1000, 540, 240, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 191, 1542, 10176,
+	10176, 1388, -1000, -1000, -1000, 28101, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 8320, 15707, -1000, 1842, 4378,
+	3896, -1000, 19379, -1000, 1105, 15707, -164, 7856, -164, -164,
+	1105, -1000, 25347, -1000, 19379, -164, -164, -164, -164, -164,
+	-164, 1105, 1105, -164, -1000, -1000, -1000, -1000, -164, -164,
+	-164, -164, 1105, -164, -164, -164, 976, 742, -1000, -1000,
+	-1000, -1000, 507, 858, 507, 19379, 19379, -1000, 19379, 19379,
+	-1000, -1000, 19379, 3447, 3349, 2824, -219, -1000, 1233, 638,
+	-1000, 17543, 832, -1000, -1000, -1000, -1000, 19379, 19379, -1000,
+	-1000, -1000, -1000, 1329, 10640, 1178, -1000, 7392, 27642, 1235,
+	-1000, 612, 608, 1280, 1299, 1327, 1216, -1000, -1000, -1000,
+	-1000, 1344, -1000, 1342, -1000, -1000, 1286, -1000, -1000, 1208,
+	1178, 21674, 706, 27642, -1000, 19379, 561, -1000, 19379, 28560,
+	1178, 1178, 1178, 1619, 17543, 1167, -1000, -1000, 520, -1000,
+	-1000, -106, -120, -1000, -1000, -1000, 10176, -1000, -1000, 6464,
+	1610, -1000, 6464, -1000, 22592, 237, 234, -1000, 986, -1000,
+	-1000, 986, -1000, -1000, -1000, 1295, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 715, 19379, 1654, -1000, 1429, -1000, 1426,
+	933, -1000, -1000, 1163, 489, 489, 9, -1000, -1000, 1291,
+	-1000, 489, -1000, 563, -1000, -1000, -1000, -1000, 489, 1099,
+	-1000, 1097, 1202, -1000, 1089, 91, 28101, -1000, -1000, -1000,
+	1324, -1000, -1000, -1000, 1158, 1172, -1000, 3367, 1068, 1063,
+	1057, 28101, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 735,
+	-1000, 19379, 4378, -1000, 9, 28101, -1000, 1335, 709, -1000,
+	278, -1000, 986, 580, -1000, -1000, 238, -1000, 1406, -1000,
+	206, 1054, -1000, 24152, 23929, 27642, 1419, 1418, 1008, 28101,
+	27642, -1000, -1000, 23692, 1006, 17543, -1000, 27642, 27642, 1178,
+	309, -1000, -1000, -1000, 1160, 14789, -1000, -1000, 976, -1000,
+	19379, 4378, -164, -1000, -1000, -1000, -1000, 308, -1000, -1000,
+	-164, -1000, 375, 2679, -1000, -1000, -1000, -1000, -1000, -1000,
+	-164, -164, -1000, -1000, -1000, -1000, -1000, -164, -1000, -1000,
+	-1000, -1000, 976, 1291, 1291, -1000, 1291, 1293, -1000, 1291,
+	46, 1291, 43, 976, 976, 4067, 3576, 3412, 3145, 2679,
+	19379, 19379, 19379, 1178, -148, -1000, 742, 17543, 3099, 2916,
+	-1000, 1528, 1161, 1171, -1000, -1000, 15248, 976, 1087, -1000,
+	21674, 1084, -1000, 1619, 10640, 17543, 17543, -1000, -1000, 17543,
+	1292, -1000, 17543, -1000, -1000, -1000, -1000, 27642, 137, -1000,
+	17543, 1286, 932, 1084, 3125, -1000, 2725, 1594, 27642, 27642,
+	27642, 1552, 742, -1000, -1000, -1000, -1000, 6000, 242, -1000,
+	1051, -1000, 1291, 1291, 1408, -1000, 1406, -1000, -1000, -1000,
+	27642, -1000, 4378, -60, -1000, -1000, -1000, -1000, -1000, -1000,
+	489, -1000, -1000, -1000, -1000, -1000, 9, 929, 9, 805,
+	-1000, 780, -1000, -1000, -257, 1290, -1000, 202, 28101, 150,
+	269, -1000, 3367, 3367, 3367, -1000, -1000, 4378, -65, -1000,
+	-1000, -1000, 23692, 220, 3367, 3367, -1000, 1009, 573, 1405,
+	234, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 998, -1000,
+	604, -1000, 267, 220, 23692, 742, 415, 1530, -1000, 10176,
+	1623, 24429, -1000, -1000, -1000, 4378, -1000, 6928, -1000, -180,
+	1609, -1000, -1000, -1000, -1000, -1000, -1000, 189, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 19379, 19379, 19379, 19379,
+	-1000, 2679, 2679, 2679, 19379, 976, 926, 742, 19379, 19379,
+	1404, -1000, 1178, -1000, -1000, 167, -1000, 27642, 1552, -1000,
+	742, 742, 742, 27642, 742, 1048, -1000, 1178, -1000, -56,
+	20756, -1000, 21674, 28560, 28560, 1025, 1025, 1025, -1000, 154,
+	1178, 1178, 1178, 361, 1178, 484, 22592, 1534, 1534, -1000,
+	1046, -1000, -1000, 489, -1000, 489, 1156, 1142, -1000, 22592,
+	-1000, 1587, -1000, 150, -1000, 905, 113, 118, -1000, 112,
+	111, 109, 108, 100, -1000, -1000, -1000, -1000, 1477, 1471,
+	1230, 1034, -1000, -1000, 996, -1000, -1000, 1289, 986, -1000,
+	1287, -1000, 970, -1000, -1000, 27642, -1000, 220, 1526, 1520,
+	1178, -1000, 1621, 1608, 1167, 14789, -1000, -1000, 976, 86,
+	-1000, -1000, 17543, -1000, -1000, 2679, 2679, 2679, 2679, -1000,
+	-1000, -1000, 104, -1000, -1000, 2679, 2679, 1653, -1000, 1178,
+	-1000, 202, -1000, -1000, 1044, -1000, 27642, -1000, 1178, -1000,
+	561, 533, 531, -1000, -1000, -1000, 1178, 771, 19379, 19379,
+	19379, 1178, 1178, 27642, 484, -1000, 260, 540, 902, -1000,
+	-1000, -1000, 211, -1000, -1000, -1000, -1000, 1040, -1000, 132,
+	30405, -1000, -1000, -1000, -1000, -1000, -1000, 1484, 1483, 125,
+	257, 1442, 1444, 1607, 24429, -1000, -1000, 710, 710, 22592,
+	1009, 22592, -1000, -1000, -1000, 19379, -1000, 184, -1000, 17543,
+	12952, 1623, -1000, -1000, -1000, 87, -255, -256, 135, 1199,
+	-1000, -1000, -1000, -1000, 976, 144, -229, -1000, -1000, 10640,
+	1171, 976, -1000, -1000, 27183, -1000, 1178, 1178, -1000, 184,
+	-1000, 2679, 2679, 2679, 19379, 27642, 1025, -1000, -1000, -1000,
+	763, -1000, 28101, 484, 127, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 17543, 17543, 5536, 30405, -1000, -1000, -1000,
+	-1000, 1286, 707, 1471, 1606, 1456, 1446, -1000, 900, 1167,
+	1037, 1281, 1033, 4378, 1030, -1000, 27642, -1000, 742, 107,
+	-1000, 742, 1178, 1178, 182, 1621, -1000, 686, -1000, -1000,
+	-1000, -1000, -1000, -180, -1000, 1378, -223, -233, 1162, -1000,
+	975, -1000, 2837, 798, 17543, 17543, 1030, -1000, -1000, -1000,
+	2679, 1025, -1000, -1000, 1217, -1000, 484, 131, -1000, 733,
+	810, 79, 73, 2620, -1000, -1000, -1000, -1000, -309, -1000,
+	-1000, 1473, -1000, 897, -1000, 1604, 1603, -1000, 1623, 484,
+	22592, 484, -1000, 184, 1397, -169, 12952, 17543, 17543, 1178,
+	-1000, 87, 976, -1000, 1370, -1000, 976, 27183, 1358, 1410,
+	-57, 874, 1023, -1000, 20297, 1023, -1000, -1000, -1000, 27642,
+	-1000, 959, 955, 868, -1000, 17543, 30405, 1577, 1573, 1570,
+	1519, 10176, 30397, -1000, -1000, 871, 867, 1621, -1000, 1021,
+	-1000, -1000, 247, -1000, -1000, 1015, -1000, 742, 12493, 1015,
+	17543, -1000, -1000, -225, 1541, -1000, -1000, 859, 1365, 1178,
+	-58, 976, 17543, -1000, 976, 1018, 307, -1000, -1000, 679,
+	30405, -1000, 769, -310, 236, 202, 225, 19379, -1000, -1000,
+	-1000, -1000, -1000, -1000, 484, 170, 1178, -1000, 17543, -1000,
+	-1000, 1015, -230, 21674, 853, 848, 27183, 1178, -1000, -1000,
+	-1000, 1321, -1000, -1000, -1000, -1000, -1000, -1000, 30405, -1000,
+	-312, 30405, 3182, -1000, -1000, -1000, 4983, -1000, -1000, -1000,
+	-1000, 71, -1000, -1000, 4378, -1000, 379, 17543, -1000, -1000,
+	-236, -1000, -1000, 1320, -1000, -1000, 741, -1000, 975, 27183,
+	1319, 1316, 1634, -1000, -314, 4808, -318, 252, 30405, 781,
+	-1000, 17543, -1000, 225, -1000, 18461, -1000, 1005, -1000, 1374,
+	-1000, -1000, 975, -1000, 1652, -1000, 1635, 449, 449, 4730,
+	641, 30405, -1000, -320, 251, 30405, -1000, 708, -1000, 2679,
+	976, -1000, 853, -1000, -1000, -1000, -1000, -1000, 218, 783,
+	-1000, -1000, -1000, 4465, -1000, -321, 30405, -1000, -1000, 1315,
+	-1000, -1000, -1000, 248, 3907, -323, 1357, -1000, 246, 30405,
+	-1000, -1000,
+}
+
+var yyPgo = [...]int{
+	0, 2012, 2009, 62, 2008, 165, 2007, 138, 2005, 2003,
+	23, 19, 17, 25, 2000, 1746, 1744, 1742, 1737, 1999,
+	1731, 1998, 47, 1996, 1995, 1729, 1994, 1988, 1727, 1724,
+	1721, 1717, 1987, 1986, 10, 1985, 22, 1984, 5, 130,
+	144, 1983, 3, 1982, 1981, 13, 1980, 1979, 1715, 1978,
+	1976, 1975, 1960, 84, 1955, 1706, 1702, 1954, 1951, 1700,
+	1689, 1950, 1949, 1687, 1685, 1683, 1947, 159, 1946, 1944,
+	142, 1943, 184, 91, 134, 1940, 1930, 1928, 1927, 95,
+	70, 1771, 98, 49, 103, 2968, 1926, 37, 12, 65,
+	1925, 1924, 136, 124, 1921, 157, 1920, 86, 247, 92,
+	1917, 1916, 146, 1915, 1912, 1911, 122, 1907, 1904, 76,
+	1903, 1900, 139, 1899, 80, 1898, 7, 33, 1897, 2,
+	39, 15, 50, 56, 1896, 1895, 1894, 1893, 1892, 128,
+	105, 1891, 1889, 127, 1888, 82, 1887, 1886, 177, 1885,
+	1884, 1883, 125, 8, 1882, 31, 45, 1881, 58, 69,
+	1879, 60, 1878, 121, 1877, 1875, 35, 21, 1873, 55,
+	1872, 61, 1870, 120, 349, 290, 40, 29, 1867, 11,
+	59, 68, 57, 116, 93, 32, 46, 104, 90, 78,
+	44, 1853, 137, 1850, 77, 135, 126, 118, 133, 1846,
+	1844, 1842, 988, 1841, 1838, 117, 1837, 85, 113, 914,
+	143, 100, 1835, 88, 1834, 1833, 1831, 1830, 28, 114,
+	1828, 1827, 96, 293, 64, 1824, 30, 2223, 53, 140,
+	27, 43, 1826, 1825, 3411, 102, 16, 94, 1823, 97,
+	36, 51, 1821, 1820, 1819, 1813, 1812, 1811, 1610, 1810,
+	1809, 1808, 1807, 81, 110, 1806, 1802, 112, 99, 1799,
+	1798, 1797, 1794, 1793, 115, 71, 131, 1792, 109, 111,
+	101, 1789, 1787, 1786, 1785, 54, 52, 1784, 1783, 1781,
+	87, 89, 1780, 66, 48, 38, 72, 14, 83, 119,
+	42, 34, 1779, 1777, 1776, 1775, 1773, 106, 4, 6,
+	9, 1772, 1770, 1769, 1766, 1765, 1758, 75, 1755, 1753,
+	67, 1752, 1749, 1748, 41, 1739, 1735, 1708, 129, 107,
+	1704, 1696, 0, 205, 145, 1693, 1681, 169,
+}
+
+var yyR1 = [...]int{
+	0, 310, 311, 311, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 66, 3, 3, 9, 4,
+	4, 4, 4, 5, 5, 90, 91, 91, 95, 95,
+	6, 6, 7, 7, 8, 8, 10, 10, 71, 71,
+	11, 12, 12, 12, 12, 314, 314, 104, 104, 102,
+	102, 103, 103, 177, 177, 13, 13, 13, 182, 182,
+	187, 187, 187, 190, 190, 190, 190, 169, 170, 15,
+	15, 15, 15, 15, 15, 15, 15, 15, 15, 2,
+	295, 295, 296, 296, 297, 297, 297, 297, 298, 298,
+	299, 299, 300, 300, 300, 300, 300, 300, 300, 300,
+	300, 300, 26, 211, 211, 46, 46, 47, 47, 47,
+	162, 162, 162, 14, 14, 14, 14, 14, 21, 23,
+	23, 35, 35, 36, 24, 24, 24, 24, 37, 37,
+	38, 27, 27, 27, 27, 27, 27, 44, 44, 44,
+	43, 43, 42, 42, 42, 42, 42, 42, 25, 25,
+	25, 25, 45, 45, 40, 40, 39, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 28,
+	28, 28, 28, 28, 28, 20, 294, 294, 294, 34,
+	34, 22, 22, 22, 22, 22, 22, 22, 22, 22,
+	22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
+	22, 22, 22, 22, 22, 22, 22, 22, 51, 267,
+	268, 268, 268, 268, 268, 268, 268, 268, 255, 255,
+	256, 231, 231, 231, 231, 231, 231, 231, 230, 230,
+	230, 230, 234, 234, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 233, 233, 233, 233, 233, 233, 233,
+	233, 235, 235, 235, 235, 235, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 237, 237, 237, 237, 237, 237, 237, 237, 254,
+	254, 238, 238, 247, 247, 248, 248, 248, 245, 245,
+	246, 246, 249, 249, 241, 242, 250, 243, 243, 243,
+	244, 244, 244, 253, 253, 253, 253, 239, 257, 257,
+	277, 277, 276, 276, 275, 275, 275, 275, 266, 266,
+	272, 272, 272, 272, 272, 272, 261, 261, 261, 260,
+	260, 262, 262, 265, 265, 274, 274, 273, 273, 258,
+	258, 258, 278, 278, 278, 278, 278, 259, 259, 259,
+	259, 279, 263, 263, 264, 264, 264, 289, 290, 288,
+	288, 288, 288, 288, 126, 126, 126, 269, 269, 269,
+	270, 270, 270, 271, 271, 271, 292, 292, 291, 291,
+	48, 48, 49, 54, 54, 53, 53, 53, 53, 53,
+	53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	53, 53, 161, 161, 161, 50, 209, 209, 287, 287,
+	286, 280, 280, 281, 281, 281, 281, 281, 282, 282,
+	283, 283, 283, 283, 283, 283, 285, 285, 284, 284,
+	16, 52, 52, 17, 17, 17, 17, 17, 17, 17,
+	18, 18, 55, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 86, 196, 196, 194, 194, 197, 197,
+	195, 195, 195, 198, 198, 198, 228, 228, 228, 57,
+	57, 62, 62, 65, 65, 65, 63, 64, 29, 30,
+	30, 30, 30, 31, 210, 210, 60, 60, 61, 61,
+	61, 61, 77, 77, 315, 315, 59, 59, 58, 58,
+	19, 316, 67, 68, 68, 69, 69, 69, 69, 69,
+	69, 70, 70, 70, 201, 201, 74, 74, 74, 72,
+	72, 72, 73, 73, 80, 80, 84, 84, 84, 84,
+	83, 83, 83, 83, 164, 164, 164, 166, 166, 166,
+	168, 168, 167, 167, 167, 167, 167, 165, 165, 220,
+	220, 220, 219, 219, 219, 219, 89, 89, 92, 92,
+	93, 93, 93, 93, 93, 93, 116, 116, 117, 117,
+	117, 117, 117, 118, 118, 118, 118, 119, 119, 119,
+	96, 147, 147, 113, 113, 113, 115, 115, 120, 120,
+	121, 114, 114, 114, 114, 114, 132, 132, 176, 176,
+	175, 175, 178, 178, 94, 94, 94, 94, 99, 99,
+	100, 100, 101, 101, 208, 208, 226, 226, 226, 105,
+	105, 105, 107, 106, 106, 106, 106, 108, 108, 110,
+	111, 111, 109, 109, 112, 122, 122, 122, 122, 123,
+	123, 85, 85, 85, 85, 85, 85, 85, 85, 193,
+	193, 125, 125, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 141, 141, 141, 141, 141, 141, 127,
+	127, 127, 127, 127, 127, 127, 79, 79, 142, 142,
+	142, 98, 97, 97, 82, 82, 81, 81, 143, 143,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 136, 136, 140, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 78, 78, 78,
+	78, 78, 78, 78, 78, 78, 78, 78, 78, 317,
+	317, 138, 137, 137, 137, 137, 137, 137, 75, 75,
+	75, 75, 75, 227, 227, 229, 229, 229, 229, 229,
+	229, 229, 229, 229, 229, 229, 229, 229, 154, 154,
+	76, 76, 152, 152, 153, 155, 155, 151, 151, 151,
+	129, 129, 129, 129, 129, 129, 129, 129, 131, 131,
+	131, 156, 156, 156, 144, 144, 87, 87, 87, 87,
+	145, 145, 88, 88, 157, 157, 158, 159, 159, 160,
+	160, 163, 171, 171, 171, 172, 172, 172, 172, 133,
+	133, 173, 173, 173, 128, 128, 128, 128, 128, 128,
+	174, 174, 174, 174, 179, 179, 146, 146, 149, 149,
+	148, 150, 180, 180, 184, 184, 181, 181, 185, 185,
+	185, 185, 188, 188, 189, 189, 189, 186, 186, 186,
+	186, 186, 186, 183, 183, 183, 223, 223, 223, 191,
+	191, 202, 202, 199, 199, 200, 200, 192, 192, 240,
+	240, 205, 205, 205, 205, 205, 205, 205, 205, 207,
+	207, 206, 206, 206, 203, 203, 203, 204, 204, 221,
+	221, 217, 217, 222, 222, 218, 218, 224, 224, 225,
+	225, 293, 293, 251, 251, 303, 303, 252, 252, 304,
+	304, 306, 306, 301, 301, 302, 302, 305, 305, 32,
+	307, 307, 308, 308, 309, 309, 309, 309, 33, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 216, 216, 216, 216, 216, 216, 216, 216,
+	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
+	216, 216, 216, 216, 216, 216, 216, 216, 216, 216,
+	216, 216, 216, 312, 313, 212, 213, 213, 213,
+}
+
+var yyR2 = [...]int{
+	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 0, 11, 4, 7, 5, 1,
+	13, 3, 3, 10, 12, 2, 1, 3, 3, 6,
+	1, 3, 1, 3, 1, 3, 7, 8, 1, 1,
+	9, 8, 7, 6, 6, 1, 1, 1, 3, 1,
+	3, 1, 3, 0, 4, 3, 4, 5, 1, 3,
+	3, 2, 2, 2, 2, 2, 1, 0, 0, 2,
+	3, 3, 11, 7, 9, 5, 5, 15, 11, 4,
+	0, 1, 1, 3, 2, 3, 3, 3, 0, 1,
+	1, 2, 2, 2, 2, 1, 2, 2, 3, 3,
+	3, 3, 4, 0, 3, 1, 1, 1, 1, 1,
+	0, 2, 2, 1, 1, 1, 1, 1, 4, 5,
+	8, 1, 2, 5, 7, 10, 8, 11, 1, 2,
+	5, 5, 5, 5, 6, 3, 5, 1, 1, 1,
+	1, 3, 1, 1, 1, 2, 1, 1, 2, 4,
+	2, 4, 2, 3, 1, 3, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 4, 3, 2, 4, 3, 0, 2, 3, 1,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 5, 5,
+	1, 1, 2, 3, 4, 3, 3, 3, 3, 3,
+	3, 0, 2, 2, 2, 2, 2, 2, 3, 1,
+	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 3, 2, 2, 2, 2,
+	2, 1, 2, 2, 2, 1, 4, 4, 3, 3,
+	2, 4, 5, 2, 3, 4, 2, 2, 3, 3,
+	3, 3, 3, 4, 1, 1, 1, 1, 1, 6,
+	6, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 0, 3, 0, 5, 0, 3, 5, 0, 1,
+	0, 1, 1, 2, 2, 3, 1, 0, 3, 3,
+	0, 2, 2, 2, 1, 2, 1, 2, 5, 4,
+	0, 1, 1, 2, 2, 2, 3, 2, 0, 1,
+	2, 4, 3, 3, 2, 2, 1, 1, 1, 1,
+	1, 0, 1, 0, 1, 1, 3, 3, 3, 3,
+	3, 1, 10, 11, 11, 12, 12, 3, 3, 2,
+	1, 5, 1, 1, 0, 2, 2, 3, 3, 1,
+	1, 2, 2, 2, 0, 1, 2, 0, 1, 3,
+	1, 2, 3, 1, 1, 1, 0, 2, 0, 2,
+	1, 1, 5, 1, 3, 2, 5, 4, 3, 3,
+	3, 5, 3, 8, 10, 3, 3, 3, 3, 3,
+	5, 4, 5, 1, 2, 2, 4, 3, 6, 5,
+	3, 8, 0, 1, 2, 4, 0, 1, 1, 1,
+	7, 1, 3, 8, 8, 6, 7, 2, 0, 5,
+	4, 4, 4, 5, 4, 5, 0, 2, 0, 3,
+	3, 3, 5, 4, 6, 4, 4, 4, 4, 4,
+	3, 2, 3, 4, 4, 3, 6, 6, 4, 4,
+	4, 4, 4, 4, 3, 3, 2, 6, 2, 4,
+	4, 4, 5, 7, 6, 5, 4, 2, 4, 3,
+	2, 6, 3, 3, 1, 1, 0, 1, 1, 1,
+	0, 2, 2, 0, 2, 2, 0, 1, 1, 2,
+	1, 1, 1, 2, 4, 4, 1, 1, 2, 3,
+	4, 4, 5, 3, 1, 1, 3, 3, 1, 1,
+	1, 1, 0, 3, 1, 1, 2, 2, 2, 2,
+	2, 0, 2, 0, 2, 1, 2, 2, 1, 2,
+	2, 1, 2, 2, 0, 1, 0, 1, 1, 0,
+	1, 1, 0, 1, 3, 5, 1, 1, 3, 5,
+	1, 2, 3, 5, 2, 5, 8, 0, 2, 5,
+	1, 1, 2, 2, 2, 2, 2, 0, 1, 0,
+	1, 2, 1, 1, 1, 1, 1, 3, 1, 1,
+	1, 4, 1, 3, 5, 12, 1, 3, 3, 5,
+	5, 6, 7, 0, 3, 3, 6, 1, 1, 2,
+	2, 2, 4, 2, 6, 3, 10, 10, 1, 3,
+	2, 1, 4, 6, 3, 2, 0, 3, 0, 3,
+	1, 3, 1, 3, 4, 4, 4, 3, 2, 4,
+	0, 1, 0, 2, 0, 1, 1, 1, 1, 1,
+	2, 2, 1, 2, 3, 2, 3, 2, 2, 3,
+	2, 1, 1, 3, 3, 0, 5, 5, 5, 0,
+	2, 1, 3, 3, 2, 3, 1, 2, 3, 0,
+	3, 1, 1, 3, 3, 4, 4, 5, 3, 4,
+	5, 6, 2, 1, 2, 1, 2, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 0, 2, 1, 1,
+	1, 3, 1, 3, 0, 1, 1, 3, 1, 3,
+	1, 1, 1, 1, 1, 1, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 2, 2, 2, 2, 2, 2, 2, 3,
+	1, 1, 1, 1, 1, 1, 5, 6, 6, 6,
+	5, 5, 5, 6, 5, 5, 6, 5, 5, 5,
+	5, 6, 5, 5, 5, 4, 4, 5, 5, 5,
+	5, 5, 4, 4, 4, 4, 4, 4, 4, 3,
+	6, 6, 6, 8, 8, 8, 8, 4, 6, 7,
+	7, 7, 9, 4, 7, 5, 4, 6, 4, 4,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 8, 8, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 3, 4, 4, 4, 4, 4, 4, 0, 3,
+	4, 7, 3, 1, 1, 2, 3, 3, 1, 2,
+	2, 1, 2, 1, 2, 2, 1, 2, 0, 1,
+	0, 2, 1, 2, 4, 0, 2, 1, 3, 5,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 0, 3, 5, 1, 3, 1, 4, 4, 5,
+	1, 3, 1, 2, 0, 2, 1, 0, 3, 1,
+	3, 2, 0, 1, 1, 0, 2, 4, 4, 1,
+	1, 0, 2, 4, 2, 1, 3, 5, 4, 6,
+	1, 3, 3, 5, 0, 5, 1, 3, 1, 2,
+	3, 1, 1, 3, 3, 3, 1, 3, 1, 2,
+	2, 3, 1, 1, 1, 1, 1, 3, 3, 3,
+	3, 3, 3, 1, 2, 1, 1, 1, 1, 1,
+	1, 0, 1, 0, 2, 0, 3, 0, 1, 0,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 1, 1, 1, 1, 1, 0, 1, 0,
+	2, 1, 1, 1, 3, 1, 1, 1, 1, 1,
+	1, 0, 2, 0, 1, 0, 4, 0, 1, 0,
+	3, 0, 3, 0, 4, 0, 3, 0, 3, 3,
+	1, 3, 2, 4, 1, 2, 1, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 0, 1, 1,
+}
+
+var yyChk = [...]int{
+	-1000, -310, -1, -3, -9, -10, -11, -12, -13, -15,
+	-48, -16, -17, -18, -55, -56, -57, -62, -63, -64,
+	-60, -59, -58, -19, -25, -28, -20, -66, -29, -30,
+	-31, -32, -33, -4, 8, 9, -71, 11, 12, 32,
+	-51, 143, -49, -50, 146, 145, 182, 147, 175, 75,
+	243, -65, 246, 247, -315, -210, 180, 181, 149, 230,
+	231, 22, 39, 248, 250, 33, 34, -5, -90, -6,
+	10, 340, 144, 244, 177, 176, 28, 407, -312, 79,
+	-311, 470, -159, 17, -67, -316, -67, -67, -67, -67,
+	-67, -267, -208, 117, 79, 25, -204, 156, 98, 331,
+	152, -211, -202, -203, 207, 217, 46, 163, 162, 153,
+	153, 154, 156, 331, 152, 191, 190, 153, -109, -224,
+	82, -215, 404, 166, 415, 416, 144, 204, 243, 257,
+	251, 285, 273, 405, 417, 167, 225, 151, 271, 274,
+	318, 165, 218, 418, 307, 313, 228, 91, 246, 327,
+	419, 168, 222, 224, 223, 212, 374, 229, 38, 178,
+	269, 262, 233, 207, 420, 412, 184, 260, 30, 202,
+	65, 421, 315, 290, 66, 422, 409, 314, 265, 261,
+	149, 423, 169, 163, 193, 424, 291, 295, 425, 319,
+	196, 426, 427, 428, 155, 255, 256, 429, 208, 321,
+	289, 35, 164, 406, 50, 188, 322, 40, 293, 197,
+	39, 299, 430, 288, 283, 300, 431, 432, 433, 434,
+	287, 254, 282, 220, 55, 150, 297, 296, 298, 221,
+	317, 278, 276, 62, 435, 170, 436, 437, 263, 20,
+	438, 439, 325, 181, 440, 44, 63, 441, 442, 194,
+	64, 443, 444, 316, 292, 294, 206, 445, 266, 160,
+	446, 190, 447, 408, 448, 323, 259, 449, 171, 250,
+	187, 180, 326, 450, 231, 451, 452, 453, 172, 454,
+	455, 456, 247, 248, 309, 226, 457, 458, 459, 460,
+	216, 201, 329, 320, 371, 54, 230, 304, 461, 253,
+	162, 232, 462, 244, 45, 9, 219, 310, 227, 217,
+	280, 189, 463, 464, 267, 268, 286, 252, 281, 245,
+	191, 192, 182, 465, 328, 305, 410, 275, 272, 199,
+	279, 466, 156, 467, 200, 249, 324, 270, 306, 153,
+	136, 274, 318, 143, 308, 309, 315, -261, 316, 190,
+	193, -228, 153, -194, 307, 200, 184, 82, 154, 155,
+	35, 320, 319, 311, -224, 157, 249, -77, 147, 183,
+	-109, -212, -212, -212, -212, -45, 82, 232, -45, 32,
+	82, 82, 38, 82, 248, 310, 310, 8, -69, -70,
+	5, 6, 7, -192, 156, 158, 245, -91, -95, -226,
+	-224, -216, 84, 375, 376, 377, 378, 379, 392, 393,
+	353, 394, 380, 381, 395, 396, 397, 382, 383, 398,
+	399, 401, 402, 400, 198, 386, 385, 384, 387, 388,
+	53, 390, 389, 391, -3, -172, 19, 18, -74, 56,
+	57, -68, -83, 126, -85, -224, -124, 100, -130, 31,
+	82, -215, 26, -129, -216, -125, -151, -150, -98, 136,
+	137, 138, 124, 125, 133, 101, 139, -136, -134, -135,
+	-137, -139, -140, 84, 83, 92, 85, 86, 87, 88,
+	93, 375, 376, 377, 378, 379, 392, 393, 353, 394,
+	380, 381, 395, 396, 397, 382, 383, 398, 399, 401,
+	402, 400, 386, 385, 384, 387, 388, 390, 389, 391,
+	94, 95, -217, -148, -312, 69, 367, 70, 152, 341,
+	342, 343, 344, 345, 403, 173, 349, 103, 49, 183,
+	10, 330, 339, 338, 337, 335, 336, 332, 334, 333,
+	351, 352, 159, 331, 130, 340, -192, -192, 13, -102,
+	-103, -109, -112, -224, -181, 245, -188, -185, 319, 320,
+	-186, -189, -183, -151, 299, 443, 444, 318, 274, 317,
+	-217, -224, 82, -2, -5, 8, -109, -268, -256, -259,
+	-218, 168, -279, -217, -214, 165, 82, -215, 148, 174,
+	99, 411, 25, 27, 302, 375, 102, 136, 376, 377,
+	378, 18, 22, 103, 135, 341, 379, 143, 73, 370,
+	332, 334, 330, 331, 308, 31, 12, 28, 176, 211,
+	24, 128, 145, 106, 107, 108, 179, 6, 26, 177,
+	353, 95, 173, 21, 76, 13, 15, 367, 368, 16,
+	159, 158, 119, 209, 154, 71, 10, 7, 139, 29,
+	116, 67, 380, 381, 61, 47, 60, 69, 117, 19,
+	195, 335, 336, 33, 403, 382, 185, 413, 383, 130,
+	214, 74, 52, 100, 93, 36, 96, 77, 98, 17,
+	210, 72, 365, 58, 373, 213, 414, 118, 146, 340,
+	70, 369, 372, 152, 8, 350, 32, 175, 386, 385,
+	384, 387, 215, 68, 343, 344, 388, 468, 153, 105,
+	351, 352, 157, 94, 5, 46, 59, 34, 11, 75,
+	78, 337, 338, 339, 49, 53, 390, 389, 391, 104,
+	14, 366, 407, 198, 154, -109, 340, -200, 159, -200,
+	191, 190, 153, 109, -52, -109, -199, 159, -217, -199,
+	-199, -199, -199, -199, -109, 140, -109, 82, 32, -198,
+	117, 14, 331, 152, 82, 190, 193, 153, 191, 156,
+	-213, -312, -218, -213, -263, 13, 119, 198, 198, 198,
+	199, 198, -197, -196, 192, 313, 314, 310, 312, 14,
+	-86, 117, 13, -213, 82, 248, 157, -61, -3, -12,
+	-10, -11, -3, 109, 32, 32, 84, 53, 32, -40,
+	-39, -41, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 228, 229, 32, -294, 79, -251, 299, 82,
+	-307, -308, -109, -67, -8, -7, -5, -312, -7, 23,
+	24, 23, 24, 23, 24, 153, -109, 323, 80, 25,
+	-312, -313, 81, -173, 21, 33, -133, 85, 88, -160,
+	-163, -85, -224, 52, -72, 23, 24, 90, 13, -220,
+	99, 98, 116, -219, 25, 82, -215, -216, 84, 140,
+	-85, -127, 119, 100, 117, 118, 102, 121, 120, 131,
+	132, 124, 125, 126, 127, 128, 129, 130, 122, 123,
+	135, 109, 110, 111, 112, 113, 114, 115, -193, -312,
+	97, -98, -312, 141, 142, -130, -130, -130, -130, -130,
+	-130, -130, -130, -312, -312, -312, -312, -312, -312, -312,
+	-312, -312, -312, -312, -312, -312, -312, -312, -312, -312,
+	-312, -312, -312, -312, -312, -312, -312, -312, -312, -312,
+	-312, -312, -312, -3, -143, -85, -312, -312, -312, -312,
+	-312, -312, -312, -312, -312, -312, -312, -312, -312, -154,
+	-85, -312, -312, -312, -317, -138, -312, -317, -138, -317,
+	-138, -317, -312, -317, -138, -317, -138, -317, -317, -138,
+	-317, -312, -312, -312, -312, -312, -312, -111, 29, -109,
+	-89, -92, -93, -94, -113, -97, -312, 60, 61, -109,
+	-98, -109, -102, -314, 80, 13, 78, -314, 80, 140,
+	80, -182, -187, 321, 323, 245, -186, -186, -223, -217,
+	84, 31, 109, 97, 32, 140, -159, -67, 81, 80,
+	-259, 168, 165, -230, -234, -236, -235, -237, -232, -233,
+	271, 274, 278, 276, 272, 277, 136, 275, 280, 281,
+	282, 283, 284, 285, 286, 287, 288, 289, 290, 32,
+	178, 267, 268, 269, 270, 291, 292, 293, 294, 295,
+	296, 297, 298, 251, 273, 405, 252, 253, 254, 255,
+	256, 257, 259, 260, 261, 262, 263, 264, 265, 82,
+	-216, -279, 79, -217, 25, 156, 82, 100, 82, 82,
+	82, -200, 82, 80, 157, -102, 26, 77, -104, -109,
+	82, 82, 82, 82, -225, -224, -214, -213, -198, 84,
+	-85, -200, -200, -213, -213, -213, -109, -109, -109, -109,
+	-198, -198, -213, -213, -195, 13, 119, 13, -195, -195,
+	-85, -130, -109, 82, 82, 248, 82, -40, -40, 84,
+	-40, 80, 109, -40, 81, -143, -293, 469, 80, -309,
+	25, 323, 324, 300, -74, -70, -3, -109, -213, 324,
+	325, -95, -97, -312, -174, -218, 11, 119, 80, 20,
+	80, -171, 27, 28, 140, -131, 53, 85, 88, -201,
+	51, -109, -85, -85, -141, 93, 100, 94, 95, -219,
+	126, -225, -218, -214, -130, -142, -148, -98, 89, 119,
+	117, 118, 102, -130, -130, -130, -130, -130, -130, -130,
+	-130, -130, -130, -130, -130, -130, -130, -130, -130, -227,
+	82, 84, 82, -85, -129, -129, -217, -72, -81, -84,
+	126, -85, -224, -81, -81, -72, -313, -313, -78, -217,
+	354, 355, 356, 357, 358, 359, 360, 361, 362, 363,
+	364, -84, -81, -81, -81, -84, -81, -72, -72, -81,
+	-313, -313, -313, -313, -81, -81, -81, -81, -72, -81,
+	-81, -81, -72, -313, 80, -313, -3, -81, -81, -81,
+	-313, -85, -85, -151, 84, -81, -151, 84, -81, -130,
+	346, 347, 348, -81, -81, -72, -152, -153, 104, -151,
+	-81, -81, -313, -130, -217, -217, -81, -82, -81, -81,
+	-81, -177, 186, -109, 32, 80, -105, -107, -106, -108,
+	67, 71, 73, 68, 69, 70, 74, -208, -89, -96,
+	49, -312, -98, -312, -114, 186, -115, -122, 25, -226,
+	58, 59, 75, 158, 76, -177, 78, -89, -109, -89,
+	-112, -224, 126, -185, -188, 80, 322, 324, 325, -182,
+	-244, 135, 77, 96, -85, 77, 96, -85, -218, -225,
+	-172, -74, -269, -270, -271, -218, 84, 85, -256, -257,
+	-258, -259, -272, 168, -278, 160, 162, 46, -260, 169,
+	154, 47, -231, -245, 305, -238, 79, -238, -238, 279,
+	271, 274, 272, -238, -238, -238, -238, -238, -243, 274,
+	-243, -243, -243, -243, 272, 79, 79, -238, -238, -238,
+	-247, 79, -247, 266, -248, 79, -248, -248, -248, -248,
+	-279, -279, -85, -221, 78, -169, -109, -213, 26, -213,
+	-46, 204, 174, 79, -109, -109, -109, -109, 80, 82,
+	82, -264, 13, 119, -198, -224, -224, -109, -132, 25,
+	-198, -79, 179, 157, 82, -39, -129, 81, -110, 29,
+	84, -308, -226, 299, 324, -72, -7, -313, -54, -53,
+	-205, 148, 145, 146, 150, 151, -286, 302, 144, 274,
+	91, 31, 17, 341, 186, 410, 82, 187, -313, 80,
+	140, 54, -133, -133, -163, -191, 21, 13, 49, 49,
+	-73, 68, 93, 94, 95, 140, -312, -142, -130, -130,
+	-130, -79, 99, -313, -81, 80, -313, 140, -313, -313,
+	-81, -164, 365, -164, 13, -313, -313, -313, -313, -313,
+	-313, -81, -81, -313, -164, -164, -164, -164, -313, -313,
+	-313, -313, -81, -313, -313, -313, -82, -85, -313, -313,
+	-313, -313, 80, 78, 25, 13, 13, -313, 13, 13,
+	-313, -313, 13, -130, -130, -130, -313, -313, -81, -155,
+	-153, 106, -85, -313, -313, -313, -313, 80, 80, -313,
+	-313, -313, -313, -128, 32, 49, -3, -312, -312, -180,
+	-184, -151, -214, -92, -93, -93, -92, -93, 67, 67,
+	67, 72, 67, 72, 67, -106, -226, -313, -313, -147,
+	203, -208, -85, -312, -114, 36, -226, -122, -312, -312,
+	154, 154, 154, -123, 14, -89, -123, -123, 140, -187,
+	-190, 326, 323, 329, 82, 84, 140, -173, -282, 80,
+	186, -271, 109, -259, 79, 82, -216, 47, -260, 154,
+	47, -260, -265, 82, -265, 47, -249, -241, -242, -250,
+	-253, -239, 93, 100, 31, 77, 302, 160, 47, 46,
+	91, -246, 306, 85, -243, -243, -238, -238, -238, 279,
+	-238, -243, -244, 32, -244, -244, -244, -244, -243, -254,
+	84, -254, 85, -247, 85, 81, 77, -217, -3, 25,
+	-47, 10, 11, 12, -295, -296, -297, 82, 119, 209,
+	210, 157, -213, -109, -213, -213, -123, 82, 82, -195,
+	-198, 36, -130, -109, -177, 153, -309, -201, 80, -212,
+	-209, -287, -260, -292, -258, -259, -291, 161, 169, 186,
+	168, -209, -287, 168, 165, -260, 169, 160, 161, -206,
+	-260, 157, 25, -209, -209, -266, 109, -209, 186, 25,
+	-218, -218, 55, -109, -80, -170, 126, -218, -82, -79,
+	99, -130, -313, -84, -165, -164, 126, -225, -165, -165,
+	-313, -217, -312, -130, -164, -165, -165, -164, -164, -164,
+	-313, -313, -164, -165, -165, -165, -165, -313, -165, -165,
+	-165, -313, -229, 136, 271, 178, 269, 262, 289, 276,
+	304, 267, 305, -227, -229, -130, -130, -130, -130, -130,
+	13, 13, 13, 404, -159, 108, -85, 105, -130, -130,
+	-179, 77, -180, -146, -149, -148, -312, -3, -174, 8,
+	407, -178, -217, -123, 80, 109, 109, -100, -99, 77,
+	78, -101, 77, -99, 67, 67, -176, 79, 80, -148,
+	-312, -226, 80, -178, -130, -122, -130, -151, -312, -312,
+	-312, -159, -85, -123, 323, 327, 328, -270, 18, -271,
+	-274, -273, -217, -216, 160, -278, 169, -278, -265, -265,
+	79, 93, -130, 11, 47, 47, 84, 81, -244, -244,
+	-243, -238, -244, 82, 136, -244, 81, 80, 81, 80,
+	81, 80, -126, 421, 100, -109, -169, -169, 77, 81,
+	80, -230, 82, 82, 82, -109, -198, -130, -243, -109,
+	-73, -53, 79, -255, 82, -216, -212, -265, -203, 160,
+	82, 82, -212, 82, -216, 82, -216, -217, 47, 47,
+	82, -109, -217, -255, 82, -85, -217, -217, -97, 140,
+	-123, 13, 80, -83, -313, -130, -165, 140, -165, -159,
+	186, -313, -165, -165, -165, -313, -238, -238, -238, -248,
+	-238, 256, -238, 256, -313, -313, 21, 21, 21, 21,
+	-313, -130, -130, -130, -312, -76, 350, -85, 80, 80,
+	30, -179, 80, -313, -313, -313, -313, 80, -159, -184,
+	-85, -85, -85, 79, -85, -175, -217, 203, -176, 84,
+	-313, -122, -208, 21, 21, -175, -175, -175, -172, -283,
+	373, 197, 196, 195, 47, 81, 80, -238, -238, 47,
+	-175, -135, -244, -243, 84, -243, 85, 85, 421, 79,
+	-3, -109, -298, -299, -300, 91, 406, 100, 211, 212,
+	170, 213, 214, 215, -297, -230, -230, -230, -301, -197,
+	-80, -255, -161, 173, 174, -230, -230, -221, -262, -260,
+	47, 82, -207, 157, 25, 157, -161, -255, 32, 145,
+	29, -218, -156, 15, -89, -170, -170, 126, -166, -168,
+	372, 373, 18, -243, 82, -130, -130, -130, -130, -313,
+	-313, -313, -130, -313, 84, -130, -130, 47, -149, 49,
+	-3, -312, -217, -172, -175, 81, 80, -148, 313, -114,
+	-226, -151, -151, -313, -313, -313, -285, 194, -312, -312,
+	-312, 196, 47, -312, -276, -275, 78, 164, 91, -273,
+	-171, -171, 81, -244, -244, 81, 81, -274, -169, 21,
+	-169, -300, 84, 215, 211, 215, 215, 215, 215, 216,
+	-302, 40, -304, 41, 13, 81, 82, -231, -231, 79,
+	-265, 79, 82, -217, -161, 31, 31, -312, -157, 16,
+	18, -123, -83, -313, -167, 102, 465, 85, 374, -143,
+	-313, -313, -313, -313, -75, 119, 407, -313, -313, 11,
+	-146, -3, 81, -217, -312, -122, 119, 119, -284, -312,
+	85, -130, -130, -130, -312, -312, -175, -275, 82, 196,
+	-266, 84, 171, 81, 202, -22, -3, -10, -11, -12,
+	-13, -15, -48, -16, -17, -23, -24, -18, -55, -56,
+	-65, -63, -64, -60, -59, -27, -25, -28, -20, -29,
+	-30, -31, -26, 103, 159, 233, 243, 38, 38, 207,
+	208, -240, 158, -305, 45, -303, -252, 44, 18, -89,
+	-274, -221, -274, -130, -280, -281, 186, -158, -85, -144,
+	-87, -85, 369, 370, 367, -156, -170, -167, 445, 423,
+	445, 423, 203, -159, -313, 405, 74, 408, -180, -313,
+	-116, -117, -217, 62, -312, -312, -280, -313, -313, -313,
+	-130, -175, -313, 85, -109, -277, -276, 203, -169, -85,
+	-85, 82, -44, -222, 236, 237, 238, -218, -34, -22,
+	-176, 85, -304, 18, -306, 42, 43, 84, -123, 81,
+	79, 81, -313, 80, -217, 407, 80, -312, -312, 371,
+	-157, 99, -166, 55, 406, 409, -313, 80, 21, -230,
+	84, 64, -120, -121, -85, -120, -313, -313, -313, 79,
+	-162, 205, 206, -35, -36, 104, 105, 234, 235, 239,
+	-230, 80, 470, 40, 84, 18, 18, -156, -277, -274,
+	-277, -281, 49, 369, -87, -145, -88, -85, -312, -145,
+	-312, -167, -313, 55, -313, -117, 63, 64, 26, 313,
+	84, -313, 80, -220, -313, -175, -169, 82, 82, 108,
+	106, -36, -85, -34, 21, 21, 21, 31, -218, 108,
+	-22, 84, 84, -157, 81, 188, 119, -313, 80, -313,
+	-313, -145, 407, -208, 84, 64, -312, 313, -313, -121,
+	-313, 81, -14, -21, -13, -10, -11, -12, 243, 103,
+	-34, 105, 470, -45, 85, -3, -43, -42, 85, -45,
+	241, 100, 242, 82, -130, -277, 189, -312, -88, -313,
+	408, -226, -118, -119, 93, 66, 31, 84, -116, -312,
+	-289, -290, 77, -169, -34, 470, -34, 108, 106, -37,
+	-38, 107, -22, 80, 240, -312, 185, -143, 409, 77,
+	84, -313, -116, -290, 77, -289, 77, 12, 11, 470,
+	108, 470, 159, -34, 108, 106, -38, -85, -42, -130,
+	185, -313, 65, 66, -313, -288, 172, 167, 170, 32,
+	-288, 108, 103, 470, 159, -34, 105, -313, -313, -119,
+	166, 31, 93, 108, 470, -34, 77, 159, 108, 470,
+	66, 159,
+}
+
+var yyDef = [...]int{
+	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
+	31, 32, 33, -2, 561, 561, 561, 561, 561, 561,
+	674, -2, 410, 411, 0, 0, 0, 0, -2, 530,
+	531, 532, 536, 537, 552, -2, 1465, 1465, 1465, 0,
+	189, 0, 0, 0, 0, 0, 0, 39, 0, 0,
+	58, 59, 1007, 0, 555, 544, 545, 0, 0, 1463,
+	1, 3, 945, 0, 576, 563, 0, 1007, 1007, 0,
+	0, 89, 0, 0, 0, 675, 0, 0, 0, 1005,
+	1005, 0, 0, 1028, 0, 1002, 1024, 1025, 1026, 0,
+	1003, 0, 1003, 1003, 1003, 1003, 1003, 0, 481, 692,
+	1037, 1038, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
+	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
+	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
+	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
+	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
+	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
+	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
+	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
+	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
+	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
+	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
+	1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
+	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352,
+	1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362,
+	1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372,
+	1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382,
+	1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392,
+	1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402,
+	1403, 1404, 1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412,
+	1413, 1414, 1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422,
+	1423, 1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431, 0,
+	0, 0, 523, 0, 1466, 1466, 496, 0, 498, 0,
+	0, 0, 0, 0, 507, 510, 0, 1466, 356, 357,
+	358, 527, 528, 517, 529, 0, 0, 0, 0, 0,
+	556, 557, 558, 559, 560, 168, 170, 0, 190, 0,
+	193, 196, 1043, 538, 0, 0, 1068, 561, 0, 0,
+	565, 568, 571, 0, 0, 1008, 533, 45, 46, 0,
+	676, 677, 678, 1432, 1433, 1434, 1435, 1436, 1437, 1438,
+	1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447, 1448,
+	1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456, 1457, 1458,
+	1459, 1460, 1461, 1462, 0, 951, 0, 0, 579, 577,
+	578, 562, 0, 590, 609, 0, 701, 0, 706, 709,
+	-2, -2, 0, 750, 751, 752, 753, 754, 755, 0,
+	0, 0, 0, 0, 0, 0, 0, 780, 781, 782,
+	783, 784, 785, 910, 911, 912, 913, 914, 915, 916,
+	917, 1432, 1433, 1434, 1435, 1436, 1437, 1438, 1439, 1440,
+	1441, 1442, 1443, 1444, 1445, 1446, 1447, 1448, 1449, 1450,
+	1451, 1452, 1454, 1455, 1456, 1457, 1458, 1460, 1461, 1462,
+	711, 712, 907, 971, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 898, 0, 0,
+	0, 869, 869, 869, 869, 869, 869, 869, 869, 869,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 69, 71, 692, 75, 1412, 0, 976, -2, -2,
+	978, 0, 0, 0, -2, -2, -2, -2, 1232, -2,
+	907, 0, -2, 90, 937, 561, 91, 0, 230, 231,
+	0, 1244, 380, 1035, 1036, 1234, 1031, 1032, 1069, 1070,
+	1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080,
+	1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090,
+	1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100,
+	1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110,
+	1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120,
+	1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130,
+	1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140,
+	1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150,
+	1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160,
+	1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170,
+	1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180,
+	1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190,
+	1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200,
+	1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210,
+	1211, 1212, 1213, 1214, 0, 0, 0, 0, 0, 0,
+	0, 0, 1005, 0, 470, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 480, 0, 482, 1466, 523, 485,
+	0, 0, 1005, 1005, 1466, 1466, 1466, 0, 0, 0,
+	494, 1467, 1468, 495, 0, 382, 383, 523, 523, 1466,
+	1466, 520, 0, 520, 520, 518, 519, 514, 515, 0,
+	509, 0, 0, 512, 539, 0, 0, 546, 548, 549,
+	550, 551, 547, 0, 0, 0, 172, 0, 0, 192,
+	174, 0, 177, 178, 179, 180, 181, 182, 183, 184,
+	185, 186, 187, 188, 0, 195, 0, 1041, 1044, 543,
+	1059, 1060, 0, 576, 41, 54, 52, 0, 42, 566,
+	567, 569, 570, 572, 573, 0, 1466, 0, 0, 0,
+	0, 51, 1464, 36, 0, 0, 946, 949, 950, 938,
+	939, 942, 0, 0, 574, 580, 581, 564, 0, 591,
+	0, 0, 0, 610, 0, 612, 613, 614, 615, 0,
+	704, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 729, 730, 731, 732, 733, 734, 735, 707, 0,
+	0, 722, 0, 0, 0, 772, 773, 774, 775, 776,
+	777, 778, 0, 579, 0, 0, 0, 579, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 579, 579, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 579, 0,
+	0, 0, 579, 0, 0, 748, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 579, 0,
+	899, 0, 0, 0, 840, 849, 0, 841, 850, 842,
+	851, 843, 0, 844, 852, 845, 853, 846, 847, 854,
+	848, 0, 0, 0, 744, 0, 0, 73, 0, 691,
+	0, 616, 618, 619, 620, -2, 0, 0, 0, 695,
+	742, -2, 0, 0, 0, 65, 66, 0, 0, 0,
+	0, 76, 78, 0, 0, 1412, 979, 980, 330, 996,
+	997, 998, 0, 0, 994, 0, 945, 576, 397, 0,
+	232, 0, 0, 241, 318, 249, 250, 251, 311, 253,
+	311, 311, 0, 311, 311, 311, 311, 311, 327, 327,
+	327, 327, 327, 294, 295, 296, 297, 298, 0, 0,
+	271, 311, 311, 311, 275, 301, 302, 303, 304, 305,
+	306, 307, 308, 254, 255, 256, 257, 258, 259, 260,
+	261, 262, 313, 313, 315, 315, 315, 315, 315, 0,
+	0, 379, 0, 1029, 87, 0, 1466, 0, 1466, 0,
+	0, 0, 124, 0, 0, 473, 1004, 0, 475, 67,
+	476, 477, 478, 479, 693, 1039, 1040, 483, 484, 524,
+	525, 0, 0, 488, 489, 490, 491, 492, 493, 384,
+	499, 500, 501, 506, 523, 0, 0, 0, 656, 523,
+	508, 736, 0, 541, 540, 0, 553, 169, 171, 173,
+	191, 0, 0, 194, 197, 0, 0, 0, 0, 1062,
+	0, 1064, 1066, 0, 579, 0, 0, 0, 445, 534,
+	535, 47, 48, 0, 0, 960, 952, 0, 0, 0,
+	0, 941, 943, 944, 0, 0, 918, 0, 0, 582,
+	575, 38, 702, 703, 705, 723, 0, 725, 727, 611,
+	592, 0, 908, -2, 713, 714, 738, 739, 740, 0,
+	0, 0, 0, 736, 718, 0, 756, 757, 758, 759,
+	760, 761, 762, 763, 764, 765, 766, 767, 768, 771,
+	883, 884, 0, 708, 769, 770, 779, 0, 0, 746,
+	586, 587, 0, 0, 0, 0, 0, 0, 0, 857,
+	858, 859, 860, 861, 862, 863, 864, 865, 866, 867,
+	868, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 744, 741, 0, 970, 0, 0, 0, 0,
+	819, 0, 0, 753, 910, 0, 753, 910, 0, 0,
+	0, 0, 0, 0, 0, 0, 905, 902, 0, 0,
+	0, 0, 870, 0, 0, 0, 0, 0, 745, 0,
+	0, 0, 0, 690, 0, 0, 0, 0, 0, 0,
+	679, 0, 0, 682, 0, 0, 0, 0, 0, 0,
+	0, 0, 674, 0, 643, 0, 695, 651, 0, 695,
+	0, 0, 0, 0, 0, 699, 0, 699, 70, 699,
+	72, 0, 694, 977, 0, 0, 0, 81, 82, 77,
+	981, 0, 987, 988, 989, 990, 991, 992, 908, 0,
+	951, 579, 458, 398, 400, 403, 404, 405, 233, 235,
+	236, 237, 0, 1244, 371, 1352, 1393, -2, 363, 1275,
+	-2, -2, 240, 320, 319, 252, 0, 327, 327, 311,
+	311, 311, 311, 280, 327, 283, 286, 287, 330, 0,
+	330, 330, 330, 330, 327, 0, 0, 272, 273, 274,
+	263, 0, 264, 313, 266, 0, 267, 268, 269, 270,
+	377, 378, 0, 0, 0, 0, 0, 95, 1006, 96,
+	0, 125, 126, 100, 228, 0, 471, 1466, 0, 1466,
+	1466, 699, 0, 0, 502, 521, 522, 520, 523, 0,
+	505, 513, 0, 0, 542, 175, 176, 198, 73, 0,
+	1042, 1061, 0, 1065, 1067, 574, 55, 53, 412, 413,
+	1465, -2, 446, 1021, 446, 446, 433, 348, 446, 1011,
+	1012, 1013, 1014, 1015, 1016, 1017, 1018, 0, 0, 0,
+	0, 0, 947, 948, 940, 0, 999, 1000, 919, 920,
+	88, 583, 724, 726, 728, 0, 744, 715, 736, 719,
+	0, 716, 0, 710, 0, 0, 607, 0, 607, 607,
+	0, 805, 0, 806, 0, 0, 607, 607, 0, 0,
+	0, 0, 0, 0, 812, 813, 814, 815, 607, 607,
+	607, 607, 0, 607, 607, 607, 0, 749, -2, 816,
+	817, 818, 0, 0, 0, 0, 0, 876, 0, 0,
+	877, 827, 0, 0, 0, 0, 0, 833, 937, 0,
+	903, 0, 0, 836, 838, 839, 871, 0, 0, 872,
+	873, 874, 875, 964, 0, 0, 955, 0, 0, 699,
+	972, 0, 0, 617, 670, 672, 0, 667, 680, 681,
+	683, 0, 685, 0, 687, 688, 658, 623, 743, 640,
+	0, 0, 0, 0, 645, 0, 695, 655, 0, 0,
+	0, 0, 0, 937, 0, 699, 63, 64, 0, 79,
+	80, 0, 0, 86, 331, 332, 0, 99, 229, 0,
+	0, 401, 0, 234, 0, 0, 0, 350, 363, 359,
+	360, 363, 354, 364, 355, 0, 242, 243, 244, 245,
+	246, 247, 322, 0, 0, 0, 326, 0, 334, 336,
+	0, 248, 321, 0, 330, 330, 327, 278, 279, 311,
+	284, 330, 288, 0, 289, 290, 291, 292, 330, 0,
+	309, 0, 0, 265, 0, 394, 0, 1030, 87, 87,
+	0, 127, 128, 129, 0, 101, 102, 0, 0, 0,
+	0, 0, 474, 68, 486, 487, 497, 385, 386, 523,
+	504, 0, 737, 511, 327, 0, 1063, 582, 0, 415,
+	0, 1465, 363, 0, 434, 435, 0, 447, 448, 449,
+	0, 0, 1465, 0, 0, 0, 448, 0, 0, 0,
+	0, 1022, 1023, 0, 0, 0, 349, 0, 0, 0,
+	962, 961, 953, 37, 699, 0, 593, 909, 0, 717,
+	0, 720, 607, 747, 790, 608, 588, 0, 791, 792,
+	607, 594, 937, 0, 807, 794, 795, 808, 809, 810,
+	607, 607, 811, 799, 798, 797, 800, 607, 803, 802,
+	804, 786, 0, 311, 311, 888, 311, 315, 891, 311,
+	893, 311, 896, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 900, 835, 906, 0, 0, 0,
+	56, 0, 964, 954, 966, 968, 0, 0, 0, -2,
+	1213, 0, 662, 937, 0, 0, 0, 664, 671, 0,
+	0, 665, 0, 666, 684, 686, 621, 0, 0, 641,
+	0, 658, 0, 0, -2, 654, 0, 0, 0, 0,
+	0, 945, 700, 62, 83, 84, 85, 399, 0, 402,
+	0, 365, 311, 311, 0, 369, 0, 370, 352, 353,
+	0, 323, 324, 0, 333, 335, 337, 312, 276, 277,
+	330, 285, 281, 328, 329, 293, 327, 0, 327, 0,
+	316, 0, 381, 395, 0, 0, 93, 0, 0, 108,
+	0, 104, 0, 0, 0, 472, 503, 657, 1053, 689,
+	88, 414, 0, 442, 0, 0, 418, 1029, 361, 0,
+	-2, 419, 420, 425, 426, 427, 428, 429, 0, 440,
+	0, 422, 0, 442, 0, 437, 0, 0, 49, 0,
+	921, 0, 88, 88, 787, 721, 789, 0, 793, 597,
+	0, 837, 788, 796, 801, 820, 885, 327, 889, 890,
+	892, 894, 895, 897, 822, 821, 0, 0, 0, 0,
+	828, 0, 0, 0, 0, 0, 0, 904, 0, 0,
+	0, 57, 0, 969, -2, 0, 74, 0, 945, 973,
+	974, 975, 668, 0, 673, 0, 660, 0, 624, 0,
+	695, 652, 0, 0, 0, 0, 0, 0, 61, 466,
+	0, 0, 0, 0, 0, 339, 0, 942, 942, 351,
+	0, 325, 282, 330, 310, 330, 0, 0, 396, 0,
+	87, 0, 87, 109, 110, 0, 0, 0, 115, 0,
+	0, 0, 0, 0, 103, 105, 106, 107, 1055, 1049,
+	0, 0, 417, 443, 0, 241, 241, 0, 363, 362,
+	0, 436, 0, 1019, 1020, 0, 431, 442, 0, 0,
+	0, 963, 934, 0, 699, 0, 584, 589, 0, 0,
+	600, 601, 0, 886, 887, 0, 0, 0, 0, 829,
+	830, 831, 878, 834, 901, 0, 0, 0, 967, 0,
+	958, 0, 663, 60, 0, 659, 0, 642, 0, 644,
+	695, 0, 0, 696, 697, 698, 468, 0, 0, 0,
+	0, 0, 0, 0, 338, 342, 0, 348, 0, 366,
+	367, 368, 0, 299, 300, 314, 317, 0, 94, 0,
+	0, 111, 112, 113, 114, 116, 117, 0, 0, 0,
+	1009, 1057, -2, 0, 0, 416, 444, 238, 239, 0,
+	1029, 0, 421, 430, 432, 0, 439, 0, 43, 0,
+	0, 921, 88, 595, 598, 0, 0, 0, 0, 937,
+	823, 825, 824, 826, 0, 0, 0, 855, 856, 0,
+	957, 0, 669, 661, 0, 653, 0, 0, 459, 0,
+	467, 0, 0, 0, 0, 0, 0, 343, 344, 345,
+	0, 347, 0, 340, 0, 87, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 0, 0, 0, 0, 118, 119, 120,
+	121, 658, 0, 1049, 0, 1051, 0, 1048, 0, 699,
+	0, 0, 0, 438, 0, 451, 0, 935, 936, 922,
+	924, 926, 0, 0, 0, 934, 585, 0, 602, 606,
+	603, 605, 604, 597, 832, 0, 0, 0, 965, -2,
+	0, 626, 0, 1326, 0, 0, 0, 460, 461, 462,
+	0, 0, 464, 346, 0, 92, 341, 130, 98, 0,
+	0, 1031, 0, 0, 157, 158, 159, 1033, 0, 199,
+	35, 0, 1056, 0, 1054, 0, 0, 1050, 921, 340,
+	0, 340, 450, 0, 457, 0, 0, 0, 0, 0,
+	44, 0, 0, 879, 0, 882, 0, 0, 0, 0,
+	0, 0, 0, 648, 609, 0, 469, 463, 465, 0,
+	87, 0, 0, 0, 141, 0, 0, 0, 0, 0,
+	155, 0, 0, 1010, 1058, 0, 0, 934, 423, 0,
+	441, 452, 0, 923, 925, 0, 930, 932, 0, 0,
+	0, 599, 596, 880, 674, 627, 628, 0, 0, 0,
+	0, 0, 0, 650, 0, 0, 0, 131, 132, 0,
+	0, 142, 0, 0, 0, 0, 0, 0, 1034, 122,
+	200, 1052, 1046, 40, 340, 0, 0, 927, 0, 933,
+	928, 0, 0, 0, 633, 0, 0, 0, 646, 649,
+	647, 372, 87, 133, 134, 135, 136, 137, 0, 139,
+	0, 0, 0, 151, 152, 153, 0, 160, 162, 163,
+	164, 0, 166, 167, 156, 424, 0, 0, 931, 929,
+	0, 625, 629, 0, 637, 638, 0, 630, 0, 0,
+	373, 374, 0, 97, 0, 0, 0, 0, 0, 0,
+	148, 0, 154, 0, 165, 0, 455, 0, 881, 0,
+	639, 631, 0, 375, 0, 376, 0, 0, 0, 0,
+	0, 143, 144, 0, 0, 0, 149, 0, 161, 0,
+	0, 456, 634, 635, 632, 387, 389, 390, 0, 0,
+	388, 138, 140, 0, 146, 0, 0, 453, 454, 0,
+	391, 392, 393, 0, 0, 0, 0, 145, 0, 150,
+	636, 147,
+}
+
+var yyTok1 = [...]int{
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 101, 3, 3, 3, 129, 121, 3,
+	79, 81, 126, 124, 80, 125, 140, 127, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 470,
+	110, 109, 111, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 131, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 120, 3, 133,
+}
+
+var yyTok2 = [...]int{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
+	72, 73, 74, 75, 76, 77, 78, 82, 83, 84,
+	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 102, 103, 104, 105,
+	106, 107, 108, 112, 113, 114, 115, 116, 117, 118,
+	119, 122, 123, 128, 130, 132, 134, 135, 136, 137,
+	138, 139, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274,
+}
+
+var yyTok3 = [...]int{
+	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 57616, 291, 57617, 292, 57618, 293, 57619, 294,
+	57620, 295, 57621, 296, 57622, 297, 57623, 298, 57624, 299,
+	57625, 300, 57626, 301, 57627, 302, 57628, 303, 57629, 304,
+	57630, 305, 57631, 306, 57632, 307, 57633, 308, 57634, 309,
+	57635, 310, 57636, 311, 57637, 312, 57638, 313, 57639, 314,
+	57640, 315, 57641, 316, 57642, 317, 57643, 318, 57644, 319,
+	57645, 320, 57646, 321, 57647, 322, 57648, 323, 57649, 324,
+	57650, 325, 57651, 326, 57652, 327, 57653, 328, 57654, 329,
+	57655, 330, 57656, 331, 57657, 332, 57658, 333, 57659, 334,
+	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
+	57665, 340, 57666, 341, 57667, 342, 57668, 343, 57669, 344,
+	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
+	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
+	57680, 355, 57681, 356, 57682, 357, 57683, 358, 57684, 359,
+	57685, 360, 57686, 361, 57687, 362, 57688, 363, 57689, 364,
+	57690, 365, 57691, 366, 57692, 367, 57693, 368, 57694, 369,
+	57695, 370, 57696, 371, 57697, 372, 57698, 373, 57699, 374,
+	57700, 375, 57701, 376, 57702, 377, 57703, 378, 57704, 379,
+	57705, 380, 57706, 381, 57707, 382, 57708, 383, 57709, 384,
+	57710, 385, 57711, 386, 57712, 387, 57713, 388, 57714, 389,
+	57715, 390, 57716, 391, 57717, 392, 57718, 393, 57719, 394,
+	57720, 395, 57721, 396, 57722, 397, 57723, 398, 57724, 399,
+	57725, 400, 57726, 401, 57727, 402, 57728, 403, 57729, 404,
+	57730, 405, 57731, 406, 57732, 407, 57733, 408, 57734, 409,
+	57735, 410, 57736, 411, 57737, 412, 57738, 413, 57739, 414,
+	57740, 415, 57741, 416, 57742, 417, 57743, 418, 57744, 419,
+	57745, 420, 57746, 421, 57747, 422, 57748, 423, 57749, 424,
+	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
+	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
+	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
+	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
+	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
+	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
+	57780, 455, 57781, 456, 57782, 457, 57783, 458, 57784, 459,
+	57785, 460, 57786, 461, 57787, 462, 57788, 463, 57789, 464,
+	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
+	0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := yyPact[state]
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || yyExca[i+1] != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := yyExca[i]
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = yyTok1[0]
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = yyTok1[char]
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = yyTok2[char-yyPrivate]
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = yyTok3[i+0]
+		if token == char {
+			token = yyTok3[i+1]
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = yyTok2[1] /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = yyPact[yystate]
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = yyAct[yyn]
+	if yyChk[yyn] == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = yyDef[yystate]
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = yyExca[xi+0]
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = yyExca[xi+1]
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = yyAct[yyn] /* simulate a shift of "error" */
+					if yyChk[yystate] == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= yyR2[yyn]
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = yyR1[yyn]
+	yyg := yyPgo[yyn]
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = yyAct[yyg]
+	} else {
+		yystate = yyAct[yyj]
+		if yyChk[yystate] != -yyn {
+			yystate = yyAct[yyg]
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 1:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:455
+		{
+			setParseTree(yylex, yyDollar[1].statement)
+		}
+	case 2:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:460
+		{
+		}
+	case 3:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:461
+		{
+		}
+	case 4:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:465
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 34:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:498
+		{
+			setParseTree(yylex, nil)
+		}
+	case 35:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:504
+		{
+			yyVAL.statement = &Load{Local: yyDollar[3].boolVal, Infile: yyDollar[4].str, Table: yyDollar[5].tableName, Partition: yyDollar[6].partitions, Charset: yyDollar[7].str, Fields: yyDollar[8].Fields, Lines: yyDollar[9].Lines, IgnoreNum: yyDollar[10].sqlVal, Columns: yyDollar[11].columns}
+		}
+	case 36:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:510
+		{
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 37:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:517
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+		}
+	case 38:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:523
+		{
+			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
+		}
+	case 39:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:530
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 40:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sql.y:534
+		{
+			yyVAL.selStmt = &Select{CommonTableExprs: yyDollar[1].tableExprs, Comments: Comments(yyDollar[3].bytes2), Cache: yyDollar[4].str, Distinct: yyDollar[5].str, Hints: yyDollar[7].str, SelectExprs: yyDollar[8].selectExprs, From: yyDollar[10].tableExprs, Where: NewWhere(WhereStr, yyDollar[11].expr), GroupBy: GroupBy(yyDollar[12].exprs), Having: NewWhere(HavingStr, yyDollar[13].expr)}
+			if yyDollar[6].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 41:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:541
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 42:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:545
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 43:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:551
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[6].str, SelectExprs: yyDollar[7].selectExprs, From: TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			if yyDollar[5].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 44:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:558
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[6].str, SelectExprs: yyDollar[7].selectExprs, From: yyDollar[9].tableExprs, Where: NewWhere(WhereStr, yyDollar[10].expr), GroupBy: GroupBy(yyDollar[11].exprs), Having: NewWhere(HavingStr, yyDollar[12].expr)}
+			if yyDollar[5].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 45:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:567
+		{
+			yyVAL.tableExprs = yyDollar[2].tableExprs
+		}
+	case 46:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:573
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 47:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:577
+		{
+			yyVAL.tableExprs = append(yyDollar[1].tableExprs, yyDollar[3].tableExpr)
+		}
+	case 48:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:583
+		{
+			yyVAL.tableExpr = &CommonTableExpr{&AliasedTableExpr{Expr: yyDollar[3].simpleTableExpr, As: yyDollar[1].tableIdent}, nil}
+		}
+	case 49:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:587
+		{
+			yyVAL.tableExpr = &CommonTableExpr{&AliasedTableExpr{Expr: yyDollar[6].simpleTableExpr, As: yyDollar[1].tableIdent}, yyDollar[3].columns}
+		}
+	case 50:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:593
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 51:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:597
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:603
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 53:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:607
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 54:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:615
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 55:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:619
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 56:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:625
+		{
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[6].ins
+			ins.Action = yyDollar[1].str
+			ins.Comments = yyDollar[2].bytes2
+			ins.Ignore = yyDollar[3].str
+			ins.Table = yyDollar[4].tableName
+			ins.Partitions = yyDollar[5].partitions
+			ins.OnDup = OnDup(yyDollar[7].assignExprs)
+			yyVAL.statement = ins
+		}
+	case 57:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:637
+		{
+			cols := make(Columns, 0, len(yyDollar[7].assignExprs))
+			vals := make(ValTuple, 0, len(yyDollar[8].assignExprs))
+			for _, updateList := range yyDollar[7].assignExprs {
+				cols = append(cols, updateList.Name.Name)
+				vals = append(vals, updateList.Expr)
+			}
+			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].assignExprs)}
+		}
+	case 58:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:649
+		{
+			yyVAL.str = InsertStr
+		}
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:653
+		{
+			yyVAL.str = ReplaceStr
+		}
+	case 60:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:659
+		{
+			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].assignExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
+		}
+	case 61:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:665
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+		}
+	case 62:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:669
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
+		}
+	case 63:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:673
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 64:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:677
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 65:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:682
+		{
+		}
+	case 66:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:683
+		{
+		}
+	case 67:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:687
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
+		}
+	case 68:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:691
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
+		}
+	case 69:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:697
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 70:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:701
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 71:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:707
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 72:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:711
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 73:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:716
+		{
+			yyVAL.partitions = nil
+		}
+	case 74:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:720
+		{
+			yyVAL.partitions = yyDollar[3].partitions
+		}
+	case 75:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:726
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setVarExprs}
+		}
+	case 76:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:730
+		{
+			for i := 0; i < len(yyDollar[4].setVarExprs); i++ {
+				yyDollar[4].setVarExprs[i].Scope = SetScope_None
+			}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setVarExprs}
+		}
+	case 77:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:737
+		{
+			for i := 0; i < len(yyDollar[5].setVarExprs); i++ {
+				yyDollar[5].setVarExprs[i].Scope = yyDollar[3].setScope
+			}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[5].setVarExprs}
+		}
+	case 78:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:746
+		{
+			yyVAL.setVarExprs = SetVarExprs{yyDollar[1].setVarExpr}
+		}
+	case 79:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:750
+		{
+			yyVAL.setVarExprs = append(yyVAL.setVarExprs, yyDollar[3].setVarExpr)
+		}
+	case 80:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:756
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
+		}
+	case 81:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:760
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
+		}
+	case 82:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:764
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
+		}
+	case 83:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:770
+		{
+			yyVAL.str = IsolationLevelRepeatableRead
+		}
+	case 84:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:774
+		{
+			yyVAL.str = IsolationLevelReadCommitted
+		}
+	case 85:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:778
+		{
+			yyVAL.str = IsolationLevelReadUncommitted
+		}
+	case 86:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:782
+		{
+			yyVAL.str = IsolationLevelSerializable
+		}
+	case 87:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:787
+		{
+			yyVAL.int = yyPosition(yylex)
+		}
+	case 88:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:792
+		{
+			yyVAL.int = yyOldPosition(yylex)
+		}
+	case 89:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:798
+		{
+			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
+			if len(yyDollar[1].ddl.TableSpec.Constraints) > 0 {
+				yyDollar[1].ddl.ConstraintAction = AddStr
+			}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 90:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:807
+		{
+			yyDollar[1].ddl.OptSelect = &OptSelect{Select: yyDollar[3].selStmt}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 91:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:812
+		{
+			yyDollar[1].ddl.OptLike = &OptLike{LikeTable: yyDollar[3].tableName}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 92:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:817
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, IndexSpec: &IndexSpec{Action: CreateStr, ToName: yyDollar[4].colIdent, Using: yyDollar[5].colIdent, Type: yyDollar[2].str, Columns: yyDollar[9].indexColumns, Options: yyDollar[11].indexOptions}}
+		}
+	case 93:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:821
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, View: yyDollar[3].tableName.ToViewName(), ViewExpr: yyDollar[6].selStmt, SubStatementPositionStart: yyDollar[5].int, SubStatementPositionEnd: yyDollar[7].int - 1}
+		}
+	case 94:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:825
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, View: yyDollar[5].tableName.ToViewName(), ViewExpr: yyDollar[8].selStmt, SubStatementPositionStart: yyDollar[7].int, SubStatementPositionEnd: yyDollar[9].int - 1, OrReplace: true}
+		}
+	case 95:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:829
+		{
+			var ne bool
+			if yyDollar[3].byt != 0 {
+				ne = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), IfNotExists: ne}
+		}
+	case 96:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:837
+		{
+			var ne bool
+			if yyDollar[3].byt != 0 {
+				ne = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), IfNotExists: ne}
+		}
+	case 97:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:845
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[8].tableName, TriggerSpec: &TriggerSpec{Name: string(yyDollar[4].bytes), Time: yyDollar[5].str, Event: yyDollar[6].str, Order: yyDollar[12].triggerOrder, Body: yyDollar[14].statement}, SubStatementPositionStart: yyDollar[13].int, SubStatementPositionEnd: yyDollar[15].int - 1}
+		}
+	case 98:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:849
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, ProcedureSpec: &ProcedureSpec{Name: string(yyDollar[4].bytes), Definer: yyDollar[2].str, Params: yyDollar[6].procedureParams, Characteristics: yyDollar[8].characteristics, Body: yyDollar[10].statement}, SubStatementPositionStart: yyDollar[9].int, SubStatementPositionEnd: yyDollar[11].int - 1}
+		}
+	case 99:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:856
+		{
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 100:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:864
+		{
+			yyVAL.procedureParams = nil
+		}
+	case 101:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:868
+		{
+			yyVAL.procedureParams = yyDollar[1].procedureParams
+		}
+	case 102:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:874
+		{
+			yyVAL.procedureParams = []ProcedureParam{yyDollar[1].procedureParam}
+		}
+	case 103:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:878
+		{
+			yyVAL.procedureParams = append(yyVAL.procedureParams, yyDollar[3].procedureParam)
+		}
+	case 104:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:884
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_In, Name: string(yyDollar[1].bytes), Type: yyDollar[2].columnType}
+		}
+	case 105:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:888
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_In, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 106:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:892
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_Inout, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 107:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:896
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_Out, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 108:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:901
+		{
+			yyVAL.characteristics = nil
+		}
+	case 109:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:905
+		{
+			yyVAL.characteristics = yyDollar[1].characteristics
+		}
+	case 110:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:911
+		{
+			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
+		}
+	case 111:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:915
+		{
+			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[2].characteristic)
+		}
+	case 112:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:921
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_Comment, Comment: string(yyDollar[2].bytes)}
+		}
+	case 113:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:925
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_LanguageSql}
+		}
+	case 114:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:929
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_NotDeterministic}
+		}
+	case 115:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:933
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_Deterministic}
+		}
+	case 116:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:937
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ContainsSql}
+		}
+	case 117:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:941
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_NoSql}
+		}
+	case 118:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:945
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ReadsSqlData}
+		}
+	case 119:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:949
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ModifiesSqlData}
+		}
+	case 120:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:953
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_SqlSecurityDefiner}
+		}
+	case 121:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:957
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_SqlSecurityInvoker}
+		}
+	case 122:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:963
+		{
+			yyVAL.statement = &BeginEndBlock{Statements: yyDollar[2].statements}
+		}
+	case 123:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:968
+		{
+			yyVAL.str = ""
+		}
+	case 124:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:972
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 125:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:978
+		{
+			yyVAL.str = BeforeStr
+		}
+	case 126:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:982
+		{
+			yyVAL.str = AfterStr
+		}
+	case 127:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:988
+		{
+			yyVAL.str = InsertStr
+		}
+	case 128:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:992
+		{
+			yyVAL.str = UpdateStr
+		}
+	case 129:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:996
+		{
+			yyVAL.str = DeleteStr
+		}
+	case 130:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1001
+		{
+			yyVAL.triggerOrder = nil
+		}
+	case 131:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1005
+		{
+			yyVAL.triggerOrder = &TriggerOrder{PrecedesOrFollows: FollowsStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 132:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1009
+		{
+			yyVAL.triggerOrder = &TriggerOrder{PrecedesOrFollows: PrecedesStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 133:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1015
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 138:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1025
+		{
+			yyVAL.statement = &BeginEndBlock{Statements: yyDollar[2].statements}
+		}
+	case 139:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1031
+		{
+			yyVAL.statement = &CaseStatement{Expr: yyDollar[2].expr, Cases: yyDollar[3].caseStatementCases}
+		}
+	case 140:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1035
+		{
+			yyVAL.statement = &CaseStatement{Expr: yyDollar[2].expr, Cases: yyDollar[3].caseStatementCases, Else: yyDollar[5].statements}
+		}
+	case 141:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1041
+		{
+			yyVAL.caseStatementCases = []CaseStatementCase{yyDollar[1].caseStatementCase}
+		}
+	case 142:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1045
+		{
+			yyVAL.caseStatementCases = append(yyVAL.caseStatementCases, yyDollar[2].caseStatementCase)
+		}
+	case 143:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1051
+		{
+			yyVAL.caseStatementCase = CaseStatementCase{Case: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 144:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1057
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}
+			yyVAL.statement = &IfStatement{Conditions: conds}
+		}
+	case 145:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:1062
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}
+			yyVAL.statement = &IfStatement{Conditions: conds, Else: yyDollar[7].statements}
+		}
+	case 146:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1067
+		{
+			conds := yyDollar[6].ifStatementConditions
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}, conds...)
+			yyVAL.statement = &IfStatement{Conditions: conds}
+		}
+	case 147:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:1073
+		{
+			conds := yyDollar[6].ifStatementConditions
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}, conds...)
+			yyVAL.statement = &IfStatement{Conditions: conds, Else: yyDollar[8].statements}
+		}
+	case 148:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1081
+		{
+			yyVAL.ifStatementConditions = []IfStatementCondition{yyDollar[1].ifStatementCondition}
+		}
+	case 149:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1085
+		{
+			yyVAL.ifStatementConditions = append(yyVAL.ifStatementConditions, yyDollar[2].ifStatementCondition)
+		}
+	case 150:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1091
+		{
+			yyVAL.ifStatementCondition = IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 151:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1097
+		{
+			yyVAL.statement = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), SqlStateValue: string(yyDollar[5].bytes)}}
+		}
+	case 152:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1101
+		{
+			yyVAL.statement = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), MysqlErrorCode: NewIntVal(yyDollar[5].bytes)}}
+		}
+	case 153:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1105
+		{
+			yyVAL.statement = &Declare{Cursor: &DeclareCursor{Name: string(yyDollar[2].bytes), SelectStmt: yyDollar[5].selStmt}}
+		}
+	case 154:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1109
+		{
+			yyVAL.statement = &Declare{Handler: &DeclareHandler{Action: yyDollar[2].declareHandlerAction, ConditionValues: yyDollar[5].declareHandlerConditions, Statement: yyDollar[6].statement}}
+		}
+	case 155:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1113
+		{
+			yyVAL.statement = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].colIdents, VarType: yyDollar[3].columnType}}
+		}
+	case 156:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1117
+		{
+			yyDollar[3].columnType.Default = yyDollar[5].expr
+			yyVAL.statement = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].colIdents, VarType: yyDollar[3].columnType}}
+		}
+	case 157:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1124
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Continue
+		}
+	case 158:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1128
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Exit
+		}
+	case 159:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1132
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Undo
+		}
+	case 160:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1138
+		{
+			yyVAL.declareHandlerConditions = []DeclareHandlerCondition{yyDollar[1].declareHandlerCondition}
+		}
+	case 161:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1142
+		{
+			yyVAL.declareHandlerConditions = append(yyVAL.declareHandlerConditions, yyDollar[3].declareHandlerCondition)
+		}
+	case 162:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1148
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_MysqlErrorCode, MysqlErrorCode: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 163:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1152
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlState, String: string(yyDollar[1].bytes)}
+		}
+	case 164:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1156
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlWarning}
+		}
+	case 165:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1160
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_NotFound}
+		}
+	case 166:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1164
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlException}
+		}
+	case 167:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1168
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_ConditionName, String: string(yyDollar[1].bytes)}
+		}
+	case 168:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1174
+		{
+			yyVAL.statement = &Signal{SqlStateValue: string(yyDollar[2].bytes)}
+		}
+	case 169:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1178
+		{
+			yyVAL.statement = &Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}
+		}
+	case 170:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1182
+		{
+			yyVAL.statement = &Signal{ConditionName: string(yyDollar[2].bytes)}
+		}
+	case 171:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1186
+		{
+			yyVAL.statement = &Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}
+		}
+	case 172:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1192
+		{
+			yyVAL.bytes = yyDollar[2].bytes
+		}
+	case 173:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1196
+		{
+			yyVAL.bytes = yyDollar[3].bytes
+		}
+	case 174:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1202
+		{
+			yyVAL.signalInfos = []SignalInfo{yyDollar[1].signalInfo}
+		}
+	case 175:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1206
+		{
+			yyVAL.signalInfos = append(yyVAL.signalInfos, yyDollar[3].signalInfo)
+		}
+	case 176:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1212
+		{
+			yyVAL.signalInfo = SignalInfo{ConditionItemName: yyDollar[1].signalConditionItemName, Value: yyDollar[3].expr.(*SQLVal)}
+		}
+	case 177:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1218
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ClassOrigin
+		}
+	case 178:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1222
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_SubclassOrigin
+		}
+	case 179:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1226
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_MessageText
+		}
+	case 180:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1230
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_MysqlErrno
+		}
+	case 181:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1234
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintCatalog
+		}
+	case 182:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1238
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintSchema
+		}
+	case 183:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1242
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintName
+		}
+	case 184:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1246
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_CatalogName
+		}
+	case 185:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1250
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_SchemaName
+		}
+	case 186:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1254
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_TableName
+		}
+	case 187:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1258
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ColumnName
+		}
+	case 188:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1262
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_CursorName
+		}
+	case 189:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1268
+		{
+			yyVAL.statement = &Resignal{}
+		}
+	case 190:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1272
+		{
+			yyVAL.statement = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes)}}
+		}
+	case 191:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1276
+		{
+			yyVAL.statement = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}}
+		}
+	case 192:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1280
+		{
+			yyVAL.statement = &Resignal{Signal{Info: yyDollar[3].signalInfos}}
+		}
+	case 193:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1284
+		{
+			yyVAL.statement = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes)}}
+		}
+	case 194:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1288
+		{
+			yyVAL.statement = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}}
+		}
+	case 195:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1294
+		{
+			yyVAL.statement = &Call{FuncName: string(yyDollar[2].bytes), Params: yyDollar[3].exprs}
+		}
+	case 196:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1299
+		{
+			yyVAL.exprs = nil
+		}
+	case 197:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1303
+		{
+			yyVAL.exprs = nil
+		}
+	case 198:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1307
+		{
+			yyVAL.exprs = yyDollar[2].exprs
+		}
+	case 199:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1313
+		{
+			yyVAL.statements = Statements{yyDollar[1].statement}
+		}
+	case 200:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1317
+		{
+			yyVAL.statements = append(yyVAL.statements, yyDollar[3].statement)
+		}
+	case 201:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1323
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 228:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1355
+		{
+			var ne bool
+			if yyDollar[4].byt != 0 {
+				ne = true
+			}
+
+			var neTemp bool
+			if yyDollar[2].byt != 0 {
+				neTemp = true
+			}
+
+			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[5].tableName, IfNotExists: ne, Temporary: neTemp}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 229:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1372
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Options = yyDollar[4].str
+			yyVAL.TableSpec.PartitionOpt = yyDollar[5].partOption
+		}
+	case 230:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1380
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+		}
+	case 231:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1385
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
+		}
+	case 232:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1390
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+			yyVAL.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+		}
+	case 233:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1396
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+		}
+	case 234:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1400
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			yyVAL.TableSpec.AddConstraint(yyDollar[4].constraintDefinition)
+		}
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1405
+		{
+			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
+		}
+	case 236:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1409
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 237:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1413
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 238:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1419
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 239:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1427
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 240:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1437
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+		}
+	case 241:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1446
+		{
+			yyVAL.columnType = ColumnType{}
+		}
+	case 242:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1450
+		{
+			opt := ColumnType{NotNull: yyDollar[2].boolVal, sawnull: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 243:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1459
+		{
+			opt := ColumnType{Default: yyDollar[2].optVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 244:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1468
+		{
+			opt := ColumnType{OnUpdate: yyDollar[2].optVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 245:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1477
+		{
+			opt := ColumnType{Autoincrement: yyDollar[2].boolVal, sawai: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 246:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1486
+		{
+			opt := ColumnType{KeyOpt: yyDollar[2].colKeyOpt}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 247:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1495
+		{
+			opt := ColumnType{Comment: yyDollar[2].sqlVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 248:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1506
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
+			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
+		}
+	case 252:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1517
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Length = yyDollar[2].sqlVal
+		}
+	case 253:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1522
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 254:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1528
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 255:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1532
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 256:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1536
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 257:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1540
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 258:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1544
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 259:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1548
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 260:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1552
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 261:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1556
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 262:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1560
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 263:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1566
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 264:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1572
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 265:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1578
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+			yyVAL.columnType.Length = yyDollar[3].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[3].LengthScaleOption.Scale
+		}
+	case 266:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1584
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 267:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1590
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 268:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1596
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 269:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1602
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 270:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1608
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 271:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1616
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 272:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1620
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 273:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1624
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 274:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1628
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 275:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1632
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 276:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1638
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 277:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1642
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 278:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1646
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 279:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1650
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 280:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1654
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 281:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1658
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 282:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1662
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal, Charset: yyDollar[4].str, Collate: yyDollar[5].str}
+		}
+	case 283:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1666
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 284:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1670
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 285:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1674
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Length: yyDollar[4].sqlVal}
+		}
+	case 286:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1678
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 287:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1682
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 288:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1686
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 289:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1690
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 290:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1694
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 291:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1698
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 292:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1702
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 293:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1706
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 294:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1710
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 295:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1714
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 296:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1718
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 297:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1722
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 298:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1726
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 299:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1730
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 300:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1735
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 301:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1741
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 302:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1745
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 303:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1749
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 304:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1753
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 305:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1757
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 306:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1761
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 307:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1765
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 308:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1769
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 309:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1775
+		{
+			yyVAL.strs = make([]string, 0, 4)
+			yyVAL.strs = append(yyVAL.strs, string(yyDollar[1].bytes))
+		}
+	case 310:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1780
+		{
+			yyVAL.strs = append(yyDollar[1].strs, string(yyDollar[3].bytes))
+		}
+	case 311:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1785
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 312:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1789
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 313:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1794
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 314:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1798
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 315:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1806
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 316:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1810
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+			}
+		}
+	case 317:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1816
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 318:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1824
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 319:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1828
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 320:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1833
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 321:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1837
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 322:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1844
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 323:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1848
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 324:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1854
+		{
+			yyVAL.optVal = yyDollar[2].expr
+		}
+	case 325:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1860
+		{
+			yyVAL.optVal = yyDollar[3].expr
+		}
+	case 326:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1866
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 327:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1871
+		{
+			yyVAL.str = ""
+		}
+	case 328:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1875
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 329:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1879
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 330:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1884
+		{
+			yyVAL.str = ""
+		}
+	case 331:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1888
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 332:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1892
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 333:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1898
+		{
+			yyVAL.colKeyOpt = colKeyPrimary
+		}
+	case 334:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1902
+		{
+			yyVAL.colKeyOpt = colKey
+		}
+	case 335:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1906
+		{
+			yyVAL.colKeyOpt = colKeyUniqueKey
+		}
+	case 336:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1910
+		{
+			yyVAL.colKeyOpt = colKeyUnique
+		}
+	case 337:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1916
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
+		}
+	case 338:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1922
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
+		}
+	case 339:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1926
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
+		}
+	case 340:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1931
+		{
+			yyVAL.indexOptions = nil
+		}
+	case 341:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1935
+		{
+			yyVAL.indexOptions = yyDollar[1].indexOptions
+		}
+	case 342:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1941
+		{
+			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
+		}
+	case 343:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1945
+		{
+			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
+		}
+	case 344:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1951
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+		}
+	case 345:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1955
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+		}
+	case 346:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1959
+		{
+			// should not be string
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 347:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1964
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 348:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1970
+		{
+			yyVAL.str = ""
+		}
+	case 349:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1974
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 350:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1980
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		}
+	case 351:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1984
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Primary: true, Unique: true}
+		}
+	case 352:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1988
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
+		}
+	case 353:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1992
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
+		}
+	case 354:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1996
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
+		}
+	case 355:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2000
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
+		}
+	case 356:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2006
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 357:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2010
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 358:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2014
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 359:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2020
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 360:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2024
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 361:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2029
+		{
+			yyVAL.str = ""
+		}
+	case 362:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2033
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 363:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2038
+		{
+			yyVAL.str = ""
+		}
+	case 364:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2042
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 365:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2048
+		{
+			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
+		}
+	case 366:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2052
+		{
+			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
+		}
+	case 367:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2058
+		{
+			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal, Order: yyDollar[3].str}
+		}
+	case 368:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2062
+		{
+			yyVAL.indexColumn = &IndexColumn{Column: NewColIdent(string(yyDollar[1].bytes)), Length: yyDollar[2].sqlVal, Order: yyDollar[3].str}
+		}
+	case 369:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2068
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 370:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2072
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 371:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2076
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+		}
+	case 372:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2082
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
+		}
+	case 373:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2086
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
+		}
+	case 374:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2090
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
+		}
+	case 375:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2094
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
+		}
+	case 376:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2098
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[12].ReferenceAction, OnUpdate: yyDollar[11].ReferenceAction}
+		}
+	case 377:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2104
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 378:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2108
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 379:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2112
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[2].constraintInfo}
+		}
+	case 380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2116
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+		}
+	case 381:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2122
+		{
+			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
+		}
+	case 382:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2128
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2132
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 384:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2137
+		{
+			yyVAL.str = ""
+		}
+	case 385:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2141
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 386:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2145
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 387:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2151
+		{
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+		}
+	case 388:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2157
+		{
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+		}
+	case 389:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2163
+		{
+			yyVAL.ReferenceAction = Restrict
+		}
+	case 390:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2167
+		{
+			yyVAL.ReferenceAction = Cascade
+		}
+	case 391:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2171
+		{
+			yyVAL.ReferenceAction = NoAction
+		}
+	case 392:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2175
+		{
+			yyVAL.ReferenceAction = SetDefault
+		}
+	case 393:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2179
+		{
+			yyVAL.ReferenceAction = SetNull
+		}
+	case 394:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2184
+		{
+			yyVAL.boolean = true
+		}
+	case 395:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2188
+		{
+			yyVAL.boolean = true
+		}
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2192
+		{
+			yyVAL.boolean = false
+		}
+	case 397:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2197
+		{
+			yyVAL.str = ""
+		}
+	case 398:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2201
+		{
+			yyVAL.str = " " + string(yyDollar[1].str)
+		}
+	case 399:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2205
+		{
+			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
+		}
+	case 400:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2213
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 401:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2217
+		{
+			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
+		}
+	case 402:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2221
+		{
+			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
+		}
+	case 403:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2227
+		{
+			yyVAL.str = yyDollar[1].colIdent.String()
+		}
+	case 404:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2231
+		{
+			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+		}
+	case 405:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2235
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 406:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2240
+		{
+			yyVAL.str = ""
+		}
+	case 407:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2244
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 408:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2249
+		{
+			yyVAL.str = string("")
+		}
+	case 409:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2253
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 412:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2263
+		{
+			for i := 0; i < len(yyDollar[5].ddls); i++ {
+				if yyDollar[5].ddls[i].Action == RenameStr {
+					yyDollar[5].ddls[i].FromTables = append(TableNames{yyDollar[4].tableName}, yyDollar[5].ddls[i].FromTables...)
+				} else {
+					yyDollar[5].ddls[i].Table = yyDollar[4].tableName
+				}
+			}
+			yyVAL.statement = &MultiAlterDDL{Table: yyDollar[4].tableName, Statements: yyDollar[5].ddls}
+		}
+	case 413:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2276
+		{
+			yyVAL.ddls = []*DDL{yyDollar[1].ddl}
+		}
+	case 414:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2280
+		{
+			yyVAL.ddls = append(yyVAL.ddls, yyDollar[3].ddl)
+		}
+	case 415:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2286
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 416:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2290
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddColumn(yyDollar[4].columnDefinition)
+			ddl.Column = yyDollar[4].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 417:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2297
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: AddStr, TableSpec: &TableSpec{}, ColumnOrder: yyDollar[4].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			ddl.Column = yyDollar[3].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 418:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2304
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 419:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2308
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: DropStr, Column: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 420:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2312
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 421:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2316
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: RenameStr, Column: NewColIdent(string(yyDollar[3].bytes)), ToColumn: NewColIdent(string(yyDollar[5].bytes))}
+		}
+	case 422:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2320
+		{
+			// Change this to a rename statement
+			yyVAL.ddl = &DDL{Action: RenameStr, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 423:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2325
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr, ToName: NewColIdent(yyDollar[3].str), Using: yyDollar[4].colIdent, Columns: yyDollar[6].indexColumns, Options: yyDollar[8].indexOptions}}
+		}
+	case 424:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2329
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr, ToName: NewColIdent(yyDollar[5].str), Type: yyDollar[3].str, Using: yyDollar[6].colIdent, Columns: yyDollar[8].indexColumns, Options: yyDollar[10].indexOptions}}
+		}
+	case 425:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2333
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes)}}}}
+		}
+	case 426:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2338
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes)}}}}
+		}
+	case 427:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2343
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes), Details: &CheckConstraintDefinition{}}}}}
+		}
+	case 428:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2348
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes), Details: &CheckConstraintDefinition{}}}}}
+		}
+	case 429:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2353
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: DropStr, ToName: yyDollar[3].colIdent}}
+		}
+	case 430:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2357
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: RenameStr, FromName: yyDollar[3].colIdent, ToName: yyDollar[5].colIdent}}
+		}
+	case 431:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2361
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: ModifyStr, TableSpec: &TableSpec{}, ColumnOrder: yyDollar[4].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			ddl.Column = yyDollar[3].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 432:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2368
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: ChangeStr, TableSpec: &TableSpec{}, Column: NewColIdent(string(yyDollar[3].bytes)), ColumnOrder: yyDollar[5].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[4].columnDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 433:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2374
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, PartitionSpec: yyDollar[1].partSpec}
+		}
+	case 434:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2378
+		{
+			ddl := &DDL{Action: AlterStr, ConstraintAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 435:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2384
+		{
+			ddl := &DDL{Action: AlterStr, ConstraintAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 436:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2390
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[4].bytes), Details: &ForeignKeyDefinition{}}}}}
+		}
+	case 437:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2395
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AutoIncSpec: &AutoIncSpec{Value: yyDollar[3].expr}}
+		}
+	case 438:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2399
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, DefaultSpec: &DefaultSpec{Action: SetStr, Column: yyDollar[3].colIdent, Value: yyDollar[6].expr}}
+		}
+	case 439:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2403
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, DefaultSpec: &DefaultSpec{Action: DropStr, Column: yyDollar[3].colIdent}}
+		}
+	case 440:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2407
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: DropStr, Type: PrimaryStr}}
+		}
+	case 441:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2411
+		{
+			ddl := &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr}}
+			ddl.IndexSpec = &IndexSpec{Action: CreateStr, Using: NewColIdent(""), ToName: NewColIdent(yyDollar[2].str), Type: PrimaryStr, Columns: yyDollar[6].indexColumns, Options: yyDollar[8].indexOptions}
+			yyVAL.ddl = ddl
+		}
+	case 442:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2418
+		{
+			yyVAL.columnOrder = nil
+		}
+	case 443:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2422
+		{
+			yyVAL.columnOrder = &ColumnOrder{First: true}
+		}
+	case 444:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2426
+		{
+			yyVAL.columnOrder = &ColumnOrder{AfterColumn: NewColIdent(string(yyDollar[2].bytes))}
+		}
+	case 445:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2432
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
+		}
+	case 446:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2438
+		{
+		}
+	case 447:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2440
+		{
+		}
+	case 450:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2448
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
+		}
+	case 451:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2454
+		{
+			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
+		}
+	case 452:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2458
+		{
+			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
+		}
+	case 453:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2464
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
+		}
+	case 454:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2468
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+		}
+	case 455:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2472
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+		}
+	case 456:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2476
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, In: yyDollar[6].exprs}
+		}
+	case 457:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2480
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent}
+		}
+	case 458:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2485
+		{
+			yyVAL.partOption = nil
+		}
+	case 459:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2489
+		{
+			yyVAL.partOption = yyDollar[3].partOption
+			yyVAL.partOption.Count = yyDollar[4].sqlVal
+			yyVAL.partOption.Definitions = yyDollar[5].partDefs
+		}
+	case 460:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2497
+		{
+			yyVAL.partOption = &PartitionOption{Type: RangeStr, Expr: yyDollar[3].expr}
+		}
+	case 461:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2501
+		{
+			yyVAL.partOption = &PartitionOption{Type: ListStr, Expr: yyDollar[3].expr}
+		}
+	case 462:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2505
+		{
+			yyVAL.partOption = &PartitionOption{Type: HashStr, Expr: yyDollar[3].expr}
+		}
+	case 463:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2509
+		{
+			yyVAL.partOption = &PartitionOption{Type: HashStr, Linear: true, Expr: yyDollar[4].expr}
+		}
+	case 464:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2513
+		{
+			yyVAL.partOption = &PartitionOption{Type: KeyStr, Columns: yyDollar[3].columns}
+		}
+	case 465:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2517
+		{
+			yyVAL.partOption = &PartitionOption{Type: KeyStr, Linear: true, Columns: yyDollar[4].columns}
+		}
+	case 466:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2522
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 467:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2526
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 468:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2531
+		{
+			yyVAL.partDefs = nil
+		}
+	case 469:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2535
+		{
+			yyVAL.partDefs = yyDollar[2].partDefs
+		}
+	case 470:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2541
+		{
+			yyVAL.statement = yyDollar[3].ddl
+		}
+	case 471:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2547
+		{
+			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 472:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2551
+		{
+			yyVAL.ddl = yyDollar[1].ddl
+			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
+			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
+		}
+	case 473:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2559
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 474:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2567
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, IndexSpec: &IndexSpec{Action: DropStr, ToName: yyDollar[3].colIdent}}
+		}
+	case 475:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2571
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromViews: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 476:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2579
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes), IfExists: exists}
+		}
+	case 477:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2587
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes), IfExists: exists}
+		}
+	case 478:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2595
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, TriggerSpec: &TriggerSpec{Name: string(yyDollar[4].bytes)}, IfExists: exists}
+		}
+	case 479:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2603
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, ProcedureSpec: &ProcedureSpec{Name: string(yyDollar[4].bytes)}, IfExists: exists}
+		}
+	case 480:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2613
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+		}
+	case 481:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2617
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+		}
+	case 482:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2622
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
+		}
+	case 483:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2628
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 484:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2633
+		{
+			yyVAL.statement = &Show{Type: CharsetStr, Filter: yyDollar[4].showFilter}
+		}
+	case 485:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2637
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), Filter: yyDollar[3].showFilter}
+		}
+	case 486:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2641
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), IfNotExists: yyDollar[4].byt == 1, Database: string(yyDollar[5].bytes)}
+		}
+	case 487:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2645
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), IfNotExists: yyDollar[4].byt == 1, Database: string(yyDollar[5].bytes)}
+		}
+	case 488:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2650
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 489:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2654
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 490:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2658
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 491:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2662
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+		}
+	case 492:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2666
+		{
+			yyVAL.statement = &Show{Type: CreateTriggerStr, Table: yyDollar[4].tableName}
+		}
+	case 493:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2670
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+		}
+	case 494:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2674
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 495:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2678
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 496:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2682
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 497:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2686
+		{
+			yyVAL.statement = &Show{Type: IndexStr, Table: yyDollar[4].tableName, Database: yyDollar[5].str, ShowIndexFilterOpt: yyDollar[6].expr}
+		}
+	case 498:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2690
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 499:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2694
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 500:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2698
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 501:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2702
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 502:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2706
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Database: yyDollar[4].str, Filter: yyDollar[5].showFilter}
+		}
+	case 503:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2710
+		{
+			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
+			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
+		}
+	case 504:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2715
+		{
+			// this is ugly, but I couldn't find a better way for now
+			if yyDollar[3].str == "processlist" {
+				yyVAL.statement = &Show{Type: yyDollar[3].str}
+			} else {
+				showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[4].str, Filter: yyDollar[6].showFilter, AsOf: yyDollar[5].expr}
+				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
+			}
+		}
+	case 505:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2725
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[3].bytes), ShowTablesOpt: &ShowTablesOpt{DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
+		}
+	case 506:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2729
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 507:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2733
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 508:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2737
+		{
+			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
+			showCollationFilterOpt := yyDollar[4].expr
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
+		}
+	case 509:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2743
+		{
+			// Cannot dereference $3 directly, or else the parser stackcannot be pooled. See yyParsePooled
+			cmp := yyDollar[3].expr.(*ComparisonExpr)
+			cmp.Left = &ColName{Name: NewColIdent("collation")}
+			var ex Expr = cmp
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &ex}
+		}
+	case 510:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2751
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 511:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2755
+		{
+			yyVAL.statement = &Show{Type: DiffStr, OnTable: yyDollar[4].tableName, Table: yyDollar[6].tableName}
+		}
+	case 512:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2765
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 513:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2771
+		{
+			yyVAL.expr = &ComparisonExpr{Operator: LikeStr, Right: yyDollar[2].expr, Escape: yyDollar[3].expr}
+		}
+	case 514:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2777
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 515:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2781
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 516:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2787
+		{
+			yyVAL.str = ""
+		}
+	case 517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2791
+		{
+			yyVAL.str = "full "
+		}
+	case 518:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2797
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 519:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2801
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 520:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2807
+		{
+			yyVAL.str = ""
+		}
+	case 521:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2811
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 522:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2815
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 523:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2821
+		{
+			yyVAL.showFilter = nil
+		}
+	case 524:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2825
+		{
+			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
+		}
+	case 525:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2829
+		{
+			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
+		}
+	case 526:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2835
+		{
+			yyVAL.str = ""
+		}
+	case 527:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2839
+		{
+			yyVAL.str = SessionStr
+		}
+	case 528:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2843
+		{
+			yyVAL.str = GlobalStr
+		}
+	case 529:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2849
+		{
+			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
+		}
+	case 530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2853
+		{
+			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
+		}
+	case 531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2859
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 532:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2863
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 533:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2869
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 534:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2873
+		{
+			yyVAL.statement = &Begin{TransactionCharacteristic: TxReadWrite}
+		}
+	case 535:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2877
+		{
+			yyVAL.statement = &Begin{TransactionCharacteristic: TxReadOnly}
+		}
+	case 536:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2883
+		{
+			yyVAL.statement = &Commit{}
+		}
+	case 537:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2889
+		{
+			yyVAL.statement = &Rollback{}
+		}
+	case 538:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2895
+		{
+			yyVAL.statement = &Savepoint{Identifier: string(yyDollar[2].bytes)}
+		}
+	case 539:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2901
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 540:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2905
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 541:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2909
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 542:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2913
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[5].bytes)}
+		}
+	case 543:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2919
+		{
+			yyVAL.statement = &ReleaseSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 544:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2924
+		{
+		}
+	case 545:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2925
+		{
+		}
+	case 546:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2929
+		{
+			yyVAL.statement = &Explain{ExplainFormat: yyDollar[2].str, Statement: yyDollar[3].statement}
+		}
+	case 547:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2933
+		{
+			yyVAL.statement = &Explain{Analyze: true, ExplainFormat: TreeStr, Statement: yyDollar[3].selStmt}
+		}
+	case 548:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2939
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 552:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2947
+		{
+			yyVAL.str = ""
+		}
+	case 553:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2951
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 556:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2962
+		{
+			yyVAL.statement = &Show{Type: "columns", OnTable: yyDollar[2].tableName}
+		}
+	case 557:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2966
+		{
+			yyVAL.statement = &OtherRead{}
+		}
+	case 558:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2972
+		{
+			yyVAL.statement = &OtherAdmin{}
+		}
+	case 559:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2976
+		{
+			yyVAL.statement = &OtherAdmin{}
+		}
+	case 560:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2982
+		{
+			yyVAL.statement = &DDL{Action: FlushStr}
+		}
+	case 561:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2986
+		{
+			setAllowComments(yylex, true)
+		}
+	case 562:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2990
+		{
+			yyVAL.bytes2 = yyDollar[2].bytes2
+			setAllowComments(yylex, false)
+		}
+	case 563:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2996
+		{
+			yyVAL.bytes2 = nil
+		}
+	case 564:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3000
+		{
+			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
+		}
+	case 565:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3006
+		{
+			yyVAL.str = UnionStr
+		}
+	case 566:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3010
+		{
+			yyVAL.str = UnionAllStr
+		}
+	case 567:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3014
+		{
+			yyVAL.str = UnionDistinctStr
+		}
+	case 568:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3018
+		{
+			yyVAL.str = ExceptStr
+		}
+	case 569:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3022
+		{
+			yyVAL.str = ExceptAllStr
+		}
+	case 570:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3026
+		{
+			yyVAL.str = ExceptDistinctStr
+		}
+	case 571:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3032
+		{
+			yyVAL.str = IntersectStr
+		}
+	case 572:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3036
+		{
+			yyVAL.str = IntersectAllStr
+		}
+	case 573:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3040
+		{
+			yyVAL.str = IntersectDistinctStr
+		}
+	case 574:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3045
+		{
+			yyVAL.byt = 0
+		}
+	case 575:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3049
+		{
+			yyVAL.byt = 1
+		}
+	case 576:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3054
+		{
+			yyVAL.str = ""
+		}
+	case 577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3058
+		{
+			yyVAL.str = SQLNoCacheStr
+		}
+	case 578:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3062
+		{
+			yyVAL.str = SQLCacheStr
+		}
+	case 579:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3067
+		{
+			yyVAL.str = ""
+		}
+	case 580:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3071
+		{
+			yyVAL.str = ""
+		}
+	case 581:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3075
+		{
+			yyVAL.str = DistinctStr
+		}
+	case 582:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3080
+		{
+			yyVAL.str = ""
+		}
+	case 583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3084
+		{
+			yyVAL.str = StraightJoinHint
+		}
+	case 584:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3090
+		{
+			if ae, ok := yyDollar[2].selectExpr.(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[1].int
+				ae.EndParsePos = yyDollar[3].int - 1
+			}
+			yyVAL.selectExprs = SelectExprs{yyDollar[2].selectExpr}
+		}
+	case 585:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3098
+		{
+			if ae, ok := yyDollar[4].selectExpr.(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[3].int
+				ae.EndParsePos = yyDollar[5].int - 1
+			}
+			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[4].selectExpr)
+		}
+	case 586:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3109
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 587:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3113
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr}
+		}
+	case 588:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3117
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 589:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3121
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 590:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3127
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 591:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3131
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
+		}
+	case 592:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3135
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 593:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3139
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 594:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3145
+		{
+			yyVAL.over = &Over{WindowName: yyDollar[2].colIdent}
+		}
+	case 595:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3149
+		{
+			yyVAL.over = &Over{OrderBy: yyDollar[3].orderBy, Frame: yyDollar[4].windowFrame}
+		}
+	case 596:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3153
+		{
+			yyVAL.over = &Over{PartitionBy: yyDollar[5].exprs, OrderBy: yyDollar[6].orderBy, Frame: yyDollar[7].windowFrame}
+		}
+	case 597:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3158
+		{
+			yyVAL.windowFrame = nil
+		}
+	case 598:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3162
+		{
+			yyVAL.windowFrame = &WindowFrame{Unit: yyDollar[1].str, Start: yyDollar[2].frameBound}
+		}
+	case 599:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3166
+		{
+			yyVAL.windowFrame = &WindowFrame{Unit: yyDollar[1].str, Start: yyDollar[3].frameBound, End: yyDollar[5].frameBound}
+		}
+	case 600:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3172
+		{
+			yyVAL.str = RowsStr
+		}
+	case 601:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3176
+		{
+			yyVAL.str = RangeStr
+		}
+	case 602:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3182
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedPrecedingStr}
+		}
+	case 603:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3186
+		{
+			yyVAL.frameBound = &FrameBound{Type: PrecedingStr, Offset: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 604:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3190
+		{
+			yyVAL.frameBound = &FrameBound{Type: CurrentRowStr}
+		}
+	case 605:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3194
+		{
+			yyVAL.frameBound = &FrameBound{Type: FollowingStr, Offset: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 606:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3198
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedFollowingStr}
+		}
+	case 607:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3203
+		{
+			yyVAL.over = nil
+		}
+	case 608:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3207
+		{
+			yyVAL.over = yyDollar[1].over
+		}
+	case 609:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3212
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 610:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3216
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 611:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3220
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 612:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3226
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 613:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3230
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 614:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3234
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 615:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3238
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 616:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3244
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 617:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3248
+		{
+			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
+		}
+	case 620:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3258
+		{
+			yyVAL.tableExpr = yyDollar[1].aliasedTableName
+		}
+	case 621:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3262
+		{
+			switch n := yyDollar[1].simpleTableExpr.(type) {
+			case *Subquery:
+				n.Columns = yyDollar[4].columns
+			case *ValuesStatement:
+				n.Columns = yyDollar[4].columns
+			}
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].simpleTableExpr, As: yyDollar[3].tableIdent}
+		}
+	case 622:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3272
+		{
+			// missed alias for subquery
+			yylex.Error("Every derived table must have its own alias")
+			return 1
+		}
+	case 623:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3278
+		{
+			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
+		}
+	case 624:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3282
+		{
+			yyDollar[2].subquery.Columns = yyDollar[5].columns
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[2].subquery, As: yyDollar[4].tableIdent, Lateral: true}
+		}
+	case 625:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:3287
+		{
+			yyVAL.tableExpr = &JSONTableExpr{Expr: yyDollar[3].expr, Path: NewStrVal(yyDollar[5].bytes), Columns: yyDollar[8].jsonTableColumns, Alias: yyDollar[12].tableIdent}
+		}
+	case 626:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3293
+		{
+			yyVAL.jsonTableColumns = []*JSONTableColumn{yyDollar[1].jsonTableColumn}
+		}
+	case 627:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3297
+		{
+			yyVAL.jsonTableColumns = append(yyVAL.jsonTableColumns, yyDollar[3].jsonTableColumn)
+		}
+	case 628:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3303
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{Name: yyDollar[1].colIdent, Ordinality: true}
+		}
+	case 629:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3307
+		{
+			yyVAL.jsonTableColumn = yyDollar[5].jsonTableColumn
+			yyVAL.jsonTableColumn.Name = yyDollar[1].colIdent
+			yyVAL.jsonTableColumn.Type = yyDollar[2].columnType
+			yyVAL.jsonTableColumn.Path = NewStrVal(yyDollar[4].bytes)
+		}
+	case 630:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3314
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType, Exists: true, Path: NewStrVal(yyDollar[5].bytes)}
+		}
+	case 631:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3318
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{Path: NewStrVal(yyDollar[2].bytes), Nested: yyDollar[5].jsonTableColumns}
+		}
+	case 632:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3322
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{Path: NewStrVal(yyDollar[3].bytes), Nested: yyDollar[6].jsonTableColumns}
+		}
+	case 633:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3327
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{}
+		}
+	case 634:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3331
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{OnEmpty: yyDollar[1].jsonTableFallback}
+		}
+	case 635:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3335
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{OnError: yyDollar[1].jsonTableFallback}
+		}
+	case 636:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3339
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{OnEmpty: yyDollar[1].jsonTableFallback, OnError: yyDollar[4].jsonTableFallback}
+		}
+	case 637:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3345
+		{
+			yyVAL.jsonTableFallback = &JSONTableFallback{}
+		}
+	case 638:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3349
+		{
+			yyVAL.jsonTableFallback = &JSONTableFallback{Error: true}
+		}
+	case 639:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3353
+		{
+			yyVAL.jsonTableFallback = &JSONTableFallback{Default: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 640:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3359
+		{
+			yyVAL.simpleTableExpr = &ValuesStatement{Rows: yyDollar[2].values}
+		}
+	case 641:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3365
+		{
+			yyVAL.values = Values{yyDollar[2].valTuple}
+		}
+	case 642:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3369
+		{
+			yyVAL.values = append(yyVAL.values, yyDollar[4].valTuple)
+		}
+	case 643:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3375
+		{
+			yyVAL.aliasedTableName = yyDollar[2].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+		}
+	case 644:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3380
+		{
+			yyVAL.aliasedTableName = yyDollar[6].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+			yyVAL.aliasedTableName.Partitions = yyDollar[4].partitions
+		}
+	case 645:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3386
+		{
+			yyVAL.aliasedTableName = yyDollar[3].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+			yyVAL.aliasedTableName.Pivot = yyDollar[2].pivot
+		}
+	case 646:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:3394
+		{
+			yyVAL.pivot = &Pivot{Type: PivotStr, Expr: yyDollar[3].expr, For: yyDollar[5].colName, In: yyDollar[8].selectExprs}
+		}
+	case 647:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:3398
+		{
+			yyVAL.pivot = &Pivot{Type: UnpivotStr, Expr: yyDollar[3].colName, For: yyDollar[5].colName, In: yyDollar[8].selectExprs}
+		}
+	case 648:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3404
+		{
+			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
+		}
+	case 649:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3408
+		{
+			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
+		}
+	case 650:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3414
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
+		}
+	case 651:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3423
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{Hints: yyDollar[1].indexHints}
+		}
+	case 652:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3427
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{AsOf: &AsOf{Time: yyDollar[3].expr}, Hints: yyDollar[4].indexHints}
+		}
+	case 653:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3431
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{AsOf: &AsOf{Time: yyDollar[3].expr}, As: yyDollar[5].tableIdent, Hints: yyDollar[6].indexHints}
+		}
+	case 654:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3435
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
+		}
+	case 655:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3439
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{As: yyDollar[1].tableIdent, Hints: yyDollar[2].indexHints}
+		}
+	case 656:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3450
+		{
+			yyVAL.expr = nil
+		}
+	case 657:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3454
+		{
+			yyVAL.expr = yyDollar[3].expr
+		}
+	case 658:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3459
+		{
+			yyVAL.columns = nil
+		}
+	case 659:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3463
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 660:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3469
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 661:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3473
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 662:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3479
+		{
+			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
+		}
+	case 663:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3483
+		{
+			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
+		}
+	case 664:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3496
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 665:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3500
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 666:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3504
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 667:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3508
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
+		}
+	case 668:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3514
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 669:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3516
+		{
+			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
+		}
+	case 670:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3520
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 671:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3522
+		{
+			yyVAL.joinCondition = yyDollar[1].joinCondition
+		}
+	case 672:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3526
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 673:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3528
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 674:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3531
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 675:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3533
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 677:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3538
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 678:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3542
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 679:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3548
+		{
+			yyVAL.str = JoinStr
+		}
+	case 680:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3552
+		{
+			yyVAL.str = JoinStr
+		}
+	case 681:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3556
+		{
+			yyVAL.str = JoinStr
+		}
+	case 682:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3562
+		{
+			yyVAL.str = StraightJoinStr
+		}
+	case 683:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3568
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 684:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3572
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 685:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3576
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 686:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3580
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 687:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3586
+		{
+			yyVAL.str = NaturalJoinStr
+		}
+	case 688:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3590
+		{
+			if yyDollar[2].str == LeftJoinStr {
+				yyVAL.str = NaturalLeftJoinStr
+			} else {
+				yyVAL.str = NaturalRightJoinStr
+			}
+		}
+	case 689:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3600
+		{
+			yyVAL.tableName = yyDollar[3].tableName
+		}
+	case 690:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3606
+		{
+			yyVAL.tableName = yyDollar[2].tableName
+		}
+	case 691:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3610
+		{
+			yyVAL.tableName = yyDollar[1].tableName
+		}
+	case 692:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3616
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 693:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3620
+		{
+			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
+		}
+	case 694:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3626
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 695:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3631
+		{
+			yyVAL.indexHints = nil
+		}
+	case 696:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3635
+		{
+			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
+		}
+	case 697:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3639
+		{
+			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
+		}
+	case 698:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3643
+		{
+			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
+		}
+	case 699:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3648
+		{
+			yyVAL.expr = nil
+		}
+	case 700:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3652
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 701:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3658
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 702:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3662
+		{
+			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 703:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3666
+		{
+			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 704:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3670
+		{
+			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
+		}
+	case 705:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3674
+		{
+			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
+		}
+	case 706:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3678
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 707:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3682
+		{
+			yyVAL.expr = &Default{ColName: yyDollar[2].str}
+		}
+	case 708:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3686
+		{
+			name, scope, err := VarScope(string(yyDollar[1].bytes))
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_User {
+				yylex.Error(fmt.Sprintf("invalid user variable name `%s`", string(yyDollar[1].bytes)))
+				return 1
+			}
+			yyVAL.expr = &VarAssignmentExpr{Name: name, Expr: yyDollar[3].expr}
+		}
+	case 709:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3700
+		{
+			yyVAL.str = ""
+		}
+	case 710:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3704
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 711:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3710
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 712:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3714
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 713:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3720
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
+		}
+	case 714:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3724
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
+		}
+	case 715:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3728
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
+		}
+	case 716:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3732
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+		}
+	case 717:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3736
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
+		}
+	case 718:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3740
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
+		}
+	case 719:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3744
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
+		}
+	case 720:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3748
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
+		}
+	case 721:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3752
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
+		}
+	case 722:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3756
+		{
+			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
+		}
+	case 723:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3762
+		{
+			yyVAL.str = IsNullStr
+		}
+	case 724:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3766
+		{
+			yyVAL.str = IsNotNullStr
+		}
+	case 725:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3770
+		{
+			yyVAL.str = IsTrueStr
+		}
+	case 726:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3774
+		{
+			yyVAL.str = IsNotTrueStr
+		}
+	case 727:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3778
+		{
+			yyVAL.str = IsFalseStr
+		}
+	case 728:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3782
+		{
+			yyVAL.str = IsNotFalseStr
+		}
+	case 729:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3788
+		{
+			yyVAL.str = EqualStr
+		}
+	case 730:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3792
+		{
+			yyVAL.str = LessThanStr
+		}
+	case 731:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3796
+		{
+			yyVAL.str = GreaterThanStr
+		}
+	case 732:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3800
+		{
+			yyVAL.str = LessEqualStr
+		}
+	case 733:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3804
+		{
+			yyVAL.str = GreaterEqualStr
+		}
+	case 734:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3808
+		{
+			yyVAL.str = NotEqualStr
+		}
+	case 735:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3812
+		{
+			yyVAL.str = NullSafeEqualStr
+		}
+	case 736:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3817
+		{
+			yyVAL.expr = nil
+		}
+	case 737:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3821
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 738:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3827
+		{
+			yyVAL.colTuple = yyDollar[1].valTuple
+		}
+	case 739:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3831
+		{
+			yyVAL.colTuple = yyDollar[1].subquery
+		}
+	case 740:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3835
+		{
+			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
+		}
+	case 741:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3841
+		{
+			yyVAL.subquery = &Subquery{Select: yyDollar[2].selStmt}
+		}
+	case 742:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3847
+		{
+			yyVAL.simpleTableExpr = yyDollar[1].subquery
+		}
+	case 743:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3851
+		{
+			yyVAL.simpleTableExpr = yyDollar[2].simpleTableExpr
+		}
+	case 744:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3857
+		{
+			yyVAL.selectExprs = nil
+		}
+	case 746:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3864
+		{
+			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
+		}
+	case 747:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3868
+		{
+			yyVAL.selectExprs = append(yyDollar[1].selectExprs, yyDollar[3].selectExpr)
+		}
+	case 748:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3874
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 749:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3878
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 750:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3884
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 751:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3888
+		{
+			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 752:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3892
+		{
+			yyVAL.expr = yyDollar[1].boolVal
+		}
+	case 753:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3896
+		{
+			yyVAL.expr = yyDollar[1].colName
+		}
+	case 754:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3900
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 755:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3904
+		{
+			yyVAL.expr = yyDollar[1].subquery
+		}
+	case 756:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3908
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
+		}
+	case 757:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3912
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
+		}
+	case 758:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3916
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
+		}
+	case 759:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3920
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ConcatStr, Right: yyDollar[3].expr}
+		}
+	case 760:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3924
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
+		}
+	case 761:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3928
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
+		}
+	case 762:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3932
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
+		}
+	case 763:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3936
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
+		}
+	case 764:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3940
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
+		}
+	case 765:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3944
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 766:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3948
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 767:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3952
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
+		}
+	case 768:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3956
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
+		}
+	case 769:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3960
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+		}
+	case 770:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3964
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+		}
+	case 771:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3968
+		{
+			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
+		}
+	case 772:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3972
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 773:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3976
+		{
+			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 774:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3980
+		{
+			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
+		}
+	case 775:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3984
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				yyVAL.expr = num
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 776:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3992
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				// Handle double negative
+				if num.Val[0] == '-' {
+					num.Val = num.Val[1:]
+					yyVAL.expr = num
+				} else {
+					yyVAL.expr = NewIntVal(append([]byte("-"), num.Val...))
+				}
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 777:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4006
+		{
+			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
+		}
+	case 778:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4010
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
+		}
+	case 779:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4014
+		{
+			// This rule prevents the usage of INTERVAL
+			// as a function. If support is needed for that,
+			// we'll need to revisit this. The solution
+			// will be non-trivial because of grammar conflicts.
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 786:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4034
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: yyDollar[3].str == DistinctStr, Exprs: yyDollar[4].selectExprs}
+		}
+	case 787:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4038
+		{
+			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+		}
+	case 788:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4048
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 789:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4052
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 790:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4056
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 791:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4060
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 792:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4064
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 793:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4068
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 794:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4072
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 795:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4076
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 796:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4080
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 797:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4084
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 798:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4088
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 799:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4092
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 800:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4096
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 801:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4100
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 802:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4104
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 803:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4108
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 804:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4112
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 805:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4121
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 806:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4125
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 807:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4129
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].selectExpr}, Over: yyDollar[5].over}
+		}
+	case 808:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4133
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 809:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4137
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].selectExpr}, Over: yyDollar[5].over}
+		}
+	case 810:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4141
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 811:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4145
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 812:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4149
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 813:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4153
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 814:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4157
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 815:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4161
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 816:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4172
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 817:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4176
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 818:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4180
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 819:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4184
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 820:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4188
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 821:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4192
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 822:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4196
+		{
+			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
+		}
+	case 823:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4200
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 824:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4204
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 825:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4208
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 826:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4212
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 827:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4216
+		{
+			yyVAL.expr = &TrimExpr{Pattern: NewStrVal([]byte(" ")), Str: yyDollar[3].expr, Dir: Both}
+		}
+	case 828:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4220
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[3].expr, Str: yyDollar[5].expr, Dir: Both}
+		}
+	case 829:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4224
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[4].expr, Str: yyDollar[6].expr, Dir: Leading}
+		}
+	case 830:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4228
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[4].expr, Str: yyDollar[6].expr, Dir: Trailing}
+		}
+	case 831:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4232
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[4].expr, Str: yyDollar[6].expr, Dir: Both}
+		}
+	case 832:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:4236
+		{
+			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
+		}
+	case 833:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4240
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 834:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4244
+		{
+			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
+		}
+	case 835:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4248
+		{
+			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
+		}
+	case 836:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4252
+		{
+			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+		}
+	case 837:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4256
+		{
+			yyVAL.expr = &ExtractFuncExpr{Name: string("extract"), Unit: yyDollar[3].str, Expr: yyDollar[5].expr}
+		}
+	case 838:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4260
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 839:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4264
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 840:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4274
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 841:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4278
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 842:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4282
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 843:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4287
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 844:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4292
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 845:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4297
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 846:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4303
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 847:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4308
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 848:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4312
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 849:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4317
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 850:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4321
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 851:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4325
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 852:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4330
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 853:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4335
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 854:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4340
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 855:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4344
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 856:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4348
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 857:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4355
+		{
+			yyVAL.str = yyDollar[1].colIdent.String()
+		}
+	case 858:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4359
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 859:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4363
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 860:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4367
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 861:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4371
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 862:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4375
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 863:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4379
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 864:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4383
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 865:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4387
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 866:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4391
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 867:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4395
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 868:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4399
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 871:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4410
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 872:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4420
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 873:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4424
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 874:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4428
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 875:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4432
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 876:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4436
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 877:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4440
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 878:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4446
+		{
+			yyVAL.str = ""
+		}
+	case 879:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4450
+		{
+			yyVAL.str = BooleanModeStr
+		}
+	case 880:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4454
+		{
+			yyVAL.str = NaturalLanguageModeStr
+		}
+	case 881:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4458
+		{
+			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
+		}
+	case 882:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4462
+		{
+			yyVAL.str = QueryExpansionStr
+		}
+	case 883:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4468
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 884:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4472
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 885:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4478
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 886:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4482
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
+		}
+	case 887:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4486
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
+		}
+	case 888:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4490
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 889:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4494
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 890:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4498
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 891:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4504
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 892:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4508
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 893:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4512
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 894:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4516
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 895:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4520
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 896:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4524
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 897:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4528
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 898:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4533
+		{
+			yyVAL.expr = nil
+		}
+	case 899:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4537
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 900:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4542
+		{
+			yyVAL.str = string("")
+		}
+	case 901:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4546
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 902:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4552
+		{
+			yyVAL.whens = []*When{yyDollar[1].when}
+		}
+	case 903:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4556
+		{
+			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
+		}
+	case 904:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4562
+		{
+			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
+		}
+	case 905:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4567
+		{
+			yyVAL.expr = nil
+		}
+	case 906:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4571
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 907:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4577
+		{
+			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
+		}
+	case 908:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4581
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
+		}
+	case 909:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4585
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
+		}
+	case 910:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4591
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 911:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4595
+		{
+			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
+		}
+	case 912:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4599
+		{
+			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
+		}
+	case 913:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4603
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 914:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4607
+		{
+			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
+		}
+	case 915:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4611
+		{
+			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
+		}
+	case 916:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4615
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 917:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4619
+		{
+			yyVAL.expr = &NullVal{}
+		}
+	case 918:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4625
+		{
+			yyVAL.expr = NewIntVal([]byte("1"))
+		}
+	case 919:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4629
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 920:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4633
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 921:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4638
+		{
+			yyVAL.exprs = nil
+		}
+	case 922:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4642
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 923:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4646
+		{
+			for _, e := range yyDollar[3].exprs {
+				if _, ok := e.(*GroupingSetExpr); ok {
+					yylex.Error("WITH ROLLUP can't be used with ROLLUP, CUBE or GROUPING SETS")
+					return 1
+				}
+			}
+			yyVAL.exprs = Exprs{&GroupingSetExpr{Type: WithRollupStr, Exprs: yyDollar[3].exprs}}
+		}
+	case 924:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4658
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 925:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4662
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 926:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4668
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 927:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4672
+		{
+			yyVAL.expr = &GroupingSetExpr{Type: RollupStr, Exprs: yyDollar[3].exprs}
+		}
+	case 928:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4676
+		{
+			yyVAL.expr = &GroupingSetExpr{Type: CubeStr, Exprs: yyDollar[3].exprs}
+		}
+	case 929:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4680
+		{
+			yyVAL.expr = &GroupingSetExpr{Type: GroupingSetsStr, Exprs: yyDollar[4].exprs}
+		}
+	case 930:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4686
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 931:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4690
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 932:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4697
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 933:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4701
+		{
+			yyVAL.expr = ValTuple{}
+		}
+	case 934:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4706
+		{
+			yyVAL.expr = nil
+		}
+	case 935:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4710
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 936:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4716
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 937:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4721
+		{
+			yyVAL.orderBy = nil
+		}
+	case 938:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4725
+		{
+			yyVAL.orderBy = yyDollar[3].orderBy
+		}
+	case 939:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4731
+		{
+			yyVAL.orderBy = OrderBy{yyDollar[1].order}
+		}
+	case 940:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4735
+		{
+			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
+		}
+	case 941:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4741
+		{
+			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
+		}
+	case 942:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4746
+		{
+			yyVAL.str = AscScr
+		}
+	case 943:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4750
+		{
+			yyVAL.str = AscScr
+		}
+	case 944:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4754
+		{
+			yyVAL.str = DescScr
+		}
+	case 945:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4759
+		{
+			yyVAL.limit = nil
+		}
+	case 946:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4763
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
+		}
+	case 947:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4767
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
+		}
+	case 948:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4771
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
+		}
+	case 949:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4777
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 950:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4781
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 951:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4786
+		{
+			yyVAL.str = ""
+		}
+	case 952:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4790
+		{
+			yyVAL.str = ForUpdateStr
+		}
+	case 953:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4794
+		{
+			yyVAL.str = ShareModeStr
+		}
+	case 954:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4807
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
+		}
+	case 955:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4811
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
+		}
+	case 956:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4815
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
+		}
+	case 957:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4820
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
+		}
+	case 958:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4824
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
+		}
+	case 959:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4828
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
+		}
+	case 960:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4835
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 961:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4839
+		{
+			yyVAL.columns = Columns{yyDollar[3].colIdent}
+		}
+	case 962:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4843
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 963:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4847
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
+		}
+	case 964:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4852
+		{
+			yyVAL.assignExprs = nil
+		}
+	case 965:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4856
+		{
+			yyVAL.assignExprs = yyDollar[5].assignExprs
+		}
+	case 966:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4862
+		{
+			yyVAL.values = Values{yyDollar[1].valTuple}
+		}
+	case 967:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4866
+		{
+			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
+		}
+	case 968:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4872
+		{
+			yyVAL.valTuple = yyDollar[1].valTuple
+		}
+	case 969:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4876
+		{
+			yyVAL.valTuple = ValTuple{}
+		}
+	case 970:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4882
+		{
+			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
+		}
+	case 971:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4888
+		{
+			if len(yyDollar[1].valTuple) == 1 {
+				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
+			} else {
+				yyVAL.expr = yyDollar[1].valTuple
+			}
+		}
+	case 972:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4898
+		{
+			yyVAL.assignExprs = AssignmentExprs{yyDollar[1].assignExpr}
+		}
+	case 973:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4902
+		{
+			yyVAL.assignExprs = append(yyDollar[1].assignExprs, yyDollar[3].assignExpr)
+		}
+	case 974:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4908
+		{
+			yyVAL.assignExpr = &AssignmentExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
+		}
+	case 975:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4911
+		{
+			yyVAL.assignExpr = &AssignmentExpr{Name: &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}, Expr: yyDollar[3].expr}
+		}
+	case 976:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4917
+		{
+			yyVAL.setVarExprs = SetVarExprs{yyDollar[1].setVarExpr}
+		}
+	case 977:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4921
+		{
+			yyVAL.setVarExprs = append(yyDollar[1].setVarExprs, yyDollar[3].setVarExpr)
+		}
+	case 978:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4927
+		{
+			colName, scope, err := VarScopeForColName(yyDollar[1].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyDollar[1].setVarExpr.Name = colName
+			yyDollar[1].setVarExpr.Scope = scope
+			yyVAL.setVarExpr = yyDollar[1].setVarExpr
+		}
+	case 979:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4938
+		{
+			_, scope, err := VarScopeForColName(yyDollar[2].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].setVarExpr.Name.Name.val))
+				return 1
+			}
+			yyDollar[2].setVarExpr.Scope = yyDollar[1].setScope
+			yyVAL.setVarExpr = yyDollar[2].setVarExpr
+		}
+	case 980:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4951
+		{
+			_, scope, err := VarScopeForColName(yyDollar[2].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].setVarExpr.Name.Name.val))
+				return 1
+			}
+			yyDollar[2].setVarExpr.Scope = yyDollar[1].setScope
+			yyVAL.setVarExpr = yyDollar[2].setVarExpr
+		}
+	case 981:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4964
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr, Scope: SetScope_Session}
+		}
+	case 982:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4970
+		{
+			yyVAL.setScope = SetScope_Global
+		}
+	case 983:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4974
+		{
+			yyVAL.setScope = SetScope_Session
+		}
+	case 984:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4980
+		{
+			yyVAL.setScope = SetScope_Session
+		}
+	case 985:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4984
+		{
+			yyVAL.setScope = SetScope_Persist
+		}
+	case 986:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4988
+		{
+			yyVAL.setScope = SetScope_PersistOnly
+		}
+	case 987:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4994
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 988:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4998
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 989:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5002
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr, Scope: SetScope_None}
+		}
+	case 990:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5006
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 991:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5010
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 992:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5014
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr, Scope: SetScope_None}
+		}
+	case 994:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5021
+		{
+			yyVAL.bytes = []byte("charset")
+		}
+	case 996:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5028
+		{
+			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
+		}
+	case 997:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5032
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 998:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5036
+		{
+			yyVAL.expr = &Default{}
+		}
+	case 1001:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5045
+		{
+			yyVAL.byt = 0
+		}
+	case 1002:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5047
+		{
+			yyVAL.byt = 1
+		}
+	case 1003:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5050
+		{
+			yyVAL.byt = 0
+		}
+	case 1004:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5052
+		{
+			yyVAL.byt = 1
+		}
+	case 1005:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5055
+		{
+			yyVAL.byt = 0
+		}
+	case 1006:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5057
+		{
+			yyVAL.byt = 1
+		}
+	case 1007:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5060
+		{
+			yyVAL.str = ""
+		}
+	case 1008:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5062
+		{
+			yyVAL.str = IgnoreStr
+		}
+	case 1009:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5065
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1010:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5067
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 1011:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5071
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1012:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5073
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1013:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5075
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1014:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5077
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1015:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5079
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1016:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5081
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1017:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5083
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1018:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5085
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1019:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5089
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1020:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5091
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1021:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5094
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1022:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5096
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1023:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5098
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1024:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5102
+		{
+			yyVAL.str = UniqueStr
+		}
+	case 1025:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5104
+		{
+			yyVAL.str = FulltextStr
+		}
+	case 1026:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5106
+		{
+			yyVAL.str = SpatialStr
+		}
+	case 1027:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5109
+		{
+			yyVAL.str = ""
+		}
+	case 1028:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5111
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1029:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5114
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 1030:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5116
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 1031:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5120
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1032:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5124
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1033:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5130
+		{
+			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
+		}
+	case 1034:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5134
+		{
+			yyVAL.colIdents = append(yyVAL.colIdents, yyDollar[3].colIdent)
+		}
+	case 1036:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5141
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1037:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5147
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1038:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5151
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1040:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5158
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1041:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5163
+		{
+			yyVAL.str = string("")
+		}
+	case 1042:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5165
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 1043:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5168
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 1044:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5170
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 1045:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5173
+		{
+			yyVAL.EnclosedBy = nil
+		}
+	case 1046:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5177
+		{
+			yyVAL.EnclosedBy = &EnclosedBy{Optionally: yyDollar[1].boolVal, Delim: NewStrVal(yyDollar[4].bytes)}
+		}
+	case 1047:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5182
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 1048:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5186
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 1049:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5191
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1050:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5195
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1051:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5200
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1052:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5204
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1053:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5209
+		{
+			yyVAL.Fields = nil
+		}
+	case 1054:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5213
+		{
+			yyVAL.Fields = &Fields{TerminatedBy: yyDollar[2].sqlVal, EnclosedBy: yyDollar[3].EnclosedBy, EscapedBy: yyDollar[4].sqlVal}
+		}
+	case 1055:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5218
+		{
+			yyVAL.Lines = nil
+		}
+	case 1056:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5222
+		{
+			yyVAL.Lines = &Lines{StartingBy: yyDollar[2].sqlVal, TerminatedBy: yyDollar[3].sqlVal}
+		}
+	case 1057:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5227
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1058:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5231
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1059:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5237
+		{
+			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
+		}
+	case 1060:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5243
+		{
+			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
+		}
+	case 1061:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5247
+		{
+			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
+		}
+	case 1062:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5253
+		{
+			yyVAL.tableAndLockType = &TableAndLockType{Table: &AliasedTableExpr{Expr: yyDollar[1].tableName}, Lock: yyDollar[2].lockType}
+		}
+	case 1063:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5257
+		{
+			yyVAL.tableAndLockType = &TableAndLockType{Table: &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[3].tableIdent}, Lock: yyDollar[4].lockType}
+		}
+	case 1064:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5263
+		{
+			yyVAL.lockType = LockRead
+		}
+	case 1065:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5267
+		{
+			yyVAL.lockType = LockReadLocal
+		}
+	case 1066:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5271
+		{
+			yyVAL.lockType = LockWrite
+		}
+	case 1067:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5275
+		{
+			yyVAL.lockType = LockLowPriorityWrite
+		}
+	case 1068:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5281
+		{
+			yyVAL.statement = &UnlockTables{}
+		}
+	case 1463:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5705
+		{
+			if incNesting(yylex) {
+				yylex.Error("max nesting level reached")
+				return 1
+			}
+		}
+	case 1464:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5714
+		{
+			decNesting(yylex)
+		}
+	case 1465:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5719
+		{
+			skipToEnd(yylex)
+		}
+	case 1466:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5724
+		{
+			skipToEnd(yylex)
+		}
+	case 1467:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5728
+		{
+			skipToEnd(yylex)
+		}
+	case 1468:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5732
+		{
+			skipToEnd(yylex)
+		}
+	}
+	goto yystack /* stack new state and value */
+}
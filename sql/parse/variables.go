@@ -24,6 +24,7 @@ import (
 
 func parseShowVariables(ctx *sql.Context, s string) (sql.Node, error) {
 	var pattern string
+	var global bool
 
 	r := bufio.NewReader(strings.NewReader(s))
 	for _, fn := range []parseFunc{
@@ -37,6 +38,7 @@ func parseShowVariables(ctx *sql.Context, s string) (sql.Node, error) {
 
 			switch s {
 			case "global", "session":
+				global = s == "global"
 				if err := skipSpaces(in); err != nil {
 					return err
 				}
@@ -68,5 +70,8 @@ func parseShowVariables(ctx *sql.Context, s string) (sql.Node, error) {
 		}
 	}
 
+	if global {
+		return plan.NewShowGlobalVariables(pattern), nil
+	}
 	return plan.NewShowVariables(pattern), nil
 }
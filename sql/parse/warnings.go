@@ -31,19 +31,33 @@ import (
 
 var errInvalidIndex = errors.NewKind("invalid %s index %d (index must be non-negative)")
 
+// parseShowWarnings parses SHOW WARNINGS and SHOW ERRORS, with an optional LIMIT, and their SHOW COUNT(*) variants.
 func parseShowWarnings(ctx *sql.Context, s string) (sql.Node, error) {
 	var (
-		offstr string
-		cntstr string
+		offstr    string
+		cntstr    string
+		kind      string
+		countOnly bool
 	)
 
 	r := bufio.NewReader(strings.NewReader(s))
 	for _, fn := range []parseFunc{
 		expect("show"),
 		skipSpaces,
-		expect("warnings"),
+		multiMaybe(&countOnly, "count", "(", "*", ")"),
 		skipSpaces,
+		readIdent(&kind),
 		func(in *bufio.Reader) error {
+			if kind != "warnings" && kind != "errors" {
+				return errUnexpectedSyntax.New("warnings or errors", kind)
+			}
+			return nil
+		},
+		skipSpaces,
+		func(in *bufio.Reader) error {
+			if countOnly {
+				return nil
+			}
 			if expect("limit")(in) == nil {
 				skipSpaces(in)
 				readValue(&cntstr)(in)
@@ -65,8 +79,16 @@ func parseShowWarnings(ctx *sql.Context, s string) (sql.Node, error) {
 		}
 	}
 
+	warnings := ctx.Session.Warnings()
+	if countOnly {
+		return plan.NewShowWarningsCount(warnings, kind == "errors"), nil
+	}
+	if kind == "errors" {
+		warnings = sql.ErrorWarnings(warnings)
+	}
+
 	var (
-		node   sql.Node = plan.ShowWarnings(ctx.Session.Warnings())
+		node   sql.Node = plan.ShowWarnings(warnings)
 		offset int
 		count  int
 		err    error
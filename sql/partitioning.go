@@ -0,0 +1,318 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PartitionMethod is the way the rows of a partitioned table are assigned to its partitions.
+type PartitionMethod byte
+
+const (
+	// PartitionByRange assigns rows to the first partition whose bound is greater than the value of their
+	// partitioning expression.
+	PartitionByRange PartitionMethod = iota + 1
+	// PartitionByList assigns rows to the partition whose values include the value of their partitioning expression.
+	PartitionByList
+	// PartitionByHash assigns rows to the partition whose position is the value of their partitioning expression
+	// modulo the number of partitions.
+	PartitionByHash
+)
+
+func (m PartitionMethod) String() string {
+	switch m {
+	case PartitionByRange:
+		return "RANGE"
+	case PartitionByList:
+		return "LIST"
+	case PartitionByHash:
+		return "HASH"
+	default:
+		return fmt.Sprintf("PartitionMethod(%d)", byte(m))
+	}
+}
+
+// PartitionDefinition is one of the partitions of a PartitionScheme.
+type PartitionDefinition struct {
+	Name string
+	// LessThan is the exclusive upper bound of the partitioning expression of the rows in a RANGE partition. It's nil
+	// for a partition declared with VALUES LESS THAN MAXVALUE.
+	LessThan interface{}
+	// Values are the values of the partitioning expression of the rows in a LIST partition.
+	Values []interface{}
+}
+
+// PartitionScheme is the way the rows of a table are split into partitions, declared with a PARTITION BY clause.
+type PartitionScheme struct {
+	Method PartitionMethod
+	// Expression is the partitioning expression, evaluated on the rows of the table.
+	Expression Expression
+	Partitions []PartitionDefinition
+}
+
+// PartitionedTable is a table whose rows are split into partitions by a PartitionScheme. The partitions it returns
+// have the names of the partitions of its scheme as keys.
+type PartitionedTable interface {
+	Table
+	// PartitionScheme returns the partitioning scheme of the table.
+	PartitionScheme() *PartitionScheme
+	// WithPartitions returns a version of the table that only reads the partitions named.
+	WithPartitions(names []string) Table
+}
+
+// PartitionedTableCreator is a database that can create partitioned tables.
+type PartitionedTableCreator interface {
+	Database
+	// CreatePartitionedTable creates a table with the given name and schema, whose rows are partitioned with the
+	// scheme given. If a table with that name already exists, must return sql.ErrTableAlreadyExists
+	CreatePartitionedTable(ctx *Context, name string, schema Schema, scheme *PartitionScheme) error
+}
+
+// NewPartitionScheme returns a partitioning scheme for the method, expression and partitions given, after checking
+// that they're valid and converting the bounds and values of the partitions to the type of the expression.
+func NewPartitionScheme(method PartitionMethod, expr Expression, partitions []PartitionDefinition) (*PartitionScheme, error) {
+	if len(partitions) == 0 {
+		return nil, ErrInvalidPartitionScheme.New("at least one partition must be defined")
+	}
+
+	typ := expr.Type()
+	if method == PartitionByHash && !IsInteger(typ) {
+		return nil, ErrInvalidPartitionScheme.New("the HASH partitioning expression must be an integer")
+	}
+
+	names := make(map[string]struct{}, len(partitions))
+	defs := make([]PartitionDefinition, len(partitions))
+	for i, p := range partitions {
+		lower := strings.ToLower(p.Name)
+		if _, ok := names[lower]; ok {
+			return nil, ErrInvalidPartitionScheme.New(fmt.Sprintf("duplicate partition name %s", p.Name))
+		}
+		names[lower] = struct{}{}
+
+		def := PartitionDefinition{Name: p.Name}
+		switch method {
+		case PartitionByRange:
+			if len(p.Values) > 0 {
+				return nil, ErrInvalidPartitionScheme.New("only VALUES LESS THAN can be used in RANGE partitioning")
+			}
+			if p.LessThan == nil {
+				if i != len(partitions)-1 {
+					return nil, ErrInvalidPartitionScheme.New("MAXVALUE can only be used in the last partition")
+				}
+				break
+			}
+
+			bound, err := typ.Convert(p.LessThan)
+			if err != nil {
+				return nil, err
+			}
+			if i > 0 {
+				cmp, err := typ.Compare(defs[i-1].LessThan, bound)
+				if err != nil {
+					return nil, err
+				}
+				if cmp >= 0 {
+					return nil, ErrInvalidPartitionScheme.New("VALUES LESS THAN must be strictly increasing for each partition")
+				}
+			}
+			def.LessThan = bound
+		case PartitionByList:
+			if p.LessThan != nil || len(p.Values) == 0 {
+				return nil, ErrInvalidPartitionScheme.New("only VALUES IN can be used in LIST partitioning")
+			}
+			for _, v := range p.Values {
+				if v != nil {
+					var err error
+					if v, err = typ.Convert(v); err != nil {
+						return nil, err
+					}
+				}
+				def.Values = append(def.Values, v)
+			}
+		case PartitionByHash:
+			if p.LessThan != nil || len(p.Values) > 0 {
+				return nil, ErrInvalidPartitionScheme.New("HASH partitions can't have values")
+			}
+		default:
+			return nil, ErrInvalidPartitionScheme.New(fmt.Sprintf("unknown partitioning method %s", method))
+		}
+		defs[i] = def
+	}
+
+	s := &PartitionScheme{Method: method, Expression: expr, Partitions: defs}
+	if method == PartitionByList {
+		// Each value can only be in one partition, so the first partition that has it must be its own
+		for i, p := range defs {
+			for _, v := range p.Values {
+				j, err := s.partitionIndex(v)
+				if err != nil {
+					return nil, err
+				}
+				if j != i {
+					return nil, ErrInvalidPartitionScheme.New(fmt.Sprintf("multiple definition of the same constant %v in LIST partitioning", v))
+				}
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// Names returns the names of the partitions of the scheme.
+func (s *PartitionScheme) Names() []string {
+	names := make([]string, len(s.Partitions))
+	for i, p := range s.Partitions {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// PartitionOf returns the name of the partition the row given belongs to, or ErrNoPartitionForValue if there isn't
+// one.
+func (s *PartitionScheme) PartitionOf(ctx *Context, row Row) (string, error) {
+	v, err := s.Expression.Eval(ctx, row)
+	if err != nil {
+		return "", err
+	}
+
+	i, err := s.partitionIndex(v)
+	if err != nil {
+		return "", err
+	}
+	return s.Partitions[i].Name, nil
+}
+
+// partitionIndex returns the position of the partition of the rows whose partitioning expression has the value given.
+// NULL values belong to the first RANGE partition, to the LIST partition that has NULL among its values, and to the
+// first HASH partition, as in MySQL.
+func (s *PartitionScheme) partitionIndex(v interface{}) (int, error) {
+	typ := s.Expression.Type()
+	switch s.Method {
+	case PartitionByRange:
+		for i, p := range s.Partitions {
+			if v == nil || p.LessThan == nil {
+				return i, nil
+			}
+			cmp, err := typ.Compare(v, p.LessThan)
+			if err != nil {
+				return 0, err
+			}
+			if cmp < 0 {
+				return i, nil
+			}
+		}
+	case PartitionByList:
+		for i, p := range s.Partitions {
+			for _, pv := range p.Values {
+				if v == nil || pv == nil {
+					if v == nil && pv == nil {
+						return i, nil
+					}
+					continue
+				}
+				cmp, err := typ.Compare(v, pv)
+				if err != nil {
+					return 0, err
+				}
+				if cmp == 0 {
+					return i, nil
+				}
+			}
+		}
+	case PartitionByHash:
+		if v == nil {
+			return 0, nil
+		}
+		n, err := Int64.Convert(v)
+		if err != nil {
+			return 0, err
+		}
+		i := n.(int64) % int64(len(s.Partitions))
+		if i < 0 {
+			i = -i
+		}
+		return int(i), nil
+	}
+
+	return 0, ErrNoPartitionForValue.New(v)
+}
+
+// PartitionsForValues returns the names of the partitions that can hold the rows whose partitioning expression has
+// one of the values given.
+func (s *PartitionScheme) PartitionsForValues(values []interface{}) ([]string, error) {
+	selected := make([]bool, len(s.Partitions))
+	for _, v := range values {
+		i, err := s.partitionIndex(v)
+		if ErrNoPartitionForValue.Is(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		selected[i] = true
+	}
+
+	return s.selectedNames(selected), nil
+}
+
+// PartitionsForRange returns the names of the partitions that can hold the rows whose partitioning expression is
+// between the bounds given. A nil bound leaves that side of the range open. Only RANGE partitions can be told apart
+// by a range of values, so the partitions of other methods are all returned.
+func (s *PartitionScheme) PartitionsForRange(lower interface{}, lowerInclusive bool, upper interface{}, upperInclusive bool) ([]string, error) {
+	if s.Method != PartitionByRange {
+		return s.Names(), nil
+	}
+
+	typ := s.Expression.Type()
+	selected := make([]bool, len(s.Partitions))
+	for i, p := range s.Partitions {
+		selected[i] = true
+
+		// The partition holds the values from the bound of the previous partition to its own bound
+		if upper != nil && i > 0 {
+			cmp, err := typ.Compare(upper, s.Partitions[i-1].LessThan)
+			if err != nil {
+				return nil, err
+			}
+			if cmp < 0 || (cmp == 0 && !upperInclusive) {
+				selected[i] = false
+			}
+		}
+
+		if lower != nil && p.LessThan != nil {
+			cmp, err := typ.Compare(p.LessThan, lower)
+			if err != nil {
+				return nil, err
+			}
+			if cmp <= 0 {
+				selected[i] = false
+			}
+		}
+	}
+
+	return s.selectedNames(selected), nil
+}
+
+func (s *PartitionScheme) selectedNames(selected []bool) []string {
+	names := make([]string, 0, len(s.Partitions))
+	for i, ok := range selected {
+		if ok {
+			names = append(names, s.Partitions[i].Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestPartitionOf(t *testing.T) {
+	field := expression.NewGetField(0, sql.Int64, "a", true)
+
+	rangeScheme, err := sql.NewPartitionScheme(sql.PartitionByRange, field, []sql.PartitionDefinition{
+		{Name: "p0", LessThan: 10},
+		{Name: "p1", LessThan: int8(20)},
+		{Name: "p2"},
+	})
+	require.NoError(t, err)
+
+	listScheme, err := sql.NewPartitionScheme(sql.PartitionByList, field, []sql.PartitionDefinition{
+		{Name: "odd", Values: []interface{}{1, 3}},
+		{Name: "even", Values: []interface{}{2, 4, nil}},
+	})
+	require.NoError(t, err)
+
+	hashScheme, err := sql.NewPartitionScheme(sql.PartitionByHash, field, []sql.PartitionDefinition{
+		{Name: "p0"}, {Name: "p1"}, {Name: "p2"},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		scheme    *sql.PartitionScheme
+		value     interface{}
+		partition string
+		err       bool
+	}{
+		{rangeScheme, int64(-5), "p0", false},
+		{rangeScheme, int64(10), "p1", false},
+		{rangeScheme, int64(19), "p1", false},
+		{rangeScheme, int64(1000), "p2", false},
+		{rangeScheme, nil, "p0", false},
+		{listScheme, int64(3), "odd", false},
+		{listScheme, int64(4), "even", false},
+		{listScheme, nil, "even", false},
+		{listScheme, int64(5), "", true},
+		{hashScheme, int64(4), "p1", false},
+		{hashScheme, int64(-5), "p2", false},
+		{hashScheme, nil, "p0", false},
+	}
+
+	ctx := sql.NewEmptyContext()
+	for _, tt := range testCases {
+		t.Run(tt.scheme.Method.String(), func(t *testing.T) {
+			partition, err := tt.scheme.PartitionOf(ctx, sql.NewRow(tt.value))
+			if tt.err {
+				require.True(t, sql.ErrNoPartitionForValue.Is(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.partition, partition)
+		})
+	}
+}
+
+func TestPartitionsForRange(t *testing.T) {
+	require := require.New(t)
+
+	scheme, err := sql.NewPartitionScheme(sql.PartitionByRange, expression.NewGetField(0, sql.Int64, "a", true), []sql.PartitionDefinition{
+		{Name: "p0", LessThan: 10},
+		{Name: "p1", LessThan: 20},
+		{Name: "p2"},
+	})
+	require.NoError(err)
+
+	names, err := scheme.PartitionsForRange(nil, false, int64(10), false)
+	require.NoError(err)
+	require.Equal([]string{"p0"}, names)
+
+	names, err = scheme.PartitionsForRange(nil, false, int64(10), true)
+	require.NoError(err)
+	require.Equal([]string{"p0", "p1"}, names)
+
+	names, err = scheme.PartitionsForRange(int64(20), true, nil, false)
+	require.NoError(err)
+	require.Equal([]string{"p2"}, names)
+
+	names, err = scheme.PartitionsForRange(int64(5), true, int64(15), true)
+	require.NoError(err)
+	require.Equal([]string{"p0", "p1"}, names)
+
+	names, err = scheme.PartitionsForValues([]interface{}{int64(12), int64(100)})
+	require.NoError(err)
+	require.Equal([]string{"p1", "p2"}, names)
+}
+
+func TestNewPartitionSchemeErrors(t *testing.T) {
+	field := expression.NewGetField(0, sql.Int64, "a", true)
+
+	testCases := []struct {
+		name       string
+		method     sql.PartitionMethod
+		expr       sql.Expression
+		partitions []sql.PartitionDefinition
+	}{
+		{"no partitions", sql.PartitionByRange, field, nil},
+		{"duplicate names", sql.PartitionByHash, field, []sql.PartitionDefinition{{Name: "p0"}, {Name: "P0"}}},
+		{"bounds not increasing", sql.PartitionByRange, field, []sql.PartitionDefinition{{Name: "p0", LessThan: 10}, {Name: "p1", LessThan: 10}}},
+		{"maxvalue not last", sql.PartitionByRange, field, []sql.PartitionDefinition{{Name: "p0"}, {Name: "p1", LessThan: 10}}},
+		{"duplicate list values", sql.PartitionByList, field, []sql.PartitionDefinition{{Name: "p0", Values: []interface{}{1}}, {Name: "p1", Values: []interface{}{1}}}},
+		{"hash of text", sql.PartitionByHash, expression.NewGetField(0, sql.LongText, "b", true), []sql.PartitionDefinition{{Name: "p0"}}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sql.NewPartitionScheme(tt.method, tt.expr, tt.partitions)
+			require.True(t, sql.ErrInvalidPartitionScheme.Is(err), "unexpected error %v", err)
+		})
+	}
+}
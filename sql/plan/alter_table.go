@@ -56,26 +56,43 @@ func (r *RenameTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		return nil, ErrRenameTableNotSupported.New(r.db.Name())
 	}
 
+	// Tables are renamed in order, so that tables can be swapped through a temporary name. If any of them can't be
+	// renamed, the ones already renamed are renamed back, so that the statement is applied either fully or not at all.
+	var renamed []int
+	oldNames := make([]string, len(r.oldNames))
 	var err error
 	for i, oldName := range r.oldNames {
 		var tbl sql.Table
 		var ok bool
 		tbl, ok, err = r.db.GetTableInsensitive(ctx, oldName)
 		if err != nil {
-			return nil, err
+			break
 		}
 
 		if !ok {
-			return nil, sql.ErrTableNotFound.New(oldName)
+			err = sql.ErrTableNotFound.New(oldName)
+			break
 		}
 
-		err = renamer.RenameTable(ctx, tbl.Name(), r.newNames[i])
+		oldNames[i] = tbl.Name()
+		err = renamer.RenameTable(ctx, oldNames[i], r.newNames[i])
 		if err != nil {
 			break
 		}
+		renamed = append(renamed, i)
+	}
+
+	if err != nil {
+		for j := len(renamed) - 1; j >= 0; j-- {
+			i := renamed[j]
+			if rerr := renamer.RenameTable(ctx, r.newNames[i], oldNames[i]); rerr != nil {
+				return nil, rerr
+			}
+		}
+		return nil, err
 	}
 
-	return sql.RowsToRowIter(), err
+	return sql.RowsToRowIter(), nil
 }
 
 func (r *RenameTable) WithChildren(children ...sql.Node) (sql.Node, error) {
@@ -144,6 +161,10 @@ func (a *AddColumn) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		}
 	}
 
+	if tblSch.IndexOf(a.column.Name, tbl.Name()) >= 0 {
+		return nil, sql.ErrDuplicateColumn.New(a.column.Name)
+	}
+
 	if err := a.validateDefaultPosition(tblSch); err != nil {
 		return nil, err
 	}
@@ -253,6 +274,10 @@ func (d *DropColumn) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		return nil, sql.ErrTableColumnNotFound.New(tbl.Name(), d.column)
 	}
 
+	if len(tbl.Schema()) == 1 {
+		return nil, sql.ErrCantDropAllColumns.New()
+	}
+
 	for _, col := range tbl.Schema() {
 		if col.Default == nil {
 			continue
@@ -321,6 +346,10 @@ func (r *RenameColumn) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, erro
 		return nil, sql.ErrTableColumnNotFound.New(tbl.Name(), r.columnName)
 	}
 
+	if newIdx := tbl.Schema().IndexOf(r.newColumnName, tbl.Name()); newIdx >= 0 && newIdx != idx {
+		return nil, sql.ErrDuplicateColumn.New(r.newColumnName)
+	}
+
 	nc := *tbl.Schema()[idx]
 	nc.Name = r.newColumnName
 	col := &nc
@@ -402,6 +431,10 @@ func (m *ModifyColumn) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, erro
 		return nil, sql.ErrTableColumnNotFound.New(tbl.Name(), m.columnName)
 	}
 
+	if newIdx := tblSch.IndexOf(m.column.Name, tbl.Name()); newIdx >= 0 && newIdx != idx {
+		return nil, sql.ErrDuplicateColumn.New(m.column.Name)
+	}
+
 	if m.order != nil && !m.order.First {
 		idx = tblSch.IndexOf(m.order.AfterColumn, tbl.Name())
 		if idx < 0 {
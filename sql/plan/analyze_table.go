@@ -0,0 +1,132 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// analyzeNotSupported is the message of the tables that don't implement sql.AnalyzableTable.
+const analyzeNotSupported = "The storage engine for the table doesn't support analyze"
+
+// AnalyzeTable is an ANALYZE TABLE statement, which computes the statistics of the tables given. Tables that can't be
+// analyzed, and errors analyzing them, are reported in the rows returned rather than failing the statement.
+type AnalyzeTable struct {
+	tables []sql.Node
+}
+
+var _ sql.Node = (*AnalyzeTable)(nil)
+
+// NewAnalyzeTable creates a new AnalyzeTable node.
+func NewAnalyzeTable(tables []sql.Node) *AnalyzeTable {
+	return &AnalyzeTable{tables: tables}
+}
+
+var analyzeTableSchema = sql.Schema{
+	{Name: "Table", Type: sql.LongText},
+	{Name: "Op", Type: sql.LongText},
+	{Name: "Msg_type", Type: sql.LongText},
+	{Name: "Msg_text", Type: sql.LongText},
+}
+
+// Schema implements the sql.Node interface.
+func (n *AnalyzeTable) Schema() sql.Schema {
+	return analyzeTableSchema
+}
+
+// Resolved implements the sql.Node interface.
+func (n *AnalyzeTable) Resolved() bool {
+	for _, t := range n.tables {
+		if !t.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (n *AnalyzeTable) Children() []sql.Node {
+	return n.tables
+}
+
+// WithChildren implements the sql.Node interface.
+func (n *AnalyzeTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != len(n.tables) {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), len(n.tables))
+	}
+	return NewAnalyzeTable(children), nil
+}
+
+// RowIter implements the sql.Node interface.
+func (n *AnalyzeTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.AnalyzeTable")
+	defer span.Finish()
+
+	rows := make([]sql.Row, len(n.tables))
+	for i, t := range n.tables {
+		rt, ok := t.(*ResolvedTable)
+		if !ok {
+			return nil, fmt.Errorf("unexpected table node in ANALYZE TABLE: %T", t)
+		}
+
+		db := rt.Database.Name()
+		name := fmt.Sprintf("%s.%s", db, rt.Name())
+		at, ok := getAnalyzableTable(rt.Table)
+		if !ok {
+			rows[i] = sql.NewRow(name, "analyze", "note", analyzeNotSupported)
+			continue
+		}
+
+		var err error
+		if ctx.Statistics != nil {
+			err = ctx.Statistics.Analyze(ctx, db, at)
+		} else {
+			err = at.AnalyzeTable(ctx)
+		}
+
+		if err != nil {
+			rows[i] = sql.NewRow(name, "analyze", "Error", err.Error())
+		} else {
+			rows[i] = sql.NewRow(name, "analyze", "status", "OK")
+		}
+	}
+
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func (n *AnalyzeTable) String() string {
+	names := make([]string, len(n.tables))
+	for i, t := range n.tables {
+		names[i] = t.String()
+	}
+
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("AnalyzeTable")
+	_ = p.WriteChildren(names...)
+	return p.String()
+}
+
+func getAnalyzableTable(t sql.Table) (sql.AnalyzableTable, bool) {
+	switch t := t.(type) {
+	case sql.AnalyzableTable:
+		return t, true
+	case sql.TableWrapper:
+		return getAnalyzableTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
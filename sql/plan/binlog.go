@@ -0,0 +1,271 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// BinlogStatementType is the kind of statement managing the binary log of the server.
+type BinlogStatementType int
+
+const (
+	// BinlogStatement_ShowMasterStatus is SHOW MASTER STATUS, or SHOW BINARY LOG STATUS.
+	BinlogStatement_ShowMasterStatus BinlogStatementType = iota
+	// BinlogStatement_ShowBinaryLogs is SHOW BINARY LOGS, or SHOW MASTER LOGS.
+	BinlogStatement_ShowBinaryLogs
+	// BinlogStatement_ShowReplicas is SHOW REPLICAS, or SHOW SLAVE HOSTS.
+	BinlogStatement_ShowReplicas
+	// BinlogStatement_PurgeBinaryLogs is PURGE BINARY LOGS, or PURGE MASTER LOGS.
+	BinlogStatement_PurgeBinaryLogs
+)
+
+var masterStatusSchema = sql.Schema{
+	{Name: "File", Type: sql.LongText},
+	{Name: "Position", Type: sql.Uint64},
+	{Name: "Binlog_Do_DB", Type: sql.LongText},
+	{Name: "Binlog_Ignore_DB", Type: sql.LongText},
+	{Name: "Executed_Gtid_Set", Type: sql.LongText},
+}
+
+var binaryLogsSchema = sql.Schema{
+	{Name: "Log_name", Type: sql.LongText},
+	{Name: "File_size", Type: sql.Uint64},
+	{Name: "Encrypted", Type: sql.LongText},
+}
+
+var replicasSchema = sql.Schema{
+	{Name: "Server_Id", Type: sql.Uint32},
+	{Name: "Host", Type: sql.LongText},
+	{Name: "Port", Type: sql.Uint32},
+	{Name: "Source_Id", Type: sql.Uint32},
+	{Name: "Replica_UUID", Type: sql.LongText},
+}
+
+// BinlogStatement is one of the administration statements of the binary log and of replication. They describe the
+// binary log set on the binlog of the engine, if any. Without one, they behave like they do on a MySQL server with
+// binary logging disabled: the status and the list of replicas are empty, purging logs does nothing, and listing the
+// log files is an error.
+type BinlogStatement struct {
+	bt BinlogStatementType
+	// purgeTarget is the TO or BEFORE clause of a PURGE BINARY LOGS statement.
+	purgeTarget string
+}
+
+var _ sql.Node = (*BinlogStatement)(nil)
+
+// NewBinlogStatement creates a new BinlogStatement node. The purge target is only used by PURGE BINARY LOGS.
+func NewBinlogStatement(bt BinlogStatementType, purgeTarget string) *BinlogStatement {
+	return &BinlogStatement{
+		bt:          bt,
+		purgeTarget: purgeTarget,
+	}
+}
+
+// Kind returns the kind of this statement.
+func (b *BinlogStatement) Kind() BinlogStatementType {
+	return b.bt
+}
+
+// Resolved implements the sql.Node interface.
+func (b *BinlogStatement) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (b *BinlogStatement) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (b *BinlogStatement) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 0)
+	}
+	return b, nil
+}
+
+// Schema implements the sql.Node interface.
+func (b *BinlogStatement) Schema() sql.Schema {
+	switch b.bt {
+	case BinlogStatement_ShowMasterStatus:
+		return masterStatusSchema
+	case BinlogStatement_ShowBinaryLogs:
+		return binaryLogsSchema
+	case BinlogStatement_ShowReplicas:
+		return replicasSchema
+	default:
+		return sql.OkResultSchema
+	}
+}
+
+// RowIter implements the sql.Node interface.
+func (b *BinlogStatement) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	log := binaryLog(ctx)
+	switch b.bt {
+	case BinlogStatement_ShowMasterStatus:
+		if log == nil {
+			return sql.RowsToRowIter(), nil
+		}
+		file, pos := log.Position()
+		return sql.RowsToRowIter(sql.NewRow(file, pos, "", "", log.ExecutedGTIDs())), nil
+	case BinlogStatement_ShowBinaryLogs:
+		if log == nil {
+			return nil, sql.ErrNoBinaryLogging.New()
+		}
+		var rows []sql.Row
+		for _, f := range log.Files() {
+			rows = append(rows, sql.NewRow(f.Name, f.Size, "No"))
+		}
+		return sql.RowsToRowIter(rows...), nil
+	case BinlogStatement_PurgeBinaryLogs:
+		if log != nil {
+			if matches := purgeToRegex.FindStringSubmatch(b.purgeTarget); matches != nil {
+				if err := log.Purge(matches[1]); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+	default:
+		return sql.RowsToRowIter(), nil
+	}
+}
+
+// purgeToRegex matches the TO clause of PURGE BINARY LOGS, capturing the file logs are purged up to. Logs aren't
+// purged by date.
+var purgeToRegex = regexp.MustCompile(`(?is)^to\s+['"](.*)['"]$`)
+
+// binaryLog returns the binary log written by the engine of the context given, or nil if it doesn't write one.
+func binaryLog(ctx *sql.Context) sql.BinaryLog {
+	if ctx.Binlog == nil {
+		return nil
+	}
+	return ctx.Binlog.BinaryLog()
+}
+
+func (b *BinlogStatement) String() string {
+	switch b.bt {
+	case BinlogStatement_ShowMasterStatus:
+		return "SHOW MASTER STATUS"
+	case BinlogStatement_ShowBinaryLogs:
+		return "SHOW BINARY LOGS"
+	case BinlogStatement_ShowReplicas:
+		return "SHOW REPLICAS"
+	default:
+		return fmt.Sprintf("PURGE BINARY LOGS %s", b.purgeTarget)
+	}
+}
+
+var binlogEventsSchema = sql.Schema{
+	{Name: "Log_name", Type: sql.LongText},
+	{Name: "Pos", Type: sql.Uint64},
+	{Name: "Event_type", Type: sql.LongText},
+	{Name: "Server_id", Type: sql.Uint32},
+	{Name: "End_log_pos", Type: sql.Uint64},
+	{Name: "Info", Type: sql.LongText},
+}
+
+// ShowBinlogEvents is SHOW BINLOG EVENTS, which lists the events of a file of the binary log written by the engine.
+type ShowBinlogEvents struct {
+	// LogName is the file whose events are listed. If empty, the events of the first file are.
+	LogName string
+	// Pos is the position of the first event listed.
+	Pos uint64
+	// Offset is the number of events skipped, and Limit the maximum number of events listed, if not negative.
+	Offset int64
+	Limit  int64
+}
+
+var _ sql.Node = (*ShowBinlogEvents)(nil)
+
+// NewShowBinlogEvents creates a new ShowBinlogEvents node, listing all the events of the file given.
+func NewShowBinlogEvents(logName string) *ShowBinlogEvents {
+	return &ShowBinlogEvents{LogName: logName, Limit: -1}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowBinlogEvents) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (s *ShowBinlogEvents) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowBinlogEvents) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+// Schema implements the sql.Node interface.
+func (s *ShowBinlogEvents) Schema() sql.Schema {
+	return binlogEventsSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (s *ShowBinlogEvents) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	log := binaryLog(ctx)
+	if log == nil {
+		// Without a binary log, MySQL lists no events
+		return sql.RowsToRowIter(), nil
+	}
+
+	name := s.LogName
+	if name == "" {
+		name = log.Files()[0].Name
+	}
+	events, err := log.Events(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	for _, e := range events {
+		if e.Pos < s.Pos {
+			continue
+		}
+		rows = append(rows, sql.NewRow(e.LogName, e.Pos, e.EventType, e.ServerID, e.EndLogPos, e.Info))
+	}
+	if s.Offset >= int64(len(rows)) {
+		rows = nil
+	} else {
+		rows = rows[s.Offset:]
+	}
+	if s.Limit >= 0 && s.Limit < int64(len(rows)) {
+		rows = rows[:s.Limit]
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func (s *ShowBinlogEvents) String() string {
+	var b strings.Builder
+	b.WriteString("SHOW BINLOG EVENTS")
+	if s.LogName != "" {
+		fmt.Fprintf(&b, " IN '%s'", s.LogName)
+	}
+	if s.Pos != 0 {
+		fmt.Fprintf(&b, " FROM %d", s.Pos)
+	}
+	if s.Limit >= 0 {
+		fmt.Fprintf(&b, " LIMIT %d, %d", s.Offset, s.Limit)
+	}
+	return b.String()
+}
@@ -90,56 +90,77 @@ func (b *Block) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	var returnSch sql.Schema
 
 	selectSeen := false
-	for _, s := range b.statements {
-		err := func() error {
-			rowCache, disposeFunc := ctx.Memory.NewRowsCache()
-			defer disposeFunc()
+	run := func(ctx *sql.Context, s sql.Node) error {
+		rowCache, disposeFunc := ctx.Memory.NewRowsCache()
+		defer disposeFunc()
 
-			var isSelect bool
-			subIter, err := s.RowIter(ctx, row)
-			if err != nil {
-				return err
-			}
-			subIterNode := s
-			subIterSch := s.Schema()
-			if blockSubIter, ok := subIter.(BlockRowIter); ok {
-				subIterNode = blockSubIter.RepresentingNode()
-				subIterSch = blockSubIter.Schema()
-			}
-			if isSelect = nodeRepresentsSelect(subIterNode); isSelect {
-				selectSeen = true
-				returnNode = subIterNode
-				returnSch = subIterSch
-			} else if !selectSeen {
-				returnNode = subIterNode
-				returnSch = subIterSch
-			}
+		var isSelect bool
+		subIter, err := s.RowIter(ctx, row)
+		if err != nil {
+			return err
+		}
+		subIterNode := s
+		subIterSch := s.Schema()
+		if blockSubIter, ok := subIter.(BlockRowIter); ok {
+			subIterNode = blockSubIter.RepresentingNode()
+			subIterSch = blockSubIter.Schema()
+		}
+		if isSelect = nodeRepresentsSelect(subIterNode); isSelect {
+			selectSeen = true
+			returnNode = subIterNode
+			returnSch = subIterSch
+		} else if !selectSeen {
+			returnNode = subIterNode
+			returnSch = subIterSch
+		}
 
-			for {
-				newRow, err := subIter.Next()
-				if err == io.EOF {
-					err := subIter.Close(ctx)
-					if err != nil {
-						return err
-					}
-					if isSelect || !selectSeen {
-						returnRows = rowCache.Get()
-					}
-					break
-				} else if err != nil {
+		for {
+			newRow, err := subIter.Next()
+			if err == io.EOF {
+				err := subIter.Close(ctx)
+				if err != nil {
+					return err
+				}
+				if isSelect || !selectSeen {
+					returnRows = rowCache.Get()
+				}
+				break
+			} else if err != nil {
+				return err
+			} else if isSelect || !selectSeen {
+				err = rowCache.Add(newRow)
+				if err != nil {
 					return err
-				} else if isSelect || !selectSeen {
-					err = rowCache.Add(newRow)
-					if err != nil {
-						return err
-					}
 				}
 			}
-			return nil
-		}()
-		if err != nil {
+		}
+		return nil
+	}
+
+	// Handlers are declared at the start of BEGIN/END blocks, and handle the conditions raised by the statements that
+	// follow them.
+	var handlers []*DeclareHandler
+	for _, s := range b.statements {
+		if handler, ok := s.(*DeclareHandler); ok {
+			handlers = append(handlers, handler)
+			continue
+		}
+
+		err := run(ctx, s)
+		if err == nil {
+			continue
+		}
+
+		handler, condition := findHandler(handlers, err)
+		if handler == nil {
 			return nil, err
 		}
+		if err := run(withHandlerCondition(ctx, condition), handler.Statement); err != nil {
+			return nil, err
+		}
+		if handler.Action == DeclareHandlerAction_Exit {
+			break
+		}
 	}
 
 	b.rowIterSch = returnSch
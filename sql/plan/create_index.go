@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -40,6 +41,9 @@ var (
 	ErrExprTypeNotIndexable = errors.NewKind("expression %q with type %s cannot be indexed")
 )
 
+// asyncIndexConfigKey is the key of the config of CREATE INDEX that, if true, saves the index in the background.
+const asyncIndexConfigKey = "async"
+
 // CreateIndex is a node to create an index.
 type CreateIndex struct {
 	Name            string
@@ -182,13 +186,23 @@ func (c *CreateIndex) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		"driver": index.Driver(),
 	})
 
-	createIndex := func() {
-		c.createIndex(ctx, log, driver, index, iter, created, ready)
+	createIndex := func(ctx *sql.Context) error {
+		return c.createIndex(ctx, log, driver, index, iter, created, ready)
 	}
 
 	log.Info("starting to save the index")
 
-	createIndex()
+	// Indexes created with the async option are saved by a background job of the engine, so the statement returns
+	// before the index is ready to be used.
+	if async, _ := strconv.ParseBool(c.Config[asyncIndexConfigKey]); async && ctx.BackgroundJobs != nil {
+		_, err := ctx.BackgroundJobs.Run(ctx, fmt.Sprintf("create index %s", index.ID()), createIndex)
+		if err == nil {
+			return sql.RowsToRowIter(), nil
+		}
+		log.WithField("err", err).Warn("unable to save the index in the background")
+	}
+
+	_ = createIndex(ctx)
 
 	return sql.RowsToRowIter(), nil
 }
@@ -201,7 +215,7 @@ func (c *CreateIndex) createIndex(
 	iter sql.PartitionIndexKeyValueIter,
 	done chan<- struct{},
 	ready <-chan struct{},
-) {
+) error {
 	span, ctx := ctx.Span("plan.createIndex",
 		opentracing.Tags{
 			"index":  index.ID(),
@@ -229,18 +243,20 @@ func (c *CreateIndex) createIndex(
 		ctx.Error(0, "unable to save the index: %s", err)
 		logrus.WithField("err", err).Error("unable to save the index")
 
-		deleted, err := ctx.GetIndexRegistry().DeleteIndex(index.Database(), index.ID(), true)
-		if err != nil {
-			ctx.Error(0, "unable to delete index: %s", err)
-			logrus.WithField("err", err).Error("unable to delete the index")
+		deleted, derr := ctx.GetIndexRegistry().DeleteIndex(index.Database(), index.ID(), true)
+		if derr != nil {
+			ctx.Error(0, "unable to delete index: %s", derr)
+			logrus.WithField("err", derr).Error("unable to delete the index")
 		} else {
 			<-deleted
 		}
-	} else {
-		<-ready
-		span.Finish()
-		log.Info("index successfully created")
+		return err
 	}
+
+	<-ready
+	span.Finish()
+	log.Info("index successfully created")
+	return nil
 }
 
 // Schema implements the Node interface.
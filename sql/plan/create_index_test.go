@@ -86,6 +86,48 @@ func TestCreateIndexAsync(t *testing.T) {
 	require.True(found)
 }
 
+func TestCreateIndexInBackground(t *testing.T) {
+	require := require.New(t)
+
+	table := memory.NewTable("foo", sql.Schema{
+		{Name: "a", Source: "foo"},
+		{Name: "b", Source: "foo"},
+	})
+
+	idxReg := sql.NewIndexRegistry()
+	driver := new(mockDriver)
+	idxReg.RegisterIndexDriver(driver)
+	db := memory.NewDatabase("foo")
+	db.AddTable("foo", table)
+	catalog := test.NewCatalog(sql.NewDatabaseProvider(db))
+
+	exprs := []sql.Expression{
+		expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", true),
+	}
+
+	ci := NewCreateIndex("idx", NewResolvedTable(table, nil, nil), exprs, "mock", map[string]string{
+		"async": "true",
+	})
+	ci.Catalog = catalog
+	ci.CurrentDatabase = "foo"
+
+	jobs := sql.NewBackgroundJobs()
+	sess := sql.NewBaseSession()
+	sess.SetIndexRegistry(idxReg)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess), sql.WithBackgroundJobs(jobs))
+	_, err := ci.RowIter(ctx, nil)
+	require.NoError(err)
+
+	require.Eventually(func() bool {
+		status := jobs.Jobs()
+		return len(status) == 1 && status[0].State == sql.BackgroundJobFinished
+	}, time.Second, 10*time.Millisecond)
+	require.Equal("create index idx", jobs.Jobs()[0].Name)
+	require.Equal([]string{"idx"}, driver.saved)
+	require.NotNil(idxReg.Index("foo", "idx"))
+	jobs.Close()
+}
+
 func TestCreateIndexNotIndexableExprs(t *testing.T) {
 	require := require.New(t)
 
@@ -16,18 +16,21 @@ package plan
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/vitess/go/mysql"
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 )
 
-// CreateDB creates an in memory database that lasts the length of the process only.
+// CreateDB creates an in memory database that lasts the length of the process only. Temporary databases only exist in
+// the session that created them, until it's closed.
 type CreateDB struct {
 	Catalog     sql.Catalog
 	dbName      string
 	IfNotExists bool
+	Temporary   bool
 }
 
 func (c CreateDB) Resolved() bool {
@@ -39,7 +42,11 @@ func (c CreateDB) String() string {
 	if c.IfNotExists {
 		ifNotExists = " if not exists"
 	}
-	return fmt.Sprintf("%s database%s %v", sqlparser.CreateStr, ifNotExists, c.dbName)
+	temporary := ""
+	if c.Temporary {
+		temporary = " temporary"
+	}
+	return fmt.Sprintf("%s%s database%s %v", sqlparser.CreateStr, temporary, ifNotExists, c.dbName)
 }
 
 func (c CreateDB) Schema() sql.Schema {
@@ -51,7 +58,10 @@ func (c CreateDB) Children() []sql.Node {
 }
 
 func (c CreateDB) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	exists := c.Catalog.HasDB(c.dbName)
+	_, exists := sql.GetSessionDatabase(ctx, c.dbName)
+	if !exists && !c.Temporary {
+		exists = c.Catalog.HasDB(c.dbName)
+	}
 	rows := []sql.Row{{sql.OkResult{RowsAffected: 1}}}
 
 	if exists {
@@ -68,7 +78,12 @@ func (c CreateDB) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		}
 	}
 
-	err := c.Catalog.CreateDatabase(ctx, c.dbName)
+	var err error
+	if c.Temporary {
+		err = c.Catalog.CreateSessionDatabase(ctx, c.dbName)
+	} else {
+		err = c.Catalog.CreateDatabase(ctx, c.dbName)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +102,17 @@ func NewCreateDatabase(dbName string, ifNotExists bool) *CreateDB {
 	}
 }
 
-// DropDB removes a databases from the Catalog and updates the active database if it gets removed itself.
+// NewCreateTemporaryDatabase returns a *CreateDB node that creates a database that only exists in the current session.
+func NewCreateTemporaryDatabase(dbName string, ifNotExists bool) *CreateDB {
+	return &CreateDB{
+		dbName:      dbName,
+		IfNotExists: ifNotExists,
+		Temporary:   true,
+	}
+}
+
+// DropDB removes a databases from the Catalog and updates the active database if it gets removed itself. The databases
+// of the current session take precedence over the ones of the Catalog with the same name.
 type DropDB struct {
 	Catalog  sql.Catalog
 	dbName   string
@@ -115,6 +140,13 @@ func (d DropDB) Children() []sql.Node {
 }
 
 func (d DropDB) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if sess, ok := ctx.Session.(sql.SessionDatabaseSession); ok && sess.DropSessionDatabase(d.dbName) {
+		if strings.EqualFold(ctx.GetCurrentDatabase(), d.dbName) {
+			ctx.SetCurrentDatabase("")
+		}
+		return sql.RowsToRowIter(sql.Row{sql.OkResult{RowsAffected: 1}}), nil
+	}
+
 	exists := d.Catalog.HasDB(d.dbName)
 	if !exists {
 		if d.IfExists {
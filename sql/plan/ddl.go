@@ -96,6 +96,8 @@ type TableSpec struct {
 	FkDefs  []*sql.ForeignKeyConstraint
 	ChDefs  []*sql.CheckConstraint
 	IdxDefs []*IndexDefinition
+	// PartitionScheme is the partitioning of the table, or nil if it isn't partitioned.
+	PartitionScheme *sql.PartitionScheme
 }
 
 func (c *TableSpec) WithSchema(schema sql.Schema) *TableSpec {
@@ -122,6 +124,12 @@ func (c *TableSpec) WithIndices(idxDefs []*IndexDefinition) *TableSpec {
 	return &nc
 }
 
+func (c *TableSpec) WithPartitionScheme(scheme *sql.PartitionScheme) *TableSpec {
+	nc := *c
+	nc.PartitionScheme = scheme
+	return &nc
+}
+
 // CreateTable is a node describing the creation of some table.
 type CreateTable struct {
 	ddlNode
@@ -134,6 +142,7 @@ type CreateTable struct {
 	like        sql.Node
 	temporary   TempTableOption
 	selectNode  sql.Node
+	partitions  *sql.PartitionScheme
 }
 
 var _ sql.Databaser = (*CreateTable)(nil)
@@ -153,6 +162,7 @@ func NewCreateTable(db sql.Database, name string, ifn IfNotExistsOption, temp Te
 		fkDefs:      tableSpec.FkDefs,
 		chDefs:      tableSpec.ChDefs,
 		idxDefs:     tableSpec.IdxDefs,
+		partitions:  tableSpec.PartitionScheme,
 		ifNotExists: ifn,
 		temporary:   temp,
 	}
@@ -181,6 +191,7 @@ func NewCreateTableSelect(db sql.Database, name string, selectNode sql.Node, tab
 		fkDefs:      tableSpec.FkDefs,
 		chDefs:      tableSpec.ChDefs,
 		idxDefs:     tableSpec.IdxDefs,
+		partitions:  tableSpec.PartitionScheme,
 		name:        name,
 		selectNode:  selectNode,
 		ifNotExists: ifn,
@@ -212,7 +223,22 @@ func (c *CreateTable) Resolved() bool {
 // RowIter implements the Node interface.
 func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	var err error
-	if c.temporary == IsTempTable {
+	if c.partitions != nil {
+		if c.temporary == IsTempTable {
+			return sql.RowsToRowIter(), sql.ErrInvalidPartitionScheme.New("temporary tables can't be partitioned")
+		}
+
+		creatable, ok := c.db.(sql.PartitionedTableCreator)
+		if !ok {
+			return sql.RowsToRowIter(), sql.ErrPartitioningNotSupported.New(c.db.Name())
+		}
+
+		if err := c.validateDefaultPosition(); err != nil {
+			return sql.RowsToRowIter(), err
+		}
+
+		err = creatable.CreatePartitionedTable(ctx, c.name, c.schema, c.partitions)
+	} else if c.temporary == IsTempTable {
 		creatable, ok := c.db.(sql.TemporaryTableCreator)
 		if !ok {
 			return sql.RowsToRowIter(), sql.ErrTemporaryTableNotSupported.New()
@@ -242,7 +268,7 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 
 	//TODO: in the event that foreign keys or indexes aren't supported, you'll be left with a created table and no foreign keys/indexes
 	//this also means that if a foreign key or index fails, you'll only have what was declared up to the failure
-	tableNode, ok, err := c.db.GetTableInsensitive(ctx, c.name)
+	tableNode, ok, err := c.getCreatedTable(ctx)
 	if err != nil {
 		return sql.RowsToRowIter(), err
 	}
@@ -274,6 +300,15 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 	return sql.RowsToRowIter(), nil
 }
 
+// getCreatedTable returns the table created by this node, which is a temporary table of the session if this node
+// creates a temporary table in a database that lists them.
+func (c *CreateTable) getCreatedTable(ctx *sql.Context) (sql.Table, bool, error) {
+	if _, ok := c.db.(sql.TemporaryTableDatabase); ok && c.temporary == IsTempTable {
+		return sql.GetTemporaryTable(ctx, c.db, c.name)
+	}
+	return c.db.GetTableInsensitive(ctx, c.name)
+}
+
 func (c *CreateTable) createIndexes(ctx *sql.Context, tableNode sql.Table) error {
 	idxAlterable, ok := tableNode.(sql.IndexAlterableTable)
 	if !ok {
@@ -413,6 +448,9 @@ func (c *CreateTable) DebugString() string {
 	if len(c.chDefs) > 0 {
 		children = append(children, c.checkConstraintsDebugString())
 	}
+	if c.partitions != nil {
+		children = append(children, fmt.Sprintf("Partition by %s(%s): %s", c.partitions.Method, sql.DebugString(c.partitions.Expression), strings.Join(c.partitions.Names(), ", ")))
+	}
 
 	p.WriteChildren(children...)
 	return p.String()
@@ -488,9 +526,10 @@ func (c *CreateTable) TableSpec() *TableSpec {
 	tableSpec := TableSpec{}
 
 	ret := tableSpec.WithSchema(c.schema)
-	ret = tableSpec.WithForeignKeys(c.fkDefs)
-	ret = tableSpec.WithIndices(c.idxDefs)
-	ret = tableSpec.WithCheckConstraints(c.chDefs)
+	ret = ret.WithForeignKeys(c.fkDefs)
+	ret = ret.WithIndices(c.idxDefs)
+	ret = ret.WithCheckConstraints(c.chDefs)
+	ret = ret.WithPartitionScheme(c.partitions)
 
 	return ret
 }
@@ -507,6 +546,18 @@ func (c *CreateTable) Temporary() TempTableOption {
 	return c.temporary
 }
 
+// PartitionScheme returns the partitioning of the table created, or nil if it isn't partitioned.
+func (c *CreateTable) PartitionScheme() *sql.PartitionScheme {
+	return c.partitions
+}
+
+// WithPartitionScheme returns a copy of this node creating a table partitioned with the scheme given.
+func (c *CreateTable) WithPartitionScheme(scheme *sql.PartitionScheme) *CreateTable {
+	nc := *c
+	nc.partitions = scheme
+	return &nc
+}
+
 func (c *CreateTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 	if len(exprs) != len(c.schema)+len(c.chDefs) {
 		return nil, sql.ErrInvalidChildrenNumber.New(c, len(exprs), len(c.schema)+len(c.chDefs))
@@ -0,0 +1,189 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// CreateFunction creates a user-defined function of a database, such as a function compiled to WebAssembly.
+type CreateFunction struct {
+	Db          sql.Database
+	Name        string
+	IfNotExists bool
+	// Function is the function created, which the parser has already built and checked.
+	Function sql.Function
+	// CreateStatement is the statement stored in the database, which the function is built from again when it's called.
+	CreateStatement string
+}
+
+var _ sql.Node = (*CreateFunction)(nil)
+var _ sql.Databaser = (*CreateFunction)(nil)
+
+// NewCreateFunction returns a *CreateFunction node.
+func NewCreateFunction(db sql.Database, name string, ifNotExists bool, fn sql.Function, createStatement string) *CreateFunction {
+	return &CreateFunction{
+		Db:              db,
+		Name:            name,
+		IfNotExists:     ifNotExists,
+		Function:        fn,
+		CreateStatement: createStatement,
+	}
+}
+
+// Database implements the sql.Databaser interface.
+func (c *CreateFunction) Database() sql.Database {
+	return c.Db
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (c *CreateFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	nc := *c
+	nc.Db = database
+	return &nc, nil
+}
+
+// Resolved implements the sql.Node interface.
+func (c *CreateFunction) Resolved() bool {
+	_, ok := c.Db.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+// String implements the sql.Node interface.
+func (c *CreateFunction) String() string {
+	ifNotExists := ""
+	if c.IfNotExists {
+		ifNotExists = "IF NOT EXISTS "
+	}
+	return fmt.Sprintf("CREATE FUNCTION %s%s", ifNotExists, c.Name)
+}
+
+// Schema implements the sql.Node interface.
+func (c *CreateFunction) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (c *CreateFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *CreateFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(c, children...)
+}
+
+// RowIter implements the sql.Node interface.
+func (c *CreateFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	fdb, ok := c.Db.(sql.UserFunctionDatabase)
+	if !ok {
+		return nil, sql.ErrUserFunctionsNotSupported.New(c.Db.Name())
+	}
+
+	now := time.Now()
+	err := fdb.SaveUserFunction(ctx, sql.UserFunctionDetails{
+		Name:            c.Name,
+		CreateStatement: c.CreateStatement,
+		CreatedAt:       now,
+		ModifiedAt:      now,
+	})
+	if err != nil && !(c.IfNotExists && sql.ErrUserFunctionAlreadyExists.Is(err)) {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{sql.NewOkResult(0)}), nil
+}
+
+// DropFunction drops a user-defined function of a database.
+type DropFunction struct {
+	db           sql.Database
+	IfExists     bool
+	FunctionName string
+}
+
+var _ sql.Databaser = (*DropFunction)(nil)
+var _ sql.Node = (*DropFunction)(nil)
+
+// NewDropFunction returns a *DropFunction node.
+func NewDropFunction(db sql.Database, functionName string, ifExists bool) *DropFunction {
+	return &DropFunction{
+		db:           db,
+		IfExists:     ifExists,
+		FunctionName: strings.ToLower(functionName),
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (d *DropFunction) Resolved() bool {
+	_, ok := d.db.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+// String implements the sql.Node interface.
+func (d *DropFunction) String() string {
+	ifExists := ""
+	if d.IfExists {
+		ifExists = "IF EXISTS "
+	}
+	return fmt.Sprintf("DROP FUNCTION %s%s", ifExists, d.FunctionName)
+}
+
+// Schema implements the sql.Node interface.
+func (d *DropFunction) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (d *DropFunction) Children() []sql.Node {
+	return nil
+}
+
+// RowIter implements the sql.Node interface.
+func (d *DropFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	fdb, ok := d.db.(sql.UserFunctionDatabase)
+	if !ok {
+		if d.IfExists {
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, sql.ErrUserFunctionsNotSupported.New(d.db.Name())
+	}
+	err := fdb.DropUserFunction(ctx, d.FunctionName)
+	if d.IfExists && sql.ErrUserFunctionDoesNotExist.Is(err) {
+		return sql.RowsToRowIter(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DropFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(d, children...)
+}
+
+// Database implements the sql.Databaser interface.
+func (d *DropFunction) Database() sql.Database {
+	return d.db
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (d *DropFunction) WithDatabase(db sql.Database) (sql.Node, error) {
+	nd := *d
+	nd.db = db
+	return &nd, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DeclareHandlerAction is what happens once the statement of a handler was executed.
+type DeclareHandlerAction byte
+
+const (
+	// DeclareHandlerAction_Continue continues with the statement following the one that raised the condition.
+	DeclareHandlerAction_Continue DeclareHandlerAction = iota
+	// DeclareHandlerAction_Exit leaves the BEGIN/END block the handler was declared in.
+	DeclareHandlerAction_Exit
+)
+
+// HandlerConditionType is the kind of condition value a handler is declared for.
+type HandlerConditionType byte
+
+const (
+	HandlerConditionType_SqlState HandlerConditionType = iota
+	HandlerConditionType_MysqlErrCode
+	HandlerConditionType_ConditionName
+	HandlerConditionType_SqlWarning
+	HandlerConditionType_NotFound
+	HandlerConditionType_SqlException
+)
+
+// HandlerCondition is one of the condition values a handler is declared for.
+type HandlerCondition struct {
+	Type          HandlerConditionType
+	SqlStateValue string
+	MysqlErrCode  int64
+	Name          string
+}
+
+// DeclareHandler represents the DECLARE ... HANDLER statement. Handlers are run by the BEGIN/END block they're declared
+// in, when one of its statements raises a condition they're declared for.
+type DeclareHandler struct {
+	Action     DeclareHandlerAction
+	Conditions []HandlerCondition
+	Statement  sql.Node
+}
+
+var _ sql.Node = (*DeclareHandler)(nil)
+var _ sql.DebugStringer = (*DeclareHandler)(nil)
+
+// NewDeclareHandler returns a *DeclareHandler node.
+func NewDeclareHandler(action DeclareHandlerAction, conditions []HandlerCondition, statement sql.Node) *DeclareHandler {
+	return &DeclareHandler{
+		Action:     action,
+		Conditions: conditions,
+		Statement:  statement,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (d *DeclareHandler) Resolved() bool {
+	return d.Statement.Resolved()
+}
+
+// String implements the sql.Node interface.
+func (d *DeclareHandler) String() string {
+	return fmt.Sprintf("%s %s", d.declaration(), d.Statement.String())
+}
+
+// DebugString implements the sql.DebugStringer interface.
+func (d *DeclareHandler) DebugString() string {
+	return fmt.Sprintf("%s %s", d.declaration(), sql.DebugString(d.Statement))
+}
+
+func (d *DeclareHandler) declaration() string {
+	action := "CONTINUE"
+	if d.Action == DeclareHandlerAction_Exit {
+		action = "EXIT"
+	}
+	conditions := make([]string, len(d.Conditions))
+	for i, c := range d.Conditions {
+		conditions[i] = c.String()
+	}
+	return fmt.Sprintf("DECLARE %s HANDLER FOR %s", action, strings.Join(conditions, ", "))
+}
+
+// Schema implements the sql.Node interface.
+func (d *DeclareHandler) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (d *DeclareHandler) Children() []sql.Node {
+	return []sql.Node{d.Statement}
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DeclareHandler) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 1)
+	}
+	nd := *d
+	nd.Statement = children[0]
+	return &nd, nil
+}
+
+// RowIter implements the sql.Node interface. Declaring a handler doesn't do anything by itself, it's run by its block.
+func (d *DeclareHandler) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return sql.RowsToRowIter(), nil
+}
+
+// matchPriority returns how specifically the handler matches the condition given, or 0 if it doesn't. As in MySQL,
+// handlers for an error code take precedence over handlers for a SQLSTATE, which take precedence over the handlers
+// for a class of conditions.
+func (d *DeclareHandler) matchPriority(condition *mysql.SQLError) int {
+	state := condition.SQLState()
+	class := state[:2]
+	priority := 0
+	for _, c := range d.Conditions {
+		p := 0
+		switch c.Type {
+		case HandlerConditionType_MysqlErrCode:
+			if int64(condition.Number()) == c.MysqlErrCode {
+				p = 3
+			}
+		case HandlerConditionType_SqlState:
+			if strings.EqualFold(state, c.SqlStateValue) {
+				p = 2
+			}
+		case HandlerConditionType_SqlWarning:
+			if class == "01" {
+				p = 1
+			}
+		case HandlerConditionType_NotFound:
+			if class == "02" {
+				p = 1
+			}
+		case HandlerConditionType_SqlException:
+			if class != "00" && class != "01" && class != "02" {
+				p = 1
+			}
+		}
+		if p > priority {
+			priority = p
+		}
+	}
+	return priority
+}
+
+// findHandler returns the handler of the ones given that handles the error given, along with the condition the error
+// raised, or nil if none of them handles it.
+func findHandler(handlers []*DeclareHandler, err error) (*DeclareHandler, *mysql.SQLError) {
+	if len(handlers) == 0 {
+		return nil, nil
+	}
+	condition, _ := sql.CastSQLError(err)
+	if condition == nil || len(condition.SQLState()) < 2 {
+		return nil, nil
+	}
+
+	var found *DeclareHandler
+	priority := 0
+	for _, h := range handlers {
+		if p := h.matchPriority(condition); p > priority {
+			found, priority = h, p
+		}
+	}
+	return found, condition
+}
+
+// handlerConditionKey is the key of the condition being handled in the contexts of handler statements.
+type handlerConditionKey struct{}
+
+// withHandlerCondition returns a context for the statement of a handler of the condition given, which RESIGNAL
+// raises again.
+func withHandlerCondition(ctx *sql.Context, condition *mysql.SQLError) *sql.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context, handlerConditionKey{}, condition))
+}
+
+// handlerCondition returns the condition being handled by the handler statement the context given belongs to, if any.
+func handlerCondition(ctx *sql.Context) (*mysql.SQLError, bool) {
+	condition, ok := ctx.Value(handlerConditionKey{}).(*mysql.SQLError)
+	return condition, ok
+}
+
+func (c HandlerCondition) String() string {
+	switch c.Type {
+	case HandlerConditionType_SqlState:
+		return fmt.Sprintf("SQLSTATE '%s'", c.SqlStateValue)
+	case HandlerConditionType_MysqlErrCode:
+		return fmt.Sprintf("%d", c.MysqlErrCode)
+	case HandlerConditionType_ConditionName:
+		return c.Name
+	case HandlerConditionType_SqlWarning:
+		return "SQLWARNING"
+	case HandlerConditionType_NotFound:
+		return "NOT FOUND"
+	default:
+		return "SQLEXCEPTION"
+	}
+}
@@ -137,7 +137,7 @@ func (d *deleteIter) Next() (sql.Row, error) {
 		row = row[len(row)-len(d.schema):]
 	}
 
-	return row, d.deleter.Delete(d.ctx, row)
+	return row, d.deleter.Delete(d.ctx, rowToStored(d.ctx, d.schema, row))
 }
 
 func (d *deleteIter) Close(ctx *sql.Context) error {
@@ -23,6 +23,9 @@ import (
 // Distinct is a node that ensures all rows that come from it are unique.
 type Distinct struct {
 	UnaryNode
+	// Hash is the expression hashing the rows of the child, if rows aren't hashed with sql.HashOf, such as a row
+	// hash of all their columns.
+	Hash sql.Expression
 }
 
 // NewDistinct creates a new Distinct node.
@@ -47,7 +50,7 @@ func (d *Distinct) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, newDistinctIter(ctx, it)), nil
+	return sql.NewSpanIter(span, newDistinctIter(ctx, it, d.Hash)), nil
 }
 
 // WithChildren implements the Node interface.
@@ -56,7 +59,16 @@ func (d *Distinct) WithChildren(children ...sql.Node) (sql.Node, error) {
 		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 1)
 	}
 
-	return NewDistinct(children[0]), nil
+	nd := NewDistinct(children[0])
+	nd.Hash = d.Hash
+	return nd, nil
+}
+
+// WithHash returns a copy of this node hashing its rows with the expression given.
+func (d *Distinct) WithHash(hash sql.Expression) *Distinct {
+	nd := *d
+	nd.Hash = hash
+	return &nd
 }
 
 func (d Distinct) String() string {
@@ -68,7 +80,11 @@ func (d Distinct) String() string {
 
 func (d Distinct) DebugString() string {
 	p := sql.NewTreePrinter()
-	_ = p.WriteNode("Distinct")
+	if d.Hash != nil {
+		_ = p.WriteNode("Distinct(hash: %s)", sql.DebugString(d.Hash))
+	} else {
+		_ = p.WriteNode("Distinct")
+	}
 	_ = p.WriteChildren(sql.DebugString(d.Child))
 	return p.String()
 }
@@ -79,15 +95,19 @@ func (d Distinct) DebugString() string {
 // Even though they are just 64-bit integers, this could be a problem in large
 // result sets.
 type distinctIter struct {
+	ctx       *sql.Context
 	childIter sql.RowIter
+	hash      sql.Expression
 	seen      sql.KeyValueCache
 	dispose   sql.DisposeFunc
 }
 
-func newDistinctIter(ctx *sql.Context, child sql.RowIter) *distinctIter {
+func newDistinctIter(ctx *sql.Context, child sql.RowIter, hash sql.Expression) *distinctIter {
 	cache, dispose := ctx.Memory.NewHistoryCache()
 	return &distinctIter{
+		ctx:       ctx,
 		childIter: child,
+		hash:      hash,
 		seen:      cache,
 		dispose:   dispose,
 	}
@@ -103,7 +123,7 @@ func (di *distinctIter) Next() (sql.Row, error) {
 			return nil, err
 		}
 
-		hash, err := sql.HashOf(row)
+		hash, err := di.hashOf(row)
 		if err != nil {
 			return nil, err
 		}
@@ -120,6 +140,21 @@ func (di *distinctIter) Next() (sql.Row, error) {
 	}
 }
 
+func (di *distinctIter) hashOf(row sql.Row) (uint64, error) {
+	if di.hash == nil {
+		return sql.HashOf(row)
+	}
+	hash, err := di.hash.Eval(di.ctx, row)
+	if err != nil {
+		return 0, err
+	}
+	hash, err = sql.Uint64.Convert(hash)
+	if err != nil {
+		return 0, err
+	}
+	return hash.(uint64), nil
+}
+
 func (di *distinctIter) Close(ctx *sql.Context) error {
 	di.Dispose()
 	return di.childIter.Close(ctx)
@@ -19,12 +19,26 @@ import "github.com/dolthub/go-mysql-server/sql"
 // EmptyTable is a node representing an empty table.
 var EmptyTable = new(emptyTable)
 
-type emptyTable struct{}
+// NewEmptyTableWithSchema returns a node representing an empty table with the schema given. It's used in place of the
+// nodes that are known to return no rows, so that the nodes above them still see the same schema.
+func NewEmptyTableWithSchema(schema sql.Schema) sql.Node {
+	return &emptyTable{schema: schema}
+}
+
+// IsEmptyTable returns whether the node given is an empty table.
+func IsEmptyTable(node sql.Node) bool {
+	_, ok := node.(*emptyTable)
+	return ok
+}
+
+type emptyTable struct {
+	schema sql.Schema
+}
 
-func (emptyTable) Schema() sql.Schema   { return nil }
-func (emptyTable) Children() []sql.Node { return nil }
-func (emptyTable) Resolved() bool       { return true }
-func (e *emptyTable) String() string    { return "EmptyTable" }
+func (e *emptyTable) Schema() sql.Schema { return e.schema }
+func (emptyTable) Children() []sql.Node  { return nil }
+func (emptyTable) Resolved() bool        { return true }
+func (e *emptyTable) String() string     { return "EmptyTable" }
 
 func (emptyTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	return sql.RowsToRowIter(), nil
@@ -0,0 +1,87 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SortSpillSizeSessionVar is the session variable holding the approximate number of bytes of rows a Sort buffers in
+// memory. Once a Sort buffers more than that, the rows are sorted and spilled to a temporary file, and the sorted runs
+// are merged when the rows are read. Zero disables spilling.
+const SortSpillSizeSessionVar = "sort_spill_size"
+
+// sortSpillSize returns the number of bytes of rows a Sort buffers before spilling them to disk, or zero if it must
+// keep all of them in memory.
+func sortSpillSize(ctx *sql.Context) uint64 {
+	return spillSize(ctx, SortSpillSizeSessionVar)
+}
+
+// sortRun is a sorted sequence of rows, either spilled to a temporary file or kept in memory.
+type sortRun struct {
+	file *spillFile
+	rows []sql.Row
+	done bool
+}
+
+// spillSortRun writes the sorted rows given to a temporary file and returns the run to read them back.
+func spillSortRun(rows []sql.Row) (*sortRun, error) {
+	f, err := newSpillFile()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if err := f.write(row); err != nil {
+			_ = f.close()
+			return nil, err
+		}
+	}
+
+	if err := f.rewind(); err != nil {
+		_ = f.close()
+		return nil, err
+	}
+
+	return &sortRun{file: f}, nil
+}
+
+// next returns the next row of the run, or io.EOF when there are none left.
+func (r *sortRun) next() (sql.Row, error) {
+	if r.file == nil {
+		if len(r.rows) == 0 {
+			return nil, io.EOF
+		}
+		row := r.rows[0]
+		r.rows = r.rows[1:]
+		return row, nil
+	}
+
+	return r.file.next()
+}
+
+// close releases the run, removing its temporary file if it has one.
+func (r *sortRun) close() error {
+	r.rows = nil
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.close()
+	r.file = nil
+	return err
+}
@@ -17,6 +17,8 @@ package plan
 import (
 	"fmt"
 	"io"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/cespare/xxhash"
@@ -31,11 +33,34 @@ import (
 // ErrGroupBy is returned when the aggregation is not supported.
 var ErrGroupBy = errors.NewKind("group by aggregation '%v' not supported")
 
+// ErrGroupingArgumentNotGrouped is returned when an argument of GROUPING isn't one of the grouping expressions.
+var ErrGroupingArgumentNotGrouped = errors.NewKind("argument #%d of GROUPING function is not in GROUP BY")
+
+// GroupBySpillSizeSessionVar is the session variable holding the approximate number of bytes of groups a GroupBy keeps
+// in memory. Once a GroupBy holds more than that, the rows of new groups are spilled to temporary files, partitioned by
+// their grouping key, and each partition is aggregated on its own after the groups in memory are returned. Zero
+// disables spilling.
+const GroupBySpillSizeSessionVar = "group_by_spill_size"
+
+// groupBySpillPartitions is the number of partitions the rows of a GroupBy are spilled to. Each level of spilling
+// partitions rows on the next groupBySpillBits bits of their grouping key.
+const (
+	groupBySpillPartitions = 16
+	groupBySpillBits       = 4
+)
+
+// aggregationBufferSize is the estimated size in memory of an aggregation buffer.
+const aggregationBufferSize = 64
+
 // GroupBy groups the rows by some expressions.
 type GroupBy struct {
 	UnaryNode
 	SelectedExprs []sql.Expression
 	GroupByExprs  []sql.Expression
+	// GroupingSets are the sets of grouping expressions, by their index in GroupByExprs, that the rows are grouped by
+	// one after the other, as in GROUP BY ROLLUP, CUBE and GROUPING SETS. The rows of each set have the grouping
+	// expressions that aren't part of it set to NULL. If empty, the rows are grouped by all the grouping expressions.
+	GroupingSets [][]int
 }
 
 // NewGroupBy creates a new GroupBy node. Like Project, GroupBy is a top-level node, and contains all the fields that
@@ -50,6 +75,13 @@ func NewGroupBy(selectedExprs, groupByExprs []sql.Expression, child sql.Node) *G
 	}
 }
 
+// WithGroupingSets returns a copy of the node grouping its rows by each of the sets of grouping expressions given.
+func (g *GroupBy) WithGroupingSets(sets [][]int) *GroupBy {
+	ng := *g
+	ng.GroupingSets = sets
+	return &ng
+}
+
 // Resolved implements the Resolvable interface.
 func (g *GroupBy) Resolved() bool {
 	return g.UnaryNode.Child.Resolved() &&
@@ -76,7 +108,7 @@ func (g *GroupBy) Schema() sql.Schema {
 		s[i] = &sql.Column{
 			Name:     name,
 			Type:     e.Type(),
-			Nullable: e.IsNullable(),
+			Nullable: e.IsNullable() || len(g.GroupingSets) > 0,
 			Source:   table,
 		}
 	}
@@ -98,8 +130,22 @@ func (g *GroupBy) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	}
 
 	var iter sql.RowIter
-	if len(g.GroupByExprs) == 0 {
+	if len(g.GroupingSets) > 0 {
+		sets, err := g.groupingSets()
+		if err != nil {
+			span.Finish()
+			i.Close(ctx)
+			return nil, err
+		}
+		grouping := newGroupByGroupingIter(ctx, g.SelectedExprs, g.GroupByExprs, i)
+		grouping.sets = sets
+		// Every row is aggregated once for each set, which partitions of spilled rows wouldn't account for
+		grouping.spillSize = 0
+		iter = grouping
+	} else if len(g.GroupByExprs) == 0 {
 		iter = newGroupByIter(ctx, g.SelectedExprs, i)
+	} else if g.InputSorted() {
+		iter = newGroupByStreamingIter(ctx, g.SelectedExprs, g.GroupByExprs, i)
 	} else {
 		iter = newGroupByGroupingIter(ctx, g.SelectedExprs, g.GroupByExprs, i)
 	}
@@ -107,13 +153,133 @@ func (g *GroupBy) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	return sql.NewSpanIter(span, iter), nil
 }
 
+// InputSorted returns whether the rows of the child of the node come sorted on its grouping expressions, so that they
+// are aggregated as they stream, one group at a time.
+func (g *GroupBy) InputSorted() bool {
+	return len(g.GroupingSets) == 0 && len(g.GroupByExprs) > 0 && groupingInputSorted(g.Child, g.GroupByExprs)
+}
+
+// groupingInputSorted returns whether the rows of the node given are sorted on the grouping expressions given, so that
+// the rows of every group come one after the other. Only grouping expressions of types whose values sort equal when they
+// are identical are considered, since rows are grouped on identical values.
+func groupingInputSorted(n sql.Node, groupByExprs []sql.Expression) bool {
+	for {
+		filter, ok := n.(*Filter)
+		if !ok {
+			break
+		}
+		n = filter.Child
+	}
+
+	order := inputOrder(n)
+	if len(order) == 0 {
+		return false
+	}
+
+	for _, e := range groupByExprs {
+		if !sql.IsInteger(e.Type()) && !sql.IsTime(e.Type()) {
+			return false
+		}
+	}
+
+	// The sort expressions must start with all the grouping expressions, in any order. A grouping expression monotonic
+	// in a sort expression is sorted too, but the rows of one of its groups aren't sorted on the expressions after it.
+	covered := make([]bool, len(groupByExprs))
+	remaining := len(groupByExprs)
+	for _, o := range order {
+		found, monotonic := false, false
+		for j, e := range groupByExprs {
+			if sameOrderExpression(o, e) {
+				found = true
+			} else if m, ok := e.(sql.MonotonicExpression); ok && m.MonotonicArgument() != nil && sameOrderExpression(o, m.MonotonicArgument()) {
+				found, monotonic = true, true
+			} else {
+				continue
+			}
+			if !covered[j] {
+				covered[j] = true
+				remaining--
+			}
+		}
+
+		if !found {
+			return false
+		}
+		if remaining == 0 {
+			return true
+		}
+		if monotonic {
+			return false
+		}
+	}
+
+	return false
+}
+
+// inputOrder returns the expressions the rows of the node given are sorted on: the fields of a sort, or the
+// expressions of an ordered index its table is read through. It returns nil if the rows aren't known to be sorted.
+func inputOrder(n sql.Node) []sql.Expression {
+	switch n := n.(type) {
+	case *Sort:
+		exprs := make([]sql.Expression, len(n.SortFields))
+		for i, sf := range n.SortFields {
+			exprs[i] = sf.Column
+		}
+		return exprs
+	case *DecoratedNode:
+		return inputOrder(n.Child)
+	case *TableAlias:
+		if ita, ok := n.Child.(*IndexedTableAccess); ok {
+			return indexOrder(ita, n.Name())
+		}
+	case *IndexedTableAccess:
+		return indexOrder(n, n.Name())
+	}
+	return nil
+}
+
+// indexOrder returns the columns of the index a table is read through, as fields of the table name given, if the index
+// returns its rows sorted on them.
+func indexOrder(n *IndexedTableAccess, table string) []sql.Expression {
+	idx, ok := n.index.(sql.OrderedIndex)
+	if !ok || idx.Order() != sql.IndexOrderAsc {
+		return nil
+	}
+
+	schema := n.ResolvedTable.Schema()
+	var exprs []sql.Expression
+Expressions:
+	for _, e := range idx.Expressions() {
+		name := e[strings.LastIndex(e, ".")+1:]
+		for i, col := range schema {
+			if strings.EqualFold(col.Name, name) {
+				exprs = append(exprs, expression.NewGetFieldWithTable(i, col.Type, table, col.Name, col.Nullable))
+				continue Expressions
+			}
+		}
+		break
+	}
+	return exprs
+}
+
+// sameOrderExpression returns whether two expressions are the same for sorting purposes. Fields are the same when they
+// name the same column, whatever their position in a row.
+func sameOrderExpression(a, b sql.Expression) bool {
+	if fa, ok := a.(*expression.GetField); ok {
+		if fb, ok := b.(*expression.GetField); ok {
+			return strings.EqualFold(fa.Table(), fb.Table()) && strings.EqualFold(fa.Name(), fb.Name())
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
 // WithChildren implements the Node interface.
 func (g *GroupBy) WithChildren(children ...sql.Node) (sql.Node, error) {
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 1)
 	}
 
-	return NewGroupBy(g.SelectedExprs, g.GroupByExprs, children[0]), nil
+	return NewGroupBy(g.SelectedExprs, g.GroupByExprs, children[0]).WithGroupingSets(g.GroupingSets), nil
 }
 
 // WithExpressions implements the Node interface.
@@ -129,7 +295,7 @@ func (g *GroupBy) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 	grouping := make([]sql.Expression, len(g.GroupByExprs))
 	copy(grouping, exprs[len(g.SelectedExprs):])
 
-	return NewGroupBy(agg, grouping, g.Child), nil
+	return NewGroupBy(agg, grouping, g.Child).WithGroupingSets(g.GroupingSets), nil
 }
 
 func (g *GroupBy) String() string {
@@ -146,11 +312,14 @@ func (g *GroupBy) String() string {
 		grouping[i] = g.String()
 	}
 
-	_ = pr.WriteChildren(
+	children := []string{
 		fmt.Sprintf("SelectedExprs(%s)", strings.Join(selectedExprs, ", ")),
 		fmt.Sprintf("Grouping(%s)", strings.Join(grouping, ", ")),
-		g.Child.String(),
-	)
+	}
+	if len(g.GroupingSets) > 0 {
+		children = append(children, fmt.Sprintf("GroupingSets(%s)", g.formatGroupingSets(sql.Expression.String)))
+	}
+	_ = pr.WriteChildren(append(children, g.Child.String())...)
 	return pr.String()
 }
 
@@ -168,11 +337,16 @@ func (g *GroupBy) DebugString() string {
 		grouping[i] = sql.DebugString(g)
 	}
 
-	_ = pr.WriteChildren(
+	children := []string{
 		fmt.Sprintf("SelectedExprs(%s)", strings.Join(selectedExprs, ", ")),
 		fmt.Sprintf("Grouping(%s)", strings.Join(grouping, ", ")),
-		sql.DebugString(g.Child),
-	)
+	}
+	if len(g.GroupingSets) > 0 {
+		children = append(children, fmt.Sprintf("GroupingSets(%s)", g.formatGroupingSets(func(e sql.Expression) string {
+			return sql.DebugString(e)
+		})))
+	}
+	_ = pr.WriteChildren(append(children, sql.DebugString(g.Child))...)
 	return pr.String()
 }
 
@@ -184,6 +358,118 @@ func (g *GroupBy) Expressions() []sql.Expression {
 	return exprs
 }
 
+// formatGroupingSets returns the grouping sets of the node, with their expressions formatted with the function given.
+func (g *GroupBy) formatGroupingSets(format func(sql.Expression) string) string {
+	sets := make([]string, len(g.GroupingSets))
+	for i, set := range g.GroupingSets {
+		exprs := make([]string, len(set))
+		for j, idx := range set {
+			exprs[j] = format(g.GroupByExprs[idx])
+		}
+		sets[i] = "(" + strings.Join(exprs, ", ") + ")"
+	}
+	return strings.Join(sets, ", ")
+}
+
+// groupingSet is a set of grouping expressions of a GroupBy, with the expressions to aggregate its groups.
+type groupingSet struct {
+	// keyExprs are the grouping expressions of the set, preceded by the index of the set, so that the groups of
+	// different sets never share a key.
+	keyExprs []sql.Expression
+	// selectedExprs are the selected expressions of the GroupBy, with the grouping expressions that aren't part of the
+	// set replaced by NULL outside of aggregations, and GROUPING replaced by its value for the set.
+	selectedExprs []sql.Expression
+	// groupedOut tells, for each grouping expression of the GroupBy, whether it's not part of the set.
+	groupedOut []bool
+}
+
+// groupingSetGroup is a group of a grouping set, with the values of the grouping expressions of the GroupBy that are
+// part of the set.
+type groupingSetGroup struct {
+	set    *groupingSet
+	values []interface{}
+}
+
+// groupingSets returns the grouping sets of the node.
+func (g *GroupBy) groupingSets() ([]groupingSet, error) {
+	sets := make([]groupingSet, len(g.GroupingSets))
+	for i, set := range g.GroupingSets {
+		groupedOut := make([]bool, len(g.GroupByExprs))
+		for j := range groupedOut {
+			groupedOut[j] = true
+		}
+
+		keyExprs := []sql.Expression{expression.NewLiteral(int64(i), sql.Int64)}
+		for _, idx := range set {
+			groupedOut[idx] = false
+			keyExprs = append(keyExprs, g.GroupByExprs[idx])
+		}
+
+		selectedExprs := make([]sql.Expression, len(g.SelectedExprs))
+		for j, e := range g.SelectedExprs {
+			var err error
+			selectedExprs[j], err = groupingSetExpression(e, g.GroupByExprs, groupedOut)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		sets[i] = groupingSet{keyExprs: keyExprs, selectedExprs: selectedExprs, groupedOut: groupedOut}
+	}
+	return sets, nil
+}
+
+// groupingSetExpression returns the expression given as evaluated for a grouping set, given whether each of the
+// grouping expressions is grouped out of it. Grouped out expressions are NULL, except inside aggregations, which still
+// aggregate their values.
+func groupingSetExpression(e sql.Expression, groupByExprs []sql.Expression, groupedOut []bool) (sql.Expression, error) {
+	if g, ok := e.(*aggregation.Grouping); ok {
+		argGroupedOut := make([]bool, len(g.Args))
+		for i, arg := range g.Args {
+			idx := groupByExprIndex(groupByExprs, arg)
+			if idx < 0 {
+				return nil, ErrGroupingArgumentNotGrouped.New(i + 1)
+			}
+			argGroupedOut[i] = groupedOut[idx]
+		}
+		return expression.NewLiteral(g.Mask(argGroupedOut), g.Type()), nil
+	}
+
+	if _, ok := e.(sql.Aggregation); ok {
+		return e, nil
+	}
+
+	if idx := groupByExprIndex(groupByExprs, e); idx >= 0 && groupedOut[idx] {
+		return expression.NewLiteral(nil, e.Type()), nil
+	}
+
+	children := e.Children()
+	if len(children) == 0 {
+		return e, nil
+	}
+
+	newChildren := make([]sql.Expression, len(children))
+	for i, c := range children {
+		var err error
+		newChildren[i], err = groupingSetExpression(c, groupByExprs, groupedOut)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e.WithChildren(newChildren...)
+}
+
+// groupByExprIndex returns the index of the expression given in the grouping expressions given, or -1 if it's not one
+// of them.
+func groupByExprIndex(groupByExprs []sql.Expression, e sql.Expression) int {
+	for i, g := range groupByExprs {
+		if g.String() == e.String() {
+			return i
+		}
+	}
+	return -1
+}
+
 type groupByIter struct {
 	selectedExprs []sql.Expression
 	child         sql.RowIter
@@ -254,6 +540,18 @@ type groupByGroupingIter struct {
 	child         sql.RowIter
 	ctx           *sql.Context
 	dispose       sql.DisposeFunc
+	// sets are the grouping sets every row is aggregated in, if the rows aren't only grouped by all the grouping
+	// expressions, and setGroups the groups of the sets by key.
+	sets      []groupingSet
+	setGroups map[uint64]groupingSetGroup
+
+	// Once the groups in memory take more than spillSize bytes, the rows of new groups are written to partitions,
+	// which are aggregated by partition after the groups in memory are returned.
+	spillSize  uint64
+	size       uint64
+	level      int
+	partitions []*spillFile
+	partition  *groupByGroupingIter
 }
 
 func newGroupByGroupingIter(
@@ -266,6 +564,7 @@ func newGroupByGroupingIter(
 		groupByExprs:  groupByExprs,
 		child:         child,
 		ctx:           ctx,
+		spillSize:     spillSize(ctx, GroupBySpillSizeSessionVar),
 	}
 }
 
@@ -278,7 +577,7 @@ func (i *groupByGroupingIter) Next() (sql.Row, error) {
 	}
 
 	if i.pos >= len(i.keys) {
-		return nil, io.EOF
+		return i.nextSpilled()
 	}
 
 	buffers, err := i.get(i.keys[i.pos])
@@ -299,37 +598,197 @@ func (i *groupByGroupingIter) compute() error {
 			return err
 		}
 
-		key, err := groupingKey(i.ctx, i.groupByExprs, row)
-		if err != nil {
-			return err
+		if len(i.sets) == 0 {
+			if err := i.aggregate(i.groupByExprs, i.selectedExprs, row); err != nil {
+				return err
+			}
+			continue
 		}
 
-		b, err := i.get(key)
-		if sql.ErrKeyNotFound.Is(err) {
-			b = make([]sql.AggregationBuffer, len(i.selectedExprs))
-			for j, a := range i.selectedExprs {
-				b[j], err = newAggregationBuffer(a)
-				if err != nil {
+		for j := range i.sets {
+			set := &i.sets[j]
+			groups := len(i.keys)
+			if err := i.aggregate(set.keyExprs, set.selectedExprs, row); err != nil {
+				return err
+			}
+			if len(i.keys) > groups {
+				if err := i.addSetGroup(i.keys[groups], set, row); err != nil {
 					return err
 				}
 			}
+		}
+	}
+
+	if len(i.sets) > 0 {
+		i.sortSetGroups()
+	}
+	return nil
+}
+
+// addSetGroup records the values of the grouping expressions of the new group of a grouping set with the key given,
+// from its first row.
+func (i *groupByGroupingIter) addSetGroup(key uint64, set *groupingSet, row sql.Row) error {
+	if i.setGroups == nil {
+		i.setGroups = make(map[uint64]groupingSetGroup)
+	}
+
+	values := make([]interface{}, len(i.groupByExprs))
+	for j, e := range i.groupByExprs {
+		if set.groupedOut[j] {
+			continue
+		}
+		v, err := e.Eval(i.ctx, row)
+		if err != nil {
+			return err
+		}
+		values[j] = v
+	}
+
+	i.setGroups[key] = groupingSetGroup{set: set, values: values}
+	return nil
+}
+
+// sortSetGroups sorts the groups of the grouping sets by the values of the grouping expressions, with the groups
+// that don't group by an expression after the ones that do, so that every super-aggregate row comes right after the
+// rows it summarizes, like the rows of WITH ROLLUP.
+func (i *groupByGroupingIter) sortSetGroups() {
+	sort.SliceStable(i.keys, func(a, b int) bool {
+		ga, gb := i.setGroups[i.keys[a]], i.setGroups[i.keys[b]]
+		for j, e := range i.groupByExprs {
+			outA, outB := ga.set.groupedOut[j], gb.set.groupedOut[j]
+			if outA != outB {
+				return outB
+			}
+			if outA {
+				continue
+			}
+			cmp, err := e.Type().Compare(ga.values[j], gb.values[j])
+			if err == nil && cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+}
+
+// aggregate updates the group of the row given, keyed by the grouping expressions given, creating its buffers for the
+// selected expressions given if it's a new group.
+func (i *groupByGroupingIter) aggregate(groupByExprs, selectedExprs []sql.Expression, row sql.Row) error {
+	key, err := groupingKey(i.ctx, groupByExprs, row)
+	if err != nil {
+		return err
+	}
+
+	b, err := i.get(key)
+	if sql.ErrKeyNotFound.Is(err) {
+		if i.spillSize > 0 && i.size > i.spillSize {
+			return i.spill(key, row)
+		}
+
+		groupSize := estimateRowSize(row) + aggregationBufferSize*uint64(len(selectedExprs))
+		if err := i.ctx.MemoryAccount().Grow(groupSize); err != nil {
+			// Groups that don't fit in the memory limits are spilled rather than failing the query, as long as
+			// some groups in memory are released before the spilled ones are aggregated
+			if i.spillSize > 0 && i.size > 0 {
+				return i.spill(key, row)
+			}
+			return err
+		}
+		i.size += groupSize
 
-			if err := i.aggregations.Put(key, b); err != nil {
+		b = make([]sql.AggregationBuffer, len(selectedExprs))
+		for j, a := range selectedExprs {
+			b[j], err = newAggregationBuffer(a)
+			if err != nil {
 				return err
 			}
+		}
 
-			i.keys = append(i.keys, key)
-		} else if err != nil {
+		if err := i.aggregations.Put(key, b); err != nil {
 			return err
 		}
 
-		err = updateBuffers(i.ctx, b, row)
+		i.keys = append(i.keys, key)
+	} else if err != nil {
+		return err
+	}
+
+	return updateBuffers(i.ctx, b, row)
+}
+
+// spill writes a row of a group that isn't in memory to the partition for its grouping key.
+func (i *groupByGroupingIter) spill(key uint64, row sql.Row) error {
+	if i.partitions == nil {
+		i.partitions = make([]*spillFile, groupBySpillPartitions)
+	}
+
+	p := (key >> uint(i.level*groupBySpillBits)) % groupBySpillPartitions
+	if i.partitions[p] == nil {
+		f, err := newSpillFile()
 		if err != nil {
 			return err
 		}
+		i.partitions[p] = f
 	}
 
-	return nil
+	return i.partitions[p].write(row)
+}
+
+// nextSpilled returns the next group of the spilled partitions, aggregating them one at a time.
+func (i *groupByGroupingIter) nextSpilled() (sql.Row, error) {
+	// All the groups in memory have been returned, so they're released before aggregating the partitions
+	if i.size > 0 {
+		i.releaseGroups()
+	}
+
+	for {
+		if i.partition != nil {
+			row, err := i.partition.Next()
+			if err != io.EOF {
+				return row, err
+			}
+
+			err = i.partition.Close(i.ctx)
+			i.partition = nil
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var f *spillFile
+		for f == nil && len(i.partitions) > 0 {
+			f, i.partitions = i.partitions[0], i.partitions[1:]
+		}
+		if f == nil {
+			return nil, io.EOF
+		}
+
+		if err := f.rewind(); err != nil {
+			_ = f.close()
+			return nil, err
+		}
+
+		i.partition = newGroupByGroupingIter(i.ctx, i.selectedExprs, i.groupByExprs, &spillFileIter{f})
+		i.partition.level = i.level + 1
+		// Once every bit of the grouping keys has been used to partition rows, spilling again wouldn't split them
+		if i.partition.level*groupBySpillBits >= 64 {
+			i.partition.spillSize = 0
+		}
+	}
+}
+
+// releaseGroups disposes of the groups in memory and releases the memory accounted for them.
+func (i *groupByGroupingIter) releaseGroups() {
+	i.Dispose()
+	if i.dispose != nil {
+		i.dispose()
+	}
+	i.aggregations, i.dispose = i.ctx.Memory.NewHistoryCache()
+	i.keys = nil
+	i.pos = 0
+
+	i.ctx.MemoryAccount().Shrink(i.size)
+	i.size = 0
 }
 
 func (i *groupByGroupingIter) get(key uint64) ([]sql.AggregationBuffer, error) {
@@ -350,14 +809,131 @@ func (i *groupByGroupingIter) put(key uint64, val []sql.AggregationBuffer) error
 func (i *groupByGroupingIter) Close(ctx *sql.Context) error {
 	i.Dispose()
 	i.aggregations = nil
+	i.setGroups = nil
 	if i.dispose != nil {
 		i.dispose()
 		i.dispose = nil
 	}
+	i.ctx.MemoryAccount().Shrink(i.size)
+	i.size = 0
 
+	var err error
+	if i.partition != nil {
+		err = i.partition.Close(ctx)
+		i.partition = nil
+	}
+	for _, f := range i.partitions {
+		if f != nil {
+			if cerr := f.close(); cerr != nil && err == nil {
+				err = cerr
+			}
+		}
+	}
+	i.partitions = nil
+
+	if cerr := i.child.Close(ctx); cerr != nil {
+		return cerr
+	}
+	return err
+}
+
+// groupByStreamingIter aggregates rows that come sorted on their grouping key, so that it only needs to keep the
+// buffers of one group in memory. Groups are returned in the same order as groupByGroupingIter would.
+type groupByStreamingIter struct {
+	selectedExprs []sql.Expression
+	groupByExprs  []sql.Expression
+	child         sql.RowIter
+	ctx           *sql.Context
+	buf           []sql.AggregationBuffer
+	key           uint64
+	done          bool
+}
+
+func newGroupByStreamingIter(
+	ctx *sql.Context,
+	selectedExprs, groupByExprs []sql.Expression,
+	child sql.RowIter,
+) *groupByStreamingIter {
+	return &groupByStreamingIter{
+		selectedExprs: selectedExprs,
+		groupByExprs:  groupByExprs,
+		child:         child,
+		ctx:           ctx,
+	}
+}
+
+func (i *groupByStreamingIter) Next() (sql.Row, error) {
+	if i.done {
+		return nil, io.EOF
+	}
+
+	for {
+		row, err := i.child.Next()
+		if err == io.EOF {
+			i.done = true
+			if i.buf == nil {
+				return nil, io.EOF
+			}
+			return i.evalGroup()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := groupingKey(i.ctx, i.groupByExprs, row)
+		if err != nil {
+			return nil, err
+		}
+
+		var result sql.Row
+		if i.buf != nil && key != i.key {
+			result, err = i.evalGroup()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if i.buf == nil {
+			i.buf = make([]sql.AggregationBuffer, len(i.selectedExprs))
+			for j, a := range i.selectedExprs {
+				i.buf[j], err = newAggregationBuffer(a)
+				if err != nil {
+					return nil, err
+				}
+			}
+			i.key = key
+		}
+
+		if err := updateBuffers(i.ctx, i.buf, row); err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			return result, nil
+		}
+	}
+}
+
+// evalGroup returns the row of the current group and releases its buffers.
+func (i *groupByStreamingIter) evalGroup() (sql.Row, error) {
+	row, err := evalBuffers(i.ctx, i.buf)
+	i.Dispose()
+	i.buf = nil
+	return row, err
+}
+
+func (i *groupByStreamingIter) Close(ctx *sql.Context) error {
+	i.Dispose()
+	i.buf = nil
 	return i.child.Close(ctx)
 }
 
+func (i *groupByStreamingIter) Dispose() {
+	for _, b := range i.buf {
+		b.Dispose()
+	}
+}
+
 func (i *groupByGroupingIter) Dispose() {
 	for _, k := range i.keys {
 		bs, _ := i.get(k)
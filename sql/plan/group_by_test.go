@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -168,6 +169,84 @@ func TestGroupByAggregationGrouping(t *testing.T) {
 	require.Equal(expected, rows)
 }
 
+func TestGroupByGroupingSets(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	child := memory.NewTable("test", sql.Schema{
+		{Name: "a", Type: sql.LongText, Source: "test"},
+		{Name: "b", Type: sql.Int64, Source: "test"},
+		{Name: "c", Type: sql.Int64, Source: "test"},
+	})
+	for _, r := range []sql.Row{
+		sql.NewRow("x", int64(1), int64(10)),
+		sql.NewRow("x", int64(2), int64(20)),
+		sql.NewRow("x", int64(2), int64(30)),
+		sql.NewRow("y", int64(1), int64(40)),
+	} {
+		require.NoError(child.Insert(ctx, r))
+	}
+
+	a := expression.NewGetFieldWithTable(0, sql.LongText, "test", "a", false)
+	b := expression.NewGetFieldWithTable(1, sql.Int64, "test", "b", false)
+	c := expression.NewGetFieldWithTable(2, sql.Int64, "test", "c", false)
+	grouping, err := aggregation.NewGrouping(a, b)
+	require.NoError(err)
+
+	// ROLLUP(a, b)
+	p := NewGroupBy(
+		[]sql.Expression{a, b, aggregation.NewSum(c), grouping},
+		[]sql.Expression{a, b},
+		NewResolvedTable(child, nil, nil),
+	).WithGroupingSets([][]int{{0, 1}, {0}, {}})
+
+	for _, col := range p.Schema() {
+		require.True(col.Nullable)
+	}
+
+	rows, err := sql.NodeToRows(ctx, p)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"x", int64(1), float64(10), int64(0)},
+		{"x", int64(2), float64(50), int64(0)},
+		{"x", nil, float64(60), int64(1)},
+		{"y", int64(1), float64(40), int64(0)},
+		{"y", nil, float64(40), int64(1)},
+		{nil, nil, float64(100), int64(3)},
+	}, rows)
+
+	// GROUPING SETS((a), (b)) has the same values in different sets
+	p = NewGroupBy(
+		[]sql.Expression{a, b, aggregation.NewCount(c)},
+		[]sql.Expression{a, b},
+		NewResolvedTable(child, nil, nil),
+	).WithGroupingSets([][]int{{0}, {1}})
+
+	rows, err = sql.NodeToRows(ctx, p)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"x", nil, int64(3)},
+		{"y", nil, int64(1)},
+		{nil, int64(1), int64(2)},
+		{nil, int64(2), int64(2)},
+	}, rows)
+
+	other, err := aggregation.NewGrouping(c)
+	require.NoError(err)
+	p = NewGroupBy(
+		[]sql.Expression{a, other},
+		[]sql.Expression{a},
+		NewResolvedTable(child, nil, nil),
+	).WithGroupingSets([][]int{{0}, {}})
+
+	_, err = sql.NodeToRows(ctx, p)
+	require.True(ErrGroupingArgumentNotGrouped.Is(err))
+
+	withChild, err := p.WithChildren(NewResolvedTable(child, nil, nil))
+	require.NoError(err)
+	require.Equal([][]int{{0}, {}}, withChild.(*GroupBy).GroupingSets)
+}
+
 func BenchmarkGroupBy(b *testing.B) {
 	table := benchmarkTable(b)
 
@@ -240,3 +319,89 @@ func benchmarkTable(t testing.TB) sql.Table {
 
 	return table
 }
+
+func TestGroupByStreaming(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	child := memory.NewTable("test", sql.Schema{
+		{Name: "col1", Type: sql.Int64, Source: "test"},
+		{Name: "col2", Type: sql.Int64, Source: "test"},
+	})
+	for _, r := range []sql.Row{
+		sql.NewRow(int64(2), int64(1)),
+		sql.NewRow(int64(1), int64(2)),
+		sql.NewRow(int64(3), int64(3)),
+		sql.NewRow(int64(1), int64(4)),
+		sql.NewRow(int64(2), int64(5)),
+	} {
+		require.NoError(child.Insert(ctx, r))
+	}
+
+	col1 := expression.NewGetFieldWithTable(0, sql.Int64, "test", "col1", false)
+	col2 := expression.NewGetFieldWithTable(1, sql.Int64, "test", "col2", false)
+	sorted := NewSort([]sql.SortField{{Column: col1, Order: sql.Descending}, {Column: col2, Order: sql.Ascending}}, NewResolvedTable(child, nil, nil))
+
+	require.True(groupingInputSorted(sorted, []sql.Expression{col1}))
+	require.True(groupingInputSorted(sorted, []sql.Expression{col2, col1}))
+	require.False(groupingInputSorted(sorted, []sql.Expression{col2}))
+	require.False(groupingInputSorted(NewResolvedTable(child, nil, nil), []sql.Expression{col1}))
+
+	gb := NewGroupBy(
+		[]sql.Expression{col1, aggregation.NewSum(col2)},
+		[]sql.Expression{col1},
+		sorted,
+	)
+
+	iter, err := gb.RowIter(ctx, nil)
+	require.NoError(err)
+	require.IsType(&groupByStreamingIter{}, iter)
+
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		sql.NewRow(int64(3), float64(3)),
+		sql.NewRow(int64(2), float64(6)),
+		sql.NewRow(int64(1), float64(6)),
+	}, rows)
+}
+
+func TestGroupBySpill(t *testing.T) {
+	child := memory.NewTable("test", sql.Schema{
+		{Name: "col1", Type: sql.Int64, Source: "test"},
+		{Name: "col2", Type: sql.Int64, Source: "test"},
+	})
+
+	expected := make(map[int64]float64)
+	for i := int64(0); i < 200; i++ {
+		require.NoError(t, child.Insert(sql.NewEmptyContext(), sql.NewRow(i%37, i)))
+		expected[i%37] += float64(i)
+	}
+
+	col1 := expression.NewGetFieldWithTable(0, sql.Int64, "test", "col1", false)
+	col2 := expression.NewGetFieldWithTable(1, sql.Int64, "test", "col2", false)
+
+	for _, size := range []uint64{0, 1, 1000} {
+		t.Run(fmt.Sprintf("spill size %d", size), func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			require.NoError(ctx.SetSessionVariable(ctx, GroupBySpillSizeSessionVar, size))
+
+			gb := NewGroupBy(
+				[]sql.Expression{col1, aggregation.NewSum(col2)},
+				[]sql.Expression{col1},
+				NewResolvedTable(child, nil, nil),
+			)
+
+			rows, err := sql.NodeToRows(ctx, gb)
+			require.NoError(err)
+
+			actual := make(map[int64]float64)
+			for _, row := range rows {
+				actual[row[0].(int64)] = row[1].(float64)
+			}
+			require.Len(rows, len(expected))
+			require.Equal(expected, actual)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/export"
+)
+
+// ImportRows returns the rows of the schema given read from r, delimited text in the format given. The lines are
+// parsed like the lines of a LOAD DATA file with the same FIELDS and LINES options, and r is decompressed the same way
+// if it's compressed with gzip or zstd. The values of the rows are converted to the types of their columns. Closing
+// the iterator closes r.
+func ImportRows(ctx *sql.Context, r io.ReadCloser, schema sql.Schema, format export.Format) (sql.RowIter, error) {
+	iter, err := newLoadDataIter(ctx, r, nil, schema, format)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	// Imports aren't LOAD DATA statements, so they aren't reported as such.
+	iter.reported = true
+	return &importIter{loadDataIter: iter}, nil
+}
+
+// ImportTable inserts into the table given the rows read from r by ImportRows, with the schema of the table, in a
+// single statement: if any row can't be inserted, none of them are. It returns the number of rows inserted, and
+// closes r.
+func ImportTable(ctx *sql.Context, r io.ReadCloser, table sql.InsertableTable, format export.Format) (n int64, err error) {
+	iter, err := ImportRows(ctx, r, table.Schema(), format)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if closeErr := iter.Close(ctx); err == nil {
+			err = closeErr
+		}
+	}()
+
+	inserter := table.Inserter(ctx)
+	inserter.StatementBegin(ctx)
+	defer func() {
+		if err != nil {
+			_ = inserter.DiscardChanges(ctx, err)
+			_ = inserter.Close(ctx)
+			n = 0
+			return
+		}
+		if err = inserter.StatementComplete(ctx); err == nil {
+			err = inserter.Close(ctx)
+		}
+	}()
+
+	for {
+		row, err := iter.Next()
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		if err := inserter.Insert(ctx, row); err != nil {
+			return n, err
+		}
+		n++
+	}
+}
+
+// importIter is the iterator of ImportRows, converting the values parsed from the lines of the file to the types of
+// their columns.
+type importIter struct {
+	*loadDataIter
+}
+
+func (i *importIter) Next() (sql.Row, error) {
+	row, err := i.loadDataIter.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	for j, col := range i.schema {
+		if row[j] == nil {
+			continue
+		}
+		row[j], err = col.Type.Convert(row[j])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/export"
+)
+
+func TestImportRows(t *testing.T) {
+	schema := sql.Schema{
+		{Name: "pk", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "c1", Type: sql.Text, Source: "t", Nullable: true},
+		{Name: "c2", Type: sql.Float64, Source: "t", Nullable: true},
+	}
+
+	var out bytes.Buffer
+	w := export.NewWriter(&out, schema, export.CSVFormat)
+	for _, row := range []sql.Row{{int64(1), "one", 1.5}, {int64(2), nil, nil}, {int64(3), "three", -2.0}} {
+		require.NoError(t, w.WriteRow(row))
+	}
+	require.NoError(t, w.Flush())
+	text := out.Bytes()
+
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	_, err := gw.Write(text)
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+
+	var zst bytes.Buffer
+	zw, err := zstd.NewWriter(&zst)
+	require.NoError(t, err)
+	_, err = zw.Write(text)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	expected := []sql.Row{{int64(1), "one", 1.5}, {int64(2), nil, nil}, {int64(3), "three", -2.0}}
+	for name, data := range map[string][]byte{"text": text, "gzip": gz.Bytes(), "zstd": zst.Bytes()} {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			iter, err := ImportRows(ctx, ioutil.NopCloser(bytes.NewReader(data)), schema, export.CSVFormat)
+			require.NoError(err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(err)
+			require.Equal(expected, rows)
+		})
+	}
+}
+
+func TestImportTable(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewTable("t", sql.Schema{
+		{Name: "pk", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "c1", Type: sql.Text, Source: "t", Nullable: true},
+	})
+
+	n, err := ImportTable(ctx, ioutil.NopCloser(strings.NewReader("1\tone\n2\t\\N\n")), table, export.DefaultFormat)
+	require.NoError(err)
+	require.Equal(int64(2), n)
+
+	// A line that can't be imported imports none of them
+	n, err = ImportTable(ctx, ioutil.NopCloser(strings.NewReader("3\tthree\nfour\t4\n")), table, export.DefaultFormat)
+	require.Error(err)
+	require.Equal(int64(0), n)
+
+	n, err = ImportTable(ctx, ioutil.NopCloser(strings.NewReader("1\tuno\n")), table, export.DefaultFormat)
+	require.Error(err)
+	require.True(sql.ErrPrimaryKeyViolation.Is(err) || sql.ErrUniqueKeyViolation.Is(err), "unexpected error %v", err)
+	require.Equal(int64(0), n)
+
+	rows, err := sql.RowIterToRows(ctx, mustRowIter(t, ctx, NewResolvedTable(table, nil, nil)))
+	require.NoError(err)
+	require.ElementsMatch([]sql.Row{{int64(1), "one"}, {int64(2), nil}}, rows)
+}
@@ -23,6 +23,11 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
+// IndexLookupBatchSizeSessionVar is the session variable holding the number of rows of the primary table an IndexedJoin
+// looks up in the secondary table at once, when the secondary table is a sql.BatchedIndexAddressableTable. One disables
+// batching.
+const IndexLookupBatchSizeSessionVar = "index_lookup_batch_size"
+
 // An IndexedJoin is a join that uses index lookups for the secondary table.
 type IndexedJoin struct {
 	// The primary and secondary table nodes. The normal meanings of Left and
@@ -126,7 +131,8 @@ func indexedJoinRowIter(
 		span.Finish()
 		return nil, err
 	}
-	return sql.NewSpanIter(span, &indexedJoinIter{
+
+	iter := &indexedJoinIter{
 		parentRow:         parentRow,
 		primary:           l,
 		secondaryProvider: right,
@@ -135,7 +141,43 @@ func indexedJoinRowIter(
 		joinType:          joinType,
 		rowSize:           len(parentRow) + len(left.Schema()) + len(right.Schema()),
 		scopeLen:          scopeLen,
-	}), nil
+	}
+
+	if batchSize := indexLookupBatchSize(ctx); batchSize > 1 {
+		if access, ok := batchedTableAccess(right); ok {
+			iter.batchAccess = access
+			iter.batchSize = batchSize
+		}
+	}
+
+	return sql.NewSpanIter(span, iter), nil
+}
+
+// indexLookupBatchSize returns the number of rows of the primary table an IndexedJoin looks up at once.
+func indexLookupBatchSize(ctx *sql.Context) int {
+	val, err := ctx.GetSessionVariable(ctx, IndexLookupBatchSizeSessionVar)
+	if err != nil {
+		return 1
+	}
+
+	size, ok := val.(int64)
+	if !ok {
+		return 1
+	}
+	return int(size)
+}
+
+// batchedTableAccess returns the IndexedTableAccess of the secondary node given if its lookups can be batched.
+func batchedTableAccess(n sql.Node) (*IndexedTableAccess, bool) {
+	switch n := n.(type) {
+	case *IndexedTableAccess:
+		if _, ok := n.batchedTable(); ok {
+			return n, true
+		}
+	case *TableAlias:
+		return batchedTableAccess(n.Child)
+	}
+	return nil, false
 }
 
 // indexedJoinIter is an iterator that iterates over every row in the primary table and performs an index lookup in
@@ -153,10 +195,26 @@ type indexedJoinIter struct {
 	foundMatch bool
 	rowSize    int
 	scopeLen   int
+
+	// When batchAccess is set, the secondary rows of batchSize primary rows are looked up at once and kept in batch.
+	batchAccess *IndexedTableAccess
+	batchSize   int
+	batch       []indexedJoinLookup
+	primaryDone bool
+}
+
+// indexedJoinLookup is a row of the primary table along with the iterator over its rows in the secondary table.
+type indexedJoinLookup struct {
+	primaryRow sql.Row
+	secondary  sql.RowIter
 }
 
 func (i *indexedJoinIter) loadPrimary() error {
 	if i.primaryRow == nil {
+		if i.batchAccess != nil {
+			return i.loadBatchedPrimary()
+		}
+
 		r, err := i.primary.Next()
 		if err != nil {
 			return err
@@ -169,6 +227,53 @@ func (i *indexedJoinIter) loadPrimary() error {
 	return nil
 }
 
+// loadBatchedPrimary loads the next primary row and its secondary rows from the current batch, looking up a new batch
+// when it's exhausted.
+func (i *indexedJoinIter) loadBatchedPrimary() error {
+	if len(i.batch) == 0 {
+		if err := i.loadBatch(); err != nil {
+			return err
+		}
+	}
+
+	i.primaryRow = i.batch[0].primaryRow
+	i.secondary = i.batch[0].secondary
+	i.batch = i.batch[1:]
+	i.foundMatch = false
+	return nil
+}
+
+func (i *indexedJoinIter) loadBatch() error {
+	var rows []sql.Row
+	for !i.primaryDone && len(rows) < i.batchSize {
+		r, err := i.primary.Next()
+		if err == io.EOF {
+			i.primaryDone = true
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		rows = append(rows, i.parentRow.Append(r))
+	}
+
+	if len(rows) == 0 {
+		return io.EOF
+	}
+
+	iters, err := i.batchAccess.RowIterBatch(i.ctx, rows)
+	if err != nil {
+		return err
+	}
+
+	i.batch = make([]indexedJoinLookup, len(rows))
+	for j := range rows {
+		i.batch[j] = indexedJoinLookup{primaryRow: rows[j], secondary: iters[j]}
+	}
+	return nil
+}
+
 func (i *indexedJoinIter) loadSecondary() (sql.Row, error) {
 	if i.secondary == nil {
 		rowIter, err := i.secondaryProvider.RowIter(i.ctx, i.primaryRow)
@@ -271,5 +376,12 @@ func (i *indexedJoinIter) Close(ctx *sql.Context) (err error) {
 		i.secondary = nil
 	}
 
+	for _, lookup := range i.batch {
+		if cerr := lookup.secondary.Close(ctx); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	i.batch = nil
+
 	return err
 }
@@ -0,0 +1,82 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestIndexedJoinBatchedLookups(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	left := memory.NewTable("l", sql.Schema{
+		{Name: "a", Source: "l", Type: sql.Int64},
+	})
+	right := memory.NewTable("r", sql.Schema{
+		{Name: "b", Source: "r", Type: sql.Int64},
+		{Name: "c", Source: "r", Type: sql.Text},
+	})
+	for _, v := range []int64{3, 1, 4, 2, 5} {
+		require.NoError(t, left.Insert(ctx, sql.NewRow(v)))
+	}
+	for _, row := range []sql.Row{
+		sql.NewRow(int64(1), "one"),
+		sql.NewRow(int64(3), "three"),
+		sql.NewRow(int64(2), "two"),
+		sql.NewRow(int64(3), "tres"),
+	} {
+		require.NoError(t, right.Insert(ctx, row))
+	}
+
+	leftField := expression.NewGetFieldWithTable(0, sql.Int64, "l", "a", false)
+	rightField := expression.NewGetFieldWithTable(1, sql.Int64, "r", "b", false)
+	idx := &memory.Index{TableName: "r", Tbl: right, Name: "r_b", Exprs: []sql.Expression{rightField.WithIndex(0)}}
+
+	expected := []sql.Row{
+		sql.NewRow(int64(3), int64(3), "three"),
+		sql.NewRow(int64(3), int64(3), "tres"),
+		sql.NewRow(int64(1), int64(1), "one"),
+		sql.NewRow(int64(4), nil, nil),
+		sql.NewRow(int64(2), int64(2), "two"),
+		sql.NewRow(int64(5), nil, nil),
+	}
+
+	for _, batchSize := range []int64{1, 2, 32} {
+		t.Run(fmt.Sprintf("batch size %d", batchSize), func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			require.NoError(ctx.SetSessionVariable(ctx, IndexLookupBatchSizeSessionVar, batchSize))
+
+			join := NewIndexedJoin(
+				NewResolvedTable(left, nil, nil),
+				NewTableAlias("r", NewIndexedTableAccess(NewResolvedTable(right, nil, nil), idx, []sql.Expression{leftField})),
+				JoinTypeLeft,
+				expression.NewEquals(leftField, rightField),
+				0,
+			)
+
+			rows, err := sql.NodeToRows(ctx, join)
+			require.NoError(err)
+			require.Equal(expected, rows)
+		})
+	}
+}
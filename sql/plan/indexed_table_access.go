@@ -77,7 +77,50 @@ func (i *IndexedTableAccess) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 		return nil, err
 	}
 
-	return sql.NewTableRowIter(ctx, indexedTable, partIter), nil
+	return timestampsToSession(ctx, i.Schema(), sql.NewTableRowIter(ctx, indexedTable, partIter)), nil
+}
+
+// batchedTable returns the table of this node if it can look up the rows of many index lookups at once.
+func (i *IndexedTableAccess) batchedTable() (sql.BatchedIndexAddressableTable, bool) {
+	return getBatchedIndexAddressableTable(i.ResolvedTable.Table)
+}
+
+func getBatchedIndexAddressableTable(table sql.Table) (sql.BatchedIndexAddressableTable, bool) {
+	switch t := table.(type) {
+	case sql.BatchedIndexAddressableTable:
+		return t, true
+	case sql.TableWrapper:
+		return getBatchedIndexAddressableTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
+
+// RowIterBatch returns an iterator for each of the rows given, like calling RowIter() for each of them, but looking
+// up the rows for all of them in a single call to the table. The table must be a BatchedIndexAddressableTable.
+func (i *IndexedTableAccess) RowIterBatch(ctx *sql.Context, rows []sql.Row) ([]sql.RowIter, error) {
+	table, ok := i.batchedTable()
+	if !ok {
+		return nil, ErrNoIndexableTable.New(i.ResolvedTable)
+	}
+
+	lookups := make([]sql.IndexLookup, len(rows))
+	for j, row := range rows {
+		lookup, err := i.getLookup(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		lookups[j] = lookup
+	}
+
+	iters, err := table.IndexLookupBatch(ctx, lookups)
+	if err != nil {
+		return nil, err
+	}
+	for j, iter := range iters {
+		iters[j] = timestampsToSession(ctx, i.Schema(), iter)
+	}
+	return iters, nil
 }
 
 func (i *IndexedTableAccess) CanBuildIndex(ctx *sql.Context) (bool, error) {
@@ -147,7 +190,7 @@ func formatIndexDecoratorString(idx sql.Index) string {
 
 func (i *IndexedTableAccess) DebugString() string {
 	if i.lookup != nil {
-		return fmt.Sprintf("IndexedTableAccess(%s on %s, using fields %s)", i.Name(), formatIndexDecoratorString(i.index), "STATIC LOOKUP("+sql.DebugString(i.lookup)+")")
+		return fmt.Sprintf("IndexedTableAccess(%s on %s, using fields %s)", i.Name(), formatIndexDecoratorString(i.index), "STATIC LOOKUP("+sql.DebugString(i.lookup.Ranges())+")")
 	}
 	keyExprs := make([]string, len(i.keyExprs))
 	for j := range i.keyExprs {
@@ -17,8 +17,10 @@ package plan
 import (
 	"fmt"
 	"io"
+	"math"
 	"strings"
 
+	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -127,6 +129,7 @@ type insertIter struct {
 	tableNode           sql.Node
 	closed              bool
 	ignore              bool
+	sqlMode             sql.SqlMode
 }
 
 func GetInsertable(node sql.Node) (sql.InsertableTable, error) {
@@ -188,9 +191,21 @@ func newInsertIter(
 		}
 	}
 
-	rowIter, err := values.RowIter(ctx, row)
-	if err != nil {
-		return nil, err
+	// When the rows to insert are selected from the table being inserted into, all of them are read before the first
+	// insert, so that the inserted rows are never read back as rows to insert. The projection of the values is still
+	// evaluated as each row is inserted, since it assigns the AUTO_INCREMENT values.
+	var rowIter sql.RowIter
+	if readsTable(values, insertable.Name()) {
+		if p, ok := values.(*Project); ok {
+			rowIter = &iter{p: p, childIter: &bufferedRowIter{ctx: ctx, node: p.Child, row: row}, row: row, ctx: ctx}
+		} else {
+			rowIter = &bufferedRowIter{ctx: ctx, node: values, row: row}
+		}
+	} else {
+		rowIter, err = values.RowIter(ctx, row)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	insertExpressions := getInsertExpressions(values)
@@ -206,6 +221,7 @@ func newInsertIter(
 		checks:      checks,
 		ctx:         ctx,
 		ignore:      ignore,
+		sqlMode:     sql.LoadSqlMode(ctx),
 	}
 
 	if replacer != nil {
@@ -215,6 +231,70 @@ func newInsertIter(
 	}
 }
 
+// bufferedRowIter reads all the rows of a node on its first call to Next, and then returns them one by one. Errors
+// reading the rows are returned by that first call, after which there are no rows left.
+type bufferedRowIter struct {
+	ctx  *sql.Context
+	node sql.Node
+	row  sql.Row
+	rows sql.RowIter
+}
+
+func (b *bufferedRowIter) Next() (sql.Row, error) {
+	if b.rows == nil {
+		b.rows = sql.RowsToRowIter()
+
+		iter, err := b.node.RowIter(b.ctx, b.row)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := sql.RowIterToRows(b.ctx, iter)
+		if err != nil {
+			return nil, err
+		}
+		b.rows = sql.RowsToRowIter(rows...)
+	}
+	return b.rows.Next()
+}
+
+func (b *bufferedRowIter) Close(ctx *sql.Context) error {
+	if b.rows == nil {
+		return nil
+	}
+	return b.rows.Close(ctx)
+}
+
+// readsTable returns whether the node given reads the rows of the table with the name given, either directly or in
+// a subquery.
+func readsTable(n sql.Node, name string) bool {
+	var found bool
+	Inspect(n, func(n sql.Node) bool {
+		if found {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ResolvedTable:
+			found = strings.EqualFold(n.Name(), name)
+		case *IndexedTableAccess:
+			found = strings.EqualFold(n.Name(), name)
+		}
+
+		if ex, ok := n.(sql.Expressioner); ok && !found {
+			for _, e := range ex.Expressions() {
+				sql.Inspect(e, func(e sql.Expression) bool {
+					if sq, ok := e.(*Subquery); ok && readsTable(sq.Query, name) {
+						found = true
+					}
+					return !found
+				})
+			}
+		}
+		return !found
+	})
+	return found
+}
+
 func getInsertExpressions(values sql.Node) []sql.Expression {
 	var exprs []sql.Expression
 	Inspect(values, func(node sql.Node) bool {
@@ -267,15 +347,19 @@ func (i *insertIter) Next() (returnRow sql.Row, returnErr error) {
 	}
 
 	// Do any necessary type conversions to the target schema
-	for i, col := range i.schema {
-		if row[i] != nil {
-			row[i], err = col.Type.Convert(row[i])
+	for idx, col := range i.schema {
+		if row[idx] != nil {
+			row[idx], err = i.convertValue(col, row[idx])
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	// The rows returned have the TIMESTAMP values of the session, like the rows read from tables, while the rows written
+	// to the table have them in UTC.
+	stored := rowToStored(i.ctx, i.schema, row)
+
 	if i.replacer != nil {
 		toReturn := make(sql.Row, len(row)*2)
 		for i := 0; i < len(row); i++ {
@@ -284,7 +368,7 @@ func (i *insertIter) Next() (returnRow sql.Row, returnErr error) {
 		// May have multiple duplicate pk & unique errors due to multiple indexes
 		//TODO: how does this interact with triggers?
 		for {
-			if err := i.replacer.Insert(i.ctx, row); err != nil {
+			if err := i.replacer.Insert(i.ctx, stored); err != nil {
 				if !sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) {
 					_ = i.rowSource.Close(i.ctx)
 					return nil, err
@@ -296,22 +380,20 @@ func (i *insertIter) Next() (returnRow sql.Row, returnErr error) {
 					return nil, err
 				}
 				// the row had to be deleted, write the values into the toReturn row
-				for i := 0; i < len(ue.Existing); i++ {
-					toReturn[i] = ue.Existing[i]
-				}
+				copy(toReturn, rowToSession(i.ctx, i.schema, ue.Existing))
 			} else {
 				break
 			}
 		}
 		return toReturn, nil
 	} else {
-		if err := i.inserter.Insert(i.ctx, row); err != nil {
+		if err := i.inserter.Insert(i.ctx, stored); err != nil {
 			if (!sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) && !sql.ErrDuplicateEntry.Is(err)) || len(i.updateExprs) == 0 {
 				return i.ignoreOrClose(err)
 			}
 
 			ue := err.(*errors.Error).Cause().(sql.UniqueKeyError)
-			return i.handleOnDuplicateKeyUpdate(row, ue.Existing)
+			return i.handleOnDuplicateKeyUpdate(row, rowToSession(i.ctx, i.schema, ue.Existing))
 		}
 	}
 
@@ -320,6 +402,126 @@ func (i *insertIter) Next() (returnRow sql.Row, returnErr error) {
 	return row, nil
 }
 
+// convertValue converts the value given to the type of the column given, according to the sql_mode of the session.
+// In strict mode, values that don't fit the column are errors. Otherwise, and with INSERT IGNORE, strings that are too
+// long are truncated, out of range numbers are clamped to the closest value of the type and invalid or out of range
+// dates are written as zero values, with a warning. Zero dates are accepted unless NO_ZERO_DATE is enabled, in which
+// case they are errors in strict mode and warnings otherwise.
+func (i *insertIter) convertValue(col *sql.Column, val interface{}) (interface{}, error) {
+	converted, err := col.Type.Convert(val)
+	strict := i.sqlMode.Strict() && !i.ignore
+
+	if err != nil && !strict && (sql.ErrOutOfRange.Is(err) || sql.ErrConvertToDecimalLimit.Is(err)) {
+		if clamped, ok := clampNumber(col.Type, val); ok {
+			i.ctx.Warn(1264, "Out of range value for column '%s'", col.Name)
+			return clamped, nil
+		}
+	}
+
+	if st, ok := col.Type.(sql.StringType); ok {
+		if err == nil || !sql.ErrLengthBeyondLimit.Is(err) || strict {
+			return converted, err
+		}
+		i.ctx.Warn(1265, "Data truncated for column '%s'", col.Name)
+		return truncateString(st, val)
+	}
+
+	_, isDatetime := col.Type.(sql.DatetimeType)
+	if !isDatetime && col.Type != sql.Year {
+		return converted, err
+	}
+
+	if err != nil {
+		if !sql.ErrConvertingToTimeOutOfRange.Is(err) && !sql.ErrConvertingToTime.Is(err) && !sql.ErrConvertingToYear.Is(err) {
+			return nil, err
+		}
+		if strict {
+			return nil, err
+		}
+
+		if sql.ErrConvertingToTime.Is(err) {
+			i.ctx.Warn(1265, "Data truncated for column '%s'", col.Name)
+		} else {
+			i.ctx.Warn(1264, "Out of range value for column '%s'", col.Name)
+		}
+		return col.Type.Zero(), nil
+	}
+
+	if sql.IsZeroTime(converted) && i.sqlMode.ModeEnabled(sql.SqlModeNoZeroDate) {
+		if strict {
+			return nil, sql.ErrIncorrectTimeValue.New(strings.ToLower(col.Type.String()), val, col.Name)
+		}
+		i.ctx.Warn(1264, "Out of range value for column '%s'", col.Name)
+	}
+
+	return converted, nil
+}
+
+// numberBounds are the smallest and largest values of the numeric types with a fixed range.
+var numberBounds = map[sql.Type][2]interface{}{
+	sql.Int8:    {int64(math.MinInt8), int64(math.MaxInt8)},
+	sql.Int16:   {int64(math.MinInt16), int64(math.MaxInt16)},
+	sql.Int24:   {int64(-1 << 23), int64(1<<23 - 1)},
+	sql.Int32:   {int64(math.MinInt32), int64(math.MaxInt32)},
+	sql.Int64:   {int64(math.MinInt64), int64(math.MaxInt64)},
+	sql.Uint8:   {uint64(0), uint64(math.MaxUint8)},
+	sql.Uint16:  {uint64(0), uint64(math.MaxUint16)},
+	sql.Uint24:  {uint64(0), uint64(1<<24 - 1)},
+	sql.Uint32:  {uint64(0), uint64(math.MaxUint32)},
+	sql.Uint64:  {uint64(0), uint64(math.MaxUint64)},
+	sql.Float32: {float64(-math.MaxFloat32), float64(math.MaxFloat32)},
+}
+
+// clampNumber returns the value of the numeric type given closest to the out of range value given, like MySQL stores
+// out of range values outside of strict mode, and whether the type has such a value.
+func clampNumber(typ sql.Type, val interface{}) (interface{}, bool) {
+	f, err := sql.Float64.Convert(val)
+	if err != nil {
+		return nil, false
+	}
+	negative := f.(float64) < 0
+
+	var bound interface{}
+	if dt, ok := typ.(sql.DecimalType); ok {
+		// The largest decimal has every digit of its precision set to 9
+		max := decimal.New(1, int32(dt.Precision()-dt.Scale())).Sub(decimal.New(1, -int32(dt.Scale())))
+		if negative {
+			max = max.Neg()
+		}
+		bound = max
+	} else if bounds, ok := numberBounds[typ]; ok {
+		bound = bounds[1]
+		if negative {
+			bound = bounds[0]
+		}
+	} else {
+		return nil, false
+	}
+
+	clamped, err := typ.Convert(bound)
+	return clamped, err == nil
+}
+
+// truncateString returns the longest prefix of the value given that fits in the string type given.
+func truncateString(typ sql.StringType, val interface{}) (interface{}, error) {
+	str, err := sql.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+
+	runes := []rune(str.(string))
+	if int64(len(runes)) > typ.MaxCharacterLength() {
+		runes = runes[:typ.MaxCharacterLength()]
+	}
+	for {
+		converted, err := typ.Convert(string(runes))
+		if err == nil || !sql.ErrLengthBeyondLimit.Is(err) || len(runes) == 0 {
+			return converted, err
+		}
+		runes = runes[:len(runes)-1]
+	}
+}
+
 func (i *insertIter) handleOnDuplicateKeyUpdate(row, rowToUpdate sql.Row) (returnRow sql.Row, returnErr error) {
 	err := i.resolveValues(i.ctx, row)
 	if err != nil {
@@ -331,7 +533,7 @@ func (i *insertIter) handleOnDuplicateKeyUpdate(row, rowToUpdate sql.Row) (retur
 		return nil, err
 	}
 
-	err = i.updater.Update(i.ctx, rowToUpdate, newRow)
+	err = i.updater.Update(i.ctx, rowToStored(i.ctx, i.schema, rowToUpdate), rowToStored(i.ctx, i.schema, newRow))
 	if err != nil {
 		return nil, err
 	}
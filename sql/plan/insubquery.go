@@ -68,6 +68,12 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			return nil, sql.ErrInvalidOperandColumns.New(sql.NumColumns(typ), sql.NumColumns(right.Type()))
 		}
 
+		// Values can only be looked up by their hash when both sides are converted to the same type. Otherwise, they
+		// need to be compared one by one.
+		if !sql.TypesEqual(typ, right.Type().Promote()) {
+			return in.evalWithoutHash(ctx, row, typ, left, leftNull, right)
+		}
+
 		typ := right.Type()
 
 		values, err := right.HashMultiple(ctx, row)
@@ -113,6 +119,50 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 }
 
+// evalWithoutHash compares the left value given, converted to the type given, with every value of the subquery. It
+// has the same NULL semantics as the lookup by hash: the result is NULL if there's no match and any of the values is
+// NULL, or if the left value is NULL and the subquery isn't empty.
+func (in *InSubquery) evalWithoutHash(ctx *sql.Context, row sql.Row, typ sql.Type, left interface{}, leftNull bool, right *Subquery) (interface{}, error) {
+	values, err := right.EvalMultiple(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if leftNull {
+		if len(values) == 0 {
+			return false, nil
+		}
+		return nil, nil
+	}
+
+	rightNull := false
+	for _, val := range values {
+		if val == nil {
+			rightNull = true
+			continue
+		}
+
+		// Values that can't be converted to the type of the comparison can't be equal to the left value
+		val, err = typ.Convert(val)
+		if err != nil {
+			continue
+		}
+
+		cmp, err := typ.Compare(left, val)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == 0 {
+			return true, nil
+		}
+	}
+
+	if rightNull {
+		return nil, nil
+	}
+	return false, nil
+}
+
 // WithChildren implements the Expression interface.
 func (in *InSubquery) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -93,6 +93,37 @@ func TestInSubquery(t *testing.T) {
 			false,
 			nil,
 		},
+		{
+			"left is in right of a different type",
+			expression.NewLiteral(float64(2), sql.Float64),
+			project(
+				expression.NewLiteral(int64(2), sql.Int64),
+			),
+			nil,
+			true,
+			nil,
+		},
+		{
+			"left is not in right with nulls",
+			expression.NewGetField(0, sql.Text, "foo", false),
+			project(
+				expression.NewLiteral(nil, sql.Null),
+			),
+			sql.NewRow("four"),
+			nil,
+			nil,
+		},
+		{
+			"left is nil and right is empty",
+			expression.NewGetField(0, sql.Text, "foo", false),
+			plan.NewFilter(
+				expression.NewLiteral(false, sql.Boolean),
+				project(expression.NewGetField(1, sql.Text, "foo", false)),
+			),
+			sql.NewRow(nil),
+			false,
+			nil,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -181,6 +212,37 @@ func TestNotInSubquery(t *testing.T) {
 			true,
 			nil,
 		},
+		{
+			"left is in right of a different type",
+			expression.NewLiteral(float64(2), sql.Float64),
+			project(
+				expression.NewLiteral(int64(2), sql.Int64),
+			),
+			nil,
+			false,
+			nil,
+		},
+		{
+			"left is not in right with nulls",
+			expression.NewGetField(0, sql.Text, "foo", false),
+			project(
+				expression.NewLiteral(nil, sql.Null),
+			),
+			sql.NewRow("four"),
+			nil,
+			nil,
+		},
+		{
+			"left is nil and right is empty",
+			expression.NewGetField(0, sql.Text, "foo", false),
+			plan.NewFilter(
+				expression.NewLiteral(false, sql.Boolean),
+				project(expression.NewGetField(1, sql.Text, "foo", false)),
+			),
+			sql.NewRow(nil),
+			true,
+			nil,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -0,0 +1,167 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/export"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
+)
+
+// IntoOutfile is SELECT ... INTO OUTFILE, which writes the rows of a query to a new file on the server rather than
+// returning them. Like with DescribeQuery, the query isn't a child of the node, since the node's schema isn't the
+// query's, and is analyzed on its own.
+type IntoOutfile struct {
+	query  sql.Node
+	File   string
+	Fields *sqlparser.Fields
+	Lines  *sqlparser.Lines
+}
+
+var _ sql.Node = (*IntoOutfile)(nil)
+
+// NewIntoOutfile returns a new *IntoOutfile node writing the rows of the query given to the file given, with the
+// FIELDS and LINES options given, which may be nil.
+func NewIntoOutfile(query sql.Node, file string, fields *sqlparser.Fields, lines *sqlparser.Lines) *IntoOutfile {
+	return &IntoOutfile{query: query, File: file, Fields: fields, Lines: lines}
+}
+
+// Query returns the query whose rows are written.
+func (i *IntoOutfile) Query() sql.Node {
+	return i.query
+}
+
+// WithQuery returns a copy of this node writing the rows of the query given.
+func (i *IntoOutfile) WithQuery(query sql.Node) *IntoOutfile {
+	ni := *i
+	ni.query = query
+	return &ni
+}
+
+// Resolved implements the sql.Node interface.
+func (i *IntoOutfile) Resolved() bool {
+	return i.query.Resolved()
+}
+
+// Schema implements the sql.Node interface.
+func (i *IntoOutfile) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// Children implements the sql.Node interface.
+func (i *IntoOutfile) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (i *IntoOutfile) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(i, children...)
+}
+
+func (i *IntoOutfile) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("IntoOutfile(%s)", i.File)
+	_ = pr.WriteChildren(i.query.String())
+	return pr.String()
+}
+
+func (i *IntoOutfile) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("IntoOutfile(%s)", i.File)
+	_ = pr.WriteChildren(sql.DebugString(i.query))
+	return pr.String()
+}
+
+// RowIter implements the sql.Node interface.
+func (i *IntoOutfile) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	format, err := outfileFormat(i.Fields, i.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	// Files are relative to secure_file_priv, like those of LOAD DATA
+	dir, err := ctx.GetSessionVariable(ctx, "secure_file_priv")
+	if err != nil {
+		return nil, err
+	}
+	if dir == nil {
+		dir = ""
+	}
+	fileName := filepath.Join(dir.(string), i.File)
+
+	// Files are never overwritten
+	file, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if os.IsExist(err) {
+		return nil, sql.ErrOutfileExists.New(i.File)
+	} else if err != nil {
+		return nil, err
+	}
+
+	iter, err := i.query.RowIter(ctx, row)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	n, err := export.Rows(ctx, file, i.query.Schema(), iter, format)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(int(n)))), nil
+}
+
+// outfileFormat returns the format of the FIELDS and LINES options given, which are those of the default format when
+// they aren't given.
+func outfileFormat(fields *sqlparser.Fields, lines *sqlparser.Lines) (export.Format, error) {
+	format := export.DefaultFormat
+	if lines != nil {
+		if lines.StartingBy != nil {
+			format.LinesStartingBy = string(lines.StartingBy.Val)
+		}
+		if lines.TerminatedBy != nil {
+			format.LinesTerminatedBy = string(lines.TerminatedBy.Val)
+		}
+	}
+
+	if fields != nil {
+		if fields.TerminatedBy != nil {
+			format.FieldsTerminatedBy = string(fields.TerminatedBy.Val)
+		}
+		if fields.EscapedBy != nil {
+			if len(fields.EscapedBy.Val) > 1 {
+				return export.Format{}, sql.ErrLoadDataCharacterLength.New(fmt.Sprintf("INTO OUTFILE ESCAPED BY %s", fields.EscapedBy))
+			}
+			format.FieldsEscapedBy = string(fields.EscapedBy.Val)
+		}
+		if fields.EnclosedBy != nil {
+			format.FieldsOptionallyEnclosed = bool(fields.EnclosedBy.Optionally)
+			if fields.EnclosedBy.Delim != nil {
+				if len(fields.EnclosedBy.Delim.Val) > 1 {
+					return export.Format{}, sql.ErrLoadDataCharacterLength.New("INTO OUTFILE ENCLOSED BY")
+				}
+				format.FieldsEnclosedBy = string(fields.EnclosedBy.Delim.Val)
+			}
+		}
+	}
+
+	return format, nil
+}
@@ -489,6 +489,7 @@ type joinIter struct {
 	secondaryRows sql.RowsCache
 	pos           int
 	dispose       sql.DisposeFunc
+	accounted     uint64
 }
 
 func (i *joinIter) Dispose() {
@@ -496,6 +497,18 @@ func (i *joinIter) Dispose() {
 		i.dispose()
 		i.dispose = nil
 	}
+	i.ctx.MemoryAccount().Shrink(i.accounted)
+	i.accounted = 0
+}
+
+// account accounts the size of a secondary row held in memory to the memory account of the query.
+func (i *joinIter) account(row sql.Row) error {
+	size := estimateRowSize(row)
+	if err := i.ctx.MemoryAccount().Grow(size); err != nil {
+		return err
+	}
+	i.accounted += size
+	return nil
 }
 
 func (i *joinIter) loadPrimary() error {
@@ -531,6 +544,11 @@ func (i *joinIter) loadSecondaryInMemory() error {
 			return err
 		}
 
+		if err := i.account(row); err != nil {
+			iter.Close(i.ctx)
+			return err
+		}
+
 		if err := i.secondaryRows.Add(row); err != nil {
 			iter.Close(i.ctx)
 			return err
@@ -608,6 +626,9 @@ func (i *joinIter) loadSecondary() (row sql.Row, err error) {
 		var switchToMultipass bool
 		if !i.ctx.Memory.HasAvailable() {
 			switchToMultipass = true
+		} else if err := i.account(rightRow); err != nil {
+			// Rows that would go over the memory limits are read again for every primary row instead
+			switchToMultipass = true
 		} else {
 			err := i.secondaryRows.Add(rightRow)
 			if err != nil && !sql.ErrNoMemoryAvailable.Is(err) {
@@ -725,7 +746,7 @@ func nodeHasJoin(node sql.Node) bool {
 	hasJoinNode := false
 	Inspect(node, func(node sql.Node) bool {
 		switch node.(type) {
-		case JoinNode, *CrossJoin, *IndexedJoin:
+		case JoinNode, *CrossJoin, *IndexedJoin, *MergeJoin:
 			hasJoinNode = true
 			return false
 		default:
@@ -0,0 +1,384 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/oliveagle/jsonpath"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var (
+	// ErrJSONTableMissingValue is returned when the path of a JSON_TABLE column with ERROR ON EMPTY doesn't match any
+	// value.
+	ErrJSONTableMissingValue = errors.NewKind("Missing value for JSON_TABLE column '%s'")
+	// ErrJSONTableInvalidValue is returned when the value of a JSON_TABLE column with ERROR ON ERROR can't be converted
+	// to the type of the column.
+	ErrJSONTableInvalidValue = errors.NewKind("Invalid value for JSON_TABLE column '%s': %s")
+)
+
+// JSONTableFallback is what a JSON_TABLE column returns when its path doesn't match any value (ON EMPTY), or when the
+// value it matches can't be converted to the type of the column (ON ERROR). The zero value returns NULL.
+type JSONTableFallback struct {
+	// Error is whether an error is returned instead of a value.
+	Error bool
+	// Default is the JSON value returned instead, converted to the type of the column, if it's not nil.
+	Default interface{}
+}
+
+// JSONTableColumn is a column of a JSON_TABLE, which is one of:
+//
+//	name FOR ORDINALITY
+//	name type PATH path [{NULL | ERROR | DEFAULT json} ON EMPTY] [{NULL | ERROR | DEFAULT json} ON ERROR]
+//	name type EXISTS PATH path
+//	NESTED [PATH] path COLUMNS (column, ...)
+type JSONTableColumn struct {
+	Name string
+	Type sql.Type
+	// Path is the path of the value of the column, or of the rows of the nested columns, relative to the row of the
+	// columns it belongs to.
+	Path string
+	// Ordinality is whether the column is the number of the row among the rows matched by the path of its columns,
+	// starting at 1.
+	Ordinality bool
+	// Exists is whether the column is 1 if the path matches a value, and 0 otherwise.
+	Exists  bool
+	OnEmpty JSONTableFallback
+	OnError JSONTableFallback
+	// Nested are the columns of a NESTED PATH, which has no name or type of its own.
+	Nested []JSONTableColumn
+}
+
+// IsNested returns whether the column is a NESTED PATH.
+func (c JSONTableColumn) IsNested() bool {
+	return c.Nested != nil
+}
+
+// JSONTable turns a JSON document into rows, one for every value matched by a path, with columns made of the values
+// matched by the paths of its columns relative to each row. For example:
+//
+//	SELECT * FROM JSON_TABLE('[{"a": 1}, {"a": 2}]', '$[*]' COLUMNS (a INT PATH '$.a')) AS jt
+//
+// returns the rows 1 and 2. The JSON document is evaluated with the row given to the node, so it can reference the
+// columns of the tables preceding it in a LateralJoin. A path with a wildcard matches every value it selects, while
+// other paths match a single value, even if that value is an array.
+type JSONTable struct {
+	DataExpr sql.Expression
+	Path     string
+	Columns  []JSONTableColumn
+	name     string
+}
+
+var _ sql.Node = (*JSONTable)(nil)
+var _ sql.Nameable = (*JSONTable)(nil)
+var _ sql.Expressioner = (*JSONTable)(nil)
+
+// NewJSONTable creates a new JSONTable node with the name given.
+func NewJSONTable(dataExpr sql.Expression, path string, columns []JSONTableColumn, name string) *JSONTable {
+	return &JSONTable{
+		DataExpr: dataExpr,
+		Path:     path,
+		Columns:  columns,
+		name:     name,
+	}
+}
+
+// Name implements the sql.Nameable interface.
+func (t *JSONTable) Name() string {
+	return t.name
+}
+
+// Resolved implements the sql.Node interface.
+func (t *JSONTable) Resolved() bool {
+	return t.DataExpr.Resolved()
+}
+
+// Schema implements the sql.Node interface.
+func (t *JSONTable) Schema() sql.Schema {
+	var schema sql.Schema
+	for _, c := range jsonTableLeafColumns(t.Columns) {
+		schema = append(schema, &sql.Column{
+			Name:     c.Name,
+			Type:     c.Type,
+			Source:   t.name,
+			Nullable: !c.Ordinality && !c.Exists,
+		})
+	}
+	return schema
+}
+
+// Children implements the sql.Node interface.
+func (t *JSONTable) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (t *JSONTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 0)
+	}
+	return t, nil
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (t *JSONTable) Expressions() []sql.Expression {
+	return []sql.Expression{t.DataExpr}
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (t *JSONTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(exprs), 1)
+	}
+	nt := *t
+	nt.DataExpr = exprs[0]
+	return &nt, nil
+}
+
+// RowIter implements the sql.Node interface.
+func (t *JSONTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	val, err := t.DataExpr.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return sql.RowsToRowIter(), nil
+	}
+
+	doc, err := sql.JSON.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	js, err := doc.(sql.JSONValue).Unmarshall(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := jsonTableMatches(js.Val, t.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	for i, item := range items {
+		itemRows, err := jsonTableRows(item, i+1, t.Columns)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, itemRows...)
+	}
+
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func (t *JSONTable) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("JSONTable(%s)", t.name)
+	_ = pr.WriteChildren(
+		fmt.Sprintf("data: %s", t.DataExpr),
+		fmt.Sprintf("path: %s", t.Path),
+		fmt.Sprintf("columns: %s", formatJSONTableColumns(t.Columns)),
+	)
+	return pr.String()
+}
+
+func (t *JSONTable) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("JSONTable(%s)", t.name)
+	_ = pr.WriteChildren(
+		fmt.Sprintf("data: %s", sql.DebugString(t.DataExpr)),
+		fmt.Sprintf("path: %s", t.Path),
+		fmt.Sprintf("columns: %s", formatJSONTableColumns(t.Columns)),
+	)
+	return pr.String()
+}
+
+func formatJSONTableColumns(columns []JSONTableColumn) string {
+	var cols []string
+	for _, c := range columns {
+		switch {
+		case c.IsNested():
+			cols = append(cols, fmt.Sprintf("NESTED PATH '%s' (%s)", c.Path, formatJSONTableColumns(c.Nested)))
+		case c.Ordinality:
+			cols = append(cols, fmt.Sprintf("%s FOR ORDINALITY", c.Name))
+		case c.Exists:
+			cols = append(cols, fmt.Sprintf("%s %s EXISTS PATH '%s'", c.Name, c.Type, c.Path))
+		default:
+			cols = append(cols, fmt.Sprintf("%s %s PATH '%s'", c.Name, c.Type, c.Path))
+		}
+	}
+	return strings.Join(cols, ", ")
+}
+
+// jsonTableLeafColumns returns the columns given that have a value, with nested columns in place of their NESTED PATH.
+func jsonTableLeafColumns(columns []JSONTableColumn) []JSONTableColumn {
+	var leaves []JSONTableColumn
+	for _, c := range columns {
+		if c.IsNested() {
+			leaves = append(leaves, jsonTableLeafColumns(c.Nested)...)
+		} else {
+			leaves = append(leaves, c)
+		}
+	}
+	return leaves
+}
+
+// jsonTableMatches returns the values matched by the path given in the JSON value given.
+func jsonTableMatches(val interface{}, path string) ([]interface{}, error) {
+	c, err := jsonpath.Compile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := c.Lookup(val)
+	if err != nil {
+		// The path doesn't exist in the value
+		return nil, nil
+	}
+
+	if strings.Contains(path, "*") {
+		if matches, ok := match.([]interface{}); ok {
+			return matches, nil
+		}
+	}
+	return []interface{}{match}, nil
+}
+
+// jsonTableRows returns the rows of the columns given for the JSON value given, which is the row with the ordinal
+// given among the values matched by the path of the columns. Each NESTED PATH adds one row for every value it matches,
+// with NULL in the columns of the other NESTED PATHs of the same columns. If none of them match anything, a single row
+// with NULL in all the nested columns is returned.
+func jsonTableRows(item interface{}, ordinal int, columns []JSONTableColumn) ([]sql.Row, error) {
+	var row sql.Row
+	var nested []int
+	for _, c := range columns {
+		if c.IsNested() {
+			nested = append(nested, len(row))
+			row = append(row, make(sql.Row, len(jsonTableLeafColumns(c.Nested)))...)
+			continue
+		}
+
+		val, err := jsonTableColumnValue(item, ordinal, c)
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, val)
+	}
+
+	var rows []sql.Row
+	var i int
+	for _, c := range columns {
+		if !c.IsNested() {
+			continue
+		}
+		offset := nested[i]
+		i++
+
+		matches, err := jsonTableMatches(item, c.Path)
+		if err != nil {
+			return nil, err
+		}
+		for j, match := range matches {
+			nestedRows, err := jsonTableRows(match, j+1, c.Nested)
+			if err != nil {
+				return nil, err
+			}
+			for _, nestedRow := range nestedRows {
+				r := row.Copy()
+				copy(r[offset:], nestedRow)
+				rows = append(rows, r)
+			}
+		}
+	}
+
+	if len(rows) == 0 {
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// jsonTableColumnValue returns the value of the column given for the JSON value given, which is the row with the
+// ordinal given.
+func jsonTableColumnValue(item interface{}, ordinal int, c JSONTableColumn) (interface{}, error) {
+	if c.Ordinality {
+		return c.Type.Convert(ordinal)
+	}
+
+	matches, err := jsonTableMatches(item, c.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Exists {
+		if len(matches) > 0 {
+			return c.Type.Convert(1)
+		}
+		return c.Type.Convert(0)
+	}
+
+	if len(matches) == 0 {
+		if c.OnEmpty.Error {
+			return nil, ErrJSONTableMissingValue.New(c.Name)
+		}
+		return jsonTableConvert(c.OnEmpty.Default, c.Type)
+	}
+
+	val, err := jsonTableConvert(matches[0], c.Type)
+	if err != nil {
+		if c.OnError.Error {
+			return nil, ErrJSONTableInvalidValue.New(c.Name, err.Error())
+		}
+		return jsonTableConvert(c.OnError.Default, c.Type)
+	}
+	return val, nil
+}
+
+// jsonTableConvert converts the JSON value given to the type given. Objects and arrays are only valid for JSON and
+// string types, which hold their JSON text.
+func jsonTableConvert(val interface{}, typ sql.Type) (interface{}, error) {
+	if val == nil {
+		return nil, nil
+	}
+
+	if sql.IsJSON(typ) {
+		return sql.JSONDocument{Val: val}, nil
+	}
+
+	switch v := val.(type) {
+	case map[string]interface{}, []interface{}:
+		if !sql.IsText(typ) {
+			return nil, sql.ErrInvalidType.New(typ.String())
+		}
+		text, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return typ.Convert(string(text))
+	case bool:
+		if !sql.IsText(typ) {
+			if v {
+				return typ.Convert(1)
+			}
+			return typ.Convert(0)
+		}
+	}
+
+	return typ.Convert(val)
+}
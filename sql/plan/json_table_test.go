@@ -0,0 +1,73 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestJSONTable(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	doc := `[{"name": "a", "n": "1", "tags": ["x", "y"]}, {}, {"name": 5, "n": "abc"}]`
+	table := NewJSONTable(
+		expression.NewLiteral(doc, sql.LongText),
+		"$[*]",
+		[]JSONTableColumn{
+			{Name: "id", Type: sql.Uint32, Ordinality: true},
+			{Name: "name", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 20), Path: "$.name"},
+			{Name: "n", Type: sql.Int32, Path: "$.n", OnError: JSONTableFallback{Default: float64(-1)}},
+			{Name: "has_tags", Type: sql.Int32, Path: "$.tags", Exists: true},
+			{Path: "$.tags[*]", Nested: []JSONTableColumn{{Name: "tag", Type: sql.Text, Path: "$"}}},
+		},
+		"jt",
+	)
+
+	require.Equal(sql.Schema{
+		{Name: "id", Type: sql.Uint32, Source: "jt"},
+		{Name: "name", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 20), Source: "jt", Nullable: true},
+		{Name: "n", Type: sql.Int32, Source: "jt", Nullable: true},
+		{Name: "has_tags", Type: sql.Int32, Source: "jt"},
+		{Name: "tag", Type: sql.Text, Source: "jt", Nullable: true},
+	}, table.Schema())
+
+	rows, err := sql.NodeToRows(ctx, table)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{uint32(1), "a", int32(1), int32(1), "x"},
+		{uint32(1), "a", int32(1), int32(1), "y"},
+		{uint32(2), nil, nil, int32(0), nil},
+		{uint32(3), "5", int32(-1), int32(0), nil},
+	}, rows)
+
+	table.Columns = []JSONTableColumn{
+		{Name: "name", Type: sql.Text, Path: "$.name", OnEmpty: JSONTableFallback{Error: true}},
+	}
+	_, err = sql.NodeToRows(ctx, table)
+	require.Error(err)
+	require.True(ErrJSONTableMissingValue.Is(err))
+
+	table.DataExpr = expression.NewLiteral(nil, sql.Null)
+	rows, err = sql.NodeToRows(ctx, table)
+	require.NoError(err)
+	require.Empty(rows)
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// KillType is the kind of KILL statement.
+type KillType int
+
+const (
+	// KillType_Query terminates the statement the connection is currently executing, but leaves the connection intact.
+	KillType_Query KillType = iota
+	// KillType_Connection terminates the statement the connection is executing, then closes the connection.
+	KillType_Connection
+)
+
+func (kt KillType) String() string {
+	if kt == KillType_Query {
+		return "QUERY"
+	}
+	return "CONNECTION"
+}
+
+// Kill is a KILL [QUERY | CONNECTION] statement, which aborts the work of another connection.
+type Kill struct {
+	kt     KillType
+	connID uint32
+}
+
+var _ sql.Node = (*Kill)(nil)
+
+// NewKill creates a new Kill node.
+func NewKill(kt KillType, connID uint32) *Kill {
+	return &Kill{
+		kt:     kt,
+		connID: connID,
+	}
+}
+
+// Kind returns the kind of this KILL statement.
+func (k *Kill) Kind() KillType {
+	return k.kt
+}
+
+// ConnectionID returns the id of the connection being killed.
+func (k *Kill) ConnectionID() uint32 {
+	return k.connID
+}
+
+// Resolved implements the sql.Node interface.
+func (k *Kill) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (k *Kill) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (k *Kill) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(k, len(children), 0)
+	}
+	return k, nil
+}
+
+// Schema implements the sql.Node interface.
+func (k *Kill) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface. Queries of the killed connection are cancelled through their contexts,
+// so they stop at the next row boundary. Connections that are neither running a query nor known to the integrator
+// are reported as unknown threads.
+func (k *Kill) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if !k.connectionExists(ctx) {
+		return nil, sql.ErrUnknownThreadID.New(k.connID)
+	}
+
+	ctx.ProcessList.Kill(k.connID)
+	if k.kt == KillType_Connection {
+		if err := ctx.KillConnection(k.connID); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (k *Kill) connectionExists(ctx *sql.Context) bool {
+	for _, p := range ctx.ProcessList.Processes() {
+		if p.Connection == k.connID {
+			return true
+		}
+	}
+	return ctx.HasConnection(k.connID)
+}
+
+func (k *Kill) String() string {
+	return fmt.Sprintf("KILL %s %d", k.kt.String(), k.connID)
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"io"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// LateMaterialization fetches the full rows of a table for the rows returned by its child, which reads the table
+// projected on only some of its columns, usually to filter it. Rows are fetched by primary key, in batches of the size
+// of the index_lookup_batch_size session variable.
+type LateMaterialization struct {
+	UnaryNode
+	Table sql.RowFetchTable
+}
+
+var _ sql.Node = (*LateMaterialization)(nil)
+
+// NewLateMaterialization creates a new LateMaterialization node fetching the rows of the table given for the rows of
+// its child.
+func NewLateMaterialization(table sql.RowFetchTable, child sql.Node) *LateMaterialization {
+	return &LateMaterialization{
+		UnaryNode: UnaryNode{Child: child},
+		Table:     table,
+	}
+}
+
+// RowIter implements the sql.Node interface.
+func (l *LateMaterialization) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.LateMaterialization", opentracing.Tag{Key: "table", Value: l.Table.Name()})
+
+	iter, err := l.Child.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	var pkIdxs []int
+	for i, col := range l.Table.Schema() {
+		if col.PrimaryKey {
+			pkIdxs = append(pkIdxs, i)
+		}
+	}
+
+	return sql.NewSpanIter(span, &lateMaterializationIter{
+		ctx:       ctx,
+		table:     l.Table,
+		child:     iter,
+		pkIdxs:    pkIdxs,
+		batchSize: indexLookupBatchSize(ctx),
+	}), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (l *LateMaterialization) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), 1)
+	}
+	return NewLateMaterialization(l.Table, children[0]), nil
+}
+
+func (l *LateMaterialization) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("LateMaterialization(%s)", l.Table.Name())
+	_ = pr.WriteChildren(l.Child.String())
+	return pr.String()
+}
+
+func (l *LateMaterialization) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("LateMaterialization(%s)", l.Table.Name())
+	_ = pr.WriteChildren(sql.DebugString(l.Child))
+	return pr.String()
+}
+
+type lateMaterializationIter struct {
+	ctx       *sql.Context
+	table     sql.RowFetchTable
+	child     sql.RowIter
+	pkIdxs    []int
+	batchSize int
+	rows      []sql.Row
+	pos       int
+	childDone bool
+}
+
+func (i *lateMaterializationIter) Next() (sql.Row, error) {
+	for i.pos >= len(i.rows) {
+		if err := i.fetchBatch(); err != nil {
+			return nil, err
+		}
+	}
+
+	row := i.rows[i.pos]
+	i.pos++
+	return row, nil
+}
+
+// fetchBatch reads the next batch of rows from the child and fetches their full rows.
+func (i *lateMaterializationIter) fetchBatch() error {
+	var keys []sql.Row
+	for !i.childDone && len(keys) < i.batchSize {
+		row, err := i.child.Next()
+		if err == io.EOF {
+			i.childDone = true
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		key := make(sql.Row, len(i.pkIdxs))
+		for j, idx := range i.pkIdxs {
+			key[j] = row[idx]
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return io.EOF
+	}
+
+	iter, err := i.table.FetchRows(i.ctx, keys)
+	if err != nil {
+		return err
+	}
+
+	rows, err := sql.RowIterToRows(i.ctx, iter)
+	if err != nil {
+		return err
+	}
+
+	i.rows = rows
+	i.pos = 0
+	return nil
+}
+
+func (i *lateMaterializationIter) Close(ctx *sql.Context) error {
+	i.rows = nil
+	return i.child.Close(ctx)
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestLateMaterialization(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewRowFetchTable("t", sql.Schema{
+		{Name: "id", Source: "t", Type: sql.Int64, PrimaryKey: true},
+		{Name: "a", Source: "t", Type: sql.Int64},
+		{Name: "body", Source: "t", Type: sql.LongText},
+	})
+	for i := int64(1); i <= 6; i++ {
+		require.NoError(t, table.Insert(ctx, sql.NewRow(i, i%3, fmt.Sprintf("body %d", i))))
+	}
+
+	narrow := table.WithProjection([]string{"id", "a"})
+	filter := NewFilter(
+		expression.NewEquals(
+			expression.NewGetFieldWithTable(1, sql.Int64, "t", "a", false),
+			expression.NewLiteral(int64(1), sql.Int64),
+		),
+		NewResolvedTable(narrow, nil, nil),
+	)
+
+	expected := []sql.Row{
+		sql.NewRow(int64(1), int64(1), "body 1"),
+		sql.NewRow(int64(4), int64(1), "body 4"),
+	}
+
+	for _, batchSize := range []int64{1, 2, 32} {
+		t.Run(fmt.Sprintf("batch size %d", batchSize), func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			require.NoError(ctx.SetSessionVariable(ctx, IndexLookupBatchSizeSessionVar, batchSize))
+
+			rows, err := sql.NodeToRows(ctx, NewLateMaterialization(table, filter))
+			require.NoError(err)
+			require.Equal(expected, rows)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// LateralJoin joins every row of its child with the rows its right side returns for that row. The right side is a
+// LATERAL derived table or a JSON_TABLE, which is analyzed with the child as its outer scope, so it can reference the
+// columns of the tables that precede it in the FROM clause:
+//
+//	SELECT * FROM customers c, LATERAL (SELECT * FROM orders o WHERE o.customer_id = c.id LIMIT 1) AS o
+//
+// Unlike the other joins, the right side is not a child of the node, since it's analyzed separately and evaluated
+// again for each row of the child. In a left lateral join, rows of the child for which the right side returns no rows
+// are returned with NULL in the columns of the right side.
+type LateralJoin struct {
+	UnaryNode
+	Right sql.Node
+	Left  bool
+}
+
+var _ sql.Node = (*LateralJoin)(nil)
+
+// NewLateralJoin creates a new LateralJoin node, which is a left join if left is true.
+func NewLateralJoin(child, right sql.Node, left bool) *LateralJoin {
+	return &LateralJoin{
+		UnaryNode: UnaryNode{child},
+		Right:     right,
+		Left:      left,
+	}
+}
+
+// WithRight returns a copy of the node with its right side replaced.
+func (j *LateralJoin) WithRight(right sql.Node) *LateralJoin {
+	nj := *j
+	nj.Right = right
+	return &nj
+}
+
+// Resolved implements the sql.Node interface.
+func (j *LateralJoin) Resolved() bool {
+	return j.Child.Resolved() && j.Right.Resolved()
+}
+
+// Schema implements the sql.Node interface.
+func (j *LateralJoin) Schema() sql.Schema {
+	schema := append(sql.Schema{}, j.Child.Schema()...)
+	for _, col := range j.Right.Schema() {
+		if j.Left {
+			c := *col
+			c.Nullable = true
+			col = &c
+		}
+		schema = append(schema, col)
+	}
+	return schema
+}
+
+// WithChildren implements the sql.Node interface.
+func (j *LateralJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 1)
+	}
+	return NewLateralJoin(children[0], j.Right, j.Left), nil
+}
+
+// RowIter implements the sql.Node interface.
+func (j *LateralJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.LateralJoin")
+
+	li, err := j.Child.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	// The query of a derived table is evaluated on its own, since the SubqueryAlias would hide the outer row from it.
+	query := j.Right
+	if sa, ok := query.(*SubqueryAlias); ok {
+		query = sa.Child
+	}
+
+	return sql.NewSpanIter(span, &lateralJoinIter{
+		ctx:      ctx,
+		left:     li,
+		query:    query,
+		rightLen: len(j.Right.Schema()),
+		outer:    j.Left,
+	}), nil
+}
+
+func (j *LateralJoin) String() string {
+	pr := sql.NewTreePrinter()
+	if j.Left {
+		_ = pr.WriteNode("LateralLeftJoin")
+	} else {
+		_ = pr.WriteNode("LateralJoin")
+	}
+	_ = pr.WriteChildren(j.Child.String(), j.Right.String())
+	return pr.String()
+}
+
+func (j *LateralJoin) DebugString() string {
+	pr := sql.NewTreePrinter()
+	if j.Left {
+		_ = pr.WriteNode("LateralLeftJoin")
+	} else {
+		_ = pr.WriteNode("LateralJoin")
+	}
+	_ = pr.WriteChildren(sql.DebugString(j.Child), sql.DebugString(j.Right))
+	return pr.String()
+}
+
+type lateralJoinIter struct {
+	ctx      *sql.Context
+	left     sql.RowIter
+	query    sql.Node
+	rightLen int
+	outer    bool
+
+	leftRow sql.Row
+	right   sql.RowIter
+	matched bool
+}
+
+func (i *lateralJoinIter) Next() (sql.Row, error) {
+	for {
+		if i.right == nil {
+			leftRow, err := i.left.Next()
+			if err != nil {
+				return nil, err
+			}
+
+			// Like subquery expressions, the right side is evaluated with the left row prepended to its own rows.
+			q, err := TransformUp(i.query, prependRowInPlan(leftRow))
+			if err != nil {
+				return nil, err
+			}
+			right, err := q.RowIter(i.ctx, leftRow)
+			if err != nil {
+				return nil, err
+			}
+
+			i.leftRow, i.right, i.matched = leftRow, right, false
+		}
+
+		rightRow, err := i.right.Next()
+		if err == io.EOF {
+			err = i.right.Close(i.ctx)
+			i.right = nil
+			if err != nil {
+				return nil, err
+			}
+			if !i.matched && i.outer {
+				return append(i.leftRow.Copy(), make(sql.Row, i.rightLen)...), nil
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		i.matched = true
+		return append(i.leftRow.Copy(), rightRow[len(i.leftRow):]...), nil
+	}
+}
+
+func (i *lateralJoinIter) Close(ctx *sql.Context) error {
+	err := i.left.Close(ctx)
+	if i.right != nil {
+		if rerr := i.right.Close(ctx); err == nil {
+			err = rerr
+		}
+	}
+	return err
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestLateralJoin(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	customers := memory.NewTable("customers", sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "customers"},
+		{Name: "tags", Type: sql.LongText, Source: "customers", Nullable: true},
+	})
+	orders := memory.NewTable("orders", sql.Schema{
+		{Name: "customer_id", Type: sql.Int64, Source: "orders"},
+		{Name: "total", Type: sql.Int64, Source: "orders"},
+	})
+	for _, r := range []sql.Row{
+		{int64(1), `["a", "b"]`},
+		{int64(2), nil},
+	} {
+		require.NoError(customers.Insert(ctx, r))
+	}
+	for _, r := range []sql.Row{
+		{int64(1), int64(10)},
+		{int64(1), int64(20)},
+		{int64(3), int64(30)},
+	} {
+		require.NoError(orders.Insert(ctx, r))
+	}
+
+	// The right sides are resolved with the columns of the left side preceding their own columns.
+	tags := NewJSONTable(
+		expression.NewGetFieldWithTable(1, sql.LongText, "customers", "tags", true),
+		"$[*]",
+		[]JSONTableColumn{{Name: "tag", Type: sql.LongText, Path: "$"}},
+		"jt",
+	)
+	customerOrders := NewSubqueryAlias("o", "", NewProject(
+		[]sql.Expression{expression.NewGetFieldWithTable(3, sql.Int64, "orders", "total", false)},
+		NewFilter(
+			expression.NewEquals(
+				expression.NewGetFieldWithTable(2, sql.Int64, "orders", "customer_id", false),
+				expression.NewGetFieldWithTable(0, sql.Int64, "customers", "id", false),
+			),
+			NewResolvedTable(orders, nil, nil),
+		),
+	))
+
+	testCases := []struct {
+		name     string
+		node     sql.Node
+		expected []sql.Row
+	}{
+		{
+			name: "json table",
+			node: NewLateralJoin(NewResolvedTable(customers, nil, nil), tags, false),
+			expected: []sql.Row{
+				{int64(1), `["a", "b"]`, "a"},
+				{int64(1), `["a", "b"]`, "b"},
+			},
+		},
+		{
+			name: "left join with json table",
+			node: NewLateralJoin(NewResolvedTable(customers, nil, nil), tags, true),
+			expected: []sql.Row{
+				{int64(1), `["a", "b"]`, "a"},
+				{int64(1), `["a", "b"]`, "b"},
+				{int64(2), nil, nil},
+			},
+		},
+		{
+			name: "derived table",
+			node: NewLateralJoin(NewResolvedTable(customers, nil, nil), customerOrders, false),
+			expected: []sql.Row{
+				{int64(1), `["a", "b"]`, int64(10)},
+				{int64(1), `["a", "b"]`, int64(20)},
+			},
+		},
+		{
+			name: "left join with derived table",
+			node: NewLateralJoin(NewResolvedTable(customers, nil, nil), customerOrders, true),
+			expected: []sql.Row{
+				{int64(1), `["a", "b"]`, int64(10)},
+				{int64(1), `["a", "b"]`, int64(20)},
+				{int64(2), nil, nil},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := sql.NodeToRows(ctx, tt.node)
+			require.NoError(err)
+			require.Equal(tt.expected, rows)
+		})
+	}
+
+	join := NewLateralJoin(NewResolvedTable(customers, nil, nil), customerOrders, true)
+	require.Equal(sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "customers"},
+		{Name: "tags", Type: sql.LongText, Source: "customers", Nullable: true},
+		{Name: "total", Type: sql.Int64, Source: "o", Nullable: true},
+	}, join.Schema())
+}
@@ -16,16 +16,21 @@ package plan
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
-	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/text/transform"
 
 	"github.com/dolthub/go-mysql-server/sql"
-	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/export"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 )
 
 type LoadData struct {
@@ -37,6 +42,9 @@ type LoadData struct {
 	Fields                  *sqlparser.Fields
 	Lines                   *sqlparser.Lines
 	IgnoreNum               int64
+	Ignore                  bool
+	Charset                 string
+	Parallelism             int
 	fieldsTerminatedByDelim string
 	fieldsEnclosedByDelim   string
 	fieldsOptionallyDelim   bool
@@ -49,6 +57,10 @@ const (
 	TmpfileName = ".LOADFILE"
 )
 
+// loadDataChunkLines is the number of lines of the chunks of the file that are parsed concurrently when LOAD DATA has a
+// parallelism greater than one.
+const loadDataChunkLines = 1024
+
 // Default values as defined here: https://dev.mysql.com/doc/refman/8.0/en/load-data.html
 const (
 	defaultFieldsTerminatedByDelim = "\t"
@@ -70,31 +82,28 @@ func (l *LoadData) String() string {
 	return pr.String()
 }
 
+// Schema returns the input columns of the statement: the columns given, in that order, or all the columns of the
+// table if none are given. The fields of each line of the file are the values of these columns.
 func (l *LoadData) Schema() sql.Schema {
-	return l.Destination.Schema()
-}
-
-func (l *LoadData) Children() []sql.Node {
-	return []sql.Node{l.Destination}
-}
-
-func (l *LoadData) splitLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
-	// Return nothing if at end of file and no data passed.
-	if atEOF && len(data) == 0 {
-		return 0, nil, nil
+	schema := l.Destination.Schema()
+	if len(l.ColumnNames) == 0 {
+		return schema
 	}
 
-	// Find the index of the LINES TERMINATED BY delim.
-	if i := strings.Index(string(data), l.linesTerminatedByDelim); i >= 0 {
-		return i + 1, data[0:i], nil
-	}
-
-	// If at end of file with data return the data.
-	if atEOF {
-		return len(data), data, nil
+	input := make(sql.Schema, 0, len(l.ColumnNames))
+	for _, name := range l.ColumnNames {
+		for _, col := range schema {
+			if strings.EqualFold(col.Name, name) {
+				input = append(input, col)
+				break
+			}
+		}
 	}
+	return input
+}
 
-	return
+func (l *LoadData) Children() []sql.Node {
+	return []sql.Node{l.Destination}
 }
 
 // setParsingValues parses the LoadData object to get the delimiter into FIELDS and LINES terms.
@@ -144,6 +153,18 @@ func (l *LoadData) setParsingValues() error {
 	return nil
 }
 
+// format returns the FIELDS and LINES options of the statement, once set by setParsingValues.
+func (l *LoadData) format() export.Format {
+	return export.Format{
+		FieldsTerminatedBy:       l.fieldsTerminatedByDelim,
+		FieldsEnclosedBy:         l.fieldsEnclosedByDelim,
+		FieldsOptionallyEnclosed: l.fieldsOptionallyDelim,
+		FieldsEscapedBy:          l.fieldsEscapedByDelim,
+		LinesStartingBy:          l.linesStartingByDelim,
+		LinesTerminatedBy:        l.linesTerminatedByDelim,
+	}
+}
+
 func (l *LoadData) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	// Start the parsing by grabbing all the config variables.
 	err := l.setParsingValues()
@@ -175,103 +196,344 @@ func (l *LoadData) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		fileName = filepath.Join(dir.(string), l.File)
 	}
 
-	file, err := os.Open(fileName)
+	decoder, err := l.decoder(ctx)
 	if err != nil {
-		return nil, sql.ErrLoadDataCannotOpen.New(err.Error())
+		return nil, err
 	}
 
-	scanner := bufio.NewScanner(file)
+	file, err := l.open(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
 
-	// Set the split function for lines.
-	scanner.Split(l.splitLines)
+	// The file is read as UTF-8, like all the strings of the engine, whatever the character sets of the columns.
+	iter, err := newLoadDataIter(ctx, file, decoder, l.Schema(), l.format())
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	iter.ignore = l.Ignore
+	iter.report = sql.LoadDataReport{File: l.File}
 
 	// Skip through the lines that need to be ignored.
-	for l.IgnoreNum > 0 && scanner.Scan() {
-		scanner.Text()
-		l.IgnoreNum--
+	for ignore := l.IgnoreNum; ignore > 0 && iter.scanner.Scan(); ignore-- {
+		iter.line++
+	}
+
+	if l.Local {
+		iter.tmpfile = fileName
+	}
+	// The destination is wrapped in an exchange when the query is parallelized.
+	Inspect(l.Destination, func(n sql.Node) bool {
+		if nameable, ok := n.(sql.Nameable); ok {
+			iter.report.Table = nameable.Name()
+			return false
+		}
+		return true
+	})
+
+	if l.Parallelism > 1 {
+		iter.parseChunks(l.Parallelism)
 	}
 
-	return &loadDataIter{
-		scanner:                 scanner,
-		destination:             l.Destination,
+	return iter, nil
+}
+
+// open returns the contents of the file of the statement, which is at the path given on the local disk. The files of
+// statements that aren't LOCAL are first looked up in the LOAD DATA sources of the context, if any.
+func (l *LoadData) open(ctx *sql.Context, path string) (io.ReadCloser, error) {
+	if !l.Local && ctx.LoadDataSources != nil {
+		r, ok, err := ctx.LoadDataSources.Open(ctx, l.File)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return r, nil
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, sql.ErrLoadDataCannotOpen.New(err.Error())
+	}
+	return file, nil
+}
+
+var (
+	// gzipMagic starts the files compressed with gzip.
+	gzipMagic = []byte{0x1f, 0x8b}
+	// zstdMagic starts the files compressed with zstd.
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// decompress returns a reader of the contents of the file given, which are decompressed if the file is compressed
+// with gzip or zstd. Compressed files are recognized by their contents, whatever their names.
+func decompress(file io.Reader) (io.Reader, error) {
+	r := bufio.NewReader(file)
+	magic, _ := r.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, sql.ErrLoadDataCannotOpen.New(err.Error())
+		}
+		return gz, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, sql.ErrLoadDataCannotOpen.New(err.Error())
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return r, nil
+	}
+}
+
+// decoder returns the decoder converting the bytes of the file to UTF-8, according to the character set given with
+// CHARACTER SET or, if there's none, the one of the database.
+func (l *LoadData) decoder(ctx *sql.Context) (transform.Transformer, error) {
+	charset := l.Charset
+	if charset == "" || charset == "default" {
+		val, err := ctx.GetSessionVariable(ctx, "character_set_database")
+		if err != nil {
+			return nil, err
+		}
+		charset, _ = val.(string)
+	}
+
+	cs, err := sql.ParseCharacterSet(strings.ToLower(charset))
+	if err != nil {
+		return nil, err
+	}
+	enc, ok := cs.Encoding()
+	if !ok {
+		return nil, sql.ErrCharacterSetNotSupported.New(charset)
+	}
+	return enc.NewDecoder(), nil
+}
+
+// newLoadDataIter returns an iterator of the rows of the schema given parsed from the lines of the file given, in the
+// format given. The file is decompressed if it's compressed, and then decoded to UTF-8 with the decoder given, if any.
+// The iterator closes the file.
+func newLoadDataIter(ctx *sql.Context, file io.ReadCloser, decoder transform.Transformer, schema sql.Schema, format export.Format) (*loadDataIter, error) {
+	contents, err := decompress(file)
+	if err != nil {
+		return nil, err
+	}
+	text := contents
+	if decoder != nil {
+		text = transform.NewReader(contents, decoder)
+	}
+
+	iter := &loadDataIter{
+		scanner:                 bufio.NewScanner(text),
+		schema:                  schema,
 		ctx:                     ctx,
 		file:                    file,
-		local:                   l.Local,
-		fieldsTerminatedByDelim: l.fieldsTerminatedByDelim,
-		fieldsEnclosedByDelim:   l.fieldsEnclosedByDelim,
-		fieldsOptionallyDelim:   l.fieldsOptionallyDelim,
-		fieldsEscapedByDelim:    l.fieldsEscapedByDelim,
-		linesTerminatedByDelim:  l.linesTerminatedByDelim,
-		linesStartingByDelim:    l.linesStartingByDelim,
-	}, nil
+		contents:                contents,
+		fieldsTerminatedByDelim: format.FieldsTerminatedBy,
+		fieldsEnclosedByDelim:   format.FieldsEnclosedBy,
+		fieldsOptionallyDelim:   format.FieldsOptionallyEnclosed,
+		fieldsEscapedByDelim:    format.FieldsEscapedBy,
+		linesTerminatedByDelim:  format.LinesTerminatedBy,
+		linesStartingByDelim:    format.LinesStartingBy,
+	}
+	iter.scanner.Split(iter.splitLines)
+	return iter, nil
 }
 
 type loadDataIter struct {
 	scanner                 *bufio.Scanner
-	destination             sql.Node
+	schema                  sql.Schema
 	ctx                     *sql.Context
-	file                    *os.File
-	local                   bool
+	file                    io.ReadCloser
+	contents                io.Reader // the decompressed contents of file
+	tmpfile                 string
+	ignore                  bool
+	line                    int64
+	report                  sql.LoadDataReport
+	reported                bool
 	fieldsTerminatedByDelim string
 	fieldsEnclosedByDelim   string
 	fieldsOptionallyDelim   bool
 	fieldsEscapedByDelim    string
 	linesTerminatedByDelim  string
 	linesStartingByDelim    string
+	chunks                  chan chan []loadDataLine
+	chunk                   []loadDataLine
+	done                    chan struct{}
+	workers                 sync.WaitGroup
 }
 
-func (l loadDataIter) Next() (returnRow sql.Row, returnErr error) {
-	keepGoing := l.scanner.Scan()
-	if !keepGoing {
-		return nil, io.EOF
-	}
+// loadDataLine is a line of the file parsed into the row of its values, or into the error it's rejected with.
+type loadDataLine struct {
+	line int64
+	row  sql.Row
+	err  error
+}
 
-	line := l.scanner.Text()
-	exprs, err := l.parseFields(line)
+// loadDataChunk is a chunk of consecutive lines of the file, the first of which has the line number given. The lines
+// parsed are sent to parsed.
+type loadDataChunk struct {
+	first  int64
+	lines  []string
+	parsed chan []loadDataLine
+}
 
-	if err != nil {
+func (l *loadDataIter) Next() (sql.Row, error) {
+	for {
+		parsed, ok := l.nextLine()
+		if !ok {
+			break
+		}
+
+		if parsed.err != nil {
+			if !l.ignore {
+				return nil, parsed.err
+			}
+			l.reject(parsed.line, parsed.err)
+			continue
+		}
+
+		// If row is nil then this is a skipped line (see test cases). Keep skipping until row != nil
+		if parsed.row == nil {
+			continue
+		}
+
+		l.report.Loaded++
+		return parsed.row, nil
+	}
+
+	if err := l.scanner.Err(); err != nil {
 		return nil, err
 	}
 
-	// If exprs is nil then this is a skipped line (see test cases). Keep skipping
-	// until exprs != nil
-	for exprs == nil {
-		keepGoing = l.scanner.Scan()
-		if !keepGoing {
-			return nil, io.EOF
-		}
+	if !l.reported && l.ctx.LoadData != nil {
+		l.ctx.LoadData.ReportLoadData(l.ctx, l.report)
+	}
+	l.reported = true
+	return nil, io.EOF
+}
 
-		line = l.scanner.Text()
-		exprs, err = l.parseFields(line)
+// nextLine returns the next line of the file, parsed, or false if there are no more lines.
+func (l *loadDataIter) nextLine() (loadDataLine, bool) {
+	if l.chunks == nil {
+		if !l.scanner.Scan() {
+			return loadDataLine{}, false
+		}
+		l.line++
+		row, err := l.parseRow(l.scanner.Text(), l.line)
+		return loadDataLine{line: l.line, row: row, err: err}, true
+	}
 
-		if err != nil {
-			return nil, err
+	for len(l.chunk) == 0 {
+		parsed, ok := <-l.chunks
+		if !ok {
+			return loadDataLine{}, false
 		}
+		l.chunk = <-parsed
 	}
 
-	// TODO: Match schema with column order
-	// return the exprs as a row
-	vals := make([]interface{}, len(exprs))
-	for i, expr := range exprs {
-		if expr != nil {
-			vals[i], err = expr.Eval(l.ctx, returnRow)
-			if err != nil {
-				return nil, err
+	next := l.chunk[0]
+	l.chunk = l.chunk[1:]
+	return next, true
+}
+
+// parseChunks starts parsing the rest of the file concurrently: a goroutine reads it in chunks of lines, which are
+// parsed by the number of workers given. Next still returns the lines in the order of the file, so the rows are
+// inserted, and the lines rejected, just like when the file is parsed line by line.
+func (l *loadDataIter) parseChunks(workers int) {
+	l.chunks = make(chan chan []loadDataLine, workers*2)
+	l.done = make(chan struct{})
+	work := make(chan loadDataChunk)
+
+	l.workers.Add(workers + 1)
+	go func(line int64) {
+		defer l.workers.Done()
+		defer close(work)
+		defer close(l.chunks)
+		l.readChunks(work, line)
+	}(l.line)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer l.workers.Done()
+			for chunk := range work {
+				chunk.parsed <- l.parseChunk(chunk)
 			}
+		}()
+	}
+}
+
+// readChunks splits the rest of the file, after the line number given, into chunks at line boundaries, which are sent
+// to work to be parsed and to chunks to be returned in order, until the file ends or the iterator is closed.
+func (l *loadDataIter) readChunks(work chan<- loadDataChunk, line int64) {
+	for {
+		chunk := loadDataChunk{first: line + 1, parsed: make(chan []loadDataLine, 1)}
+		for len(chunk.lines) < loadDataChunkLines && l.scanner.Scan() {
+			chunk.lines = append(chunk.lines, l.scanner.Text())
+		}
+		if len(chunk.lines) == 0 {
+			return
 		}
+		line += int64(len(chunk.lines))
+
+		select {
+		case l.chunks <- chunk.parsed:
+		case <-l.done:
+			return
+		}
+
+		select {
+		case work <- chunk:
+		case <-l.done:
+			return
+		}
+	}
+}
+
+// parseChunk parses all the lines of the chunk given.
+func (l *loadDataIter) parseChunk(chunk loadDataChunk) []loadDataLine {
+	parsed := make([]loadDataLine, len(chunk.lines))
+	for i, text := range chunk.lines {
+		line := chunk.first + int64(i)
+		row, err := l.parseRow(text, line)
+		parsed[i] = loadDataLine{line: line, row: row, err: err}
 	}
+	return parsed
+}
 
-	return sql.NewRow(vals...), nil
+// reject skips the line given because of the error given, which is added to the report and as a warning.
+func (l *loadDataIter) reject(line int64, err error) {
+	l.report.Rejected = append(l.report.Rejected, sql.LoadDataRejectedRow{Line: line, Reason: err.Error()})
+
+	sqlErr, _ := sql.CastSQLError(err)
+	l.ctx.Warn(sqlErr.Num, "%s", err.Error())
 }
 
-func (l loadDataIter) Close(ctx *sql.Context) error {
-	if l.local {
-		err := os.Remove(l.file.Name())
-		if err != nil {
+func (l *loadDataIter) Close(ctx *sql.Context) error {
+	// The goroutines parsing the file must be done reading it before it's closed.
+	if l.done != nil {
+		close(l.done)
+		l.workers.Wait()
+		l.done = nil
+	}
+
+	// Decompressors may hold resources of their own.
+	if closer, ok := l.contents.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
 			return err
 		}
-	} else {
-		err := l.file.Close()
+	}
+
+	err := l.file.Close()
+	if err != nil {
+		return err
+	}
+
+	// The files of LOCAL statements are temporary copies of the files of the client.
+	if l.tmpfile != "" {
+		err := os.Remove(l.tmpfile)
 		if err != nil {
 			return err
 		}
@@ -284,8 +546,27 @@ func (l loadDataIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
+func (l *loadDataIter) splitLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	// Return nothing if at end of file and no data passed.
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	// Find the index of the LINES TERMINATED BY delim.
+	if i := strings.Index(string(data), l.linesTerminatedByDelim); i >= 0 {
+		return i + 1, data[0:i], nil
+	}
+
+	// If at end of file with data return the data.
+	if atEOF {
+		return len(data), data, nil
+	}
+
+	return
+}
+
 // parseLinePrefix searches for the delim defined by linesStartingByDelim.
-func (l loadDataIter) parseLinePrefix(line string) string {
+func (l *loadDataIter) parseLinePrefix(line string) string {
 	if l.linesStartingByDelim == "" {
 		return line
 	}
@@ -300,69 +581,116 @@ func (l loadDataIter) parseLinePrefix(line string) string {
 	}
 }
 
-func (l loadDataIter) parseFields(line string) ([]sql.Expression, error) {
+// parseFields returns the fields of the line given, with NULL fields as nil, or nil if the line is skipped.
+func (l *loadDataIter) parseFields(text string, line int64) ([]*string, error) {
 	// Step 1. Start by Searching for prefix if there is one
-	line = l.parseLinePrefix(line)
-	if line == "" {
+	text = l.parseLinePrefix(text)
+	if text == "" {
 		return nil, nil
 	}
 
 	// Step 2: Split the lines into fields given the delim
-	fields := strings.Split(line, l.fieldsTerminatedByDelim)
+	fields := strings.Split(text, l.fieldsTerminatedByDelim)
 
-	// Step 3: Go through each field and see if it was enclosed by something
-	// TODO: Support the OPTIONALLY parameter.
+	// Step 3: Go through each field and see if it was enclosed by something. With OPTIONALLY, only some fields are.
 	if l.fieldsEnclosedByDelim != "" {
 		for i, field := range fields {
-			if string(field[0]) == l.fieldsEnclosedByDelim && string(field[len(field)-1]) == l.fieldsEnclosedByDelim {
+			if len(field) >= 2 && string(field[0]) == l.fieldsEnclosedByDelim && string(field[len(field)-1]) == l.fieldsEnclosedByDelim {
 				fields[i] = field[1 : len(field)-1]
-			} else {
-				return nil, fmt.Errorf("error: field not properly enclosed")
+			} else if !l.fieldsOptionallyDelim {
+				return nil, sql.ErrLoadDataUnenclosedField.New(i+1, line)
 			}
 		}
 	}
 
 	//Step 4: Handle the ESCAPED BY parameter.
-	if l.fieldsEscapedByDelim != "" {
-		for i, field := range fields {
+	values := make([]*string, len(fields))
+	for i := range fields {
+		field := fields[i]
+		if l.fieldsEscapedByDelim != "" {
 			if field == "\\N" {
-				fields[i] = "NULL"
+				continue
 			} else if field == "\\Z" {
-				fields[i] = fmt.Sprintf("%c", 26) // ASCII 26
+				field = fmt.Sprintf("%c", 26) // ASCII 26
 			} else if field == "\\0" {
-				fields[i] = fmt.Sprintf("%c", 0) // ASCII 0
+				field = fmt.Sprintf("%c", 0) // ASCII 0
 			} else {
-				fields[i] = strings.ReplaceAll(field, l.fieldsEscapedByDelim, "")
+				field = strings.ReplaceAll(field, l.fieldsEscapedByDelim, "")
 			}
 		}
+		if field != "NULL" {
+			values[i] = &field
+		}
 	}
 
-	exprs := make([]sql.Expression, len(l.destination.Schema()))
+	return values, nil
+}
+
+// parseRow returns the row of values of the input columns for the text of the line given, or nil if the line is
+// skipped. Lines with a different number of fields than there are input columns, and fields that aren't valid values
+// of their columns, are errors. It's safe to call concurrently.
+func (l *loadDataIter) parseRow(text string, line int64) (sql.Row, error) {
+	fields, err := l.parseFields(text, line)
+	if err != nil || fields == nil {
+		return nil, err
+	}
 
-	limit := len(exprs)
-	if len(fields) < limit {
-		limit = len(fields)
+	if len(fields) < len(l.schema) {
+		return nil, sql.ErrLoadDataMissingColumns.New(line)
+	}
+	if len(fields) > len(l.schema) {
+		return nil, sql.ErrLoadDataTruncatedRow.New(line)
 	}
 
-	for i := 0; i < limit; i++ {
+	row := make(sql.Row, len(l.schema))
+	for i, col := range l.schema {
 		field := fields[i]
-		dSchema := l.destination.Schema()[i]
-		// Replace the empty string with defaults
-		if field == "" {
-			_, ok := dSchema.Type.(sql.StringType)
-			if !ok {
-				exprs[i] = expression.NewLiteral(dSchema.Default, dSchema.Type)
-			} else {
-				exprs[i] = expression.NewLiteral(field, sql.LongText)
+		_, isString := col.Type.(sql.StringType)
+		switch {
+		case field == nil:
+			if !col.Nullable {
+				return nil, sql.ErrLoadDataNullValue.New(col.Name, line)
+			}
+		case *field == "" && !isString:
+			// Replace the empty string with defaults
+			if col.Default != nil {
+				row[i], err = col.Default.Eval(l.ctx, nil)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if row[i] == nil && !col.Nullable {
+				row[i] = col.Type.Zero()
 			}
-		} else if field == "NULL" {
-			exprs[i] = nil
-		} else {
-			exprs[i] = expression.NewLiteral(field, sql.LongText)
+		case isString:
+			// Strings that are too long are truncated or rejected when inserted, depending on the SQL mode.
+			row[i] = *field
+		default:
+			if _, err := col.Type.Convert(*field); err != nil {
+				return nil, sql.ErrLoadDataIncorrectValue.New(strings.ToLower(col.Type.String()), *field, col.Name, line)
+			}
+			row[i] = *field
 		}
 	}
 
-	return exprs, nil
+	return row, nil
+}
+
+// LoadDataInfo is the Info for OKResults returned by LOAD DATA statements.
+type LoadDataInfo struct {
+	// Records is the number of lines read from the file, not counting the lines skipped with IGNORE n LINES.
+	Records int
+	// Deleted is the number of rows of the table replaced by lines of the file with REPLACE.
+	Deleted int
+	// Skipped is the number of lines that weren't loaded, because they were invalid or duplicated a key with IGNORE.
+	Skipped int
+	// Warnings is the number of warnings of the statement.
+	Warnings int
+}
+
+// String implements fmt.Stringer
+func (li LoadDataInfo) String() string {
+	return fmt.Sprintf("Records: %d  Deleted: %d  Skipped: %d  Warnings: %d", li.Records, li.Deleted, li.Skipped, li.Warnings)
 }
 
 func (l *LoadData) WithChildren(children ...sql.Node) (sql.Node, error) {
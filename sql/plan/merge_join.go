@@ -0,0 +1,395 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// MergeJoin is an equi-join between two children that return their rows sorted by the join keys, in ascending order.
+// Both sides are read once, in step, and only the rows of the right side that share the current key are held in
+// memory. LeftKeys and RightKeys are the compared expressions of each side, in the order the rows are sorted by. The
+// whole join condition is still evaluated for every pair of rows with equal keys.
+type MergeJoin struct {
+	BinaryNode
+	Cond      sql.Expression
+	LeftKeys  []sql.Expression
+	RightKeys []sql.Expression
+	joinType  JoinType
+}
+
+var _ sql.Node = (*MergeJoin)(nil)
+var _ sql.Expressioner = (*MergeJoin)(nil)
+
+// NewMergeJoin creates a new MergeJoin node. Only inner and left joins are supported.
+func NewMergeJoin(left, right sql.Node, joinType JoinType, cond sql.Expression, leftKeys, rightKeys []sql.Expression) *MergeJoin {
+	return &MergeJoin{
+		BinaryNode: BinaryNode{left, right},
+		Cond:       cond,
+		LeftKeys:   leftKeys,
+		RightKeys:  rightKeys,
+		joinType:   joinType,
+	}
+}
+
+// JoinType returns the join type for this merge join
+func (j *MergeJoin) JoinType() JoinType {
+	return j.joinType
+}
+
+// Schema implements the sql.Node interface.
+func (j *MergeJoin) Schema() sql.Schema {
+	if j.joinType == JoinTypeLeft {
+		return append(j.left.Schema(), makeNullable(j.right.Schema())...)
+	}
+	return append(j.left.Schema(), j.right.Schema()...)
+}
+
+// Resolved implements the sql.Resolvable interface.
+func (j *MergeJoin) Resolved() bool {
+	return j.left.Resolved() && j.right.Resolved() && expression.ExpressionsResolved(j.Expressions()...)
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (j *MergeJoin) Expressions() []sql.Expression {
+	exprs := []sql.Expression{j.Cond}
+	exprs = append(exprs, j.LeftKeys...)
+	return append(exprs, j.RightKeys...)
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (j *MergeJoin) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	expected := 1 + len(j.LeftKeys) + len(j.RightKeys)
+	if len(exprs) != expected {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(exprs), expected)
+	}
+
+	nj := *j
+	nj.Cond = exprs[0]
+	nj.LeftKeys = exprs[1 : 1+len(j.LeftKeys)]
+	nj.RightKeys = exprs[1+len(j.LeftKeys):]
+	return &nj, nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (j *MergeJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 2)
+	}
+
+	nj := *j
+	nj.BinaryNode = BinaryNode{children[0], children[1]}
+	return &nj, nil
+}
+
+func (j *MergeJoin) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%sMergeJoin%s", j.joinTypePrefix(), j.Cond)
+	_ = pr.WriteChildren(j.left.String(), j.right.String())
+	return pr.String()
+}
+
+func (j *MergeJoin) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%sMergeJoin%s", j.joinTypePrefix(), sql.DebugString(j.Cond))
+	_ = pr.WriteChildren(sql.DebugString(j.left), sql.DebugString(j.right))
+	return pr.String()
+}
+
+func (j *MergeJoin) joinTypePrefix() string {
+	if j.joinType == JoinTypeLeft {
+		return "Left"
+	}
+	return ""
+}
+
+// RowIter implements the sql.Node interface.
+func (j *MergeJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var leftName, rightName string
+	if leftTable, ok := j.left.(sql.Nameable); ok {
+		leftName = leftTable.Name()
+	} else {
+		leftName = reflect.TypeOf(j.left).String()
+	}
+
+	if rightTable, ok := j.right.(sql.Nameable); ok {
+		rightName = rightTable.Name()
+	} else {
+		rightName = reflect.TypeOf(j.right).String()
+	}
+
+	span, ctx := ctx.Span("plan.mergeJoin", opentracing.Tags{
+		"left":  leftName,
+		"right": rightName,
+	})
+
+	l, err := j.left.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	r, err := j.right.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		_ = l.Close(ctx)
+		return nil, err
+	}
+
+	keyTypes := make([]sql.Type, len(j.LeftKeys))
+	sortKeys := true
+	for i, key := range j.LeftKeys {
+		keyTypes[i] = key.Type()
+		sortKeys = sortKeys && sql.HasSortKey(keyTypes[i])
+	}
+
+	return sql.NewSpanIter(span, &mergeJoinIter{
+		ctx:       ctx,
+		parentRow: row,
+		left:      l,
+		right:     r,
+		cond:      j.Cond,
+		leftKeys:  j.LeftKeys,
+		rightKeys: j.RightKeys,
+		keyTypes:  keyTypes,
+		sortKeys:  sortKeys,
+		joinType:  j.joinType,
+		leftLen:   len(j.left.Schema()),
+		rowSize:   len(row) + len(j.left.Schema()) + len(j.right.Schema()),
+	}), nil
+}
+
+// mergeJoinIter merges two iterators sorted by their join keys. For each left row, it collects the run of right rows
+// with the same key, which is reused as long as the following left rows share that key.
+type mergeJoinIter struct {
+	ctx       *sql.Context
+	parentRow sql.Row
+	left      sql.RowIter
+	right     sql.RowIter
+	cond      sql.Expression
+	leftKeys  []sql.Expression
+	rightKeys []sql.Expression
+	keyTypes  []sql.Type
+	sortKeys  bool
+	joinType  JoinType
+	leftLen   int
+	rowSize   int
+
+	leftRow    sql.Row
+	foundMatch bool
+	matching   bool
+
+	run    []sql.Row
+	runKey *mergeJoinKey
+	runIdx int
+
+	rightRow  sql.Row
+	rightKey  mergeJoinKey
+	rightDone bool
+}
+
+func (i *mergeJoinIter) Next() (sql.Row, error) {
+	for {
+		if i.leftRow == nil {
+			if err := i.loadLeft(); err != nil {
+				return nil, err
+			}
+		}
+
+		if i.matching && i.runIdx < len(i.run) {
+			row := i.buildRow(i.leftRow, i.run[i.runIdx])
+			i.runIdx++
+
+			matches, err := conditionIsTrue(i.ctx, row, i.cond)
+			if err != nil {
+				return nil, err
+			}
+			if !matches {
+				continue
+			}
+
+			i.foundMatch = true
+			return row[len(i.parentRow):], nil
+		}
+
+		left := i.leftRow
+		i.leftRow = nil
+		if !i.foundMatch && i.joinType == JoinTypeLeft {
+			return i.buildRow(left, nil)[len(i.parentRow):], nil
+		}
+	}
+}
+
+// loadLeft reads the next left row and positions the run of right rows on its key.
+func (i *mergeJoinIter) loadLeft() error {
+	r, err := i.left.Next()
+	if err != nil {
+		return err
+	}
+
+	i.leftRow = r
+	i.foundMatch = false
+	i.runIdx = 0
+
+	key, hasNull, err := i.evalKey(i.leftKeys, i.buildRow(r, nil))
+	if err != nil {
+		return err
+	}
+
+	// NULL keys never compare equal to anything
+	if hasNull {
+		i.matching = false
+		return nil
+	}
+
+	return i.seek(key)
+}
+
+// seek advances the right side until the run of right rows with the key given has been collected.
+func (i *mergeJoinIter) seek(key mergeJoinKey) error {
+	if i.runKey != nil {
+		cmp, err := i.compareKeys(key, *i.runKey)
+		if err != nil {
+			return err
+		}
+
+		// The left rows are sorted, so a smaller key than the current run can't match any right row left.
+		if cmp <= 0 {
+			i.matching = cmp == 0
+			return nil
+		}
+	}
+
+	i.run = i.run[:0]
+	for {
+		if i.rightRow == nil {
+			if i.rightDone {
+				break
+			}
+
+			r, err := i.right.Next()
+			if err == io.EOF {
+				i.rightDone = true
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			rightKey, hasNull, err := i.evalKey(i.rightKeys, i.buildRow(nil, r))
+			if err != nil {
+				return err
+			}
+			if hasNull {
+				continue
+			}
+
+			i.rightRow = r
+			i.rightKey = rightKey
+		}
+
+		cmp, err := i.compareKeys(i.rightKey, key)
+		if err != nil {
+			return err
+		}
+
+		if cmp > 0 {
+			break
+		}
+		if cmp == 0 {
+			i.run = append(i.run, i.rightRow)
+		}
+		i.rightRow = nil
+	}
+
+	i.runKey = &key
+	i.matching = len(i.run) > 0
+	return nil
+}
+
+func (i *mergeJoinIter) compareKeys(a, b mergeJoinKey) (int, error) {
+	if i.sortKeys {
+		return bytes.Compare(a.sortKey, b.sortKey), nil
+	}
+
+	for j := range a.values {
+		cmp, err := i.keyTypes[j].Compare(a.values[j], b.values[j])
+		if err != nil {
+			return 0, err
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	return 0, nil
+}
+
+// buildRow builds a row of the join from the left and right rows given, either of which may be nil.
+func (i *mergeJoinIter) buildRow(left, right sql.Row) sql.Row {
+	row := make(sql.Row, i.rowSize)
+
+	copy(row, i.parentRow)
+	copy(row[len(i.parentRow):], left)
+	copy(row[len(i.parentRow)+i.leftLen:], right)
+
+	return row
+}
+
+func (i *mergeJoinIter) Close(ctx *sql.Context) error {
+	err := i.left.Close(ctx)
+	if rerr := i.right.Close(ctx); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// mergeJoinKey is the key of a row in a merge join. When every key has a type with sort keys, the values are encoded
+// once into a single sort key, which is compared byte by byte instead.
+type mergeJoinKey struct {
+	values  []interface{}
+	sortKey []byte
+}
+
+// evalKey evaluates the key expressions given on the row, and returns whether any of the keys is NULL.
+func (i *mergeJoinIter) evalKey(keys []sql.Expression, row sql.Row) (mergeJoinKey, bool, error) {
+	values := make([]interface{}, len(keys))
+	for j, key := range keys {
+		v, err := key.Eval(i.ctx, row)
+		if err != nil {
+			return mergeJoinKey{}, false, err
+		}
+		if v == nil {
+			return mergeJoinKey{}, true, nil
+		}
+		values[j] = v
+	}
+
+	if !i.sortKeys {
+		return mergeJoinKey{values: values}, false, nil
+	}
+
+	sortKey, err := sql.EncodeRowSortKey(i.keyTypes, values)
+	if err != nil {
+		return mergeJoinKey{}, false, err
+	}
+	return mergeJoinKey{sortKey: sortKey}, false, nil
+}
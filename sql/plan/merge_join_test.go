@@ -0,0 +1,127 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestMergeJoin(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	left := memory.NewTable("l", sql.Schema{
+		{Name: "a", Source: "l", Type: sql.Int64, Nullable: true},
+		{Name: "x", Source: "l", Type: sql.Text},
+	})
+	right := memory.NewTable("r", sql.Schema{
+		{Name: "b", Source: "r", Type: sql.Int64, Nullable: true},
+		{Name: "y", Source: "r", Type: sql.Text},
+	})
+
+	// Both tables are inserted in key order, which is the order a table scan returns them in
+	for _, r := range []sql.Row{
+		{nil, "l0"},
+		{int64(1), "l1"},
+		{int64(2), "l2"},
+		{int64(2), "l3"},
+		{int64(4), "l4"},
+		{int64(5), "l5"},
+	} {
+		require.NoError(t, left.Insert(ctx, r))
+	}
+	for _, r := range []sql.Row{
+		{nil, "r0"},
+		{int64(2), "r1"},
+		{int64(2), "r2"},
+		{int64(3), "r3"},
+		{int64(4), "r4"},
+	} {
+		require.NoError(t, right.Insert(ctx, r))
+	}
+
+	leftKey := expression.NewGetFieldWithTable(0, sql.Int64, "l", "a", true)
+	rightKey := expression.NewGetFieldWithTable(2, sql.Int64, "r", "b", true)
+	cond := expression.NewEquals(leftKey, rightKey)
+
+	t.Run("inner", func(t *testing.T) {
+		j := NewMergeJoin(
+			NewResolvedTable(left, nil, nil),
+			NewResolvedTable(right, nil, nil),
+			JoinTypeInner,
+			cond,
+			[]sql.Expression{leftKey},
+			[]sql.Expression{rightKey},
+		)
+
+		require.Equal(t, []sql.Row{
+			{int64(2), "l2", int64(2), "r1"},
+			{int64(2), "l2", int64(2), "r2"},
+			{int64(2), "l3", int64(2), "r1"},
+			{int64(2), "l3", int64(2), "r2"},
+			{int64(4), "l4", int64(4), "r4"},
+		}, collectRows(t, j))
+	})
+
+	t.Run("left", func(t *testing.T) {
+		j := NewMergeJoin(
+			NewResolvedTable(left, nil, nil),
+			NewResolvedTable(right, nil, nil),
+			JoinTypeLeft,
+			cond,
+			[]sql.Expression{leftKey},
+			[]sql.Expression{rightKey},
+		)
+
+		require.Equal(t, []sql.Row{
+			{nil, "l0", nil, nil},
+			{int64(1), "l1", nil, nil},
+			{int64(2), "l2", int64(2), "r1"},
+			{int64(2), "l2", int64(2), "r2"},
+			{int64(2), "l3", int64(2), "r1"},
+			{int64(2), "l3", int64(2), "r2"},
+			{int64(4), "l4", int64(4), "r4"},
+			{int64(5), "l5", nil, nil},
+		}, collectRows(t, j))
+	})
+
+	t.Run("residual condition", func(t *testing.T) {
+		j := NewMergeJoin(
+			NewResolvedTable(left, nil, nil),
+			NewResolvedTable(right, nil, nil),
+			JoinTypeLeft,
+			expression.NewAnd(cond, expression.NewEquals(
+				expression.NewGetFieldWithTable(3, sql.Text, "r", "y", false),
+				expression.NewLiteral("r2", sql.Text),
+			)),
+			[]sql.Expression{leftKey},
+			[]sql.Expression{rightKey},
+		)
+
+		require.Equal(t, []sql.Row{
+			{nil, "l0", nil, nil},
+			{int64(1), "l1", nil, nil},
+			{int64(2), "l2", int64(2), "r2"},
+			{int64(2), "l3", int64(2), "r2"},
+			{int64(4), "l4", nil, nil},
+			{int64(5), "l5", nil, nil},
+		}, collectRows(t, j))
+	})
+}
@@ -0,0 +1,459 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrPivotRequiresAggregation is returned when the expression of a PIVOT is not an aggregation.
+var ErrPivotRequiresAggregation = errors.NewKind("PIVOT requires an aggregate function, got %s")
+
+// Pivot turns the values of a column into columns of their own. Rows are grouped by every column of the child not
+// referenced by the aggregate or the pivot column, and each group has one output column per pivot value, holding the
+// aggregate of the rows of the group with that value. For example:
+//
+//	SELECT * FROM sales PIVOT (SUM(amount) FOR quarter IN ('Q1', 'Q2'))
+//
+// returns one row per combination of the other columns of sales, with columns Q1 and Q2 holding the total amounts of
+// the first and second quarters. Pivot values can be given a column name with an alias.
+type Pivot struct {
+	UnaryNode
+	Aggregate sql.Expression
+	Column    sql.Expression
+	Values    []sql.Expression
+}
+
+var _ sql.Node = (*Pivot)(nil)
+var _ sql.Expressioner = (*Pivot)(nil)
+
+// NewPivot creates a new Pivot node.
+func NewPivot(aggregate, column sql.Expression, values []sql.Expression, child sql.Node) *Pivot {
+	return &Pivot{
+		UnaryNode: UnaryNode{child},
+		Aggregate: aggregate,
+		Column:    column,
+		Values:    values,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (p *Pivot) Resolved() bool {
+	return p.Child.Resolved() &&
+		p.Aggregate.Resolved() &&
+		p.Column.Resolved() &&
+		expression.ExpressionsResolved(p.Values...)
+}
+
+// Schema implements the sql.Node interface.
+func (p *Pivot) Schema() sql.Schema {
+	childSchema := p.Child.Schema()
+
+	var schema sql.Schema
+	for _, i := range groupingIndexes(childSchema, p.Aggregate, p.Column) {
+		schema = append(schema, childSchema[i])
+	}
+
+	for _, v := range p.Values {
+		schema = append(schema, &sql.Column{
+			Name:     pivotColumnName(v),
+			Type:     p.Aggregate.Type(),
+			Nullable: true,
+		})
+	}
+
+	return schema
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (p *Pivot) Expressions() []sql.Expression {
+	return append([]sql.Expression{p.Aggregate, p.Column}, p.Values...)
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (p *Pivot) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(p.Values)+2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(exprs), len(p.Values)+2)
+	}
+	return NewPivot(exprs[0], exprs[1], exprs[2:], p.Child), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (p *Pivot) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 1)
+	}
+	return NewPivot(p.Aggregate, p.Column, p.Values, children[0]), nil
+}
+
+// RowIter implements the sql.Node interface.
+func (p *Pivot) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	agg, ok := p.Aggregate.(sql.Aggregation)
+	if !ok {
+		return nil, ErrPivotRequiresAggregation.New(p.Aggregate)
+	}
+
+	values := make([]interface{}, len(p.Values))
+	for i, v := range p.Values {
+		val, err := v.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = val
+	}
+
+	childIter, err := p.Child.RowIter(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pivotIter{
+		ctx:       ctx,
+		aggregate: agg,
+		column:    p.Column,
+		values:    values,
+		grouping:  groupingIndexes(p.Child.Schema(), p.Aggregate, p.Column),
+		childIter: childIter,
+	}, nil
+}
+
+func (p *Pivot) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("Pivot(%s FOR %s IN (%s))", p.Aggregate, p.Column, expressionsString(p.Values, fmt.Stringer.String))
+	_ = pr.WriteChildren(p.Child.String())
+	return pr.String()
+}
+
+func (p *Pivot) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("Pivot(%s FOR %s IN (%s))", sql.DebugString(p.Aggregate), sql.DebugString(p.Column), expressionsString(p.Values, func(e fmt.Stringer) string { return sql.DebugString(e) }))
+	_ = pr.WriteChildren(sql.DebugString(p.Child))
+	return pr.String()
+}
+
+type pivotGroup struct {
+	row     sql.Row
+	buffers []sql.AggregationBuffer
+}
+
+type pivotIter struct {
+	ctx       *sql.Context
+	aggregate sql.Aggregation
+	column    sql.Expression
+	values    []interface{}
+	grouping  []int
+	childIter sql.RowIter
+	groups    []*pivotGroup
+	pos       int
+	computed  bool
+}
+
+func (i *pivotIter) Next() (sql.Row, error) {
+	if !i.computed {
+		if err := i.compute(); err != nil {
+			return nil, err
+		}
+		i.computed = true
+	}
+
+	if i.pos >= len(i.groups) {
+		return nil, io.EOF
+	}
+
+	group := i.groups[i.pos]
+	i.pos++
+
+	row := make(sql.Row, 0, len(group.row)+len(group.buffers))
+	row = append(row, group.row...)
+	for _, b := range group.buffers {
+		v, err := b.Eval(i.ctx)
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, v)
+	}
+
+	return row, nil
+}
+
+func (i *pivotIter) compute() error {
+	groupsByKey := make(map[uint64]*pivotGroup)
+	for {
+		row, err := i.childIter.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		groupRow := make(sql.Row, len(i.grouping))
+		for j, idx := range i.grouping {
+			groupRow[j] = row[idx]
+		}
+
+		key, err := sql.HashOf(groupRow)
+		if err != nil {
+			return err
+		}
+
+		group, ok := groupsByKey[key]
+		if !ok {
+			group = &pivotGroup{row: groupRow, buffers: make([]sql.AggregationBuffer, len(i.values))}
+			for j := range group.buffers {
+				group.buffers[j], err = i.aggregate.NewBuffer()
+				if err != nil {
+					return err
+				}
+			}
+			groupsByKey[key] = group
+			i.groups = append(i.groups, group)
+		}
+
+		val, err := i.column.Eval(i.ctx, row)
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			continue
+		}
+
+		for j, pivotValue := range i.values {
+			if pivotValue == nil {
+				continue
+			}
+
+			cmp, err := i.column.Type().Compare(val, pivotValue)
+			if err != nil {
+				return err
+			}
+
+			if cmp == 0 {
+				if err := group.buffers[j].Update(i.ctx, row); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+}
+
+func (i *pivotIter) Close(ctx *sql.Context) error {
+	for _, group := range i.groups {
+		for _, b := range group.buffers {
+			b.Dispose()
+		}
+	}
+	return i.childIter.Close(ctx)
+}
+
+// Unpivot turns columns into rows, the opposite of Pivot. Every row of the child becomes one row per unpivoted column
+// with a non-NULL value, holding the other columns of the row, the name of the unpivoted column and its value. For
+// example:
+//
+//	SELECT * FROM quarterly UNPIVOT (amount FOR quarter IN (Q1, Q2))
+//
+// returns a row with the quarter 'Q1' and its amount and another with 'Q2' and its amount for each row of quarterly.
+type Unpivot struct {
+	UnaryNode
+	ValueColumn string
+	NameColumn  string
+	Columns     []sql.Expression
+}
+
+var _ sql.Node = (*Unpivot)(nil)
+var _ sql.Expressioner = (*Unpivot)(nil)
+
+// NewUnpivot creates a new Unpivot node.
+func NewUnpivot(valueColumn, nameColumn string, columns []sql.Expression, child sql.Node) *Unpivot {
+	return &Unpivot{
+		UnaryNode:   UnaryNode{child},
+		ValueColumn: valueColumn,
+		NameColumn:  nameColumn,
+		Columns:     columns,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (u *Unpivot) Resolved() bool {
+	return u.Child.Resolved() && expression.ExpressionsResolved(u.Columns...)
+}
+
+// Schema implements the sql.Node interface.
+func (u *Unpivot) Schema() sql.Schema {
+	childSchema := u.Child.Schema()
+
+	var schema sql.Schema
+	for _, i := range groupingIndexes(childSchema, u.Columns...) {
+		schema = append(schema, childSchema[i])
+	}
+
+	// The type of the values is only known once the columns are resolved
+	var valueType sql.Type = sql.Null
+	if len(u.Columns) > 0 && u.Columns[0].Resolved() {
+		valueType = u.Columns[0].Type()
+	}
+
+	return append(schema,
+		&sql.Column{Name: u.NameColumn, Type: sql.LongText},
+		&sql.Column{Name: u.ValueColumn, Type: valueType},
+	)
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (u *Unpivot) Expressions() []sql.Expression {
+	return u.Columns
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (u *Unpivot) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(u.Columns) {
+		return nil, sql.ErrInvalidChildrenNumber.New(u, len(exprs), len(u.Columns))
+	}
+	return NewUnpivot(u.ValueColumn, u.NameColumn, exprs, u.Child), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (u *Unpivot) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 1)
+	}
+	return NewUnpivot(u.ValueColumn, u.NameColumn, u.Columns, children[0]), nil
+}
+
+// RowIter implements the sql.Node interface.
+func (u *Unpivot) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	childIter, err := u.Child.RowIter(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(u.Columns))
+	for i, c := range u.Columns {
+		names[i] = pivotColumnName(c)
+	}
+
+	return &unpivotIter{
+		ctx:       ctx,
+		columns:   u.Columns,
+		names:     names,
+		grouping:  groupingIndexes(u.Child.Schema(), u.Columns...),
+		childIter: childIter,
+	}, nil
+}
+
+func (u *Unpivot) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("Unpivot(%s FOR %s IN (%s))", u.ValueColumn, u.NameColumn, expressionsString(u.Columns, fmt.Stringer.String))
+	_ = pr.WriteChildren(u.Child.String())
+	return pr.String()
+}
+
+type unpivotIter struct {
+	ctx       *sql.Context
+	columns   []sql.Expression
+	names     []string
+	grouping  []int
+	childIter sql.RowIter
+	row       sql.Row
+	pos       int
+}
+
+func (i *unpivotIter) Next() (sql.Row, error) {
+	for {
+		if i.row == nil || i.pos >= len(i.columns) {
+			row, err := i.childIter.Next()
+			if err != nil {
+				return nil, err
+			}
+			i.row = row
+			i.pos = 0
+		}
+
+		col := i.pos
+		i.pos++
+
+		val, err := i.columns[col].Eval(i.ctx, i.row)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			continue
+		}
+
+		row := make(sql.Row, 0, len(i.grouping)+2)
+		for _, idx := range i.grouping {
+			row = append(row, i.row[idx])
+		}
+		return append(row, i.names[col], val), nil
+	}
+}
+
+func (i *unpivotIter) Close(ctx *sql.Context) error {
+	return i.childIter.Close(ctx)
+}
+
+// groupingIndexes returns the indexes of the columns in the schema given that aren't referenced by any of the
+// expressions given.
+func groupingIndexes(schema sql.Schema, exprs ...sql.Expression) []int {
+	used := make(map[string]bool)
+	for _, e := range exprs {
+		sql.Inspect(e, func(e sql.Expression) bool {
+			if gf, ok := e.(*expression.GetField); ok {
+				used[strings.ToLower(gf.Table())+"."+strings.ToLower(gf.Name())] = true
+			}
+			return true
+		})
+	}
+
+	var indexes []int
+	for i, col := range schema {
+		if !used[strings.ToLower(col.Source)+"."+strings.ToLower(col.Name)] {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// pivotColumnName returns the column name for a pivot value or an unpivoted column: the alias or column name if there
+// is one, or the value itself otherwise.
+func pivotColumnName(e sql.Expression) string {
+	if n, ok := e.(sql.Nameable); ok {
+		return n.Name()
+	}
+
+	if lit, ok := e.(*expression.Literal); ok {
+		if v := lit.Value(); v != nil {
+			return fmt.Sprint(v)
+		}
+	}
+
+	return e.String()
+}
+
+func expressionsString(exprs []sql.Expression, format func(fmt.Stringer) string) string {
+	strs := make([]string, len(exprs))
+	for i, e := range exprs {
+		strs[i] = format(e)
+	}
+	return strings.Join(strs, ", ")
+}
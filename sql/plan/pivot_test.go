@@ -0,0 +1,110 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+)
+
+func TestPivot(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	child := memory.NewTable("sales", sql.Schema{
+		{Name: "region", Type: sql.LongText, Source: "sales"},
+		{Name: "quarter", Type: sql.LongText, Source: "sales"},
+		{Name: "amount", Type: sql.Int64, Source: "sales"},
+	})
+	for _, r := range []sql.Row{
+		{"EU", "Q1", int64(10)},
+		{"EU", "Q2", int64(20)},
+		{"EU", "Q1", int64(5)},
+		{"US", "Q2", int64(7)},
+		{"US", "Q3", int64(1)},
+	} {
+		require.NoError(child.Insert(ctx, r))
+	}
+
+	pivot := NewPivot(
+		aggregation.NewSum(expression.NewGetFieldWithTable(2, sql.Int64, "sales", "amount", false)),
+		expression.NewGetFieldWithTable(1, sql.LongText, "sales", "quarter", false),
+		[]sql.Expression{
+			expression.NewLiteral("Q1", sql.LongText),
+			expression.NewAlias("second", expression.NewLiteral("Q2", sql.LongText)),
+		},
+		NewResolvedTable(child, nil, nil),
+	)
+
+	require.Equal(sql.Schema{
+		{Name: "region", Type: sql.LongText, Source: "sales"},
+		{Name: "Q1", Type: sql.Float64, Nullable: true},
+		{Name: "second", Type: sql.Float64, Nullable: true},
+	}, pivot.Schema())
+
+	rows, err := sql.NodeToRows(ctx, pivot)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"EU", float64(15), float64(20)},
+		{"US", nil, float64(7)},
+	}, rows)
+}
+
+func TestUnpivot(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	child := memory.NewTable("quarterly", sql.Schema{
+		{Name: "region", Type: sql.LongText, Source: "quarterly"},
+		{Name: "q1", Type: sql.Int64, Source: "quarterly", Nullable: true},
+		{Name: "q2", Type: sql.Int64, Source: "quarterly", Nullable: true},
+	})
+	for _, r := range []sql.Row{
+		{"EU", int64(15), int64(20)},
+		{"US", nil, int64(7)},
+	} {
+		require.NoError(child.Insert(ctx, r))
+	}
+
+	unpivot := NewUnpivot(
+		"amount",
+		"quarter",
+		[]sql.Expression{
+			expression.NewGetFieldWithTable(1, sql.Int64, "quarterly", "q1", true),
+			expression.NewGetFieldWithTable(2, sql.Int64, "quarterly", "q2", true),
+		},
+		NewResolvedTable(child, nil, nil),
+	)
+
+	require.Equal(sql.Schema{
+		{Name: "region", Type: sql.LongText, Source: "quarterly"},
+		{Name: "quarter", Type: sql.LongText},
+		{Name: "amount", Type: sql.Int64},
+	}, unpivot.Schema())
+
+	rows, err := sql.NodeToRows(ctx, unpivot)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"EU", "q1", int64(15)},
+		{"EU", "q2", int64(20)},
+		{"US", "q2", int64(7)},
+	}, rows)
+}
@@ -415,6 +415,16 @@ func IsNoRowNode(node sql.Node) bool {
 	return IsDDLNode(node) || IsShowNode(node)
 }
 
+// IsWriteNode returns whether the node given is a statement that changes data or schema.
+func IsWriteNode(node sql.Node) bool {
+	switch node.(type) {
+	case *InsertInto, *Update, *DeleteFrom:
+		return true
+	default:
+		return IsDDLNode(node)
+	}
+}
+
 func IsDDLNode(node sql.Node) bool {
 	switch node.(type) {
 	case *CreateTable, *DropTable, *Truncate,
@@ -424,6 +434,7 @@ func IsDDLNode(node sql.Node) bool {
 		*CreateView, *DropView,
 		*CreateIndex, *AlterIndex, *DropIndex,
 		*CreateProcedure, *DropProcedure,
+		*CreateFunction, *DropFunction,
 		*CreateForeignKey, *DropForeignKey,
 		*CreateCheck, *DropCheck,
 		*CreateTrigger, *DropTrigger, *AlterPK:
@@ -440,7 +451,8 @@ func IsShowNode(node sql.Node) bool {
 		*ShowDatabases, *ShowCreateDatabase,
 		*ShowColumns, *ShowIndexes,
 		*ShowProcessList, *ShowTableStatus,
-		*ShowVariables, *ShowWarnings:
+		*ShowVariables, *ShowWarnings, *ShowWarningsCount,
+		*ShowDiff:
 		return true
 	default:
 		return false
@@ -15,9 +15,6 @@
 package plan
 
 import (
-	"sort"
-	"strings"
-
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
@@ -58,6 +55,8 @@ var processListSchema = sql.Schema{
 
 // ShowProcessList shows a list of all current running processes.
 type ShowProcessList struct {
+	// Database is the current database of the session running this node. It's reported for processes that were
+	// started without a current database.
 	Database string
 }
 
@@ -88,41 +87,20 @@ func (p *ShowProcessList) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 	var rows = make([]sql.Row, len(processes))
 
 	for i, proc := range processes {
-		var status []string
-		var names []string
-		for name := range proc.Progress {
-			names = append(names, name)
-		}
-		sort.Strings(names)
-
-		for _, name := range names {
-			progress := proc.Progress[name]
-
-			printer := sql.NewTreePrinter()
-			_ = printer.WriteNode("\n" + progress.String())
-			children := []string{}
-			for _, partitionProgress := range progress.PartitionsProgress {
-				children = append(children, partitionProgress.String())
-			}
-			sort.Strings(children)
-			_ = printer.WriteChildren(children...)
-
-			status = append(status, printer.String())
-		}
-
-		if len(status) == 0 {
-			status = []string{"running"}
+		db := proc.Database
+		if db == "" {
+			db = p.Database
 		}
 
 		rows[i] = process{
 			id:      int64(proc.Connection),
 			user:    proc.User,
 			time:    int64(proc.Seconds()),
-			state:   strings.Join(status, ""),
+			state:   proc.State(),
 			command: "Query",
-			host:    ctx.Session.Client().Address,
+			host:    proc.Host,
 			info:    proc.Query,
-			db:      p.Database,
+			db:      db,
 		}.toRow()
 	}
 
@@ -0,0 +1,412 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// The options of CHANGE REPLICATION SOURCE. Their older MASTER_ names are converted to these by the parser.
+const (
+	ReplicationOption_SourceHost         = "SOURCE_HOST"
+	ReplicationOption_SourcePort         = "SOURCE_PORT"
+	ReplicationOption_SourceUser         = "SOURCE_USER"
+	ReplicationOption_SourcePassword     = "SOURCE_PASSWORD"
+	ReplicationOption_SourceAutoPosition = "SOURCE_AUTO_POSITION"
+	ReplicationOption_SourceConnectRetry = "SOURCE_CONNECT_RETRY"
+	ReplicationOption_SourceRetryCount   = "SOURCE_RETRY_COUNT"
+)
+
+// The options of CHANGE REPLICATION FILTER.
+const (
+	ReplicationOption_ReplicateDoDB        = "REPLICATE_DO_DB"
+	ReplicationOption_ReplicateIgnoreDB    = "REPLICATE_IGNORE_DB"
+	ReplicationOption_ReplicateDoTable     = "REPLICATE_DO_TABLE"
+	ReplicationOption_ReplicateIgnoreTable = "REPLICATE_IGNORE_TABLE"
+)
+
+// ReplicationOption is an option of CHANGE REPLICATION SOURCE or CHANGE REPLICATION FILTER. The value of source
+// options is a string or an uint64, and the value of filter options is a list of databases or tables.
+type ReplicationOption struct {
+	Name  string
+	Value interface{}
+}
+
+func (o ReplicationOption) String() string {
+	switch v := o.Value.(type) {
+	case string:
+		if o.Name == ReplicationOption_SourcePassword {
+			v = "***"
+		}
+		return fmt.Sprintf("%s = '%s'", o.Name, v)
+	case []string:
+		return fmt.Sprintf("%s = (%s)", o.Name, strings.Join(v, ", "))
+	default:
+		return fmt.Sprintf("%s = %v", o.Name, v)
+	}
+}
+
+// ChangeReplicationSource is the CHANGE REPLICATION SOURCE TO statement, or CHANGE MASTER TO, which sets the source
+// server a replica replicates from. Options that aren't given keep their value.
+type ChangeReplicationSource struct {
+	Options []ReplicationOption
+}
+
+var _ sql.Node = (*ChangeReplicationSource)(nil)
+
+// NewChangeReplicationSource creates a new ChangeReplicationSource node.
+func NewChangeReplicationSource(options []ReplicationOption) *ChangeReplicationSource {
+	return &ChangeReplicationSource{Options: options}
+}
+
+// Resolved implements the sql.Node interface.
+func (c *ChangeReplicationSource) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (c *ChangeReplicationSource) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *ChangeReplicationSource) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 0)
+	}
+	return c, nil
+}
+
+// Schema implements the sql.Node interface.
+func (c *ChangeReplicationSource) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (c *ChangeReplicationSource) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	err := updateReplicaConfig(ctx, func(config *sql.ReplicaConfig) error {
+		for _, o := range c.Options {
+			if err := applySourceOption(config, o); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (c *ChangeReplicationSource) String() string {
+	options := make([]string, len(c.Options))
+	for i, o := range c.Options {
+		options[i] = o.String()
+	}
+	return fmt.Sprintf("CHANGE REPLICATION SOURCE TO %s", strings.Join(options, ", "))
+}
+
+// ChangeReplicationFilter is the CHANGE REPLICATION FILTER statement, which sets the databases and tables whose changes
+// a replica applies. Filters that aren't given keep their value, and an empty list clears a filter.
+type ChangeReplicationFilter struct {
+	Options []ReplicationOption
+}
+
+var _ sql.Node = (*ChangeReplicationFilter)(nil)
+
+// NewChangeReplicationFilter creates a new ChangeReplicationFilter node.
+func NewChangeReplicationFilter(options []ReplicationOption) *ChangeReplicationFilter {
+	return &ChangeReplicationFilter{Options: options}
+}
+
+// Resolved implements the sql.Node interface.
+func (c *ChangeReplicationFilter) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (c *ChangeReplicationFilter) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *ChangeReplicationFilter) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 0)
+	}
+	return c, nil
+}
+
+// Schema implements the sql.Node interface.
+func (c *ChangeReplicationFilter) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (c *ChangeReplicationFilter) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	err := updateReplicaConfig(ctx, func(config *sql.ReplicaConfig) error {
+		for _, o := range c.Options {
+			if err := applyFilterOption(config, o); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (c *ChangeReplicationFilter) String() string {
+	options := make([]string, len(c.Options))
+	for i, o := range c.Options {
+		options[i] = o.String()
+	}
+	return fmt.Sprintf("CHANGE REPLICATION FILTER %s", strings.Join(options, ", "))
+}
+
+// StartReplica is the START REPLICA statement, or START SLAVE, which starts replicating from the source server.
+type StartReplica struct{}
+
+var _ sql.Node = (*StartReplica)(nil)
+
+// NewStartReplica creates a new StartReplica node.
+func NewStartReplica() *StartReplica {
+	return &StartReplica{}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *StartReplica) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (s *StartReplica) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *StartReplica) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+	return s, nil
+}
+
+// Schema implements the sql.Node interface.
+func (s *StartReplica) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (s *StartReplica) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	config, err := loadReplicaConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.SourceHost == "" {
+		return nil, sql.ErrReplicaNotConfigured.New()
+	}
+
+	if config.Running {
+		ctx.Session.Warn(&sql.Warning{
+			Level:   "Note",
+			Code:    3083,
+			Message: "Replication thread(s) for channel '' are already running.",
+		})
+	} else {
+		config.Running = true
+		if err := ctx.Replication.SaveReplicaConfig(ctx, config); err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (s *StartReplica) String() string {
+	return "START REPLICA"
+}
+
+// StopReplica is the STOP REPLICA statement, or STOP SLAVE, which stops replicating from the source server.
+type StopReplica struct{}
+
+var _ sql.Node = (*StopReplica)(nil)
+
+// NewStopReplica creates a new StopReplica node.
+func NewStopReplica() *StopReplica {
+	return &StopReplica{}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *StopReplica) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (s *StopReplica) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *StopReplica) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+	return s, nil
+}
+
+// Schema implements the sql.Node interface.
+func (s *StopReplica) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (s *StopReplica) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	config, err := loadReplicaConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !config.Running {
+		ctx.Session.Warn(&sql.Warning{
+			Level:   "Note",
+			Code:    3084,
+			Message: "Replication thread(s) for channel '' are already stopped.",
+		})
+	} else {
+		config.Running = false
+		if err := ctx.Replication.SaveReplicaConfig(ctx, config); err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (s *StopReplica) String() string {
+	return "STOP REPLICA"
+}
+
+// loadReplicaConfig returns the replication configuration saved in the store of the context given.
+func loadReplicaConfig(ctx *sql.Context) (sql.ReplicaConfig, error) {
+	if ctx.Replication == nil {
+		return sql.ReplicaConfig{}, sql.ErrNoReplicationConfigStore.New()
+	}
+	return ctx.Replication.LoadReplicaConfig(ctx)
+}
+
+// updateReplicaConfig changes the replication configuration saved in the store of the context given with the function
+// given, which can only be done while the replica isn't running.
+func updateReplicaConfig(ctx *sql.Context, update func(config *sql.ReplicaConfig) error) error {
+	config, err := loadReplicaConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	if config.Running {
+		return sql.ErrReplicaRunning.New()
+	}
+
+	if err := update(&config); err != nil {
+		return err
+	}
+
+	return ctx.Replication.SaveReplicaConfig(ctx, config)
+}
+
+// applySourceOption sets the option of CHANGE REPLICATION SOURCE given on the configuration given.
+func applySourceOption(config *sql.ReplicaConfig, o ReplicationOption) error {
+	switch o.Name {
+	case ReplicationOption_SourceHost, ReplicationOption_SourceUser, ReplicationOption_SourcePassword:
+		s, ok := o.Value.(string)
+		if !ok {
+			return sql.ErrSyntaxError.New(fmt.Sprintf("%s must be a string", o.Name))
+		}
+		switch o.Name {
+		case ReplicationOption_SourceHost:
+			config.SourceHost = s
+		case ReplicationOption_SourceUser:
+			config.SourceUser = s
+		default:
+			config.SourcePassword = s
+		}
+	case ReplicationOption_SourcePort, ReplicationOption_SourceAutoPosition, ReplicationOption_SourceConnectRetry,
+		ReplicationOption_SourceRetryCount:
+		n, ok := o.Value.(uint64)
+		if !ok {
+			return sql.ErrSyntaxError.New(fmt.Sprintf("%s must be a number", o.Name))
+		}
+		switch o.Name {
+		case ReplicationOption_SourcePort:
+			if n > 65535 {
+				return sql.ErrSyntaxError.New(fmt.Sprintf("%s must be a port number", o.Name))
+			}
+			config.SourcePort = uint32(n)
+		case ReplicationOption_SourceAutoPosition:
+			if n > 1 {
+				return sql.ErrSyntaxError.New(fmt.Sprintf("%s must be 0 or 1", o.Name))
+			}
+			config.SourceAutoPosition = n == 1
+		case ReplicationOption_SourceConnectRetry:
+			if n > 1<<32-1 {
+				return sql.ErrSyntaxError.New(fmt.Sprintf("%s is out of range", o.Name))
+			}
+			config.SourceConnectRetry = uint32(n)
+		default:
+			if n > 1<<32-1 {
+				return sql.ErrSyntaxError.New(fmt.Sprintf("%s is out of range", o.Name))
+			}
+			config.SourceRetryCount = uint32(n)
+		}
+	default:
+		return sql.ErrSyntaxError.New(fmt.Sprintf("unknown replication source option %s", o.Name))
+	}
+	return nil
+}
+
+// applyFilterOption sets the filter of CHANGE REPLICATION FILTER given on the configuration given.
+func applyFilterOption(config *sql.ReplicaConfig, o ReplicationOption) error {
+	values, ok := o.Value.([]string)
+	if !ok {
+		return sql.ErrSyntaxError.New(fmt.Sprintf("%s must be a list", o.Name))
+	}
+
+	switch o.Name {
+	case ReplicationOption_ReplicateDoDB:
+		config.Filters.DoDBs = values
+	case ReplicationOption_ReplicateIgnoreDB:
+		config.Filters.IgnoreDBs = values
+	case ReplicationOption_ReplicateDoTable, ReplicationOption_ReplicateIgnoreTable:
+		for _, v := range values {
+			if i := strings.IndexByte(v, '.'); i <= 0 || i == len(v)-1 {
+				return sql.ErrSyntaxError.New(fmt.Sprintf("%s must list tables as database.table, got %s", o.Name, v))
+			}
+		}
+		if o.Name == ReplicationOption_ReplicateDoTable {
+			config.Filters.DoTables = values
+		} else {
+			config.Filters.IgnoreTables = values
+		}
+	default:
+		return sql.ErrSyntaxError.New(fmt.Sprintf("unknown replication filter %s", o.Name))
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestReplicationStatements(t *testing.T) {
+	require := require.New(t)
+
+	store := sql.NewMemoryReplicationConfigStore()
+	ctx := sql.NewContext(context.Background(), sql.WithReplicationConfigStore(store))
+
+	run := func(n sql.Node) error {
+		_, err := sql.NodeToRows(ctx, n)
+		return err
+	}
+
+	err := run(NewStartReplica())
+	require.True(sql.ErrReplicaNotConfigured.Is(err))
+
+	require.NoError(run(NewChangeReplicationSource([]ReplicationOption{
+		{Name: ReplicationOption_SourceHost, Value: "db1"},
+		{Name: ReplicationOption_SourcePort, Value: uint64(3307)},
+		{Name: ReplicationOption_SourceAutoPosition, Value: uint64(1)},
+	})))
+	require.NoError(run(NewChangeReplicationSource([]ReplicationOption{
+		{Name: ReplicationOption_SourceUser, Value: "repl"},
+	})))
+	require.NoError(run(NewChangeReplicationFilter([]ReplicationOption{
+		{Name: ReplicationOption_ReplicateDoDB, Value: []string{"db1"}},
+		{Name: ReplicationOption_ReplicateIgnoreTable, Value: []string{"db1.t1"}},
+	})))
+
+	err = run(NewChangeReplicationFilter([]ReplicationOption{
+		{Name: ReplicationOption_ReplicateDoTable, Value: []string{"t1"}},
+	}))
+	require.True(sql.ErrSyntaxError.Is(err))
+
+	err = run(NewChangeReplicationSource([]ReplicationOption{
+		{Name: ReplicationOption_SourcePort, Value: "3307"},
+	}))
+	require.True(sql.ErrSyntaxError.Is(err))
+
+	require.NoError(run(NewStartReplica()))
+
+	config, err := store.LoadReplicaConfig(ctx)
+	require.NoError(err)
+	require.Equal(sql.ReplicaConfig{
+		SourceHost:         "db1",
+		SourcePort:         3307,
+		SourceUser:         "repl",
+		SourceAutoPosition: true,
+		Filters: sql.ReplicationFilters{
+			DoDBs:        []string{"db1"},
+			IgnoreTables: []string{"db1.t1"},
+		},
+		Running: true,
+	}, config)
+
+	err = run(NewChangeReplicationSource([]ReplicationOption{
+		{Name: ReplicationOption_SourceHost, Value: "db2"},
+	}))
+	require.True(sql.ErrReplicaRunning.Is(err))
+
+	require.NoError(run(NewStartReplica()))
+	require.Len(ctx.Warnings(), 1)
+
+	require.NoError(run(NewStopReplica()))
+	config, err = store.LoadReplicaConfig(ctx)
+	require.NoError(err)
+	require.False(config.Running)
+}
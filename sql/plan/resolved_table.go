@@ -60,7 +60,8 @@ func (t *ResolvedTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, err
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, sql.NewTableRowIter(ctx, t.Table, partitions)), nil
+	iter := timestampsToSession(ctx, t.Schema(), sql.NewTableRowIter(ctx, t.Table, partitions))
+	return sql.NewSpanIter(span, iter), nil
 }
 
 // WithChildren implements the Node interface.
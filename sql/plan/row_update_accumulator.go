@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 
 	"github.com/dolthub/vitess/go/mysql"
@@ -33,6 +34,7 @@ const (
 	UpdateTypeUpdate
 	UpdateTypeDelete
 	UpdateTypeJoinUpdate
+	UpdateTypeLoadData
 )
 
 // RowUpdateAccumulator wraps other nodes that update tables, and returns their results as OKResults with the appropriate
@@ -154,6 +156,60 @@ func (o *onDuplicateUpdateHandler) okResult() sql.OkResult {
 	return sql.NewOkResult(o.rowsAffected)
 }
 
+// loadDataRowHandler handles the row counts of LOAD DATA statements. The lines of the file that are rejected before
+// being inserted are taken from the report of the statement, which the handler receives instead of the reporter of
+// the context, and passes on to it.
+type loadDataRowHandler struct {
+	replace  bool
+	ctx      *sql.Context
+	reporter sql.LoadDataReporter
+	inserted int
+	deleted  int
+	// ignored is the number of rows that weren't inserted because they duplicated a key with IGNORE.
+	ignored  int
+	rejected int
+}
+
+var _ sql.LoadDataReporter = (*loadDataRowHandler)(nil)
+
+func (l *loadDataRowHandler) handleRowUpdate(row sql.Row) error {
+	l.inserted++
+
+	// With REPLACE, a row was deleted as well as inserted if at least one column in the first half of the row is
+	// non-null.
+	if l.replace {
+		for i := 0; i < len(row)/2; i++ {
+			if row[i] != nil {
+				l.deleted++
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReportLoadData implements the sql.LoadDataReporter interface.
+func (l *loadDataRowHandler) ReportLoadData(ctx *sql.Context, report sql.LoadDataReport) {
+	l.rejected = len(report.Rejected)
+	if l.reporter != nil {
+		l.reporter.ReportLoadData(ctx, report)
+	}
+}
+
+func (l *loadDataRowHandler) okResult() sql.OkResult {
+	skipped := l.ignored + l.rejected
+	return sql.OkResult{
+		RowsAffected: uint64(l.inserted + l.deleted),
+		Info: LoadDataInfo{
+			Records:  l.inserted + skipped,
+			Deleted:  l.deleted,
+			Skipped:  skipped,
+			Warnings: int(l.ctx.WarningCount()),
+		},
+	}
+}
+
 type updateRowHandler struct {
 	rowsMatched  int
 	rowsAffected int
@@ -260,6 +316,13 @@ type accumulatorIter struct {
 	iter             sql.RowIter
 	once             sync.Once
 	updateRowHandler accumulatorRowHandler
+	// table is the table whose rows are changed, or nil if there are several.
+	table *ResolvedTable
+	// binlogEvents returns the binlog events of the changes of a row, if the changes are written to a binlog. They're
+	// kept in events until the statement finishes without errors.
+	binlogEvents func(row sql.Row) ([]sql.BinlogEvent, error)
+	events       []sql.BinlogEvent
+	failed       bool
 }
 
 func (a *accumulatorIter) Next() (sql.Row, error) {
@@ -277,19 +340,37 @@ func (a *accumulatorIter) Next() (sql.Row, error) {
 		if err == io.EOF {
 			return sql.NewRow(a.updateRowHandler.okResult()), nil
 		} else if ErrInsertIgnore.Is(err) {
+			if l, ok := a.updateRowHandler.(*loadDataRowHandler); ok {
+				l.ignored++
+			}
 			continue
 		} else if err != nil {
+			a.failed = true
 			return nil, err
 		}
 
 		err = a.updateRowHandler.handleRowUpdate(row)
 		if err != nil {
+			a.failed = true
 			return nil, err
 		}
+
+		if a.binlogEvents != nil {
+			events, err := a.binlogEvents(row)
+			if err != nil {
+				a.failed = true
+				return nil, err
+			}
+			a.events = append(a.events, events...)
+		}
 	}
 }
 
 func (a *accumulatorIter) Close(ctx *sql.Context) error {
+	if l, ok := a.updateRowHandler.(*loadDataRowHandler); ok {
+		ctx.LoadData = l.reporter
+	}
+
 	err := a.iter.Close(ctx)
 	if err != nil {
 		return err
@@ -298,12 +379,23 @@ func (a *accumulatorIter) Close(ctx *sql.Context) error {
 	result := a.updateRowHandler.okResult()
 	ctx.SetLastQueryInfo(sql.RowCount, int64(result.RowsAffected))
 
+	if a.table != nil && a.table.Database != nil && ctx.Statistics != nil && result.RowsAffected > 0 {
+		err = ctx.Statistics.RecordChanges(ctx, a.table.Database.Name(), a.table.Table, uint64(result.RowsAffected))
+		if err != nil {
+			return err
+		}
+	}
+
 	// For UPDATE, the affected-rows value is the number of rows “found”; that is, matched by the WHERE clause for FOUND_ROWS
 	// cc. https://dev.mysql.com/doc/c-api/8.0/en/mysql-affected-rows.html
 	if au, ok := a.updateRowHandler.(*updateRowHandler); ok {
 		ctx.SetLastQueryInfo(sql.FoundRows, int64(au.rowsMatched))
 	}
 
+	if len(a.events) > 0 && !a.failed {
+		ctx.Binlog.Write(ctx, a.events...)
+	}
+
 	return nil
 }
 
@@ -329,6 +421,10 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 		rowHandler = &updateRowHandler{schema: schema[:len(schema)/2]}
 	case UpdateTypeDelete:
 		rowHandler = &deleteRowHandler{}
+	case UpdateTypeLoadData:
+		handler := &loadDataRowHandler{replace: insertsWithReplace(r.Child), ctx: ctx, reporter: ctx.LoadData}
+		ctx.LoadData = handler
+		rowHandler = handler
 	case UpdateTypeJoinUpdate:
 		var schema sql.Schema
 		var updaterMap map[string]sql.RowUpdater
@@ -354,8 +450,184 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 		panic(fmt.Sprintf("Unrecognized RowUpdateType %d", r.RowUpdateType))
 	}
 
-	return &accumulatorIter{
+	var table *ResolvedTable
+	if r.RowUpdateType != UpdateTypeJoinUpdate {
+		table = updatedTable(r.Child)
+	}
+
+	iter := &accumulatorIter{
 		iter:             rowIter,
 		updateRowHandler: rowHandler,
-	}, nil
+		table:            table,
+	}
+	if ctx.Binlog != nil && ctx.Binlog.HasSinks() {
+		// The rows of the events have the TIMESTAMP values stored by the table, in UTC, rather than the ones of the session
+		binlogEvents := r.binlogEvents(table)
+		iter.binlogEvents = func(row sql.Row) ([]sql.BinlogEvent, error) {
+			events, err := binlogEvents(row)
+			for i := range events {
+				events[i].Before = rowToStored(ctx, events[i].Schema, events[i].Before)
+				events[i].After = rowToStored(ctx, events[i].Schema, events[i].After)
+			}
+			return events, err
+		}
+	}
+	return iter, nil
+}
+
+// binlogEvents returns a function returning the binlog events of the changes of a row of the node, whose table is the
+// one given unless the node updates a join.
+func (r RowUpdateAccumulator) binlogEvents(table *ResolvedTable) func(row sql.Row) ([]sql.BinlogEvent, error) {
+	var db, name string
+	var tableSchema sql.Schema
+	if table != nil {
+		name = table.Name()
+		tableSchema = table.Schema()
+		if table.Database != nil {
+			db = table.Database.Name()
+		}
+	}
+
+	// LOAD DATA changes the rows of the table like the INSERT or REPLACE it's run as.
+	updateType := r.RowUpdateType
+	if updateType == UpdateTypeLoadData {
+		updateType = UpdateTypeInsert
+		if insertsWithReplace(r.Child) {
+			updateType = UpdateTypeReplace
+		}
+	}
+
+	schema := r.Child.Schema()
+	switch updateType {
+	case UpdateTypeInsert:
+		return func(row sql.Row) ([]sql.BinlogEvent, error) {
+			return []sql.BinlogEvent{{Type: sql.BinlogInsert, Database: db, Table: name, Schema: tableSchema, After: row.Copy()}}, nil
+		}
+	case UpdateTypeDelete:
+		return func(row sql.Row) ([]sql.BinlogEvent, error) {
+			return []sql.BinlogEvent{{Type: sql.BinlogDelete, Database: db, Table: name, Schema: tableSchema, Before: row.Copy()}}, nil
+		}
+	case UpdateTypeReplace:
+		return func(row sql.Row) ([]sql.BinlogEvent, error) {
+			// A row was deleted if at least one column in the first half of the row is non-null
+			oldRow, newRow := row[:len(row)/2], row[len(row)/2:]
+			for _, v := range oldRow {
+				if v != nil {
+					return []sql.BinlogEvent{{Type: sql.BinlogUpdate, Database: db, Table: name, Schema: tableSchema, Before: oldRow.Copy(), After: newRow.Copy()}}, nil
+				}
+			}
+			return []sql.BinlogEvent{{Type: sql.BinlogInsert, Database: db, Table: name, Schema: tableSchema, After: newRow.Copy()}}, nil
+		}
+	case UpdateTypeDuplicateKeyUpdate, UpdateTypeUpdate:
+		if r.RowUpdateType == UpdateTypeUpdate {
+			schema = schema[:len(schema)/2]
+		}
+		return func(row sql.Row) ([]sql.BinlogEvent, error) {
+			if len(row) == len(schema) {
+				return []sql.BinlogEvent{{Type: sql.BinlogInsert, Database: db, Table: name, Schema: tableSchema, After: row.Copy()}}, nil
+			}
+			oldRow, newRow := row[:len(row)/2], row[len(row)/2:]
+			return binlogUpdateEvents(db, name, tableSchema, oldRow, newRow, schema)
+		}
+	case UpdateTypeJoinUpdate:
+		var joinSchema sql.Schema
+		tables := make(map[string]*ResolvedTable)
+		Inspect(r.Child, func(node sql.Node) bool {
+			switch node := node.(type) {
+			case JoinNode, *CrossJoin, *Project, *IndexedJoin:
+				if joinSchema == nil {
+					joinSchema = node.Schema()
+				}
+			case *TableAlias:
+				if rt, ok := node.Child.(*ResolvedTable); ok {
+					tables[node.Name()] = rt
+				}
+			case *ResolvedTable:
+				if _, ok := tables[node.Name()]; !ok {
+					tables[node.Name()] = node
+				}
+			}
+			return true
+		})
+		tableSchemas := recreateTableSchemaFromJoinSchema(joinSchema)
+
+		var updated []string
+		Inspect(r.Child, func(node sql.Node) bool {
+			if uj, ok := node.(*UpdateJoin); ok {
+				for name := range uj.updaters {
+					updated = append(updated, name)
+				}
+				return false
+			}
+			return true
+		})
+		sort.Strings(updated)
+
+		return func(row sql.Row) ([]sql.BinlogEvent, error) {
+			oldRows := splitRowIntoTableRowMap(row[:len(row)/2], joinSchema)
+			newRows := splitRowIntoTableRowMap(row[len(row)/2:], joinSchema)
+
+			var events []sql.BinlogEvent
+			for _, alias := range updated {
+				db, name, tableSchema := "", alias, sql.Schema(nil)
+				if rt, ok := tables[alias]; ok {
+					name = rt.Name()
+					tableSchema = rt.Schema()
+					if rt.Database != nil {
+						db = rt.Database.Name()
+					}
+				}
+				e, err := binlogUpdateEvents(db, name, tableSchema, oldRows[alias], newRows[alias], tableSchemas[alias])
+				if err != nil {
+					return nil, err
+				}
+				events = append(events, e...)
+			}
+			return events, nil
+		}
+	default:
+		return nil
+	}
+}
+
+// binlogUpdateEvents returns the binlog event of an update of a row of the table given, if it changed the row. The
+// rows are compared with the schema given, which is the schema of the rows of the node.
+func binlogUpdateEvents(db, table string, tableSchema sql.Schema, oldRow, newRow sql.Row, schema sql.Schema) ([]sql.BinlogEvent, error) {
+	equals, err := oldRow.Equals(newRow, schema)
+	if err != nil || equals {
+		return nil, err
+	}
+	return []sql.BinlogEvent{{Type: sql.BinlogUpdate, Database: db, Table: table, Schema: tableSchema, Before: oldRow.Copy(), After: newRow.Copy()}}, nil
+}
+
+// updatedTable returns the table changed by the node given, which is the first table found in it.
+func updatedTable(n sql.Node) *ResolvedTable {
+	var table *ResolvedTable
+	Inspect(n, func(node sql.Node) bool {
+		if table != nil {
+			return false
+		}
+
+		switch node := node.(type) {
+		case *ResolvedTable:
+			table = node
+		case *IndexedTableAccess:
+			table = node.ResolvedTable
+		}
+		return table == nil
+	})
+	return table
+}
+
+// insertsWithReplace returns whether the node given inserts its rows with REPLACE.
+func insertsWithReplace(n sql.Node) bool {
+	var replace bool
+	Inspect(n, func(n sql.Node) bool {
+		if ii, ok := n.(*InsertInto); ok {
+			replace = ii.IsReplace
+			return false
+		}
+		return true
+	})
+	return replace
 }
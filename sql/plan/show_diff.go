@@ -0,0 +1,91 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowdiff"
+)
+
+// ShowDiff is a node that shows the rows that were added, removed or modified between two tables, matched by primary
+// key.
+type ShowDiff struct {
+	BinaryNode
+}
+
+// NewShowDiff creates a new ShowDiff node comparing the rows of |from| to the rows of |to|.
+func NewShowDiff(from, to sql.Node) *ShowDiff {
+	return &ShowDiff{BinaryNode{left: from, right: to}}
+}
+
+// Schema implements the Node interface. It's the schema of the rows of rowdiff.DiffSchema.
+func (n *ShowDiff) Schema() sql.Schema {
+	from, ok := n.left.(*ResolvedTable)
+	if !ok {
+		return nil
+	}
+	to, ok := n.right.(*ResolvedTable)
+	if !ok {
+		return nil
+	}
+	return rowdiff.DiffSchema(from.Table, to.Table)
+}
+
+// RowIter implements the Node interface.
+func (n *ShowDiff) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	from, ok := n.left.(*ResolvedTable)
+	if !ok {
+		return nil, sql.ErrInvalidChildType.New(n, n.left, (*ResolvedTable)(nil))
+	}
+	to, ok := n.right.(*ResolvedTable)
+	if !ok {
+		return nil, sql.ErrInvalidChildType.New(n, n.right, (*ResolvedTable)(nil))
+	}
+
+	diffs, err := rowdiff.DiffTables(ctx, from.Table, to.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	fromWidth, toWidth := len(from.Schema()), len(to.Schema())
+	rows := make([]sql.Row, len(diffs))
+	for i, d := range diffs {
+		rows[i] = d.ToRow(fromWidth, toWidth)
+	}
+
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// WithChildren implements the Node interface.
+func (n *ShowDiff) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 2)
+	}
+	return NewShowDiff(children[0], children[1]), nil
+}
+
+// String implements the fmt.Stringer interface.
+func (n *ShowDiff) String() string {
+	return fmt.Sprintf("SHOW DIFF FROM %s TO %s", nameOf(n.left), nameOf(n.right))
+}
+
+func nameOf(node sql.Node) string {
+	if nameable, ok := node.(sql.Nameable); ok {
+		return nameable.Name()
+	}
+	return node.String()
+}
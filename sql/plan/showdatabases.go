@@ -53,10 +53,17 @@ func (*ShowDatabases) Schema() sql.Schema {
 // RowIter implements the Node interface.
 func (p *ShowDatabases) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	dbs := p.Catalog.AllDatabases()
-	var rows = make([]sql.Row, 0, len(dbs))
-	for _, db := range dbs {
+	sessionDbs := sql.GetAllSessionDatabases(ctx)
+	var rows = make([]sql.Row, 0, len(dbs)+len(sessionDbs))
+	for _, db := range sessionDbs {
 		rows = append(rows, sql.Row{db.Name()})
 	}
+	for _, db := range dbs {
+		// Session databases shadow the databases with the same name
+		if _, ok := sql.GetSessionDatabase(ctx, db.Name()); !ok {
+			rows = append(rows, sql.Row{db.Name()})
+		}
+	}
 
 	sort.Slice(rows, func(i, j int) bool {
 		return strings.Compare(rows[i][0].(string), rows[j][0].(string)) < 0
@@ -22,13 +22,13 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
-// ShowVariables is a node that shows the global and session variables
-//TODO: implement the GLOBAL and SESSION distinction
+// ShowVariables is a node that shows the session variables, or the global variables for SHOW GLOBAL VARIABLES
 type ShowVariables struct {
 	pattern string
+	global  bool
 }
 
-// NewShowVariables returns a new ShowVariables reference.
+// NewShowVariables returns a new ShowVariables reference showing the values of the variables for the session.
 // like is a "like pattern". If like is an empty string it will return all variables.
 func NewShowVariables(like string) *ShowVariables {
 	return &ShowVariables{
@@ -36,6 +36,15 @@ func NewShowVariables(like string) *ShowVariables {
 	}
 }
 
+// NewShowGlobalVariables returns a new ShowVariables reference showing the global values of the variables.
+// like is a "like pattern". If like is an empty string it will return all variables.
+func NewShowGlobalVariables(like string) *ShowVariables {
+	return &ShowVariables{
+		pattern: like,
+		global:  true,
+	}
+}
+
 // Resolved implements sql.Node interface. The function always returns true.
 func (sv *ShowVariables) Resolved() bool {
 	return true
@@ -56,7 +65,11 @@ func (sv *ShowVariables) String() string {
 	if sv.pattern != "" {
 		like = fmt.Sprintf(" LIKE '%s'", sv.pattern)
 	}
-	return fmt.Sprintf("SHOW VARIABLES%s", like)
+	var scope string
+	if sv.global {
+		scope = " GLOBAL"
+	}
+	return fmt.Sprintf("SHOW%s VARIABLES%s", scope, like)
 }
 
 // Schema returns a new Schema reference for "SHOW VARIABLES" query.
@@ -85,7 +98,12 @@ func (sv *ShowVariables) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, er
 		)
 	}
 
-	for k, v := range ctx.GetAllSessionVariables() {
+	vars := ctx.GetAllSessionVariables()
+	if sv.global {
+		vars = sql.SystemVariables.GetAllGlobalVariables()
+	}
+
+	for k, v := range vars {
 		if like != nil {
 			b, err := like.Eval(ctx, sql.NewRow(k, sv.pattern))
 			if err != nil {
@@ -71,3 +71,45 @@ func TestShowVariablesWithLike(t *testing.T) {
 
 	assert.Equal(t, expectedRows, rows)
 }
+
+func TestShowGlobalVariables(t *testing.T) {
+	require := require.New(t)
+
+	sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{
+		{
+			Name:    "test_show_global_only",
+			Scope:   sql.SystemVariableScope_Global,
+			Dynamic: true,
+			Type:    sql.NewSystemIntType("test_show_global_only", 0, 100, false),
+			Default: int64(1),
+		},
+		{
+			Name:    "test_show_global_both",
+			Scope:   sql.SystemVariableScope_Both,
+			Dynamic: true,
+			Type:    sql.NewSystemIntType("test_show_global_both", 0, 100, false),
+			Default: int64(1),
+		},
+	})
+
+	ctx := sql.NewEmptyContext()
+	require.NoError(sql.SystemVariables.SetGlobal("test_show_global_only", int64(2)))
+	require.NoError(sql.SystemVariables.SetGlobal("test_show_global_both", int64(3)))
+	require.Error(sql.SystemVariables.SetGlobal("test_show_global_both", int64(101)))
+
+	// Global only variables have no session value, while the others keep the value they had when the session started
+	sessionRows, err := sql.RowIterToRows(ctx, mustRowIter(t, ctx, NewShowVariables("test_show_global_%")))
+	require.NoError(err)
+	require.Equal([]sql.Row{{"test_show_global_both", int64(1)}, {"test_show_global_only", int64(2)}}, sessionRows)
+
+	globalRows, err := sql.RowIterToRows(ctx, mustRowIter(t, ctx, NewShowGlobalVariables("test_show_global_%")))
+	require.NoError(err)
+	require.Equal([]sql.Row{{"test_show_global_both", int64(3)}, {"test_show_global_only", int64(2)}}, globalRows)
+	require.Equal("SHOW GLOBAL VARIABLES LIKE 'test_show_global_%'", NewShowGlobalVariables("test_show_global_%").String())
+}
+
+func mustRowIter(t *testing.T, ctx *sql.Context, n sql.Node) sql.RowIter {
+	iter, err := n.RowIter(ctx, nil)
+	require.NoError(t, err)
+	return iter
+}
@@ -62,3 +62,59 @@ func (sw ShowWarnings) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, erro
 
 	return sql.RowsToRowIter(rows...), nil
 }
+
+// ShowWarningsCount is a node that shows the number of session warnings, or only of errors, as SHOW COUNT(*) WARNINGS
+// and SHOW COUNT(*) ERRORS do. Errors are included in the number of warnings.
+type ShowWarningsCount struct {
+	Errors bool
+	Count  int64
+}
+
+var _ sql.Node = (*ShowWarningsCount)(nil)
+
+// NewShowWarningsCount creates a new ShowWarningsCount node for the warnings given.
+func NewShowWarningsCount(warnings []*sql.Warning, errors bool) *ShowWarningsCount {
+	if errors {
+		warnings = sql.ErrorWarnings(warnings)
+	}
+	return &ShowWarningsCount{Errors: errors, Count: int64(len(warnings))}
+}
+
+// Resolved implements the sql.Node interface.
+func (*ShowWarningsCount) Resolved() bool {
+	return true
+}
+
+// WithChildren implements the sql.Node interface.
+func (sc *ShowWarningsCount) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(sc, len(children), 0)
+	}
+
+	return sc, nil
+}
+
+// String implements the fmt.Stringer interface.
+func (sc *ShowWarningsCount) String() string {
+	if sc.Errors {
+		return "SHOW COUNT(*) ERRORS"
+	}
+	return "SHOW COUNT(*) WARNINGS"
+}
+
+// Schema implements the sql.Node interface.
+func (sc *ShowWarningsCount) Schema() sql.Schema {
+	name := "@@session.warning_count"
+	if sc.Errors {
+		name = "@@session.error_count"
+	}
+	return sql.Schema{&sql.Column{Name: name, Type: sql.Int64, Nullable: false}}
+}
+
+// Children implements the sql.Node interface.
+func (*ShowWarningsCount) Children() []sql.Node { return nil }
+
+// RowIter implements the sql.Node interface.
+func (sc *ShowWarningsCount) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return sql.RowsToRowIter(sql.NewRow(sc.Count)), nil
+}
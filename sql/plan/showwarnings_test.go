@@ -53,3 +53,24 @@ func TestShowWarnings(t *testing.T) {
 	}
 	require.NoError(it.Close(ctx))
 }
+
+func TestShowWarningsCount(t *testing.T) {
+	require := require.New(t)
+
+	ctx := sql.NewEmptyContext()
+	ctx.Session.Warn(&sql.Warning{Level: "Warning", Message: "w1", Code: 1})
+	ctx.Session.Warn(&sql.Warning{Level: "Error", Message: "e1", Code: 2})
+	ctx.Session.Warn(&sql.Warning{Level: "Note", Message: "n1", Code: 3})
+
+	warnings := NewShowWarningsCount(ctx.Session.Warnings(), false)
+	require.Equal("@@session.warning_count", warnings.Schema()[0].Name)
+	rows, err := sql.NodeToRows(ctx, warnings)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(3)}}, rows)
+
+	errs := NewShowWarningsCount(ctx.Session.Warnings(), true)
+	require.Equal("@@session.error_count", errs.Schema()[0].Name)
+	rows, err = sql.NodeToRows(ctx, errs)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(1)}}, rows)
+}
@@ -60,8 +60,17 @@ type SignalName struct {
 	Name   string
 }
 
+// Resignal represents the RESIGNAL statement, which raises the condition handled by the handler it's in again, with
+// the SQLSTATE and condition information items given replaced.
+type Resignal struct {
+	SqlStateValue string // Empty when the SQLSTATE of the handled condition is kept
+	Name          string // Condition name, replaced with its SQLSTATE by the analyzer
+	Info          map[SignalConditionItemName]SignalInfo
+}
+
 var _ sql.Node = (*Signal)(nil)
 var _ sql.Node = (*SignalName)(nil)
+var _ sql.Node = (*Resignal)(nil)
 
 // NewSignal returns a *Signal node.
 func NewSignal(sqlstate string, info map[SignalConditionItemName]SignalInfo) *Signal {
@@ -112,6 +121,15 @@ func NewSignalName(name string, info map[SignalConditionItemName]SignalInfo) *Si
 	}
 }
 
+// NewResignal returns a *Resignal node.
+func NewResignal(sqlstate, name string, info map[SignalConditionItemName]SignalInfo) *Resignal {
+	return &Resignal{
+		SqlStateValue: sqlstate,
+		Name:          name,
+		Info:          info,
+	}
+}
+
 // Resolved implements the sql.Node interface.
 func (s *Signal) Resolved() bool {
 	return true
@@ -215,6 +233,69 @@ func (s *SignalName) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 	return nil, fmt.Errorf("may not iterate over unresolved node *SignalName")
 }
 
+// Resolved implements the sql.Node interface.
+func (s *Resignal) Resolved() bool {
+	return s.Name == ""
+}
+
+// String implements the sql.Node interface.
+func (s *Resignal) String() string {
+	condition := ""
+	if s.Name != "" {
+		condition = " " + s.Name
+	} else if s.SqlStateValue != "" {
+		condition = fmt.Sprintf(" SQLSTATE '%s'", s.SqlStateValue)
+	}
+	infoStr := ""
+	if len(s.Info) > 0 {
+		infoStr = " SET"
+		i := 0
+		for _, info := range s.Info {
+			if i > 0 {
+				infoStr += ","
+			}
+			infoStr += " " + info.String()
+			i++
+		}
+	}
+	return fmt.Sprintf("RESIGNAL%s%s", condition, infoStr)
+}
+
+// Schema implements the sql.Node interface.
+func (s *Resignal) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (s *Resignal) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *Resignal) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+// RowIter implements the sql.Node interface.
+func (s *Resignal) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	condition, ok := handlerCondition(ctx)
+	if !ok {
+		return nil, mysql.NewSQLError(1645, "0K000", "RESIGNAL when handler not active")
+	}
+
+	code, state, message := condition.Number(), condition.SQLState(), condition.Message
+	if s.SqlStateValue != "" {
+		state = s.SqlStateValue
+	}
+	if info, ok := s.Info[SignalConditionItemName_MysqlErrno]; ok {
+		code = int(info.IntValue)
+	}
+	if info, ok := s.Info[SignalConditionItemName_MessageText]; ok {
+		message = info.StrValue
+	}
+	return nil, mysql.NewSQLError(code, state, "%s", message)
+}
+
 func (s SignalInfo) String() string {
 	itemName := strings.ToUpper(string(s.ConditionItemName))
 	if s.ConditionItemName == SignalConditionItemName_MysqlErrno {
@@ -17,7 +17,6 @@ package plan
 import (
 	"fmt"
 	"io"
-	"sort"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -126,6 +125,12 @@ type sortIter struct {
 	childIter  sql.RowIter
 	sortedRows []sql.Row
 	idx        int
+	// runs are the sorted runs merged to produce the rows when the Sort spilled to disk, and heads holds the next row
+	// of each of them.
+	runs  []*sortRun
+	heads *expression.Sorter
+	// accounted is the number of bytes of the rows held in memory accounted to the memory account of the query.
+	accounted uint64
 }
 
 func newSortIter(ctx *sql.Context, s *Sort, child sql.RowIter) *sortIter {
@@ -146,6 +151,10 @@ func (i *sortIter) Next() (sql.Row, error) {
 		i.idx = 0
 	}
 
+	if i.heads != nil {
+		return i.nextMerged()
+	}
+
 	if i.idx >= len(i.sortedRows) {
 		return nil, io.EOF
 	}
@@ -156,13 +165,34 @@ func (i *sortIter) Next() (sql.Row, error) {
 
 func (i *sortIter) Close(ctx *sql.Context) error {
 	i.sortedRows = nil
-	return i.childIter.Close(ctx)
+	i.ctx.MemoryAccount().Shrink(i.accounted)
+	i.accounted = 0
+	err := i.closeRuns()
+	if cerr := i.childIter.Close(ctx); cerr != nil {
+		return cerr
+	}
+	return err
+}
+
+func (i *sortIter) closeRuns() error {
+	var err error
+	for _, run := range i.runs {
+		if cerr := run.close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	i.runs = nil
+	return err
 }
 
 func (i *sortIter) computeSortedRows() error {
+	spillSize := sortSpillSize(i.ctx)
 	cache, dispose := i.ctx.Memory.NewRowsCache()
-	defer dispose()
+	defer func() {
+		dispose()
+	}()
 
+	var size uint64
 	for {
 		row, err := i.childIter.Next()
 
@@ -176,19 +206,103 @@ func (i *sortIter) computeSortedRows() error {
 		if err := cache.Add(row); err != nil {
 			return err
 		}
+
+		rowSize := estimateRowSize(row)
+		if err := i.ctx.MemoryAccount().Grow(rowSize); err != nil {
+			return err
+		}
+		i.accounted += rowSize
+
+		size += rowSize
+		if spillSize == 0 || size <= spillSize {
+			continue
+		}
+
+		rows := cache.Get()
+		if err := expression.SortRows(i.ctx, i.s.SortFields, rows); err != nil {
+			return err
+		}
+
+		run, err := spillSortRun(rows)
+		if err != nil {
+			// Rows that can't be written to disk, e.g. because they hold values of types gob doesn't know about, are
+			// kept in memory along with the rest of them.
+			spillSize = 0
+			continue
+		}
+
+		i.runs = append(i.runs, run)
+		i.ctx.MemoryAccount().Shrink(size)
+		i.accounted -= size
+		dispose()
+		cache, dispose = i.ctx.Memory.NewRowsCache()
+		size = 0
 	}
 
 	rows := cache.Get()
-	sorter := &expression.Sorter{
+	if err := expression.SortRows(i.ctx, i.s.SortFields, rows); err != nil {
+		return err
+	}
+
+	if len(i.runs) == 0 {
+		i.sortedRows = rows
+		return nil
+	}
+
+	// Rows buffered after the last spill are merged as the last run, which keeps the sort stable since runs hold
+	// consecutive rows of the child
+	i.runs = append(i.runs, &sortRun{rows: rows})
+	i.heads = &expression.Sorter{
 		SortFields: i.s.SortFields,
-		Rows:       rows,
-		LastError:  nil,
+		Rows:       make([]sql.Row, len(i.runs)),
 		Ctx:        i.ctx,
 	}
-	sort.Stable(sorter)
-	if sorter.LastError != nil {
-		return sorter.LastError
+	for j := range i.runs {
+		if err := i.advanceRun(j); err != nil {
+			return err
+		}
 	}
-	i.sortedRows = rows
 	return nil
 }
+
+// advanceRun reads the next row of the run with the index given into its head.
+func (i *sortIter) advanceRun(j int) error {
+	row, err := i.runs[j].next()
+	if err == io.EOF {
+		i.heads.Rows[j] = nil
+		i.runs[j].done = true
+		return i.runs[j].close()
+	}
+	if err != nil {
+		return err
+	}
+
+	i.heads.Rows[j] = row
+	return nil
+}
+
+// nextMerged returns the smallest head of the sorted runs, preferring earlier runs when rows compare equal.
+func (i *sortIter) nextMerged() (sql.Row, error) {
+	smallest := -1
+	for j, run := range i.runs {
+		if run.done {
+			continue
+		}
+		if smallest == -1 || i.heads.Less(j, smallest) {
+			smallest = j
+		}
+	}
+
+	if i.heads.LastError != nil {
+		return nil, i.heads.LastError
+	}
+	if smallest == -1 {
+		return nil, io.EOF
+	}
+
+	row := i.heads.Rows[smallest]
+	if err := i.advanceRun(smallest); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
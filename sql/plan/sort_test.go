@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
@@ -294,3 +295,85 @@ func TestSortDescending(t *testing.T) {
 	require.NoError(err)
 	require.Equal(expected, actual)
 }
+
+func TestSortSpill(t *testing.T) {
+	schema := sql.Schema{
+		{Name: "col1", Type: sql.Int64, Nullable: true},
+		{Name: "col2", Type: sql.Text, Nullable: true},
+		{Name: "col3", Type: sql.Datetime, Nullable: true},
+	}
+
+	date := time.Date(2021, time.May, 2, 10, 30, 0, 0, time.UTC)
+	data := []sql.Row{
+		sql.NewRow(int64(3), "c", date),
+		sql.NewRow(int64(1), "a", nil),
+		sql.NewRow(nil, "d", date),
+		sql.NewRow(int64(2), nil, date),
+		sql.NewRow(int64(1), "b", date),
+		sql.NewRow(int64(2), "e", nil),
+	}
+
+	sf := []sql.SortField{
+		{Column: expression.NewGetField(0, sql.Int64, "col1", true), Order: sql.Ascending, NullOrdering: sql.NullsFirst},
+	}
+
+	expected := []sql.Row{
+		sql.NewRow(nil, "d", date),
+		sql.NewRow(int64(1), "a", nil),
+		sql.NewRow(int64(1), "b", date),
+		sql.NewRow(int64(2), nil, date),
+		sql.NewRow(int64(2), "e", nil),
+		sql.NewRow(int64(3), "c", date),
+	}
+
+	for _, spillSize := range []uint64{1, 200, 0} {
+		t.Run(fmt.Sprintf("spill size %d", spillSize), func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			require.NoError(ctx.SetSessionVariable(ctx, SortSpillSizeSessionVar, spillSize))
+
+			child := memory.NewTable("test", schema)
+			for _, row := range data {
+				require.NoError(child.Insert(sql.NewEmptyContext(), row))
+			}
+
+			actual, err := sql.NodeToRows(ctx, NewSort(sf, NewResolvedTable(child, nil, nil)))
+			require.NoError(err)
+			require.Equal(expected, actual)
+		})
+	}
+}
+
+func TestSortMemoryLimit(t *testing.T) {
+	require := require.New(t)
+
+	child := memory.NewTable("test", sql.Schema{
+		{Name: "col1", Type: sql.Int64, Nullable: true},
+		{Name: "col2", Type: sql.Text, Nullable: true},
+	})
+	for i := int64(5); i > 0; i-- {
+		require.NoError(child.Insert(sql.NewEmptyContext(), sql.NewRow(i, "a")))
+	}
+
+	sf := []sql.SortField{
+		{Column: expression.NewGetField(0, sql.Int64, "col1", true), Order: sql.Ascending},
+	}
+
+	ctx := sql.NewEmptyContext()
+	ctx.MemoryAccount().SetLimit(100)
+	require.NoError(ctx.SetSessionVariable(ctx, SortSpillSizeSessionVar, uint64(0)))
+
+	_, err := sql.NodeToRows(ctx, NewSort(sf, NewResolvedTable(child, nil, nil)))
+	require.Error(err)
+	require.True(sql.ErrOutOfMemory.Is(err))
+	require.Equal(uint64(0), ctx.MemoryAccount().Used())
+
+	// Spilling every row keeps the sort within the limit
+	require.NoError(ctx.SetSessionVariable(ctx, SortSpillSizeSessionVar, uint64(1)))
+
+	rows, err := sql.NodeToRows(ctx, NewSort(sf, NewResolvedTable(child, nil, nil)))
+	require.NoError(err)
+	require.Len(rows, 5)
+	require.Equal(int64(1), rows[0][0])
+	require.Equal(uint64(0), ctx.MemoryAccount().Used())
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"bufio"
+	"encoding/gob"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func init() {
+	// Row values are written to spill files as interfaces, so gob needs to know about every concrete type other than
+	// the basic ones it already handles.
+	gob.Register(time.Time{})
+	gob.Register(decimal.Decimal{})
+	gob.Register(sql.JSONDocument{})
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+}
+
+// spillSize returns the number of bytes held in memory before spilling to disk according to the session variable
+// given, or zero if spilling is disabled.
+func spillSize(ctx *sql.Context, sysVarName string) uint64 {
+	val, err := ctx.GetSessionVariable(ctx, sysVarName)
+	if err != nil {
+		return 0
+	}
+
+	size, _ := val.(uint64)
+	return size
+}
+
+// estimateRowSize returns a rough estimate of the memory used by a row, counting the contents of strings and byte
+// slices and a fixed size for any other value.
+func estimateRowSize(row sql.Row) uint64 {
+	size := uint64(24 + 16*len(row))
+	for _, v := range row {
+		switch v := v.(type) {
+		case string:
+			size += uint64(len(v))
+		case []byte:
+			size += uint64(len(v))
+		}
+	}
+	return size
+}
+
+// openSpillFiles is the number of spill files of all running queries that haven't been removed yet.
+var openSpillFiles int64
+
+// OpenSpillFiles returns the number of temporary files that the operators of the running queries have spilled rows to.
+func OpenSpillFiles() int64 {
+	return atomic.LoadInt64(&openSpillFiles)
+}
+
+// spillFile is a temporary file that rows are written to and then read back from in the same order.
+type spillFile struct {
+	file *os.File
+	w    *bufio.Writer
+	enc  *gob.Encoder
+	dec  *gob.Decoder
+}
+
+func newSpillFile() (*spillFile, error) {
+	f, err := ioutil.TempFile("", "gms-spill-")
+	if err != nil {
+		return nil, err
+	}
+
+	atomic.AddInt64(&openSpillFiles, 1)
+	w := bufio.NewWriter(f)
+	return &spillFile{file: f, w: w, enc: gob.NewEncoder(w)}, nil
+}
+
+// write appends a row to the file.
+func (f *spillFile) write(row sql.Row) error {
+	return f.enc.Encode([]interface{}(row))
+}
+
+// rewind finishes writing the file and prepares it to read its rows from the start.
+func (f *spillFile) rewind() error {
+	if err := f.w.Flush(); err != nil {
+		return err
+	}
+
+	if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	f.dec = gob.NewDecoder(bufio.NewReader(f.file))
+	return nil
+}
+
+// next returns the next row of the file, or io.EOF when there are none left.
+func (f *spillFile) next() (sql.Row, error) {
+	var row []interface{}
+	if err := f.dec.Decode(&row); err != nil {
+		return nil, err
+	}
+	return sql.NewRow(row...), nil
+}
+
+// close closes and removes the file.
+func (f *spillFile) close() error {
+	atomic.AddInt64(&openSpillFiles, -1)
+	err := f.file.Close()
+	if rerr := os.Remove(f.file.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// spillFileIter is a sql.RowIter over the rows of a spill file, which is removed when the iterator is closed.
+type spillFileIter struct {
+	f *spillFile
+}
+
+func (i *spillFileIter) Next() (sql.Row, error) {
+	return i.f.next()
+}
+
+func (i *spillFileIter) Close(*sql.Context) error {
+	return i.f.close()
+}
@@ -38,9 +38,15 @@ type Subquery struct {
 	cache []interface{}
 	// Cached hash results, if any
 	hashCache sql.KeyValueCache
+	// Whether the subquery returns any rows, if only that has been cached, for EXISTS
+	existsCached bool
+	hasResultRow bool
 	// Dispose function for the cache, if any. This would appear to violate the rule that nodes must be comparable by
 	// reflect.DeepEquals, but it's safe in practice because the function is always nil until execution.
 	disposeFunc sql.DisposeFunc
+	// Memory account the cached results are accounted to, and the number of bytes accounted, if any
+	memAccount *sql.MemoryAccount
+	accounted  uint64
 	// Mutex to guard the caches
 	cacheMu sync.Mutex
 }
@@ -205,7 +211,19 @@ func (s *Subquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 func prependRowInPlan(row sql.Row) func(n sql.Node) (sql.Node, error) {
 	return func(n sql.Node) (sql.Node, error) {
 		switch n := n.(type) {
-		case *Project, *GroupBy, *Having, *SubqueryAlias, *Window, sql.Table, *ValueDerivedTable, *Union:
+		case *Union:
+			// Unions are opaque, but their selects are analyzed with the scope of the subquery like the rest of it, so
+			// the row is prepended to the rows of each select rather than to the combined result.
+			left, err := TransformUp(n.Left(), prependRowInPlan(row))
+			if err != nil {
+				return nil, err
+			}
+			right, err := TransformUp(n.Right(), prependRowInPlan(row))
+			if err != nil {
+				return nil, err
+			}
+			return n.WithChildren(left, right)
+		case *Project, *GroupBy, *Having, *SubqueryAlias, *Window, sql.Table, *ValueDerivedTable, *JSONTable:
 			return &prependNode{
 				UnaryNode: UnaryNode{Child: n},
 				row:       row,
@@ -232,7 +250,7 @@ func (s *Subquery) EvalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, e
 
 	if s.canCacheResults {
 		s.cacheMu.Lock()
-		if s.resultsCached == false {
+		if s.resultsCached == false && s.accountCache(ctx, result) == nil {
 			s.cache, s.resultsCached = result, true
 		}
 		s.cacheMu.Unlock()
@@ -284,26 +302,39 @@ func (s *Subquery) evalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, e
 }
 
 // HashMultiple returns all rows returned by a subquery, backed by a sql.KeyValueCache. Keys are constructed using the
-// 64-bit hash of the values stored.
+// 64-bit hash of the values stored, which are converted to the promoted type of the subquery first, so that values of
+// the same type hash the same regardless of the expressions that produced them. Results already cached by Eval or
+// EvalMultiple are hashed, rather than computed again.
 func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCache, error) {
 	s.cacheMu.Lock()
-	cached := s.resultsCached && s.hashCache != nil
+	cached, hashCache, result := s.resultsCached, s.hashCache, s.cache
 	s.cacheMu.Unlock()
-	if cached {
-		return s.hashCache, nil
+	if hashCache != nil {
+		return hashCache, nil
 	}
 
-	result, err := s.evalMultiple(ctx, row)
-	if err != nil {
-		return nil, err
+	if !cached {
+		var err error
+		result, err = s.evalMultiple(ctx, row)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if s.canCacheResults {
 		s.cacheMu.Lock()
 		defer s.cacheMu.Unlock()
-		if !s.resultsCached || s.hashCache == nil {
+		if s.hashCache == nil {
+			if s.resultsCached {
+				result = s.cache
+			} else if err := s.accountCache(ctx, result); err != nil {
+				// Results that don't fit in the memory limits aren't cached, and are computed again for every row
+				cache := sql.NewMapCache()
+				return cache, putAllRows(cache, s.Type().Promote(), result)
+			}
+
 			hashCache, disposeFn := ctx.Memory.NewHistoryCache()
-			err = putAllRows(hashCache, result)
+			err := putAllRows(hashCache, s.Type().Promote(), result)
 			if err != nil {
 				return nil, err
 			}
@@ -313,19 +344,36 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 	}
 
 	cache := sql.NewMapCache()
-	return cache, putAllRows(cache, result)
+	return cache, putAllRows(cache, s.Type().Promote(), result)
 }
 
-// HasResultRow returns whether the subquery has a result set > 0.
+// accountCache accounts the results given, which are about to be cached, to the memory account of the query. Results
+// that would go over the memory limits must not be cached. It must be called with cacheMu held.
+func (s *Subquery) accountCache(ctx *sql.Context, result []interface{}) error {
+	size := estimateRowSize(result)
+	if err := ctx.MemoryAccount().Grow(size); err != nil {
+		return err
+	}
+
+	s.memAccount = ctx.MemoryAccount()
+	s.accounted += size
+	return nil
+}
+
+// HasResultRow returns whether the subquery has a result set > 0. Only the first row is computed, and whether there
+// is one is cached along with any other results of the subquery.
 func (s *Subquery) HasResultRow(ctx *sql.Context, row sql.Row) (bool, error) {
 	// First check if the query was cached.
 	s.cacheMu.Lock()
-	cached := s.resultsCached
+	cached, existsCached, hasResultRow := s.resultsCached, s.existsCached, s.hasResultRow
 	s.cacheMu.Unlock()
 
 	if cached {
 		return len(s.cache) > 0, nil
 	}
+	if existsCached {
+		return hasResultRow, nil
+	}
 
 	// Any source of rows, as well as any node that alters the schema of its children, needs to be wrapped so that its
 	// result rows are prepended with the scope row.
@@ -341,24 +389,32 @@ func (s *Subquery) HasResultRow(ctx *sql.Context, row sql.Row) (bool, error) {
 
 	// Call the iterator once and see if it has a row. If io.EOF is received return false.
 	_, err = iter.Next()
-	if err == io.EOF {
-		return false, nil
-	}
-
-	if err != nil {
+	if err != nil && err != io.EOF {
+		iter.Close(ctx)
 		return false, err
 	}
+	hasResultRow = err == nil
 
 	err = iter.Close(ctx)
 	if err != nil {
 		return false, err
 	}
 
-	return true, nil
+	if s.canCacheResults {
+		s.cacheMu.Lock()
+		s.existsCached, s.hasResultRow = true, hasResultRow
+		s.cacheMu.Unlock()
+	}
+
+	return hasResultRow, nil
 }
 
-func putAllRows(cache sql.KeyValueCache, vals []interface{}) error {
+func putAllRows(cache sql.KeyValueCache, typ sql.Type, vals []interface{}) error {
 	for _, val := range vals {
+		val, err := typ.Convert(val)
+		if err != nil {
+			return err
+		}
 		rowKey, err := sql.HashOf(sql.NewRow(val))
 		if err != nil {
 			return err
@@ -439,5 +495,9 @@ func (s *Subquery) Dispose() {
 		s.disposeFunc()
 		s.disposeFunc = nil
 	}
+	if s.memAccount != nil {
+		s.memAccount.Shrink(s.accounted)
+		s.memAccount, s.accounted = nil, 0
+	}
 	disposeNode(s.Query)
 }
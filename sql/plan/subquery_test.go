@@ -82,3 +82,69 @@ func TestSubqueryMultipleRows(t *testing.T) {
 	require.NoError(err)
 	require.Equal(values, []interface{}{"one", "two", "three"})
 }
+
+func TestSubqueryCachedResults(t *testing.T) {
+	require := require.New(t)
+
+	ctx := sql.NewEmptyContext()
+	table := memory.NewTable("foo", sql.Schema{
+		{Name: "i", Source: "foo", Type: sql.Int64},
+	})
+	require.NoError(table.Insert(ctx, sql.Row{int64(1)}))
+
+	query := plan.NewProject(
+		[]sql.Expression{
+			expression.NewGetField(0, sql.Int64, "i", false),
+		},
+		plan.NewResolvedTable(table, nil, nil),
+	)
+
+	// The results of cacheable subqueries are computed once, and later rows added to the table aren't seen
+	cached := plan.NewSubquery(query, "select i from foo").WithCachedResults()
+	values, err := cached.EvalMultiple(ctx, nil)
+	require.NoError(err)
+	require.Equal([]interface{}{int64(1)}, values)
+
+	uncached := plan.NewSubquery(query, "select i from foo")
+	value, err := uncached.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(int64(1), value)
+
+	require.NoError(table.Insert(ctx, sql.Row{int64(2)}))
+
+	hashed, err := cached.HashMultiple(ctx, nil)
+	require.NoError(err)
+	require.Equal(1, hashed.Size())
+
+	hashed, err = uncached.HashMultiple(ctx, nil)
+	require.NoError(err)
+	require.Equal(2, hashed.Size())
+
+	empty := memory.NewTable("bar", sql.Schema{
+		{Name: "i", Source: "bar", Type: sql.Int64},
+	})
+	emptyQuery := plan.NewProject(
+		[]sql.Expression{
+			expression.NewGetField(0, sql.Int64, "i", false),
+		},
+		plan.NewResolvedTable(empty, nil, nil),
+	)
+
+	cached = plan.NewSubquery(emptyQuery, "select i from bar").WithCachedResults()
+	uncached = plan.NewSubquery(emptyQuery, "select i from bar")
+	for _, s := range []*plan.Subquery{cached, uncached} {
+		hasRow, err := s.HasResultRow(ctx, nil)
+		require.NoError(err)
+		require.False(hasRow)
+	}
+
+	require.NoError(empty.Insert(ctx, sql.Row{int64(1)}))
+
+	hasRow, err := cached.HasResultRow(ctx, nil)
+	require.NoError(err)
+	require.False(hasRow)
+
+	hasRow, err = uncached.HasResultRow(ctx, nil)
+	require.NoError(err)
+	require.True(hasRow)
+}
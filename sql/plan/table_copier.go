@@ -2,8 +2,10 @@ package plan
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // TableCopier is a supporting node that allows for the optimization of copying tables. It should be used in two cases.
@@ -45,6 +47,16 @@ func (tc *TableCopier) Database() sql.Database {
 	return tc.db
 }
 
+// CreatedTable returns the table created by the copier if it copies the rows of a CREATE TABLE ... SELECT statement,
+// once the statement has run, and whether it does.
+func (tc *TableCopier) CreatedTable(ctx *sql.Context) (sql.Table, bool, error) {
+	ct, ok := tc.destination.(*CreateTable)
+	if !ok {
+		return nil, false, nil
+	}
+	return ct.getCreatedTable(ctx)
+}
+
 func (tc *TableCopier) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	if _, ok := tc.destination.(*CreateTable); ok {
 		return tc.processCreateTable(ctx, row)
@@ -61,12 +73,24 @@ func (tc *TableCopier) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, erro
 func (tc *TableCopier) processCreateTable(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	ct := tc.destination.(*CreateTable)
 
+	// CREATE TABLE IF NOT EXISTS ... SELECT doesn't insert any row into a table that already exists
+	if ct.IfNotExists() == IfNotExists {
+		_, exists, err := ct.getCreatedTable(ctx)
+		if err != nil {
+			return sql.RowsToRowIter(), err
+		}
+		if exists {
+			ctx.Warn(1050, "Table '%s' already exists", ct.Name())
+			return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+		}
+	}
+
 	_, err := ct.RowIter(ctx, row)
 	if err != nil {
 		return sql.RowsToRowIter(), err
 	}
 
-	table, tableExists, err := tc.db.GetTableInsensitive(ctx, ct.Name())
+	table, tableExists, err := ct.getCreatedTable(ctx)
 	if err != nil {
 		return sql.RowsToRowIter(), err
 	}
@@ -75,8 +99,12 @@ func (tc *TableCopier) processCreateTable(ctx *sql.Context, row sql.Row) (sql.Ro
 		return sql.RowsToRowIter(), fmt.Errorf("error: Newly created table does not exist")
 	}
 
-	if tc.createTableSelectCanBeCopied(table) {
-		return tc.copyTableOver(ctx, tc.source.Schema()[0].Source, table.Name())
+	// Temporary tables are filled by inserting rows, since copying table data is done by table name and their names may
+	// be shadowing persistent tables. So are tables created while the binlog collects events, since the rows of the
+	// table are written to it as inserted rows.
+	copyRows := ct.Temporary() != IsTempTable && (ctx.Binlog == nil || !ctx.Binlog.HasSinks())
+	if source, ok := tc.createTableSelectCanBeCopied(table); ok && copyRows {
+		return tc.copyTableOver(ctx, source.Name(), table.Name())
 	}
 
 	// TODO: Improve parsing for CREATE TABLE SELECT to allow for IGNORE/REPLACE and custom specs
@@ -88,33 +116,53 @@ func (tc *TableCopier) processCreateTable(ctx *sql.Context, row sql.Row) (sql.Ro
 	return roa.RowIter(ctx, row)
 }
 
-// createTableSelectCanBeCopied determines whether the newly created table's data can just be copied from the source table
-func (tc *TableCopier) createTableSelectCanBeCopied(tableNode sql.Table) bool {
+// createTableSelectCanBeCopied determines whether the newly created table's data can just be copied from the source
+// table, which is only the case when the select reads every row and column of a table of the same database, in order.
+// It returns the source table if so.
+func (tc *TableCopier) createTableSelectCanBeCopied(tableNode sql.Table) (*ResolvedTable, bool) {
 	// The differences in LIMIT between integrators prevent us from using a copy
 	if _, ok := tc.source.(*Limit); ok {
-		return false
+		return nil, false
 	}
 
 	// If the DB does not implement the TableCopierDatabase interface we cannot copy over the table.
 	if _, ok := tc.db.(sql.TableCopierDatabase); !ok {
-		return false
+		return nil, false
+	}
+
+	// Filters, joins and computed columns change the rows to insert, so only projections of the columns of a table
+	// can be copied.
+	source := tc.source
+	if p, ok := source.(*Project); ok {
+		for i, e := range p.Projections {
+			gf, ok := e.(*expression.GetField)
+			if !ok || gf.Index() != i {
+				return nil, false
+			}
+		}
+		source = p.Child
+	}
+
+	rt, ok := source.(*ResolvedTable)
+	if !ok || rt.Database == nil || !strings.EqualFold(rt.Database.Name(), tc.db.Name()) {
+		return nil, false
 	}
 
 	// If there isn't a match in schema we cannot do a direct copy.
 	sourceSchema := tc.source.Schema()
 	tableNodeSchema := tableNode.Schema()
 
-	if len(sourceSchema) != len(tableNodeSchema) {
-		return false
+	if len(sourceSchema) != len(tableNodeSchema) || len(sourceSchema) != len(rt.Schema()) {
+		return nil, false
 	}
 
 	for i, sn := range sourceSchema {
 		if sn.Name != tableNodeSchema[i].Name {
-			return false
+			return nil, false
 		}
 	}
 
-	return true
+	return rt, true
 }
 
 // copyTableOver is used when we can guarantee the destination table will have the same data as the source table.
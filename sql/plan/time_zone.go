@@ -0,0 +1,105 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Tables store TIMESTAMP values in UTC, while queries see them as wall clock times in the time zone of the session,
+// like all the other datetime values. The rows of tables are converted to the time zone of the session as they're
+// read by the nodes reading them, so that every expression, filter and result sees them that way, and converted back
+// to UTC as they're written to their tables and to the binlog.
+
+// timestampColumns returns the indexes of the TIMESTAMP columns of the schema given.
+func timestampColumns(schema sql.Schema) []int {
+	var columns []int
+	for i, col := range schema {
+		if col.Type == sql.Timestamp {
+			columns = append(columns, i)
+		}
+	}
+	return columns
+}
+
+// timestampsToSession returns the rows of the iterator given, read from a table with the schema given, with their
+// TIMESTAMP values as wall clock times in the time zone of the session.
+func timestampsToSession(ctx *sql.Context, schema sql.Schema, iter sql.RowIter) sql.RowIter {
+	columns := timestampColumns(schema)
+	if len(columns) == 0 {
+		return iter
+	}
+	return &timeZoneIter{childIter: iter, columns: columns, loc: sql.SessionTimeZone(ctx)}
+}
+
+// timeZoneIter converts the TIMESTAMP values of the rows of a table from UTC to a time zone.
+type timeZoneIter struct {
+	childIter sql.RowIter
+	columns   []int
+	loc       *time.Location
+}
+
+func (t *timeZoneIter) Next() (sql.Row, error) {
+	row, err := t.childIter.Next()
+	if err != nil {
+		return nil, err
+	}
+	return convertTimestamps(row, t.columns, func(ts time.Time) time.Time {
+		return sql.TimestampToTimeZone(ts, t.loc)
+	}), nil
+}
+
+func (t *timeZoneIter) Close(ctx *sql.Context) error {
+	return t.childIter.Close(ctx)
+}
+
+// rowToSession returns the row given, read from a table with the schema given, with its TIMESTAMP values as wall
+// clock times in the time zone of the session.
+func rowToSession(ctx *sql.Context, schema sql.Schema, row sql.Row) sql.Row {
+	loc := sql.SessionTimeZone(ctx)
+	return convertTimestamps(row, timestampColumns(schema), func(ts time.Time) time.Time {
+		return sql.TimestampToTimeZone(ts, loc)
+	})
+}
+
+// rowToStored returns the row given, to be written to a table with the schema given, with its TIMESTAMP values in
+// UTC.
+func rowToStored(ctx *sql.Context, schema sql.Schema, row sql.Row) sql.Row {
+	loc := sql.SessionTimeZone(ctx)
+	return convertTimestamps(row, timestampColumns(schema), func(ts time.Time) time.Time {
+		return sql.TimestampFromTimeZone(ts, loc)
+	})
+}
+
+// convertTimestamps returns the row given with the conversion given applied to the time values of the columns given.
+// The row is copied rather than modified, since it can be the one stored by a table.
+func convertTimestamps(row sql.Row, columns []int, convert func(time.Time) time.Time) sql.Row {
+	copied := false
+	for _, i := range columns {
+		if i >= len(row) {
+			continue
+		}
+		if ts, ok := row[i].(time.Time); ok {
+			if !copied {
+				row = row.Copy()
+				copied = true
+			}
+			row[i] = convert(ts)
+		}
+	}
+	return row
+}
@@ -64,37 +64,57 @@ var _ sql.RowIter = (*triggerBlockIter)(nil)
 
 // Next implements the sql.RowIter interface.
 func (i *triggerBlockIter) Next() (sql.Row, error) {
-	run := false
+	first := false
 	i.once.Do(func() {
-		run = true
+		first = true
 	})
 
-	if !run {
+	if !first {
 		return nil, io.EOF
 	}
 
 	row := i.row
-	for _, s := range i.statements {
-		subIter, err := s.RowIter(i.ctx, row)
+	run := func(ctx *sql.Context, s sql.Node) error {
+		subIter, err := s.RowIter(ctx, row)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		for {
 			newRow, err := subIter.Next()
 			if err == io.EOF {
-				err := subIter.Close(i.ctx)
-				if err != nil {
-					return nil, err
-				}
-				break
+				return subIter.Close(ctx)
 			} else if err != nil {
-				return nil, err
+				return err
 			}
 			row = newRow[len(newRow)/2:]
 		}
 	}
 
+	var handlers []*DeclareHandler
+	for _, s := range i.statements {
+		if handler, ok := s.(*DeclareHandler); ok {
+			handlers = append(handlers, handler)
+			continue
+		}
+
+		err := run(i.ctx, s)
+		if err == nil {
+			continue
+		}
+
+		handler, condition := findHandler(handlers, err)
+		if handler == nil {
+			return nil, err
+		}
+		if err := run(withHandlerCondition(i.ctx, condition), handler.Statement); err != nil {
+			return nil, err
+		}
+		if handler.Action == DeclareHandlerAction_Exit {
+			break
+		}
+	}
+
 	return row, nil
 }
 
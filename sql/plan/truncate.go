@@ -88,6 +88,11 @@ func (p *Truncate) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
 	if err != nil {
 		return nil, err
 	}
+	if ctx.Statistics != nil && removed > 0 {
+		if err := ctx.Statistics.RecordChanges(ctx, p.db, truncatable, uint64(removed)); err != nil {
+			return nil, err
+		}
+	}
 	for _, col := range truncatable.Schema() {
 		if col.AutoIncrement {
 			aiTable, ok := truncatable.(sql.AutoIncrementTable)
@@ -20,9 +20,40 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
-// Union is a node that returns everything in Left and then everything in Right
+// SetOpType is the set operation a Union node uses to combine the rows of its children.
+type SetOpType int
+
+const (
+	// SetOp_Union returns all the rows of the left side and then all the rows of the right side, like UNION ALL.
+	// UNION DISTINCT is a Distinct node over such a Union.
+	SetOp_Union SetOpType = iota
+	// SetOp_Intersect returns the rows of the left side that are also in the right side, like INTERSECT.
+	SetOp_Intersect
+	// SetOp_Except returns the rows of the left side that aren't in the right side, like EXCEPT.
+	SetOp_Except
+)
+
+func (op SetOpType) String() string {
+	switch op {
+	case SetOp_Intersect:
+		return "Intersect"
+	case SetOp_Except:
+		return "Except"
+	default:
+		return "Union"
+	}
+}
+
+// Union is a node that returns everything in Left and then everything in Right. It also implements the other set
+// operations, INTERSECT and EXCEPT, which share the resolution of their two sides in isolation and the merging of
+// their schemas.
 type Union struct {
 	BinaryNode
+	Op SetOpType
+	// Distinct is whether an INTERSECT or EXCEPT returns every row at most once. Unlike for UNION, this can't be done
+	// with a Distinct node above, since duplicate rows of the left side of an EXCEPT are all removed by a single row
+	// of the right side.
+	Distinct bool
 }
 
 // NewUnion creates a new Union node with the given children.
@@ -32,6 +63,16 @@ func NewUnion(left, right sql.Node) *Union {
 	}
 }
 
+// NewSetOp creates a new Union node for the set operation given, which returns every row at most once if distinct is
+// true. UNION DISTINCT should be a Distinct node over a UNION ALL instead.
+func NewSetOp(op SetOpType, distinct bool, left, right sql.Node) *Union {
+	return &Union{
+		BinaryNode: BinaryNode{left: left, right: right},
+		Op:         op,
+		Distinct:   distinct,
+	}
+}
+
 func (u *Union) Schema() sql.Schema {
 	ls := u.left.Schema()
 	rs := u.right.Schema()
@@ -55,6 +96,15 @@ func (u *Union) Opaque() bool {
 // RowIter implements the Node interface.
 func (u *Union) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.Union")
+	if u.Op != SetOp_Union {
+		iter, err := newSetOpIter(ctx, u, row)
+		if err != nil {
+			span.Finish()
+			return nil, err
+		}
+		return sql.NewSpanIter(span, iter), nil
+	}
+
 	li, err := u.left.RowIter(ctx, row)
 	if err != nil {
 		span.Finish()
@@ -75,19 +125,26 @@ func (u *Union) WithChildren(children ...sql.Node) (sql.Node, error) {
 	if len(children) != 2 {
 		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 2)
 	}
-	return NewUnion(children[0], children[1]), nil
+	return NewSetOp(u.Op, u.Distinct, children[0], children[1]), nil
+}
+
+func (u Union) name() string {
+	if u.Distinct {
+		return u.Op.String() + " distinct"
+	}
+	return u.Op.String()
 }
 
 func (u Union) String() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("Union")
+	_ = pr.WriteNode(u.name())
 	_ = pr.WriteChildren(u.left.String(), u.right.String())
 	return pr.String()
 }
 
 func (u Union) DebugString() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("Union")
+	_ = pr.WriteNode(u.name())
 	_ = pr.WriteChildren(sql.DebugString(u.left), sql.DebugString(u.right))
 	return pr.String()
 }
@@ -125,3 +182,93 @@ func (ui *unionIter) Close(ctx *sql.Context) error {
 		return nil
 	}
 }
+
+// setOpIter returns the rows of the left side of an INTERSECT or EXCEPT that are, or aren't, in its right side. The
+// right side is read first to count how many times each row appears in it. Without DISTINCT, every row of the right
+// side matches a single row of the left side, so duplicates are kept as many times as MySQL does.
+type setOpIter struct {
+	op       SetOpType
+	distinct bool
+	left     sql.RowIter
+	counts   map[uint64]int
+	emitted  map[uint64]struct{}
+}
+
+func newSetOpIter(ctx *sql.Context, u *Union, row sql.Row) (*setOpIter, error) {
+	ri, err := u.right.RowIter(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint64]int)
+	for {
+		r, err := ri.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = ri.Close(ctx)
+			return nil, err
+		}
+
+		hash, err := sql.HashOf(r)
+		if err != nil {
+			_ = ri.Close(ctx)
+			return nil, err
+		}
+		counts[hash]++
+	}
+	if err := ri.Close(ctx); err != nil {
+		return nil, err
+	}
+
+	li, err := u.left.RowIter(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	return &setOpIter{
+		op:       u.Op,
+		distinct: u.Distinct,
+		left:     li,
+		counts:   counts,
+		emitted:  make(map[uint64]struct{}),
+	}, nil
+}
+
+func (si *setOpIter) Next() (sql.Row, error) {
+	for {
+		row, err := si.left.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		hash, err := sql.HashOf(row)
+		if err != nil {
+			return nil, err
+		}
+
+		if si.distinct {
+			if _, ok := si.emitted[hash]; ok {
+				continue
+			}
+		}
+
+		inRight := si.counts[hash] > 0
+		if inRight && !si.distinct {
+			si.counts[hash]--
+		}
+		if inRight != (si.op == SetOp_Intersect) {
+			continue
+		}
+
+		if si.distinct {
+			si.emitted[hash] = struct{}{}
+		}
+		return row, nil
+	}
+}
+
+func (si *setOpIter) Close(ctx *sql.Context) error {
+	return si.left.Close(ctx)
+}
@@ -102,3 +102,43 @@ func TestUnion(t *testing.T) {
 		require.Equal(c.expected, results)
 	}
 }
+
+func TestSetOps(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	schema := sql.Schema{{Name: "i", Type: sql.Int64, Nullable: true, Source: "t"}}
+
+	newTable := func(values ...interface{}) sql.Node {
+		table := memory.NewTable("t", schema)
+		for _, v := range values {
+			require.NoError(t, table.Insert(ctx, sql.NewRow(v)))
+		}
+		return NewResolvedTable(table, nil, nil)
+	}
+
+	left := []interface{}{int64(1), int64(1), int64(1), int64(2), int64(3), nil}
+	right := []interface{}{int64(1), int64(1), int64(2), int64(4), nil}
+
+	testCases := []struct {
+		name     string
+		op       SetOpType
+		distinct bool
+		expected []sql.Row
+	}{
+		{"intersect", SetOp_Intersect, true, []sql.Row{{int64(1)}, {int64(2)}, {nil}}},
+		{"intersect all", SetOp_Intersect, false, []sql.Row{{int64(1)}, {int64(1)}, {int64(2)}, {nil}}},
+		{"except", SetOp_Except, true, []sql.Row{{int64(3)}}},
+		{"except all", SetOp_Except, false, []sql.Row{{int64(1)}, {int64(3)}}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			node := NewSetOp(tt.op, tt.distinct, newTable(left...), newTable(right...))
+			iter, err := node.RowIter(ctx, nil)
+			require.NoError(t, err)
+
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+}
@@ -171,7 +171,7 @@ func (u *updateIter) Next() (sql.Row, error) {
 				}
 			}
 
-			err = u.updater.Update(u.ctx, oldRow, newRow)
+			err = u.updater.Update(u.ctx, rowToStored(u.ctx, u.schema, oldRow), rowToStored(u.ctx, u.schema, newRow))
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,228 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// PrunedTable is a table that skips the partitions of a sql.ZoneMapTable whose statistics show that none of their rows
+// can match some predicates. Rows of the partitions that are scanned still need to be filtered.
+type PrunedTable struct {
+	sql.ZoneMapTable
+	// Predicates must all hold for a row to match. Each of them is a comparison of a column of the table against a
+	// literal, a BETWEEN or IN expression over a column and literals, or an IS NULL or IS NOT NULL check of a column.
+	Predicates []sql.Expression
+}
+
+var _ sql.TableWrapper = (*PrunedTable)(nil)
+
+// NewPrunedTable returns a new PrunedTable that scans the partitions of the table given that could match the
+// predicates given.
+func NewPrunedTable(table sql.ZoneMapTable, predicates []sql.Expression) *PrunedTable {
+	return &PrunedTable{ZoneMapTable: table, Predicates: predicates}
+}
+
+// Underlying implements sql.TableWrapper interface.
+func (t *PrunedTable) Underlying() sql.Table {
+	return t.ZoneMapTable
+}
+
+// Partitions implements the sql.Table interface.
+func (t *PrunedTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	iter, err := t.ZoneMapTable.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &prunedPartitionIter{ctx: ctx, table: t, iter: iter}, nil
+}
+
+type prunedPartitionIter struct {
+	ctx   *sql.Context
+	table *PrunedTable
+	iter  sql.PartitionIter
+}
+
+func (i *prunedPartitionIter) Next() (sql.Partition, error) {
+	for {
+		p, err := i.iter.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		stats, err := i.table.PartitionStatistics(i.ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		if partitionCanMatch(stats, i.table.Predicates) {
+			return p, nil
+		}
+	}
+}
+
+func (i *prunedPartitionIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
+}
+
+// IsPrunablePredicate returns whether the expression given can be used by a PrunedTable to skip partitions.
+func IsPrunablePredicate(e sql.Expression) bool {
+	switch e := e.(type) {
+	case *expression.IsNull:
+		return isField(e.Child)
+	case *expression.Not:
+		isNull, ok := e.Child.(*expression.IsNull)
+		return ok && isField(isNull.Child)
+	case *expression.Between:
+		return isField(e.Val) && isLiteral(e.Lower) && isLiteral(e.Upper)
+	case *expression.InTuple:
+		tuple, ok := e.Right().(expression.Tuple)
+		if !ok || !isField(e.Left()) {
+			return false
+		}
+		for _, el := range tuple {
+			if !isLiteral(el) {
+				return false
+			}
+		}
+		return true
+	case *expression.Equals, *expression.LessThan, *expression.LessThanOrEqual,
+		*expression.GreaterThan, *expression.GreaterThanOrEqual:
+		c := e.(expression.Comparer)
+		return (isField(c.Left()) && isLiteral(c.Right())) || (isLiteral(c.Left()) && isField(c.Right()))
+	default:
+		return false
+	}
+}
+
+func isField(e sql.Expression) bool {
+	_, ok := e.(*expression.GetField)
+	return ok
+}
+
+func isLiteral(e sql.Expression) bool {
+	_, ok := e.(*expression.Literal)
+	return ok
+}
+
+// partitionCanMatch returns whether any of the rows summarized by the statistics given could match all the
+// predicates given.
+func partitionCanMatch(stats sql.PartitionStatistics, predicates []sql.Expression) bool {
+	for _, p := range predicates {
+		if !predicateCanMatch(stats, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// predicateCanMatch returns whether any of the rows summarized by the statistics given could match the predicate
+// given. It errs on the side of returning true when the statistics can't tell.
+func predicateCanMatch(stats sql.PartitionStatistics, e sql.Expression) bool {
+	switch e := e.(type) {
+	case *expression.IsNull:
+		col, ok := columnStatistics(stats, e.Child)
+		return !ok || col.NullCount > 0
+	case *expression.Not:
+		col, ok := columnStatistics(stats, e.Child.(*expression.IsNull).Child)
+		return !ok || col.NullCount < stats.RowCount
+	case *expression.Between:
+		col, ok := columnStatistics(stats, e.Val)
+		if !ok {
+			return true
+		}
+		typ := e.Val.Type()
+		return valueInRange(typ, col, e.Lower, false) && valueInRange(typ, col, e.Upper, true)
+	case *expression.InTuple:
+		col, ok := columnStatistics(stats, e.Left())
+		if !ok {
+			return true
+		}
+		for _, el := range e.Right().(expression.Tuple) {
+			if valueInRange(e.Left().Type(), col, el, true) && valueInRange(e.Left().Type(), col, el, false) {
+				return true
+			}
+		}
+		return false
+	}
+
+	c := e.(expression.Comparer)
+	field, value, flipped := c.Left(), c.Right(), false
+	if isLiteral(field) {
+		field, value, flipped = value, field, true
+	}
+
+	col, ok := columnStatistics(stats, field)
+	if !ok {
+		return true
+	}
+
+	typ := field.Type()
+	switch e.(type) {
+	case *expression.Equals:
+		return valueInRange(typ, col, value, true) && valueInRange(typ, col, value, false)
+	case *expression.LessThan, *expression.LessThanOrEqual:
+		// column < value needs a min below the value, value < column a max above it
+		_, strict := e.(*expression.LessThan)
+		return compareBound(typ, col, value, !flipped, strict)
+	default:
+		_, strict := e.(*expression.GreaterThan)
+		return compareBound(typ, col, value, flipped, strict)
+	}
+}
+
+// columnStatistics returns the statistics of the column the field given reads.
+func columnStatistics(stats sql.PartitionStatistics, field sql.Expression) (sql.ColumnStatistics, bool) {
+	col, ok := stats.Columns[strings.ToLower(field.(*expression.GetField).Name())]
+	return col, ok
+}
+
+// valueInRange returns whether the min of the column is not above the literal given, if min is true, or whether its max
+// is not below it otherwise.
+func valueInRange(typ sql.Type, col sql.ColumnStatistics, value sql.Expression, min bool) bool {
+	return compareBound(typ, col, value, min, false)
+}
+
+// compareBound returns whether the column can hold a value below the literal given, if below is true, or above it
+// otherwise. The value itself counts unless strict is true.
+func compareBound(typ sql.Type, col sql.ColumnStatistics, value sql.Expression, below, strict bool) bool {
+	v, err := value.Eval(nil, nil)
+	if err != nil {
+		return true
+	}
+
+	// Comparisons with NULL, and comparisons with a column that only holds NULLs, never match
+	if v == nil || col.Min == nil || col.Max == nil {
+		return false
+	}
+
+	bound := col.Max
+	if below {
+		bound = col.Min
+	}
+
+	cmp, err := typ.Compare(bound, v)
+	if err != nil {
+		return true
+	}
+
+	if below {
+		return cmp < 0 || (!strict && cmp == 0)
+	}
+	return cmp > 0 || (!strict && cmp == 0)
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestPrunedTable(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewZoneMapTable("t", sql.Schema{
+		{Name: "a", Source: "t", Type: sql.Int64},
+		{Name: "b", Source: "t", Type: sql.Text, Nullable: true},
+	}, 2)
+	for _, row := range []sql.Row{
+		sql.NewRow(int64(1), "a"),
+		sql.NewRow(int64(10), nil),
+		sql.NewRow(int64(2), "b"),
+		sql.NewRow(int64(20), nil),
+	} {
+		require.NoError(t, table.Insert(ctx, row))
+	}
+
+	a := expression.NewGetFieldWithTable(0, sql.Int64, "t", "a", false)
+	b := expression.NewGetFieldWithTable(1, sql.Text, "t", "b", true)
+	lit := func(v interface{}) sql.Expression {
+		return expression.NewLiteral(v, sql.Int64)
+	}
+
+	testCases := []struct {
+		name       string
+		predicate  sql.Expression
+		partitions int
+	}{
+		{"equals", expression.NewEquals(a, lit(int64(2))), 1},
+		{"greater than", expression.NewGreaterThan(a, lit(int64(5))), 1},
+		{"literal on the left", expression.NewLessThan(lit(int64(5)), a), 1},
+		{"less than or equal", expression.NewLessThanOrEqual(a, lit(int64(10))), 2},
+		{"less than", expression.NewLessThan(a, lit(int64(1))), 0},
+		{"between", expression.NewBetween(a, lit(int64(3)), lit(int64(9))), 0},
+		{"in", expression.NewInTuple(a, expression.NewTuple(lit(int64(1)), lit(int64(20)))), 2},
+		{"is null", expression.NewIsNull(b), 1},
+		{"is not null", expression.NewNot(expression.NewIsNull(b)), 1},
+		{"equals null", expression.NewEquals(a, lit(nil)), 0},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.True(IsPrunablePredicate(tt.predicate))
+
+			pruned := NewPrunedTable(table, []sql.Expression{tt.predicate})
+			iter, err := pruned.Partitions(ctx)
+			require.NoError(err)
+
+			var partitions int
+			for {
+				_, err := iter.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(err)
+				partitions++
+			}
+			require.NoError(iter.Close(ctx))
+			require.Equal(tt.partitions, partitions)
+
+			// Pruning never changes the rows that pass the filter
+			expected, err := sql.NodeToRows(ctx, NewFilter(tt.predicate, NewResolvedTable(table, nil, nil)))
+			require.NoError(err)
+			actual, err := sql.NodeToRows(ctx, NewFilter(tt.predicate, NewResolvedTable(pruned, nil, nil)))
+			require.NoError(err)
+			require.ElementsMatch(expected, actual)
+		})
+	}
+
+	require.False(t, IsPrunablePredicate(expression.NewEquals(a, b)))
+}
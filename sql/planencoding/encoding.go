@@ -0,0 +1,92 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package planencoding encodes analyzed plans into a versioned format that can be sent to another process, and decodes
+// them there into plans that run against the tables of that process. Tables are encoded by database and table name,
+// and functions by name, so the process decoding a plan must have the same databases, tables and functions as the one
+// that encoded it, with the same schemas.
+//
+// Only the nodes and expressions that a plan fragment reading tables is made of can be encoded: tables, aliases,
+// filters, projections, sorts, limits, aggregations, joins and unions, along with field, literal, comparison,
+// arithmetic, logic and function expressions. Encoding any other node or expression fails.
+package planencoding
+
+import (
+	"encoding/json"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Version is the version of the format written by Encode. Decode only reads plans encoded with this version.
+const Version = 1
+
+var (
+	// ErrUnsupportedNode is returned when encoding a plan with a node that can't be encoded.
+	ErrUnsupportedNode = errors.NewKind("plan node %T can't be encoded")
+
+	// ErrUnsupportedExpression is returned when encoding a plan with an expression that can't be encoded.
+	ErrUnsupportedExpression = errors.NewKind("expression %T can't be encoded")
+
+	// ErrUnsupportedVersion is returned when decoding a plan encoded with a different version of the format.
+	ErrUnsupportedVersion = errors.NewKind("plan encoded with version %d can't be decoded, only version %d is supported")
+
+	// ErrInvalidPlan is returned when decoding data that isn't a valid encoded plan.
+	ErrInvalidPlan = errors.NewKind("invalid encoded plan: %s")
+)
+
+// encodedPlan is the top level of an encoded plan.
+type encodedPlan struct {
+	Version int          `json:"version"`
+	Node    *encodedNode `json:"node"`
+}
+
+// Encode returns the encoded form of the analyzed plan given. The nodes that only track the progress of the query in
+// this process, such as QueryProcess, aren't encoded, since the process decoding the plan tracks its own queries.
+func Encode(node sql.Node) ([]byte, error) {
+	n, err := encodeNode(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(encodedPlan{Version: Version, Node: n})
+}
+
+// Decode returns the plan encoded in the data given, with its tables and functions resolved with the catalog given.
+// The plan returned is ready to be run.
+func Decode(ctx *sql.Context, catalog sql.Catalog, data []byte) (sql.Node, error) {
+	var p encodedPlan
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, ErrInvalidPlan.New(err.Error())
+	}
+
+	if p.Version != Version {
+		return nil, ErrUnsupportedVersion.New(p.Version, Version)
+	}
+	if p.Node == nil {
+		return nil, ErrInvalidPlan.New("missing node")
+	}
+
+	d := &decoder{ctx: ctx, catalog: catalog, types: make(map[string]sql.Type)}
+	return d.decodeNode(p.Node)
+}
+
+// decoder holds the state of the decoding of a plan.
+type decoder struct {
+	ctx     *sql.Context
+	catalog sql.Catalog
+	// types caches the types already parsed, by their encoded name
+	types map[string]sql.Type
+}
@@ -0,0 +1,173 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planencoding_test
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planencoding"
+)
+
+func newDatabase(t *testing.T, rows ...sql.Row) *memory.Database {
+	table := memory.NewTable("mytable", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "mytable", PrimaryKey: true},
+		{Name: "s", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 20), Source: "mytable", Nullable: true},
+	})
+	for _, row := range rows {
+		require.NoError(t, table.Insert(sql.NewEmptyContext(), row))
+	}
+
+	db := memory.NewDatabase("mydb")
+	db.AddTable("mytable", table)
+	return db
+}
+
+func TestEncodeDecode(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	local := analyzer.NewCatalog(memory.NewMemoryDBProvider(newDatabase(t)))
+	remote := analyzer.NewCatalog(memory.NewMemoryDBProvider(newDatabase(t,
+		sql.NewRow(int64(1), "abc"),
+		sql.NewRow(int64(2), "abd"),
+		sql.NewRow(int64(3), "bcd"),
+		sql.NewRow(int64(4), "acd"),
+		sql.NewRow(int64(5), nil),
+	)))
+
+	table, db, err := local.Table(ctx, "mydb", "mytable")
+	require.NoError(err)
+	upper, err := local.Function("upper")
+	require.NoError(err)
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	s := expression.NewGetFieldWithTable(1, table.Schema()[1].Type, "mytable", "s", true)
+	upperS, err := upper.NewInstance([]sql.Expression{s})
+	require.NoError(err)
+
+	node := plan.NewLimit(
+		expression.NewLiteral(int8(2), sql.Int8),
+		plan.NewSort(
+			[]sql.SortField{{Column: i, Order: sql.Descending, NullOrdering: sql.NullsFirst}},
+			plan.NewProject(
+				[]sql.Expression{i, expression.NewAlias("u", upperS)},
+				plan.NewFilter(
+					expression.NewAnd(
+						expression.NewGreaterThan(i, expression.NewLiteral(int64(1), sql.Int64)),
+						expression.NewOr(
+							expression.NewLike(s, expression.NewLiteral("a%", sql.LongText), nil),
+							expression.NewIsNull(s),
+						),
+					),
+					plan.NewResolvedTable(table, db, nil),
+				),
+			),
+		),
+	)
+
+	data, err := planencoding.Encode(node)
+	require.NoError(err)
+
+	decoded, err := planencoding.Decode(ctx, remote, data)
+	require.NoError(err)
+	require.Equal(node.String(), decoded.String())
+
+	// The decoded plan reads the tables of the catalog it was decoded with
+	rows, err := sql.NodeToRows(ctx, decoded)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(5), nil}, {int64(4), "ACD"}}, rows)
+}
+
+func TestEncodeDecodeAnalyzedPlans(t *testing.T) {
+	db := newDatabase(t,
+		sql.NewRow(int64(1), "first"),
+		sql.NewRow(int64(2), "second"),
+		sql.NewRow(int64(3), "third"),
+		sql.NewRow(int64(4), "first"),
+	)
+	e := sqle.NewDefault(memory.NewMemoryDBProvider(db))
+
+	queries := []string{
+		"SELECT i, s FROM mytable WHERE i > 1 ORDER BY i DESC LIMIT 2",
+		"SELECT s, count(*) AS c FROM mytable GROUP BY s ORDER BY c DESC, s",
+		"SELECT DISTINCT s FROM mytable WHERE s LIKE 'f%' OR i IN (2, 3) ORDER BY 1",
+		"SELECT a.i, b.i FROM mytable a INNER JOIN mytable b ON a.i = b.i + 1 ORDER BY a.i",
+		"SELECT i * 2, convert(i, char) FROM mytable WHERE i BETWEEN 2 AND 3 ORDER BY i",
+		"SELECT * FROM (SELECT i FROM mytable WHERE NOT (i = 1)) sq ORDER BY i LIMIT 1 OFFSET 1",
+	}
+
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext().WithCurrentDB("mydb")
+
+			_, iter, err := e.Query(ctx, query)
+			require.NoError(err)
+			expected, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(err)
+
+			parsed, err := parse.Parse(ctx, query)
+			require.NoError(err)
+			analyzed, err := e.Analyzer.Analyze(ctx, parsed, nil)
+			require.NoError(err)
+
+			data, err := planencoding.Encode(analyzed)
+			require.NoError(err)
+			decoded, err := planencoding.Decode(ctx, e.Analyzer.Catalog, data)
+			require.NoError(err)
+
+			rows, err := sql.NodeToRows(ctx, decoded)
+			require.NoError(err)
+			require.Equal(expected, rows)
+		})
+	}
+}
+
+func TestEncodeDecodeErrors(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	catalog := analyzer.NewCatalog(memory.NewMemoryDBProvider(newDatabase(t)))
+
+	_, err := planencoding.Encode(plan.NewShowProcessList())
+	require.True(planencoding.ErrUnsupportedNode.Is(err))
+
+	_, err = planencoding.Encode(plan.NewFilter(expression.NewUnresolvedColumn("a"), plan.NewShowProcessList()))
+	require.True(planencoding.ErrUnsupportedExpression.Is(err))
+
+	_, err = planencoding.Decode(ctx, catalog, []byte(`{"version":2,"node":{"type":"Distinct"}}`))
+	require.True(planencoding.ErrUnsupportedVersion.Is(err))
+
+	for _, data := range []string{
+		`{"version":1`,
+		`{"version":1}`,
+		`{"version":1,"node":{"type":"Unknown"}}`,
+		`{"version":1,"node":{"type":"Distinct"}}`,
+		`{"version":1,"node":{"type":"Filter","children":[{"type":"ResolvedTable","database":"mydb","table":"mytable","columns":["i","s"]}]}}`,
+		`{"version":1,"node":{"type":"ResolvedTable","database":"mydb","table":"mytable","columns":["x"]}}`,
+	} {
+		_, err = planencoding.Decode(ctx, catalog, []byte(data))
+		require.True(planencoding.ErrInvalidPlan.Is(err), "%s: %v", data, err)
+	}
+}
@@ -0,0 +1,360 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planencoding
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+)
+
+const (
+	getFieldExpr           = "GetField"
+	literalExpr            = "Literal"
+	aliasExpr              = "Alias"
+	equalsExpr             = "Equals"
+	nullSafeEqualsExpr     = "NullSafeEquals"
+	greaterThanExpr        = "GreaterThan"
+	greaterThanOrEqualExpr = "GreaterThanOrEqual"
+	lessThanExpr           = "LessThan"
+	lessThanOrEqualExpr    = "LessThanOrEqual"
+	regexpExpr             = "Regexp"
+	likeExpr               = "Like"
+	inTupleExpr            = "InTuple"
+	hashInTupleExpr        = "HashInTuple"
+	betweenExpr            = "Between"
+	andExpr                = "And"
+	orExpr                 = "Or"
+	notExpr                = "Not"
+	isNullExpr             = "IsNull"
+	isTrueExpr             = "IsTrue"
+	isFalseExpr            = "IsFalse"
+	arithmeticExpr         = "Arithmetic"
+	unaryMinusExpr         = "UnaryMinus"
+	tupleExpr              = "Tuple"
+	convertExpr            = "Convert"
+	functionExpr           = "Function"
+	starExpr               = "Star"
+
+	// nullTypeName is the encoded name of the type of NULL literals, which isn't a column type
+	nullTypeName = "null"
+)
+
+// encodedExpression is the encoded form of an expression. Which of its fields are set depends on its type.
+type encodedExpression struct {
+	Type string `json:"type"`
+	// Name is the name of a field, alias or function, the operator of an arithmetic expression or the type a Convert
+	// converts to
+	Name string `json:"name,omitempty"`
+	// Table is the table of a field or star
+	Table    string `json:"table,omitempty"`
+	Index    int    `json:"index,omitempty"`
+	Nullable bool   `json:"nullable,omitempty"`
+	// SQLType is the type of a field or literal
+	SQLType string `json:"sqlType,omitempty"`
+	// Value is the value of a literal, as it's sent to clients, unless it's NULL
+	Value    []byte               `json:"value,omitempty"`
+	Null     bool                 `json:"null,omitempty"`
+	Children []*encodedExpression `json:"children,omitempty"`
+}
+
+func encodeExpressions(exprs ...sql.Expression) ([]*encodedExpression, error) {
+	encoded := make([]*encodedExpression, len(exprs))
+	for i, e := range exprs {
+		var err error
+		if encoded[i], err = encodeExpression(e); err != nil {
+			return nil, err
+		}
+	}
+	return encoded, nil
+}
+
+func encodeExpression(e sql.Expression) (*encodedExpression, error) {
+	var encoded *encodedExpression
+	switch e := e.(type) {
+	case *expression.GetField:
+		typ, err := encodeType(e.Type())
+		if err != nil {
+			return nil, err
+		}
+		return &encodedExpression{
+			Type:     getFieldExpr,
+			Name:     e.Name(),
+			Table:    e.Table(),
+			Index:    e.Index(),
+			Nullable: e.IsNullable(),
+			SQLType:  typ,
+		}, nil
+	case *expression.Literal:
+		return encodeLiteral(e)
+	case *expression.Star:
+		// Stars are left in the arguments of aggregations, such as COUNT(*)
+		return &encodedExpression{Type: starExpr, Table: e.Table}, nil
+	case *expression.Alias:
+		encoded = &encodedExpression{Type: aliasExpr, Name: e.Name()}
+	case *expression.Equals:
+		encoded = &encodedExpression{Type: equalsExpr}
+	case *expression.NullSafeEquals:
+		encoded = &encodedExpression{Type: nullSafeEqualsExpr}
+	case *expression.GreaterThan:
+		encoded = &encodedExpression{Type: greaterThanExpr}
+	case *expression.GreaterThanOrEqual:
+		encoded = &encodedExpression{Type: greaterThanOrEqualExpr}
+	case *expression.LessThan:
+		encoded = &encodedExpression{Type: lessThanExpr}
+	case *expression.LessThanOrEqual:
+		encoded = &encodedExpression{Type: lessThanOrEqualExpr}
+	case *expression.Regexp:
+		encoded = &encodedExpression{Type: regexpExpr}
+	case *expression.Like:
+		encoded = &encodedExpression{Type: likeExpr}
+		if e.Escape() != nil {
+			// The escape is encoded as the last child
+			children, err := encodeExpressions(append(e.Children(), e.Escape())...)
+			if err != nil {
+				return nil, err
+			}
+			encoded.Children = children
+			return encoded, nil
+		}
+	case *expression.HashInTuple:
+		encoded = &encodedExpression{Type: hashInTupleExpr}
+	case *expression.InTuple:
+		encoded = &encodedExpression{Type: inTupleExpr}
+	case *expression.Between:
+		encoded = &encodedExpression{Type: betweenExpr}
+	case *expression.And:
+		encoded = &encodedExpression{Type: andExpr}
+	case *expression.Or:
+		encoded = &encodedExpression{Type: orExpr}
+	case *expression.Not:
+		encoded = &encodedExpression{Type: notExpr}
+	case *expression.IsNull:
+		encoded = &encodedExpression{Type: isNullExpr}
+	case *expression.IsTrue:
+		encoded = &encodedExpression{Type: isTrueExpr}
+		if e.IsFalse() {
+			encoded.Type = isFalseExpr
+		}
+	case *expression.Arithmetic:
+		encoded = &encodedExpression{Type: arithmeticExpr, Name: e.Op}
+	case *expression.UnaryMinus:
+		encoded = &encodedExpression{Type: unaryMinusExpr}
+	case expression.Tuple:
+		encoded = &encodedExpression{Type: tupleExpr}
+	case *expression.Convert:
+		encoded = &encodedExpression{Type: convertExpr, Name: e.CastToType()}
+	case sql.FunctionExpression:
+		encoded = &encodedExpression{Type: functionExpr, Name: e.FunctionName()}
+	default:
+		return nil, ErrUnsupportedExpression.New(e)
+	}
+
+	children, err := encodeExpressions(e.Children()...)
+	if err != nil {
+		return nil, err
+	}
+	encoded.Children = children
+	return encoded, nil
+}
+
+func encodeLiteral(lit *expression.Literal) (*encodedExpression, error) {
+	typ, err := encodeType(lit.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := &encodedExpression{Type: literalExpr, SQLType: typ}
+	if lit.Value() == nil {
+		encoded.Null = true
+		return encoded, nil
+	}
+
+	val, err := lit.Type().SQL(lit.Value())
+	if err != nil {
+		return nil, err
+	}
+	encoded.Value = val.Raw()
+	return encoded, nil
+}
+
+// encodeType returns the name of the type given, which is the column type it's parsed from.
+func encodeType(typ sql.Type) (string, error) {
+	if typ == sql.Null {
+		return nullTypeName, nil
+	}
+	return typ.String(), nil
+}
+
+func (d *decoder) decodeType(name string) (sql.Type, error) {
+	if name == nullTypeName {
+		return sql.Null, nil
+	}
+	if typ, ok := d.types[name]; ok {
+		return typ, nil
+	}
+
+	typ, err := parse.ParseColumnTypeString(d.ctx, name)
+	if err != nil {
+		return nil, ErrInvalidPlan.New(fmt.Sprintf("unknown type %s: %s", name, err))
+	}
+	d.types[name] = typ
+	return typ, nil
+}
+
+func (d *decoder) decodeExpressions(exprs []*encodedExpression) ([]sql.Expression, error) {
+	decoded := make([]sql.Expression, len(exprs))
+	for i, e := range exprs {
+		var err error
+		if decoded[i], err = d.decodeExpression(e); err != nil {
+			return nil, err
+		}
+	}
+	return decoded, nil
+}
+
+func (d *decoder) decodeExpression(e *encodedExpression) (sql.Expression, error) {
+	if e == nil {
+		return nil, ErrInvalidPlan.New("missing expression")
+	}
+
+	children, err := d.decodeExpressions(e.Children)
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.Type {
+	case getFieldExpr:
+		typ, err := d.decodeType(e.SQLType)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewGetFieldWithTable(e.Index, typ, e.Table, e.Name, e.Nullable), nil
+	case literalExpr:
+		return d.decodeLiteral(e)
+	case starExpr:
+		if e.Table != "" {
+			return expression.NewQualifiedStar(e.Table), nil
+		}
+		return expression.NewStar(), nil
+	case tupleExpr:
+		return expression.NewTuple(children...), nil
+	case functionExpr:
+		fn, err := d.catalog.Function(e.Name)
+		if err != nil {
+			return nil, err
+		}
+		return fn.NewInstance(children)
+	case likeExpr:
+		switch len(children) {
+		case 2:
+			return expression.NewLike(children[0], children[1], nil), nil
+		case 3:
+			return expression.NewLike(children[0], children[1], children[2]), nil
+		}
+		return nil, invalidChildren(e, len(children), 2)
+	case betweenExpr:
+		if len(children) != 3 {
+			return nil, invalidChildren(e, len(children), 3)
+		}
+		return expression.NewBetween(children[0], children[1], children[2]), nil
+	}
+
+	switch e.Type {
+	case aliasExpr, notExpr, isNullExpr, isTrueExpr, isFalseExpr, unaryMinusExpr, convertExpr:
+		if len(children) != 1 {
+			return nil, invalidChildren(e, len(children), 1)
+		}
+		return decodeUnaryExpression(e, children[0])
+	case equalsExpr, nullSafeEqualsExpr, greaterThanExpr, greaterThanOrEqualExpr, lessThanExpr, lessThanOrEqualExpr,
+		regexpExpr, inTupleExpr, hashInTupleExpr, andExpr, orExpr, arithmeticExpr:
+		if len(children) != 2 {
+			return nil, invalidChildren(e, len(children), 2)
+		}
+		return decodeBinaryExpression(e, children[0], children[1])
+	default:
+		return nil, ErrInvalidPlan.New(fmt.Sprintf("unknown expression type %s", e.Type))
+	}
+}
+
+func decodeUnaryExpression(e *encodedExpression, child sql.Expression) (sql.Expression, error) {
+	switch e.Type {
+	case aliasExpr:
+		return expression.NewAlias(e.Name, child), nil
+	case notExpr:
+		return expression.NewNot(child), nil
+	case isNullExpr:
+		return expression.NewIsNull(child), nil
+	case isTrueExpr:
+		return expression.NewIsTrue(child), nil
+	case isFalseExpr:
+		return expression.NewIsFalse(child), nil
+	case unaryMinusExpr:
+		return expression.NewUnaryMinus(child), nil
+	default:
+		return expression.NewConvert(child, e.Name), nil
+	}
+}
+
+func decodeBinaryExpression(e *encodedExpression, left, right sql.Expression) (sql.Expression, error) {
+	switch e.Type {
+	case equalsExpr:
+		return expression.NewEquals(left, right), nil
+	case nullSafeEqualsExpr:
+		return expression.NewNullSafeEquals(left, right), nil
+	case greaterThanExpr:
+		return expression.NewGreaterThan(left, right), nil
+	case greaterThanOrEqualExpr:
+		return expression.NewGreaterThanOrEqual(left, right), nil
+	case lessThanExpr:
+		return expression.NewLessThan(left, right), nil
+	case lessThanOrEqualExpr:
+		return expression.NewLessThanOrEqual(left, right), nil
+	case regexpExpr:
+		return expression.NewRegexp(left, right), nil
+	case inTupleExpr:
+		return expression.NewInTuple(left, right), nil
+	case hashInTupleExpr:
+		return expression.NewHashInTuple(left, right)
+	case andExpr:
+		return expression.NewAnd(left, right), nil
+	case orExpr:
+		return expression.NewOr(left, right), nil
+	default:
+		return expression.NewArithmetic(left, right, e.Name), nil
+	}
+}
+
+func (d *decoder) decodeLiteral(e *encodedExpression) (sql.Expression, error) {
+	typ, err := d.decodeType(e.SQLType)
+	if err != nil {
+		return nil, err
+	}
+	if e.Null {
+		return expression.NewLiteral(nil, typ), nil
+	}
+
+	val, err := typ.Convert(string(e.Value))
+	if err != nil {
+		return nil, ErrInvalidPlan.New(fmt.Sprintf("invalid %s value %q: %s", e.SQLType, e.Value, err))
+	}
+	return expression.NewLiteral(val, typ), nil
+}
+
+func invalidChildren(e *encodedExpression, children, expected int) error {
+	return ErrInvalidPlan.New(fmt.Sprintf("%s expression has %d children, expected %d", e.Type, children, expected))
+}
@@ -0,0 +1,400 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planencoding
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+const (
+	resolvedTableNode   = "ResolvedTable"
+	emptyTableNode      = "EmptyTable"
+	tableAliasNode      = "TableAlias"
+	subqueryAliasNode   = "SubqueryAlias"
+	filterNode          = "Filter"
+	havingNode          = "Having"
+	projectNode         = "Project"
+	groupByNode         = "GroupBy"
+	sortNode            = "Sort"
+	limitNode           = "Limit"
+	offsetNode          = "Offset"
+	distinctNode        = "Distinct"
+	orderedDistinctNode = "OrderedDistinct"
+	innerJoinNode       = "InnerJoin"
+	leftJoinNode        = "LeftJoin"
+	rightJoinNode       = "RightJoin"
+	crossJoinNode       = "CrossJoin"
+	unionNode           = "Union"
+	exchangeNode        = "Exchange"
+)
+
+// encodedNode is the encoded form of a node. Which of its fields are set depends on its type.
+type encodedNode struct {
+	Type string `json:"type"`
+	// Database and Table name the table of a ResolvedTable
+	Database string `json:"database,omitempty"`
+	Table    string `json:"table,omitempty"`
+	// Columns are the columns of a ResolvedTable, which may be a projection of its table, or the column names of a
+	// SubqueryAlias
+	Columns []string `json:"columns,omitempty"`
+	// Name is the name of an alias
+	Name       string `json:"name,omitempty"`
+	Definition string `json:"definition,omitempty"`
+	// Schema is the schema of an EmptyTable
+	Schema      []encodedColumn      `json:"schema,omitempty"`
+	Expressions []*encodedExpression `json:"expressions,omitempty"`
+	// GroupBy are the grouping expressions of a GroupBy, whose selected expressions are in Expressions, and
+	// GroupingSets its grouping sets
+	GroupBy       []*encodedExpression `json:"groupBy,omitempty"`
+	GroupingSets  [][]int              `json:"groupingSets,omitempty"`
+	SortFields    []encodedSortField   `json:"sortFields,omitempty"`
+	CalcFoundRows bool                 `json:"calcFoundRows,omitempty"`
+	Comment       string               `json:"comment,omitempty"`
+	Parallelism   int                  `json:"parallelism,omitempty"`
+	// SetOp and Distinct are the set operation of a Union, and whether it returns every row at most once
+	SetOp    plan.SetOpType `json:"setOp,omitempty"`
+	Distinct bool           `json:"distinct,omitempty"`
+	Children []*encodedNode `json:"children,omitempty"`
+}
+
+type encodedColumn struct {
+	Name       string `json:"name"`
+	Source     string `json:"source,omitempty"`
+	Type       string `json:"type"`
+	Nullable   bool   `json:"nullable,omitempty"`
+	PrimaryKey bool   `json:"primaryKey,omitempty"`
+}
+
+type encodedSortField struct {
+	Column       *encodedExpression `json:"column"`
+	Order        sql.SortOrder      `json:"order"`
+	NullOrdering sql.NullOrdering   `json:"nullOrdering"`
+}
+
+func encodeNode(node sql.Node) (*encodedNode, error) {
+	var n *encodedNode
+	var err error
+	switch node := node.(type) {
+	case *plan.QueryProcess:
+		return encodeNode(node.Child)
+	case *plan.DecoratedNode:
+		// Decorations only describe the plan
+		return encodeNode(node.Child)
+	case *plan.ResolvedTable:
+		if node.AsOf != nil {
+			return nil, ErrUnsupportedNode.New(node)
+		}
+		n = &encodedNode{Type: resolvedTableNode, Table: node.Name()}
+		if node.Database != nil {
+			n.Database = node.Database.Name()
+		}
+		for _, col := range node.Schema() {
+			n.Columns = append(n.Columns, col.Name)
+		}
+		return n, nil
+	case *plan.TableAlias:
+		n = &encodedNode{Type: tableAliasNode, Name: node.Name()}
+	case *plan.SubqueryAlias:
+		n = &encodedNode{Type: subqueryAliasNode, Name: node.Name(), Definition: node.TextDefinition, Columns: node.Columns}
+	case *plan.Filter:
+		n = &encodedNode{Type: filterNode}
+		n.Expressions, err = encodeExpressions(node.Expression)
+	case *plan.Having:
+		n = &encodedNode{Type: havingNode}
+		n.Expressions, err = encodeExpressions(node.Cond)
+	case *plan.Project:
+		n = &encodedNode{Type: projectNode}
+		n.Expressions, err = encodeExpressions(node.Projections...)
+	case *plan.GroupBy:
+		n = &encodedNode{Type: groupByNode, GroupingSets: node.GroupingSets}
+		if n.Expressions, err = encodeExpressions(node.SelectedExprs...); err != nil {
+			return nil, err
+		}
+		n.GroupBy, err = encodeExpressions(node.GroupByExprs...)
+	case *plan.Sort:
+		n = &encodedNode{Type: sortNode}
+		n.SortFields, err = encodeSortFields(node.SortFields)
+	case *plan.Limit:
+		n = &encodedNode{Type: limitNode, CalcFoundRows: node.CalcFoundRows}
+		n.Expressions, err = encodeExpressions(node.Limit)
+	case *plan.Offset:
+		n = &encodedNode{Type: offsetNode}
+		n.Expressions, err = encodeExpressions(node.Offset)
+	case *plan.Distinct:
+		n = &encodedNode{Type: distinctNode}
+		if node.Hash != nil {
+			n.Expressions, err = encodeExpressions(node.Hash)
+		}
+	case *plan.OrderedDistinct:
+		n = &encodedNode{Type: orderedDistinctNode}
+	case *plan.InnerJoin:
+		n = &encodedNode{Type: innerJoinNode, Comment: node.Comment()}
+		n.Expressions, err = encodeExpressions(node.Cond)
+	case *plan.LeftJoin:
+		n = &encodedNode{Type: leftJoinNode, Comment: node.Comment()}
+		n.Expressions, err = encodeExpressions(node.Cond)
+	case *plan.RightJoin:
+		n = &encodedNode{Type: rightJoinNode, Comment: node.Comment()}
+		n.Expressions, err = encodeExpressions(node.Cond)
+	case *plan.CrossJoin:
+		n = &encodedNode{Type: crossJoinNode}
+	case *plan.Union:
+		n = &encodedNode{Type: unionNode, SetOp: node.Op, Distinct: node.Distinct}
+	case *plan.Exchange:
+		n = &encodedNode{Type: exchangeNode, Parallelism: node.Parallelism}
+	default:
+		if !plan.IsEmptyTable(node) {
+			return nil, ErrUnsupportedNode.New(node)
+		}
+		n = &encodedNode{Type: emptyTableNode}
+		n.Schema, err = encodeSchema(node.Schema())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range node.Children() {
+		c, err := encodeNode(child)
+		if err != nil {
+			return nil, err
+		}
+		n.Children = append(n.Children, c)
+	}
+
+	return n, nil
+}
+
+func encodeSortFields(fields []sql.SortField) ([]encodedSortField, error) {
+	encoded := make([]encodedSortField, len(fields))
+	for i, f := range fields {
+		column, err := encodeExpression(f.Column)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = encodedSortField{Column: column, Order: f.Order, NullOrdering: f.NullOrdering}
+	}
+	return encoded, nil
+}
+
+func encodeSchema(schema sql.Schema) ([]encodedColumn, error) {
+	encoded := make([]encodedColumn, len(schema))
+	for i, col := range schema {
+		typ, err := encodeType(col.Type)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = encodedColumn{
+			Name:       col.Name,
+			Source:     col.Source,
+			Type:       typ,
+			Nullable:   col.Nullable,
+			PrimaryKey: col.PrimaryKey,
+		}
+	}
+	return encoded, nil
+}
+
+func (d *decoder) decodeNode(n *encodedNode) (sql.Node, error) {
+	children := make([]sql.Node, len(n.Children))
+	for i, c := range n.Children {
+		if c == nil {
+			return nil, ErrInvalidPlan.New(fmt.Sprintf("missing child of %s node", n.Type))
+		}
+
+		child, err := d.decodeNode(c)
+		if err != nil {
+			return nil, err
+		}
+		children[i] = child
+	}
+
+	exprs, err := d.decodeExpressions(n.Expressions)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.Type {
+	case resolvedTableNode:
+		return d.decodeResolvedTable(n)
+	case emptyTableNode:
+		schema, err := d.decodeSchema(n.Schema)
+		if err != nil {
+			return nil, err
+		}
+		return plan.NewEmptyTableWithSchema(schema), nil
+	}
+
+	switch n.Type {
+	case tableAliasNode, subqueryAliasNode, filterNode, havingNode, projectNode, groupByNode, sortNode, limitNode,
+		offsetNode, distinctNode, orderedDistinctNode, exchangeNode:
+		if len(children) != 1 {
+			return nil, ErrInvalidPlan.New(fmt.Sprintf("%s node has %d children, expected 1", n.Type, len(children)))
+		}
+	case innerJoinNode, leftJoinNode, rightJoinNode, crossJoinNode, unionNode:
+		if len(children) != 2 {
+			return nil, ErrInvalidPlan.New(fmt.Sprintf("%s node has %d children, expected 2", n.Type, len(children)))
+		}
+	default:
+		return nil, ErrInvalidPlan.New(fmt.Sprintf("unknown node type %s", n.Type))
+	}
+
+	switch n.Type {
+	case filterNode, havingNode, limitNode, offsetNode, innerJoinNode, leftJoinNode, rightJoinNode:
+		if len(exprs) != 1 {
+			return nil, ErrInvalidPlan.New(fmt.Sprintf("%s node has %d expressions, expected 1", n.Type, len(exprs)))
+		}
+	}
+
+	switch n.Type {
+	case tableAliasNode:
+		return plan.NewTableAlias(n.Name, children[0]), nil
+	case subqueryAliasNode:
+		sq := plan.NewSubqueryAlias(n.Name, n.Definition, children[0])
+		sq.Columns = n.Columns
+		return sq, nil
+	case filterNode:
+		return plan.NewFilter(exprs[0], children[0]), nil
+	case havingNode:
+		return plan.NewHaving(exprs[0], children[0]), nil
+	case projectNode:
+		return plan.NewProject(exprs, children[0]), nil
+	case groupByNode:
+		groupBy, err := d.decodeExpressions(n.GroupBy)
+		if err != nil {
+			return nil, err
+		}
+		return plan.NewGroupBy(exprs, groupBy, children[0]).WithGroupingSets(n.GroupingSets), nil
+	case sortNode:
+		fields, err := d.decodeSortFields(n.SortFields)
+		if err != nil {
+			return nil, err
+		}
+		return plan.NewSort(fields, children[0]), nil
+	case limitNode:
+		limit := plan.NewLimit(exprs[0], children[0])
+		limit.CalcFoundRows = n.CalcFoundRows
+		return limit, nil
+	case offsetNode:
+		return plan.NewOffset(exprs[0], children[0]), nil
+	case distinctNode:
+		distinct := plan.NewDistinct(children[0])
+		if len(exprs) > 0 {
+			distinct.Hash = exprs[0]
+		}
+		return distinct, nil
+	case orderedDistinctNode:
+		return plan.NewOrderedDistinct(children[0]), nil
+	case exchangeNode:
+		return plan.NewExchange(n.Parallelism, children[0]), nil
+	case innerJoinNode:
+		return withComment(plan.NewInnerJoin(children[0], children[1], exprs[0]), n.Comment), nil
+	case leftJoinNode:
+		return withComment(plan.NewLeftJoin(children[0], children[1], exprs[0]), n.Comment), nil
+	case rightJoinNode:
+		return withComment(plan.NewRightJoin(children[0], children[1], exprs[0]), n.Comment), nil
+	case crossJoinNode:
+		return plan.NewCrossJoin(children[0], children[1]), nil
+	default:
+		return plan.NewSetOp(n.SetOp, n.Distinct, children[0], children[1]), nil
+	}
+}
+
+func withComment(node sql.CommentedNode, comment string) sql.Node {
+	if comment == "" {
+		return node
+	}
+	return node.WithComment(comment)
+}
+
+// decodeResolvedTable returns the table named by the node given in the catalog of the decoder, projected to the
+// columns of the node if they aren't all the columns of the table.
+func (d *decoder) decodeResolvedTable(n *encodedNode) (sql.Node, error) {
+	table, db, err := d.catalog.Table(d.ctx, n.Database, n.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sameColumns(table.Schema(), n.Columns) {
+		projected, ok := table.(sql.ProjectedTable)
+		if ok && hasColumns(table, n.Columns) {
+			table = projected.WithProjection(n.Columns)
+		}
+		if !ok || !sameColumns(table.Schema(), n.Columns) {
+			return nil, ErrInvalidPlan.New(fmt.Sprintf("table %s.%s doesn't have columns %s", n.Database, n.Table,
+				strings.Join(n.Columns, ", ")))
+		}
+	}
+
+	return plan.NewResolvedTable(table, db, nil), nil
+}
+
+// hasColumns returns whether the table given has all the columns given, which it can then be projected to.
+func hasColumns(table sql.Table, columns []string) bool {
+	for _, col := range columns {
+		if !table.Schema().Contains(col, table.Name()) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameColumns(schema sql.Schema, columns []string) bool {
+	if len(schema) != len(columns) {
+		return false
+	}
+	for i, col := range schema {
+		if !strings.EqualFold(col.Name, columns[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *decoder) decodeSortFields(fields []encodedSortField) ([]sql.SortField, error) {
+	decoded := make([]sql.SortField, len(fields))
+	for i, f := range fields {
+		if f.Column == nil {
+			return nil, ErrInvalidPlan.New("missing sort field column")
+		}
+		column, err := d.decodeExpression(f.Column)
+		if err != nil {
+			return nil, err
+		}
+		decoded[i] = sql.SortField{Column: column, Order: f.Order, NullOrdering: f.NullOrdering}
+	}
+	return decoded, nil
+}
+
+func (d *decoder) decodeSchema(columns []encodedColumn) (sql.Schema, error) {
+	schema := make(sql.Schema, len(columns))
+	for i, col := range columns {
+		typ, err := d.decodeType(col.Type)
+		if err != nil {
+			return nil, err
+		}
+		schema[i] = &sql.Column{
+			Name:       col.Name,
+			Source:     col.Source,
+			Type:       typ,
+			Nullable:   col.Nullable,
+			PrimaryKey: col.PrimaryKey,
+		}
+	}
+	return schema, nil
+}
@@ -17,6 +17,8 @@ package sql
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -63,6 +65,8 @@ type Process struct {
 	Pid        uint64
 	Connection uint32
 	User       string
+	Host       string
+	Database   string
 	Query      string
 	Progress   map[string]TableProgress
 	StartedAt  time.Time
@@ -77,6 +81,37 @@ func (p *Process) Seconds() uint64 {
 	return uint64(time.Since(p.StartedAt) / time.Second)
 }
 
+// State returns a description of what this process is doing, built from the progress of the tables it's reading.
+func (p *Process) State() string {
+	var names []string
+	for name := range p.Progress {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var status []string
+	for _, name := range names {
+		progress := p.Progress[name]
+
+		printer := NewTreePrinter()
+		_ = printer.WriteNode("\n" + progress.String())
+		children := []string{}
+		for _, partitionProgress := range progress.PartitionsProgress {
+			children = append(children, partitionProgress.String())
+		}
+		sort.Strings(children)
+		_ = printer.WriteChildren(children...)
+
+		status = append(status, printer.String())
+	}
+
+	if len(status) == 0 {
+		return "running"
+	}
+
+	return strings.Join(status, "")
+}
+
 // Progress between done items and total items
 type Progress struct {
 	Name  string
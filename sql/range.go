@@ -14,6 +14,8 @@
 
 package sql
 
+import "strings"
+
 // RangeCollection is a collection of ranges that represent different (non-overlapping) filter expressions.
 type RangeCollection []Range
 
@@ -50,6 +52,34 @@ func (ranges RangeCollection) Intersect(otherRanges RangeCollection) (RangeColle
 	return newRanges, nil
 }
 
+// DebugString returns the ranges of this RangeCollection in interval notation, such as [{(1, 5)}, {[7, 7]}].
+func (ranges RangeCollection) DebugString() string {
+	strs := make([]string, len(ranges))
+	for i, rang := range ranges {
+		strs[i] = rang.DebugString()
+	}
+	return "[" + strings.Join(strs, ", ") + "]"
+}
+
+// DebugString returns this Range in interval notation, with one set of intervals per index column, such as
+// {[1, 1], (-∞, 3)}.
+func (rang Range) DebugString() string {
+	strs := make([]string, len(rang))
+	for i, rangeColumn := range rang {
+		strs[i] = rangeColumn.DebugString()
+	}
+	return "{" + strings.Join(strs, ", ") + "}"
+}
+
+// DebugString returns this RangeColumn in interval notation. Disjoint intervals are separated by a union sign.
+func (rc RangeColumn) DebugString() string {
+	strs := make([]string, len(rc))
+	for i, rce := range rc {
+		strs[i] = rce.DebugString()
+	}
+	return strings.Join(strs, " ∪ ")
+}
+
 // AsEmpty returns a Range full of empty RangeColumns with the same types as the calling Range.
 func (rang Range) AsEmpty() Range {
 	emptyRange := make(Range, len(rang))
@@ -211,6 +211,32 @@ func (r RangeColumnExpr) String() string {
 	return fmt.Sprintf("RangeColumnExpr(%s, %s)", r.LowerBound.String(), r.UpperBound.String())
 }
 
+// DebugString returns this RangeColumnExpr in interval notation, such as (1, 5] or [3, ∞).
+func (r RangeColumnExpr) DebugString() string {
+	var lower, upper string
+	switch l := r.LowerBound.(type) {
+	case Above:
+		lower = fmt.Sprintf("(%v", l.key)
+	case Below:
+		lower = fmt.Sprintf("[%v", l.key)
+	case AboveAll:
+		lower = "(∞"
+	case BelowAll:
+		lower = "(-∞"
+	}
+	switch u := r.UpperBound.(type) {
+	case Above:
+		upper = fmt.Sprintf("%v]", u.key)
+	case Below:
+		upper = fmt.Sprintf("%v)", u.key)
+	case AboveAll:
+		upper = "∞)"
+	case BelowAll:
+		upper = "-∞)"
+	}
+	return lower + ", " + upper
+}
+
 // TryIntersect attempts to intersect the given RangeColumnExpr with the calling RangeColumnExpr. Returns true if the
 // intersection result is not the empty RangeColumnExpr, however a valid RangeColumnExpr is always returned if the error
 // is nil.
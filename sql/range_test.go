@@ -0,0 +1,43 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeDebugString(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("(1, 5)", OpenRangeColumnExpr(1, 5, Int64).DebugString())
+	require.Equal("[1, 5]", ClosedRangeColumnExpr(1, 5, Int64).DebugString())
+	require.Equal("(-∞, 5)", LessThanRangeColumnExpr(5, Int64).DebugString())
+	require.Equal("[3, ∞)", GreaterOrEqualRangeColumnExpr(3, Int64).DebugString())
+	require.Equal("(-∞, ∞)", AllRangeColumnExpr(Int64).DebugString())
+
+	ranges := RangeCollection{
+		Range{
+			RangeColumn{ClosedRangeColumnExpr(1, 1, Int64)},
+			RangeColumn{LessThanRangeColumnExpr("b", LongText), GreaterThanRangeColumnExpr("d", LongText)},
+		},
+		Range{
+			RangeColumn{OpenRangeColumnExpr(4, 7, Int64)},
+			RangeColumn{AllRangeColumnExpr(LongText)},
+		},
+	}
+	require.Equal("[{[1, 1], (-∞, b) ∪ (d, ∞)}, {(4, 7), (-∞, ∞)}]", ranges.DebugString())
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/shopspring/decimal"
+)
+
+// The rendering of values as text lives here, and everything showing values as text goes through it: the values sent
+// to clients, CAST(... AS CHAR), SELECT ... INTO OUTFILE and dumps. Types render their values in their SQL method
+// with the functions of this file, so that a value reads the same whichever way it's shown.
+
+// NullText is the text of NULL among other values, such as in dumps of rows as SQL, or in files of rows without an
+// escape character.
+const NullText = "NULL"
+
+// NullMarker returns the text of NULL in files of rows with the escape character given, such as those of SELECT ...
+// INTO OUTFILE, which LOAD DATA reads back as NULL: \N with the default escape character, or NULL without any.
+func NullMarker(escapedBy string) string {
+	if escapedBy == "" {
+		return NullText
+	}
+	return escapedBy + "N"
+}
+
+// RenderValue returns the text of the value given of the type given, which is the text clients are sent for it. The
+// value must not be nil, which callers render with the NULL marker of their output. Values of unknown types, or of
+// types that can't hold them, such as NULL, are rendered by what they are.
+func RenderValue(typ Type, v interface{}) (string, error) {
+	if typ != nil {
+		sv, err := typ.SQL(v)
+		if err != nil {
+			return "", err
+		}
+		if !sv.IsNull() {
+			return sv.ToString(), nil
+		}
+	}
+	s, err := LongText.Convert(v)
+	if err != nil {
+		return "", err
+	}
+	return s.(string), nil
+}
+
+const (
+	// floatDigits is the number of significant digits of FLOAT values, which are rounded to them when rendered.
+	floatDigits = 6
+	// maxPlainExponent bounds the values MySQL renders in plain notation: those with up to 15 digits before the point,
+	// or more if they also have digits after it, and up to 14 zeros between the point and their first digit.
+	maxPlainExponent = 15
+)
+
+// renderFloat returns the text of the float given of the size given in bits, 32 or 64, as MySQL renders it. DOUBLE
+// values have the least digits that read back as the same value, and FLOAT values as many as they have up to six.
+// Values are written in plain notation unless they have more than 15 digits before the point and none after it, or
+// more than 14 zeros after the point, and in scientific notation otherwise, with no sign in positive exponents, such as
+// 0.30000000000000004, 3, 100000000000000, 1e15 and 1e-16.
+func renderFloat(f float64, bitSize int) string {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'f', -1, bitSize)
+	}
+
+	e := strconv.FormatFloat(f, 'e', -1, 64)
+	if bitSize == 32 {
+		e = strconv.FormatFloat(f, 'e', floatDigits-1, 64)
+	}
+
+	// e is [-]d[.ddd]e±dd, whose digits are split from the position of the decimal point among them
+	var sign string
+	if e[0] == '-' {
+		sign = "-"
+		e = e[1:]
+	}
+	mantissa, exp := e[:strings.IndexByte(e, 'e')], e[strings.IndexByte(e, 'e')+1:]
+	digits := strings.TrimRight(strings.Replace(mantissa, ".", "", 1), "0")
+	if digits == "" {
+		return sign + "0"
+	}
+	point, _ := strconv.Atoi(exp)
+	point++
+
+	if point < 1-maxPlainExponent || (point > maxPlainExponent && len(digits) <= point) {
+		scientific := digits[:1]
+		if len(digits) > 1 {
+			scientific += "." + digits[1:]
+		}
+		return sign + scientific + "e" + strconv.Itoa(point-1)
+	}
+
+	switch {
+	case point <= 0:
+		return sign + "0." + strings.Repeat("0", -point) + digits
+	case point < len(digits):
+		return sign + digits[:point] + "." + digits[point:]
+	default:
+		return sign + digits + strings.Repeat("0", point-len(digits))
+	}
+}
+
+// renderDecimal returns the text of the decimal given with the number of digits after the point given, padded with
+// trailing zeros.
+func renderDecimal(d decimal.Decimal, scale int32) string {
+	return d.StringFixed(scale)
+}
+
+// renderTemporal returns the text of the time given as a value of the DATE, DATETIME or TIMESTAMP type given. The
+// zero time is rendered as MySQL's zero date.
+func renderTemporal(baseType query.Type, t time.Time) string {
+	if baseType == sqltypes.Date {
+		if t.Equal(zeroTime) {
+			return zeroDateStr
+		}
+		return t.Format(DateLayout)
+	}
+	if t.Equal(zeroTime) {
+		return zeroTimestampDatetimeStr
+	}
+	return t.Format(TimestampDatetimeLayout)
+}
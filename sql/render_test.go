@@ -0,0 +1,94 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderValue(t *testing.T) {
+	instant := time.Date(2021, 6, 1, 12, 30, 15, 250000000, time.UTC)
+
+	tests := []struct {
+		typ      Type
+		val      interface{}
+		expected string
+	}{
+		{Int8, int8(-3), "-3"},
+		{Uint64, uint64(18446744073709551615), "18446744073709551615"},
+		{Float64, 0.1, "0.1"},
+		{Float64, 1e21, "1e21"},
+		{Float64, 1e14, "100000000000000"},
+		{Float64, 999999999999999.0, "999999999999999"},
+		{Float64, 1e15, "1e15"},
+		{Float64, -1e15, "-1e15"},
+		{Float64, 1234567890123456.7, "1234567890123456.8"},
+		{Float64, 12345678901234567890.0, "1.2345678901234567e19"},
+		{Float64, 1e-14, "0.00000000000001"},
+		{Float64, 1.5e-15, "0.0000000000000015"},
+		{Float64, -1e-15, "-0.000000000000001"},
+		{Float64, 1e-16, "1e-16"},
+		{Float64, 1.5e-16, "1.5e-16"},
+		{Float64, 3.0, "3"},
+		{Float64, 0.30000000000000004, "0.30000000000000004"},
+		{Float64, -1.5e-10, "-0.00000000015"},
+		{Float64, 1.7976931348623157e308, "1.7976931348623157e308"},
+		{Float64, 1.2345678901234567e-20, "1.2345678901234567e-20"},
+		{Float64, math.Copysign(0, -1), "-0"},
+		{Float32, float32(0.1), "0.1"},
+		{Float32, float32(3.1415927), "3.14159"},
+		{Float32, float32(123456789), "123457000"},
+		{Float32, float32(-2.5e20), "-2.5e20"},
+		{Float32, float32(1e6), "1000000"},
+		{Float32, float32(1e14), "100000000000000"},
+		{Float32, float32(1e15), "1e15"},
+		{Float32, float32(1.5e15), "1.5e15"},
+		{Float32, float32(1e-15), "0.000000000000001"},
+		{Float32, float32(1e-16), "1e-16"},
+		{MustCreateDecimalType(10, 2), "1.5", "1.50"},
+		{MustCreateDecimalType(10, 0), decimal.RequireFromString("2"), "2"},
+		{Date, instant, "2021-06-01"},
+		{Datetime, instant, "2021-06-01 12:30:15.25"},
+		{Timestamp, instant, "2021-06-01 12:30:15.25"},
+		{Date, zeroTime, "0000-00-00"},
+		{Datetime, zeroTime, "0000-00-00 00:00:00"},
+		{LongText, "abc", "abc"},
+		{Null, "abc", "abc"},
+		{nil, 2.5, "2.5"},
+		{nil, instant, "2021-06-01 12:30:15.25"},
+	}
+	for _, tt := range tests {
+		name := "untyped"
+		if tt.typ != nil {
+			name = tt.typ.String()
+		}
+		t.Run(name, func(t *testing.T) {
+			s, err := RenderValue(tt.typ, tt.val)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, s)
+		})
+	}
+}
+
+func TestNullMarker(t *testing.T) {
+	require.Equal(t, `\N`, NullMarker(`\`))
+	require.Equal(t, "#N", NullMarker("#"))
+	require.Equal(t, "NULL", NullMarker(""))
+}
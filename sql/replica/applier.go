@@ -0,0 +1,380 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replica applies the changes a replica receives from its source server to the tables of the engine.
+package replica
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrRowNotFound is returned in strict mode when a row to update or delete isn't in its table.
+var ErrRowNotFound = errors.NewKind("Can't find record in '%s'")
+
+// ErrTransactionAlreadyApplied is returned in strict mode when a transaction whose GTID was already applied is applied
+// again.
+var ErrTransactionAlreadyApplied = errors.NewKind("transaction %s was already applied")
+
+// ErrQueryEventsUnsupported is returned when a query event is applied by an applier without a QueryRunner.
+var ErrQueryEventsUnsupported = errors.NewKind("can't apply the query event %q without a query runner")
+
+// ErrTableNotWritable is returned when a row event changes a table that doesn't support the change.
+var ErrTableNotWritable = errors.NewKind("table %s doesn't support the changes of replicated row events")
+
+// ApplyMode is how row events are applied when the table doesn't have the rows they expect.
+type ApplyMode byte
+
+const (
+	// ApplyStrict applies row events as they are, so inserting a row whose key is already in its table, or updating or
+	// deleting a row that isn't in its table, is an error, as is applying a transaction that was already applied.
+	ApplyStrict ApplyMode = iota
+	// ApplyIdempotent applies row events so that applying them again has no effect: inserted rows replace the rows
+	// with the same key, updated rows are inserted when their old row isn't in the table, rows to delete that aren't in
+	// the table are ignored, and transactions that were already applied are skipped. It's meant for replaying the
+	// changes a replica may have applied before it crashed, when its position was saved separately from its tables.
+	ApplyIdempotent
+)
+
+// EventType is the kind of change of a row event.
+type EventType byte
+
+const (
+	// Insert events add the After row.
+	Insert EventType = iota
+	// Update events replace the Before row with the After row.
+	Update
+	// Delete events remove the Before row.
+	Delete
+	// Query events run the Query statement in the Database, for changes to the schema such as CREATE TABLE. They're
+	// run with the QueryRunner of the applier, in both modes.
+	Query
+)
+
+// RowEvent is the change of a single row of a table, or a statement changing the schema of a database.
+type RowEvent struct {
+	Type     EventType
+	Database string
+	Table    string
+	Before   sql.Row
+	After    sql.Row
+	// Query is the statement of query events.
+	Query string
+}
+
+// Transaction is a group of row events committed together on the source server. Its GTID is nil if the source server
+// doesn't use GTIDs, and its Timestamp is zero if the time it was committed isn't known.
+type Transaction struct {
+	GTID      *GTID
+	Timestamp time.Time
+	Events    []RowEvent
+}
+
+// QueryRunner runs the statement of a query event in the database given.
+type QueryRunner func(ctx *sql.Context, database, query string) error
+
+// Applier applies the transactions of row events a replica receives to the tables of a database provider, and keeps
+// the set of GTIDs of the transactions it applied.
+type Applier struct {
+	provider sql.DatabaseProvider
+	mode     ApplyMode
+	queries  QueryRunner
+
+	mu       sync.Mutex
+	executed *GTIDSet
+}
+
+// NewApplier creates a new Applier in the mode given. The executed GTIDs are the transactions already applied, such
+// as the ones saved by the replica the last time it ran, or nil if there are none.
+func NewApplier(provider sql.DatabaseProvider, mode ApplyMode, executed *GTIDSet) *Applier {
+	if executed == nil {
+		executed = NewGTIDSet()
+	} else {
+		executed = executed.Copy()
+	}
+	return &Applier{provider: provider, mode: mode, executed: executed}
+}
+
+// SetQueryRunner sets the runner of the statements of query events. Without one, applying a query event is an error.
+func (a *Applier) SetQueryRunner(r QueryRunner) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.queries = r
+}
+
+// ExecutedGTIDs returns a copy of the set of GTIDs of the transactions applied, which the replica saves as its
+// position.
+func (a *Applier) ExecutedGTIDs() *GTIDSet {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.executed.Copy()
+}
+
+// Apply applies the row events of the transaction given, and returns whether it was applied. In idempotent mode,
+// transactions that were already applied are skipped. The row events of databases and tables filtered out by the
+// replication filters of the context are skipped. Transactions are applied one at a time.
+func (a *Applier) Apply(ctx *sql.Context, txn Transaction) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if txn.GTID != nil && a.executed.Contains(*txn.GTID) {
+		if a.mode == ApplyIdempotent {
+			return false, nil
+		}
+		return false, ErrTransactionAlreadyApplied.New(txn.GTID.String())
+	}
+
+	var filters sql.ReplicationFilters
+	if ctx.Replication != nil {
+		config, err := ctx.Replication.LoadReplicaConfig(ctx)
+		if err != nil {
+			return false, err
+		}
+		filters = config.Filters
+	}
+
+	for _, event := range txn.Events {
+		if !filters.ShouldReplicate(event.Database, event.Table) {
+			continue
+		}
+		if err := a.applyEvent(ctx, event); err != nil {
+			return false, err
+		}
+	}
+
+	if txn.GTID != nil {
+		a.executed.Add(*txn.GTID)
+	}
+	return true, nil
+}
+
+func (a *Applier) applyEvent(ctx *sql.Context, event RowEvent) error {
+	if event.Type == Query {
+		if a.queries == nil {
+			return ErrQueryEventsUnsupported.New(event.Query)
+		}
+		return a.queries(ctx, event.Database, event.Query)
+	}
+
+	db, err := a.provider.Database(event.Database)
+	if err != nil {
+		return err
+	}
+
+	table, ok, err := db.GetTableInsensitive(ctx, event.Table)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return sql.ErrTableNotFound.New(event.Table)
+	}
+
+	key := keyColumns(table.Schema())
+
+	switch event.Type {
+	case Insert:
+		return a.applyInsert(ctx, table, key, event.After)
+	case Update:
+		return a.applyUpdate(ctx, table, key, event.Before, event.After)
+	default:
+		return a.applyDelete(ctx, table, key, event.Before)
+	}
+}
+
+func (a *Applier) applyInsert(ctx *sql.Context, table sql.Table, key []int, row sql.Row) error {
+	if a.mode == ApplyIdempotent {
+		existing, err := findRow(ctx, table, key, row)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return updateRow(ctx, table, existing, row)
+		}
+	}
+	return insertRow(ctx, table, row)
+}
+
+func (a *Applier) applyUpdate(ctx *sql.Context, table sql.Table, key []int, before, after sql.Row) error {
+	existing, err := findRow(ctx, table, key, before)
+	if err != nil {
+		return err
+	}
+
+	if a.mode == ApplyStrict {
+		if existing == nil {
+			return ErrRowNotFound.New(table.Name())
+		}
+		return updateRow(ctx, table, existing, after)
+	}
+
+	// A row already having the new key was either written by this same event before, or conflicts with it. Either way,
+	// the new row replaces it.
+	if existing == nil || !keysEqual(table.Schema(), key, before, after) {
+		conflict, err := findRow(ctx, table, key, after)
+		if err != nil {
+			return err
+		}
+		if conflict != nil {
+			if existing == nil {
+				return updateRow(ctx, table, conflict, after)
+			}
+			if err := deleteRow(ctx, table, conflict); err != nil {
+				return err
+			}
+		}
+	}
+
+	if existing == nil {
+		return insertRow(ctx, table, after)
+	}
+	return updateRow(ctx, table, existing, after)
+}
+
+func (a *Applier) applyDelete(ctx *sql.Context, table sql.Table, key []int, row sql.Row) error {
+	existing, err := findRow(ctx, table, key, row)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		if a.mode == ApplyIdempotent {
+			return nil
+		}
+		return ErrRowNotFound.New(table.Name())
+	}
+	return deleteRow(ctx, table, existing)
+}
+
+// keyColumns returns the indexes of the primary key columns of the schema given, or of all its columns if it has no
+// primary key, since rows of tables without primary keys are identified by all their values.
+func keyColumns(schema sql.Schema) []int {
+	var key []int
+	for i, col := range schema {
+		if col.PrimaryKey {
+			key = append(key, i)
+		}
+	}
+	if len(key) == 0 {
+		for i := range schema {
+			key = append(key, i)
+		}
+	}
+	return key
+}
+
+// keysEqual returns whether the two rows given have the same values in the key columns given.
+func keysEqual(schema sql.Schema, key []int, a, b sql.Row) bool {
+	for _, i := range key {
+		if a[i] == nil || b[i] == nil {
+			if a[i] != nil || b[i] != nil {
+				return false
+			}
+			continue
+		}
+
+		cmp, err := schema[i].Type.Compare(a[i], b[i])
+		if err != nil || cmp != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// findRow returns the row of the table given with the same key as the row given, or nil if there's none.
+func findRow(ctx *sql.Context, table sql.Table, key []int, row sql.Row) (sql.Row, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := table.Schema()
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	for {
+		existing, err := iter.Next()
+		if err == io.EOF {
+			return nil, iter.Close(ctx)
+		}
+		if err != nil {
+			iter.Close(ctx)
+			return nil, err
+		}
+
+		if keysEqual(schema, key, existing, row) {
+			return existing, iter.Close(ctx)
+		}
+	}
+}
+
+func insertRow(ctx *sql.Context, table sql.Table, row sql.Row) error {
+	insertable, ok := table.(sql.InsertableTable)
+	if !ok {
+		return ErrTableNotWritable.New(table.Name())
+	}
+
+	inserter := insertable.Inserter(ctx)
+	return edit(ctx, inserter, func() error {
+		return inserter.Insert(ctx, row)
+	})
+}
+
+func updateRow(ctx *sql.Context, table sql.Table, old, new sql.Row) error {
+	updatable, ok := table.(sql.UpdatableTable)
+	if !ok {
+		return ErrTableNotWritable.New(table.Name())
+	}
+
+	updater := updatable.Updater(ctx)
+	return edit(ctx, updater, func() error {
+		return updater.Update(ctx, old, new)
+	})
+}
+
+func deleteRow(ctx *sql.Context, table sql.Table, row sql.Row) error {
+	deletable, ok := table.(sql.DeletableTable)
+	if !ok {
+		return ErrTableNotWritable.New(table.Name())
+	}
+
+	deleter := deletable.Deleter(ctx)
+	return edit(ctx, deleter, func() error {
+		return deleter.Delete(ctx, row)
+	})
+}
+
+// tableEditor is the part of the row inserters, updaters and deleters of tables that edit uses.
+type tableEditor interface {
+	sql.TableEditor
+	sql.Closer
+}
+
+// edit runs the change given as a statement of the table editor given, and closes the editor.
+func edit(ctx *sql.Context, editor tableEditor, change func() error) error {
+	editor.StatementBegin(ctx)
+	if err := change(); err != nil {
+		editor.DiscardChanges(ctx, err)
+		editor.Close(ctx)
+		return err
+	}
+
+	if err := editor.StatementComplete(ctx); err != nil {
+		editor.Close(ctx)
+		return err
+	}
+	return editor.Close(ctx)
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func newTestApplier(t *testing.T, mode ApplyMode) (*Applier, *memory.Table) {
+	table := memory.NewTable("t", sql.Schema{
+		{Name: "pk", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "v", Type: sql.Text, Source: "t", Nullable: true},
+	})
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t", table)
+
+	ctx := sql.NewEmptyContext()
+	require.NoError(t, table.Insert(ctx, sql.NewRow(int64(1), "a")))
+	require.NoError(t, table.Insert(ctx, sql.NewRow(int64(2), "b")))
+
+	return NewApplier(sql.NewDatabaseProvider(db), mode, nil), table
+}
+
+func tableRows(t *testing.T, ctx *sql.Context, table sql.Table) []sql.Row {
+	partitions, err := table.Partitions(ctx)
+	require.NoError(t, err)
+	rows, err := sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, table, partitions))
+	require.NoError(t, err)
+	return rows
+}
+
+// replayed is a transaction a replica could apply twice, if it crashed after applying it but before saving its
+// position.
+var replayed = Transaction{
+	GTID: &GTID{source1, 1},
+	Events: []RowEvent{
+		{Type: Insert, Database: "mydb", Table: "t", After: sql.NewRow(int64(3), "c")},
+		{Type: Update, Database: "mydb", Table: "t", Before: sql.NewRow(int64(1), "a"), After: sql.NewRow(int64(1), "x")},
+		{Type: Update, Database: "mydb", Table: "t", Before: sql.NewRow(int64(2), "b"), After: sql.NewRow(int64(4), "b")},
+		{Type: Delete, Database: "mydb", Table: "t", Before: sql.NewRow(int64(3), "c")},
+	},
+}
+
+func TestApplyIdempotent(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	applier, table := newTestApplier(t, ApplyIdempotent)
+	expected := []sql.Row{sql.NewRow(int64(1), "x"), sql.NewRow(int64(4), "b")}
+
+	applied, err := applier.Apply(ctx, replayed)
+	require.NoError(err)
+	require.True(applied)
+	require.ElementsMatch(expected, tableRows(t, ctx, table))
+
+	// Transactions already applied are skipped
+	applied, err = applier.Apply(ctx, replayed)
+	require.NoError(err)
+	require.False(applied)
+	require.Equal(source1+":1", applier.ExecutedGTIDs().String())
+
+	// The position of a replica that crashed doesn't include the transaction, so its events are applied again
+	applier = NewApplier(applier.provider, ApplyIdempotent, nil)
+	applied, err = applier.Apply(ctx, replayed)
+	require.NoError(err)
+	require.True(applied)
+	require.ElementsMatch(expected, tableRows(t, ctx, table))
+}
+
+func TestApplyStrict(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	applier, table := newTestApplier(t, ApplyStrict)
+
+	applied, err := applier.Apply(ctx, replayed)
+	require.NoError(err)
+	require.True(applied)
+	require.ElementsMatch([]sql.Row{sql.NewRow(int64(1), "x"), sql.NewRow(int64(4), "b")}, tableRows(t, ctx, table))
+
+	_, err = applier.Apply(ctx, replayed)
+	require.True(ErrTransactionAlreadyApplied.Is(err))
+
+	_, err = applier.Apply(ctx, Transaction{Events: []RowEvent{
+		{Type: Delete, Database: "mydb", Table: "t", Before: sql.NewRow(int64(3), "c")},
+	}})
+	require.True(ErrRowNotFound.Is(err))
+
+	_, err = applier.Apply(ctx, Transaction{Events: []RowEvent{
+		{Type: Insert, Database: "mydb", Table: "t", After: sql.NewRow(int64(1), "y")},
+	}})
+	require.Error(err)
+}
+
+func TestApplyFiltered(t *testing.T) {
+	require := require.New(t)
+
+	store := sql.NewMemoryReplicationConfigStore()
+	ctx := sql.NewContext(context.Background(), sql.WithReplicationConfigStore(store))
+	require.NoError(store.SaveReplicaConfig(ctx, sql.ReplicaConfig{
+		Filters: sql.ReplicationFilters{IgnoreTables: []string{"mydb.t"}},
+	}))
+
+	applier, table := newTestApplier(t, ApplyStrict)
+	applied, err := applier.Apply(ctx, replayed)
+	require.NoError(err)
+	require.True(applied)
+	require.ElementsMatch([]sql.Row{sql.NewRow(int64(1), "a"), sql.NewRow(int64(2), "b")}, tableRows(t, ctx, table))
+}
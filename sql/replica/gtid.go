@@ -0,0 +1,178 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrInvalidGTID is returned when parsing a GTID or a GTID set that isn't well formed.
+var ErrInvalidGTID = errors.NewKind("invalid GTID: %s")
+
+// GTID is the global transaction identifier of a transaction: the UUID of the server that committed it and the
+// sequence number of the transaction on that server.
+type GTID struct {
+	SourceID string
+	Sequence uint64
+}
+
+// ParseGTID parses a GTID of the form source_id:sequence.
+func ParseGTID(s string) (GTID, error) {
+	i := strings.LastIndexByte(s, ':')
+	if i <= 0 {
+		return GTID{}, ErrInvalidGTID.New(s)
+	}
+
+	seq, err := strconv.ParseUint(s[i+1:], 10, 64)
+	if err != nil || seq == 0 {
+		return GTID{}, ErrInvalidGTID.New(s)
+	}
+
+	return GTID{SourceID: strings.ToLower(s[:i]), Sequence: seq}, nil
+}
+
+func (g GTID) String() string {
+	return fmt.Sprintf("%s:%d", g.SourceID, g.Sequence)
+}
+
+// gtidInterval is an inclusive range of sequence numbers.
+type gtidInterval struct {
+	start, end uint64
+}
+
+// GTIDSet is a set of GTIDs, such as the transactions a replica already applied, kept as ranges of sequence numbers of
+// each source server. It's not safe for concurrent use.
+type GTIDSet struct {
+	intervals map[string][]gtidInterval
+}
+
+// NewGTIDSet creates a new empty GTIDSet.
+func NewGTIDSet() *GTIDSet {
+	return &GTIDSet{intervals: make(map[string][]gtidInterval)}
+}
+
+// ParseGTIDSet parses a GTID set in the format used by MySQL, such as the value of @@gtid_executed: a comma separated
+// list of source IDs, each followed by colon separated sequence numbers or ranges of them, as in
+// 3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5:11. An empty string is an empty set.
+func ParseGTIDSet(s string) (*GTIDSet, error) {
+	set := NewGTIDSet()
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		fields := strings.Split(part, ":")
+		if len(fields) < 2 || fields[0] == "" {
+			return nil, ErrInvalidGTID.New(s)
+		}
+
+		source := strings.ToLower(fields[0])
+		for _, field := range fields[1:] {
+			start, end := field, field
+			if i := strings.IndexByte(field, '-'); i >= 0 {
+				start, end = field[:i], field[i+1:]
+			}
+
+			first, err := strconv.ParseUint(start, 10, 64)
+			if err != nil || first == 0 {
+				return nil, ErrInvalidGTID.New(s)
+			}
+			last, err := strconv.ParseUint(end, 10, 64)
+			if err != nil || last < first {
+				return nil, ErrInvalidGTID.New(s)
+			}
+
+			set.addInterval(source, gtidInterval{first, last})
+		}
+	}
+	return set, nil
+}
+
+// Contains returns whether the GTID given is in the set.
+func (s *GTIDSet) Contains(g GTID) bool {
+	intervals := s.intervals[g.SourceID]
+	i := sort.Search(len(intervals), func(i int) bool {
+		return intervals[i].end >= g.Sequence
+	})
+	return i < len(intervals) && intervals[i].start <= g.Sequence
+}
+
+// Add adds the GTID given to the set.
+func (s *GTIDSet) Add(g GTID) {
+	s.addInterval(g.SourceID, gtidInterval{g.Sequence, g.Sequence})
+}
+
+// addInterval adds the range of sequence numbers given of the source given, merging it with the ranges it overlaps or
+// is adjacent to.
+func (s *GTIDSet) addInterval(source string, in gtidInterval) {
+	intervals := s.intervals[source]
+
+	var merged []gtidInterval
+	i := 0
+	for ; i < len(intervals) && intervals[i].end+1 < in.start; i++ {
+		merged = append(merged, intervals[i])
+	}
+	for ; i < len(intervals) && intervals[i].start <= in.end+1; i++ {
+		if intervals[i].start < in.start {
+			in.start = intervals[i].start
+		}
+		if intervals[i].end > in.end {
+			in.end = intervals[i].end
+		}
+	}
+	merged = append(merged, in)
+	merged = append(merged, intervals[i:]...)
+
+	s.intervals[source] = merged
+}
+
+// Copy returns a copy of the set.
+func (s *GTIDSet) Copy() *GTIDSet {
+	c := NewGTIDSet()
+	for source, intervals := range s.intervals {
+		c.intervals[source] = append([]gtidInterval(nil), intervals...)
+	}
+	return c
+}
+
+// String returns the set in the format used by MySQL, with the source IDs sorted.
+func (s *GTIDSet) String() string {
+	sources := make([]string, 0, len(s.intervals))
+	for source := range s.intervals {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	parts := make([]string, len(sources))
+	for i, source := range sources {
+		var b strings.Builder
+		b.WriteString(source)
+		for _, in := range s.intervals[source] {
+			if in.start == in.end {
+				fmt.Fprintf(&b, ":%d", in.start)
+			} else {
+				fmt.Fprintf(&b, ":%d-%d", in.start, in.end)
+			}
+		}
+		parts[i] = b.String()
+	}
+	return strings.Join(parts, ",")
+}
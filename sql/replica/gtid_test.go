@@ -0,0 +1,63 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	source1 = "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+	source2 = "4f22fa47-71ca-11e1-9e33-c80aa9429562"
+)
+
+func TestGTIDSet(t *testing.T) {
+	require := require.New(t)
+
+	set, err := ParseGTIDSet(source2 + ":1-3, " + source1 + ":1-5:11")
+	require.NoError(err)
+	require.Equal(source1+":1-5:11,"+source2+":1-3", set.String())
+
+	require.True(set.Contains(GTID{source1, 5}))
+	require.True(set.Contains(GTID{source1, 11}))
+	require.False(set.Contains(GTID{source1, 6}))
+	require.False(set.Contains(GTID{source2, 4}))
+
+	set.Add(GTID{source1, 7})
+	require.Equal(source1+":1-5:7:11,"+source2+":1-3", set.String())
+	set.Add(GTID{source1, 6})
+	set.Add(GTID{source1, 8})
+	require.Equal(source1+":1-8:11,"+source2+":1-3", set.String())
+
+	c := set.Copy()
+	c.Add(GTID{source1, 9})
+	require.False(set.Contains(GTID{source1, 9}))
+
+	g, err := ParseGTID(source1 + ":12")
+	require.NoError(err)
+	require.Equal(GTID{source1, 12}, g)
+	require.Equal(source1+":12", g.String())
+
+	empty, err := ParseGTIDSet("")
+	require.NoError(err)
+	require.Equal("", empty.String())
+
+	for _, invalid := range []string{source1, source1 + ":0", source1 + ":5-3", ":1", source1 + ":a"} {
+		_, err := ParseGTIDSet(invalid)
+		require.True(ErrInvalidGTID.Is(err), invalid)
+	}
+}
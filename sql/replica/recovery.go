@@ -0,0 +1,143 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrRecoveryTargetNotReached is returned when a change stream ends before the transaction of its recovery target.
+var ErrRecoveryTargetNotReached = errors.NewKind("the change stream ended before reaching the recovery target %s")
+
+// TransactionSource is a captured stream of committed transactions, such as the binlog of a server or the write ahead
+// log of a storage backend, that Recover replays.
+type TransactionSource interface {
+	// Next returns the next transaction of the stream, in commit order, or io.EOF when there are no more.
+	Next(ctx *sql.Context) (Transaction, error)
+}
+
+// transactionSlice is a TransactionSource of the transactions of a slice.
+type transactionSlice struct {
+	txns []Transaction
+}
+
+// NewTransactionSource returns a TransactionSource of the transactions given, which must be in commit order.
+func NewTransactionSource(txns []Transaction) TransactionSource {
+	return &transactionSlice{txns: txns}
+}
+
+// Next implements the TransactionSource interface.
+func (s *transactionSlice) Next(*sql.Context) (Transaction, error) {
+	if len(s.txns) == 0 {
+		return Transaction{}, io.EOF
+	}
+	txn := s.txns[0]
+	s.txns = s.txns[1:]
+	return txn, nil
+}
+
+// FromBinlog returns the transaction received by a binlog sink as a transaction to apply. Its GTID is made of the
+// source ID given and the sequence number of the transaction, unless either is empty.
+func FromBinlog(sourceID string, tx sql.BinlogTransaction) Transaction {
+	txn := Transaction{Timestamp: tx.Timestamp, Events: make([]RowEvent, len(tx.Events))}
+	if sourceID != "" && tx.Sequence != 0 {
+		txn.GTID = &GTID{SourceID: sourceID, Sequence: tx.Sequence}
+	}
+
+	for i, e := range tx.Events {
+		event := RowEvent{Database: e.Database, Table: e.Table, Before: e.Before, After: e.After, Query: e.Query}
+		switch e.Type {
+		case sql.BinlogInsert:
+			event.Type = Insert
+		case sql.BinlogUpdate:
+			event.Type = Update
+		case sql.BinlogDelete:
+			event.Type = Delete
+		default:
+			event.Type = Query
+		}
+		txn.Events[i] = event
+	}
+	return txn
+}
+
+// RecoveryTarget is the point of a change stream up to which Recover replays its transactions. A target without a
+// GTID or a time replays the whole stream.
+type RecoveryTarget struct {
+	// GTID is the last transaction replayed, if not nil. It's an error for the stream to end before it.
+	GTID *GTID
+	// Time is the time after which committed transactions aren't replayed, if not zero. Transactions without a
+	// timestamp are always replayed.
+	Time time.Time
+}
+
+func (t RecoveryTarget) String() string {
+	switch {
+	case t.GTID != nil && !t.Time.IsZero():
+		return fmt.Sprintf("%s before %s", t.GTID, t.Time.Format(time.RFC3339Nano))
+	case t.GTID != nil:
+		return t.GTID.String()
+	case !t.Time.IsZero():
+		return t.Time.Format(time.RFC3339Nano)
+	default:
+		return "end of stream"
+	}
+}
+
+// Recover replays the transactions of the source given with the applier given, in order, until it reaches the
+// target, and returns how many of them were applied. Along with a backup of the tables taken at a known position,
+// this is how a storage backend recovers its tables to a point in time: the backup is restored, and the changes
+// committed since are replayed from there. Transactions the applier already applied are skipped in idempotent mode,
+// so that the stream can be replayed from before the position of the backup.
+func Recover(ctx *sql.Context, applier *Applier, source TransactionSource, target RecoveryTarget) (int, error) {
+	var applied int
+	for {
+		txn, err := source.Next(ctx)
+		if err == io.EOF {
+			if target.GTID != nil {
+				return applied, ErrRecoveryTargetNotReached.New(target)
+			}
+			return applied, nil
+		}
+		if err != nil {
+			return applied, err
+		}
+
+		if !target.Time.IsZero() && !txn.Timestamp.IsZero() && txn.Timestamp.After(target.Time) {
+			if target.GTID != nil {
+				return applied, ErrRecoveryTargetNotReached.New(target)
+			}
+			return applied, nil
+		}
+
+		ok, err := applier.Apply(ctx, txn)
+		if err != nil {
+			return applied, err
+		}
+		if ok {
+			applied++
+		}
+
+		if target.GTID != nil && txn.GTID != nil && *txn.GTID == *target.GTID {
+			return applied, nil
+		}
+	}
+}
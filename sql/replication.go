@@ -0,0 +1,179 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// ReplicaConfig is the configuration of the replication of a server from a source server, as set by the CHANGE
+// REPLICATION SOURCE and CHANGE REPLICATION FILTER statements, and whether the replica is running, as set by START
+// REPLICA and STOP REPLICA. The engine doesn't replicate by itself: integrators that run a server as a replica read
+// this configuration from their ReplicationConfigStore.
+type ReplicaConfig struct {
+	// SourceHost is the host name or address of the source server. The replica can't start without it.
+	SourceHost string
+	// SourcePort is the port of the source server.
+	SourcePort uint32
+	// SourceUser is the user the replica connects to the source server as.
+	SourceUser string
+	// SourcePassword is the password of the user the replica connects to the source server as.
+	SourcePassword string
+	// SourceAutoPosition is whether the replica finds the position it replicates from with GTIDs.
+	SourceAutoPosition bool
+	// SourceConnectRetry is the number of seconds between attempts to reconnect to the source server.
+	SourceConnectRetry uint32
+	// SourceRetryCount is the number of attempts to reconnect to the source server before giving up.
+	SourceRetryCount uint32
+	// Filters are the databases and tables the replica applies changes to.
+	Filters ReplicationFilters
+	// Running is whether the replica was started.
+	Running bool
+}
+
+// ReplicationFilters are the databases and tables whose changes a replica applies or ignores. Tables are given as
+// database.table.
+type ReplicationFilters struct {
+	DoDBs        []string
+	IgnoreDBs    []string
+	DoTables     []string
+	IgnoreTables []string
+}
+
+// ShouldReplicate returns whether changes to the table given of the database given pass the filters. The table is
+// empty for changes that aren't to a table, such as CREATE DATABASE, which are only filtered by database. Like in
+// MySQL, database filters are checked first: when there are databases to replicate, changes to other databases are
+// ignored, and otherwise changes to the databases to ignore are. Then changes to tables to replicate are replicated,
+// changes to tables to ignore are ignored, and changes to other tables are only replicated when there are no tables
+// to replicate. Names are compared case-insensitively.
+func (f ReplicationFilters) ShouldReplicate(db, table string) bool {
+	if len(f.DoDBs) > 0 {
+		if !containsFold(f.DoDBs, db) {
+			return false
+		}
+	} else if containsFold(f.IgnoreDBs, db) {
+		return false
+	}
+
+	if table == "" {
+		return true
+	}
+
+	qualified := db + "." + table
+	if containsFold(f.DoTables, qualified) {
+		return true
+	}
+	if containsFold(f.IgnoreTables, qualified) {
+		return false
+	}
+	return len(f.DoTables) == 0
+}
+
+// containsFold returns whether the list given contains the name given, compared case-insensitively.
+func containsFold(list []string, name string) bool {
+	for _, n := range list {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplicationFilterSet holds replication filters that can be changed while they're being used, such as the filters of
+// the changes written to the binary log.
+type ReplicationFilterSet struct {
+	mu      sync.RWMutex
+	filters ReplicationFilters
+}
+
+// NewReplicationFilterSet creates a new ReplicationFilterSet with the filters given.
+func NewReplicationFilterSet(filters ReplicationFilters) *ReplicationFilterSet {
+	return &ReplicationFilterSet{filters: filters.copy()}
+}
+
+// Filters returns the current filters.
+func (s *ReplicationFilterSet) Filters() ReplicationFilters {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.filters.copy()
+}
+
+// SetFilters replaces the filters with the ones given.
+func (s *ReplicationFilterSet) SetFilters(filters ReplicationFilters) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.filters = filters.copy()
+}
+
+// ShouldReplicate returns whether changes to the table given of the database given pass the current filters.
+func (s *ReplicationFilterSet) ShouldReplicate(db, table string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.filters.ShouldReplicate(db, table)
+}
+
+// ReplicationConfigStore persists the configuration of a replica, so that it survives restarts of the server.
+type ReplicationConfigStore interface {
+	// LoadReplicaConfig returns the configuration last saved, or an empty configuration if none was saved.
+	LoadReplicaConfig(ctx *Context) (ReplicaConfig, error)
+	// SaveReplicaConfig saves the configuration given, replacing the one saved before.
+	SaveReplicaConfig(ctx *Context, config ReplicaConfig) error
+}
+
+// MemoryReplicationConfigStore is a ReplicationConfigStore that keeps the configuration in memory, so it's lost when
+// the server stops.
+type MemoryReplicationConfigStore struct {
+	mu     sync.Mutex
+	config ReplicaConfig
+}
+
+var _ ReplicationConfigStore = (*MemoryReplicationConfigStore)(nil)
+
+// NewMemoryReplicationConfigStore creates a new MemoryReplicationConfigStore with an empty configuration.
+func NewMemoryReplicationConfigStore() *MemoryReplicationConfigStore {
+	return &MemoryReplicationConfigStore{}
+}
+
+// LoadReplicaConfig implements the ReplicationConfigStore interface.
+func (s *MemoryReplicationConfigStore) LoadReplicaConfig(ctx *Context) (ReplicaConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.config.copy(), nil
+}
+
+// SaveReplicaConfig implements the ReplicationConfigStore interface.
+func (s *MemoryReplicationConfigStore) SaveReplicaConfig(ctx *Context, config ReplicaConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config = config.copy()
+	return nil
+}
+
+// copy returns a copy of the configuration that doesn't share its filters.
+func (c ReplicaConfig) copy() ReplicaConfig {
+	c.Filters = c.Filters.copy()
+	return c
+}
+
+// copy returns a copy of the filters that doesn't share their lists.
+func (f ReplicationFilters) copy() ReplicationFilters {
+	return ReplicationFilters{
+		DoDBs:        append([]string(nil), f.DoDBs...),
+		IgnoreDBs:    append([]string(nil), f.IgnoreDBs...),
+		DoTables:     append([]string(nil), f.DoTables...),
+		IgnoreTables: append([]string(nil), f.IgnoreTables...),
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicationFilters(t *testing.T) {
+	testCases := []struct {
+		name    string
+		filters ReplicationFilters
+		db      string
+		table   string
+		ok      bool
+	}{
+		{"no filters", ReplicationFilters{}, "db1", "t1", true},
+		{"database to replicate", ReplicationFilters{DoDBs: []string{"db1"}}, "DB1", "t1", true},
+		{"database not to replicate", ReplicationFilters{DoDBs: []string{"db1"}}, "db2", "t1", false},
+		{"database to ignore", ReplicationFilters{IgnoreDBs: []string{"db1"}}, "db1", "", false},
+		{"database not to ignore", ReplicationFilters{IgnoreDBs: []string{"db1"}}, "db2", "", true},
+		{"databases to replicate over databases to ignore", ReplicationFilters{DoDBs: []string{"db1"}, IgnoreDBs: []string{"db1"}}, "db1", "t1", true},
+		{"table to replicate", ReplicationFilters{DoTables: []string{"db1.t1"}}, "db1", "t1", true},
+		{"table not to replicate", ReplicationFilters{DoTables: []string{"db1.t1"}}, "db1", "t2", false},
+		{"table to ignore", ReplicationFilters{IgnoreTables: []string{"db1.t1"}}, "db1", "T1", false},
+		{"table not to ignore", ReplicationFilters{IgnoreTables: []string{"db1.t1"}}, "db2", "t1", true},
+		{"database changes with tables to replicate", ReplicationFilters{DoTables: []string{"db1.t1"}}, "db2", "", true},
+		{"table of an ignored database", ReplicationFilters{IgnoreDBs: []string{"db1"}, DoTables: []string{"db1.t1"}}, "db1", "t1", false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.ok, tt.filters.ShouldReplicate(tt.db, tt.table))
+		})
+	}
+}
+
+func TestReplicationFilterSet(t *testing.T) {
+	require := require.New(t)
+
+	filters := ReplicationFilters{IgnoreDBs: []string{"db1"}}
+	set := NewReplicationFilterSet(filters)
+	require.False(set.ShouldReplicate("db1", "t1"))
+
+	// The set doesn't share the lists of the filters it's given or returns
+	filters.IgnoreDBs[0] = "db2"
+	set.Filters().IgnoreDBs[0] = "db2"
+	require.False(set.ShouldReplicate("db1", "t1"))
+
+	set.SetFilters(ReplicationFilters{DoDBs: []string{"db2"}})
+	require.False(set.ShouldReplicate("db1", "t1"))
+	require.True(set.ShouldReplicate("db2", "t1"))
+}
+
+func TestMemoryReplicationConfigStore(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+
+	store := NewMemoryReplicationConfigStore()
+	config, err := store.LoadReplicaConfig(ctx)
+	require.NoError(err)
+	require.Equal(ReplicaConfig{}, config)
+
+	config.SourceHost = "db1"
+	config.Filters.DoDBs = []string{"mydb"}
+	require.NoError(store.SaveReplicaConfig(ctx, config))
+	config.Filters.DoDBs[0] = "otherdb"
+
+	config, err = store.LoadReplicaConfig(ctx)
+	require.NoError(err)
+	require.Equal("db1", config.SourceHost)
+	require.Equal([]string{"mydb"}, config.Filters.DoDBs)
+}
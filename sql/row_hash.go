@@ -0,0 +1,205 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"math"
+	"time"
+
+	"github.com/cespare/xxhash"
+	"github.com/shopspring/decimal"
+)
+
+// The tags of the canonical encoding of values, which come before their payload.
+const (
+	canonicalNull byte = iota
+	canonicalInt
+	canonicalUint
+	canonicalFloat
+	canonicalDecimal
+	canonicalString
+	canonicalTime
+	canonicalJSON
+	canonicalOther
+)
+
+// CanonicalRowHash returns a stable 64-bit hash of the values of the row given. Unlike HashOf, values are hashed by
+// what they are rather than by how they're represented: integers of any size, integral floats and decimals with the
+// same value have the same hash, as do decimals with or without trailing zeros, strings and byte slices with the same
+// bytes, times in any location with the same instant, and JSON documents with the same keys in any order. Hashes
+// don't depend on the process, so they can be stored and compared across servers.
+func CanonicalRowHash(ctx *Context, row Row) (uint64, error) {
+	h := xxhash.New()
+	if err := writeCanonicalRow(ctx, h, row); err != nil {
+		return 0, err
+	}
+	return h.Sum64(), nil
+}
+
+// CanonicalRowFingerprint returns the SHA-256 of the canonical encoding of the values of the row given, which is
+// hashed like in CanonicalRowHash, for uses where collisions of 64-bit hashes matter.
+func CanonicalRowFingerprint(ctx *Context, row Row) ([]byte, error) {
+	h := sha256.New()
+	if err := writeCanonicalRow(ctx, h, row); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// CanonicalValue returns the value given of the type given as the value its canonical encoding is made from, which is
+// only different for types whose values have another representation, such as decimals, which are strings.
+func CanonicalValue(typ Type, v interface{}) (interface{}, error) {
+	if dt, ok := typ.(DecimalType); ok && v != nil {
+		d, err := dt.ConvertToDecimal(v)
+		if err != nil {
+			return nil, err
+		}
+		if !d.Valid {
+			return nil, nil
+		}
+		return d.Decimal, nil
+	}
+	return v, nil
+}
+
+// writeCanonicalRow writes the canonical encoding of the row given to the hash given. Each value is a tag followed by
+// a fixed size or length-prefixed payload, so that the encoding of a row is never the encoding of another.
+func writeCanonicalRow(ctx *Context, h hash.Hash, row Row) error {
+	var buf []byte
+	for _, v := range row {
+		var err error
+		buf, err = appendCanonical(ctx, buf[:0], v)
+		if err != nil {
+			return err
+		}
+		if _, err := h.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendCanonical(ctx *Context, buf []byte, v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(buf, canonicalNull), nil
+	case bool:
+		if v {
+			return appendCanonicalInt(buf, 1), nil
+		}
+		return appendCanonicalInt(buf, 0), nil
+	case int:
+		return appendCanonicalInt(buf, int64(v)), nil
+	case int8:
+		return appendCanonicalInt(buf, int64(v)), nil
+	case int16:
+		return appendCanonicalInt(buf, int64(v)), nil
+	case int32:
+		return appendCanonicalInt(buf, int64(v)), nil
+	case int64:
+		return appendCanonicalInt(buf, v), nil
+	case uint:
+		return appendCanonicalUint(buf, uint64(v)), nil
+	case uint8:
+		return appendCanonicalUint(buf, uint64(v)), nil
+	case uint16:
+		return appendCanonicalUint(buf, uint64(v)), nil
+	case uint32:
+		return appendCanonicalUint(buf, uint64(v)), nil
+	case uint64:
+		return appendCanonicalUint(buf, v), nil
+	case float32:
+		return appendCanonicalFloat(buf, float64(v)), nil
+	case float64:
+		return appendCanonicalFloat(buf, v), nil
+	case decimal.Decimal:
+		return appendCanonicalDecimal(buf, v), nil
+	case string:
+		return appendCanonicalBytes(buf, canonicalString, []byte(v)), nil
+	case []byte:
+		return appendCanonicalBytes(buf, canonicalString, v), nil
+	case time.Time:
+		buf = append(buf, canonicalTime)
+		buf = appendUint64(buf, uint64(v.Unix()))
+		return appendUint64(buf, uint64(v.Nanosecond())), nil
+	case JSONValue:
+		doc, err := v.Unmarshall(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Maps are marshalled with their keys sorted
+		b, err := json.Marshal(doc.Val)
+		if err != nil {
+			return nil, err
+		}
+		return appendCanonicalBytes(buf, canonicalJSON, b), nil
+	default:
+		return appendCanonicalBytes(buf, canonicalOther, []byte(fmt.Sprintf("%T:%v", v, v))), nil
+	}
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendCanonicalInt(buf []byte, v int64) []byte {
+	return appendUint64(append(buf, canonicalInt), uint64(v))
+}
+
+// appendCanonicalUint encodes unsigned integers that fit in an int64 like signed ones.
+func appendCanonicalUint(buf []byte, v uint64) []byte {
+	if v <= math.MaxInt64 {
+		return appendCanonicalInt(buf, int64(v))
+	}
+	return appendUint64(append(buf, canonicalUint), v)
+}
+
+// appendCanonicalFloat encodes integral floats like integers, and all NaNs and zeros alike.
+func appendCanonicalFloat(buf []byte, f float64) []byte {
+	switch {
+	case math.IsNaN(f):
+		f = math.NaN()
+	case f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64:
+		return appendCanonicalInt(buf, int64(f))
+	case f == math.Trunc(f) && f >= 0 && f < math.MaxUint64:
+		return appendCanonicalUint(buf, uint64(f))
+	}
+	return appendUint64(append(buf, canonicalFloat), math.Float64bits(f))
+}
+
+// appendCanonicalDecimal encodes decimals that are integers or floats like them, and others without trailing zeros.
+func appendCanonicalDecimal(buf []byte, d decimal.Decimal) []byte {
+	if d.Equal(d.Truncate(0)) {
+		if i := d.IntPart(); decimal.NewFromInt(i).Equal(d) {
+			return appendCanonicalInt(buf, i)
+		}
+	}
+	if f, exact := d.Float64(); exact || decimal.NewFromFloat(f).Equal(d) {
+		return appendCanonicalFloat(buf, f)
+	}
+	return appendCanonicalBytes(buf, canonicalDecimal, []byte(d.String()))
+}
+
+func appendCanonicalBytes(buf []byte, tag byte, b []byte) []byte {
+	buf = appendUint64(append(buf, tag), uint64(len(b)))
+	return append(buf, b...)
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalRowHash(t *testing.T) {
+	ctx := NewEmptyContext()
+
+	jsonA, err := JSON.Convert(`{"a": 1, "b": [true, null]}`)
+	require.NoError(t, err)
+	jsonB, err := JSON.Convert(`{"b": [true, null], "a": 1}`)
+	require.NoError(t, err)
+
+	instant := time.Date(2021, 6, 1, 12, 30, 0, 500, time.UTC)
+	plus2 := time.FixedZone("+02:00", 2*60*60)
+
+	equal := []struct {
+		name string
+		a, b Row
+	}{
+		{"integer sizes", Row{int8(-3), uint16(7)}, Row{int64(-3), uint64(7)}},
+		{"integral floats", Row{float32(1), float64(-2)}, Row{int32(1), int(-2)}},
+		{"decimals", Row{decimal.RequireFromString("1.00"), decimal.RequireFromString("0.50")}, Row{int64(1), 0.5}},
+		{"zeros", Row{math.Copysign(0, -1)}, Row{0}},
+		{"NaNs", Row{math.NaN()}, Row{-math.NaN()}},
+		{"bools", Row{true, false}, Row{1, 0}},
+		{"strings", Row{"abc"}, Row{[]byte("abc")}},
+		{"times", Row{instant}, Row{instant.In(plus2)}},
+		{"json", Row{jsonA}, Row{jsonB}},
+		{"nulls", Row{nil, nil}, Row{nil, nil}},
+	}
+	for _, tt := range equal {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			a, err := CanonicalRowHash(ctx, tt.a)
+			require.NoError(err)
+			b, err := CanonicalRowHash(ctx, tt.b)
+			require.NoError(err)
+			require.Equal(a, b)
+
+			fa, err := CanonicalRowFingerprint(ctx, tt.a)
+			require.NoError(err)
+			fb, err := CanonicalRowFingerprint(ctx, tt.b)
+			require.NoError(err)
+			require.Equal(fa, fb)
+		})
+	}
+
+	different := []struct {
+		name string
+		a, b Row
+	}{
+		{"values", Row{1, "a"}, Row{2, "a"}},
+		{"order", Row{1, 2}, Row{2, 1}},
+		{"concatenations", Row{"ab", "c"}, Row{"a", "bc"}},
+		{"null and zero", Row{nil}, Row{0}},
+		{"null and empty string", Row{nil}, Row{""}},
+		{"number and string", Row{1}, Row{"1"}},
+		{"large unsigned", Row{uint64(math.MaxUint64)}, Row{int64(-1)}},
+		{"decimal precision", Row{decimal.RequireFromString("0.1000000000000000000001")}, Row{0.1}},
+		{"arity", Row{nil}, Row{nil, nil}},
+	}
+	for _, tt := range different {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			a, err := CanonicalRowHash(ctx, tt.a)
+			require.NoError(err)
+			b, err := CanonicalRowHash(ctx, tt.b)
+			require.NoError(err)
+			require.NotEqual(a, b)
+		})
+	}
+}
+
+func TestCanonicalValue(t *testing.T) {
+	require := require.New(t)
+
+	v, err := CanonicalValue(MustCreateDecimalType(10, 2), "1.50")
+	require.NoError(err)
+	require.Equal(decimal.RequireFromString("1.5").String(), v.(decimal.Decimal).String())
+
+	v, err = CanonicalValue(MustCreateDecimalType(10, 2), nil)
+	require.NoError(err)
+	require.Nil(v)
+
+	v, err = CanonicalValue(LongText, "1.50")
+	require.NoError(err)
+	require.Equal("1.50", v)
+}
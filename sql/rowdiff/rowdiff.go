@@ -0,0 +1,356 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rowdiff compares the rows of two tables, matching them by primary key.
+package rowdiff
+
+import (
+	"io"
+	"strings"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrNoPrimaryKey is returned when one of the tables being compared has no primary key.
+var ErrNoPrimaryKey = errors.NewKind("table %s has no primary key, rows cannot be compared")
+
+// ErrPrimaryKeyMismatch is returned when the tables being compared have different primary keys.
+var ErrPrimaryKeyMismatch = errors.NewKind("tables %s and %s have different primary keys")
+
+// DiffType is the kind of change between two versions of a row.
+type DiffType byte
+
+const (
+	// Added rows are only present in the second table.
+	Added DiffType = iota
+	// Removed rows are only present in the first table.
+	Removed
+	// Modified rows are present in both tables with different values.
+	Modified
+)
+
+func (t DiffType) String() string {
+	switch t {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Modified:
+		return "modified"
+	default:
+		return "unknown"
+	}
+}
+
+// RowDiff is a single difference between two tables. From is nil for added rows, To is nil for removed rows.
+type RowDiff struct {
+	Type DiffType
+	From sql.Row
+	To   sql.Row
+}
+
+// DiffSchema returns the schema of the rows produced by RowDiff.ToRow for the two tables given: a diff_type column
+// followed by the columns of |from| prefixed with "from_" and the columns of |to| prefixed with "to_".
+func DiffSchema(from, to sql.Table) sql.Schema {
+	schema := sql.Schema{
+		{Name: "diff_type", Type: sql.LongText, Nullable: false},
+	}
+
+	for _, col := range from.Schema() {
+		schema = append(schema, &sql.Column{Name: "from_" + col.Name, Type: col.Type, Nullable: true})
+	}
+	for _, col := range to.Schema() {
+		schema = append(schema, &sql.Column{Name: "to_" + col.Name, Type: col.Type, Nullable: true})
+	}
+
+	return schema
+}
+
+// ToRow returns this diff as a row with the schema returned by DiffSchema. |fromWidth| and |toWidth| are the number of
+// columns of the two tables, used to pad the side of the diff that has no row.
+func (d RowDiff) ToRow(fromWidth, toWidth int) sql.Row {
+	row := make(sql.Row, 1+fromWidth+toWidth)
+	row[0] = d.Type.String()
+	copy(row[1:], d.From)
+	copy(row[1+fromWidth:], d.To)
+	return row
+}
+
+// DiffTables returns the rows that were added, removed or modified between |from| and |to|. Rows are matched by
+// primary key, so both tables must have primary keys made of the same columns. Non-key columns are compared by name;
+// columns only present in one of the tables are ignored. If both tables implement sql.Checksumable and report the
+// same checksum, no rows are read. Added and modified rows are returned in the order they are found in |to|, followed
+// by removed rows in the order they are found in |from|.
+func DiffTables(ctx *sql.Context, from, to sql.Table) ([]RowDiff, error) {
+	fromKey, toKey, err := primaryKeys(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if same, err := sameChecksum(from, to); err != nil {
+		return nil, err
+	} else if same {
+		return nil, nil
+	}
+
+	fromSchema := from.Schema()
+	toSchema := to.Schema()
+	shared := sharedColumns(fromSchema, toSchema)
+
+	// Rows are bucketed by the hash of their key, and matched by comparing the keys themselves, so that rows whose
+	// keys collide aren't confused with each other
+	fromRows := make(map[uint64][]*keyedRow)
+	var fromOrder []*keyedRow
+	err = iterRows(ctx, from, func(row sql.Row) error {
+		key, err := convertKey(fromSchema, fromKey, fromKey, row)
+		if err != nil {
+			return err
+		}
+		hash, err := sql.HashOf(key)
+		if err != nil {
+			return err
+		}
+		r := &keyedRow{key: key, row: row}
+		fromRows[hash] = append(fromRows[hash], r)
+		fromOrder = append(fromOrder, r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []RowDiff
+	err = iterRows(ctx, to, func(row sql.Row) error {
+		// Keys from the second table are converted to the types of the first one so that the keys can be compared
+		key, err := convertKey(fromSchema, fromKey, toKey, row)
+		if err != nil {
+			return err
+		}
+		hash, err := sql.HashOf(key)
+		if err != nil {
+			return err
+		}
+
+		fromRow, err := findRow(fromSchema, fromKey, fromRows[hash], key)
+		if err != nil {
+			return err
+		}
+		if fromRow == nil {
+			diffs = append(diffs, RowDiff{Type: Added, To: row})
+			return nil
+		}
+		fromRow.matched = true
+
+		equal, err := rowsEqual(fromSchema, shared, fromRow.row, row)
+		if err != nil {
+			return err
+		}
+		if !equal {
+			diffs = append(diffs, RowDiff{Type: Modified, From: fromRow.row, To: row})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range fromOrder {
+		if !r.matched {
+			diffs = append(diffs, RowDiff{Type: Removed, From: r.row})
+		}
+	}
+
+	return diffs, nil
+}
+
+// primaryKeys returns the positions of the primary key columns of both tables, in the order they appear in |from|.
+func primaryKeys(from, to sql.Table) ([]int, []int, error) {
+	fromSchema := from.Schema()
+	toSchema := to.Schema()
+
+	var fromKey, toKey []int
+	for i, col := range fromSchema {
+		if !col.PrimaryKey {
+			continue
+		}
+
+		j := indexOfColumn(toSchema, col.Name)
+		if j < 0 || !toSchema[j].PrimaryKey {
+			return nil, nil, ErrPrimaryKeyMismatch.New(from.Name(), to.Name())
+		}
+
+		fromKey = append(fromKey, i)
+		toKey = append(toKey, j)
+	}
+
+	if len(fromKey) == 0 {
+		return nil, nil, ErrNoPrimaryKey.New(from.Name())
+	}
+
+	toPkCount := 0
+	for _, col := range toSchema {
+		if col.PrimaryKey {
+			toPkCount++
+		}
+	}
+	if toPkCount != len(toKey) {
+		return nil, nil, ErrPrimaryKeyMismatch.New(from.Name(), to.Name())
+	}
+
+	return fromKey, toKey, nil
+}
+
+func sameChecksum(from, to sql.Table) (bool, error) {
+	fromChecksumable, ok := from.(sql.Checksumable)
+	if !ok {
+		return false, nil
+	}
+	toChecksumable, ok := to.(sql.Checksumable)
+	if !ok {
+		return false, nil
+	}
+
+	fromChecksum, err := fromChecksumable.Checksum()
+	if err != nil {
+		return false, err
+	}
+	toChecksum, err := toChecksumable.Checksum()
+	if err != nil {
+		return false, err
+	}
+
+	return fromChecksum != "" && fromChecksum == toChecksum, nil
+}
+
+// keyedRow is a row of the first table being compared, along with its converted key.
+type keyedRow struct {
+	key     sql.Row
+	row     sql.Row
+	matched bool
+}
+
+// convertKey returns the key columns |key| of |row|, converting each value to the type of the corresponding column in
+// |schema| at the positions |schemaKey|.
+func convertKey(schema sql.Schema, schemaKey, key []int, row sql.Row) (sql.Row, error) {
+	keyRow := make(sql.Row, len(key))
+	for i, idx := range key {
+		v, err := schema[schemaKey[i]].Type.Convert(row[idx])
+		if err != nil {
+			return nil, err
+		}
+		keyRow[i] = v
+	}
+	return keyRow, nil
+}
+
+// findRow returns the unmatched row of |rows| whose key is equal to |key|, or nil if there is none. The keys are
+// compared with the types of the columns of |schema| at the positions |schemaKey|.
+func findRow(schema sql.Schema, schemaKey []int, rows []*keyedRow, key sql.Row) (*keyedRow, error) {
+	for _, r := range rows {
+		if r.matched {
+			continue
+		}
+		equal := true
+		for i, idx := range schemaKey {
+			cmp, err := schema[idx].Type.Compare(r.key[i], key[i])
+			if err != nil {
+				return nil, err
+			}
+			if cmp != 0 {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+// columnPair is the position of a column present in both tables being compared.
+type columnPair struct {
+	from, to int
+}
+
+func sharedColumns(from, to sql.Schema) []columnPair {
+	var pairs []columnPair
+	for i, col := range from {
+		if j := indexOfColumn(to, col.Name); j >= 0 {
+			pairs = append(pairs, columnPair{i, j})
+		}
+	}
+	return pairs
+}
+
+func rowsEqual(schema sql.Schema, shared []columnPair, from, to sql.Row) (bool, error) {
+	for _, pair := range shared {
+		typ := schema[pair.from].Type
+		left, right := from[pair.from], to[pair.to]
+
+		if left == nil || right == nil {
+			if left != right {
+				return false, nil
+			}
+			continue
+		}
+
+		cmp, err := typ.Compare(left, right)
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func iterRows(ctx *sql.Context, table sql.Table, cb func(sql.Row) error) error {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	for {
+		row, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			iter.Close(ctx)
+			return err
+		}
+
+		if err := cb(row); err != nil {
+			iter.Close(ctx)
+			return err
+		}
+	}
+
+	return iter.Close(ctx)
+}
+
+func indexOfColumn(schema sql.Schema, name string) int {
+	for i, col := range schema {
+		if strings.EqualFold(col.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
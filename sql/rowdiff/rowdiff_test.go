@@ -0,0 +1,96 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowdiff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowdiff"
+)
+
+func TestDiffTables(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.Schema{
+		{Name: "pk", Type: sql.Int64, Nullable: false, PrimaryKey: true},
+		{Name: "v", Type: sql.Text, Nullable: true},
+	}
+
+	from := memory.NewPartitionedTable("from", schema, 2)
+	for _, row := range []sql.Row{
+		sql.NewRow(int64(1), "one"),
+		sql.NewRow(int64(2), "two"),
+		sql.NewRow(int64(3), "three"),
+		sql.NewRow(int64(4), nil),
+	} {
+		require.NoError(from.Insert(ctx, row))
+	}
+
+	to := memory.NewTable("to", schema)
+	for _, row := range []sql.Row{
+		sql.NewRow(int64(1), "one"),
+		sql.NewRow(int64(3), "THREE"),
+		sql.NewRow(int64(4), nil),
+		sql.NewRow(int64(5), "five"),
+	} {
+		require.NoError(to.Insert(ctx, row))
+	}
+
+	diffs, err := rowdiff.DiffTables(ctx, from, to)
+	require.NoError(err)
+	require.Equal([]rowdiff.RowDiff{
+		{Type: rowdiff.Modified, From: sql.NewRow(int64(3), "three"), To: sql.NewRow(int64(3), "THREE")},
+		{Type: rowdiff.Added, To: sql.NewRow(int64(5), "five")},
+		{Type: rowdiff.Removed, From: sql.NewRow(int64(2), "two")},
+	}, diffs)
+
+	require.Equal(
+		sql.NewRow("removed", int64(2), "two", nil, nil),
+		diffs[2].ToRow(len(from.Schema()), len(to.Schema())),
+	)
+	require.Len(rowdiff.DiffSchema(from, to), 5)
+
+	diffs, err = rowdiff.DiffTables(ctx, to, to)
+	require.NoError(err)
+	require.Empty(diffs)
+}
+
+func TestDiffTablesPrimaryKeys(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	keyed := memory.NewTable("keyed", sql.Schema{
+		{Name: "pk", Type: sql.Int64, Nullable: false, PrimaryKey: true},
+		{Name: "v", Type: sql.Int64, Nullable: true},
+	})
+	otherKey := memory.NewTable("other_key", sql.Schema{
+		{Name: "pk", Type: sql.Int64, Nullable: false},
+		{Name: "v", Type: sql.Int64, Nullable: false, PrimaryKey: true},
+	})
+	keyless := memory.NewTable("keyless", sql.Schema{
+		{Name: "pk", Type: sql.Int64, Nullable: true},
+	})
+
+	_, err := rowdiff.DiffTables(ctx, keyed, otherKey)
+	require.True(rowdiff.ErrPrimaryKeyMismatch.Is(err))
+
+	_, err = rowdiff.DiffTables(ctx, keyless, keyless)
+	require.True(rowdiff.ErrNoPrimaryKey.Is(err))
+}
@@ -0,0 +1,510 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schemadiff computes the DDL statements needed to turn the schema of one database or table into the schema
+// of another. It only inspects the interfaces exposed by sql.Database and sql.Table, so any two databases visible to
+// an engine can be compared, even when they come from different integrators.
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DiffDatabases returns the statements that transform the schema of |from| into the schema of |to|. Tables that only
+// exist in |from| are dropped, tables that only exist in |to| are created, and tables that exist in both are altered.
+// Statements are ordered by table name so that the output is deterministic.
+func DiffDatabases(ctx *sql.Context, from, to sql.Database) ([]string, error) {
+	fromNames, err := from.GetTableNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	toNames, err := to.GetTableNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string)
+	for _, name := range fromNames {
+		names[strings.ToLower(name)] = name
+	}
+	for _, name := range toNames {
+		if _, ok := names[strings.ToLower(name)]; !ok {
+			names[strings.ToLower(name)] = name
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for lower := range names {
+		sorted = append(sorted, lower)
+	}
+	sort.Strings(sorted)
+
+	var stmts []string
+	for _, lower := range sorted {
+		name := names[lower]
+
+		fromTable, inFrom, err := from.GetTableInsensitive(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		toTable, inTo, err := to.GetTableInsensitive(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case inFrom && !inTo:
+			stmts = append(stmts, fmt.Sprintf("DROP TABLE %s", quote(fromTable.Name())))
+		case !inFrom && inTo:
+			stmt, err := CreateTableStatement(ctx, toTable)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, stmt)
+		case inFrom && inTo:
+			tableStmts, err := DiffTables(ctx, fromTable, toTable)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, tableStmts...)
+		}
+	}
+
+	return stmts, nil
+}
+
+// DiffTables returns the ALTER TABLE statements that transform the schema of |from| into the schema of |to|. The
+// statements reference |from| by name. Column order is only taken into account for added columns; reordering existing
+// columns is not reported.
+func DiffTables(ctx *sql.Context, from, to sql.Table) ([]string, error) {
+	d := &tableDiff{name: from.Name()}
+
+	d.diffColumns(from.Schema(), to.Schema())
+	d.diffPrimaryKey(from.Schema(), to.Schema())
+
+	fromIndexes, err := secondaryIndexes(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toIndexes, err := secondaryIndexes(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	d.diffIndexes(fromIndexes, toIndexes)
+
+	fromFks, err := foreignKeys(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toFks, err := foreignKeys(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	d.diffForeignKeys(fromFks, toFks)
+
+	fromChecks, err := checks(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toChecks, err := checks(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	d.diffChecks(fromChecks, toChecks)
+
+	return d.statements(), nil
+}
+
+// CreateTableStatement returns a CREATE TABLE statement for the table given, including its primary key, secondary
+// indexes, foreign keys and check constraints.
+func CreateTableStatement(ctx *sql.Context, table sql.Table) (string, error) {
+	schema := table.Schema()
+
+	var defs []string
+	for _, col := range schema {
+		defs = append(defs, "  "+ColumnDefinition(col))
+	}
+
+	if pks := primaryKeyColumns(schema); len(pks) > 0 {
+		defs = append(defs, fmt.Sprintf("  PRIMARY KEY (%s)", quoteAll(pks)))
+	}
+
+	indexes, err := secondaryIndexes(ctx, table)
+	if err != nil {
+		return "", err
+	}
+	for _, idx := range indexes {
+		defs = append(defs, "  "+idx.definition())
+	}
+
+	fks, err := foreignKeys(ctx, table)
+	if err != nil {
+		return "", err
+	}
+	for _, fk := range fks {
+		defs = append(defs, "  "+foreignKeyDefinition(fk))
+	}
+
+	chks, err := checks(ctx, table)
+	if err != nil {
+		return "", err
+	}
+	for _, chk := range chks {
+		defs = append(defs, "  "+checkDefinition(chk))
+	}
+
+	return fmt.Sprintf("CREATE TABLE %s (\n%s\n)", quote(table.Name()), strings.Join(defs, ",\n")), nil
+}
+
+// ColumnDefinition returns the definition of the column given as it would appear in a CREATE TABLE or ALTER TABLE
+// statement, e.g. "`a` bigint NOT NULL DEFAULT 1".
+func ColumnDefinition(col *sql.Column) string {
+	def := fmt.Sprintf("%s %s", quote(col.Name), strings.ToLower(col.Type.String()))
+
+	if !col.Nullable {
+		def += " NOT NULL"
+	}
+
+	if col.AutoIncrement {
+		def += " AUTO_INCREMENT"
+	}
+
+	if col.Default != nil {
+		def += " DEFAULT " + col.Default.String()
+	}
+
+	if col.Comment != "" {
+		def += fmt.Sprintf(" COMMENT '%s'", strings.ReplaceAll(col.Comment, "'", "''"))
+	}
+
+	return def
+}
+
+// dropKind is the kind of object dropped by a clause of a table diff. Drops are emitted in the order of their kinds, so
+// that nothing is dropped while another object still depends on it: foreign keys first, then checks, indexes, the
+// primary key and finally columns.
+type dropKind int
+
+const (
+	dropForeignKey dropKind = iota
+	dropCheck
+	dropIndex
+	dropPrimaryKey
+	dropColumn
+	dropKinds
+)
+
+// tableDiff accumulates the individual clauses of a table diff. Drops are emitted before additions so that a
+// constraint or index can be redefined under the same name.
+type tableDiff struct {
+	name  string
+	drops [dropKinds][]string
+	adds  []string
+}
+
+func (d *tableDiff) drop(kind dropKind, clause string, args ...interface{}) {
+	d.drops[kind] = append(d.drops[kind], fmt.Sprintf(clause, args...))
+}
+
+func (d *tableDiff) add(clause string, args ...interface{}) {
+	d.adds = append(d.adds, fmt.Sprintf(clause, args...))
+}
+
+func (d *tableDiff) statements() []string {
+	var clauses []string
+	for _, drops := range d.drops {
+		clauses = append(clauses, drops...)
+	}
+	clauses = append(clauses, d.adds...)
+
+	var stmts []string
+	for _, clause := range clauses {
+		stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s %s", quote(d.name), clause))
+	}
+	return stmts
+}
+
+func (d *tableDiff) diffColumns(from, to sql.Schema) {
+	for _, col := range from {
+		if indexOfColumn(to, col.Name) < 0 {
+			d.drop(dropColumn, "DROP COLUMN %s", quote(col.Name))
+		}
+	}
+
+	for i, col := range to {
+		idx := indexOfColumn(from, col.Name)
+		if idx < 0 {
+			d.add("ADD COLUMN %s%s", ColumnDefinition(col), columnPosition(to, i))
+			continue
+		}
+
+		if ColumnDefinition(from[idx]) != ColumnDefinition(col) {
+			d.add("MODIFY COLUMN %s", ColumnDefinition(col))
+		}
+	}
+}
+
+func (d *tableDiff) diffPrimaryKey(from, to sql.Schema) {
+	fromPks := primaryKeyColumns(from)
+	toPks := primaryKeyColumns(to)
+
+	if strings.EqualFold(strings.Join(fromPks, ","), strings.Join(toPks, ",")) {
+		return
+	}
+
+	if len(fromPks) > 0 {
+		d.drop(dropPrimaryKey, "DROP PRIMARY KEY")
+	}
+	if len(toPks) > 0 {
+		d.add("ADD PRIMARY KEY (%s)", quoteAll(toPks))
+	}
+}
+
+func (d *tableDiff) diffIndexes(from, to []indexDef) {
+	fromByName := make(map[string]indexDef)
+	for _, idx := range from {
+		fromByName[strings.ToLower(idx.name)] = idx
+	}
+	toByName := make(map[string]indexDef)
+	for _, idx := range to {
+		toByName[strings.ToLower(idx.name)] = idx
+	}
+
+	for _, idx := range from {
+		other, ok := toByName[strings.ToLower(idx.name)]
+		if !ok || other.definition() != idx.definition() {
+			d.drop(dropIndex, "DROP INDEX %s", quote(idx.name))
+		}
+	}
+
+	for _, idx := range to {
+		other, ok := fromByName[strings.ToLower(idx.name)]
+		if !ok || other.definition() != idx.definition() {
+			d.add("ADD %s", idx.definition())
+		}
+	}
+}
+
+func (d *tableDiff) diffForeignKeys(from, to []sql.ForeignKeyConstraint) {
+	fromByName := make(map[string]sql.ForeignKeyConstraint)
+	for _, fk := range from {
+		fromByName[strings.ToLower(fk.Name)] = fk
+	}
+	toByName := make(map[string]sql.ForeignKeyConstraint)
+	for _, fk := range to {
+		toByName[strings.ToLower(fk.Name)] = fk
+	}
+
+	for _, fk := range from {
+		other, ok := toByName[strings.ToLower(fk.Name)]
+		if !ok || foreignKeyDefinition(other) != foreignKeyDefinition(fk) {
+			d.drop(dropForeignKey, "DROP FOREIGN KEY %s", quote(fk.Name))
+		}
+	}
+
+	for _, fk := range to {
+		other, ok := fromByName[strings.ToLower(fk.Name)]
+		if !ok || foreignKeyDefinition(other) != foreignKeyDefinition(fk) {
+			d.add("ADD %s", foreignKeyDefinition(fk))
+		}
+	}
+}
+
+func (d *tableDiff) diffChecks(from, to []sql.CheckDefinition) {
+	fromByName := make(map[string]sql.CheckDefinition)
+	for _, chk := range from {
+		fromByName[strings.ToLower(chk.Name)] = chk
+	}
+	toByName := make(map[string]sql.CheckDefinition)
+	for _, chk := range to {
+		toByName[strings.ToLower(chk.Name)] = chk
+	}
+
+	for _, chk := range from {
+		other, ok := toByName[strings.ToLower(chk.Name)]
+		if !ok || checkDefinition(other) != checkDefinition(chk) {
+			d.drop(dropCheck, "DROP CHECK %s", quote(chk.Name))
+		}
+	}
+
+	for _, chk := range to {
+		other, ok := fromByName[strings.ToLower(chk.Name)]
+		if !ok || checkDefinition(other) != checkDefinition(chk) {
+			d.add("ADD %s", checkDefinition(chk))
+		}
+	}
+}
+
+// indexOfColumn returns the position of the column named |name| in |schema|, compared case-insensitively, or -1 if
+// there is no such column.
+func indexOfColumn(schema sql.Schema, name string) int {
+	for i, col := range schema {
+		if strings.EqualFold(col.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// columnPosition returns the FIRST / AFTER clause placing the column at index |i| of |schema|.
+func columnPosition(schema sql.Schema, i int) string {
+	if i == 0 {
+		return " FIRST"
+	}
+	return " AFTER " + quote(schema[i-1].Name)
+}
+
+// indexDef is the portion of an index definition that is relevant to a schema diff.
+type indexDef struct {
+	name    string
+	unique  bool
+	columns []string
+	comment string
+}
+
+func (i indexDef) definition() string {
+	unique := ""
+	if i.unique {
+		unique = "UNIQUE "
+	}
+
+	def := fmt.Sprintf("%sINDEX %s (%s)", unique, quote(i.name), quoteAll(i.columns))
+	if i.comment != "" {
+		def += fmt.Sprintf(" COMMENT '%s'", strings.ReplaceAll(i.comment, "'", "''"))
+	}
+
+	return def
+}
+
+// secondaryIndexes returns the user-visible indexes of the table given, excluding the primary key and any generated
+// indexes, sorted by name.
+func secondaryIndexes(ctx *sql.Context, table sql.Table) ([]indexDef, error) {
+	it, ok := table.(sql.IndexedTable)
+	if !ok {
+		return nil, nil
+	}
+
+	indexes, err := it.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var defs []indexDef
+	for _, idx := range indexes {
+		if idx.IsGenerated() || strings.EqualFold(idx.ID(), "PRIMARY") {
+			continue
+		}
+
+		def := indexDef{
+			name:    idx.ID(),
+			unique:  idx.IsUnique(),
+			comment: idx.Comment(),
+		}
+		for _, expr := range idx.Expressions() {
+			def.columns = append(def.columns, columnFromIndexExpression(expr))
+		}
+		defs = append(defs, def)
+	}
+
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].name < defs[j].name
+	})
+
+	return defs, nil
+}
+
+// columnFromIndexExpression strips the table qualifier from an index expression of the form "table.column".
+func columnFromIndexExpression(expr string) string {
+	if idx := strings.LastIndex(expr, "."); idx >= 0 {
+		return expr[idx+1:]
+	}
+	return expr
+}
+
+func foreignKeys(ctx *sql.Context, table sql.Table) ([]sql.ForeignKeyConstraint, error) {
+	switch t := table.(type) {
+	case sql.ForeignKeyTable:
+		return t.GetForeignKeys(ctx)
+	case sql.TableWrapper:
+		return foreignKeys(ctx, t.Underlying())
+	default:
+		return nil, nil
+	}
+}
+
+func foreignKeyDefinition(fk sql.ForeignKeyConstraint) string {
+	def := fmt.Sprintf(
+		"CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+		quote(fk.Name),
+		quoteAll(fk.Columns),
+		quote(fk.ReferencedTable),
+		quoteAll(fk.ReferencedColumns),
+	)
+
+	if len(fk.OnDelete) > 0 && fk.OnDelete != sql.ForeignKeyReferenceOption_DefaultAction {
+		def += " ON DELETE " + string(fk.OnDelete)
+	}
+	if len(fk.OnUpdate) > 0 && fk.OnUpdate != sql.ForeignKeyReferenceOption_DefaultAction {
+		def += " ON UPDATE " + string(fk.OnUpdate)
+	}
+
+	return def
+}
+
+func checks(ctx *sql.Context, table sql.Table) ([]sql.CheckDefinition, error) {
+	switch t := table.(type) {
+	case sql.CheckTable:
+		return t.GetChecks(ctx)
+	case sql.TableWrapper:
+		return checks(ctx, t.Underlying())
+	default:
+		return nil, nil
+	}
+}
+
+func checkDefinition(chk sql.CheckDefinition) string {
+	def := fmt.Sprintf("CONSTRAINT %s CHECK (%s)", quote(chk.Name), chk.CheckExpression)
+	if !chk.Enforced {
+		def += " NOT ENFORCED"
+	}
+	return def
+}
+
+func primaryKeyColumns(schema sql.Schema) []string {
+	var pks []string
+	for _, col := range schema {
+		if col.PrimaryKey {
+			pks = append(pks, col.Name)
+		}
+	}
+	return pks
+}
+
+func quote(id string) string {
+	return "`" + strings.ReplaceAll(id, "`", "``") + "`"
+}
+
+func quoteAll(ids []string) string {
+	quoted := make([]string, len(ids))
+	for i, id := range ids {
+		quoted[i] = quote(id)
+	}
+	return strings.Join(quoted, ",")
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemadiff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/schemadiff"
+)
+
+func TestDiffTables(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	from := memory.NewTable("mytable", sql.Schema{
+		&sql.Column{Name: "pk", Type: sql.Int64, Nullable: false, Source: "mytable", PrimaryKey: true},
+		&sql.Column{Name: "a", Type: sql.Int32, Nullable: true, Source: "mytable"},
+		&sql.Column{Name: "b", Type: sql.Text, Nullable: true, Source: "mytable"},
+	})
+	require.NoError(from.CreateIndex(ctx, "idx_a", sql.IndexUsing_Default, sql.IndexConstraint_None, []sql.IndexColumn{{Name: "a"}}, ""))
+
+	to := memory.NewTable("mytable", sql.Schema{
+		&sql.Column{Name: "pk", Type: sql.Int64, Nullable: false, Source: "mytable", PrimaryKey: true},
+		&sql.Column{Name: "a", Type: sql.Int64, Nullable: false, Source: "mytable"},
+		&sql.Column{Name: "c", Type: sql.Text, Nullable: true, Source: "mytable", Comment: "new"},
+	})
+	require.NoError(to.CreateIndex(ctx, "idx_a", sql.IndexUsing_Default, sql.IndexConstraint_Unique, []sql.IndexColumn{{Name: "a"}}, ""))
+	require.NoError(to.CreateCheck(ctx, &sql.CheckDefinition{Name: "chk_a", CheckExpression: "a > 0", Enforced: true}))
+
+	stmts, err := schemadiff.DiffTables(ctx, from, to)
+	require.NoError(err)
+	require.Equal([]string{
+		"ALTER TABLE `mytable` DROP INDEX `idx_a`",
+		"ALTER TABLE `mytable` DROP COLUMN `b`",
+		"ALTER TABLE `mytable` MODIFY COLUMN `a` bigint NOT NULL",
+		"ALTER TABLE `mytable` ADD COLUMN `c` text COMMENT 'new' AFTER `a`",
+		"ALTER TABLE `mytable` ADD UNIQUE INDEX `idx_a` (`a`)",
+		"ALTER TABLE `mytable` ADD CONSTRAINT `chk_a` CHECK (a > 0)",
+	}, stmts)
+
+	stmts, err = schemadiff.DiffTables(ctx, to, to)
+	require.NoError(err)
+	require.Empty(stmts)
+}
+
+func TestDiffTablesDropOrder(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	from := memory.NewTable("mytable", sql.Schema{
+		&sql.Column{Name: "pk", Type: sql.Int64, Nullable: false, Source: "mytable", PrimaryKey: true},
+		&sql.Column{Name: "a", Type: sql.Int64, Nullable: true, Source: "mytable"},
+		&sql.Column{Name: "b", Type: sql.Int64, Nullable: true, Source: "mytable"},
+	})
+	require.NoError(from.CreateIndex(ctx, "idx_b", sql.IndexUsing_Default, sql.IndexConstraint_None, []sql.IndexColumn{{Name: "b"}}, ""))
+	require.NoError(from.CreateCheck(ctx, &sql.CheckDefinition{Name: "chk_b", CheckExpression: "b > 0", Enforced: true}))
+	require.NoError(from.CreateForeignKey(ctx, "fk_b", []string{"b"}, "parent", []string{"id"}, sql.ForeignKeyReferenceOption_DefaultAction, sql.ForeignKeyReferenceOption_DefaultAction))
+
+	to := memory.NewTable("mytable", sql.Schema{
+		&sql.Column{Name: "a", Type: sql.Int64, Nullable: true, Source: "mytable"},
+	})
+
+	// Every object is dropped before the objects it depends on
+	stmts, err := schemadiff.DiffTables(ctx, from, to)
+	require.NoError(err)
+	require.Equal([]string{
+		"ALTER TABLE `mytable` DROP FOREIGN KEY `fk_b`",
+		"ALTER TABLE `mytable` DROP CHECK `chk_b`",
+		"ALTER TABLE `mytable` DROP INDEX `idx_b`",
+		"ALTER TABLE `mytable` DROP PRIMARY KEY",
+		"ALTER TABLE `mytable` DROP COLUMN `pk`",
+		"ALTER TABLE `mytable` DROP COLUMN `b`",
+	}, stmts)
+}
+
+func TestDiffDatabases(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.Schema{
+		&sql.Column{Name: "pk", Type: sql.Int64, Nullable: false, PrimaryKey: true},
+		&sql.Column{Name: "v", Type: sql.Int64, Nullable: true},
+	}
+
+	from := memory.NewDatabase("db1")
+	from.AddTable("shared", memory.NewTable("shared", schema))
+	from.AddTable("dropped", memory.NewTable("dropped", schema))
+
+	to := memory.NewDatabase("db2")
+	to.AddTable("shared", memory.NewTable("shared", schema))
+	to.AddTable("added", memory.NewTable("added", schema))
+
+	stmts, err := schemadiff.DiffDatabases(ctx, from, to)
+	require.NoError(err)
+	require.Equal([]string{
+		"CREATE TABLE `added` (\n  `pk` bigint NOT NULL,\n  `v` bigint,\n  PRIMARY KEY (`pk`)\n)",
+		"DROP TABLE `dropped`",
+	}, stmts)
+}
@@ -160,6 +160,8 @@ type BaseSession struct {
 	lastQueryInfo    map[string]int64
 	tx               Transaction
 	ignoreAutocommit bool
+	tempTables       map[string]map[string]Table
+	sessionDbs       map[string]Database
 }
 
 func (s *BaseSession) GetLogger() *logrus.Entry {
@@ -212,6 +214,10 @@ func (s *BaseSession) GetAllSessionVariables() map[string]interface{} {
 	defer s.mu.RUnlock()
 
 	for k, v := range s.systemVars {
+		// Variables that only have a global scope have no session value.
+		if sysVar, global, ok := SystemVariables.GetGlobal(k); ok && sysVar.Scope == SystemVariableScope_Global {
+			v = global
+		}
 		m[k] = v
 	}
 	return m
@@ -249,16 +255,21 @@ func (s *BaseSession) SetUserVariable(ctx *Context, varName string, value interf
 
 // GetSessionVariable implements the Session interface.
 func (s *BaseSession) GetSessionVariable(ctx *Context, sysVarName string) (interface{}, error) {
-	sysVar, _, ok := SystemVariables.GetGlobal(sysVarName)
+	sysVar, global, ok := SystemVariables.GetGlobal(sysVarName)
 	if !ok {
 		return nil, ErrUnknownSystemVariable.New(sysVarName)
 	}
+	// Variables that only have a global scope have no session value, so changes with SET GLOBAL are seen by all
+	// sessions.
+	if sysVar.Scope == SystemVariableScope_Global {
+		return global, nil
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	val, ok := s.systemVars[strings.ToLower(sysVarName)]
 	if !ok {
-		s.systemVars[strings.ToLower(sysVarName)] = sysVar.Default
-		val = sysVar.Default
+		s.systemVars[strings.ToLower(sysVarName)] = global
+		val = global
 	}
 	return val, nil
 }
@@ -423,17 +434,30 @@ type (
 	}
 )
 
+// ErrorWarnings returns the warnings given with the Error level, which are the ones SHOW ERRORS shows.
+func ErrorWarnings(warnings []*Warning) []*Warning {
+	errs := make([]*Warning, 0, len(warnings))
+	for _, w := range warnings {
+		if w.Level == "Error" {
+			errs = append(errs, w)
+		}
+	}
+	return errs
+}
+
 const (
-	RowCount     = "row_count"
-	FoundRows    = "found_rows"
-	LastInsertId = "last_insert_id"
+	RowCount           = "row_count"
+	FoundRows          = "found_rows"
+	LastInsertId       = "last_insert_id"
+	LastCommitSequence = "last_commit_sequence"
 )
 
 func defaultLastQueryInfo() map[string]int64 {
 	return map[string]int64{
-		RowCount:     0,
-		FoundRows:    1, // this is kind of a hack -- it handles the case of `select found_rows()` before any select statement is issued
-		LastInsertId: 0,
+		RowCount:           0,
+		FoundRows:          1, // this is kind of a hack -- it handles the case of `select found_rows()` before any select statement is issued
+		LastInsertId:       0,
+		LastCommitSequence: 0,
 	}
 }
 
@@ -530,13 +554,36 @@ func NewBaseSession() *BaseSession {
 type Context struct {
 	context.Context
 	Session
-	Memory      *MemoryManager
-	ProcessList ProcessList
-	pid         uint64
-	query       string
-	queryTime   time.Time
-	tracer      opentracing.Tracer
-	rootSpan    opentracing.Span
+	Memory         *MemoryManager
+	ProcessList    ProcessList
+	EngineStatus   EngineStatusProvider
+	BackgroundJobs *BackgroundJobs
+	Statistics     *StatisticsTracker
+	Replication    ReplicationConfigStore
+	BinlogFilters  *ReplicationFilterSet
+	Binlog         *Binlog
+	LoadData       LoadDataReporter
+	// LoadDataSources open the files of LOAD DATA statements that aren't on the local disk.
+	LoadDataSources *LoadDataSources
+	// FunctionLimits bound the work done by single calls of expensive functions.
+	FunctionLimits *FunctionLimits
+	memAccount     *MemoryAccount
+	pid            uint64
+	query          string
+	queryTime      time.Time
+	tracer         opentracing.Tracer
+	rootSpan       opentracing.Span
+	services       Services
+}
+
+// Services are handles to optional or plugin functionality that can be used by the SQL implementation in certain
+// situations. An integrator can set methods on Services for a given *Context and different parts of go-mysql-server
+// will inspect it in order to fulfill their implementations.
+type Services struct {
+	// KillConnection closes the connection with the id given. It's used by KILL CONNECTION.
+	KillConnection func(connID uint32) error
+	// HasConnection returns whether there is a connection with the id given. It's used by KILL.
+	HasConnection func(connID uint32) bool
 }
 
 // ContextOption is a function to configure the context.
@@ -574,6 +621,7 @@ func WithQuery(q string) ContextOption {
 func WithMemoryManager(m *MemoryManager) ContextOption {
 	return func(ctx *Context) {
 		ctx.Memory = m
+		ctx.memAccount = m.NewAccount()
 	}
 }
 
@@ -590,6 +638,76 @@ func WithProcessList(p ProcessList) ContextOption {
 	}
 }
 
+// WithEngineStatus sets the provider of the engine state shown by information_schema.
+func WithEngineStatus(p EngineStatusProvider) ContextOption {
+	return func(ctx *Context) {
+		ctx.EngineStatus = p
+	}
+}
+
+// WithBackgroundJobs sets the background jobs of the engine the context runs queries in.
+func WithBackgroundJobs(b *BackgroundJobs) ContextOption {
+	return func(ctx *Context) {
+		ctx.BackgroundJobs = b
+	}
+}
+
+// WithStatistics sets the tracker of the changes to the tables whose statistics are refreshed automatically.
+func WithStatistics(s *StatisticsTracker) ContextOption {
+	return func(ctx *Context) {
+		ctx.Statistics = s
+	}
+}
+
+// WithReplicationConfigStore sets the store of the replication configuration managed by the replication statements.
+func WithReplicationConfigStore(s ReplicationConfigStore) ContextOption {
+	return func(ctx *Context) {
+		ctx.Replication = s
+	}
+}
+
+// WithBinlogFilters sets the filters of the changes the engine the context runs queries in writes to its binary log.
+func WithBinlogFilters(f *ReplicationFilterSet) ContextOption {
+	return func(ctx *Context) {
+		ctx.BinlogFilters = f
+	}
+}
+
+// WithBinlog sets the binary log the changes of the statements of the context are written to.
+func WithBinlog(b *Binlog) ContextOption {
+	return func(ctx *Context) {
+		ctx.Binlog = b
+	}
+}
+
+// WithLoadDataReporter sets the receiver of the reports of the LOAD DATA statements of the context.
+func WithLoadDataReporter(r LoadDataReporter) ContextOption {
+	return func(ctx *Context) {
+		ctx.LoadData = r
+	}
+}
+
+// WithLoadDataSources sets the sources the files of the LOAD DATA statements of the context are opened from.
+func WithLoadDataSources(s *LoadDataSources) ContextOption {
+	return func(ctx *Context) {
+		ctx.LoadDataSources = s
+	}
+}
+
+// WithFunctionLimits sets the limits of the calls of expensive functions in the statements of the context.
+func WithFunctionLimits(l *FunctionLimits) ContextOption {
+	return func(ctx *Context) {
+		ctx.FunctionLimits = l
+	}
+}
+
+// WithServices sets the services available to the context.
+func WithServices(services Services) ContextOption {
+	return func(ctx *Context) {
+		ctx.services = services
+	}
+}
+
 var ctxNowFunc = time.Now
 var ctxNowFuncMutex = &sync.Mutex{}
 
@@ -629,6 +747,10 @@ func NewContext(
 		c.Memory = NewMemoryManager(ProcessMemory)
 	}
 
+	if c.memAccount == nil {
+		c.memAccount = c.Memory.NewAccount()
+	}
+
 	if c.ProcessList == nil {
 		c.ProcessList = EmptyProcessList{}
 	}
@@ -694,6 +816,27 @@ func (c *Context) WithContext(ctx context.Context) *Context {
 	return &nc
 }
 
+// KillConnection closes the connection with the id given, if the integrator provided a way to do so.
+func (c *Context) KillConnection(connID uint32) error {
+	if c.services.KillConnection != nil {
+		return c.services.KillConnection(connID)
+	}
+	return nil
+}
+
+// HasConnection returns whether there is a connection with the id given, if the integrator provided a way to know it.
+func (c *Context) HasConnection(connID uint32) bool {
+	if c.services.HasConnection != nil {
+		return c.services.HasConnection(connID)
+	}
+	return false
+}
+
+// MemoryAccount returns the account that operators of the query of this context track their memory usage with.
+func (c *Context) MemoryAccount() *MemoryAccount {
+	return c.memAccount
+}
+
 // RootSpan returns the root span, if any.
 func (c *Context) RootSpan() opentracing.Span {
 	return c.rootSpan
@@ -0,0 +1,147 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+)
+
+// SessionDatabaseProvider is a database provider that can create databases that only exist in the session that created
+// them, such as private scratch databases for the clients of an embedded server. Session databases are never added to
+// the provider, which only builds them.
+type SessionDatabaseProvider interface {
+	DatabaseProvider
+	// NewSessionDatabase returns a new empty database with the name given, to be held by the session of the context
+	// given.
+	NewSessionDatabase(ctx *Context, name string) (Database, error)
+}
+
+// SessionDatabaseSession is a session that holds the session databases created in it. Session databases are only
+// visible to the session that created them, take precedence over the databases of the provider with the same name, and
+// are dropped when the session is closed. BaseSession implements it.
+type SessionDatabaseSession interface {
+	Session
+	// AddSessionDatabase adds the database given to the session. Returns ErrDatabaseExists if the session already has
+	// a database with the same name.
+	AddSessionDatabase(db Database) error
+	// GetSessionDatabase returns the session database with the name given, case-insensitively.
+	GetSessionDatabase(name string) (Database, bool)
+	// GetAllSessionDatabases returns the session databases, sorted by name.
+	GetAllSessionDatabases() []Database
+	// DropSessionDatabase drops the session database with the name given, case-insensitively, and returns whether it
+	// existed.
+	DropSessionDatabase(name string) bool
+	// DropAllSessionDatabases drops all the session databases.
+	DropAllSessionDatabases()
+}
+
+var _ SessionDatabaseSession = (*BaseSession)(nil)
+
+// AddSessionDatabase implements the SessionDatabaseSession interface.
+func (s *BaseSession) AddSessionDatabase(db Database) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := strings.ToLower(db.Name())
+	if _, ok := s.sessionDbs[name]; ok {
+		return ErrDatabaseExists.New(db.Name())
+	}
+
+	if s.sessionDbs == nil {
+		s.sessionDbs = make(map[string]Database)
+	}
+	s.sessionDbs[name] = db
+	return nil
+}
+
+// GetSessionDatabase implements the SessionDatabaseSession interface.
+func (s *BaseSession) GetSessionDatabase(name string) (Database, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	db, ok := s.sessionDbs[strings.ToLower(name)]
+	return db, ok
+}
+
+// GetAllSessionDatabases implements the SessionDatabaseSession interface.
+func (s *BaseSession) GetAllSessionDatabases() []Database {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	dbs := make([]Database, 0, len(s.sessionDbs))
+	for _, db := range s.sessionDbs {
+		dbs = append(dbs, db)
+	}
+
+	sort.Slice(dbs, func(i, j int) bool {
+		return dbs[i].Name() < dbs[j].Name()
+	})
+	return dbs
+}
+
+// DropSessionDatabase implements the SessionDatabaseSession interface.
+func (s *BaseSession) DropSessionDatabase(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessionDbs[strings.ToLower(name)]; !ok {
+		return false
+	}
+
+	delete(s.sessionDbs, strings.ToLower(name))
+	return true
+}
+
+// DropAllSessionDatabases implements the SessionDatabaseSession interface.
+func (s *BaseSession) DropAllSessionDatabases() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessionDbs = nil
+}
+
+// GetSessionDatabase returns the session database of the current session with the name given, case-insensitively, if
+// it has one. Session databases take precedence over the databases of the provider with the same name.
+func GetSessionDatabase(ctx *Context, name string) (Database, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	sess, ok := ctx.Session.(SessionDatabaseSession)
+	if !ok {
+		return nil, false
+	}
+	return sess.GetSessionDatabase(name)
+}
+
+// GetAllSessionDatabases returns the session databases of the current session, sorted by name.
+func GetAllSessionDatabases(ctx *Context) []Database {
+	if ctx == nil {
+		return nil
+	}
+	sess, ok := ctx.Session.(SessionDatabaseSession)
+	if !ok {
+		return nil
+	}
+	return sess.GetAllSessionDatabases()
+}
+
+// GetDatabase returns the database with the name given, case-insensitively: the session database of the current session
+// with that name if it has one, or the database of the catalog given otherwise.
+func GetDatabase(ctx *Context, c Catalog, name string) (Database, error) {
+	if db, ok := GetSessionDatabase(ctx, name); ok {
+		return db, nil
+	}
+	return c.Database(name)
+}
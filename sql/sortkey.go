@@ -0,0 +1,241 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrSortKeyUnsupported is returned when values of a type can't be encoded as sort keys.
+var ErrSortKeyUnsupported = errors.NewKind("values of type %s can't be encoded as sort keys")
+
+const (
+	sortKeyNotNull byte = 0x01
+	sortKeyNull    byte = 0x02
+)
+
+// Sort keys are a binary encoding of values that preserves their order: comparing the sort keys of two values of the
+// same type with bytes.Compare gives the same result as comparing the values with the type's Compare function. Keys
+// are self-delimiting, so the keys of several values can be concatenated into the key of a row, which then sorts like
+// the values compared one after the other. Every key starts with a byte telling whether the value is NULL, which sorts
+// NULL after every other value, like Compare.
+//
+// Numbers are encoded in big endian with their sign bit flipped, strings are escaped and terminated so that no key is
+// the prefix of another, and decimals are encoded as their exponent followed by their digits.
+
+// HasSortKey returns whether values of the type given can be encoded as sort keys.
+func HasSortKey(typ Type) bool {
+	switch typ.(type) {
+	case SortKeyer, numberTypeImpl, decimalType, datetimeType, timespanType, yearType, bitType, enumType, setType,
+		systemBoolType, nullType:
+		return true
+	default:
+		return false
+	}
+}
+
+// EncodeSortKey appends the sort key of the value given, of the type given, to dst and returns the extended slice.
+func EncodeSortKey(dst []byte, typ Type, v interface{}) ([]byte, error) {
+	if v == nil {
+		return append(dst, sortKeyNull), nil
+	}
+	dst = append(dst, sortKeyNotNull)
+
+	switch t := typ.(type) {
+	case SortKeyer:
+		key, err := t.SortKey(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendSortKeyBytes(dst, key), nil
+	case numberTypeImpl:
+		switch t.baseType {
+		case sqltypes.Uint8, sqltypes.Uint16, sqltypes.Uint24, sqltypes.Uint32, sqltypes.Uint64:
+			u, err := convertToUint64(t, v)
+			if err != nil {
+				return nil, err
+			}
+			return appendSortKeyUint(dst, u), nil
+		case sqltypes.Float32, sqltypes.Float64:
+			f, err := convertToFloat64(t, v)
+			if err != nil {
+				return nil, err
+			}
+			return appendSortKeyFloat(dst, f), nil
+		default:
+			i, err := convertToInt64(t, v)
+			if err != nil {
+				return nil, err
+			}
+			return appendSortKeyInt(dst, i), nil
+		}
+	case decimalType:
+		d, err := t.ConvertToDecimal(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendSortKeyDecimal(dst, d.Decimal), nil
+	case datetimeType:
+		tm, ok := v.(time.Time)
+		if !ok {
+			converted, err := t.Convert(v)
+			if err != nil {
+				return nil, err
+			}
+			tm = converted.(time.Time)
+		} else if t.baseType == sqltypes.Date {
+			tm = tm.Truncate(24 * time.Hour)
+		}
+		dst = appendSortKeyInt(dst, tm.Unix())
+		return appendSortKeyUint32(dst, uint32(tm.Nanosecond())), nil
+	case timespanType:
+		ts, err := t.ConvertToTimespanImpl(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendSortKeyInt(dst, ts.AsMicroseconds()), nil
+	case yearType:
+		y, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendSortKeyInt(dst, int64(y.(int16))), nil
+	case bitType:
+		b, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendSortKeyUint(dst, b.(uint64)), nil
+	case enumType:
+		idx, err := t.ConvertToIndex(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendSortKeyInt(dst, int64(idx)), nil
+	case setType:
+		bits, err := t.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendSortKeyUint(dst, bits), nil
+	case systemBoolType:
+		b, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendSortKeyInt(dst, int64(b.(int8))), nil
+	case nullType:
+		return dst, nil
+	default:
+		return nil, ErrSortKeyUnsupported.New(typ)
+	}
+}
+
+// EncodeRowSortKey returns the sort key of the values given, each encoded with the type at the same position. Comparing
+// the keys of two rows gives the same result as comparing their values one by one.
+func EncodeRowSortKey(types []Type, values []interface{}) ([]byte, error) {
+	var key []byte
+	for i, v := range values {
+		var err error
+		key, err = EncodeSortKey(key, types[i], v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+func appendSortKeyUint(dst []byte, u uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], u)
+	return append(dst, buf[:]...)
+}
+
+func appendSortKeyUint32(dst []byte, u uint32) []byte {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], u)
+	return append(dst, buf[:]...)
+}
+
+func appendSortKeyInt(dst []byte, i int64) []byte {
+	return appendSortKeyUint(dst, uint64(i)^(1<<63))
+}
+
+// appendSortKeyFloat encodes positive floats with their sign bit set, and negative floats with every bit flipped so
+// that larger magnitudes sort first. Negative zero is encoded as zero, since they compare equal.
+func appendSortKeyFloat(dst []byte, f float64) []byte {
+	if f == 0 {
+		f = 0
+	}
+	bits := math.Float64bits(f)
+	if bits&(1<<63) != 0 {
+		bits = ^bits
+	} else {
+		bits |= 1 << 63
+	}
+	return appendSortKeyUint(dst, bits)
+}
+
+// appendSortKeyBytes escapes every 0x00 byte as 0x00 0xFF and terminates the key with 0x00 0x01, so that a key sorts
+// before every longer key it's a prefix of.
+func appendSortKeyBytes(dst []byte, b []byte) []byte {
+	for _, c := range b {
+		if c == 0x00 {
+			dst = append(dst, 0x00, 0xFF)
+		} else {
+			dst = append(dst, c)
+		}
+	}
+	return append(dst, 0x00, 0x01)
+}
+
+// appendSortKeyDecimal encodes a decimal as 0.d1d2...dn × 10^e: a byte for the sign, then the exponent e and the digits
+// without trailing zeros. Negative decimals have those bytes flipped, so that larger magnitudes sort first.
+func appendSortKeyDecimal(dst []byte, d decimal.Decimal) []byte {
+	sign := d.Sign()
+	if sign == 0 {
+		return append(dst, 0x01)
+	}
+
+	digits := strings.TrimLeft(d.Coefficient().String(), "-")
+	trimmed := strings.TrimRight(digits, "0")
+	exp := int64(d.Exponent()) + int64(len(digits))
+
+	if sign > 0 {
+		dst = append(dst, 0x02)
+	} else {
+		dst = append(dst, 0x00)
+	}
+
+	body := len(dst)
+	dst = appendSortKeyUint32(dst, uint32(int32(exp))^(1<<31))
+	dst = append(dst, trimmed...)
+	dst = append(dst, 0x00)
+
+	if sign < 0 {
+		for i := body; i < len(dst); i++ {
+			dst[i] = ^dst[i]
+		}
+	}
+
+	return dst
+}
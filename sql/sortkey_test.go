@@ -0,0 +1,111 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeSortKey(t *testing.T) {
+	testCases := []struct {
+		name   string
+		typ    Type
+		values []interface{}
+	}{
+		{"signed", Int64, []interface{}{int64(math.MinInt64), int64(-2), int8(-1), 0, int32(1), int64(math.MaxInt64), nil}},
+		{"unsigned", Uint64, []interface{}{uint64(0), uint8(1), uint64(256), uint64(math.MaxUint64), nil}},
+		{"float", Float64, []interface{}{math.Inf(-1), -1.5, -0.5, 0.0, 0.25, 2.0, math.Inf(1), nil}},
+		{"decimal", MustCreateDecimalType(10, 3), []interface{}{"-100", "-5.5", "-5", "-0.12", "-0.1", "0", "0.1", "0.12", "5", "5.001", "100", nil}},
+		{"string", LongText, []interface{}{"", "a", "a\x00", "a\x00b", "ab", "b", nil}},
+		{"datetime", Datetime, []interface{}{
+			time.Date(1000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(1969, time.December, 31, 23, 59, 59, 500, time.UTC),
+			time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+			"2021-05-02 10:00:00",
+			time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC),
+			nil,
+		}},
+		{"time", Time, []interface{}{"-10:00:00", "00:00:00", "00:00:01", "838:59:59", nil}},
+		{"enum", MustCreateEnumType([]string{"b", "a"}, Collation_Default), []interface{}{"b", "a", nil}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.True(HasSortKey(tt.typ))
+
+			keys := make([][]byte, len(tt.values))
+			for i, v := range tt.values {
+				var err error
+				keys[i], err = EncodeSortKey(nil, tt.typ, v)
+				require.NoError(err)
+			}
+
+			for i := range tt.values {
+				for j := range tt.values {
+					expected, err := tt.typ.Compare(tt.values[i], tt.values[j])
+					require.NoError(err)
+					require.Equal(expected, bytes.Compare(keys[i], keys[j]), "comparing %v and %v", tt.values[i], tt.values[j])
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeSortKeyEqualValues(t *testing.T) {
+	require := require.New(t)
+
+	a, err := EncodeSortKey(nil, Float64, 0.0)
+	require.NoError(err)
+	b, err := EncodeSortKey(nil, Float64, math.Copysign(0, -1))
+	require.NoError(err)
+	require.Equal(a, b)
+
+	a, err = EncodeSortKey(nil, MustCreateDecimalType(10, 3), "1.5")
+	require.NoError(err)
+	b, err = EncodeSortKey(nil, MustCreateDecimalType(10, 3), "1.500")
+	require.NoError(err)
+	require.Equal(a, b)
+}
+
+func TestEncodeRowSortKey(t *testing.T) {
+	require := require.New(t)
+	types := []Type{LongText, Int64}
+
+	rows := [][]interface{}{
+		{"a", int64(2)},
+		{"a", nil},
+		{"a\x00", int64(1)},
+		{"ab", int64(-1)},
+		{nil, int64(0)},
+	}
+
+	var prev []byte
+	for _, row := range rows {
+		key, err := EncodeRowSortKey(types, row)
+		require.NoError(err)
+		require.Equal(-1, bytes.Compare(prev, key), "%v", row)
+		prev = key
+	}
+
+	require.False(HasSortKey(JSON))
+	_, err := EncodeSortKey(nil, JSON, `{"a": 1}`)
+	require.True(ErrSortKeyUnsupported.Is(err))
+}
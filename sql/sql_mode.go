@@ -0,0 +1,75 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+)
+
+const (
+	// SqlModeAnsiQuotes makes double quoted text an identifier instead of a string.
+	SqlModeAnsiQuotes = "ANSI_QUOTES"
+	// SqlModeNoAutoValueOnZero makes 0 a value for AUTO_INCREMENT columns instead of generating the next one.
+	SqlModeNoAutoValueOnZero = "NO_AUTO_VALUE_ON_ZERO"
+	// SqlModeOnlyFullGroupBy rejects queries that select columns that are neither aggregated nor grouped by.
+	SqlModeOnlyFullGroupBy = "ONLY_FULL_GROUP_BY"
+	// SqlModePipesAsConcat makes || a string concatenation operator instead of a synonym of OR.
+	SqlModePipesAsConcat = "PIPES_AS_CONCAT"
+	// SqlModeNoZeroDate rejects the zero date '0000-00-00' in writes when combined with a strict mode.
+	SqlModeNoZeroDate = "NO_ZERO_DATE"
+	// SqlModeStrictTransTables makes invalid values in writes to transactional tables errors instead of warnings.
+	SqlModeStrictTransTables = "STRICT_TRANS_TABLES"
+	// SqlModeStrictAllTables makes invalid values in writes to any table errors instead of warnings.
+	SqlModeStrictAllTables = "STRICT_ALL_TABLES"
+)
+
+// SqlMode is the set of modes enabled by the sql_mode system variable, which change how the server validates values.
+// https://dev.mysql.com/doc/refman/8.0/en/sql-mode.html
+type SqlMode map[string]struct{}
+
+// NewSqlMode returns the SqlMode of the comma separated list of modes given.
+func NewSqlMode(modes string) SqlMode {
+	m := make(SqlMode)
+	for _, mode := range strings.Split(modes, ",") {
+		mode = strings.ToUpper(strings.TrimSpace(mode))
+		if mode != "" {
+			m[mode] = struct{}{}
+		}
+	}
+	return m
+}
+
+// LoadSqlMode returns the SqlMode of the session of the context given.
+func LoadSqlMode(ctx *Context) SqlMode {
+	val, err := ctx.GetSessionVariable(ctx, "sql_mode")
+	if err != nil {
+		return NewSqlMode("")
+	}
+
+	modes, _ := val.(string)
+	return NewSqlMode(modes)
+}
+
+// ModeEnabled returns whether the mode given is enabled.
+func (m SqlMode) ModeEnabled(mode string) bool {
+	_, ok := m[strings.ToUpper(mode)]
+	return ok
+}
+
+// Strict returns whether invalid values in writes are errors instead of warnings. All tables are considered
+// transactional, so STRICT_TRANS_TABLES is equivalent to STRICT_ALL_TABLES.
+func (m SqlMode) Strict() bool {
+	return m.ModeEnabled(SqlModeStrictTransTables) || m.ModeEnabled(SqlModeStrictAllTables)
+}
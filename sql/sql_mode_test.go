@@ -0,0 +1,52 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqlMode(t *testing.T) {
+	require := require.New(t)
+
+	mode := NewSqlMode("strict_trans_tables, NO_ZERO_DATE")
+	require.True(mode.ModeEnabled(SqlModeNoZeroDate))
+	require.True(mode.ModeEnabled("no_zero_date"))
+	require.False(mode.ModeEnabled(SqlModeStrictAllTables))
+	require.True(mode.Strict())
+
+	mode = NewSqlMode("")
+	require.False(mode.ModeEnabled(SqlModeNoZeroDate))
+	require.False(mode.Strict())
+
+	require.True(NewSqlMode(SqlModeStrictAllTables).Strict())
+}
+
+func TestLoadSqlMode(t *testing.T) {
+	require := require.New(t)
+	ctx := NewContext(context.Background(), WithSession(NewBaseSession()))
+
+	mode := LoadSqlMode(ctx)
+	require.True(mode.Strict())
+	require.False(mode.ModeEnabled(SqlModeNoZeroDate))
+
+	require.NoError(ctx.SetSessionVariable(ctx, "sql_mode", "NO_ZERO_DATE"))
+	mode = LoadSqlMode(ctx)
+	require.False(mode.Strict())
+	require.True(mode.ModeEnabled(SqlModeNoZeroDate))
+}
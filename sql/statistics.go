@@ -0,0 +1,188 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// StatsAutoRecalcThresholdSessionVar is the session variable holding the fraction of the rows of a table that must
+// change after its statistics were computed for them to be refreshed automatically. Zero disables automatic refreshes.
+const StatsAutoRecalcThresholdSessionVar = "stats_auto_recalc_threshold"
+
+// defaultStatsAutoRecalcThreshold refreshes the statistics of tables once a tenth of their rows changed.
+const defaultStatsAutoRecalcThreshold = 0.1
+
+// StatisticsTracker counts the rows changed in the tables that implement AnalyzableTable since their statistics were
+// last computed, and refreshes their statistics once enough of their rows changed, so that the optimizer doesn't plan
+// queries with stale row counts when nobody runs ANALYZE TABLE.
+type StatisticsTracker struct {
+	mu     sync.Mutex
+	tables map[statisticsKey]*tableModifications
+}
+
+type statisticsKey struct {
+	db, table string
+}
+
+type tableModifications struct {
+	// rows is the number of rows of the table when its statistics were last computed.
+	rows uint64
+	// changed is the number of rows changed since then.
+	changed uint64
+	// refreshing is whether the statistics of the table are being refreshed.
+	refreshing bool
+}
+
+// NewStatisticsTracker returns a new StatisticsTracker that isn't tracking any table.
+func NewStatisticsTracker() *StatisticsTracker {
+	return &StatisticsTracker{tables: make(map[statisticsKey]*tableModifications)}
+}
+
+// Analyze computes the statistics of the table of the database given and starts counting its changed rows again.
+func (s *StatisticsTracker) Analyze(ctx *Context, db string, table AnalyzableTable) error {
+	if err := table.AnalyzeTable(ctx); err != nil {
+		return err
+	}
+
+	rows, err := table.NumRows(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := newStatisticsKey(db, table.Name())
+	m, ok := s.tables[key]
+	if !ok {
+		m = &tableModifications{}
+		s.tables[key] = m
+	}
+	m.rows, m.changed = rows, 0
+	return nil
+}
+
+// RecordChanges adds the number of rows given to the rows changed in the table of the database given, and refreshes
+// its statistics if the fraction of its rows changed since they were computed reaches the
+// stats_auto_recalc_threshold of the session. Refreshes run as background jobs of the context if it has them, and
+// before returning otherwise. Tables that don't implement AnalyzableTable are ignored.
+func (s *StatisticsTracker) RecordChanges(ctx *Context, db string, table Table, changed uint64) error {
+	at, ok := analyzableTable(table)
+	if !ok || changed == 0 {
+		return nil
+	}
+
+	key := newStatisticsKey(db, at.Name())
+	s.mu.Lock()
+	m, ok := s.tables[key]
+	if !ok {
+		s.mu.Unlock()
+		// The statistics of tables that weren't analyzed since the engine started were computed before, so the number
+		// of rows they report is the baseline changes are measured against.
+		rows, err := at.NumRows(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		if m, ok = s.tables[key]; !ok {
+			m = &tableModifications{rows: rows}
+			s.tables[key] = m
+		}
+	}
+
+	m.changed += changed
+	threshold := statsAutoRecalcThreshold(ctx)
+	refresh := !m.refreshing && threshold > 0 && float64(m.changed) >= threshold*float64(m.rows)
+	if refresh {
+		m.refreshing = true
+	}
+	s.mu.Unlock()
+
+	if !refresh {
+		return nil
+	}
+
+	analyze := func(ctx *Context) error {
+		defer s.refreshed(key)
+		return s.Analyze(ctx, db, at)
+	}
+
+	// Once the background jobs of the engine are closed, statistics are refreshed in the foreground.
+	if ctx.BackgroundJobs != nil {
+		if _, err := ctx.BackgroundJobs.Run(ctx, fmt.Sprintf("analyze table %s.%s", db, at.Name()), analyze); err == nil {
+			return nil
+		}
+	}
+	return analyze(ctx)
+}
+
+// Changes returns the number of rows changed in the table of the database given since its statistics were last
+// computed, and whether the table is being tracked at all.
+func (s *StatisticsTracker) Changes(db, table string) (uint64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.tables[newStatisticsKey(db, table)]
+	if !ok {
+		return 0, false
+	}
+	return m.changed, true
+}
+
+// refreshed records that the refresh of the statistics of the table given is done.
+func (s *StatisticsTracker) refreshed(key statisticsKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if m, ok := s.tables[key]; ok {
+		m.refreshing = false
+	}
+}
+
+func newStatisticsKey(db, table string) statisticsKey {
+	return statisticsKey{db: strings.ToLower(db), table: strings.ToLower(table)}
+}
+
+// analyzableTable returns the AnalyzableTable the table given is or wraps, if any.
+func analyzableTable(table Table) (AnalyzableTable, bool) {
+	for {
+		switch t := table.(type) {
+		case AnalyzableTable:
+			return t, true
+		case TableWrapper:
+			table = t.Underlying()
+		default:
+			return nil, false
+		}
+	}
+}
+
+// statsAutoRecalcThreshold returns the fraction of the rows of a table that must change for its statistics to be
+// refreshed in the session of the context given.
+func statsAutoRecalcThreshold(ctx *Context) float64 {
+	val, err := ctx.GetSessionVariable(ctx, StatsAutoRecalcThresholdSessionVar)
+	if err != nil {
+		return defaultStatsAutoRecalcThreshold
+	}
+
+	if threshold, ok := val.(float64); ok {
+		return threshold
+	}
+	return defaultStatsAutoRecalcThreshold
+}
@@ -232,9 +232,9 @@ func (t stringType) Convert(v interface{}) (interface{}, error) {
 	case bool:
 		val = strconv.FormatBool(s)
 	case float64:
-		val = strconv.FormatFloat(s, 'f', -1, 64)
+		val = renderFloat(s, 64)
 	case float32:
-		val = strconv.FormatFloat(float64(s), 'f', -1, 32)
+		val = renderFloat(float64(s), 32)
 	case int:
 		val = strconv.FormatInt(int64(s), 10)
 	case int8:
@@ -260,7 +260,7 @@ func (t stringType) Convert(v interface{}) (interface{}, error) {
 	case []byte:
 		val = string(s)
 	case time.Time:
-		val = s.Format(TimestampDatetimeLayout)
+		val = renderTemporal(sqltypes.Datetime, s)
 	case decimal.Decimal:
 		val = s.String()
 	case decimal.NullDecimal:
@@ -419,6 +419,20 @@ func (t stringType) MaxByteLength() int64 {
 	return t.charLength * t.CharacterSet().MaxLength()
 }
 
+// SortKey implements the SortKeyer interface. Like Compare, it doesn't take the collation into account yet, so the keys
+// are the bytes of the string. Once Compare uses the collation, this should return the collation's sort key instead.
+func (t stringType) SortKey(v interface{}) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		converted, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		s = converted.(string)
+	}
+	return []byte(s), nil
+}
+
 func (t stringType) CreateMatcher(likeStr string) (regex.DisposableMatcher, error) {
 	c := t.Collation()
 	return c.LikeMatcher(likeStr)
@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 	"testing"
@@ -85,6 +86,35 @@ func TestStringCompare(t *testing.T) {
 	}
 }
 
+func TestStringSortKey(t *testing.T) {
+	typ := MustCreateStringWithDefaults(sqltypes.VarChar, 10)
+	values := []interface{}{"", "a", "A", "ab", "b", "é", 1, 10, 2, true, []byte("254")}
+
+	for _, a := range values {
+		for _, b := range values {
+			t.Run(fmt.Sprintf("%v %v", a, b), func(t *testing.T) {
+				cmp, err := typ.Compare(a, b)
+				require.NoError(t, err)
+
+				aKey, err := typ.(SortKeyer).SortKey(a)
+				require.NoError(t, err)
+				bKey, err := typ.(SortKeyer).SortKey(b)
+				require.NoError(t, err)
+
+				assert.Equal(t, cmp, bytes.Compare(aKey, bKey))
+			})
+		}
+	}
+
+	for _, c := range []Collation{Collation_utf8mb4_0900_ai_ci, Collation_binary} {
+		for _, a := range []string{"a", "A", "b", "B", "ab"} {
+			for _, b := range []string{"a", "A", "b", "B", "ab"} {
+				assert.Equal(t, c.Compare(a, b), bytes.Compare(c.SortKey(a), c.SortKey(b)), "%s: %s, %s", c.Name, a, b)
+			}
+		}
+	}
+}
+
 func TestStringCreateBlob(t *testing.T) {
 	tests := []struct {
 		baseType     query.Type
@@ -141,7 +141,7 @@ func (t systemDoubleType) EncodeValue(val interface{}) (string, error) {
 	if !ok {
 		return "", ErrSystemVariableCodeFail.New(val, t.String())
 	}
-	return strconv.FormatFloat(expectedVal, 'f', -1, 64), nil
+	return renderFloat(expectedVal, 64), nil
 }
 
 // DecodeValue implements SystemVariableType interface.
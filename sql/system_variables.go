@@ -144,6 +144,17 @@ func (sv *globalSystemVariables) GetGlobal(name string) (SystemVariable, interfa
 	return SystemVariable{}, nil, false
 }
 
+// GetAllGlobalVariables returns a copy of the global values of all the system variables.
+func (sv *globalSystemVariables) GetAllGlobalVariables() map[string]interface{} {
+	sv.mutex.RLock()
+	defer sv.mutex.RUnlock()
+	vals := make(map[string]interface{}, len(sv.sysVarVals))
+	for key, val := range sv.sysVarVals {
+		vals[key] = val
+	}
+	return vals
+}
+
 // SetGlobal sets the system variable with the given name to the given value. If the system variable does not exist,
 // then an error is returned. Additionally, if the value is invalid for the variable's type then an error is returned.
 // Only global dynamic variables may be set through this function, as it is intended for use through the SET GLOBAL
@@ -386,6 +397,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemStringType("bind_address"),
 		Default:           "*",
 	},
+	"binlog_checksum": {
+		Name:              "binlog_checksum",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemEnumType("binlog_checksum", "NONE", "CRC32"),
+		Default:           "CRC32",
+	},
 	"binlog_gtid_simple_recovery": {
 		Name:              "binlog_gtid_simple_recovery",
 		Scope:             SystemVariableScope_Global,
@@ -858,6 +877,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("generated_random_password_length", 5, 255, false),
 		Default:           int64(20),
 	},
+	"group_by_spill_size": {
+		Name:              "group_by_spill_size",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              NewSystemUintType("group_by_spill_size", 0, 18446744073709551615),
+		Default:           uint64(67108864),
+	},
 	"group_concat_max_len": {
 		Name:              "group_concat_max_len",
 		Scope:             SystemVariableScope_Both,
@@ -954,6 +981,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("immediate_server_version", -9223372036854775808, 9223372036854775807, false),
 		Default:           int64(80017),
 	},
+	"index_lookup_batch_size": {
+		Name:              "index_lookup_batch_size",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              NewSystemIntType("index_lookup_batch_size", 1, 65536, false),
+		Default:           int64(32),
+	},
 	"init_connect": {
 		Name:              "init_connect",
 		Scope:             SystemVariableScope_Global,
@@ -1018,6 +1053,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemUintType("join_buffer_size", 128, 18446744073709547520),
 		Default:           uint64(262144),
 	},
+	"join_search_budget": {
+		Name:              "join_search_budget",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              NewSystemIntType("join_search_budget", 1, 2147483647, false),
+		Default:           int64(40320),
+	},
 	"keep_files_on_create": {
 		Name:              "keep_files_on_create",
 		Scope:             SystemVariableScope_Both,
@@ -1111,8 +1154,8 @@ var systemVars = map[string]SystemVariable{
 		Scope:             SystemVariableScope_Both,
 		Dynamic:           true,
 		SetVarHintApplies: false,
-		Type:              NewSystemStringType("lc_time_names"),
-		Default:           "",
+		Type:              NewSystemEnumType("lc_time_names", LocaleNames()...),
+		Default:           DefaultLocale.Name,
 	},
 	"license": {
 		Name:              "license",
@@ -1138,6 +1181,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("lock_wait_timeout", 1, 31536000, false),
 		Default:           int64(31536000),
 	},
+	"log_bin": {
+		Name:              "log_bin",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              NewSystemBoolType("log_bin"),
+		Default:           int8(0),
+	},
 	"log_error": {
 		Name:              "log_error",
 		Scope:             SystemVariableScope_Global,
@@ -1650,6 +1701,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemUintType("open_files_limit", 0, 18446744073709551615),
 		Default:           uint64(5000),
 	},
+	"optimizer_infer_constraints": {
+		Name:              "optimizer_infer_constraints",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              NewSystemBoolType("optimizer_infer_constraints"),
+		Default:           int8(0),
+	},
 	"optimizer_prune_level": {
 		Name:              "optimizer_prune_level",
 		Scope:             SystemVariableScope_Both,
@@ -1892,6 +1951,22 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemEnumType("query_cache_type", "OFF", "ON", "DEMAND"),
 		Default:           "OFF",
 	},
+	"query_memory_limit": {
+		Name:              "query_memory_limit",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              NewSystemUintType("query_memory_limit", 0, 18446744073709551615),
+		Default:           uint64(0),
+	},
+	"query_parallelism": {
+		Name:              "query_parallelism",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemIntType("query_parallelism", 0, 1024, false),
+		Default:           int64(0),
+	},
 	//"query_prealloc_size": {
 	//	Name: "query_prealloc_size",
 	//	Scope: SystemVariableScope_Both,
@@ -2045,6 +2120,30 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("select_into_disk_sync_delay", 0, 31536000, false),
 		Default:           int64(0),
 	},
+	"server_id": {
+		Name:              "server_id",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemUintType("server_id", 0, 4294967295),
+		Default:           uint64(1),
+	},
+	"server_uuid": {
+		Name:              "server_uuid",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              NewSystemStringType("server_uuid"),
+		Default:           "",
+	},
+	"session_affinity_token": {
+		Name:              "session_affinity_token",
+		Scope:             SystemVariableScope_Session,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemStringType("session_affinity_token"),
+		Default:           "",
+	},
 	"session_track_gtids": {
 		Name:              "session_track_gtids",
 		Scope:             SystemVariableScope_Both,
@@ -2229,6 +2328,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemUintType("sort_buffer_size", 32768, 18446744073709551615),
 		Default:           uint64(262144),
 	},
+	"sort_spill_size": {
+		Name:              "sort_spill_size",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              NewSystemUintType("sort_spill_size", 0, 18446744073709551615),
+		Default:           uint64(67108864),
+	},
 	"sql_auto_is_null": {
 		Name:              "sql_auto_is_null",
 		Scope:             SystemVariableScope_Both,
@@ -2267,7 +2374,7 @@ var systemVars = map[string]SystemVariable{
 		Dynamic:           true,
 		SetVarHintApplies: true,
 		Type:              NewSystemSetType("sql_mode", "ALLOW_INVALID_DATES", "ANSI_QUOTES", "ERROR_FOR_DIVISION_BY_ZERO", "HIGH_NOT_PRECEDENCE", "IGNORE_SPACE", "NO_AUTO_VALUE_ON_ZERO", "NO_BACKSLASH_ESCAPES", "NO_DIR_IN_CREATE", "NO_ENGINE_SUBSTITUTION", "NO_UNSIGNED_SUBTRACTION", "NO_ZERO_DATE", "NO_ZERO_IN_DATE", "ONLY_FULL_GROUP_BY", "PAD_CHAR_TO_FULL_LENGTH", "PIPES_AS_CONCAT", "REAL_AS_FLOAT", "STRICT_ALL_TABLES", "STRICT_TRANS_TABLES", "TIME_TRUNCATE_FRACTIONAL"),
-		Default:           "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION",
+		Default:           "ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION",
 	},
 	"sql_notes": {
 		Name:              "sql_notes",
@@ -2381,6 +2488,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemStringType("ssl_key"),
 		Default:           "",
 	},
+	"stats_auto_recalc_threshold": {
+		Name:              "stats_auto_recalc_threshold",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemDoubleType("stats_auto_recalc_threshold", 0, 1),
+		Default:           float64(0.1),
+	},
 	"stored_program_cache": {
 		Name:              "stored_program_cache",
 		Scope:             SystemVariableScope_Global,
@@ -2435,7 +2550,7 @@ var systemVars = map[string]SystemVariable{
 		Dynamic:           false,
 		SetVarHintApplies: false,
 		Type:              NewSystemStringType("system_time_zone"),
-		Default:           "UTC",
+		Default:           systemTimeZone(),
 	},
 	"table_definition_cache": {
 		Name:              "table_definition_cache",
@@ -2587,7 +2702,7 @@ var systemVars = map[string]SystemVariable{
 		Scope:             SystemVariableScope_Both,
 		Dynamic:           true,
 		SetVarHintApplies: true,
-		Type:              NewSystemStringType("time_zone"),
+		Type:              NewSystemTimeZoneType("time_zone"),
 		Default:           "SYSTEM",
 	},
 	//TODO: this needs to utilize a function as the value is not static
@@ -0,0 +1,132 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+)
+
+// TemporaryTableSession is a session that holds the temporary tables created in it. Temporary tables are only visible
+// to the session that created them, take precedence over the persistent tables with the same name, and are dropped
+// when the session is closed. BaseSession implements it, so databases can keep their temporary tables in any session
+// built on it.
+type TemporaryTableSession interface {
+	Session
+	// AddTemporaryTable adds the temporary table given to the database named. Returns ErrTableAlreadyExists if the
+	// session already has a temporary table with the same name in that database.
+	AddTemporaryTable(db string, table Table) error
+	// GetTemporaryTable returns the temporary table of the database named with the name given, case-insensitively.
+	GetTemporaryTable(db, name string) (Table, bool)
+	// GetAllTemporaryTables returns the temporary tables of the database named, sorted by name.
+	GetAllTemporaryTables(db string) []Table
+	// DropTemporaryTable drops the temporary table of the database named with the name given, case-insensitively, and
+	// returns whether it existed.
+	DropTemporaryTable(db, name string) bool
+	// DropAllTemporaryTables drops all the temporary tables of the session.
+	DropAllTemporaryTables()
+}
+
+var _ TemporaryTableSession = (*BaseSession)(nil)
+
+// AddTemporaryTable implements the TemporaryTableSession interface.
+func (s *BaseSession) AddTemporaryTable(db string, table Table) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db = strings.ToLower(db)
+	if s.tempTables == nil {
+		s.tempTables = make(map[string]map[string]Table)
+	}
+	if s.tempTables[db] == nil {
+		s.tempTables[db] = make(map[string]Table)
+	}
+
+	name := strings.ToLower(table.Name())
+	if _, ok := s.tempTables[db][name]; ok {
+		return ErrTableAlreadyExists.New(table.Name())
+	}
+
+	s.tempTables[db][name] = table
+	return nil
+}
+
+// GetTemporaryTable implements the TemporaryTableSession interface.
+func (s *BaseSession) GetTemporaryTable(db, name string) (Table, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	table, ok := s.tempTables[strings.ToLower(db)][strings.ToLower(name)]
+	return table, ok
+}
+
+// GetAllTemporaryTables implements the TemporaryTableSession interface.
+func (s *BaseSession) GetAllTemporaryTables(db string) []Table {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tables := make([]Table, 0, len(s.tempTables[strings.ToLower(db)]))
+	for _, table := range s.tempTables[strings.ToLower(db)] {
+		tables = append(tables, table)
+	}
+
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].Name() < tables[j].Name()
+	})
+	return tables
+}
+
+// DropTemporaryTable implements the TemporaryTableSession interface.
+func (s *BaseSession) DropTemporaryTable(db, name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tables := s.tempTables[strings.ToLower(db)]
+	if _, ok := tables[strings.ToLower(name)]; !ok {
+		return false
+	}
+
+	delete(tables, strings.ToLower(name))
+	return true
+}
+
+// DropAllTemporaryTables implements the TemporaryTableSession interface.
+func (s *BaseSession) DropAllTemporaryTables() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tempTables = nil
+}
+
+// GetTemporaryTable returns the temporary table of the current session with the name given, case-insensitively, if
+// the database given supports temporary tables and the session has one with that name in it. Temporary tables take
+// precedence over the persistent tables of the database with the same name.
+func GetTemporaryTable(ctx *Context, db Database, name string) (Table, bool, error) {
+	tdb, ok := db.(TemporaryTableDatabase)
+	if !ok {
+		return nil, false, nil
+	}
+
+	tables, err := tdb.GetAllTemporaryTables(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, table := range tables {
+		if strings.EqualFold(table.Name(), name) {
+			return table, true, nil
+		}
+	}
+	return nil, false, nil
+}
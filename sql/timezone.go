@@ -0,0 +1,113 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// timeZoneOffsetRegex matches the time zones that are offsets from UTC, such as +01:00.
+var timeZoneOffsetRegex = regexp.MustCompile(`^([+-])(\d{1,2}):([0-5]\d)$`)
+
+// timeZones caches the locations of the time zones parsed by ParseTimeZone, which are read from the system time zone
+// database for named time zones.
+var timeZones sync.Map
+
+// ParseTimeZone returns the location of a time zone, which is either SYSTEM for the time zone of the server, an offset
+// from UTC between -13:59 and +14:00, such as +01:00, or the name of a time zone of the system time zone database,
+// such as Europe/Paris.
+func ParseTimeZone(tz string) (*time.Location, error) {
+	if strings.EqualFold(tz, "SYSTEM") {
+		return time.Local, nil
+	}
+	if loc, ok := timeZones.Load(tz); ok {
+		return loc.(*time.Location), nil
+	}
+
+	if matches := timeZoneOffsetRegex.FindStringSubmatch(tz); matches != nil {
+		hours, _ := strconv.Atoi(matches[2])
+		minutes, _ := strconv.Atoi(matches[3])
+		offset := hours*60 + minutes
+		if matches[1] == "-" {
+			offset = -offset
+		}
+		if offset < -(13*60+59) || offset > 14*60 {
+			return nil, ErrUnknownTimeZone.New(tz)
+		}
+		loc, _ := timeZones.LoadOrStore(tz, time.FixedZone(tz, offset*60))
+		return loc.(*time.Location), nil
+	}
+
+	// An empty name is UTC for time.LoadLocation, but isn't a valid time zone.
+	if tz == "" || tz == "Local" {
+		return nil, ErrUnknownTimeZone.New(tz)
+	}
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, ErrUnknownTimeZone.New(tz)
+	}
+	timeZones.Store(tz, loc)
+	return loc, nil
+}
+
+// SessionTimeZone returns the location of the time_zone of the session of the context given, which is the time zone
+// of the current date and time, and of the TIMESTAMP values read and written by the session. It's the time zone of the
+// server without a context.
+func SessionTimeZone(ctx *Context) *time.Location {
+	if ctx == nil || ctx.Session == nil {
+		return time.Local
+	}
+
+	val, err := ctx.GetSessionVariable(ctx, "time_zone")
+	if err != nil {
+		return time.Local
+	}
+	tz, _ := val.(string)
+	loc, err := ParseTimeZone(tz)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// TimestampToTimeZone returns the wall clock time in the location given of the TIMESTAMP value given, which is stored
+// in UTC. Like all datetime values, the wall clock time is returned in UTC. Zero dates are returned as they are.
+func TimestampToTimeZone(t time.Time, loc *time.Location) time.Time {
+	if t.Equal(zeroTime) {
+		return t
+	}
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// TimestampFromTimeZone returns the TIMESTAMP value, in UTC, of the wall clock time given in the location given. It's
+// the reverse of TimestampToTimeZone.
+func TimestampFromTimeZone(t time.Time, loc *time.Location) time.Time {
+	if t.Equal(zeroTime) {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc).UTC()
+}
+
+// systemTimeZone returns the name of the time zone of the server, for the system_time_zone system variable.
+func systemTimeZone() string {
+	name, _ := time.Now().Zone()
+	return name
+}
@@ -0,0 +1,75 @@
+// Copyright 2020-2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeZone(t *testing.T) {
+	tests := []struct {
+		tz     string
+		offset int
+		err    bool
+	}{
+		{"+00:00", 0, false},
+		{"+01:30", 90 * 60, false},
+		{"-5:00", -5 * 60 * 60, false},
+		{"+14:00", 14 * 60 * 60, false},
+		{"-13:59", -(13*60 + 59) * 60, false},
+		{"UTC", 0, false},
+		{"+14:01", 0, true},
+		{"-14:00", 0, true},
+		{"+01:60", 0, true},
+		{"", 0, true},
+		{"Local", 0, true},
+		{"Nowhere/Special", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tz, func(t *testing.T) {
+			loc, err := ParseTimeZone(tt.tz)
+			if tt.err {
+				require.True(t, ErrUnknownTimeZone.Is(err), "unexpected error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			_, offset := time.Date(2021, 1, 1, 0, 0, 0, 0, loc).Zone()
+			require.Equal(t, tt.offset, offset)
+		})
+	}
+
+	loc, err := ParseTimeZone("system")
+	require.NoError(t, err)
+	require.Equal(t, time.Local, loc)
+}
+
+func TestTimestampTimeZone(t *testing.T) {
+	require := require.New(t)
+
+	loc, err := ParseTimeZone("+02:00")
+	require.NoError(err)
+
+	wallClock := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	timestamp := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+	require.Equal(timestamp, TimestampFromTimeZone(wallClock, loc))
+	require.Equal(wallClock, TimestampToTimeZone(timestamp, loc))
+
+	require.Equal(zeroTime, TimestampFromTimeZone(zeroTime, loc))
+	require.Equal(zeroTime, TimestampToTimeZone(zeroTime, loc))
+}
@@ -23,11 +23,11 @@ import (
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/internal/regex"
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 )
 
 var (
@@ -70,6 +70,13 @@ type LikeMatcher interface {
 	CreateMatcher(likeStr string) (regex.DisposableMatcher, error)
 }
 
+// SortKeyer is a Type that can encode its values as binary-comparable sort keys. Comparing the sort keys of two values
+// with bytes.Compare must give the same result as comparing the values with the type's Compare function. EncodeSortKey
+// uses these keys for the values of types implementing this interface.
+type SortKeyer interface {
+	SortKey(v interface{}) ([]byte, error)
+}
+
 // SystemVariableType represents a SQL type specifically (and only) used in system variables. Assigning any non-system
 // variables a SystemVariableType will cause errors.
 type SystemVariableType interface {
@@ -510,6 +517,12 @@ func IsArray(t Type) bool {
 	return ok
 }
 
+// IsBit checks if t is a BIT type.
+func IsBit(t Type) bool {
+	_, ok := t.(bitType)
+	return ok
+}
+
 // IsBlob checks if t is BINARY, VARBINARY, or BLOB
 func IsBlob(t Type) bool {
 	switch t.Type() {
@@ -18,8 +18,9 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/dolthub/go-mysql-server/sql/parse/sqlparser"
 )
 
 func TestFloatCovert(t *testing.T) {
@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -22,13 +23,94 @@ import (
 type Window struct {
 	PartitionBy []Expression
 	OrderBy     SortFields
-	// TODO: window frame
+	// Frame is the frame of the window, or nil for the default frame: all the rows of the partition without ORDER BY,
+	// and the rows of the partition up to the peers of the current row with ORDER BY.
+	Frame *WindowFrame
 }
 
 func NewWindow(partitionBy []Expression, orderBy []SortField) *Window {
 	return &Window{PartitionBy: partitionBy, OrderBy: orderBy}
 }
 
+// WithFrame returns a copy of this window with the frame given.
+func (w *Window) WithFrame(frame *WindowFrame) *Window {
+	nw := *w
+	nw.Frame = frame
+	return &nw
+}
+
+// WindowFrameUnit is how the bounds of a window frame are measured.
+type WindowFrameUnit byte
+
+const (
+	// RowsFrame bounds are a number of rows before or after the current row.
+	RowsFrame WindowFrameUnit = iota
+	// RangeFrame bounds are a distance from the value of the ORDER BY expression of the current row, and include the
+	// peers of the rows at the bounds.
+	RangeFrame
+)
+
+func (u WindowFrameUnit) String() string {
+	if u == RangeFrame {
+		return "range"
+	}
+	return "rows"
+}
+
+// WindowFrameBoundType is the kind of a bound of a window frame.
+type WindowFrameBoundType byte
+
+const (
+	UnboundedPreceding WindowFrameBoundType = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+// WindowFrameBound is the start or the end of a window frame.
+type WindowFrameBound struct {
+	Type WindowFrameBoundType
+	// Offset is the number of rows, or the distance from the current value, of Preceding and Following bounds.
+	Offset int64
+}
+
+func (b WindowFrameBound) String() string {
+	switch b.Type {
+	case UnboundedPreceding:
+		return "unbounded preceding"
+	case Preceding:
+		return fmt.Sprintf("%d preceding", b.Offset)
+	case CurrentRow:
+		return "current row"
+	case Following:
+		return fmt.Sprintf("%d following", b.Offset)
+	default:
+		return "unbounded following"
+	}
+}
+
+// WindowFrame is the set of rows of its partition a window function is evaluated over for each row, relative to it.
+type WindowFrame struct {
+	Unit  WindowFrameUnit
+	Start WindowFrameBound
+	End   WindowFrameBound
+}
+
+// NewWindowFrame returns the window frame with the bounds given, or an error if the bounds are not valid: the frame
+// can't start at UNBOUNDED FOLLOWING or end at UNBOUNDED PRECEDING, and its start can't come after its end.
+func NewWindowFrame(unit WindowFrameUnit, start, end WindowFrameBound) (*WindowFrame, error) {
+	frame := &WindowFrame{Unit: unit, Start: start, End: end}
+	if start.Type == UnboundedFollowing || end.Type == UnboundedPreceding || start.Type > end.Type {
+		return nil, ErrInvalidWindowFrame.New(frame)
+	}
+	return frame, nil
+}
+
+func (f *WindowFrame) String() string {
+	return fmt.Sprintf("%s between %s and %s", f.Unit, f.Start, f.End)
+}
+
 // ToExpressions converts the PartitionBy and OrderBy expressions to a single slice of expressions suitable for
 // manipulation by analyzer rules.
 func (w *Window) ToExpressions() []Expression {
@@ -79,6 +161,10 @@ func (w *Window) String() string {
 			sb.WriteString(ob.String())
 		}
 	}
+	if w.Frame != nil {
+		sb.WriteString(" ")
+		sb.WriteString(w.Frame.String())
+	}
 	sb.WriteString(")")
 	return sb.String()
 }
@@ -107,6 +193,10 @@ func (w *Window) DebugString() string {
 			sb.WriteString(DebugString(ob))
 		}
 	}
+	if w.Frame != nil {
+		sb.WriteString(" ")
+		sb.WriteString(w.Frame.String())
+	}
 	sb.WriteString(")")
 	return sb.String()
 }
@@ -0,0 +1,108 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+)
+
+func TestStatisticsAutoRefresh(t *testing.T) {
+	require := require.New(t)
+
+	table := memory.NewAnalyzableTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t", PrimaryKey: true},
+	})
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t", table)
+	db.AddTable("u", memory.NewTable("u", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "u"},
+	}))
+
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), nil)
+	defer e.Close()
+	ctx := sql.NewEmptyContext().WithCurrentDB("mydb")
+	require.NoError(ctx.SetSessionVariable(ctx, sql.StatsAutoRecalcThresholdSessionVar, 0.5))
+
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+	numRows := func() uint64 {
+		n, err := table.NumRows(ctx)
+		require.NoError(err)
+		return n
+	}
+	// refreshed waits until the statistics of the table count the rows given and no refresh is running
+	refreshed := func(expected uint64) {
+		require.Eventually(func() bool {
+			for _, job := range e.BackgroundJobs.Jobs() {
+				if job.State == sql.BackgroundJobRunning {
+					return false
+				}
+			}
+			return numRows() == expected
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	changes := func() uint64 {
+		changed, ok := e.Statistics.Changes("mydb", "t")
+		require.True(ok)
+		return changed
+	}
+
+	require.Equal([]sql.Row{
+		{"mydb.t", "analyze", "status", "OK"},
+		{"mydb.u", "analyze", "note", "The storage engine for the table doesn't support analyze"},
+	}, query("ANALYZE TABLE t, mydb.`u`"))
+	require.Equal(uint64(0), changes())
+
+	// Any change refreshes the statistics of empty tables
+	query("INSERT INTO t VALUES (1), (2), (3), (4), (5), (6), (7), (8), (9), (10)")
+	refreshed(10)
+	require.Equal(uint64(0), changes())
+
+	query("INSERT INTO t VALUES (11), (12), (13), (14)")
+	require.Equal(uint64(4), changes())
+	require.Equal(uint64(10), numRows())
+
+	query("UPDATE t SET a = a + 100 WHERE a = 1")
+	refreshed(14)
+	require.Equal(uint64(0), changes())
+
+	// Tables not written to aren't tracked
+	_, ok := e.Statistics.Changes("mydb", "u")
+	require.False(ok)
+	query("INSERT INTO u VALUES (1)")
+	_, ok = e.Statistics.Changes("mydb", "u")
+	require.False(ok)
+
+	require.NoError(ctx.SetSessionVariable(ctx, sql.StatsAutoRecalcThresholdSessionVar, float64(0)))
+	query("DELETE FROM t")
+	require.Equal(uint64(14), changes())
+	require.Equal(uint64(14), numRows())
+
+	query("ANALYZE TABLE t")
+	require.Equal(uint64(0), changes())
+	require.Equal(uint64(0), numRows())
+}
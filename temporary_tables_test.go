@@ -0,0 +1,82 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+)
+
+func TestTemporaryTables(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	table := memory.NewTable("t", sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "t"},
+	})
+	require.NoError(table.Insert(sql.NewEmptyContext(), sql.NewRow(int64(1))))
+	db.AddTable("t", table)
+
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), nil)
+	defer e.Close()
+
+	sess1 := sql.NewBaseSession()
+	ctx1 := sql.NewContext(context.Background(), sql.WithSession(sess1)).WithCurrentDB("mydb")
+	ctx2 := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+
+	query := func(ctx *sql.Context, q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+	rows := func(ctx *sql.Context, q string) []sql.Row {
+		rows, err := query(ctx, q)
+		require.NoError(err)
+		return rows
+	}
+
+	// Temporary tables shadow the persistent tables with the same name, in their session only
+	rows(ctx1, "CREATE TEMPORARY TABLE t (a bigint primary key, b bigint)")
+	rows(ctx1, "INSERT INTO t VALUES (10, 20), (11, 21)")
+	require.Equal([]sql.Row{{int64(10), int64(20)}, {int64(11), int64(21)}}, rows(ctx1, "SELECT * FROM t ORDER BY a"))
+	require.Equal([]sql.Row{{int64(1)}}, rows(ctx2, "SELECT * FROM t"))
+	require.Equal([]sql.Row{{"t"}}, rows(ctx1, "SHOW TABLES"))
+
+	_, err := query(ctx1, "CREATE TEMPORARY TABLE T (a bigint)")
+	require.True(sql.ErrTableAlreadyExists.Is(err))
+	rows(ctx1, "CREATE TEMPORARY TABLE IF NOT EXISTS t (a bigint)")
+
+	rows(ctx1, "CREATE TEMPORARY TABLE u AS SELECT a FROM t WHERE a > 10")
+	require.Equal([]sql.Row{{int64(11)}}, rows(ctx1, "SELECT * FROM u"))
+	_, err = query(ctx2, "SELECT * FROM u")
+	require.True(sql.ErrTableNotFound.Is(err))
+
+	// Dropping a table drops the temporary table first
+	rows(ctx1, "DROP TABLE t")
+	require.Equal([]sql.Row{{int64(1)}}, rows(ctx1, "SELECT * FROM t"))
+
+	// Closing the session drops its temporary tables
+	sess1.DropAllTemporaryTables()
+	_, err = query(ctx1, "SELECT * FROM u")
+	require.True(sql.ErrTableNotFound.Is(err))
+}
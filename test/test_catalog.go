@@ -57,6 +57,11 @@ func (c *Catalog) RemoveDatabase(ctx *sql.Context, dbName string) error {
 	}
 }
 
+// CreateSessionDatabase implements the sql.Catalog interface.
+func (c *Catalog) CreateSessionDatabase(ctx *sql.Context, dbName string) error {
+	return sql.ErrSessionDatabaseNotSupported.New()
+}
+
 func (c *Catalog) HasDB(db string) bool {
 	return c.provider.HasDatabase(db)
 }
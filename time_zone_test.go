@@ -0,0 +1,101 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+)
+
+func TestTimeZone(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t", memory.NewTable("t", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		{Name: "ts", Type: sql.Timestamp, Source: "t", Nullable: true},
+		{Name: "dt", Type: sql.Datetime, Source: "t", Nullable: true},
+	}))
+
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db)), nil)
+	defer e.Close()
+
+	now := time.Date(2021, time.June, 1, 8, 0, 0, 0, time.UTC)
+	var paris, utc *sql.Context
+	require.NoError(sql.RunWithNowFunc(func() time.Time { return now }, func() error {
+		paris = sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+		utc = sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+		return nil
+	}))
+
+	query := func(ctx *sql.Context, q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+	rows := func(ctx *sql.Context, q string) []sql.Row {
+		rows, err := query(ctx, q)
+		require.NoError(err)
+		return rows
+	}
+	date := func(day, hour int) time.Time {
+		return time.Date(2021, time.June, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	rows(paris, "SET time_zone = '+02:00'")
+	rows(utc, "SET time_zone = 'UTC'")
+	require.Equal([]sql.Row{{"+02:00"}}, rows(paris, "SELECT @@time_zone"))
+
+	_, err := query(paris, "SET time_zone = 'Nowhere/Special'")
+	require.True(sql.ErrUnknownTimeZone.Is(err), "unexpected error %v", err)
+	_, err = query(paris, "SET time_zone = '+14:01'")
+	require.True(sql.ErrUnknownTimeZone.Is(err), "unexpected error %v", err)
+
+	// The current time is the one of the time zone of the session
+	require.Equal([]sql.Row{{date(1, 10)}}, rows(paris, "SELECT NOW()"))
+	require.Equal([]sql.Row{{date(1, 8)}}, rows(utc, "SELECT NOW()"))
+
+	// TIMESTAMP values are converted from and to the time zone of the session, DATETIME values are left as they are
+	rows(paris, "INSERT INTO t VALUES (1, '2021-06-02 12:00:00', '2021-06-02 12:00:00'), (2, NOW(), NOW())")
+	require.Equal([]sql.Row{
+		{int64(1), date(2, 12), date(2, 12)},
+		{int64(2), date(1, 10), date(1, 10)},
+	}, rows(paris, "SELECT * FROM t ORDER BY i"))
+	require.Equal([]sql.Row{
+		{int64(1), date(2, 10), date(2, 12)},
+		{int64(2), date(1, 8), date(1, 10)},
+	}, rows(utc, "SELECT * FROM t ORDER BY i"))
+
+	// TIMESTAMP values copied from other TIMESTAMP values aren't converted
+	rows(utc, "INSERT INTO t (i, ts) SELECT 3, ts FROM t WHERE i = 1")
+	rows(paris, "UPDATE t SET dt = '2021-06-03 12:00:00', ts = '2021-06-03 12:00:00' WHERE i = 2")
+	require.Equal([]sql.Row{
+		{int64(1), date(2, 10), date(2, 12)},
+		{int64(2), date(3, 10), date(3, 12)},
+		{int64(3), date(2, 10), nil},
+	}, rows(utc, "SELECT * FROM t ORDER BY i"))
+
+	require.Equal([]sql.Row{{float64(1622584800)}}, rows(paris, "SELECT UNIX_TIMESTAMP('2021-06-02 00:00:00')"))
+	require.Equal([]sql.Row{{date(2, 0)}}, rows(utc, "SELECT FROM_UNIXTIME(1622592000)"))
+}
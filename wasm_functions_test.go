@@ -0,0 +1,172 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/wasm"
+)
+
+// wasmTestModule returns the hex of a module exporting the functions:
+//
+//	add(i64, i64) -> i64
+//	upper(ptr i32, len i32) -> i64, which upper cases an ASCII string in place
+//	spin() -> i32, which loops forever
+//	alloc(i32) -> i32, a bump allocator
+//
+// and its memory.
+func wasmTestModule() string {
+	section := func(id byte, contents ...byte) []byte {
+		return append([]byte{id, byte(len(contents))}, contents...)
+	}
+
+	bin := []byte("\x00asm\x01\x00\x00\x00")
+	bin = append(bin, section(1,
+		4,
+		0x60, 2, 0x7e, 0x7e, 1, 0x7e,
+		0x60, 2, 0x7f, 0x7f, 1, 0x7e,
+		0x60, 0, 1, 0x7f,
+		0x60, 1, 0x7f, 1, 0x7f,
+	)...)
+	bin = append(bin, section(3, 4, 0, 1, 2, 3)...)
+	bin = append(bin, section(5, 1, 0, 1)...)
+	// The heap of the allocator starts at 1024
+	bin = append(bin, section(6, 1, 0x7f, 1, 0x41, 0x80, 0x08, 0x0b)...)
+
+	exports := []byte{5}
+	for i, name := range []string{"add", "upper", "spin", "alloc"} {
+		exports = append(exports, byte(len(name)))
+		exports = append(exports, name...)
+		exports = append(exports, 0, byte(i))
+	}
+	exports = append(exports, 6, 'm', 'e', 'm', 'o', 'r', 'y', 2, 0)
+	bin = append(bin, section(7, exports...)...)
+
+	bodies := [][]byte{
+		{0, 0x20, 0, 0x20, 1, 0x7c, 0x0b},
+		{
+			1, 3, 0x7f,
+			0x02, 0x40, 0x03, 0x40,
+			// if i >= len, break
+			0x20, 2, 0x20, 1, 0x4f, 0x0d, 1,
+			// addr = ptr + i, c = memory[addr]
+			0x20, 0, 0x20, 2, 0x6a, 0x21, 3,
+			0x20, 3, 0x2d, 0, 0, 0x21, 4,
+			// if 'a' <= c <= 'z', memory[addr] = c - 32
+			0x20, 4, 0x41, 0xe1, 0, 0x4f, 0x20, 4, 0x41, 0xfa, 0, 0x4d, 0x71,
+			0x04, 0x40, 0x20, 3, 0x20, 4, 0x41, 32, 0x6b, 0x3a, 0, 0, 0x0b,
+			// i++
+			0x20, 2, 0x41, 1, 0x6a, 0x21, 2,
+			0x0c, 0, 0x0b, 0x0b,
+			// ptr << 32 | len
+			0x20, 0, 0xad, 0x42, 32, 0x86, 0x20, 1, 0xad, 0x84, 0x0b,
+		},
+		{0, 0x03, 0x40, 0x0c, 0, 0x0b, 0x41, 0, 0x0b},
+		{0, 0x23, 0, 0x23, 0, 0x20, 0, 0x6a, 0x24, 0, 0x0b},
+	}
+	code := []byte{byte(len(bodies))}
+	for _, b := range bodies {
+		code = append(code, byte(len(b)))
+		code = append(code, b...)
+	}
+	bin = append(bin, section(10, code...)...)
+	return hex.EncodeToString(bin)
+}
+
+func TestWASMFunctions(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	e := New(analyzer.NewDefault(memory.NewMemoryDBProvider(db, memory.NewDatabase("other"))), nil)
+	defer e.Close()
+	e.FunctionLimits = &sql.FunctionLimits{MaxWASMInstructions: 100000}
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession())).WithCurrentDB("mydb")
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	module := wasmTestModule()
+	for _, q := range []string{
+		"CREATE FUNCTION add_ints(a BIGINT, b BIGINT) RETURNS BIGINT LANGUAGE WASM EXPORT 'add' AS X'" + module + "'",
+		"CREATE FUNCTION wasm_upper(s VARCHAR(100)) RETURNS TEXT LANGUAGE WASM EXPORT 'upper' AS 0x" + module,
+		"CREATE FUNCTION spin() RETURNS INT LANGUAGE WASM AS X'" + module + "'",
+		"CREATE FUNCTION IF NOT EXISTS spin() RETURNS INT LANGUAGE WASM AS X'" + module + "'",
+	} {
+		_, err := query(q)
+		require.NoError(err, q)
+	}
+
+	for _, tt := range []struct {
+		query    string
+		expected interface{}
+	}{
+		{"SELECT add_ints(2, 3)", int64(5)},
+		{"SELECT mydb.ADD_INTS(-2, 1)", int64(-1)},
+		{"SELECT add_ints(NULL, 1)", nil},
+		{"SELECT wasm_upper('hello, world')", "HELLO, WORLD"},
+		{"SELECT wasm_upper(wasm_upper('abc'))", "ABC"},
+	} {
+		rows, err := query(tt.query)
+		require.NoError(err, tt.query)
+		require.Equal([]sql.Row{{tt.expected}}, rows, tt.query)
+	}
+
+	// Functions are only visible unqualified from their database
+	ctx.SetCurrentDatabase("other")
+	_, err := query("SELECT add_ints(1, 2)")
+	require.True(sql.ErrFunctionNotFound.Is(err))
+	rows, err := query("SELECT mydb.add_ints(1, 2)")
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(3)}}, rows)
+	ctx.SetCurrentDatabase("mydb")
+
+	// Calls are bounded by the function limits
+	_, err = query("SELECT spin()")
+	require.True(sql.ErrFunctionLimitExceeded.Is(err))
+	e.FunctionLimits.Policy = sql.FunctionLimitWarn
+	rows, err = query("SELECT spin()")
+	require.NoError(err)
+	require.Equal([]sql.Row{{nil}}, rows)
+
+	// Modules must match the types of their functions
+	_, err = query("CREATE FUNCTION bad(s TEXT) RETURNS BIGINT LANGUAGE WASM EXPORT 'add' AS X'" + module + "'")
+	require.True(wasm.ErrSignatureMismatch.Is(err))
+	_, err = query("CREATE FUNCTION bad() RETURNS BIGINT LANGUAGE WASM AS X'0061736d'")
+	require.Error(err)
+	_, err = query("CREATE FUNCTION spin() RETURNS INT LANGUAGE WASM AS X'" + module + "'")
+	require.True(sql.ErrUserFunctionAlreadyExists.Is(err))
+
+	_, err = query("DROP FUNCTION add_ints")
+	require.NoError(err)
+	_, err = query("SELECT add_ints(1, 2)")
+	require.True(sql.ErrFunctionNotFound.Is(err))
+	_, err = query("DROP FUNCTION add_ints")
+	require.True(sql.ErrUserFunctionDoesNotExist.Is(err))
+	_, err = query("DROP FUNCTION IF EXISTS add_ints")
+	require.NoError(err)
+}